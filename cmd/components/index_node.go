@@ -35,10 +35,10 @@ type IndexNode struct {
 }
 
 // NewIndexNode creates a new IndexNode
-func NewIndexNode(ctx context.Context, factory dependency.Factory) (*IndexNode, error) {
+func NewIndexNode(ctx context.Context, factory dependency.Factory, opts ...grpcindexnode.Option) (*IndexNode, error) {
 	var err error
 	n := &IndexNode{}
-	svr, err := grpcindexnode.NewServer(ctx, factory)
+	svr, err := grpcindexnode.NewServer(ctx, factory, opts...)
 	if err != nil {
 		return nil, err
 	}
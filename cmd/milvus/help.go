@@ -9,7 +9,7 @@ import (
 
 var (
 	usageLine = fmt.Sprintf("Usage:\n"+
-		"%s\n%s\n%s\n%s\n", runLine, stopLine, mckLine, serverTypeLine)
+		"%s\n%s\n%s\n%s\n%s\n", runLine, stopLine, mckLine, indexNodeLine, serverTypeLine)
 
 	serverTypeLine = `
 [server type]
@@ -62,5 +62,34 @@ milvus mck run [flags]
 milvus mck cleanTrash [flags]
 	Clean the back inconsistent data
 	Tips: The flags is the same as its of the 'milvus mck [flags]'
+`
+	indexNodeLine = `
+milvus indexnode build [flags]
+	Build the index of one segment field offline with the IndexNode task code path, and print the timing and memory stats.
+	Tips: The storage settings of s3 input are read from milvus.yaml.
+[flags]
+	-input ''
+		Binlog directory or s3 uri (s3://bucket/prefix) of the segment field.
+	-params ''
+		Build params in json, e.g. {"type_params": {"dim": "128"}, "index_params": {"index_type": "HNSW", "metric_type": "L2", "M": "16", "efConstruction": "200"}}
+	-output 'index_files'
+		Local directory to save the index files.
+
+milvus indexnode replay [flags]
+	Replay the scheduler decision log of an IndexNode with a simulated clock, report the first decision not reproduced
+	and the queue waits of every tenant.
+	Tips: Enable the log by indexNode.scheduler.decisionLog.enable in milvus.yaml.
+[flags]
+	-log ''
+		Comma separated decision log files in order, e.g. scheduler_decisions.1,scheduler_decisions.
+
+milvus indexnode sandbox [flags]
+	Run a sandboxed build of IndexNode in the job directory, or a builder worker running the sandboxed builds of
+	IndexNode, it's started by IndexNode when indexNode.sandbox.enable is set.
+[flags]
+	-dir ''
+		Job directory of the sandboxed build.
+	-socket ''
+		Unix socket of IndexNode to run the sandboxed builds from.
 `
 )
@@ -0,0 +1,110 @@
+package milvus
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/indexnode"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+const (
+	IndexNodeCmd        = "indexnode"
+	IndexNodeTypeBuild  = "build"
+	IndexNodeTypeReplay = "replay"
+	// IndexNodeTypeSandbox runs a sandboxed build, it's started by IndexNode.
+	IndexNodeTypeSandbox = "sandbox"
+)
+
+type indexNodeCommand struct {
+	input  string
+	params string
+	output string
+	log    string
+	dir    string
+	socket string
+}
+
+func (c *indexNodeCommand) execute(args []string, flags *flag.FlagSet) {
+	if len(args) < 3 || (args[2] != IndexNodeTypeBuild && args[2] != IndexNodeTypeReplay && args[2] != IndexNodeTypeSandbox) {
+		fmt.Fprintln(os.Stderr, indexNodeLine)
+		return
+	}
+	flags.Usage = func() {
+		fmt.Fprintln(os.Stderr, indexNodeLine)
+	}
+	c.formatFlags(args, flags)
+	if args[2] == IndexNodeTypeReplay {
+		c.replay()
+		return
+	}
+	if args[2] == IndexNodeTypeSandbox {
+		c.sandbox()
+		return
+	}
+	if c.input == "" || c.params == "" {
+		fmt.Fprintln(os.Stderr, indexNodeLine)
+		os.Exit(-1)
+	}
+	params, err := indexnode.ParseOfflineBuildParams(c.params)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s\n", err.Error())
+		os.Exit(-1)
+	}
+
+	paramtable.Init()
+	stats, err := indexnode.RunOfflineBuild(context.Background(), c.input, c.output, params)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "offline build failed: %s\n", err.Error())
+		os.Exit(-1)
+	}
+	fmt.Fprint(os.Stdout, stats.String())
+}
+
+func (c *indexNodeCommand) replay() {
+	if c.log == "" {
+		fmt.Fprintln(os.Stderr, indexNodeLine)
+		os.Exit(-1)
+	}
+	paramtable.Init()
+	report, err := indexnode.ReplayDecisionLog(strings.Split(c.log, ",")...)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "replay failed: %s\n", err.Error())
+		os.Exit(-1)
+	}
+	fmt.Fprint(os.Stdout, report.String())
+}
+
+func (c *indexNodeCommand) sandbox() {
+	if (c.dir == "") == (c.socket == "") {
+		fmt.Fprintln(os.Stderr, indexNodeLine)
+		os.Exit(-1)
+	}
+	paramtable.Init()
+	if c.socket != "" {
+		if err := indexnode.RunBuildWorker(c.socket); err != nil {
+			fmt.Fprintf(os.Stderr, "builder worker failed: %s\n", err.Error())
+			os.Exit(-1)
+		}
+		return
+	}
+	if err := indexnode.RunBuildSandbox(c.dir); err != nil {
+		fmt.Fprintf(os.Stderr, "sandboxed build failed: %s\n", err.Error())
+		os.Exit(-1)
+	}
+}
+
+func (c *indexNodeCommand) formatFlags(args []string, flags *flag.FlagSet) {
+	flags.StringVar(&(c.input), "input", "", "binlog directory or s3 uri of the field to build index on")
+	flags.StringVar(&(c.params), "params", "", "build params in json")
+	flags.StringVar(&(c.output), "output", "index_files", "local directory to save the index files")
+	flags.StringVar(&(c.log), "log", "", "comma separated scheduler decision log files to replay in order")
+	flags.StringVar(&(c.dir), "dir", "", "job directory of the sandboxed build")
+	flags.StringVar(&(c.socket), "socket", "", "unix socket of IndexNode to run the sandboxed builds from")
+	if err := flags.Parse(args[3:]); err != nil {
+		os.Exit(-1)
+	}
+}
@@ -53,6 +53,8 @@ func RunMilvus(args []string) {
 		c = &dryRun{}
 	case MckCmd:
 		c = &mck{}
+	case IndexNodeCmd:
+		c = &indexNodeCommand{}
 	default:
 		c = &defaultCommand{}
 	}
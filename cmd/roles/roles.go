@@ -28,9 +28,11 @@ import (
 
 	"github.com/milvus-io/milvus/internal/tracer"
 
+	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/cmd/components"
+	grpcindexnode "github.com/milvus-io/milvus/internal/distributed/indexnode"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/management"
 	"github.com/milvus-io/milvus/internal/management/healthz"
@@ -108,6 +110,10 @@ type MilvusRoles struct {
 	EnableDataNode   bool `env:"ENABLE_DATA_NODE"`
 	EnableIndexCoord bool `env:"ENABLE_INDEX_COORD"`
 	EnableIndexNode  bool `env:"ENABLE_INDEX_NODE"`
+
+	// etcdCli is the etcd client shared by the components embedded in the standalone process,
+	// nil in cluster mode.
+	etcdCli *clientv3.Client
 }
 
 // EnvValue not used now.
@@ -162,7 +168,14 @@ func (mr *MilvusRoles) runIndexCoord(ctx context.Context, localMsg bool, wg *syn
 
 func (mr *MilvusRoles) runIndexNode(ctx context.Context, localMsg bool, wg *sync.WaitGroup) *components.IndexNode {
 	wg.Add(1)
-	return runComponent(ctx, localMsg, wg, components.NewIndexNode, metrics.RegisterIndexNode)
+	var opts []grpcindexnode.Option
+	if mr.etcdCli != nil {
+		// embedded in the standalone process, share the etcd client and keep the runtime configs apart.
+		opts = append(opts, grpcindexnode.WithEtcdClient(mr.etcdCli), grpcindexnode.WithParams(paramtable.Get().Namespace()))
+	}
+	return runComponent(ctx, localMsg, wg, func(ctx context.Context, factory dependency.Factory) (*components.IndexNode, error) {
+		return components.NewIndexNode(ctx, factory, opts...)
+	}, metrics.RegisterIndexNode)
 }
 
 func (mr *MilvusRoles) setupLogger() {
@@ -220,6 +233,20 @@ func (mr *MilvusRoles) Run(local bool, alias string) {
 				params.EtcdCfg.EtcdLogLevel.GetValue())
 			defer etcd.StopEtcdServer()
 		}
+
+		etcdCli, err := etcd.GetEtcdClient(
+			params.EtcdCfg.UseEmbedEtcd.GetAsBool(),
+			params.EtcdCfg.EtcdUseSSL.GetAsBool(),
+			params.EtcdCfg.Endpoints.GetAsStrings(),
+			params.EtcdCfg.EtcdTLSCert.GetValue(),
+			params.EtcdCfg.EtcdTLSKey.GetValue(),
+			params.EtcdCfg.EtcdTLSCACert.GetValue(),
+			params.EtcdCfg.EtcdTLSMinVersion.GetValue())
+		if err != nil {
+			panic(err)
+		}
+		defer etcdCli.Close()
+		mr.etcdCli = etcdCli
 		paramtable.SetRole(typeutil.StandaloneRole)
 	} else {
 		if err := os.Setenv(metricsinfo.DeployModeEnvKey, metricsinfo.ClusterDeployMode); err != nil {
@@ -73,6 +73,9 @@ const (
 
 	// SegmentIndexPath storage path const for segment index files.
 	SegmentIndexPath = `index_files`
+
+	// IndexStagingPath storage path const for the datasets handed off by the stopping IndexNodes.
+	IndexStagingPath = `index_staging`
 )
 
 const (
@@ -86,6 +89,8 @@ const (
 
 const (
 	CollectionTTLConfigKey = "collection.ttl.seconds"
+	// CollectionIndexEngineVersionKey pins the version of the engine building the indexes of the collection.
+	CollectionIndexEngineVersionKey = "collection.index.engine.version"
 )
 
 const (
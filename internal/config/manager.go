@@ -83,6 +83,7 @@ type Manager struct {
 	sources      map[string]Source
 	keySourceMap map[string]string // store the key to config source, example: key is A.B.C and source is file which means the A.B.C's value is from file
 	overlays     map[string]string // store the highest priority configs which modified at runtime
+	parent       *Manager          // the manager to fall back to for the keys of no source of its own, nil for the root
 }
 
 func NewManager() *Manager {
@@ -94,6 +95,16 @@ func NewManager() *Manager {
 	}
 }
 
+// Namespace returns a manager reading the configs of m. The configs set at runtime on the returned
+// manager are only visible to itself, so the components embedded in one process can't race on the
+// runtime configs of each other, while the configs set on m are still visible to it.
+func (m *Manager) Namespace() *Manager {
+	ns := NewManager()
+	ns.Dispatcher = m.Dispatcher
+	ns.parent = m
+	return ns
+}
+
 func (m *Manager) GetConfig(key string) (string, error) {
 	m.RLock()
 	defer m.RUnlock()
@@ -107,6 +118,9 @@ func (m *Manager) GetConfig(key string) (string, error) {
 	}
 	sourceName, ok := m.keySourceMap[realKey]
 	if !ok {
+		if m.parent != nil {
+			return m.parent.GetConfig(key)
+		}
 		return "", fmt.Errorf("key not found: %s", key)
 	}
 	return m.getConfigValueBySource(realKey, sourceName)
@@ -118,6 +132,15 @@ func (m *Manager) GetConfigs() map[string]string {
 	defer m.RUnlock()
 	config := make(map[string]string)
 
+	if m.parent != nil {
+		for key := range m.parent.GetConfigs() {
+			sValue, err := m.GetConfig(key)
+			if err != nil {
+				continue
+			}
+			config[key] = sValue
+		}
+	}
 	for key := range m.keySourceMap {
 		sValue, err := m.GetConfig(key)
 		if err != nil {
@@ -47,6 +47,38 @@ func TestAllDupliateSource(t *testing.T) {
 	assert.Error(t, err, "invalid source or source not added")
 }
 
+func TestManagerNamespace(t *testing.T) {
+	mgr := NewManager()
+	mgr.SetConfig("a.b", "1")
+	mgr.SetConfig("a.c", "2")
+
+	ns := mgr.Namespace()
+	v, err := ns.GetConfig("a.b")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", v)
+
+	ns.SetConfig("a.b", "3")
+	v, _ = ns.GetConfig("a.b")
+	assert.Equal(t, "3", v)
+	v, _ = mgr.GetConfig("a.b")
+	assert.Equal(t, "1", v)
+
+	ns.DeleteConfig("a.c")
+	_, err = ns.GetConfig("a.c")
+	assert.Error(t, err)
+	v, _ = mgr.GetConfig("a.c")
+	assert.Equal(t, "2", v)
+
+	mgr.SetConfig("a.d", "4")
+	v, _ = ns.GetConfig("a.d")
+	assert.Equal(t, "4", v)
+
+	ns.ResetConfig("a.b")
+	v, _ = ns.GetConfig("a.b")
+	assert.Equal(t, "1", v)
+	assert.Same(t, mgr.Dispatcher, ns.Dispatcher)
+}
+
 type ErrSource struct {
 }
 
@@ -14,7 +14,10 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+#include <chrono>
+#include <filesystem>
 #include <mutex>
+#include <string>
 
 #include "ConfigKnowhere.h"
 #include "exceptions/EasyAssert.h"
@@ -27,6 +30,42 @@ namespace milvus::config {
 
 std::once_flag init_knowhere_once_;
 
+// the rotated log backups older than it are removed, 0 keeps them all.
+int64_t log_max_age_seconds_ = 0;
+
+// RollOutLogFile is called by easylogging when the log file reaches the max size, after the file is closed
+// and before it's truncated. The file is kept as a backup named by the rotation time, and the backups older
+// than log_max_age_seconds_ are removed.
+void
+RollOutLogFile(const char* filename, std::size_t size, el::Level level) {
+    namespace fs = std::filesystem;
+    auto now = std::chrono::duration_cast<std::chrono::seconds>(
+                   std::chrono::system_clock::now().time_since_epoch())
+                   .count();
+    auto path = fs::path(filename);
+    std::error_code ec;
+    fs::rename(path, path.string() + "." + std::to_string(now), ec);
+    if (log_max_age_seconds_ <= 0) {
+        return;
+    }
+    auto prefix = path.filename().string() + ".";
+    auto dir = path.has_parent_path() ? path.parent_path() : fs::path(".");
+    for (const auto& entry : fs::directory_iterator(dir, ec)) {
+        auto name = entry.path().filename().string();
+        if (name.rfind(prefix, 0) != 0) {
+            continue;
+        }
+        try {
+            auto rotated = std::stoll(name.substr(prefix.size()));
+            if (now - rotated > log_max_age_seconds_) {
+                fs::remove(entry.path(), ec);
+            }
+        } catch (std::exception&) {
+            // not a backup of the rotation
+        }
+    }
+}
+
 void
 KnowhereInitImpl(const char* conf_file) {
     auto init = [&]() {
@@ -52,6 +91,35 @@ KnowhereInitImpl(const char* conf_file) {
     std::call_once(init_knowhere_once_, init);
 }
 
+void
+KnowhereInitLog(const char* conf, int64_t max_age_seconds) {
+#ifndef EMBEDDED_MILVUS
+    el::Configurations el_conf;
+    if (!el_conf.parseFromText(conf)) {
+        LOG_SERVER_ERROR_ << "invalid easylogging configurations: " << conf;
+        return;
+    }
+    log_max_age_seconds_ = max_age_seconds;
+    el::Loggers::addFlag(el::LoggingFlag::StrictLogFileSizeCheck);
+    el::Helpers::installPreRollOutCallback(RollOutLogFile);
+    el::Loggers::reconfigureAllLoggers(el_conf);
+#endif
+}
+
+void
+KnowhereSetLoggerConf(const char* logger_id, const char* conf) {
+#ifndef EMBEDDED_MILVUS
+    el::Configurations el_conf;
+    if (!el_conf.parseFromText(conf)) {
+        LOG_SERVER_ERROR_ << "invalid easylogging configurations of logger " << logger_id << ": " << conf;
+        return;
+    }
+    // the logger is registered if it hasn't logged yet.
+    el::Loggers::getLogger(logger_id);
+    el::Loggers::reconfigureLogger(logger_id, el_conf);
+#endif
+}
+
 std::string
 KnowhereSetSimdType(const char* value) {
     knowhere::KnowhereConfig::SimdType simd_type;
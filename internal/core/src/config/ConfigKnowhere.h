@@ -15,6 +15,7 @@
 // limitations under the License.
 
 #pragma once
+#include <cstdint>
 #include <string>
 
 namespace milvus::config {
@@ -22,6 +23,15 @@ namespace milvus::config {
 void
 KnowhereInitImpl(const char*);
 
+// KnowhereInitLog configures all the easylogging loggers with the configuration text, the rotated log
+// backups older than max_age_seconds are removed.
+void
+KnowhereInitLog(const char* conf, int64_t max_age_seconds);
+
+// KnowhereSetLoggerConf configures the easylogging logger of the id with the configuration text.
+void
+KnowhereSetLoggerConf(const char* logger_id, const char* conf);
+
 std::string
 KnowhereSetSimdType(const char*);
 
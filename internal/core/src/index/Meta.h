@@ -47,6 +47,8 @@ constexpr const char* FIELD_ID = "field_id";
 constexpr const char* INDEX_BUILD_ID = "index_build_id";
 constexpr const char* INDEX_ID = "index_id";
 constexpr const char* INDEX_VERSION = "index_version";
+// local root path of the index build files, the root path of the local chunk manager is used if it's absent
+constexpr const char* INDEX_LOCAL_ROOT_PATH = "index_local_root_path";
 
 // DiskAnn build params
 constexpr const char* DISK_ANN_RAW_DATA_PATH = "data_path";
@@ -188,6 +188,12 @@ GetIndexMetaFromConfig(const Config& config) {
     AssertInfo(build_id.has_value(), "build id not exist in index config");
     index_meta.build_id = std::stol(build_id.value());
 
+    // set local root path of the build files
+    auto local_root_path = index::GetValueFromConfig<std::string>(config, index::INDEX_LOCAL_ROOT_PATH);
+    if (local_root_path.has_value()) {
+        index_meta.local_root_path = local_root_path.value();
+    }
+
     return index_meta;
 }
 
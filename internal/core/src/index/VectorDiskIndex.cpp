@@ -65,9 +65,7 @@ void
 VectorDiskAnnIndex<T>::BuildWithDataset(const DatasetPtr& dataset, const Config& config) {
     auto& local_chunk_manager = storage::LocalChunkManager::GetInstance();
     auto build_config = parse_build_config(config);
-    auto segment_id = file_manager_->GetFileDataMeta().segment_id;
-    auto field_id = file_manager_->GetFileDataMeta().field_id;
-    auto local_data_path = storage::GenFieldRawDataPathPrefix(segment_id, field_id) + "raw_data";
+    auto local_data_path = file_manager_->GetLocalRawDataObjectPrefix() + "raw_data";
     build_config.data_path = local_data_path;
     if (!local_chunk_manager.Exist(local_data_path)) {
         local_chunk_manager.CreateFile(local_data_path);
@@ -91,7 +89,8 @@ VectorDiskAnnIndex<T>::BuildWithDataset(const DatasetPtr& dataset, const Config&
 
     index_->BuildAll(nullptr, cfg);
 
-    local_chunk_manager.RemoveDir(storage::GetSegmentRawDataPathPrefix(segment_id));
+    local_chunk_manager.RemoveDir(storage::GetSegmentRawDataPathPrefix(file_manager_->GetFileDataMeta().segment_id,
+                                                                       file_manager_->GetIndexMeta().local_root_path));
     // TODO ::
     // SetDim(index_->Dim());
 }
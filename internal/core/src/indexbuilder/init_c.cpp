@@ -19,6 +19,16 @@ IndexBuilderInit(const char* conf_file) {
 }
 
 // return value must be freed by the caller
+void
+IndexBuilderInitLog(const char* conf, int64_t max_age_seconds) {
+    milvus::config::KnowhereInitLog(conf, max_age_seconds);
+}
+
+void
+IndexBuilderSetLoggerConf(const char* logger_id, const char* conf) {
+    milvus::config::KnowhereSetLoggerConf(logger_id, conf);
+}
+
 char*
 IndexBuilderSetSimdType(const char* value) {
     auto real_type = milvus::config::KnowhereSetSimdType(value);
@@ -11,6 +11,8 @@
 
 #pragma once
 
+#include <stdint.h>
+
 #ifdef __cplusplus
 extern "C" {
 #endif
@@ -18,6 +20,12 @@ extern "C" {
 void
 IndexBuilderInit(const char*);
 
+void
+IndexBuilderInitLog(const char* conf, int64_t max_age_seconds);
+
+void
+IndexBuilderSetLoggerConf(const char* logger_id, const char* conf);
+
 // return value must be freed by the caller
 char*
 IndexBuilderSetSimdType(const char*);
@@ -236,12 +236,12 @@ DiskFileManagerImpl::GetRemoteIndexObjectPrefix() {
 
 std::string
 DiskFileManagerImpl::GetLocalIndexObjectPrefix() {
-    return GenLocalIndexPathPrefix(index_meta_.build_id, index_meta_.index_version);
+    return GenLocalIndexPathPrefix(index_meta_.build_id, index_meta_.index_version, index_meta_.local_root_path);
 }
 
 std::string
 DiskFileManagerImpl::GetLocalRawDataObjectPrefix() {
-    return GenFieldRawDataPathPrefix(field_meta_.segment_id, field_meta_.field_id);
+    return GenFieldRawDataPathPrefix(field_meta_.segment_id, field_meta_.field_id, index_meta_.local_root_path);
 }
 
 bool
@@ -77,6 +77,8 @@ struct IndexMeta {
     int64_t build_id;
     int64_t index_version;
     std::string key;
+    // local root path of the build files, empty means the root path of the local chunk manager
+    std::string local_root_path;
 };
 
 struct StorageConfig {
@@ -323,9 +323,10 @@ GetDimensionFromArrowArray(std::shared_ptr<arrow::Array> data, DataType data_typ
 }
 
 std::string
-GenLocalIndexPathPrefix(int64_t build_id, int64_t index_version) {
-    return milvus::ChunkMangerConfig::GetLocalRootPath() + "/" + std::string(INDEX_ROOT_PATH) + "/" +
-           std::to_string(build_id) + "/" + std::to_string(index_version) + "/";
+GenLocalIndexPathPrefix(int64_t build_id, int64_t index_version, const std::string& root_path) {
+    auto local_root_path = root_path.empty() ? milvus::ChunkMangerConfig::GetLocalRootPath() : root_path;
+    return local_root_path + "/" + std::string(INDEX_ROOT_PATH) + "/" + std::to_string(build_id) + "/" +
+           std::to_string(index_version) + "/";
 }
 
 std::string
@@ -335,15 +336,16 @@ GetLocalIndexPathPrefixWithBuildID(int64_t build_id) {
 }
 
 std::string
-GenFieldRawDataPathPrefix(int64_t segment_id, int64_t field_id) {
-    return milvus::ChunkMangerConfig::GetLocalRootPath() + "/" + std::string(RAWDATA_ROOT_PATH) + "/" +
-           std::to_string(segment_id) + "/" + std::to_string(field_id) + "/";
+GenFieldRawDataPathPrefix(int64_t segment_id, int64_t field_id, const std::string& root_path) {
+    auto local_root_path = root_path.empty() ? milvus::ChunkMangerConfig::GetLocalRootPath() : root_path;
+    return local_root_path + "/" + std::string(RAWDATA_ROOT_PATH) + "/" + std::to_string(segment_id) + "/" +
+           std::to_string(field_id) + "/";
 }
 
 std::string
-GetSegmentRawDataPathPrefix(int64_t segment_id) {
-    return milvus::ChunkMangerConfig::GetLocalRootPath() + "/" + std::string(RAWDATA_ROOT_PATH) + "/" +
-           std::to_string(segment_id);
+GetSegmentRawDataPathPrefix(int64_t segment_id, const std::string& root_path) {
+    auto local_root_path = root_path.empty() ? milvus::ChunkMangerConfig::GetLocalRootPath() : root_path;
+    return local_root_path + "/" + std::string(RAWDATA_ROOT_PATH) + "/" + std::to_string(segment_id);
 }
 
 std::vector<IndexType>
@@ -59,14 +59,15 @@ GetDimensionFromArrowArray(std::shared_ptr<arrow::Array> array, DataType data_ty
 std::string
 GetLocalIndexPathPrefixWithBuildID(int64_t build_id);
 
+// the local root path defaults to the root path of the local chunk manager if root_path is empty
 std::string
-GenLocalIndexPathPrefix(int64_t build_id, int64_t index_version);
+GenLocalIndexPathPrefix(int64_t build_id, int64_t index_version, const std::string& root_path = "");
 
 std::string
-GenFieldRawDataPathPrefix(int64_t segment_id, int64_t field_id);
+GenFieldRawDataPathPrefix(int64_t segment_id, int64_t field_id, const std::string& root_path = "");
 
 std::string
-GetSegmentRawDataPathPrefix(int64_t segment_id);
+GetSegmentRawDataPathPrefix(int64_t segment_id, const std::string& root_path = "");
 
 template <typename T>
 inline bool
@@ -19,6 +19,7 @@ package datacoord
 import (
 	"context"
 	"path"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -32,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/indexpointer"
 	"github.com/milvus-io/milvus/internal/util/metautil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -103,6 +105,7 @@ func (gc *garbageCollector) work() {
 			gc.recycleUnusedSegIndexes()
 			gc.scan()
 			gc.recycleUnusedIndexFiles()
+			gc.recycleStagedDatasets()
 		case <-gc.closeCh:
 			log.Warn("garbage collector quit")
 			return
@@ -375,6 +378,21 @@ func (gc *garbageCollector) recycleUnusedIndexFiles() {
 				segIdx.PartitionID, segIdx.SegmentID, fileID)
 			filesMap[filepath] = struct{}{}
 		}
+		// the index files staged by the in-place rebuilds are kept while the index pointer refers to them.
+		indexDir := metautil.BuildSegmentIndexDir(gc.option.cli.RootPath(), segIdx.BuildID, segIdx.IndexVersion,
+			segIdx.PartitionID, segIdx.SegmentID)
+		pointer, err := indexpointer.Read(ctx, gc.option.cli, indexDir)
+		if err != nil {
+			log.Warn("garbageCollector recycleUnusedIndexFiles read index pointer failed",
+				zap.Int64("buildID", buildID), zap.String("indexDir", indexDir), zap.Error(err))
+			continue
+		}
+		if pointer != nil {
+			filesMap[path.Join(indexDir, indexpointer.Key)] = struct{}{}
+			for _, filePath := range pointer.Paths(indexDir) {
+				filesMap[filePath] = struct{}{}
+			}
+		}
 		files, _, err := gc.option.cli.ListWithPrefix(ctx, key, true)
 		if err != nil {
 			log.Warn("garbageCollector recycleUnusedIndexFiles list files failed",
@@ -398,3 +416,41 @@ func (gc *garbageCollector) recycleUnusedIndexFiles() {
 			zap.Int("delete index files num", deletedFilesNum))
 	}
 }
+
+// recycleStagedDatasets removes the datasets staged by the stopping IndexNodes for the jobs which are no longer
+// in progress, e.g. the job is dropped or done by a node with handoff disabled, or its index is deleted.
+// A staged dataset is kept for the missing tolerance, as the meta may lag behind the node staging it.
+func (gc *garbageCollector) recycleStagedDatasets() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	prefix := path.Join(gc.option.cli.RootPath(), common.IndexStagingPath) + "/"
+	keys, modTimes, err := gc.option.cli.ListWithPrefix(ctx, prefix, true)
+	if err != nil {
+		log.Warn("garbageCollector recycleStagedDatasets list keys from chunk manager failed", zap.Error(err))
+		return
+	}
+	removed := 0
+	for i, key := range keys {
+		buildID, err := strconv.ParseInt(path.Base(key), 10, 64)
+		if err != nil {
+			log.Warn("garbageCollector recycleStagedDatasets parse build id failed", zap.String("key", key), zap.Error(err))
+			continue
+		}
+		if segIdx, ok := gc.meta.GetIndexJob(buildID); ok && !segIdx.IsDeleted &&
+			(segIdx.IndexState == commonpb.IndexState_Unissued || segIdx.IndexState == commonpb.IndexState_InProgress ||
+				segIdx.IndexState == commonpb.IndexState_Retry) {
+			continue
+		}
+		if i < len(modTimes) && time.Since(modTimes[i]) <= gc.option.missingTolerance {
+			continue
+		}
+		if err := gc.option.cli.Remove(ctx, key); err != nil {
+			log.Warn("garbageCollector recycleStagedDatasets remove staged dataset failed",
+				zap.Int64("buildID", buildID), zap.String("key", key), zap.Error(err))
+			continue
+		}
+		removed++
+	}
+	log.Info("recycle staged datasets", zap.Int("total", len(keys)), zap.Int("removed", removed))
+}
@@ -40,6 +40,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	kvmocks "github.com/milvus-io/milvus/internal/kv/mocks"
 	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
@@ -758,6 +759,48 @@ func TestGarbageCollector_recycleUnusedIndexFiles(t *testing.T) {
 	})
 }
 
+func TestGarbageCollector_recycleStagedDatasets(t *testing.T) {
+	prefix := path.Join("root", common.IndexStagingPath, "cluster")
+	keys := []string{path.Join(prefix, "1"), path.Join(prefix, "2"), path.Join(prefix, "3"), path.Join(prefix, "4"),
+		path.Join(prefix, "5"), path.Join(prefix, "bad")}
+	old := time.Now().Add(-time.Hour)
+	modTimes := []time.Time{old, old, old, time.Now(), old, old}
+	m := &meta{
+		buildID2SegmentIndex: map[UniqueID]*model.SegmentIndex{
+			1: {BuildID: 1, IndexState: commonpb.IndexState_InProgress},
+			2: {BuildID: 2, IndexState: commonpb.IndexState_Finished},
+			5: {BuildID: 5, IndexState: commonpb.IndexState_InProgress, IsDeleted: true},
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		cm := &mocks.ChunkManager{}
+		cm.EXPECT().RootPath().Return("root")
+		cm.EXPECT().ListWithPrefix(mock.Anything, path.Join("root", common.IndexStagingPath)+"/", true).Return(keys, modTimes, nil)
+		// the job in progress and the recently staged dataset are kept.
+		cm.EXPECT().Remove(mock.Anything, path.Join(prefix, "2")).Return(nil).Once()
+		cm.EXPECT().Remove(mock.Anything, path.Join(prefix, "3")).Return(nil).Once()
+		cm.EXPECT().Remove(mock.Anything, path.Join(prefix, "5")).Return(errors.New("error")).Once()
+		gc := &garbageCollector{
+			meta:   m,
+			option: GcOption{cli: cm, missingTolerance: time.Minute},
+		}
+		gc.recycleStagedDatasets()
+		cm.AssertExpectations(t)
+	})
+
+	t.Run("list fail", func(t *testing.T) {
+		cm := &mocks.ChunkManager{}
+		cm.EXPECT().RootPath().Return("root")
+		cm.EXPECT().ListWithPrefix(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil, errors.New("error"))
+		gc := &garbageCollector{
+			meta:   m,
+			option: GcOption{cli: cm},
+		}
+		gc.recycleStagedDatasets()
+	})
+}
+
 func TestGarbageCollector_clearETCD(t *testing.T) {
 	catalog := catalogmocks.NewDataCoordCatalog(t)
 	catalog.On("CreateSegmentIndex",
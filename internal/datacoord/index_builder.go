@@ -28,9 +28,11 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
 )
 
 type indexTaskState int32
@@ -80,12 +82,22 @@ type indexBuilder struct {
 	// TODO @xiaocai2333: use priority queue
 	tasks      map[int64]indexTaskState
 	notifyChan chan struct{}
+	// reported holds the results pushed by IndexNodes, they are consumed instead of polling QueryJobs.
+	reported map[int64]*reportedTaskResult
 
 	meta *meta
 
 	policy       buildIndexPolicy
 	nodeManager  *IndexNodeManager
 	chunkManager storage.ChunkManager
+	// binlogSourceOf returns the address of the DataNode which wrote the binlogs of the segment, the IndexNodes
+	// stream the binlogs still kept by it. Empty means unknown, nil if the source is not looked up.
+	binlogSourceOf func(segment *SegmentInfo) string
+}
+
+type reportedTaskResult struct {
+	nodeID UniqueID
+	info   *indexpb.IndexTaskInfo
 }
 
 func newIndexBuilder(ctx context.Context, metaTable *meta, nodeManager *IndexNodeManager, chunkManager storage.ChunkManager) *indexBuilder {
@@ -96,6 +108,7 @@ func newIndexBuilder(ctx context.Context, metaTable *meta, nodeManager *IndexNod
 		cancel:           cancel,
 		meta:             metaTable,
 		tasks:            make(map[int64]indexTaskState),
+		reported:         make(map[int64]*reportedTaskResult),
 		notifyChan:       make(chan struct{}, 1),
 		scheduleDuration: Params.DataCoordCfg.IndexTaskSchedulerInterval.GetAsDuration(time.Millisecond),
 		policy:           defaultBuildIndexPolicy,
@@ -208,6 +221,7 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 		ib.taskMutex.Lock()
 		defer ib.taskMutex.Unlock()
 		delete(ib.tasks, buildID)
+		delete(ib.reported, buildID)
 	}
 
 	meta, exist := ib.meta.GetIndexJob(buildID)
@@ -242,9 +256,24 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 			updateStateFunc(buildID, indexTaskDone)
 			return true
 		}
+		if Params.DataCoordCfg.PresignedURLEnable.GetAsBool() && getIndexType(indexParams) == indexparamcheck.IndexDISKANN {
+			// knowhere uploads the files of a disk index with the credentials of the storage config.
+			log.Ctx(ib.ctx).Warn("disk index can't be built with pre-signed urls", zap.Int64("buildID", buildID))
+			if err := ib.meta.FinishTask(&indexpb.IndexTaskInfo{
+				BuildID:    buildID,
+				State:      commonpb.IndexState_Failed,
+				FailReason: "DISKANN index can't be built with pre-signed urls",
+			}); err != nil {
+				log.Ctx(ib.ctx).Warn("IndexCoord update index state fail", zap.Int64("buildID", buildID), zap.Error(err))
+				return false
+			}
+			updateStateFunc(buildID, indexTaskDone)
+			return true
+		}
 		// peek client
 		// if all IndexNodes are executing task, wait for one of them to finish the task.
-		nodeID, client := ib.nodeManager.PeekClient(meta)
+		engineVersion := ib.getEngineVersion(meta.CollectionID)
+		nodeID, client := ib.nodeManager.PeekClient(meta, getIndexType(indexParams), engineVersion)
 		if client == nil {
 			log.Ctx(ib.ctx).RatedInfo(5, "index builder peek client error, there is no available")
 			return false
@@ -286,6 +315,9 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 				IAMEndpoint:     Params.MinioCfg.IAMEndpoint.GetValue(),
 				StorageType:     Params.CommonCfg.StorageType.GetValue(),
 			}
+			for zone, address := range Params.MinioCfg.ZoneEndpoints.GetAsJSONMap() {
+				storageConfig.ZoneEndpoints = append(storageConfig.ZoneEndpoints, &indexpb.ZoneEndpoint{Zone: zone, Address: address})
+			}
 		}
 		req := &indexpb.CreateJobRequest{
 			ClusterID:       Params.CommonCfg.ClusterPrefix.GetValue(),
@@ -297,6 +329,24 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 			IndexParams:     indexParams,
 			TypeParams:      typeParams,
 			NumRows:         meta.NumRows,
+			EngineVersion:   engineVersion,
+		}
+		if ib.binlogSourceOf != nil {
+			req.BinlogSource = ib.binlogSourceOf(segment)
+		}
+		if Params.DataCoordCfg.PresignedURLEnable.GetAsBool() {
+			if err := ib.presignJob(req, segment.GetPartitionID(), segment.GetID()); err != nil {
+				log.Ctx(ib.ctx).Warn("index builder pre-sign the urls of the index task failed", zap.Int64("buildID", buildID),
+					zap.Error(err))
+				updateStateFunc(buildID, indexTaskRetry)
+				return false
+			}
+		}
+		if err := ib.reserveSlots(client, req); err != nil {
+			log.Ctx(ib.ctx).Info("IndexNode refused to reserve a slot for the index task", zap.Int64("buildID", buildID),
+				zap.Int64("nodeID", nodeID), zap.Error(err))
+			updateStateFunc(buildID, indexTaskRetry)
+			return false
 		}
 		if err := ib.assignTask(client, req); err != nil {
 			// need to release lock then reassign, so set task state to retry
@@ -338,7 +388,88 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 	return true
 }
 
+// getEngineVersion returns the build engine version pinned by the collection, empty means the default engine of IndexNode.
+func (ib *indexBuilder) getEngineVersion(collectionID UniqueID) string {
+	collection := ib.meta.GetCollection(collectionID)
+	if collection == nil {
+		return ""
+	}
+	return collection.Properties[common.CollectionIndexEngineVersionKey]
+}
+
+// reportTaskResults records the results of finished tasks pushed by the IndexNode and triggers a schedule.
+// Results of tasks which are not in progress are ignored, so duplicated reports are harmless.
+func (ib *indexBuilder) reportTaskResults(nodeID UniqueID, infos []*indexpb.IndexTaskInfo) {
+	defer ib.notify()
+
+	ib.taskMutex.Lock()
+	defer ib.taskMutex.Unlock()
+	for _, info := range infos {
+		if info.GetState() != commonpb.IndexState_Finished && info.GetState() != commonpb.IndexState_Failed &&
+			info.GetState() != commonpb.IndexState_Retry {
+			continue
+		}
+		if state, ok := ib.tasks[info.GetBuildID()]; !ok || state != indexTaskInProgress {
+			continue
+		}
+		ib.reported[info.GetBuildID()] = &reportedTaskResult{
+			nodeID: nodeID,
+			info:   info,
+		}
+		log.Ctx(ib.ctx).Info("IndexNode reported task result", zap.Int64("buildID", info.GetBuildID()),
+			zap.Int64("nodeID", nodeID), zap.String("index state", info.GetState().String()))
+	}
+}
+
+// nodeJobs returns the build ids of the tasks in progress on the IndexNode.
+func (ib *indexBuilder) nodeJobs(nodeID UniqueID) []UniqueID {
+	metas := ib.meta.GetMetasByNodeID(nodeID)
+	ib.taskMutex.RLock()
+	defer ib.taskMutex.RUnlock()
+	buildIDs := make([]UniqueID, 0, len(metas))
+	for _, meta := range metas {
+		if ib.tasks[meta.BuildID] == indexTaskInProgress {
+			buildIDs = append(buildIDs, meta.BuildID)
+		}
+	}
+	return buildIDs
+}
+
+// popReportedResult returns the result reported by the IndexNode executing the task, if any.
+func (ib *indexBuilder) popReportedResult(buildID, nodeID UniqueID) *indexpb.IndexTaskInfo {
+	ib.taskMutex.Lock()
+	defer ib.taskMutex.Unlock()
+	result, ok := ib.reported[buildID]
+	if !ok {
+		return nil
+	}
+	delete(ib.reported, buildID)
+	if result.nodeID != nodeID {
+		return nil
+	}
+	return result.info
+}
+
+func (ib *indexBuilder) finishTask(info *indexpb.IndexTaskInfo) indexTaskState {
+	log.Ctx(ib.ctx).Info("this task has been finished", zap.Int64("buildID", info.BuildID),
+		zap.String("index state", info.State.String()))
+	if err := ib.meta.FinishTask(info); err != nil {
+		log.Ctx(ib.ctx).Warn("IndexCoord update index state fail", zap.Int64("buildID", info.BuildID),
+			zap.String("index state", info.State.String()), zap.Error(err))
+		return indexTaskInProgress
+	}
+	return indexTaskDone
+}
+
 func (ib *indexBuilder) getTaskState(buildID, nodeID UniqueID) indexTaskState {
+	if info := ib.popReportedResult(buildID, nodeID); info != nil {
+		if info.GetState() == commonpb.IndexState_Retry {
+			log.Ctx(ib.ctx).Info("this task should be retry, indexNode disowned it", zap.Int64("buildID", buildID),
+				zap.Int64("nodeID", nodeID), zap.String("fail reason", info.GetFailReason()))
+			return indexTaskRetry
+		}
+		return ib.finishTask(info)
+	}
 	client, exist := ib.nodeManager.GetClientByID(nodeID)
 	if exist {
 		ctx1, cancel := context.WithTimeout(ib.ctx, reqTimeoutInterval)
@@ -362,14 +493,7 @@ func (ib *indexBuilder) getTaskState(buildID, nodeID UniqueID) indexTaskState {
 		for _, info := range response.IndexInfos {
 			if info.BuildID == buildID {
 				if info.State == commonpb.IndexState_Failed || info.State == commonpb.IndexState_Finished {
-					log.Ctx(ib.ctx).Info("this task has been finished", zap.Int64("buildID", info.BuildID),
-						zap.String("index state", info.State.String()))
-					if err := ib.meta.FinishTask(info); err != nil {
-						log.Ctx(ib.ctx).Warn("IndexCoord update index state fail", zap.Int64("buildID", info.BuildID),
-							zap.String("index state", info.State.String()), zap.Error(err))
-						return indexTaskInProgress
-					}
-					return indexTaskDone
+					return ib.finishTask(info)
 				} else if info.State == commonpb.IndexState_Retry || info.State == commonpb.IndexState_IndexStateNone {
 					log.Ctx(ib.ctx).Info("this task should be retry", zap.Int64("buildID", buildID), zap.String("fail reason", info.FailReason))
 					return indexTaskRetry
@@ -415,6 +539,31 @@ func (ib *indexBuilder) dropIndexTask(buildID, nodeID UniqueID) bool {
 	return true
 }
 
+// reserveSlots reserves a build slot of IndexNode for the task and carries the reservation token in req.
+// The task is assigned without a reservation if IndexNode fails to serve ReserveSlots.
+func (ib *indexBuilder) reserveSlots(builderClient types.IndexNode, req *indexpb.CreateJobRequest) error {
+	ctx, cancel := context.WithTimeout(context.Background(), reqTimeoutInterval)
+	defer cancel()
+	resp, err := builderClient.ReserveSlots(ctx, &indexpb.ReserveSlotsRequest{
+		ClusterID:   req.GetClusterID(),
+		BuildID:     req.GetBuildID(),
+		NumRows:     req.GetNumRows(),
+		IndexParams: req.GetIndexParams(),
+		TypeParams:  req.GetTypeParams(),
+		Priority:    req.GetPriority(),
+	})
+	if err != nil || resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		log.Warn("IndexCoord reserve slots failed, assign the task without reservation",
+			zap.Int64("buildID", req.GetBuildID()), zap.Error(err), zap.String("reason", resp.GetStatus().GetReason()))
+		return nil
+	}
+	if !resp.GetAccepted() {
+		return errors.New(resp.GetReason())
+	}
+	req.ReservationToken = resp.GetToken()
+	return nil
+}
+
 // assignTask sends the index task to the IndexNode, it has a timeout interval, if the IndexNode doesn't respond within
 // the interval, it is considered that the task sending failed.
 func (ib *indexBuilder) assignTask(builderClient types.IndexNode, req *indexpb.CreateJobRequest) error {
@@ -433,6 +582,49 @@ func (ib *indexBuilder) assignTask(builderClient types.IndexNode, req *indexpb.C
 	return nil
 }
 
+// handoffJobs takes back the queued jobs of the stopping IndexNode and reassigns them at once,
+// instead of waiting for the node to build them before it stops.
+func (ib *indexBuilder) handoffJobs(nodeID UniqueID) {
+	client, exist := ib.nodeManager.GetClientByID(nodeID)
+	if !exist {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ib.ctx, reqTimeoutInterval)
+	defer cancel()
+	resp, err := client.HandoffJobs(ctx, &indexpb.HandoffJobsRequest{
+		ClusterID: Params.CommonCfg.ClusterPrefix.GetValue(),
+	})
+	if err != nil {
+		log.Ctx(ib.ctx).Warn("IndexCoord take back the queued jobs of IndexNode fail", zap.Int64("nodeID", nodeID), zap.Error(err))
+		return
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		log.Ctx(ib.ctx).Warn("IndexCoord take back the queued jobs of IndexNode fail", zap.Int64("nodeID", nodeID),
+			zap.String("fail reason", resp.GetStatus().GetReason()))
+		return
+	}
+	defer ib.notify()
+
+	metas := make([]*model.SegmentIndex, 0, len(resp.GetJobs()))
+	for _, job := range resp.GetJobs() {
+		if meta, ok := ib.meta.GetIndexJob(job.GetBuildID()); ok && meta.NodeID == nodeID {
+			metas = append(metas, meta)
+		}
+	}
+
+	ib.taskMutex.Lock()
+	defer ib.taskMutex.Unlock()
+	buildIDs := make([]UniqueID, 0, len(metas))
+	for _, meta := range metas {
+		if ib.tasks[meta.BuildID] == indexTaskInProgress {
+			ib.tasks[meta.BuildID] = indexTaskRetry
+			buildIDs = append(buildIDs, meta.BuildID)
+		}
+	}
+	log.Ctx(ib.ctx).Info("IndexCoord took back the queued jobs of the stopping IndexNode", zap.Int64("nodeID", nodeID),
+		zap.Int64s("buildIDs", buildIDs))
+}
+
 func (ib *indexBuilder) nodeDown(nodeID UniqueID) {
 	defer ib.notify()
 
@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/indexnode"
 	"github.com/milvus-io/milvus/internal/metastore"
 	catalogmocks "github.com/milvus-io/milvus/internal/metastore/mocks"
@@ -1035,3 +1036,121 @@ func TestIndexBuilder_Error(t *testing.T) {
 		assert.Equal(t, indexTaskRetry, state)
 	})
 }
+
+func TestIndexBuilder_ReportTaskResults(t *testing.T) {
+	Params.Init()
+
+	catalog := catalogmocks.NewDataCoordCatalog(t)
+	catalog.On("AlterSegmentIndexes",
+		mock.Anything,
+		mock.Anything,
+	).Return(nil)
+
+	ib := &indexBuilder{
+		ctx: context.Background(),
+		tasks: map[int64]indexTaskState{
+			buildID:     indexTaskInit,
+			buildID + 1: indexTaskInProgress,
+			buildID + 2: indexTaskInProgress,
+		},
+		reported:   make(map[int64]*reportedTaskResult),
+		notifyChan: make(chan struct{}, 1),
+		meta:       createMetaTable(catalog),
+		nodeManager: &IndexNodeManager{
+			ctx:         context.Background(),
+			nodeClients: map[UniqueID]types.IndexNode{},
+		},
+	}
+
+	ib.reportTaskResults(nodeID, []*indexpb.IndexTaskInfo{
+		{
+			BuildID:       buildID,
+			State:         commonpb.IndexState_Finished,
+			IndexFileKeys: []string{"file1"},
+		},
+		{
+			BuildID:       buildID + 1,
+			State:         commonpb.IndexState_Finished,
+			IndexFileKeys: []string{"file1"},
+		},
+		{
+			BuildID: buildID + 2,
+			State:   commonpb.IndexState_InProgress,
+		},
+	})
+	assert.Equal(t, 1, len(ib.reported))
+
+	t.Run("consume reported result", func(t *testing.T) {
+		ib.process(buildID + 1)
+		assert.Equal(t, indexTaskDone, ib.tasks[buildID+1])
+		assert.Equal(t, 0, len(ib.reported))
+		segIdx, ok := ib.meta.GetIndexJob(buildID + 1)
+		assert.True(t, ok)
+		assert.Equal(t, commonpb.IndexState_Finished, segIdx.IndexState)
+	})
+
+	t.Run("ignore result from another node", func(t *testing.T) {
+		ib.reportTaskResults(nodeID+1, []*indexpb.IndexTaskInfo{
+			{
+				BuildID: buildID + 2,
+				State:   commonpb.IndexState_Finished,
+			},
+		})
+		ib.process(buildID + 2)
+		assert.Equal(t, indexTaskRetry, ib.tasks[buildID+2])
+		assert.Equal(t, 0, len(ib.reported))
+	})
+}
+
+func TestIndexBuilder_GetEngineVersion(t *testing.T) {
+	ib := &indexBuilder{
+		meta: &meta{
+			collections: map[UniqueID]*collectionInfo{
+				collID: {
+					ID:         collID,
+					Properties: map[string]string{common.CollectionIndexEngineVersionKey: "knowhere-v2"},
+				},
+				collID + 1: {
+					ID: collID + 1,
+				},
+			},
+		},
+	}
+	assert.Equal(t, "knowhere-v2", ib.getEngineVersion(collID))
+	assert.Equal(t, "", ib.getEngineVersion(collID+1))
+	assert.Equal(t, "", ib.getEngineVersion(collID+2))
+}
+
+func TestIndexBuilder_HandoffJobs(t *testing.T) {
+	Params.Init()
+
+	catalog := catalogmocks.NewDataCoordCatalog(t)
+	in := indexnode.NewIndexNodeMock()
+	in.CallHandoffJobs = func(ctx context.Context, req *indexpb.HandoffJobsRequest) (*indexpb.HandoffJobsResponse, error) {
+		return &indexpb.HandoffJobsResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Jobs:   []*indexpb.CreateJobRequest{{BuildID: buildID}, {BuildID: buildID + 1}},
+		}, nil
+	}
+	ib := &indexBuilder{
+		ctx: context.Background(),
+		tasks: map[int64]indexTaskState{
+			buildID:     indexTaskInit,
+			buildID + 1: indexTaskInProgress,
+		},
+		notifyChan: make(chan struct{}, 1),
+		meta:       createMetaTable(catalog),
+		nodeManager: &IndexNodeManager{
+			ctx:         context.Background(),
+			nodeClients: map[UniqueID]types.IndexNode{nodeID: in},
+		},
+	}
+
+	ib.handoffJobs(nodeID + 1)
+	assert.Equal(t, indexTaskInProgress, ib.tasks[buildID+1])
+
+	ib.handoffJobs(nodeID)
+	assert.Equal(t, indexTaskInit, ib.tasks[buildID])
+	assert.Equal(t, indexTaskRetry, ib.tasks[buildID+1])
+	assert.Len(t, ib.notifyChan, 1)
+}
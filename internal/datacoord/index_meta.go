@@ -257,6 +257,8 @@ func (m *meta) GetIndexIDByName(collID int64, indexName string) map[int64]uint64
 type IndexState struct {
 	state      commonpb.IndexState
 	failReason string
+	// warnings are the warnings of the finished builds of the segment.
+	warnings []string
 }
 
 func (m *meta) GetSegmentIndexState(collID, segmentID UniqueID) IndexState {
@@ -283,6 +285,7 @@ func (m *meta) GetSegmentIndexState(collID, segmentID UniqueID) IndexState {
 						break
 					}
 					state.state = commonpb.IndexState_Finished
+					state.warnings = append(state.warnings, segIdx.Warnings...)
 					continue
 				}
 				state.state = commonpb.IndexState_Unissued
@@ -531,6 +534,10 @@ func (m *meta) FinishTask(taskInfo *indexpb.IndexTaskInfo) error {
 		segIdx.IndexFileKeys = common.CloneStringList(taskInfo.IndexFileKeys)
 		segIdx.FailReason = taskInfo.FailReason
 		segIdx.IndexSize = taskInfo.SerializedSize
+		segIdx.IndexFileSizes = append([]uint64(nil), taskInfo.IndexFileSizes...)
+		segIdx.IndexMemSize = taskInfo.MemSize
+		segIdx.Warnings = common.CloneStringList(taskInfo.Warnings)
+		segIdx.BruteForce = taskInfo.BruteForce
 		return m.alterSegmentIndexes([]*model.SegmentIndex{segIdx})
 	}
 
@@ -539,7 +546,9 @@ func (m *meta) FinishTask(taskInfo *indexpb.IndexTaskInfo) error {
 	}
 
 	log.Info("finish index task success", zap.Int64("buildID", taskInfo.BuildID),
-		zap.String("state", taskInfo.GetState().String()), zap.String("fail reason", taskInfo.GetFailReason()))
+		zap.String("state", taskInfo.GetState().String()), zap.String("fail reason", taskInfo.GetFailReason()),
+		zap.Bool("brute force", taskInfo.GetBruteForce()), zap.String("fail code", taskInfo.GetFailCode().String()),
+		zap.Strings("warnings", taskInfo.GetWarnings()))
 	m.updateIndexTasksMetrics()
 	return nil
 }
@@ -1122,6 +1122,17 @@ func TestMeta_FinishTask(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("brute force", func(t *testing.T) {
+		err := m.FinishTask(&indexpb.IndexTaskInfo{
+			BuildID:    buildID,
+			State:      commonpb.IndexState_Finished,
+			BruteForce: true,
+		})
+		assert.NoError(t, err)
+		assert.True(t, m.buildID2SegmentIndex[buildID].BruteForce)
+		assert.Empty(t, m.buildID2SegmentIndex[buildID].IndexFileKeys)
+	})
+
 	t.Run("fail", func(t *testing.T) {
 		m.catalog = &datacoord.Catalog{
 			MetaKv: &saveFailKV{},
@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/indexpointer"
 	"github.com/milvus-io/milvus/internal/util/metautil"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 )
@@ -306,6 +307,7 @@ func (s *Server) GetSegmentIndexState(ctx context.Context, req *indexpb.GetSegme
 			SegmentID:  segID,
 			State:      state.state,
 			FailReason: state.failReason,
+			Warnings:   state.warnings,
 		})
 	}
 	log.Info("GetSegmentIndexState successfully", zap.Int64("collectionID", req.GetCollectionID()),
@@ -431,6 +433,63 @@ func (s *Server) GetIndexBuildProgress(ctx context.Context, req *indexpb.GetInde
 	}, nil
 }
 
+// ReportJobResults receives the results of finished index jobs pushed by IndexNode.
+func (s *Server) ReportJobResults(ctx context.Context, req *indexpb.ReportJobResultsRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx)
+	log.Info("receive ReportJobResults request", zap.Int64("nodeID", req.GetNodeID()),
+		zap.Int("jobNum", len(req.GetIndexInfos())))
+	if s.isClosed() {
+		log.Warn(msgDataCoordIsUnhealthy(paramtable.GetNodeID()))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_DataCoordNA,
+			Reason:    msgDataCoordIsUnhealthy(paramtable.GetNodeID()),
+		}, nil
+	}
+	if req.GetClusterID() != Params.CommonCfg.ClusterPrefix.GetValue() {
+		log.Warn("ReportJobResults from another cluster, ignore it", zap.String("clusterID", req.GetClusterID()))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("cluster id mismatch, expected %s, actual %s", Params.CommonCfg.ClusterPrefix.GetValue(), req.GetClusterID()),
+		}, nil
+	}
+
+	s.indexBuilder.reportTaskResults(req.GetNodeID(), req.GetIndexInfos())
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// ListNodeJobs returns the index jobs in progress on the IndexNode, which it re-adopts or disowns at startup.
+func (s *Server) ListNodeJobs(ctx context.Context, req *indexpb.ListNodeJobsRequest) (*indexpb.ListNodeJobsResponse, error) {
+	log := log.Ctx(ctx)
+	log.Info("receive ListNodeJobs request", zap.Int64("nodeID", req.GetNodeID()))
+	if s.isClosed() {
+		log.Warn(msgDataCoordIsUnhealthy(paramtable.GetNodeID()))
+		return &indexpb.ListNodeJobsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_DataCoordNA,
+				Reason:    msgDataCoordIsUnhealthy(paramtable.GetNodeID()),
+			},
+		}, nil
+	}
+	if req.GetClusterID() != Params.CommonCfg.ClusterPrefix.GetValue() {
+		log.Warn("ListNodeJobs from another cluster, ignore it", zap.String("clusterID", req.GetClusterID()))
+		return &indexpb.ListNodeJobsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("cluster id mismatch, expected %s, actual %s", Params.CommonCfg.ClusterPrefix.GetValue(), req.GetClusterID()),
+			},
+		}, nil
+	}
+
+	return &indexpb.ListNodeJobsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		BuildIDs: s.indexBuilder.nodeJobs(req.GetNodeID()),
+	}, nil
+}
+
 // DescribeIndex describe the index info of the collection.
 func (s *Server) DescribeIndex(ctx context.Context, req *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
 	log := log.Ctx(ctx)
@@ -588,8 +647,17 @@ func (s *Server) GetIndexInfos(ctx context.Context, req *indexpb.GetIndexInfoReq
 			ret.SegmentInfo[segID].EnableIndex = true
 			for _, segIdx := range segIdxes {
 				if segIdx.IndexState == commonpb.IndexState_Finished {
-					indexFilePaths := metautil.BuildSegmentIndexFilePaths(s.meta.chunkManager.RootPath(), segIdx.BuildID, segIdx.IndexVersion,
-						segIdx.PartitionID, segIdx.SegmentID, segIdx.IndexFileKeys)
+					// the index files of an index rebuilt in place are resolved through its index pointer.
+					indexDir := metautil.BuildSegmentIndexDir(s.meta.chunkManager.RootPath(), segIdx.BuildID, segIdx.IndexVersion,
+						segIdx.PartitionID, segIdx.SegmentID)
+					indexFilePaths, err := indexpointer.ResolvePaths(ctx, s.meta.chunkManager, indexDir, segIdx.IndexFileKeys)
+					if err != nil {
+						log.Warn("resolve index file paths failed", zap.Int64("buildID", segIdx.BuildID), zap.Error(err))
+						errResp.Reason = err.Error()
+						return &indexpb.GetIndexInfoResponse{
+							Status: errResp,
+						}, nil
+					}
 					ret.SegmentInfo[segID].IndexInfos = append(ret.SegmentInfo[segID].IndexInfos,
 						&indexpb.IndexFilePathInfo{
 							SegmentID:      segID,
@@ -600,6 +668,8 @@ func (s *Server) GetIndexInfos(ctx context.Context, req *indexpb.GetIndexInfoReq
 							IndexParams:    s.meta.GetIndexParams(segIdx.CollectionID, segIdx.IndexID),
 							IndexFilePaths: indexFilePaths,
 							SerializedSize: segIdx.IndexSize,
+							IndexFileSizes: segIdx.IndexFileSizes,
+							MemSize:        segIdx.IndexMemSize,
 							IndexVersion:   segIdx.IndexVersion,
 							NumRows:        segIdx.NumRows,
 						})
@@ -1147,3 +1147,54 @@ func TestServer_GetIndexInfos(t *testing.T) {
 		assert.Equal(t, 1, len(resp.GetSegmentInfo()))
 	})
 }
+
+func TestServer_ReportJobResults(t *testing.T) {
+	var (
+		ctx = context.Background()
+		req = &indexpb.ReportJobResultsRequest{
+			ClusterID: Params.CommonCfg.ClusterPrefix.GetValue(),
+			NodeID:    nodeID,
+			IndexInfos: []*indexpb.IndexTaskInfo{
+				{
+					BuildID: buildID,
+					State:   commonpb.IndexState_Finished,
+				},
+			},
+		}
+	)
+
+	s := &Server{
+		indexBuilder: &indexBuilder{
+			ctx: ctx,
+			tasks: map[int64]indexTaskState{
+				buildID: indexTaskInProgress,
+			},
+			reported:   make(map[int64]*reportedTaskResult),
+			notifyChan: make(chan struct{}, 1),
+		},
+	}
+
+	t.Run("server not available", func(t *testing.T) {
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		resp, err := s.ReportJobResults(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_DataCoordNA, resp.GetErrorCode())
+	})
+
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+	t.Run("cluster id mismatch", func(t *testing.T) {
+		resp, err := s.ReportJobResults(ctx, &indexpb.ReportJobResultsRequest{
+			ClusterID: "another",
+			NodeID:    nodeID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+	})
+
+	t.Run("report success", func(t *testing.T) {
+		resp, err := s.ReportJobResults(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.Equal(t, 1, len(s.indexBuilder.reported))
+	})
+}
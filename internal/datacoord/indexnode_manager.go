@@ -18,6 +18,7 @@ package datacoord
 
 import (
 	"context"
+	"encoding/json"
 	"sync"
 
 	"go.uber.org/zap"
@@ -29,12 +30,15 @@ import (
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
 )
 
 // IndexNodeManager is used to manage the client of IndexNode.
 type IndexNodeManager struct {
 	nodeClients      map[UniqueID]types.IndexNode
 	stoppingNodes    map[UniqueID]struct{}
+	capabilities     map[UniqueID]*indexpb.NodeCapabilities
 	lock             sync.RWMutex
 	ctx              context.Context
 	indexNodeCreator indexNodeCreatorFunc
@@ -45,6 +49,7 @@ func NewNodeManager(ctx context.Context, indexNodeCreator indexNodeCreatorFunc)
 	return &IndexNodeManager{
 		nodeClients:      make(map[UniqueID]types.IndexNode),
 		stoppingNodes:    make(map[UniqueID]struct{}),
+		capabilities:     make(map[UniqueID]*indexpb.NodeCapabilities),
 		lock:             sync.RWMutex{},
 		ctx:              ctx,
 		indexNodeCreator: indexNodeCreator,
@@ -67,6 +72,7 @@ func (nm *IndexNodeManager) RemoveNode(nodeID UniqueID) {
 	defer nm.lock.Unlock()
 	delete(nm.nodeClients, nodeID)
 	delete(nm.stoppingNodes, nodeID)
+	delete(nm.capabilities, nodeID)
 	metrics.IndexNodeNum.WithLabelValues().Dec()
 }
 
@@ -77,6 +83,20 @@ func (nm *IndexNodeManager) StoppingNode(nodeID UniqueID) {
 	nm.stoppingNodes[nodeID] = struct{}{}
 }
 
+// parseNodeCapabilities parses the capabilities advertised in the session of IndexNode.
+func parseNodeCapabilities(session *sessionutil.Session) *indexpb.NodeCapabilities {
+	value, ok := session.Metadata[sessionutil.CapabilitiesMetadataKey]
+	if !ok {
+		return nil
+	}
+	capabilities := &indexpb.NodeCapabilities{}
+	if err := json.Unmarshal([]byte(value), capabilities); err != nil {
+		log.Warn("parse IndexNode capabilities failed", zap.Int64("nodeID", session.ServerID), zap.Error(err))
+		return nil
+	}
+	return capabilities
+}
+
 // AddNode adds the client of IndexNode.
 func (nm *IndexNodeManager) AddNode(nodeID UniqueID, address string) error {
 	log.Debug("add IndexNode", zap.Any("nodeID", nodeID), zap.Any("node address", address))
@@ -96,18 +116,55 @@ func (nm *IndexNodeManager) AddNode(nodeID UniqueID, address string) error {
 	return nil
 }
 
-// PeekClient peeks the client with the least load.
-func (nm *IndexNodeManager) PeekClient(meta *model.SegmentIndex) (UniqueID, types.IndexNode) {
+// SetCapabilities sets the capabilities advertised by IndexNode, nil means unknown.
+func (nm *IndexNodeManager) SetCapabilities(nodeID UniqueID, capabilities *indexpb.NodeCapabilities) {
+	nm.lock.Lock()
+	defer nm.lock.Unlock()
+	if capabilities == nil {
+		delete(nm.capabilities, nodeID)
+		return
+	}
+	nm.capabilities[nodeID] = capabilities
+}
+
+// canExecute checks whether the IndexNode is able to build the index type with the engine version,
+// nodes which do not advertise capabilities are treated as capable.
+func (nm *IndexNodeManager) canExecute(nodeID UniqueID, indexType string, engineVersion string) bool {
+	nm.lock.RLock()
+	defer nm.lock.RUnlock()
+	capabilities, ok := nm.capabilities[nodeID]
+	if !ok {
+		return true
+	}
+	if indexType != "" && !funcutil.SliceContain(capabilities.GetIndexTypes(), indexType) {
+		return false
+	}
+	return engineVersion == "" || funcutil.SliceContain(capabilities.GetEngineVersions(), engineVersion)
+}
+
+// PeekClient peeks the client with the least load among the IndexNodes able to build the index type with the engine version.
+// It returns -1 and a nil client if no IndexNode is usable.
+func (nm *IndexNodeManager) PeekClient(meta *model.SegmentIndex, indexType string, engineVersion string) (UniqueID, types.IndexNode) {
 	allClients := nm.GetAllClients()
 	if len(allClients) == 0 {
 		log.Error("there is no IndexNode online")
 		return -1, nil
 	}
+	for nodeID := range allClients {
+		if !nm.canExecute(nodeID, indexType, engineVersion) {
+			delete(allClients, nodeID)
+		}
+	}
+	if len(allClients) == 0 {
+		log.RatedWarn(5, "there is no IndexNode able to build the index", zap.String("indexType", indexType),
+			zap.String("engineVersion", engineVersion))
+		return -1, nil
+	}
 
 	// Note: In order to quickly end other goroutines, an error is returned when the client is successfully selected
 	ctx, cancel := context.WithCancel(nm.ctx)
 	var (
-		peekNodeID = UniqueID(0)
+		peekNodeID = UniqueID(-1)
 		nodeMutex  = sync.Mutex{}
 		wg         = sync.WaitGroup{}
 	)
@@ -132,7 +189,7 @@ func (nm *IndexNodeManager) PeekClient(meta *model.SegmentIndex) (UniqueID, type
 				nodeMutex.Lock()
 				defer nodeMutex.Unlock()
 				log.Info("peek client success", zap.Int64("nodeID", nodeID))
-				if peekNodeID == 0 {
+				if peekNodeID == -1 {
 					peekNodeID = nodeID
 				}
 				cancel()
@@ -143,13 +200,13 @@ func (nm *IndexNodeManager) PeekClient(meta *model.SegmentIndex) (UniqueID, type
 	}
 	wg.Wait()
 	cancel()
-	if peekNodeID != 0 {
+	if peekNodeID != -1 {
 		log.Info("peek client success", zap.Int64("nodeID", peekNodeID))
 		return peekNodeID, allClients[peekNodeID]
 	}
 
 	log.RatedDebug(5, "peek client fail")
-	return 0, nil
+	return -1, nil
 }
 
 func (nm *IndexNodeManager) ClientSupportDisk() bool {
@@ -27,12 +27,13 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestIndexNodeManager_AddNode(t *testing.T) {
 	nm := NewNodeManager(context.Background(), defaultIndexNodeCreatorFunc)
-	nodeID, client := nm.PeekClient(&model.SegmentIndex{})
+	nodeID, client := nm.PeekClient(&model.SegmentIndex{}, "", "")
 	assert.Equal(t, int64(-1), nodeID)
 	assert.Nil(t, client)
 
@@ -143,10 +144,35 @@ func TestIndexNodeManager_PeekClient(t *testing.T) {
 			},
 		}
 
-		nodeID, client := nm.PeekClient(&model.SegmentIndex{})
+		nodeID, client := nm.PeekClient(&model.SegmentIndex{}, "", "")
 		assert.NotNil(t, client)
 		assert.Contains(t, []UniqueID{8, 9}, nodeID)
 	})
+	t.Run("node id 0", func(t *testing.T) {
+		slots := int64(0)
+		nm := &IndexNodeManager{
+			ctx: context.TODO(),
+			nodeClients: map[UniqueID]types.IndexNode{
+				0: &indexnode.Mock{
+					CallGetJobStats: func(ctx context.Context, req *indexpb.GetJobStatsRequest) (*indexpb.GetJobStatsResponse, error) {
+						return &indexpb.GetJobStatsResponse{
+							TaskSlots: slots,
+							Status:    &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+						}, nil
+					},
+				},
+			},
+		}
+
+		nodeID, client := nm.PeekClient(&model.SegmentIndex{}, "", "")
+		assert.Nil(t, client)
+		assert.Equal(t, UniqueID(-1), nodeID)
+
+		slots = 1
+		nodeID, client = nm.PeekClient(&model.SegmentIndex{}, "", "")
+		assert.NotNil(t, client)
+		assert.Equal(t, UniqueID(0), nodeID)
+	})
 }
 
 func TestIndexNodeManager_ClientSupportDisk(t *testing.T) {
@@ -268,3 +294,45 @@ func TestNodeManager_StoppingNode(t *testing.T) {
 	assert.Equal(t, 0, len(nm.GetAllClients()))
 	assert.Equal(t, 0, len(nm.stoppingNodes))
 }
+
+func TestNodeManager_Capabilities(t *testing.T) {
+	session := &sessionutil.Session{ServerID: 1}
+	assert.Nil(t, parseNodeCapabilities(session))
+	session.Metadata = map[string]string{sessionutil.CapabilitiesMetadataKey: "garbage"}
+	assert.Nil(t, parseNodeCapabilities(session))
+	session.Metadata[sessionutil.CapabilitiesMetadataKey] = `{"index_types":["HNSW"]}`
+	capabilities := parseNodeCapabilities(session)
+	assert.Equal(t, []string{"HNSW"}, capabilities.GetIndexTypes())
+
+	nm := NewNodeManager(context.Background(), defaultIndexNodeCreatorFunc)
+	nm.setClient(1, &indexnode.Mock{
+		CallGetJobStats: func(ctx context.Context, req *indexpb.GetJobStatsRequest) (*indexpb.GetJobStatsResponse, error) {
+			return &indexpb.GetJobStatsResponse{
+				TaskSlots: 1,
+				Status:    &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			}, nil
+		},
+	})
+	// nodes without capabilities are able to build any index
+	assert.True(t, nm.canExecute(1, "DISKANN", ""))
+
+	nm.SetCapabilities(1, capabilities)
+	assert.True(t, nm.canExecute(1, "HNSW", ""))
+	assert.False(t, nm.canExecute(1, "DISKANN", ""))
+
+	nodeID, client := nm.PeekClient(&model.SegmentIndex{}, "HNSW", "")
+	assert.NotNil(t, client)
+	assert.Equal(t, UniqueID(1), nodeID)
+	nodeID, client = nm.PeekClient(&model.SegmentIndex{}, "DISKANN", "")
+	assert.Nil(t, client)
+	assert.Equal(t, UniqueID(-1), nodeID)
+
+	capabilities.EngineVersions = []string{"knowhere"}
+	assert.True(t, nm.canExecute(1, "HNSW", "knowhere"))
+	assert.False(t, nm.canExecute(1, "HNSW", "knowhere-v2"))
+	_, client = nm.PeekClient(&model.SegmentIndex{}, "HNSW", "knowhere-v2")
+	assert.Nil(t, client)
+
+	nm.RemoveNode(1)
+	assert.Equal(t, 0, len(nm.capabilities))
+}
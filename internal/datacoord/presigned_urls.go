@@ -0,0 +1,70 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/metautil"
+)
+
+// objectPresigner pre-signs the access to the objects of the chunk manager of DataCoord, the minio chunk
+// manager implements it.
+type objectPresigner interface {
+	PresignGet(ctx context.Context, filePath string, expiry time.Duration) (string, error)
+	PresignUpload(ctx context.Context, prefix string, expiry time.Duration) (string, map[string]string, error)
+}
+
+// presignJob replaces the credentials in the storage config of the job with the pre-signed GET urls of its
+// binlogs and an upload grant of its index directory. The keys of the index files saved by the job are the
+// directory joined with the file keys reported by QueryJobs, as for the other jobs.
+func (ib *indexBuilder) presignJob(req *indexpb.CreateJobRequest, partitionID, segmentID UniqueID) error {
+	presigner, ok := ib.chunkManager.(objectPresigner)
+	if !ok {
+		return fmt.Errorf("%s storage can't pre-sign urls", Params.CommonCfg.StorageType.GetValue())
+	}
+	expiry := Params.DataCoordCfg.PresignedURLExpiry.GetAsDuration(time.Second)
+	getURLs := make(map[string]string, len(req.GetDataPaths()))
+	for _, dataPath := range req.GetDataPaths() {
+		url, err := presigner.PresignGet(ib.ctx, dataPath, expiry)
+		if err != nil {
+			return err
+		}
+		getURLs[dataPath] = url
+	}
+	prefix := metautil.BuildSegmentIndexDir(ib.chunkManager.RootPath(), req.GetBuildID(), req.GetIndexVersion(),
+		partitionID, segmentID) + "/"
+	url, formData, err := presigner.PresignUpload(ib.ctx, prefix, expiry)
+	if err != nil {
+		return err
+	}
+	req.PresignedGetUrls = getURLs
+	req.PresignedUpload = &indexpb.PresignedUpload{
+		Url:       url,
+		FormData:  formData,
+		KeyPrefix: prefix,
+	}
+	req.StorageConfig = &indexpb.StorageConfig{
+		BucketName:  req.GetStorageConfig().GetBucketName(),
+		RootPath:    ib.chunkManager.RootPath(),
+		StorageType: req.GetStorageConfig().GetStorageType(),
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+)
+
+// presigningChunkManager signs the urls with the path and the expiry.
+type presigningChunkManager struct {
+	*mocks.ChunkManager
+	err error
+}
+
+func (cm *presigningChunkManager) PresignGet(ctx context.Context, filePath string, expiry time.Duration) (string, error) {
+	return "http://minio/" + filePath + "?expiry=" + expiry.String(), cm.err
+}
+
+func (cm *presigningChunkManager) PresignUpload(ctx context.Context, prefix string, expiry time.Duration) (string, map[string]string, error) {
+	return "http://minio/bucket", map[string]string{"key": prefix, "policy": expiry.String()}, cm.err
+}
+
+func TestPresignJob(t *testing.T) {
+	newReq := func() *indexpb.CreateJobRequest {
+		return &indexpb.CreateJobRequest{
+			BuildID:      buildID,
+			IndexVersion: 2,
+			DataPaths:    []string{"root/insert_log/1", "root/insert_log/2"},
+			StorageConfig: &indexpb.StorageConfig{
+				AccessKeyID:     "ak",
+				SecretAccessKey: "sk",
+				BucketName:      "bucket",
+				RootPath:        "root",
+				StorageType:     "minio",
+			},
+		}
+	}
+	chunkManager := &mocks.ChunkManager{}
+	chunkManager.EXPECT().RootPath().Return("root")
+
+	t.Run("unsupported storage", func(t *testing.T) {
+		ib := &indexBuilder{ctx: context.Background(), chunkManager: chunkManager}
+		assert.Error(t, ib.presignJob(newReq(), partID, segID))
+	})
+
+	t.Run("presign", func(t *testing.T) {
+		ib := &indexBuilder{ctx: context.Background(), chunkManager: &presigningChunkManager{ChunkManager: chunkManager}}
+		req := newReq()
+		require.NoError(t, ib.presignJob(req, partID, segID))
+		assert.Equal(t, map[string]string{
+			"root/insert_log/1": "http://minio/root/insert_log/1?expiry=1h0m0s",
+			"root/insert_log/2": "http://minio/root/insert_log/2?expiry=1h0m0s",
+		}, req.GetPresignedGetUrls())
+		assert.Equal(t, "http://minio/bucket", req.GetPresignedUpload().GetUrl())
+		assert.Equal(t, "root/index_files/600/2/200/500/", req.GetPresignedUpload().GetKeyPrefix())
+		assert.Equal(t, "1h0m0s", req.GetPresignedUpload().GetFormData()["policy"])
+		// the credentials never reach IndexNode.
+		assert.Empty(t, req.GetStorageConfig().GetAccessKeyID())
+		assert.Empty(t, req.GetStorageConfig().GetSecretAccessKey())
+		assert.Equal(t, "root", req.GetStorageConfig().GetRootPath())
+		assert.Equal(t, "minio", req.GetStorageConfig().GetStorageType())
+	})
+
+	t.Run("presign failed", func(t *testing.T) {
+		ib := &indexBuilder{ctx: context.Background(), chunkManager: &presigningChunkManager{
+			ChunkManager: chunkManager,
+			err:          errors.New("anonymous credentials"),
+		}}
+		req := newReq()
+		assert.Error(t, ib.presignJob(req, partID, segID))
+		assert.Equal(t, "sk", req.GetStorageConfig().GetSecretAccessKey())
+		assert.Nil(t, req.GetPresignedUpload())
+	})
+}
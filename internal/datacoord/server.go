@@ -490,6 +490,7 @@ func (s *Server) initServiceDiscovery() error {
 			if err := s.indexNodeManager.AddNode(session.ServerID, session.Address); err != nil {
 				return err
 			}
+			s.indexNodeManager.SetCapabilities(session.ServerID, parseNodeCapabilities(session))
 		}
 	}
 	s.inEventCh = s.session.WatchServices(typeutil.IndexNodeRole, inRevision+1, nil)
@@ -525,9 +526,24 @@ func (s *Server) initMeta(chunkManager storage.ChunkManager) error {
 func (s *Server) initIndexBuilder(manager storage.ChunkManager) {
 	if s.indexBuilder == nil {
 		s.indexBuilder = newIndexBuilder(s.ctx, s.meta, s.indexNodeManager, manager)
+		s.indexBuilder.binlogSourceOf = s.binlogSourceOf
 	}
 }
 
+// binlogSourceOf returns the address of the DataNode watching the channel of the segment, it's the one which
+// flushed or compacted the binlogs of the segment.
+func (s *Server) binlogSourceOf(segment *SegmentInfo) string {
+	if s.channelManager == nil || s.sessionManager == nil {
+		return ""
+	}
+	nodeID, err := s.channelManager.FindWatcher(segment.GetInsertChannel())
+	if err != nil {
+		return ""
+	}
+	addr, _ := s.sessionManager.getAddress(nodeID)
+	return addr
+}
+
 func (s *Server) initIndexNodeManager() {
 	if s.indexNodeManager == nil {
 		s.indexNodeManager = NewNodeManager(s.ctx, s.indexNodeCreator)
@@ -796,7 +812,10 @@ func (s *Server) handleSessionEvent(ctx context.Context, role string, event *ses
 			log.Info("received indexnode register",
 				zap.String("address", event.Session.Address),
 				zap.Int64("serverID", event.Session.ServerID))
-			return s.indexNodeManager.AddNode(event.Session.ServerID, event.Session.Address)
+			if err := s.indexNodeManager.AddNode(event.Session.ServerID, event.Session.Address); err != nil {
+				return err
+			}
+			s.indexNodeManager.SetCapabilities(event.Session.ServerID, parseNodeCapabilities(event.Session))
 		case sessionutil.SessionDelEvent:
 			log.Info("received indexnode unregister",
 				zap.String("address", event.Session.Address),
@@ -806,6 +825,9 @@ func (s *Server) handleSessionEvent(ctx context.Context, role string, event *ses
 			serverID := event.Session.ServerID
 			log.Info("received indexnode SessionUpdateEvent", zap.Int64("serverID", serverID))
 			s.indexNodeManager.StoppingNode(serverID)
+			if s.indexBuilder != nil {
+				go s.indexBuilder.handoffJobs(serverID)
+			}
 		default:
 			log.Warn("receive unknown service event type",
 				zap.Any("type", event.EventType))
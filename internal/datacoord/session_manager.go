@@ -296,6 +296,18 @@ func (c *SessionManager) getClient(ctx context.Context, nodeID int64) (types.Dat
 	return session.GetOrCreateClient(ctx)
 }
 
+// getAddress returns the address of the DataNode of nodeID.
+func (c *SessionManager) getAddress(nodeID int64) (string, bool) {
+	c.sessions.RLock()
+	defer c.sessions.RUnlock()
+
+	session, ok := c.sessions.data[nodeID]
+	if !ok {
+		return "", false
+	}
+	return session.info.Address, true
+}
+
 // Close release sessions
 func (c *SessionManager) Close() {
 	c.sessions.Lock()
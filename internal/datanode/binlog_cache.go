@@ -0,0 +1,100 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/cache"
+)
+
+// binlogCache keeps the insert binlogs written by the flushes and the compactions for a while, so the IndexNodes
+// building the indexes of the new segments read them by ReadBinlogs instead of the object storage.
+type binlogCache struct {
+	binlogs cache.Cache[string, []byte]
+}
+
+// newBinlogCache returns nil if dataNode.binlogCache.enable is off.
+func newBinlogCache() *binlogCache {
+	if !Params.DataNodeCfg.BinlogCacheEnable.GetAsBool() {
+		return nil
+	}
+	return &binlogCache{
+		binlogs: cache.NewCache[string, []byte](
+			cache.WithMaximumSize[string, []byte](Params.DataNodeCfg.BinlogCacheCapacity.GetAsInt64()),
+			cache.WithExpireAfterWrite[string, []byte](Params.DataNodeCfg.BinlogCacheTTL.GetAsDuration(time.Second))),
+	}
+}
+
+func (c *binlogCache) put(key string, value []byte) {
+	c.binlogs.Put(key, value)
+}
+
+func (c *binlogCache) get(key string) ([]byte, bool) {
+	return c.binlogs.GetIfPresent(key)
+}
+
+func (c *binlogCache) close() {
+	c.binlogs.Close()
+}
+
+// binlogCachingChunkManager keeps the insert binlogs written through it in the binlogCache.
+type binlogCachingChunkManager struct {
+	storage.ChunkManager
+	cache *binlogCache
+	// insertLogPrefix is the prefix of the insert binlog paths.
+	insertLogPrefix string
+}
+
+func newBinlogCachingChunkManager(cm storage.ChunkManager, binlogs *binlogCache) *binlogCachingChunkManager {
+	return &binlogCachingChunkManager{
+		ChunkManager:    cm,
+		cache:           binlogs,
+		insertLogPrefix: path.Join(cm.RootPath(), common.SegmentInsertLogPath) + "/",
+	}
+}
+
+func (cm *binlogCachingChunkManager) remember(filePath string, content []byte) {
+	if strings.HasPrefix(filePath, cm.insertLogPrefix) {
+		cm.cache.put(filePath, content)
+	}
+}
+
+// Write writes the file and keeps it if it's an insert binlog.
+func (cm *binlogCachingChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	if err := cm.ChunkManager.Write(ctx, filePath, content); err != nil {
+		return err
+	}
+	cm.remember(filePath, content)
+	return nil
+}
+
+// MultiWrite writes the files and keeps the insert binlogs of them.
+func (cm *binlogCachingChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
+	if err := cm.ChunkManager.MultiWrite(ctx, contents); err != nil {
+		return err
+	}
+	for filePath, content := range contents {
+		cm.remember(filePath, content)
+	}
+	return nil
+}
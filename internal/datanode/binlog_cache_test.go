@@ -0,0 +1,109 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+var binlogCacheTestDir = "/tmp/milvus_test/test_binlog_cache"
+
+type mockReadBinlogsServer struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*datapb.ReadBinlogsResponse
+}
+
+func (s *mockReadBinlogsServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockReadBinlogsServer) Send(resp *datapb.ReadBinlogsResponse) error {
+	s.responses = append(s.responses, resp)
+	return nil
+}
+
+func TestBinlogCache(t *testing.T) {
+	ctx := context.Background()
+	paramtable.Get().Save(Params.DataNodeCfg.BinlogCacheEnable.Key, "false")
+	assert.Nil(t, newBinlogCache())
+
+	paramtable.Get().Save(Params.DataNodeCfg.BinlogCacheEnable.Key, "true")
+	defer paramtable.Get().Reset(Params.DataNodeCfg.BinlogCacheEnable.Key)
+	binlogs := newBinlogCache()
+	require.NotNil(t, binlogs)
+	defer binlogs.close()
+
+	local := storage.NewLocalChunkManager(storage.RootPath(binlogCacheTestDir))
+	defer local.RemoveWithPrefix(ctx, local.RootPath())
+	cm := newBinlogCachingChunkManager(local, binlogs)
+
+	insertLog := path.Join(local.RootPath(), common.SegmentInsertLogPath, "1/2/3/100/1")
+	statsLog := path.Join(local.RootPath(), common.SegmentStatslogPath, "1/2/3/100/2")
+	compactedLog := path.Join(local.RootPath(), common.SegmentInsertLogPath, "1/2/4/100/3")
+	require.NoError(t, cm.MultiWrite(ctx, map[string][]byte{
+		insertLog: []byte("insert"),
+		statsLog:  []byte("stats"),
+	}))
+	require.NoError(t, cm.Write(ctx, compactedLog, []byte("compacted")))
+
+	value, ok := binlogs.get(insertLog)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("insert"), value)
+	_, ok = binlogs.get(statsLog)
+	assert.False(t, ok)
+	value, ok = binlogs.get(compactedLog)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("compacted"), value)
+
+	written, err := local.Read(ctx, statsLog)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("stats"), written)
+
+	t.Run("read binlogs", func(t *testing.T) {
+		node := &DataNode{binlogCache: binlogs}
+		node.UpdateStateCode(commonpb.StateCode_Healthy)
+		stream := &mockReadBinlogsServer{ctx: ctx}
+		err := node.ReadBinlogs(&datapb.ReadBinlogsRequest{SegmentID: 3, Paths: []string{insertLog, statsLog}}, stream)
+		assert.NoError(t, err)
+		require.Len(t, stream.responses, 1)
+		assert.Equal(t, insertLog, stream.responses[0].GetPath())
+		assert.Equal(t, []byte("insert"), stream.responses[0].GetValue())
+	})
+
+	t.Run("unhealthy", func(t *testing.T) {
+		node := &DataNode{binlogCache: binlogs}
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		stream := &mockReadBinlogsServer{ctx: ctx}
+		err := node.ReadBinlogs(&datapb.ReadBinlogsRequest{SegmentID: 3, Paths: []string{insertLog}}, stream)
+		assert.NoError(t, err)
+		require.Len(t, stream.responses, 1)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, stream.responses[0].GetStatus().GetErrorCode())
+	})
+}
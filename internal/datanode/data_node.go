@@ -100,6 +100,7 @@ var rateCol *rateCollector
 //
 //	`clearSignal` is a signal channel for releasing the flowgraph resources.
 //	`segmentCache` stores all flushing and flushed segments.
+//	`binlogCache` keeps the insert binlogs written recently for ReadBinlogs, it's nil if disabled.
 type DataNode struct {
 	ctx              context.Context
 	cancel           context.CancelFunc
@@ -123,6 +124,7 @@ type DataNode struct {
 	session        *sessionutil.Session
 	watchKv        kv.MetaKv
 	chunkManager   storage.ChunkManager
+	binlogCache    *binlogCache
 	rowIDAllocator *allocator2.IDAllocator
 
 	closer io.Closer
@@ -513,6 +515,9 @@ func (node *DataNode) Start() error {
 	}
 
 	node.chunkManager = chunkManager
+	if node.binlogCache = newBinlogCache(); node.binlogCache != nil {
+		node.chunkManager = newBinlogCachingChunkManager(chunkManager, node.binlogCache)
+	}
 
 	go node.BackGroundGC(node.clearSignal)
 
@@ -560,6 +565,10 @@ func (node *DataNode) Stop() error {
 		node.rowIDAllocator.Close()
 	}
 
+	if node.binlogCache != nil {
+		node.binlogCache.close()
+	}
+
 	if node.closer != nil {
 		err := node.closer.Close()
 		if err != nil {
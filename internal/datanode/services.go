@@ -588,6 +588,40 @@ func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest)
 	return resp, nil
 }
 
+// ReadBinlogs streams the insert binlogs of the request kept by dataNode.binlogCache, one binlog a message.
+// The binlogs not kept are skipped, the client reads them from the object storage instead.
+func (node *DataNode) ReadBinlogs(req *datapb.ReadBinlogsRequest, stream datapb.DataNode_ReadBinlogsServer) error {
+	if !node.isHealthy() {
+		return stream.Send(&datapb.ReadBinlogsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgDataNodeIsUnhealthy(paramtable.GetNodeID()),
+			},
+		})
+	}
+	if node.binlogCache == nil {
+		return nil
+	}
+	sent := 0
+	for _, binlogPath := range req.GetPaths() {
+		value, ok := node.binlogCache.get(binlogPath)
+		if !ok {
+			continue
+		}
+		if err := stream.Send(&datapb.ReadBinlogsResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Path:   binlogPath,
+			Value:  value,
+		}); err != nil {
+			return err
+		}
+		sent++
+	}
+	log.Ctx(stream.Context()).Debug("DataNode streamed the binlogs kept", zap.Int64("segmentID", req.GetSegmentID()),
+		zap.Int("requested", len(req.GetPaths())), zap.Int("sent", sent))
+	return nil
+}
+
 // AddImportSegment adds the import segment to the current DataNode.
 func (node *DataNode) AddImportSegment(ctx context.Context, req *datapb.AddImportSegmentRequest) (*datapb.AddImportSegmentResponse, error) {
 	log.Info("adding segment to DataNode flow graph",
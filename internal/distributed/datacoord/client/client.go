@@ -858,6 +858,34 @@ func (c *Client) GetIndexBuildProgress(ctx context.Context, req *indexpb.GetInde
 	return ret.(*indexpb.GetIndexBuildProgressResponse), err
 }
 
+// ReportJobResults pushes the results of finished index jobs to DataCoord.
+func (c *Client) ReportJobResults(ctx context.Context, req *indexpb.ReportJobResultsRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client datapb.DataCoordClient) (any, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.ReportJobResults(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// ListNodeJobs gets the index jobs in progress on the IndexNode from DataCoord.
+func (c *Client) ListNodeJobs(ctx context.Context, req *indexpb.ListNodeJobsRequest) (*indexpb.ListNodeJobsResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client datapb.DataCoordClient) (any, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.ListNodeJobs(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*indexpb.ListNodeJobsResponse), err
+}
+
 // DropIndex sends the drop index request to IndexCoord.
 func (c *Client) DropIndex(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error) {
 	ret, err := c.grpcClient.ReCall(ctx, func(client datapb.DataCoordClient) (any, error) {
@@ -180,6 +180,16 @@ func Test_NewClient(t *testing.T) {
 			ret, err := client.CheckHealth(ctx, nil)
 			retCheck(retNotNil, ret, err)
 		}
+
+		{
+			ret, err := client.ReportJobResults(ctx, nil)
+			retCheck(retNotNil, ret, err)
+		}
+
+		{
+			ret, err := client.ListNodeJobs(ctx, nil)
+			retCheck(retNotNil, ret, err)
+		}
 	}
 
 	client.grpcClient = &mock.GRPCClientBase[datapb.DataCoordClient]{
@@ -415,3 +415,13 @@ func (s *Server) DropIndex(ctx context.Context, request *indexpb.DropIndexReques
 func (s *Server) GetIndexBuildProgress(ctx context.Context, req *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error) {
 	return s.dataCoord.GetIndexBuildProgress(ctx, req)
 }
+
+// ReportJobResults receives the results of finished index jobs pushed by IndexNode.
+func (s *Server) ReportJobResults(ctx context.Context, req *indexpb.ReportJobResultsRequest) (*commonpb.Status, error) {
+	return s.dataCoord.ReportJobResults(ctx, req)
+}
+
+// ListNodeJobs returns the index jobs in progress on the IndexNode.
+func (s *Server) ListNodeJobs(ctx context.Context, req *indexpb.ListNodeJobsRequest) (*indexpb.ListNodeJobsResponse, error) {
+	return s.dataCoord.ListNodeJobs(ctx, req)
+}
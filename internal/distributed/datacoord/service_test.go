@@ -77,6 +77,8 @@ type MockDataCoord struct {
 	getIndexBuildProgressResp *indexpb.GetIndexBuildProgressResponse
 	getSegmentIndexStateResp  *indexpb.GetSegmentIndexStateResponse
 	getIndexInfosResp         *indexpb.GetIndexInfoResponse
+	reportJobResultsResp      *commonpb.Status
+	listNodeJobsResp          *indexpb.ListNodeJobsResponse
 }
 
 func (m *MockDataCoord) Init() error {
@@ -264,6 +266,14 @@ func (m *MockDataCoord) GetIndexBuildProgress(ctx context.Context, req *indexpb.
 	return m.getIndexBuildProgressResp, m.err
 }
 
+func (m *MockDataCoord) ReportJobResults(ctx context.Context, req *indexpb.ReportJobResultsRequest) (*commonpb.Status, error) {
+	return m.reportJobResultsResp, m.err
+}
+
+func (m *MockDataCoord) ListNodeJobs(ctx context.Context, req *indexpb.ListNodeJobsRequest) (*indexpb.ListNodeJobsResponse, error) {
+	return m.listNodeJobsResp, m.err
+}
+
 func (m *MockDataCoord) GetSegmentIndexState(ctx context.Context, req *indexpb.GetSegmentIndexStateRequest) (*indexpb.GetSegmentIndexStateResponse, error) {
 	return m.getSegmentIndexStateResp, m.err
 }
@@ -640,6 +650,24 @@ func Test_NewServer(t *testing.T) {
 		assert.NotNil(t, ret)
 	})
 
+	t.Run("ReportJobResults", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			reportJobResultsResp: &commonpb.Status{},
+		}
+		ret, err := server.ReportJobResults(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
+	t.Run("ListNodeJobs", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			listNodeJobsResp: &indexpb.ListNodeJobsResponse{},
+		}
+		ret, err := server.ListNodeJobs(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, ret)
+	})
+
 	err := server.Stop()
 	assert.Nil(t, err)
 }
@@ -298,6 +298,24 @@ func (c *Client) AddImportSegment(ctx context.Context, req *datapb.AddImportSegm
 	return ret.(*datapb.AddImportSegmentResponse), err
 }
 
+// ReadBinlogs returns the stream of the insert binlogs kept by DataNode.
+func (c *Client) ReadBinlogs(ctx context.Context, req *datapb.ReadBinlogsRequest) (datapb.DataNode_ReadBinlogsClient, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID()))
+	ret, err := c.grpcClient.ReCall(ctx, func(client datapb.DataNodeClient) (any, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.ReadBinlogs(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(datapb.DataNode_ReadBinlogsClient), err
+}
+
 // SyncSegments is the DataNode client side code for SyncSegments call.
 func (c *Client) SyncSegments(ctx context.Context, req *datapb.SyncSegmentsRequest) (*commonpb.Status, error) {
 	ret, err := c.grpcClient.ReCall(ctx, func(client datapb.DataNodeClient) (any, error) {
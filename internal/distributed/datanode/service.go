@@ -376,6 +376,11 @@ func (s *Server) AddImportSegment(ctx context.Context, request *datapb.AddImport
 	return s.datanode.AddImportSegment(ctx, request)
 }
 
+// ReadBinlogs streams the insert binlogs kept by DataNode.
+func (s *Server) ReadBinlogs(request *datapb.ReadBinlogsRequest, stream datapb.DataNode_ReadBinlogsServer) error {
+	return s.datanode.ReadBinlogs(request, stream)
+}
+
 func (s *Server) SyncSegments(ctx context.Context, request *datapb.SyncSegmentsRequest) (*commonpb.Status, error) {
 	return s.datanode.SyncSegments(ctx, request)
 }
@@ -147,6 +147,10 @@ func (m *MockDataNode) SyncSegments(ctx context.Context, req *datapb.SyncSegment
 	return m.status, m.err
 }
 
+func (m *MockDataNode) ReadBinlogs(req *datapb.ReadBinlogsRequest, stream datapb.DataNode_ReadBinlogsServer) error {
+	return m.err
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 type mockDataCoord struct {
 	types.DataCoord
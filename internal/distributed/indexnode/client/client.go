@@ -62,6 +62,7 @@ func NewClient(ctx context.Context, addr string, encryption bool) (*Client, erro
 			MaxBackoff:             float32(clientParams.MaxBackoff.GetAsFloat()),
 			BackoffMultiplier:      float32(clientParams.BackoffMultiplier.GetAsFloat()),
 			CompressionEnabled:     clientParams.CompressionEnabled.GetAsBool(),
+			AuthToken:              Params.IndexNodeCfg.GrpcAuthToken.GetValue(),
 		},
 	}
 	client.grpcClient.SetRole(typeutil.IndexNodeRole)
@@ -184,6 +185,132 @@ func (c *Client) GetJobStats(ctx context.Context, req *indexpb.GetJobStatsReques
 	return ret.(*indexpb.GetJobStatsResponse), err
 }
 
+// GetCapabilities returns the capabilities of IndexNode.
+func (c *Client) GetCapabilities(ctx context.Context, req *indexpb.GetCapabilitiesRequest) (*indexpb.GetCapabilitiesResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client indexpb.IndexNodeClient) (any, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.GetCapabilities(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*indexpb.GetCapabilitiesResponse), err
+}
+
+// WatchJobLog returns the stream of the log entries of a task.
+func (c *Client) WatchJobLog(ctx context.Context, req *indexpb.WatchJobLogRequest) (indexpb.IndexNode_WatchJobLogClient, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client indexpb.IndexNodeClient) (any, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.WatchJobLog(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(indexpb.IndexNode_WatchJobLogClient), err
+}
+
+// SetLogLevel changes the log level of IndexNode for a bounded duration.
+func (c *Client) SetLogLevel(ctx context.Context, req *indexpb.SetLogLevelRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client indexpb.IndexNodeClient) (any, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.SetLogLevel(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// EstimateWaitTime returns the estimated queue wait of a job created now and the wait SLO of the queue.
+func (c *Client) EstimateWaitTime(ctx context.Context, req *indexpb.EstimateWaitTimeRequest) (*indexpb.EstimateWaitTimeResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client indexpb.IndexNodeClient) (any, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.EstimateWaitTime(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*indexpb.EstimateWaitTimeResponse), err
+}
+
+// VerifyIndex schedules a job checking the integrity of the index files of a finished build.
+func (c *Client) VerifyIndex(ctx context.Context, req *indexpb.VerifyIndexRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client indexpb.IndexNodeClient) (any, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.VerifyIndex(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// SetSuspended suspends IndexNode or lifts the suspension.
+func (c *Client) SetSuspended(ctx context.Context, req *indexpb.SetSuspendedRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client indexpb.IndexNodeClient) (any, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.SetSuspended(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// ReserveSlots reserves a build slot of IndexNode for the job.
+func (c *Client) ReserveSlots(ctx context.Context, req *indexpb.ReserveSlotsRequest) (*indexpb.ReserveSlotsResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client indexpb.IndexNodeClient) (any, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.ReserveSlots(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*indexpb.ReserveSlotsResponse), err
+}
+
+// HandoffJobs takes back the queued jobs of IndexNode.
+func (c *Client) HandoffJobs(ctx context.Context, req *indexpb.HandoffJobsRequest) (*indexpb.HandoffJobsResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client indexpb.IndexNodeClient) (any, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.HandoffJobs(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*indexpb.HandoffJobsResponse), err
+}
+
+// SelfCheck runs a tiny end-to-end build on IndexNode and returns the result of every dependency.
+func (c *Client) SelfCheck(ctx context.Context, req *indexpb.SelfCheckRequest) (*indexpb.SelfCheckResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client indexpb.IndexNodeClient) (any, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.SelfCheck(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*indexpb.SelfCheckResponse), err
+}
+
 // ShowConfigurations gets specified configurations para of IndexNode
 func (c *Client) ShowConfigurations(ctx context.Context, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error) {
 	req = typeutil.Clone(req)
@@ -0,0 +1,196 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcindexnode
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/ratelimitutil"
+)
+
+const (
+	interceptorRecovery  = "recovery"
+	interceptorAuth      = "auth"
+	interceptorLog       = "log"
+	interceptorRateLimit = "ratelimit"
+)
+
+// newInterceptors assembles the server interceptors set by indexNode.grpc.interceptors in their order, a stream
+// rpc skips the interceptors of unary rpcs only like ratelimit. It fails on an unknown interceptor or an invalid
+// config of one, so a typo never leaves the node running without a policy it was configured with.
+func (s *Server) newInterceptors() ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor, error) {
+	cfg := &s.params.IndexNodeCfg
+	unary := make([]grpc.UnaryServerInterceptor, 0)
+	stream := make([]grpc.StreamServerInterceptor, 0)
+	for _, name := range cfg.GrpcInterceptors.GetAsStrings() {
+		switch strings.TrimSpace(name) {
+		case "":
+		case interceptorRecovery:
+			unary = append(unary, s.unaryRecoveryInterceptor)
+			stream = append(stream, streamRecoveryInterceptor)
+		case interceptorAuth:
+			token := cfg.GrpcAuthToken.GetValue()
+			if token == "" {
+				return nil, nil, fmt.Errorf("the auth interceptor of IndexNode needs %s", cfg.GrpcAuthToken.Key)
+			}
+			unary = append(unary, func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+				if err := checkAuthToken(ctx, token); err != nil {
+					return nil, err
+				}
+				return handler(ctx, req)
+			})
+			stream = append(stream, func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+				if err := checkAuthToken(ss.Context(), token); err != nil {
+					return err
+				}
+				return handler(srv, ss)
+			})
+		case interceptorLog:
+			unary = append(unary, unaryLogInterceptor)
+			stream = append(stream, streamLogInterceptor)
+		case interceptorRateLimit:
+			interceptor, err := newRateLimitInterceptor(cfg.GrpcRateLimits.GetAsJSONMap())
+			if err != nil {
+				return nil, nil, err
+			}
+			unary = append(unary, interceptor)
+		default:
+			return nil, nil, fmt.Errorf("unknown interceptor %q of IndexNode in %s", name, cfg.GrpcInterceptors.Key)
+		}
+	}
+	return unary, stream, nil
+}
+
+// panickedJob returns the job a request sets up.
+func panickedJob(req any) (string, int64, bool) {
+	switch r := req.(type) {
+	case *indexpb.CreateJobRequest:
+		return r.GetClusterID(), r.GetBuildID(), true
+	case *indexpb.VerifyIndexRequest:
+		return r.GetClusterID(), r.GetJobID(), true
+	}
+	return "", 0, false
+}
+
+// unaryRecoveryInterceptor turns a panic of the handler into an Internal error instead of crashing the node with
+// all its tasks, and drops the pending job the request may have left half set up, so it can be assigned again.
+func (s *Server) unaryRecoveryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Ctx(ctx).Error("IndexNode rpc panicked", zap.String("method", info.FullMethod), zap.Any("panic", r), zap.Stack("stack"))
+			if clusterID, jobID, ok := panickedJob(req); ok && s.indexnode != nil {
+				s.indexnode.DropPanickedJob(clusterID, jobID)
+			}
+			resp, err = nil, status.Errorf(codes.Internal, "%s panicked: %v", info.FullMethod, r)
+		}
+	}()
+	return handler(ctx, req)
+}
+
+func streamRecoveryInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Ctx(ss.Context()).Error("IndexNode rpc panicked", zap.String("method", info.FullMethod), zap.Any("panic", r), zap.Stack("stack"))
+			err = status.Errorf(codes.Internal, "%s panicked: %v", info.FullMethod, r)
+		}
+	}()
+	return handler(srv, ss)
+}
+
+// checkAuthToken accepts the requests carrying the token in their authorization header.
+func checkAuthToken(ctx context.Context, token string) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get(util.HeaderAuthorize) {
+		if subtle.ConstantTimeCompare([]byte(value), []byte(token)) == 1 {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, "invalid auth token of IndexNode")
+}
+
+// responseStatus returns the status of the response, nil if it has none.
+func responseStatus(resp any) *commonpb.Status {
+	switch r := resp.(type) {
+	case *commonpb.Status:
+		return r
+	case interface{ GetStatus() *commonpb.Status }:
+		return r.GetStatus()
+	}
+	return nil
+}
+
+func unaryLogInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	fields := []zap.Field{zap.String("method", info.FullMethod), zap.Duration("latency", time.Since(start))}
+	if err != nil {
+		log.Ctx(ctx).Warn("IndexNode rpc failed", append(fields, zap.Error(err))...)
+	} else if s := responseStatus(resp); s != nil && s.GetErrorCode() != commonpb.ErrorCode_Success {
+		log.Ctx(ctx).Info("IndexNode rpc done with error", append(fields, zap.String("errorCode", s.GetErrorCode().String()),
+			zap.String("reason", s.GetReason()))...)
+	} else {
+		log.Ctx(ctx).Debug("IndexNode rpc done", fields...)
+	}
+	return resp, err
+}
+
+func streamLogInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	fields := []zap.Field{zap.String("method", info.FullMethod), zap.Duration("latency", time.Since(start))}
+	if err != nil {
+		log.Ctx(ss.Context()).Warn("IndexNode rpc failed", append(fields, zap.Error(err))...)
+	} else {
+		log.Ctx(ss.Context()).Debug("IndexNode rpc done", fields...)
+	}
+	return err
+}
+
+// newRateLimitInterceptor rejects the requests of the methods exceeding their max requests per second in limits,
+// the methods not in limits are not limited.
+func newRateLimitInterceptor(limits map[string]string) (grpc.UnaryServerInterceptor, error) {
+	limiters := make(map[string]*ratelimitutil.Limiter, len(limits))
+	for method, value := range limits {
+		qps, err := strconv.ParseFloat(value, 64)
+		if err != nil || qps <= 0 {
+			return nil, fmt.Errorf("invalid rate limit %q of IndexNode method %s", value, method)
+		}
+		limiters[method] = ratelimitutil.NewLimiter(ratelimitutil.Limit(qps), qps)
+	}
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if limiter, ok := limiters[path.Base(info.FullMethod)]; ok && !limiter.AllowN(time.Now(), 1) {
+			return nil, status.Errorf(codes.ResourceExhausted, "%s exceeds the rate limit of IndexNode", info.FullMethod)
+		}
+		return handler(ctx, req)
+	}, nil
+}
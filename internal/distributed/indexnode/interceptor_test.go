@@ -0,0 +1,169 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcindexnode
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/indexnode"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+type panickedJobRecorder struct {
+	*indexnode.Mock
+	dropped []int64
+}
+
+func (r *panickedJobRecorder) DropPanickedJob(clusterID string, jobID int64) {
+	r.dropped = append(r.dropped, jobID)
+}
+
+// chainUnary runs the interceptors around handler like grpc_middleware.ChainUnaryServer.
+func chainUnary(interceptors []grpc.UnaryServerInterceptor, ctx context.Context, method string, req any, handler grpc.UnaryHandler) (any, error) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.index.IndexNode/" + method}
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], handler
+		handler = func(ctx context.Context, req any) (any, error) {
+			return interceptor(ctx, req, info, next)
+		}
+	}
+	return handler(ctx, req)
+}
+
+func TestInterceptors(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get().Namespace()
+	recorder := &panickedJobRecorder{Mock: indexnode.NewIndexNodeMock()}
+	s := &Server{params: params, indexnode: recorder}
+	defer params.Reset(params.IndexNodeCfg.GrpcInterceptors.Key)
+	defer params.Reset(params.IndexNodeCfg.GrpcAuthToken.Key)
+	defer params.Reset(params.IndexNodeCfg.GrpcRateLimits.Key)
+	ctx := context.Background()
+	ok := func(ctx context.Context, req any) (any, error) {
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+	}
+
+	t.Run("config", func(t *testing.T) {
+		unary, stream, err := s.newInterceptors()
+		assert.NoError(t, err)
+		assert.Len(t, unary, 1)
+		assert.Len(t, stream, 1)
+
+		params.Save(params.IndexNodeCfg.GrpcInterceptors.Key, "recovery,unknown")
+		_, _, err = s.newInterceptors()
+		assert.Error(t, err)
+		params.Save(params.IndexNodeCfg.GrpcInterceptors.Key, "auth")
+		_, _, err = s.newInterceptors()
+		assert.Error(t, err)
+		params.Save(params.IndexNodeCfg.GrpcInterceptors.Key, "ratelimit")
+		params.Save(params.IndexNodeCfg.GrpcRateLimits.Key, `{"CreateJob": "fast"}`)
+		_, _, err = s.newInterceptors()
+		assert.Error(t, err)
+		params.Save(params.IndexNodeCfg.GrpcInterceptors.Key, "")
+		unary, stream, err = s.newInterceptors()
+		assert.NoError(t, err)
+		assert.Empty(t, unary)
+		assert.Empty(t, stream)
+	})
+
+	t.Run("recovery", func(t *testing.T) {
+		params.Save(params.IndexNodeCfg.GrpcInterceptors.Key, "recovery")
+		unary, stream, err := s.newInterceptors()
+		require.NoError(t, err)
+		_, err = chainUnary(unary, ctx, "CreateJob", &indexpb.CreateJobRequest{ClusterID: "cluster", BuildID: 1},
+			func(ctx context.Context, req any) (any, error) {
+				panic("boom")
+			})
+		assert.Equal(t, codes.Internal, status.Code(err))
+		// the requests not setting up a job leave nothing behind.
+		_, err = chainUnary(unary, ctx, "QueryJobs", &indexpb.QueryJobsRequest{}, func(ctx context.Context, req any) (any, error) {
+			panic("boom")
+		})
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Equal(t, []int64{1}, recorder.dropped)
+
+		err = stream[0](nil, nil, &grpc.StreamServerInfo{FullMethod: "WatchJobLog"}, func(srv any, ss grpc.ServerStream) error {
+			panic("boom")
+		})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("auth", func(t *testing.T) {
+		params.Save(params.IndexNodeCfg.GrpcInterceptors.Key, "auth")
+		params.Save(params.IndexNodeCfg.GrpcAuthToken.Key, "secret")
+		unary, _, err := s.newInterceptors()
+		require.NoError(t, err)
+		_, err = chainUnary(unary, ctx, "CreateJob", &indexpb.CreateJobRequest{}, ok)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		wrong := metadata.NewIncomingContext(ctx, metadata.Pairs(util.HeaderAuthorize, "guess"))
+		_, err = chainUnary(unary, wrong, "CreateJob", &indexpb.CreateJobRequest{}, ok)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		authorized := metadata.NewIncomingContext(ctx, metadata.Pairs(util.HeaderAuthorize, "secret"))
+		_, err = chainUnary(unary, authorized, "CreateJob", &indexpb.CreateJobRequest{}, ok)
+		assert.NoError(t, err)
+	})
+
+	t.Run("log", func(t *testing.T) {
+		params.Save(params.IndexNodeCfg.GrpcInterceptors.Key, "log")
+		unary, _, err := s.newInterceptors()
+		require.NoError(t, err)
+		resp, err := chainUnary(unary, ctx, "CreateJob", &indexpb.CreateJobRequest{}, ok)
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+		_, err = chainUnary(unary, ctx, "QueryJobs", &indexpb.QueryJobsRequest{}, func(ctx context.Context, req any) (any, error) {
+			return &indexpb.QueryJobsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}}, nil
+		})
+		assert.NoError(t, err)
+		failure := errors.New("failure")
+		_, err = chainUnary(unary, ctx, "QueryJobs", &indexpb.QueryJobsRequest{}, func(ctx context.Context, req any) (any, error) {
+			return nil, failure
+		})
+		assert.ErrorIs(t, err, failure)
+	})
+
+	t.Run("ratelimit", func(t *testing.T) {
+		params.Save(params.IndexNodeCfg.GrpcInterceptors.Key, "recovery, ratelimit")
+		params.Save(params.IndexNodeCfg.GrpcRateLimits.Key, `{"CreateJob": 1}`)
+		unary, stream, err := s.newInterceptors()
+		require.NoError(t, err)
+		assert.Len(t, unary, 2)
+		assert.Len(t, stream, 1)
+		_, err = chainUnary(unary, ctx, "CreateJob", &indexpb.CreateJobRequest{}, ok)
+		assert.NoError(t, err)
+		_, err = chainUnary(unary, ctx, "CreateJob", &indexpb.CreateJobRequest{}, ok)
+		assert.NoError(t, err)
+		_, err = chainUnary(unary, ctx, "CreateJob", &indexpb.CreateJobRequest{}, ok)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		// the methods without limits are never limited.
+		for i := 0; i < 5; i++ {
+			_, err = chainUnary(unary, ctx, "QueryJobs", &indexpb.QueryJobsRequest{}, ok)
+			assert.NoError(t, err)
+		}
+	})
+}
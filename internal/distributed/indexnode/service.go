@@ -33,6 +33,8 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	dcc "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
+	dnc "github.com/milvus-io/milvus/internal/distributed/datanode/client"
 	"github.com/milvus-io/milvus/internal/indexnode"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
@@ -58,6 +60,15 @@ type Server struct {
 	loopWg     sync.WaitGroup
 
 	etcdCli *clientv3.Client
+	// sharedEtcdCli is true if etcdCli is shared with the other components of the process, the
+	// server must not close it.
+	sharedEtcdCli bool
+	// params is the param table of the server, a namespace of the process param table when the
+	// server is embedded with other components.
+	params *paramtable.ComponentParam
+
+	newDataCoordClient func(string, *clientv3.Client) (types.DataCoord, error)
+	newBinlogReader    func(ctx context.Context, addr string) (types.BinlogReader, error)
 }
 
 // Run initializes and starts IndexNode's grpc service.
@@ -77,8 +88,15 @@ func (s *Server) Run() error {
 func (s *Server) startGrpcLoop(grpcPort int) {
 	defer s.loopWg.Done()
 
-	Params := &paramtable.Get().IndexNodeGrpcServerCfg
+	Params := &s.params.IndexNodeGrpcServerCfg
 	log.Debug("IndexNode", zap.String("network address", Params.GetAddress()), zap.Int("network port: ", grpcPort))
+	unaryInterceptors, streamInterceptors, err := s.newInterceptors()
+	if err != nil {
+		log.Warn("IndexNode", zap.String("GrpcServer:invalid interceptors", err.Error()))
+		s.grpcErrChan <- err
+		return
+	}
+
 	lis, err := net.Listen("tcp", ":"+strconv.Itoa(grpcPort))
 	if err != nil {
 		log.Warn("IndexNode", zap.String("GrpcServer:failed to listen", err.Error()))
@@ -105,12 +123,12 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 		grpc.KeepaliveParams(kasp),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize.GetAsInt()),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize.GetAsInt()),
-		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(append([]grpc.UnaryServerInterceptor{
 			otelgrpc.UnaryServerInterceptor(opts...),
-			logutil.UnaryTraceLoggerInterceptor)),
-		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			logutil.UnaryTraceLoggerInterceptor}, unaryInterceptors...)...)),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(append([]grpc.StreamServerInterceptor{
 			otelgrpc.StreamServerInterceptor(opts...),
-			logutil.StreamTraceLoggerInterceptor)))
+			logutil.StreamTraceLoggerInterceptor}, streamInterceptors...)...)))
 	indexpb.RegisterIndexNodeServer(s.grpcServer, s)
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
 	if err := s.grpcServer.Serve(lis); err != nil {
@@ -120,11 +138,11 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 
 // init initializes IndexNode's grpc service.
 func (s *Server) init() error {
-	etcdConfig := &paramtable.Get().EtcdCfg
-	Params := &paramtable.Get().IndexNodeGrpcServerCfg
+	etcdConfig := &s.params.EtcdCfg
+	Params := &s.params.IndexNodeGrpcServerCfg
 	var err error
 	if !funcutil.CheckPortAvailable(Params.Port.GetAsInt()) {
-		paramtable.Get().Save(Params.Port.Key, fmt.Sprintf("%d", funcutil.GetAvailablePort()))
+		s.params.Save(Params.Port.Key, fmt.Sprintf("%d", funcutil.GetAvailablePort()))
 		log.Warn("IndexNode get available port when init", zap.Int("Port", Params.Port.GetAsInt()))
 	}
 
@@ -146,21 +164,51 @@ func (s *Server) init() error {
 		return err
 	}
 
-	etcdCli, err := etcd.GetEtcdClient(
-		etcdConfig.UseEmbedEtcd.GetAsBool(),
-		etcdConfig.EtcdUseSSL.GetAsBool(),
-		etcdConfig.Endpoints.GetAsStrings(),
-		etcdConfig.EtcdTLSCert.GetValue(),
-		etcdConfig.EtcdTLSKey.GetValue(),
-		etcdConfig.EtcdTLSCACert.GetValue(),
-		etcdConfig.EtcdTLSMinVersion.GetValue())
-	if err != nil {
-		log.Debug("IndexNode connect to etcd failed", zap.Error(err))
-		return err
+	if s.etcdCli == nil {
+		s.etcdCli, err = etcd.GetEtcdClient(
+			etcdConfig.UseEmbedEtcd.GetAsBool(),
+			etcdConfig.EtcdUseSSL.GetAsBool(),
+			etcdConfig.Endpoints.GetAsStrings(),
+			etcdConfig.EtcdTLSCert.GetValue(),
+			etcdConfig.EtcdTLSKey.GetValue(),
+			etcdConfig.EtcdTLSCACert.GetValue(),
+			etcdConfig.EtcdTLSMinVersion.GetValue())
+		if err != nil {
+			log.Debug("IndexNode connect to etcd failed", zap.Error(err))
+			return err
+		}
 	}
-	s.etcdCli = etcdCli
+	etcdCli := s.etcdCli
 	s.indexnode.SetEtcdClient(etcdCli)
 	s.indexnode.SetAddress(Params.GetAddress())
+
+	// DataCoord client is only used to report job results and reconcile the jobs of the previous run,
+	// IndexNode does not wait for DataCoord to be ready.
+	if s.newDataCoordClient != nil && (s.params.IndexNodeCfg.ResultCallbackEnable.GetAsBool() ||
+		s.params.IndexNodeCfg.ReconcileEnable.GetAsBool()) {
+		log.Debug("IndexNode create DataCoord client")
+		var dataCoordClient types.DataCoord
+		dataCoordClient, err = s.newDataCoordClient(etcdConfig.MetaRootPath.GetValue(), etcdCli)
+		if err != nil {
+			log.Error("IndexNode create DataCoord client failed", zap.Error(err))
+			return err
+		}
+		if err = dataCoordClient.Init(); err != nil {
+			log.Error("IndexNode init DataCoord client failed", zap.Error(err))
+			return err
+		}
+		if err = dataCoordClient.Start(); err != nil {
+			log.Error("IndexNode start DataCoord client failed", zap.Error(err))
+			return err
+		}
+		if err = s.indexnode.SetDataCoord(dataCoordClient); err != nil {
+			return err
+		}
+	}
+	if s.newBinlogReader != nil {
+		s.indexnode.SetBinlogReaderCreator(s.newBinlogReader)
+	}
+
 	err = s.indexnode.Init()
 	if err != nil {
 		log.Error("IndexNode Init failed", zap.Error(err))
@@ -187,13 +235,13 @@ func (s *Server) start() error {
 
 // Stop stops IndexNode's grpc service.
 func (s *Server) Stop() error {
-	Params := &paramtable.Get().IndexNodeGrpcServerCfg
+	Params := &s.params.IndexNodeGrpcServerCfg
 	log.Debug("IndexNode stop", zap.String("Address", Params.GetAddress()))
 	if s.indexnode != nil {
 		s.indexnode.Stop()
 	}
 	s.loopCancel()
-	if s.etcdCli != nil {
+	if s.etcdCli != nil && !s.sharedEtcdCli {
 		defer s.etcdCli.Close()
 	}
 	if s.grpcServer != nil {
@@ -246,6 +294,51 @@ func (s *Server) GetJobStats(ctx context.Context, req *indexpb.GetJobStatsReques
 	return s.indexnode.GetJobStats(ctx, req)
 }
 
+// GetCapabilities returns the capabilities of IndexNode.
+func (s *Server) GetCapabilities(ctx context.Context, req *indexpb.GetCapabilitiesRequest) (*indexpb.GetCapabilitiesResponse, error) {
+	return s.indexnode.GetCapabilities(ctx, req)
+}
+
+// SetLogLevel changes the log level of IndexNode for a bounded duration.
+func (s *Server) SetLogLevel(ctx context.Context, req *indexpb.SetLogLevelRequest) (*commonpb.Status, error) {
+	return s.indexnode.SetLogLevel(ctx, req)
+}
+
+// EstimateWaitTime returns the estimated queue wait of a job created now and the wait SLO of the queue.
+func (s *Server) EstimateWaitTime(ctx context.Context, req *indexpb.EstimateWaitTimeRequest) (*indexpb.EstimateWaitTimeResponse, error) {
+	return s.indexnode.EstimateWaitTime(ctx, req)
+}
+
+// VerifyIndex schedules a job checking the integrity of the index files of a finished build.
+func (s *Server) VerifyIndex(ctx context.Context, req *indexpb.VerifyIndexRequest) (*commonpb.Status, error) {
+	return s.indexnode.VerifyIndex(ctx, req)
+}
+
+// SetSuspended suspends IndexNode or lifts the suspension.
+func (s *Server) SetSuspended(ctx context.Context, req *indexpb.SetSuspendedRequest) (*commonpb.Status, error) {
+	return s.indexnode.SetSuspended(ctx, req)
+}
+
+// ReserveSlots reserves a build slot of IndexNode for the job.
+func (s *Server) ReserveSlots(ctx context.Context, req *indexpb.ReserveSlotsRequest) (*indexpb.ReserveSlotsResponse, error) {
+	return s.indexnode.ReserveSlots(ctx, req)
+}
+
+// HandoffJobs takes back the queued jobs of IndexNode.
+func (s *Server) HandoffJobs(ctx context.Context, req *indexpb.HandoffJobsRequest) (*indexpb.HandoffJobsResponse, error) {
+	return s.indexnode.HandoffJobs(ctx, req)
+}
+
+// SelfCheck runs a tiny end-to-end build on IndexNode and returns the result of every dependency.
+func (s *Server) SelfCheck(ctx context.Context, req *indexpb.SelfCheckRequest) (*indexpb.SelfCheckResponse, error) {
+	return s.indexnode.SelfCheck(ctx, req)
+}
+
+// WatchJobLog streams the log entries of a task.
+func (s *Server) WatchJobLog(req *indexpb.WatchJobLogRequest, stream indexpb.IndexNode_WatchJobLogServer) error {
+	return s.indexnode.WatchJobLog(req, stream)
+}
+
 // ShowConfigurations gets specified configurations para of IndexNode
 func (s *Server) ShowConfigurations(ctx context.Context, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error) {
 	return s.indexnode.ShowConfigurations(ctx, req)
@@ -256,15 +349,51 @@ func (s *Server) GetMetrics(ctx context.Context, request *milvuspb.GetMetricsReq
 	return s.indexnode.GetMetrics(ctx, request)
 }
 
+// Option sets an attribute of the IndexNode grpc server.
+type Option func(s *Server)
+
+// WithEtcdClient returns an `Option` sharing the etcd client of the process with the server, the
+// server doesn't close it on Stop.
+func WithEtcdClient(etcdCli *clientv3.Client) Option {
+	return func(s *Server) {
+		s.etcdCli = etcdCli
+		s.sharedEtcdCli = true
+	}
+}
+
+// WithParams returns an `Option` setting the param table of the server, it's usually a namespace
+// of the process param table so the server's runtime configs don't race with the other components.
+func WithParams(params *paramtable.ComponentParam) Option {
+	return func(s *Server) {
+		s.params = params
+	}
+}
+
 // NewServer create a new IndexNode grpc server.
-func NewServer(ctx context.Context, factory dependency.Factory) (*Server, error) {
+func NewServer(ctx context.Context, factory dependency.Factory, opts ...Option) (*Server, error) {
 	ctx1, cancel := context.WithCancel(ctx)
-	node := indexnode.NewIndexNode(ctx1, factory)
-
-	return &Server{
+	s := &Server{
 		loopCtx:     ctx1,
 		loopCancel:  cancel,
-		indexnode:   node,
 		grpcErrChan: make(chan error),
-	}, nil
+		params:      paramtable.Get(),
+		newDataCoordClient: func(etcdMetaRoot string, client *clientv3.Client) (types.DataCoord, error) {
+			return dcc.NewClient(ctx1, etcdMetaRoot, client)
+		},
+		newBinlogReader: func(ctx context.Context, addr string) (types.BinlogReader, error) {
+			client, err := dnc.NewClient(ctx, addr)
+			if err != nil {
+				return nil, err
+			}
+			if err = client.Init(); err != nil {
+				return nil, err
+			}
+			return client, client.Start()
+		},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.indexnode = indexnode.NewIndexNode(ctx1, factory, s.params)
+	return s, nil
 }
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	clientv3 "go.etcd.io/etcd/client/v3"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
@@ -128,3 +129,23 @@ func TestIndexNodeServer(t *testing.T) {
 	err = server.Stop()
 	assert.Nil(t, err)
 }
+
+func TestIndexNodeServerOptions(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	factory := dependency.NewDefaultFactory(true)
+
+	server, err := NewServer(ctx, factory)
+	assert.NoError(t, err)
+	assert.Same(t, paramtable.Get(), server.params)
+	assert.Nil(t, server.etcdCli)
+	assert.False(t, server.sharedEtcdCli)
+
+	params := paramtable.Get().Namespace()
+	etcdCli := &clientv3.Client{}
+	server, err = NewServer(ctx, factory, WithParams(params), WithEtcdClient(etcdCli))
+	assert.NoError(t, err)
+	assert.Same(t, params, server.params)
+	assert.Same(t, etcdCli, server.etcdCli)
+	assert.True(t, server.sharedEtcdCli)
+}
@@ -614,6 +614,14 @@ func (m *MockDataCoord) GetIndexBuildProgress(ctx context.Context, req *indexpb.
 	return nil, nil
 }
 
+func (m *MockDataCoord) ReportJobResults(ctx context.Context, req *indexpb.ReportJobResultsRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) ListNodeJobs(ctx context.Context, req *indexpb.ListNodeJobsRequest) (*indexpb.ListNodeJobsResponse, error) {
+	return nil, nil
+}
+
 // /////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 type MockProxy struct {
 	MockBase
@@ -0,0 +1,229 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/indexcgowrapper"
+	"github.com/milvus-io/milvus/internal/util/indexmanifest"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+)
+
+const (
+	// autoIndexType is the index type of the jobs letting the node choose the index type and the build params.
+	autoIndexType = "AUTOINDEX"
+	// autoIndexSampleRows is the max number of vectors sampled to characterize their distribution.
+	autoIndexSampleRows = 1024
+	// defaultAutoIndexPolicyName is the name of the built-in policy.
+	defaultAutoIndexPolicyName = "default"
+
+	// the default policy builds IVF_SQ8 instead of HNSW on the segments of more rows,
+	// and IVF_FLAT on the vectors of fewer distinct ones in the sample.
+	autoIndexHNSWMaxRows     = 1000000
+	autoIndexMinDistinctRate = 0.5
+	// the default policy builds the HNSW graph of more neighbors on the vectors of more dimensions.
+	autoIndexHNSWHighDim = 256
+)
+
+// errAutoIndex is the fail reason of the AUTOINDEX builds whose index the policy can't choose.
+var errAutoIndex = errors.New("auto index failed")
+
+// AutoIndexFeatures are the characteristics of the data an AutoIndexPolicy chooses the index on.
+type AutoIndexFeatures struct {
+	DType      schemapb.DataType
+	Dim        int64
+	NumRows    int64
+	MetricType string
+	// DistinctRate is the rate of the distinct vectors among the sampled ones.
+	DistinctRate float64
+}
+
+// AutoIndexChoice is the index chosen by an AutoIndexPolicy.
+type AutoIndexChoice struct {
+	// IndexParams are the build params of the index including the index type, they override the params of the job.
+	IndexParams map[string]string
+	// Reason explains the choice, it's recorded in the manifest of the index files.
+	Reason string
+}
+
+// AutoIndexPolicy chooses the index type and the build params of the AUTOINDEX jobs.
+type AutoIndexPolicy interface {
+	Choose(features AutoIndexFeatures) (AutoIndexChoice, error)
+}
+
+var (
+	autoIndexPoliciesMu sync.RWMutex
+	autoIndexPolicies   = map[string]AutoIndexPolicy{
+		defaultAutoIndexPolicyName: defaultAutoIndexPolicy{},
+	}
+)
+
+// RegisterAutoIndexPolicy makes an auto index policy selectable by indexNode.autoIndex.policy,
+// registering an existing name replaces it.
+func RegisterAutoIndexPolicy(name string, policy AutoIndexPolicy) {
+	autoIndexPoliciesMu.Lock()
+	defer autoIndexPoliciesMu.Unlock()
+	autoIndexPolicies[name] = policy
+}
+
+func getAutoIndexPolicy(name string) (AutoIndexPolicy, error) {
+	autoIndexPoliciesMu.RLock()
+	defer autoIndexPoliciesMu.RUnlock()
+	policy, ok := autoIndexPolicies[name]
+	if !ok {
+		return nil, fmt.Errorf("auto index policy %s is not supported", name)
+	}
+	return policy, nil
+}
+
+// defaultAutoIndexPolicy builds BIN_IVF_FLAT on the binary vectors. On the float vectors it builds IVF_FLAT
+// if the sampled vectors are duplicated much, which degrades the HNSW graph, IVF_SQ8 on the segments too large
+// to hold the HNSW graph, and HNSW otherwise. The nlist of the IVF indexes is recommended by the row count.
+type defaultAutoIndexPolicy struct{}
+
+func (defaultAutoIndexPolicy) Choose(features AutoIndexFeatures) (AutoIndexChoice, error) {
+	switch features.DType {
+	case schemapb.DataType_BinaryVector:
+		return AutoIndexChoice{
+			IndexParams: map[string]string{"index_type": indexparamcheck.IndexFaissBinIvfFlat},
+			Reason:      "binary vectors",
+		}, nil
+	case schemapb.DataType_FloatVector:
+	default:
+		return AutoIndexChoice{}, fmt.Errorf("data type %s is not a vector type", features.DType.String())
+	}
+	if features.DistinctRate < autoIndexMinDistinctRate {
+		return AutoIndexChoice{
+			IndexParams: map[string]string{"index_type": indexparamcheck.IndexFaissIvfFlat},
+			Reason:      fmt.Sprintf("%.2f of the sampled vectors are distinct", features.DistinctRate),
+		}, nil
+	}
+	if features.NumRows > autoIndexHNSWMaxRows {
+		return AutoIndexChoice{
+			IndexParams: map[string]string{"index_type": indexparamcheck.IndexFaissIvfSQ8},
+			Reason:      fmt.Sprintf("%d rows exceed %d rows of HNSW", features.NumRows, autoIndexHNSWMaxRows),
+		}, nil
+	}
+	m, efConstruction := 16, 200
+	if features.Dim > autoIndexHNSWHighDim {
+		m, efConstruction = 32, 360
+	}
+	return AutoIndexChoice{
+		IndexParams: map[string]string{
+			"index_type":                   indexparamcheck.IndexHNSW,
+			indexparamcheck.HNSWM:          strconv.Itoa(m),
+			indexparamcheck.EFConstruction: strconv.Itoa(efConstruction),
+		},
+		Reason: fmt.Sprintf("%d rows of dim %d", features.NumRows, features.Dim),
+	}, nil
+}
+
+// sampleDistinctRate returns the rate of the distinct vectors among at most sampleRows vectors sampled by rnd,
+// it returns 1 for the other data types.
+func sampleDistinctRate(data storage.FieldData, sampleRows int, rnd *rand.Rand) float64 {
+	numRows := data.RowNum()
+	if numRows == 0 {
+		return 1
+	}
+	rows := rnd.Perm(numRows)
+	if len(rows) > sampleRows {
+		rows = rows[:sampleRows]
+	}
+	sort.Ints(rows)
+	distinct := make(map[string]struct{}, len(rows))
+	switch f := data.(type) {
+	case *storage.FloatVectorFieldData:
+		vector := make([]byte, f.Dim*4)
+		for _, row := range rows {
+			for i, v := range f.Data[row*f.Dim : (row+1)*f.Dim] {
+				binary.LittleEndian.PutUint32(vector[i*4:], math.Float32bits(v))
+			}
+			distinct[string(vector)] = struct{}{}
+		}
+	case *storage.BinaryVectorFieldData:
+		rowBytes := f.Dim / 8
+		for _, row := range rows {
+			distinct[string(f.Data[row*rowBytes:(row+1)*rowBytes])] = struct{}{}
+		}
+	default:
+		return 1
+	}
+	return float64(len(distinct)) / float64(len(rows))
+}
+
+// autoIndexDecision is the index chosen for an AUTOINDEX build.
+type autoIndexDecision struct {
+	policy string
+	choice AutoIndexChoice
+}
+
+func (d *autoIndexDecision) manifest() *indexmanifest.AutoIndex {
+	if d == nil {
+		return nil
+	}
+	return &indexmanifest.AutoIndex{Policy: d.policy, IndexParams: d.choice.IndexParams, Reason: d.choice.Reason}
+}
+
+// chooseAutoIndex replaces the AUTOINDEX index type of the job by the index chosen by indexNode.autoIndex.policy
+// on the loaded data, the chosen params are recorded in the index params of the build stats. It does nothing
+// if the job isn't an AUTOINDEX one. The sample is seeded by the build id, so the retries choose the same index.
+func (it *indexBuildTask) chooseAutoIndex(ctx context.Context) error {
+	if it.newIndexParams["index_type"] != autoIndexType {
+		return nil
+	}
+	name := it.node.params.IndexNodeCfg.AutoIndexPolicy.GetValue()
+	policy, err := getAutoIndexPolicy(name)
+	if err != nil {
+		return err
+	}
+	features := AutoIndexFeatures{
+		DType:        indexcgowrapper.GenDataset(it.fieldData).DType,
+		Dim:          it.statistic.Dim,
+		NumRows:      it.statistic.NumRows,
+		MetricType:   it.newIndexParams[indexparamcheck.Metric],
+		DistinctRate: sampleDistinctRate(it.fieldData, autoIndexSampleRows, rand.New(rand.NewSource(it.BuildID))),
+	}
+	choice, err := policy.Choose(features)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errAutoIndex, err.Error())
+	}
+	if indexType := choice.IndexParams["index_type"]; indexType == "" || indexType == autoIndexType {
+		return fmt.Errorf("%w: policy %s chose no index type", errAutoIndex, name)
+	}
+	for key, value := range choice.IndexParams {
+		it.newIndexParams[key] = value
+		it.setStatisticIndexParam(key, value)
+	}
+	it.autoIndex = &autoIndexDecision{policy: name, choice: choice}
+	log.Ctx(ctx).Info("IndexNode chose the index of the AUTOINDEX job", zap.Int64("buildID", it.BuildID),
+		zap.String("policy", name), zap.Any("indexParams", choice.IndexParams), zap.String("reason", choice.Reason))
+	return nil
+}
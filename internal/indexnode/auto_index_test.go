@@ -0,0 +1,131 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+type mockAutoIndexPolicy struct {
+	choice AutoIndexChoice
+	err    error
+}
+
+func (p mockAutoIndexPolicy) Choose(features AutoIndexFeatures) (AutoIndexChoice, error) {
+	return p.choice, p.err
+}
+
+func TestDefaultAutoIndexPolicy(t *testing.T) {
+	policy := defaultAutoIndexPolicy{}
+	choose := func(features AutoIndexFeatures) map[string]string {
+		choice, err := policy.Choose(features)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, choice.Reason)
+		return choice.IndexParams
+	}
+
+	assert.Equal(t, map[string]string{"index_type": indexparamcheck.IndexFaissBinIvfFlat},
+		choose(AutoIndexFeatures{DType: schemapb.DataType_BinaryVector, Dim: 128, NumRows: 10000, DistinctRate: 0.1}))
+	assert.Equal(t, map[string]string{"index_type": indexparamcheck.IndexFaissIvfFlat},
+		choose(AutoIndexFeatures{DType: schemapb.DataType_FloatVector, Dim: 128, NumRows: 10000, DistinctRate: 0.4}))
+	assert.Equal(t, map[string]string{"index_type": indexparamcheck.IndexFaissIvfSQ8},
+		choose(AutoIndexFeatures{DType: schemapb.DataType_FloatVector, Dim: 128, NumRows: 2000000, DistinctRate: 1}))
+	assert.Equal(t, map[string]string{"index_type": indexparamcheck.IndexHNSW, "M": "16", "efConstruction": "200"},
+		choose(AutoIndexFeatures{DType: schemapb.DataType_FloatVector, Dim: 128, NumRows: 10000, DistinctRate: 1}))
+	assert.Equal(t, map[string]string{"index_type": indexparamcheck.IndexHNSW, "M": "32", "efConstruction": "360"},
+		choose(AutoIndexFeatures{DType: schemapb.DataType_FloatVector, Dim: 768, NumRows: 10000, DistinctRate: 1}))
+
+	_, err := policy.Choose(AutoIndexFeatures{DType: schemapb.DataType_Int64})
+	assert.Error(t, err)
+}
+
+func TestSampleDistinctRate(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	floats := &storage.FloatVectorFieldData{Dim: 2, Data: []float32{1, 2, 1, 2, 1, 2, 3, 4}}
+	assert.Equal(t, 0.5, sampleDistinctRate(floats, 10, rnd))
+	assert.Equal(t, 1.0, sampleDistinctRate(floats, 1, rnd))
+
+	binaries := &storage.BinaryVectorFieldData{Dim: 16, Data: []byte{1, 2, 3, 4, 5, 6, 7, 8}}
+	assert.Equal(t, 1.0, sampleDistinctRate(binaries, 10, rnd))
+	binaries.Data = []byte{1, 2, 1, 2, 1, 2, 1, 2}
+	assert.Equal(t, 0.25, sampleDistinctRate(binaries, 10, rnd))
+
+	assert.Equal(t, 1.0, sampleDistinctRate(&storage.Int64FieldData{Data: []int64{1, 1}}, 10, rnd))
+	assert.Equal(t, 1.0, sampleDistinctRate(&storage.FloatVectorFieldData{Dim: 2}, 10, rnd))
+}
+
+func TestChooseAutoIndex(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	ctx := context.Background()
+	newTask := func(indexType string) *indexBuildTask {
+		it := &indexBuildTask{
+			BuildID:   1,
+			node:      &IndexNode{params: params},
+			fieldData: &storage.FloatVectorFieldData{Dim: 2, Data: []float32{1, 2, 3, 4}},
+			newIndexParams: map[string]string{
+				"index_type":           indexType,
+				indexparamcheck.Metric: indexparamcheck.L2,
+			},
+		}
+		it.statistic.Dim, it.statistic.NumRows = 2, 2
+		it.statistic.IndexParams = []*commonpb.KeyValuePair{{Key: "index_type", Value: indexType}}
+		return it
+	}
+
+	it := newTask(indexparamcheck.IndexFaissIvfFlat)
+	assert.NoError(t, it.chooseAutoIndex(ctx))
+	assert.Nil(t, it.autoIndex)
+	assert.Equal(t, indexparamcheck.IndexFaissIvfFlat, it.newIndexParams["index_type"])
+
+	it = newTask(autoIndexType)
+	assert.NoError(t, it.chooseAutoIndex(ctx))
+	assert.Equal(t, indexparamcheck.IndexHNSW, it.newIndexParams["index_type"])
+	assert.Equal(t, "16", it.newIndexParams[indexparamcheck.HNSWM])
+	assert.Equal(t, indexparamcheck.L2, it.newIndexParams[indexparamcheck.Metric])
+	assert.Contains(t, it.statistic.IndexParams, &commonpb.KeyValuePair{Key: "index_type", Value: indexparamcheck.IndexHNSW})
+	assert.Len(t, it.statistic.IndexParams, 3)
+	assert.Equal(t, defaultAutoIndexPolicyName, it.autoIndex.manifest().Policy)
+
+	RegisterAutoIndexPolicy("mock", mockAutoIndexPolicy{err: errors.New("mock")})
+	RegisterAutoIndexPolicy("mock-empty", mockAutoIndexPolicy{choice: AutoIndexChoice{IndexParams: map[string]string{"index_type": autoIndexType}}})
+	defer func() {
+		autoIndexPoliciesMu.Lock()
+		delete(autoIndexPolicies, "mock")
+		delete(autoIndexPolicies, "mock-empty")
+		autoIndexPoliciesMu.Unlock()
+	}()
+	defer params.Reset(params.IndexNodeCfg.AutoIndexPolicy.Key)
+	params.Save(params.IndexNodeCfg.AutoIndexPolicy.Key, "mock")
+	assert.ErrorIs(t, newTask(autoIndexType).chooseAutoIndex(ctx), errAutoIndex)
+	params.Save(params.IndexNodeCfg.AutoIndexPolicy.Key, "mock-empty")
+	assert.ErrorIs(t, newTask(autoIndexType).chooseAutoIndex(ctx), errAutoIndex)
+	params.Save(params.IndexNodeCfg.AutoIndexPolicy.Key, "unknown")
+	err := newTask(autoIndexType).chooseAutoIndex(ctx)
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, errAutoIndex)
+}
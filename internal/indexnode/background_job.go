@@ -0,0 +1,96 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/indexparams"
+)
+
+// backgroundJobClass is the job class of the builds trickling along on a tiny thread budget.
+const backgroundJobClass = "background"
+
+// checkJobClass checks the job class of the job is known.
+func checkJobClass(req *indexpb.CreateJobRequest) error {
+	switch req.GetJobClass() {
+	case "", backgroundJobClass:
+		return nil
+	default:
+		return fmt.Errorf("unknown job class %s", req.GetJobClass())
+	}
+}
+
+// isBackground tells whether the task is a background job.
+func (it *indexBuildTask) isBackground() bool {
+	return it.req.GetJobClass() == backgroundJobClass
+}
+
+// backgroundThreads returns the thread budget of the background jobs.
+func (it *indexBuildTask) backgroundThreads() int {
+	threads := it.node.params.IndexNodeCfg.BackgroundBuildThreads.GetAsInt()
+	if threads < 1 {
+		threads = 1
+	}
+	return threads
+}
+
+// clampParallel clamps the go-side parallelism of the task to the thread budget if it's a background job.
+func (it *indexBuildTask) clampParallel(parallel int) int {
+	if it.isBackground() && parallel > it.backgroundThreads() {
+		return it.backgroundThreads()
+	}
+	return parallel
+}
+
+// applyBackgroundThreads caps the number of knowhere build threads in the build params of the background job to
+// the thread budget.
+func (it *indexBuildTask) applyBackgroundThreads(ctx context.Context, buildParams map[string]string) {
+	if !it.isBackground() {
+		return
+	}
+	numThreads, err := strconv.Atoi(buildParams[indexparams.NumBuildThreadKey])
+	if err == nil && numThreads <= it.backgroundThreads() {
+		return
+	}
+	buildParams[indexparams.NumBuildThreadKey] = strconv.Itoa(it.backgroundThreads())
+	log.Ctx(ctx).Info("cap the build threads of the background job", zap.Int64("buildID", it.BuildID),
+		zap.Int("threads", it.backgroundThreads()))
+}
+
+// deprioritizeBuildThread lowers the cpu and io priority of the build thread of the background job, the build
+// threads knowhere starts from it inherit the priority. The priority can't be raised back without privileges,
+// so the goroutine stays locked to the thread, which exits with the goroutine of the task instead of running
+// other goroutines at the lowered priority.
+func (it *indexBuildTask) deprioritizeBuildThread(ctx context.Context) {
+	if !it.isBackground() {
+		return
+	}
+	runtime.LockOSThread()
+	if err := setThreadPriority(it.node.params.IndexNodeCfg.BackgroundNice.GetAsInt()); err != nil {
+		log.Ctx(ctx).Warn("IndexNode failed to lower the priority of the background build thread",
+			zap.Int64("buildID", it.BuildID), zap.Error(err))
+		runtime.UnlockOSThread()
+	}
+}
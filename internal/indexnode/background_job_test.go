@@ -0,0 +1,59 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/indexparams"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestCheckJobClass(t *testing.T) {
+	assert.NoError(t, checkJobClass(&indexpb.CreateJobRequest{}))
+	assert.NoError(t, checkJobClass(&indexpb.CreateJobRequest{JobClass: backgroundJobClass}))
+	assert.Error(t, checkJobClass(&indexpb.CreateJobRequest{JobClass: "batch"}))
+}
+
+func TestBackgroundJobBudget(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	ctx := context.Background()
+	it := &indexBuildTask{
+		node: &IndexNode{params: params},
+		req:  &indexpb.CreateJobRequest{},
+	}
+	buildParams := map[string]string{indexparams.NumBuildThreadKey: "8"}
+	assert.Equal(t, 16, it.clampParallel(16))
+	it.applyBackgroundThreads(ctx, buildParams)
+	assert.Equal(t, "8", buildParams[indexparams.NumBuildThreadKey])
+
+	it.req.JobClass = backgroundJobClass
+	assert.Equal(t, 1, it.clampParallel(16))
+	it.applyBackgroundThreads(ctx, buildParams)
+	assert.Equal(t, "1", buildParams[indexparams.NumBuildThreadKey])
+
+	params.Save(params.IndexNodeCfg.BackgroundBuildThreads.Key, "4")
+	assert.Equal(t, 4, it.clampParallel(16))
+	assert.Equal(t, 2, it.clampParallel(2))
+	// the fewer threads are kept.
+	it.applyBackgroundThreads(ctx, buildParams)
+	assert.Equal(t, "1", buildParams[indexparams.NumBuildThreadKey])
+}
@@ -0,0 +1,83 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// binlogTimeRange returns the timestamp range of the rows of the binlog recorded in its descriptor event.
+func binlogTimeRange(blob *Blob) (typeutil.Timestamp, typeutil.Timestamp, error) {
+	reader, err := storage.NewBinlogReader(blob.Value)
+	if err != nil {
+		return 0, 0, fmt.Errorf("read the descriptor of binlog %s failed: %w", blob.Key, err)
+	}
+	defer reader.Close()
+	return reader.StartTimestamp, reader.EndTimestamp, nil
+}
+
+// deserializeBinlogs decodes the binlogs of the job, the binlogs flushed after the data timestamp of the job
+// are left out.
+func (it *indexBuildTask) deserializeBinlogs(ctx context.Context, blobs []*Blob) (UniqueID, UniqueID, UniqueID, *storage.InsertData, error) {
+	pinned, err := it.pinBinlogs(ctx, blobs)
+	if err != nil {
+		return 0, 0, 0, nil, err
+	}
+	var insertCodec storage.InsertCodec
+	return insertCodec.DeserializeAll(pinned)
+}
+
+// pinBinlogs returns the binlogs flushed at or before the data timestamp of the job, the binlogs flushed after it
+// are left out. A binlog spanning the timestamp fails the job, as its rows can't be told apart without
+// the timestamp field, and retrying the job on the same binlogs would fail again.
+func (it *indexBuildTask) pinBinlogs(ctx context.Context, blobs []*Blob) ([]*Blob, error) {
+	dataTs := it.req.GetDataTimestamp()
+	if dataTs == 0 {
+		return blobs, nil
+	}
+	pinned := make([]*Blob, 0, len(blobs))
+	for _, blob := range blobs {
+		start, end, err := binlogTimeRange(blob)
+		if err != nil {
+			return nil, err
+		}
+		if end <= dataTs {
+			pinned = append(pinned, blob)
+		} else if start <= dataTs {
+			it.node.storeTaskFailCode(it.ClusterID, it.BuildID, commonpb.ErrorCode_IllegalRowRecord)
+			return nil, fmt.Errorf("%w: binlog %s of the timestamps [%d, %d] spans the data timestamp %d of the job",
+				errDataMismatch, blob.Key, start, end, dataTs)
+		}
+	}
+	if len(pinned) == 0 {
+		it.node.storeTaskFailCode(it.ClusterID, it.BuildID, commonpb.ErrorCode_IllegalRowRecord)
+		return nil, fmt.Errorf("%w: no binlog is flushed at or before the data timestamp %d of the job", errDataMismatch, dataTs)
+	}
+	if len(pinned) < len(blobs) {
+		log.Ctx(ctx).Info("IndexNode skip the binlogs flushed after the data timestamp", zap.Int64("buildID", it.BuildID),
+			zap.Uint64("dataTimestamp", dataTs), zap.Int("skipped", len(blobs)-len(pinned)))
+	}
+	return pinned, nil
+}
@@ -0,0 +1,103 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// genFlushBinlog serializes a binlog of the vectors flushed at the timestamps, one row per timestamp.
+func genFlushBinlog(t *testing.T, key string, timestamps ...int64) *Blob {
+	insertCodec := storage.NewInsertCodec(&etcdpb.CollectionMeta{
+		ID: 1,
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: testVectorFieldID, Name: "vec", DataType: schemapb.DataType_FloatVector},
+			},
+		},
+	})
+	blobs, _, err := insertCodec.Serialize(2, 3, &storage.InsertData{
+		Data: map[storage.FieldID]storage.FieldData{
+			common.TimeStampField: &storage.Int64FieldData{Data: timestamps},
+			testVectorFieldID:     &storage.FloatVectorFieldData{Data: make([]float32, len(timestamps)), Dim: 1},
+		},
+	})
+	require.NoError(t, err)
+	blobs[0].Key = key
+	return blobs[0]
+}
+
+func TestPinBinlogs(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	ctx := context.Background()
+	node := &IndexNode{
+		params:   params,
+		reporter: newJobResultReporter(params),
+	}
+	node.loadOrStoreTask("cluster", 1, &taskInfo{phase: taskLoading})
+	it := &indexBuildTask{
+		ClusterID: "cluster",
+		BuildID:   1,
+		node:      node,
+		req:       &indexpb.CreateJobRequest{ClusterID: "cluster", BuildID: 1},
+	}
+	blobs := []*Blob{
+		genFlushBinlog(t, "3/1", 100, 110),
+		genFlushBinlog(t, "3/2", 200, 210),
+		genFlushBinlog(t, "3/3", 300, 310),
+	}
+
+	start, end, err := binlogTimeRange(blobs[1])
+	require.NoError(t, err)
+	assert.Equal(t, uint64(200), start)
+	assert.Equal(t, uint64(210), end)
+	_, _, err = binlogTimeRange(&Blob{Key: "bad", Value: []byte("bad")})
+	assert.Error(t, err)
+
+	pinned, err := it.pinBinlogs(ctx, blobs)
+	require.NoError(t, err)
+	assert.Equal(t, blobs, pinned)
+
+	it.req.DataTimestamp = 210
+	pinned, err = it.pinBinlogs(ctx, blobs)
+	require.NoError(t, err)
+	assert.Equal(t, blobs[:2], pinned)
+
+	_, _, _, insertData, err := it.deserializeBinlogs(ctx, blobs)
+	require.NoError(t, err)
+	assert.Equal(t, 4, insertData.Data[testVectorFieldID].RowNum())
+
+	it.req.DataTimestamp = 305
+	_, err = it.pinBinlogs(ctx, blobs)
+	assert.True(t, errors.Is(err, errDataMismatch))
+
+	it.req.DataTimestamp = 50
+	_, err = it.pinBinlogs(ctx, blobs)
+	assert.True(t, errors.Is(err, errDataMismatch))
+}
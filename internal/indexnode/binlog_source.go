@@ -0,0 +1,145 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/metautil"
+)
+
+// binlogReaders keeps the BinlogReader of each DataNode the binlogs are streamed from.
+type binlogReaders struct {
+	mu      sync.Mutex
+	creator func(ctx context.Context, addr string) (types.BinlogReader, error)
+	readers map[string]types.BinlogReader
+}
+
+func newBinlogReaders(creator func(ctx context.Context, addr string) (types.BinlogReader, error)) *binlogReaders {
+	return &binlogReaders{
+		creator: creator,
+		readers: make(map[string]types.BinlogReader),
+	}
+}
+
+func (r *binlogReaders) get(ctx context.Context, addr string) (types.BinlogReader, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if reader, ok := r.readers[addr]; ok {
+		return reader, nil
+	}
+	reader, err := r.creator(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	r.readers[addr] = reader
+	return reader, nil
+}
+
+// drop stops the reader of addr, so the next stream from the DataNode reconnects.
+func (r *binlogReaders) drop(addr string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if reader, ok := r.readers[addr]; ok {
+		reader.Stop()
+		delete(r.readers, addr)
+	}
+}
+
+func (r *binlogReaders) close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for addr, reader := range r.readers {
+		reader.Stop()
+		delete(r.readers, addr)
+	}
+}
+
+// SetBinlogReaderCreator sets the creator of the readers streaming the binlogs from the DataNodes.
+func (i *IndexNode) SetBinlogReaderCreator(creator func(ctx context.Context, addr string) (types.BinlogReader, error)) {
+	i.binlogReaders = newBinlogReaders(creator)
+}
+
+// streamBinlogs reads the binlogs of paths still kept by the DataNode which wrote them, see binlog_source of
+// the job. It's best effort, the binlogs not returned are read from the object storage by the caller.
+func (it *indexBuildTask) streamBinlogs(ctx context.Context, paths []string) map[string][]byte {
+	addr := it.req.GetBinlogSource()
+	if addr == "" || len(paths) == 0 || it.node.binlogReaders == nil ||
+		!it.node.params.IndexNodeCfg.BinlogSourceEnable.GetAsBool() {
+		return nil
+	}
+	logger := log.Ctx(ctx).With(zap.Int64("buildID", it.BuildID), zap.String("binlogSource", addr))
+	// the segment is only known once the binlogs are decoded, it's told by the path for the logs of DataNode.
+	segmentID := metautil.GetSegmentIDFromInsertLogPath(paths[0])
+	binlogs, err := readBinlogs(ctx, it.node.binlogReaders, addr, segmentID, paths,
+		it.node.params.IndexNodeCfg.BinlogSourceTimeout.GetAsDuration(time.Second))
+	if err != nil {
+		logger.Warn("failed to stream the binlogs from DataNode, read them from the object storage",
+			zap.Int("streamed", len(binlogs)), zap.Error(err))
+	} else {
+		logger.Info("binlogs streamed from DataNode", zap.Int("streamed", len(binlogs)), zap.Int("total", len(paths)))
+	}
+	return binlogs
+}
+
+// readBinlogs returns the binlogs received before an error, each message of the stream is a whole binlog.
+func readBinlogs(ctx context.Context, readers *binlogReaders, addr string, segmentID UniqueID, paths []string,
+	timeout time.Duration,
+) (map[string][]byte, error) {
+	reader, err := readers.get(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	stream, err := reader.ReadBinlogs(ctx, &datapb.ReadBinlogsRequest{SegmentID: segmentID, Paths: paths})
+	if err != nil {
+		readers.drop(addr)
+		return nil, err
+	}
+	requested := make(map[string]struct{}, len(paths))
+	for _, path := range paths {
+		requested[path] = struct{}{}
+	}
+	binlogs := make(map[string][]byte)
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return binlogs, nil
+		}
+		if err != nil {
+			return binlogs, err
+		}
+		if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return binlogs, fmt.Errorf("DataNode failed to read binlogs: %s", resp.GetStatus().GetReason())
+		}
+		if _, ok := requested[resp.GetPath()]; ok {
+			binlogs[resp.GetPath()] = resp.GetValue()
+		}
+	}
+}
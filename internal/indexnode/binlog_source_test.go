@@ -0,0 +1,140 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+type mockReadBinlogsClient struct {
+	grpc.ClientStream
+	responses []*datapb.ReadBinlogsResponse
+	err       error
+}
+
+func (c *mockReadBinlogsClient) Recv() (*datapb.ReadBinlogsResponse, error) {
+	if len(c.responses) == 0 {
+		if c.err != nil {
+			return nil, c.err
+		}
+		return nil, io.EOF
+	}
+	resp := c.responses[0]
+	c.responses = c.responses[1:]
+	return resp, nil
+}
+
+type mockBinlogReader struct {
+	stream  *mockReadBinlogsClient
+	err     error
+	stopped bool
+}
+
+func (r *mockBinlogReader) ReadBinlogs(ctx context.Context, req *datapb.ReadBinlogsRequest) (datapb.DataNode_ReadBinlogsClient, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.stream, nil
+}
+
+func (r *mockBinlogReader) Stop() error {
+	r.stopped = true
+	return nil
+}
+
+func binlogResponse(path string, value string) *datapb.ReadBinlogsResponse {
+	return &datapb.ReadBinlogsResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Path:   path,
+		Value:  []byte(value),
+	}
+}
+
+func TestStreamBinlogs(t *testing.T) {
+	ctx := context.Background()
+	params := paramtable.Get().Namespace()
+	reader := &mockBinlogReader{}
+	created := 0
+	node := &IndexNode{params: params}
+	node.SetBinlogReaderCreator(func(ctx context.Context, addr string) (types.BinlogReader, error) {
+		created++
+		return reader, nil
+	})
+	paths := []string{"files/insert_log/1/2/3/100/1", "files/insert_log/1/2/3/100/2"}
+	newTask := func(source string) *indexBuildTask {
+		return &indexBuildTask{node: node, req: &indexpb.CreateJobRequest{BuildID: 1, DataPaths: paths, BinlogSource: source}}
+	}
+
+	reader.stream = &mockReadBinlogsClient{responses: []*datapb.ReadBinlogsResponse{binlogResponse(paths[0], "a")}}
+	assert.Nil(t, newTask("localhost:21124").streamBinlogs(ctx, paths))
+
+	params.Save(params.IndexNodeCfg.BinlogSourceEnable.Key, "true")
+	assert.Nil(t, newTask("").streamBinlogs(ctx, paths))
+
+	t.Run("partial", func(t *testing.T) {
+		reader.stream = &mockReadBinlogsClient{responses: []*datapb.ReadBinlogsResponse{
+			binlogResponse(paths[1], "b"),
+			binlogResponse("files/insert_log/1/2/3/100/3", "c"),
+		}}
+		binlogs := newTask("localhost:21124").streamBinlogs(ctx, paths)
+		assert.Equal(t, map[string][]byte{paths[1]: []byte("b")}, binlogs)
+	})
+
+	t.Run("broken stream", func(t *testing.T) {
+		reader.stream = &mockReadBinlogsClient{
+			responses: []*datapb.ReadBinlogsResponse{binlogResponse(paths[0], "a")},
+			err:       errors.New("connection reset"),
+		}
+		binlogs := newTask("localhost:21124").streamBinlogs(ctx, paths)
+		assert.Equal(t, map[string][]byte{paths[0]: []byte("a")}, binlogs)
+	})
+
+	t.Run("unhealthy", func(t *testing.T) {
+		reader.stream = &mockReadBinlogsClient{responses: []*datapb.ReadBinlogsResponse{{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "unhealthy"},
+		}}}
+		_, err := readBinlogs(ctx, node.binlogReaders, "localhost:21124", 3, paths, time.Second)
+		assert.Error(t, err)
+	})
+	assert.Equal(t, 1, created)
+
+	t.Run("reconnect", func(t *testing.T) {
+		reader.err = errors.New("unavailable")
+		assert.Empty(t, newTask("localhost:21124").streamBinlogs(ctx, paths))
+		assert.True(t, reader.stopped)
+		reader.err = nil
+		reader.stream = &mockReadBinlogsClient{}
+		assert.Empty(t, newTask("localhost:21124").streamBinlogs(ctx, paths))
+		assert.Equal(t, 2, created)
+	})
+
+	node.binlogReaders.close()
+	assert.Empty(t, node.binlogReaders.readers)
+}
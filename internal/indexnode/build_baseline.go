@@ -0,0 +1,183 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+// buildBaselineWindow is the number of recent builds the throughput of an index type and dim class is computed over.
+const buildBaselineWindow = 100
+
+// dimClasses are the upper bounds of the dim classes, the builds of a dim class have a comparable throughput.
+var dimClasses = []int64{128, 512, 1024}
+
+// dimClass returns the dim class of the dim, e.g. "129-512".
+func dimClass(dim int64) string {
+	return rangeClass(dim, dimClasses)
+}
+
+// rangeClass returns the class of the value among the classes bounded by uppers, e.g. "129-512".
+func rangeClass(value int64, uppers []int64) string {
+	lower := int64(1)
+	for _, upper := range uppers {
+		if value <= upper {
+			return strconv.FormatInt(lower, 10) + "-" + strconv.FormatInt(upper, 10)
+		}
+		lower = upper + 1
+	}
+	return strconv.FormatInt(lower, 10) + "+"
+}
+
+// dimClassLower returns the lower bound of the dim class.
+func dimClassLower(class string) int64 {
+	lower, _ := strconv.ParseInt(strings.TrimSuffix(strings.SplitN(class, "-", 2)[0], "+"), 10, 64)
+	return lower
+}
+
+type buildBaselineKey struct {
+	indexType string
+	dimClass  string
+}
+
+type buildSample struct {
+	rows     int64
+	bytes    uint64
+	duration time.Duration
+}
+
+// buildSamples keeps the last buildBaselineWindow builds.
+type buildSamples struct {
+	values []buildSample
+	next   int
+}
+
+func (s *buildSamples) add(sample buildSample) {
+	if len(s.values) < buildBaselineWindow {
+		s.values = append(s.values, sample)
+		return
+	}
+	s.values[s.next] = sample
+	s.next = (s.next + 1) % buildBaselineWindow
+}
+
+// throughput returns the rows and bytes built per second over the samples.
+func (s *buildSamples) throughput() (float64, float64) {
+	var rows int64
+	var bytes uint64
+	var duration time.Duration
+	for _, sample := range s.values {
+		rows += sample.rows
+		bytes += sample.bytes
+		duration += sample.duration
+	}
+	if duration <= 0 {
+		return 0, 0
+	}
+	return float64(rows) / duration.Seconds(), float64(bytes) / duration.Seconds()
+}
+
+// buildBaselines keeps the empirical build throughput of every index type and dim class built by the node,
+// the cost model of the scheduler estimates the build time of the jobs by them.
+type buildBaselines struct {
+	mu      sync.Mutex
+	samples map[buildBaselineKey]*buildSamples
+}
+
+func newBuildBaselines() *buildBaselines {
+	return &buildBaselines{samples: make(map[buildBaselineKey]*buildSamples)}
+}
+
+// observe records the build of rows vectors of dim of the index type.
+func (b *buildBaselines) observe(indexType string, dim, rows int64, duration time.Duration) {
+	if b == nil || indexType == "" || dim <= 0 || rows <= 0 || duration <= 0 {
+		return
+	}
+	key := buildBaselineKey{indexType: indexType, dimClass: dimClass(dim)}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	samples, ok := b.samples[key]
+	if !ok {
+		samples = &buildSamples{}
+		b.samples[key] = samples
+	}
+	samples.add(buildSample{rows: rows, bytes: rawVectorSize(indexType, dim, rows), duration: duration})
+}
+
+// estimateBuild estimates the time to build rows vectors of dim of the index type, it returns false if
+// the node has not built the index type and dim class yet.
+func (b *buildBaselines) estimateBuild(indexType string, dim, rows int64) (time.Duration, bool) {
+	if b == nil || rows <= 0 {
+		return 0, false
+	}
+	key := buildBaselineKey{indexType: indexType, dimClass: dimClass(dim)}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	samples, ok := b.samples[key]
+	if !ok {
+		return 0, false
+	}
+	rowsPerSecond, _ := samples.throughput()
+	if rowsPerSecond <= 0 {
+		return 0, false
+	}
+	return time.Duration(float64(rows) / rowsPerSecond * float64(time.Second)), true
+}
+
+// estimateJob estimates the build time of the job by its index type, dim and row count.
+func (b *buildBaselines) estimateJob(req *indexpb.CreateJobRequest) (time.Duration, bool) {
+	dim, err := strconv.ParseInt(funcutil.KeyValuePair2Map(req.GetTypeParams())["dim"], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return b.estimateBuild(funcutil.KeyValuePair2Map(req.GetIndexParams())["index_type"], dim, req.GetNumRows())
+}
+
+// report returns the baselines sorted by the index type and the dim class.
+func (b *buildBaselines) report() []metricsinfo.IndexNodeBuildBaseline {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	baselines := make([]metricsinfo.IndexNodeBuildBaseline, 0, len(b.samples))
+	for key, samples := range b.samples {
+		rowsPerSecond, bytesPerSecond := samples.throughput()
+		baselines = append(baselines, metricsinfo.IndexNodeBuildBaseline{
+			IndexType:     key.indexType,
+			DimClass:      key.dimClass,
+			Builds:        len(samples.values),
+			RowsPerSecond: rowsPerSecond,
+			GBPerSecond:   bytesPerSecond / (1 << 30),
+		})
+	}
+	sort.Slice(baselines, func(i, j int) bool {
+		if baselines[i].IndexType != baselines[j].IndexType {
+			return baselines[i].IndexType < baselines[j].IndexType
+		}
+		return dimClassLower(baselines[i].DimClass) < dimClassLower(baselines[j].DimClass)
+	})
+	return baselines
+}
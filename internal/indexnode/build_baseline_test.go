@@ -0,0 +1,96 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestDimClass(t *testing.T) {
+	assert.Equal(t, "1-128", dimClass(8))
+	assert.Equal(t, "1-128", dimClass(128))
+	assert.Equal(t, "129-512", dimClass(129))
+	assert.Equal(t, "513-1024", dimClass(768))
+	assert.Equal(t, "1025+", dimClass(4096))
+	assert.Equal(t, int64(1025), dimClassLower("1025+"))
+	assert.Equal(t, int64(129), dimClassLower("129-512"))
+}
+
+func TestBuildBaselines(t *testing.T) {
+	b := newBuildBaselines()
+	_, ok := b.estimateBuild("IVF_FLAT", 128, 1000)
+	assert.False(t, ok)
+
+	b.observe("IVF_FLAT", 128, 1000, time.Second)
+	b.observe("IVF_FLAT", 64, 3000, time.Second)
+	b.observe("IVF_FLAT", 768, 1000, 4*time.Second)
+	b.observe("HNSW", 128, 1000, 0)
+
+	// 4000 rows in 2 seconds.
+	build, ok := b.estimateBuild("IVF_FLAT", 100, 10000)
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Second, build)
+	build, ok = b.estimateJob(&indexpb.CreateJobRequest{
+		NumRows:     500,
+		TypeParams:  []*commonpb.KeyValuePair{{Key: "dim", Value: "768"}},
+		IndexParams: []*commonpb.KeyValuePair{{Key: "index_type", Value: "IVF_FLAT"}},
+	})
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Second, build)
+	_, ok = b.estimateBuild("HNSW", 128, 1000)
+	assert.False(t, ok)
+
+	baselines := b.report()
+	assert.Len(t, baselines, 2)
+	assert.Equal(t, "1-128", baselines[0].DimClass)
+	assert.Equal(t, 2, baselines[0].Builds)
+	assert.Equal(t, 2000.0, baselines[0].RowsPerSecond)
+	assert.Equal(t, "513-1024", baselines[1].DimClass)
+	assert.InDelta(t, 1000.0*768*4/4/(1<<30), baselines[1].GBPerSecond, 1e-9)
+
+	var nilBaselines *buildBaselines
+	nilBaselines.observe("IVF_FLAT", 128, 1000, time.Second)
+	assert.Nil(t, nilBaselines.report())
+}
+
+func TestSchedulerMeanCost(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	sched := NewTaskScheduler(context.TODO(), params)
+	assert.Equal(t, time.Duration(0), sched.meanCost())
+	sched.waits.observeRun(time.Minute)
+	assert.Equal(t, time.Minute, sched.meanCost())
+
+	sched.baselines.observe("IVF_FLAT", 128, 1000, time.Second)
+	known := &indexBuildTask{ident: "known", req: &indexpb.CreateJobRequest{
+		NumRows:     30000,
+		TypeParams:  []*commonpb.KeyValuePair{{Key: "dim", Value: "128"}},
+		IndexParams: []*commonpb.KeyValuePair{{Key: "index_type", Value: "IVF_FLAT"}},
+	}}
+	unknown := &indexBuildTask{ident: "unknown", req: &indexpb.CreateJobRequest{}}
+	assert.NoError(t, sched.IndexBuildQueue.addUnissuedTask(known))
+	sched.IndexBuildQueue.AddActiveTask(unknown)
+	// the known task takes 30 seconds, the unknown one the mean run time.
+	assert.Equal(t, 45*time.Second, sched.meanCost())
+}
@@ -0,0 +1,166 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/indexcgowrapper"
+)
+
+// defaultEngineVersion is the version of the knowhere engine linked into IndexNode,
+// it's used by the jobs which do not pin an engine version.
+const defaultEngineVersion = "knowhere"
+
+// BuildEngine builds the index of one field of a segment, a new engine is created for every index build task.
+type BuildEngine interface {
+	// Train trains the index on the dataset, engines which need no training do nothing.
+	Train(dataset *indexcgowrapper.Dataset) error
+	// Add inserts the dataset into the trained index.
+	Add(dataset *indexcgowrapper.Dataset) error
+	// Serialize returns the index files of the built index.
+	Serialize() ([]*storage.Blob, error)
+	// Delete releases the resources held by the engine, it must be idempotent.
+	Delete() error
+}
+
+// ChunkedSerializer is implemented by the build engines able to serialize the index one file at a time,
+// IndexNode then uploads every file before the next one is serialized.
+type ChunkedSerializer interface {
+	// SerializeEach passes the index files of the built index to fn one by one.
+	SerializeEach(fn func(blob *storage.Blob) error) error
+}
+
+// IndexLoader is implemented by the build engines able to load the index files of a built index back,
+// VerifyIndex loads the verified index with it.
+type IndexLoader interface {
+	Load(blobs []*storage.Blob) error
+}
+
+// SanityQuerier is implemented by the build engines able to query the loaded index for its sanity,
+// VerifyIndex runs the queries after loading the verified index.
+type SanityQuerier interface {
+	SanityQuery() error
+}
+
+// BuildEngineFactory creates the build engine of a task.
+type BuildEngineFactory func(dType schemapb.DataType, typeParams, indexParams map[string]string,
+	config *indexpb.StorageConfig) (BuildEngine, error)
+
+var (
+	buildEnginesMu sync.RWMutex
+	buildEngines   = map[string]BuildEngineFactory{
+		defaultEngineVersion: newKnowhereEngine,
+	}
+)
+
+// RegisterBuildEngine makes a build engine selectable by the engine version of CreateJob,
+// registering an existing version replaces it.
+func RegisterBuildEngine(version string, factory BuildEngineFactory) {
+	buildEnginesMu.Lock()
+	defer buildEnginesMu.Unlock()
+	buildEngines[version] = factory
+}
+
+// getBuildEngineFactory returns the build engine factory of the engine version, empty version means the default engine.
+func getBuildEngineFactory(version string) (BuildEngineFactory, error) {
+	if version == "" {
+		version = defaultEngineVersion
+	}
+	buildEnginesMu.RLock()
+	defer buildEnginesMu.RUnlock()
+	factory, ok := buildEngines[version]
+	if !ok {
+		return nil, fmt.Errorf("engine version %s is not supported", version)
+	}
+	return factory, nil
+}
+
+// supportedEngineVersions returns the sorted engine versions IndexNode is able to build with.
+func supportedEngineVersions() []string {
+	buildEnginesMu.RLock()
+	defer buildEnginesMu.RUnlock()
+	versions := make([]string, 0, len(buildEngines))
+	for version := range buildEngines {
+		versions = append(versions, version)
+	}
+	sort.Strings(versions)
+	return versions
+}
+
+// knowhereEngine is the default build engine backed by the knowhere library through CGO.
+type knowhereEngine struct {
+	index indexcgowrapper.CodecIndex
+}
+
+var (
+	_ BuildEngine       = (*knowhereEngine)(nil)
+	_ ChunkedSerializer = (*knowhereEngine)(nil)
+	_ IndexLoader       = (*knowhereEngine)(nil)
+)
+
+func newKnowhereEngine(dType schemapb.DataType, typeParams, indexParams map[string]string,
+	config *indexpb.StorageConfig) (BuildEngine, error) {
+	index, err := indexcgowrapper.NewCgoIndex(dType, typeParams, indexParams, config)
+	if err != nil {
+		return nil, err
+	}
+	return &knowhereEngine{index: index}, nil
+}
+
+// Train does nothing, knowhere trains the index while building it in Add.
+func (e *knowhereEngine) Train(dataset *indexcgowrapper.Dataset) error {
+	return nil
+}
+
+func (e *knowhereEngine) Add(dataset *indexcgowrapper.Dataset) error {
+	return e.index.Build(dataset)
+}
+
+func (e *knowhereEngine) Serialize() ([]*storage.Blob, error) {
+	return e.index.Serialize()
+}
+
+func (e *knowhereEngine) Load(blobs []*storage.Blob) error {
+	return e.index.Load(blobs)
+}
+
+func (e *knowhereEngine) Delete() error {
+	return e.index.Delete()
+}
+
+// SerializeEach serializes the index one file at a time if the knowhere index supports it.
+func (e *knowhereEngine) SerializeEach(fn func(blob *storage.Blob) error) error {
+	if serializer, ok := e.index.(ChunkedSerializer); ok {
+		return serializer.SerializeEach(fn)
+	}
+	blobs, err := e.index.Serialize()
+	if err != nil {
+		return err
+	}
+	for _, blob := range blobs {
+		if err := fn(blob); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/indexcgowrapper"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+type mockBuildEngine struct {
+	trained  bool
+	added    bool
+	trainErr error
+}
+
+func (e *mockBuildEngine) Train(dataset *indexcgowrapper.Dataset) error {
+	e.trained = true
+	return e.trainErr
+}
+
+func (e *mockBuildEngine) Add(dataset *indexcgowrapper.Dataset) error {
+	e.added = true
+	return nil
+}
+
+func (e *mockBuildEngine) Serialize() ([]*storage.Blob, error) {
+	return []*storage.Blob{{Key: "index", Value: []byte("index")}}, nil
+}
+
+func (e *mockBuildEngine) Delete() error {
+	return nil
+}
+
+func TestBuildEngineRegistry(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	factory, err := getBuildEngineFactory("")
+	assert.NoError(t, err)
+	assert.NotNil(t, factory)
+
+	_, err = getBuildEngineFactory("mock")
+	assert.Error(t, err)
+
+	RegisterBuildEngine("mock", func(dType schemapb.DataType, typeParams, indexParams map[string]string,
+		config *indexpb.StorageConfig) (BuildEngine, error) {
+		return nil, errors.New("mock engine")
+	})
+	defer func() {
+		buildEnginesMu.Lock()
+		delete(buildEngines, "mock")
+		buildEnginesMu.Unlock()
+	}()
+	factory, err = getBuildEngineFactory("mock")
+	assert.NoError(t, err)
+	_, err = factory(schemapb.DataType_FloatVector, nil, nil, nil)
+	assert.Error(t, err)
+	assert.Equal(t, []string{defaultEngineVersion, "mock"}, supportedEngineVersions())
+	assert.Equal(t, supportedEngineVersions(), getCapabilities(params).GetEngineVersions())
+}
+
+func TestBuildWithEngine(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	ctx := context.Background()
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	newTask := func(engine BuildEngine) *indexBuildTask {
+		return &indexBuildTask{
+			node:           &IndexNode{params: params},
+			cm:             cm,
+			engine:         engine,
+			collectionID:   1,
+			partitionID:    2,
+			fieldID:        100,
+			newTypeParams:  map[string]string{"dim": "8"},
+			newIndexParams: map[string]string{"index_type": "IVF_PQ", "nlist": "16", "m": "4"},
+		}
+	}
+
+	t.Run("train and add", func(t *testing.T) {
+		engine := &mockBuildEngine{}
+		assert.NoError(t, newTask(engine).buildWithEngine(ctx, nil))
+		assert.True(t, engine.trained)
+		assert.True(t, engine.added)
+	})
+
+	t.Run("train failed", func(t *testing.T) {
+		engine := &mockBuildEngine{trainErr: errors.New("mock")}
+		assert.Error(t, newTask(engine).buildWithEngine(ctx, nil))
+		assert.False(t, engine.added)
+	})
+}
@@ -0,0 +1,111 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"plugin"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// buildHookSymbol is the symbol of the BuildHook exported by the plugin of indexNode.hook.soPath.
+const buildHookSymbol = "MilvusBuildHook"
+
+// ErrBuildRejected fails the task without retrying when it's wrapped by the error of a BuildHook,
+// the other errors of the hooks are retried like the errors of the stages.
+var ErrBuildRejected = errors.New("index build rejected by hook")
+
+// BuildStageInfo describes the stage of the build task the hooks run around.
+type BuildStageInfo struct {
+	// Task is the name of the task, ClusterID/BuildID for the index build tasks.
+	Task      string
+	ClusterID string
+	// Stage is one of Preparing, Loading, Building and Saving.
+	Stage string
+}
+
+// BuildHook runs custom logic before and after every stage of the build tasks, such as policy checks,
+// custom metrics or signing the index files.
+type BuildHook interface {
+	// Before runs before the stage, the stage doesn't run if it returns an error.
+	Before(ctx context.Context, info BuildStageInfo) error
+	// After runs after the stage with the error of the stage, the error it returns replaces the one of the stage.
+	After(ctx context.Context, info BuildStageInfo, err error) error
+}
+
+var (
+	buildHooksMu sync.RWMutex
+	buildHooks   []BuildHook
+)
+
+// RegisterBuildHook adds the hook to the chain of the build stages, it must be called before the node starts.
+// The hooks registered first run outermost, their Before runs first and their After runs last.
+func RegisterBuildHook(hook BuildHook) {
+	buildHooksMu.Lock()
+	defer buildHooksMu.Unlock()
+	buildHooks = append(buildHooks, hook)
+}
+
+func getBuildHooks() []BuildHook {
+	buildHooksMu.RLock()
+	defer buildHooksMu.RUnlock()
+	return buildHooks
+}
+
+// loadBuildHookPlugin registers the hook exported by the plugin of indexNode.hook.soPath.
+func loadBuildHookPlugin(params *paramtable.ComponentParam) error {
+	path := params.IndexNodeCfg.HookSoPath.GetValue()
+	if path == "" {
+		return nil
+	}
+	log.Info("IndexNode load build hook plugin", zap.String("path", path))
+	p, err := plugin.Open(path)
+	if err != nil {
+		return fmt.Errorf("fail to open the build hook plugin %s: %w", path, err)
+	}
+	symbol, err := p.Lookup(buildHookSymbol)
+	if err != nil {
+		return fmt.Errorf("fail to find %s in the build hook plugin %s: %w", buildHookSymbol, path, err)
+	}
+	hook, ok := symbol.(BuildHook)
+	if !ok {
+		return fmt.Errorf("%s of the build hook plugin %s is not a BuildHook", buildHookSymbol, path)
+	}
+	RegisterBuildHook(hook)
+	return nil
+}
+
+// withBuildHooks wraps the stage with the hooks.
+func withBuildHooks(hooks []BuildHook, info BuildStageInfo, stage func(context.Context) error) func(context.Context) error {
+	for i := len(hooks) - 1; i >= 0; i-- {
+		hook, next := hooks[i], stage
+		stage = func(ctx context.Context) error {
+			if err := hook.Before(ctx, info); err != nil {
+				return err
+			}
+			return hook.After(ctx, info, next(ctx))
+		}
+	}
+	return stage
+}
@@ -0,0 +1,93 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+type recordHook struct {
+	name   string
+	calls  *[]string
+	reject string
+}
+
+func (h *recordHook) Before(ctx context.Context, info BuildStageInfo) error {
+	*h.calls = append(*h.calls, fmt.Sprintf("%s before %s", h.name, info.Stage))
+	if info.Stage == h.reject {
+		return fmt.Errorf("%w: %s", ErrBuildRejected, info.Task)
+	}
+	return nil
+}
+
+func (h *recordHook) After(ctx context.Context, info BuildStageInfo, err error) error {
+	*h.calls = append(*h.calls, fmt.Sprintf("%s after %s", h.name, info.Stage))
+	return err
+}
+
+func TestWithBuildHooks(t *testing.T) {
+	ctx := context.Background()
+	calls := make([]string, 0)
+	hooks := []BuildHook{&recordHook{name: "a", calls: &calls}, &recordHook{name: "b", calls: &calls}}
+	stageErr := errors.New("stage failed")
+	stage := func(ctx context.Context) error {
+		calls = append(calls, "stage")
+		return stageErr
+	}
+
+	err := withBuildHooks(hooks, BuildStageInfo{Stage: "Loading"}, stage)(ctx)
+	assert.Equal(t, stageErr, err)
+	assert.Equal(t, []string{"a before Loading", "b before Loading", "stage", "b after Loading", "a after Loading"}, calls)
+
+	calls = calls[:0]
+	hooks[1].(*recordHook).reject = "Loading"
+	err = withBuildHooks(hooks, BuildStageInfo{Stage: "Loading"}, stage)(ctx)
+	assert.True(t, errors.Is(err, ErrBuildRejected))
+	assert.Equal(t, []string{"a before Loading", "b before Loading", "a after Loading"}, calls)
+
+	assert.NoError(t, withBuildHooks(nil, BuildStageInfo{}, func(ctx context.Context) error { return nil })(ctx))
+}
+
+func TestBuildHookRejectTask(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	calls := make([]string, 0)
+	RegisterBuildHook(&recordHook{name: "policy", calls: &calls, reject: taskBuilding.String()})
+	defer func() {
+		buildHooks = nil
+	}()
+
+	scheduler := NewTaskScheduler(context.TODO(), params)
+	task := newTask(fakeTaskSavedIndexes, nil, commonpb.IndexState_Failed)
+	assert.NoError(t, task.OnEnqueue(task.Ctx()))
+	scheduler.processTask(task, scheduler.IndexBuildQueue)
+	assert.Equal(t, commonpb.IndexState_Failed, task.GetState())
+	assert.Equal(t, fakeTaskState(fakeTaskLoadedData), task.(*fakeTask).state)
+	assert.Equal(t, []string{"policy before Preparing", "policy after Preparing", "policy before Loading",
+		"policy after Loading", "policy before Building"}, calls)
+
+	assert.NoError(t, loadBuildHookPlugin(params))
+	params.Save(params.IndexNodeCfg.HookSoPath.Key, "/not/exist.so")
+	assert.Error(t, loadBuildHookPlugin(params))
+}
@@ -0,0 +1,84 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+var errBuildLimitExceeded = errors.New("build limit exceeded")
+
+// buildLimits are the max dimension and row count of the jobs the node accepts, 0 means no limit.
+type buildLimits struct {
+	MaxDim  int64 `json:"maxDim"`
+	MaxRows int64 `json:"maxRows"`
+}
+
+// indexTypeBuildLimits returns the build limits of the index type, the limits set for the index type
+// by indexNode.limits.indexTypes override indexNode.limits.maxDim and indexNode.limits.maxRows.
+func indexTypeBuildLimits(params *paramtable.ComponentParam, indexType string) buildLimits {
+	limits := buildLimits{
+		MaxDim:  params.IndexNodeCfg.LimitMaxDim.GetAsInt64(),
+		MaxRows: params.IndexNodeCfg.LimitMaxRows.GetAsInt64(),
+	}
+	value := params.IndexNodeCfg.LimitIndexTypes.GetValue()
+	indexTypes := make(map[string]json.RawMessage)
+	if err := json.Unmarshal([]byte(value), &indexTypes); err != nil {
+		log.Warn("IndexNode ignores invalid index type build limits", zap.String("value", value), zap.Error(err))
+		return limits
+	}
+	if raw, ok := indexTypes[indexType]; ok {
+		if err := json.Unmarshal(raw, &limits); err != nil {
+			log.Warn("IndexNode ignores invalid index type build limits", zap.String("indexType", indexType), zap.Error(err))
+		}
+	}
+	return limits
+}
+
+// checkBuildLimits rejects the jobs whose vector dimension or row count exceeds the build limits of the index type,
+// which would otherwise run for hours before failing or crash the allocator.
+func checkBuildLimits(params *paramtable.ComponentParam, req *indexpb.CreateJobRequest) error {
+	indexType := ""
+	for _, kv := range req.GetIndexParams() {
+		if kv.GetKey() == "index_type" {
+			indexType = kv.GetValue()
+		}
+	}
+	limits := indexTypeBuildLimits(params, indexType)
+	for _, kv := range req.GetTypeParams() {
+		if kv.GetKey() != "dim" {
+			continue
+		}
+		dim, err := strconv.ParseInt(kv.GetValue(), 10, 64)
+		if err == nil && limits.MaxDim > 0 && dim > limits.MaxDim {
+			return fmt.Errorf("%w: dim %d of %s index exceeds the max dim %d", errBuildLimitExceeded, dim, indexType, limits.MaxDim)
+		}
+	}
+	if numRows := req.GetNumRows(); limits.MaxRows > 0 && numRows > limits.MaxRows {
+		return fmt.Errorf("%w: %d rows of %s index exceed the max row count %d", errBuildLimitExceeded, numRows, indexType, limits.MaxRows)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestCheckBuildLimits(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	req := &indexpb.CreateJobRequest{
+		TypeParams:  []*commonpb.KeyValuePair{{Key: "dim", Value: "4096"}},
+		IndexParams: []*commonpb.KeyValuePair{{Key: "index_type", Value: indexparamcheck.IndexHNSW}},
+		NumRows:     100000000,
+	}
+	assert.NoError(t, checkBuildLimits(params, req))
+
+	req.TypeParams[0].Value = "65536"
+	err := checkBuildLimits(params, req)
+	assert.True(t, errors.Is(err, errBuildLimitExceeded))
+	assert.EqualError(t, err, "build limit exceeded: dim 65536 of HNSW index exceeds the max dim 32768")
+
+	req.TypeParams[0].Value = "4096"
+	params.Save(params.IndexNodeCfg.LimitMaxRows.Key, "10000000")
+	assert.True(t, errors.Is(checkBuildLimits(params, req), errBuildLimitExceeded))
+
+	// the limits of the index type override the node limits
+	params.Save(params.IndexNodeCfg.LimitIndexTypes.Key, `{"HNSW": {"maxDim": 1024, "maxRows": 200000000}}`)
+	assert.EqualError(t, checkBuildLimits(params, req), "build limit exceeded: dim 4096 of HNSW index exceeds the max dim 1024")
+	req.TypeParams[0].Value = "128"
+	assert.NoError(t, checkBuildLimits(params, req))
+	req.IndexParams[0].Value = indexparamcheck.IndexFaissIvfFlat
+	assert.True(t, errors.Is(checkBuildLimits(params, req), errBuildLimitExceeded))
+
+	params.Save(params.IndexNodeCfg.LimitIndexTypes.Key, "invalid")
+	assert.Equal(t, buildLimits{MaxDim: 32768, MaxRows: 10000000}, indexTypeBuildLimits(params, indexparamcheck.IndexHNSW))
+}
@@ -0,0 +1,203 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/cache"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// buildResult is the outcome of a finished build remembered by buildResultCache.
+type buildResult struct {
+	collectionID   UniqueID
+	savePaths      []string
+	fileKeys       []string
+	fileSizes      []uint64
+	serializedSize uint64
+	memSize        uint64
+	statistic      *indexpb.JobInfo
+}
+
+// buildResultCache remembers the files of the finished builds by the hash of their inputs, so a repeated
+// CreateJob of a finished build is answered with the existing files instead of building the index again.
+type buildResultCache struct {
+	results cache.Cache[string, *buildResult]
+}
+
+// newBuildResultCache returns nil if the cache is disabled.
+func newBuildResultCache(params *paramtable.ComponentParam) *buildResultCache {
+	if !params.IndexNodeCfg.BuildResultCacheEnable.GetAsBool() {
+		return nil
+	}
+	return &buildResultCache{
+		results: cache.NewCache[string, *buildResult](
+			cache.WithMaximumSize[string, *buildResult](params.IndexNodeCfg.BuildResultCacheCapacity.GetAsInt64()),
+			cache.WithExpireAfterWrite[string, *buildResult](params.IndexNodeCfg.BuildResultCacheTTL.GetAsDuration(time.Second))),
+	}
+}
+
+// buildResultKey hashes everything determining the index files of the job: the binlogs read, the index params,
+// the engine version and the location the files are saved to. The in-place rebuilds are never cached.
+func buildResultKey(req *indexpb.CreateJobRequest) string {
+	h := sha256.New()
+	writeField := func(name, value string) {
+		h.Write([]byte(name + "=" + value + "\n"))
+	}
+	writePaths := func(name string, paths []string) {
+		sorted := append([]string(nil), paths...)
+		sort.Strings(sorted)
+		for _, p := range sorted {
+			writeField(name, p+"@"+req.GetDataPathRoots()[p])
+		}
+	}
+	engineVersion := req.GetEngineVersion()
+	if engineVersion == "" {
+		engineVersion = defaultEngineVersion
+	}
+	writeField("cluster", req.GetClusterID())
+	writeField("bucket", req.GetStorageConfig().GetBucketName())
+	writeField("root", req.GetStorageConfig().GetRootPath())
+	writeField("build", strconv.FormatInt(req.GetBuildID(), 10))
+	writeField("version", strconv.FormatInt(req.GetIndexVersion(), 10))
+	writeField("engine", engineVersion)
+	writeField("timestamp", strconv.FormatUint(req.GetDataTimestamp(), 10))
+	writeField("pkOffsets", strconv.FormatBool(req.GetEmitPkOffsets()))
+	writePaths("data", req.GetDataPaths())
+	writePaths("pk", req.GetPkDataPaths())
+	writeField("params", hashBuildParams(funcutil.KeyValuePair2Map(req.GetTypeParams()),
+		funcutil.KeyValuePair2Map(req.GetIndexParams())))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// hashBuildParams returns the digest of the type params and the index params, independent of the map order.
+func hashBuildParams(typeParams, indexParams map[string]string) string {
+	h := sha256.New()
+	write := func(prefix string, params map[string]string) {
+		keys := make([]string, 0, len(params))
+		for key := range params {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			h.Write([]byte(prefix + key + "=" + params[key] + "\n"))
+		}
+	}
+	write("type.", typeParams)
+	write("index.", indexParams)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Put remembers the files saved by the job.
+func (c *buildResultCache) Put(req *indexpb.CreateJobRequest, result *buildResult) {
+	c.results.Put(buildResultKey(req), result)
+}
+
+// Lookup returns the result of an identical finished build whose files are all still in storage with
+// their recorded sizes, it returns nil otherwise.
+func (c *buildResultCache) Lookup(ctx context.Context, req *indexpb.CreateJobRequest, cm storage.ChunkManager) *buildResult {
+	if req.GetRebuildInPlace() || compactingIndex(req) {
+		return nil
+	}
+	key := buildResultKey(req)
+	result, ok := c.results.GetIfPresent(key)
+	if !ok {
+		return nil
+	}
+	if err := checkBuildResultFiles(ctx, cm, result); err != nil {
+		log.Ctx(ctx).Info("the files of the cached build result are gone", zap.String("ClusterID", req.GetClusterID()),
+			zap.Int64("BuildID", req.GetBuildID()), zap.Error(err))
+		c.results.Invalidate(key)
+		return nil
+	}
+	return result
+}
+
+func (c *buildResultCache) Close() {
+	c.results.Close()
+}
+
+func checkBuildResultFiles(ctx context.Context, cm storage.ChunkManager, result *buildResult) error {
+	for idx, savePath := range result.savePaths {
+		size, err := cm.Size(ctx, savePath)
+		if err != nil {
+			return err
+		}
+		if uint64(size) != result.fileSizes[idx] {
+			return fmt.Errorf("index file %s has size %d, expected %d", savePath, size, result.fileSizes[idx])
+		}
+	}
+	return nil
+}
+
+// rememberBuildResult records the files saved by the task in the build result cache if it's enabled.
+func (it *indexBuildTask) rememberBuildResult(savePaths, fileKeys []string, fileSizes []uint64) {
+	if it.node.buildResults == nil || it.rebuildVersion != 0 {
+		return
+	}
+	it.node.buildResults.Put(it.req, &buildResult{
+		collectionID:   it.collectionID,
+		savePaths:      append([]string(nil), savePaths...),
+		fileKeys:       append([]string(nil), fileKeys...),
+		fileSizes:      append([]uint64(nil), fileSizes...),
+		serializedSize: it.serializedSize,
+		memSize:        it.memSize,
+		statistic:      proto.Clone(&it.statistic).(*indexpb.JobInfo),
+	})
+}
+
+// answerFromBuildResult finishes the pending task with the files of an identical finished build,
+// it reports whether such a build was found, the task must not be built then.
+func (i *IndexNode) answerFromBuildResult(ctx context.Context, req *indexpb.CreateJobRequest, cm storage.ChunkManager) bool {
+	// the pre-signed urls of a job don't reach the files of the other builds.
+	if i.buildResults == nil || usesPresignedURLs(req) {
+		return false
+	}
+	result := i.buildResults.Lookup(ctx, req, cm)
+	if result == nil {
+		return false
+	}
+	i.storeTaskCollection(req.GetClusterID(), req.GetBuildID(), result.collectionID)
+	i.storeIndexFilesAndStatistic(req.GetClusterID(), req.GetBuildID(), result.fileKeys, result.fileSizes,
+		result.serializedSize, result.memSize, result.statistic)
+	// the task finishes early from preparing, like a task whose data is too small to build an index.
+	for _, phase := range []taskPhase{taskPreparing, taskFinished} {
+		if err := i.transitTaskPhase(req.GetClusterID(), req.GetBuildID(), phase, ""); err != nil {
+			// the task was dropped or aborted meanwhile.
+			log.Ctx(ctx).Warn("answer the task from the build result cache failed", zap.String("ClusterID", req.GetClusterID()),
+				zap.Int64("BuildID", req.GetBuildID()), zap.Error(err))
+			return true
+		}
+	}
+	log.Ctx(ctx).Info("IndexNode answered the task with the files of an identical finished build",
+		zap.String("ClusterID", req.GetClusterID()), zap.Int64("BuildID", req.GetBuildID()), zap.Strings("IndexFiles", result.savePaths))
+	return true
+}
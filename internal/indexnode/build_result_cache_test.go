@@ -0,0 +1,124 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestBuildResultKey(t *testing.T) {
+	newReq := func() *indexpb.CreateJobRequest {
+		return &indexpb.CreateJobRequest{
+			ClusterID:     "cluster",
+			BuildID:       1,
+			IndexVersion:  1,
+			DataPaths:     []string{"a", "b"},
+			StorageConfig: &indexpb.StorageConfig{BucketName: "bucket", RootPath: "files"},
+			IndexParams:   []*commonpb.KeyValuePair{{Key: "index_type", Value: "HNSW"}, {Key: "M", Value: "16"}},
+			TypeParams:    []*commonpb.KeyValuePair{{Key: "dim", Value: "8"}},
+		}
+	}
+	key := buildResultKey(newReq())
+
+	req := newReq()
+	req.DataPaths = []string{"b", "a"}
+	req.IndexParams = []*commonpb.KeyValuePair{{Key: "M", Value: "16"}, {Key: "index_type", Value: "HNSW"}}
+	req.Priority = 1
+	assert.Equal(t, key, buildResultKey(req))
+	req.EngineVersion = defaultEngineVersion
+	assert.Equal(t, key, buildResultKey(req))
+
+	for _, change := range []func(req *indexpb.CreateJobRequest){
+		func(req *indexpb.CreateJobRequest) { req.DataPaths = append(req.DataPaths, "c") },
+		func(req *indexpb.CreateJobRequest) { req.IndexParams[1].Value = "32" },
+		func(req *indexpb.CreateJobRequest) { req.EngineVersion = "flat" },
+		func(req *indexpb.CreateJobRequest) { req.IndexVersion = 2 },
+		func(req *indexpb.CreateJobRequest) { req.StorageConfig.BucketName = "other" },
+		func(req *indexpb.CreateJobRequest) { req.DataTimestamp = 100 },
+		func(req *indexpb.CreateJobRequest) { req.DataPathRoots = map[string]string{"a": "legacy"} },
+	} {
+		req := newReq()
+		change(req)
+		assert.NotEqual(t, key, buildResultKey(req))
+	}
+}
+
+func TestBuildResultCache(t *testing.T) {
+	ctx := context.Background()
+	params := paramtable.Get().Namespace()
+	assert.Nil(t, newBuildResultCache(params))
+	params.Save(params.IndexNodeCfg.BuildResultCacheEnable.Key, "true")
+
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	savePaths := []string{path.Join(cm.RootPath(), "index_files/1/1/HNSW")}
+	assert.NoError(t, cm.Write(ctx, savePaths[0], []byte("index")))
+	req := &indexpb.CreateJobRequest{ClusterID: "cluster", BuildID: 1, IndexVersion: 1, DataPaths: []string{"a"}}
+	result := &buildResult{
+		collectionID:   100,
+		savePaths:      savePaths,
+		fileKeys:       []string{"HNSW"},
+		fileSizes:      []uint64{5},
+		serializedSize: 5,
+		statistic:      &indexpb.JobInfo{NumRows: 10},
+	}
+
+	t.Run("lookup", func(t *testing.T) {
+		cache := newBuildResultCache(params)
+		defer cache.Close()
+		assert.Nil(t, cache.Lookup(ctx, req, cm))
+		cache.Put(req, result)
+		assert.Equal(t, result, cache.Lookup(ctx, req, cm))
+		assert.Nil(t, cache.Lookup(ctx, &indexpb.CreateJobRequest{ClusterID: "cluster", BuildID: 1, IndexVersion: 1,
+			DataPaths: []string{"a"}, RebuildInPlace: true}, cm))
+
+		// the result is dropped once its files changed in storage.
+		assert.NoError(t, cm.Write(ctx, savePaths[0], []byte("new index")))
+		assert.Nil(t, cache.Lookup(ctx, req, cm))
+		assert.NoError(t, cm.Write(ctx, savePaths[0], []byte("index")))
+		assert.Nil(t, cache.Lookup(ctx, req, cm))
+	})
+
+	t.Run("answer the task", func(t *testing.T) {
+		node := &IndexNode{
+			params:   params,
+			reporter: newJobResultReporter(params),
+		}
+		node.loadOrStoreTask("cluster", 1, &taskInfo{phase: taskPending})
+		assert.False(t, node.answerFromBuildResult(ctx, req, cm))
+
+		node.buildResults = newBuildResultCache(params)
+		defer node.buildResults.Close()
+		assert.False(t, node.answerFromBuildResult(ctx, req, cm))
+		node.buildResults.Put(req, result)
+		assert.True(t, node.answerFromBuildResult(ctx, req, cm))
+		assert.Equal(t, commonpb.IndexState_Finished, node.loadTaskState("cluster", 1))
+		info := node.tasks.info(taskKey{ClusterID: "cluster", BuildID: 1})
+		assert.Equal(t, []string{"HNSW"}, info.fileKeys)
+		assert.Equal(t, []uint64{5}, info.fileSizes)
+		assert.Equal(t, int64(100), info.collectionID)
+		assert.Equal(t, int64(10), info.statistic.GetNumRows())
+	})
+}
@@ -0,0 +1,306 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync/atomic"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/indexcgowrapper"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+const (
+	buildSandboxDir     = "build_sandbox"
+	sandboxJobFile      = "job"
+	sandboxResultFile   = "result"
+	sandboxStderrFile   = "stderr"
+	sandboxStderrLength = 4096
+)
+
+// errBuildSandboxCrashed is the fail reason of the sandboxed builds whose helper process died, e.g. killed
+// for exceeding its resource limits, before reporting the result of the build.
+var errBuildSandboxCrashed = errors.New("build sandbox crashed")
+
+var (
+	// sandboxExecutable returns the binary started as the build helper, it's the running milvus binary.
+	sandboxExecutable = os.Executable
+	// sandboxArgs are the arguments of the binary running the build helper, followed by the job directory.
+	sandboxArgs = []string{"indexnode", "sandbox", "-dir"}
+)
+
+func init() {
+	// the raw data types of the datasets
+	for _, data := range []interface{}{[]bool{}, []int8{}, []int16{}, []int32{}, []int64{}, []float32{},
+		[]float64{}, []string{}, []byte{}} {
+		gob.Register(data)
+	}
+}
+
+// sandboxLimits are the resource limits of the build helper, 0 means no limit.
+type sandboxLimits struct {
+	// AddressSpace is the max virtual memory in bytes.
+	AddressSpace uint64
+	OpenFiles    uint64
+	// CPUTime is the max cpu time in seconds.
+	CPUTime uint64
+}
+
+// sandboxJob is the build handed over to the build helper.
+type sandboxJob struct {
+	EngineVersion string
+	DType         schemapb.DataType
+	TypeParams    map[string]string
+	IndexParams   map[string]string
+	StorageConfig []byte
+	Dataset       *indexcgowrapper.Dataset
+}
+
+// sandboxResult is the result of the build reported by the build helper, the index files or the build error.
+type sandboxResult struct {
+	Blobs []*storage.Blob
+	Err   string
+}
+
+// sandboxEngine runs the build engine of the version in a build helper process started from the milvus binary,
+// or in a builder worker, with the resource limits of indexNode.sandbox. A runaway build crashes the helper only, then the build fails
+// with errBuildSandboxCrashed. The whole build runs in Add, the dataset passed to Train is handed over with it.
+type sandboxEngine struct {
+	ctx    context.Context
+	params *paramtable.ComponentParam
+	// pool runs the build in a builder worker if it's not nil.
+	pool *builderPool
+	job  *sandboxJob
+	// pid is the pid of the running helper, it's set to 0 once the helper exits.
+	pid   *int64
+	dir   string
+	blobs []*storage.Blob
+}
+
+// newSandboxEngine returns the factory of the sandbox engines running the engine of the version in a helper
+// started for the build, or in a worker of the pool if it's not nil. The pid of the running helper is published to pid.
+func newSandboxEngine(ctx context.Context, params *paramtable.ComponentParam, pool *builderPool, version string,
+	pid *int64) BuildEngineFactory {
+	return func(dType schemapb.DataType, typeParams, indexParams map[string]string,
+		config *indexpb.StorageConfig) (BuildEngine, error) {
+		job := &sandboxJob{
+			EngineVersion: version,
+			DType:         dType,
+			TypeParams:    typeParams,
+			IndexParams:   indexParams,
+		}
+		if config != nil {
+			var err error
+			if job.StorageConfig, err = proto.Marshal(config); err != nil {
+				return nil, err
+			}
+		}
+		return &sandboxEngine{ctx: ctx, params: params, pool: pool, job: job, pid: pid}, nil
+	}
+}
+
+func (e *sandboxEngine) limits() sandboxLimits {
+	cfg := &e.params.IndexNodeCfg
+	return sandboxLimits{
+		AddressSpace: uint64(cfg.SandboxMaxAddressSpace.GetAsInt64()) * 1024 * 1024,
+		OpenFiles:    uint64(cfg.SandboxMaxOpenFiles.GetAsInt64()),
+		CPUTime:      uint64(cfg.SandboxMaxCPUTime.GetAsInt64()),
+	}
+}
+
+// Train does nothing, the build helper trains the index on the dataset passed to Add.
+func (e *sandboxEngine) Train(dataset *indexcgowrapper.Dataset) error {
+	return nil
+}
+
+// Add builds the index in the build helper and keeps the index files of it.
+func (e *sandboxEngine) Add(dataset *indexcgowrapper.Dataset) error {
+	e.job.Dataset = dataset
+	if e.pool != nil {
+		var err error
+		e.blobs, err = e.pool.build(e.ctx, e.limits(), e.job, e.pid)
+		return err
+	}
+	root := filepath.Join(e.params.LocalStorageCfg.Path.GetValue(), buildSandboxDir)
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return err
+	}
+	dir, err := os.MkdirTemp(root, "build")
+	if err != nil {
+		return err
+	}
+	e.dir = dir
+	e.blobs, err = e.run()
+	return err
+}
+
+func (e *sandboxEngine) run() ([]*storage.Blob, error) {
+	if err := writeSandboxFile(filepath.Join(e.dir, sandboxJobFile), e.limits(), e.job); err != nil {
+		return nil, err
+	}
+	executable, err := sandboxExecutable()
+	if err != nil {
+		return nil, err
+	}
+	stderr, err := os.Create(filepath.Join(e.dir, sandboxStderrFile))
+	if err != nil {
+		return nil, err
+	}
+	defer stderr.Close()
+
+	cmd := exec.CommandContext(e.ctx, executable, append(append([]string{}, sandboxArgs...), e.dir)...)
+	cmd.Stderr = stderr
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	atomic.StoreInt64(e.pid, int64(cmd.Process.Pid))
+	err = cmd.Wait()
+	atomic.StoreInt64(e.pid, 0)
+	if e.ctx.Err() != nil {
+		return nil, e.ctx.Err()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s, %s", errBuildSandboxCrashed, err.Error(), sandboxStderrTail(filepath.Join(e.dir, sandboxStderrFile)))
+	}
+
+	result := &sandboxResult{}
+	if err := readSandboxFile(filepath.Join(e.dir, sandboxResultFile), result); err != nil {
+		return nil, fmt.Errorf("%w: no result, %s", errBuildSandboxCrashed, err.Error())
+	}
+	if result.Err != "" {
+		return nil, errors.New(result.Err)
+	}
+	return result.Blobs, nil
+}
+
+func (e *sandboxEngine) Serialize() ([]*storage.Blob, error) {
+	return e.blobs, nil
+}
+
+// Delete removes the job directory, the helper has released the index when it exited.
+func (e *sandboxEngine) Delete() error {
+	e.blobs = nil
+	if e.dir == "" {
+		return nil
+	}
+	err := os.RemoveAll(e.dir)
+	e.dir = ""
+	return err
+}
+
+// sandboxed reports whether the task builds its index in a build helper.
+func (it *indexBuildTask) sandboxed() bool {
+	return it.node.params.IndexNodeCfg.SandboxEnable.GetAsBool()
+}
+
+func writeSandboxFile(path string, values ...interface{}) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	enc := gob.NewEncoder(f)
+	for _, value := range values {
+		if err := enc.Encode(value); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	return f.Close()
+}
+
+func readSandboxFile(path string, values ...interface{}) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	dec := gob.NewDecoder(f)
+	for _, value := range values {
+		if err := dec.Decode(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RunBuildSandbox runs the build helper of the job directory dir written by a sandboxed build of IndexNode.
+// The resource limits are set before the job is read, the error of the build engine is reported in the result,
+// and the other errors fail the helper.
+func RunBuildSandbox(dir string) error {
+	f, err := os.Open(filepath.Join(dir, sandboxJobFile))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	dec := gob.NewDecoder(f)
+	limits := sandboxLimits{}
+	if err := dec.Decode(&limits); err != nil {
+		return err
+	}
+	if err := setSandboxLimits(limits); err != nil {
+		return err
+	}
+	job := &sandboxJob{}
+	if err := dec.Decode(job); err != nil {
+		return err
+	}
+
+	node := &IndexNode{params: paramtable.Get()}
+	node.initKnowhere()
+	result := &sandboxResult{}
+	if result.Blobs, err = buildSandboxJob(job); err != nil {
+		result.Err = err.Error()
+	}
+	return writeSandboxFile(filepath.Join(dir, sandboxResultFile), result)
+}
+
+func buildSandboxJob(job *sandboxJob) ([]*storage.Blob, error) {
+	newEngine, err := getBuildEngineFactory(job.EngineVersion)
+	if err != nil {
+		return nil, err
+	}
+	var config *indexpb.StorageConfig
+	if job.StorageConfig != nil {
+		config = &indexpb.StorageConfig{}
+		if err := proto.Unmarshal(job.StorageConfig, config); err != nil {
+			return nil, err
+		}
+	}
+	engine, err := newEngine(job.DType, job.TypeParams, job.IndexParams, config)
+	if err != nil {
+		return nil, err
+	}
+	defer engine.Delete()
+	if err := engine.Train(job.Dataset); err != nil {
+		return nil, err
+	}
+	if err := engine.Add(job.Dataset); err != nil {
+		return nil, err
+	}
+	return engine.Serialize()
+}
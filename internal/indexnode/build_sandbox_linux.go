@@ -0,0 +1,58 @@
+//go:build linux
+// +build linux
+
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"golang.org/x/sys/unix"
+)
+
+// setSandboxLimits sets the soft resource limits of the build helper, the limits apply to the children of it too.
+// The hard limits are kept so a builder worker is able to set the limits of its next build, a 0 limit resets
+// the soft limit to the hard one. The cpu time limit is counted from the cpu time used so far.
+func setSandboxLimits(limits sandboxLimits) error {
+	var usage unix.Rusage
+	if err := unix.Getrusage(unix.RUSAGE_SELF, &usage); err != nil {
+		return err
+	}
+	usedCPUTime := uint64(usage.Utime.Sec + usage.Stime.Sec)
+	for resource, limit := range map[int]uint64{
+		unix.RLIMIT_AS:     limits.AddressSpace,
+		unix.RLIMIT_NOFILE: limits.OpenFiles,
+		unix.RLIMIT_CPU:    limits.CPUTime,
+	} {
+		rlimit := unix.Rlimit{}
+		if err := unix.Getrlimit(resource, &rlimit); err != nil {
+			return err
+		}
+		rlimit.Cur = rlimit.Max
+		if limit > 0 {
+			if resource == unix.RLIMIT_CPU {
+				limit += usedCPUTime
+			}
+			if limit < rlimit.Max {
+				rlimit.Cur = limit
+			}
+		}
+		if err := unix.Setrlimit(resource, &rlimit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/indexcgowrapper"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+const sandboxHelperEnv = "INDEXNODE_BUILD_SANDBOX_HELPER"
+
+// crashBuildEngine kills the build helper when adding the dataset.
+type crashBuildEngine struct {
+	mockBuildEngine
+}
+
+func (e *crashBuildEngine) Add(dataset *indexcgowrapper.Dataset) error {
+	os.Exit(2)
+	return nil
+}
+
+func registerSandboxMockEngines() func() {
+	RegisterBuildEngine("sandbox-mock", func(schemapb.DataType, map[string]string, map[string]string,
+		*indexpb.StorageConfig) (BuildEngine, error) {
+		return &mockBuildEngine{}, nil
+	})
+	RegisterBuildEngine("sandbox-fail", func(schemapb.DataType, map[string]string, map[string]string,
+		*indexpb.StorageConfig) (BuildEngine, error) {
+		return &mockBuildEngine{trainErr: errors.New("mock train")}, nil
+	})
+	RegisterBuildEngine("sandbox-crash", func(schemapb.DataType, map[string]string, map[string]string,
+		*indexpb.StorageConfig) (BuildEngine, error) {
+		return &crashBuildEngine{}, nil
+	})
+	return func() {
+		buildEnginesMu.Lock()
+		delete(buildEngines, "sandbox-mock")
+		delete(buildEngines, "sandbox-fail")
+		delete(buildEngines, "sandbox-crash")
+		buildEnginesMu.Unlock()
+	}
+}
+
+// TestBuildSandboxHelper is the build helper started by TestSandboxEngine, the job directory is the last argument.
+func TestBuildSandboxHelper(t *testing.T) {
+	if os.Getenv(sandboxHelperEnv) == "" {
+		return
+	}
+	defer registerSandboxMockEngines()()
+	assert.NoError(t, RunBuildSandbox(os.Args[len(os.Args)-1]))
+}
+
+func TestBuildSandboxJob(t *testing.T) {
+	defer registerSandboxMockEngines()()
+	dataset := indexcgowrapper.GenFloatVecDataset([]float32{1, 2, 3, 4})
+
+	blobs, err := buildSandboxJob(&sandboxJob{EngineVersion: "sandbox-mock", DType: schemapb.DataType_FloatVector, Dataset: dataset})
+	assert.NoError(t, err)
+	assert.Equal(t, []*storage.Blob{{Key: "index", Value: []byte("index")}}, blobs)
+
+	_, err = buildSandboxJob(&sandboxJob{EngineVersion: "sandbox-fail", Dataset: dataset})
+	assert.Error(t, err)
+	_, err = buildSandboxJob(&sandboxJob{EngineVersion: "sandbox-unknown", Dataset: dataset})
+	assert.Error(t, err)
+	_, err = buildSandboxJob(&sandboxJob{EngineVersion: "sandbox-mock", StorageConfig: []byte{0xff}, Dataset: dataset})
+	assert.Error(t, err)
+}
+
+func TestSandboxFile(t *testing.T) {
+	path := t.TempDir() + "/job"
+	job := &sandboxJob{
+		DType:         schemapb.DataType_FloatVector,
+		IndexParams:   map[string]string{"index_type": "HNSW"},
+		Dataset:       indexcgowrapper.GenBinaryVecDataset([]byte{1, 2}),
+		StorageConfig: []byte("config"),
+	}
+	assert.NoError(t, writeSandboxFile(path, sandboxLimits{OpenFiles: 64}, job))
+
+	limits, read := sandboxLimits{}, &sandboxJob{}
+	assert.NoError(t, readSandboxFile(path, &limits, read))
+	assert.Equal(t, sandboxLimits{OpenFiles: 64}, limits)
+	assert.Equal(t, job, read)
+}
+
+func TestSandboxEngine(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	params.Save(params.LocalStorageCfg.Path.Key, t.TempDir())
+	defer params.Reset(params.LocalStorageCfg.Path.Key)
+	ctx := context.Background()
+	dataset := indexcgowrapper.GenFloatVecDataset([]float32{1, 2, 3, 4})
+	defer func(executable func() (string, error), args []string) {
+		sandboxExecutable, sandboxArgs = executable, args
+	}(sandboxExecutable, sandboxArgs)
+	run := func(version string) (*sandboxEngine, error) {
+		var pid int64
+		engine, err := newSandboxEngine(ctx, params, nil, version, &pid)(schemapb.DataType_FloatVector,
+			map[string]string{"dim": "2"}, map[string]string{"index_type": "HNSW"}, &indexpb.StorageConfig{BucketName: "a"})
+		assert.NoError(t, err)
+		assert.NoError(t, engine.Train(dataset))
+		err = engine.Add(dataset)
+		assert.Equal(t, int64(0), pid)
+		return engine.(*sandboxEngine), err
+	}
+
+	t.Run("built", func(t *testing.T) {
+		t.Setenv(sandboxHelperEnv, "1")
+		sandboxExecutable = os.Executable
+		sandboxArgs = []string{"-test.run=^TestBuildSandboxHelper$", "--"}
+		engine, err := run("sandbox-mock")
+		assert.NoError(t, err)
+		blobs, err := engine.Serialize()
+		assert.NoError(t, err)
+		assert.Equal(t, []*storage.Blob{{Key: "index", Value: []byte("index")}}, blobs)
+
+		dir := engine.dir
+		assert.NoError(t, engine.Delete())
+		assert.NoError(t, engine.Delete())
+		_, err = os.Stat(dir)
+		assert.True(t, os.IsNotExist(err))
+
+		_, err = run("sandbox-fail")
+		assert.EqualError(t, err, "mock train")
+	})
+
+	sandboxExecutable = func() (string, error) { return "/bin/sh", nil }
+	t.Run("killed", func(t *testing.T) {
+		sandboxArgs = []string{"-c", "echo runaway >&2; kill -9 $$", "sh"}
+		_, err := run("sandbox-mock")
+		assert.ErrorIs(t, err, errBuildSandboxCrashed)
+		assert.Contains(t, err.Error(), "killed")
+		assert.Contains(t, err.Error(), "runaway")
+	})
+
+	t.Run("no result", func(t *testing.T) {
+		sandboxArgs = []string{"-c", "exit 0", "sh"}
+		_, err := run("sandbox-mock")
+		assert.ErrorIs(t, err, errBuildSandboxCrashed)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		sandboxArgs = []string{"-c", "sleep 60", "sh"}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(context.Background())
+		cancel()
+		_, err := run("sandbox-mock")
+		assert.ErrorIs(t, err, context.Canceled)
+		ctx = context.Background()
+	})
+}
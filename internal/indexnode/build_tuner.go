@@ -0,0 +1,154 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/util/hardware"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	// buildTuneTolerance is the cpu usage band around the target in which nothing is changed.
+	buildTuneTolerance = 5.0
+	// minBuildThreadRatio is the lower bound of the knowhere build thread ratio.
+	minBuildThreadRatio = 0.25
+)
+
+// buildTuner periodically adjusts the build parallelism of the scheduler and the ratio of knowhere build
+// threads to hold the cpu usage near the configured target.
+// When the cpu is too busy, the parallelism is decreased first, then the build threads.
+// When the cpu has spare capacity, the build threads are restored first, then the parallelism.
+type buildTuner struct {
+	sched *TaskScheduler
+
+	mu          sync.RWMutex
+	threadRatio float64
+
+	getCPUUsage func() float64
+
+	wg sync.WaitGroup
+}
+
+func newBuildTuner(sched *TaskScheduler) *buildTuner {
+	return &buildTuner{
+		sched:       sched,
+		threadRatio: 1,
+		getCPUUsage: hardware.GetCPUUsage,
+	}
+}
+
+// Start starts the tuning loop if auto tuning is enabled.
+func (t *buildTuner) Start(ctx context.Context) {
+	if !t.sched.params.IndexNodeCfg.BuildTuneEnable.GetAsBool() {
+		return
+	}
+	t.wg.Add(1)
+	go t.loop(ctx)
+}
+
+// Close waits for the tuning loop to exit, the loop exits when the context passed to Start is done.
+func (t *buildTuner) Close() {
+	t.wg.Wait()
+}
+
+func (t *buildTuner) loop(ctx context.Context) {
+	defer t.wg.Done()
+	interval := t.sched.params.IndexNodeCfg.BuildTuneInterval.GetAsDuration(time.Second)
+	log.Info("IndexNode start build auto tuning", zap.Duration("interval", interval))
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("IndexNode build auto tuning exit")
+			return
+		case <-ticker.C:
+			t.tune(t.getCPUUsage())
+		}
+	}
+}
+
+// targetCPUUsage returns the cpu usage to hold, co-located components on standalone are left some headroom.
+func (t *buildTuner) targetCPUUsage() float64 {
+	target := t.sched.params.IndexNodeCfg.BuildTuneTargetCPUUsage.GetAsFloat()
+	if paramtable.GetRole() == typeutil.StandaloneRole {
+		target -= t.sched.params.IndexNodeCfg.BuildTuneHeadroom.GetAsFloat()
+	}
+	return math.Max(target, buildTuneTolerance)
+}
+
+func (t *buildTuner) maxBuildParallel() int {
+	maxParallel := t.sched.params.IndexNodeCfg.BuildTuneMaxParallel.GetAsInt()
+	if maxParallel <= 0 {
+		maxParallel = hardware.GetCPUNum()
+	}
+	return maxParallel
+}
+
+func (t *buildTuner) tune(usage float64) {
+	target := t.targetCPUUsage()
+	parallel := t.sched.getBuildParallel()
+	maxParallel := t.maxBuildParallel()
+
+	t.mu.Lock()
+	ratio := t.threadRatio
+	switch {
+	case usage > target+buildTuneTolerance:
+		if parallel > 1 {
+			parallel--
+		} else {
+			ratio = math.Max(minBuildThreadRatio, ratio/2)
+		}
+	case usage < target-buildTuneTolerance:
+		if ratio < 1 {
+			ratio = math.Min(1, ratio*2)
+		} else if parallel < maxParallel {
+			parallel++
+		}
+	}
+	if parallel > maxParallel {
+		parallel = maxParallel
+	}
+	changed := ratio != t.threadRatio || parallel != t.sched.getBuildParallel()
+	t.threadRatio = ratio
+	t.mu.Unlock()
+
+	if changed {
+		t.sched.setBuildParallel(parallel)
+		log.Info("IndexNode build auto tuning adjusted", zap.Float64("cpuUsage", usage),
+			zap.Float64("target", target), zap.Int("buildParallel", parallel), zap.Float64("threadRatio", ratio))
+	}
+	metrics.IndexNodeBuildParallel.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Set(float64(parallel))
+}
+
+// getThreadRatio returns the ratio to apply on the number of knowhere build threads.
+func (t *buildTuner) getThreadRatio() float64 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.threadRatio
+}
@@ -0,0 +1,87 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/indexparams"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestBuildTuner(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	params.Save(params.IndexNodeCfg.BuildTuneTargetCPUUsage.Key, "80")
+	params.Save(params.IndexNodeCfg.BuildTuneMaxParallel.Key, "3")
+
+	sched := NewTaskScheduler(context.TODO(), params)
+	sched.setBuildParallel(2)
+	tuner := newBuildTuner(sched)
+
+	// busy cpu, decrease parallelism first
+	tuner.tune(95)
+	assert.Equal(t, 1, sched.getBuildParallel())
+	assert.Equal(t, 1.0, tuner.getThreadRatio())
+
+	// then decrease build threads
+	tuner.tune(95)
+	assert.Equal(t, 1, sched.getBuildParallel())
+	assert.Equal(t, 0.5, tuner.getThreadRatio())
+	tuner.tune(95)
+	tuner.tune(95)
+	assert.Equal(t, minBuildThreadRatio, tuner.getThreadRatio())
+
+	// within tolerance, nothing changed
+	tuner.tune(82)
+	assert.Equal(t, 1, sched.getBuildParallel())
+	assert.Equal(t, minBuildThreadRatio, tuner.getThreadRatio())
+
+	// idle cpu, restore build threads first, then parallelism up to the max
+	tuner.tune(10)
+	tuner.tune(10)
+	assert.Equal(t, 1.0, tuner.getThreadRatio())
+	assert.Equal(t, 1, sched.getBuildParallel())
+	for i := 0; i < 5; i++ {
+		tuner.tune(10)
+	}
+	assert.Equal(t, 3, sched.getBuildParallel())
+}
+
+func TestApplyBuildThreadRatio(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	sched := NewTaskScheduler(context.TODO(), params)
+	sched.setBuildParallel(1)
+	tuner := newBuildTuner(sched)
+	it := &indexBuildTask{
+		node:           &IndexNode{params: params, tuner: tuner},
+		req:            &indexpb.CreateJobRequest{},
+		newIndexParams: map[string]string{indexparams.NumBuildThreadKey: "8"},
+	}
+	buildParams := map[string]string{indexparams.NumBuildThreadKey: "8"}
+	it.applyBuildThreadRatio(context.TODO(), buildParams)
+	assert.Equal(t, "8", buildParams[indexparams.NumBuildThreadKey])
+
+	tuner.tune(95)
+	it.applyBuildThreadRatio(context.TODO(), buildParams)
+	assert.Equal(t, "4", buildParams[indexparams.NumBuildThreadKey])
+	// the params saved with the index keep the configured threads.
+	assert.Equal(t, "8", it.newIndexParams[indexparams.NumBuildThreadKey])
+}
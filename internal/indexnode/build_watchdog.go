@@ -0,0 +1,141 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+var buildWatchdogInterval = 10 * time.Second
+
+// errBuilderStalled is the fail reason of the builds making no progress.
+var errBuilderStalled = errors.New("builder stalled")
+
+// buildWatchdog detects a stuck build call by sampling the cpu time of the build,
+// the build is stalled when the cpu time doesn't grow for the stall timeout.
+type buildWatchdog struct {
+	stallTimeout time.Duration
+	cpuTime      func() (uint64, error)
+	lastCPUTime  uint64
+	lastProgress time.Time
+	stalled      bool
+}
+
+// check samples the cpu time of the build, it reports whether the build is found stalled,
+// a stalled build is reported only once.
+func (w *buildWatchdog) check(now time.Time) (bool, error) {
+	cpuTime, err := w.cpuTime()
+	if err != nil {
+		return false, err
+	}
+	if cpuTime != w.lastCPUTime {
+		w.lastCPUTime = cpuTime
+		w.lastProgress = now
+		return false, nil
+	}
+	if w.stalled || now.Sub(w.lastProgress) < w.stallTimeout {
+		return false, nil
+	}
+	w.stalled = true
+	return true, nil
+}
+
+// buildCPUTime returns the cpu time of the process running the build: the build helper while the build is
+// sandboxed, IndexNode itself otherwise. knowhere builds on its own thread pool, so the cpu time of the thread
+// calling it doesn't tell the progress, and the cpu time of IndexNode also grows with the other builds running.
+func (it *indexBuildTask) buildCPUTime() (uint64, error) {
+	if pid := atomic.LoadInt64(&it.sandboxPID); pid > 0 {
+		// the helper may have exited since the pid was read
+		if cpuTime, err := processCPUTime(int(pid)); err == nil {
+			return cpuTime, nil
+		}
+	}
+	return processCPUTime(os.Getpid())
+}
+
+// watchBuild watches the build until the returned function is called. A stalled build is recorded as a warning,
+// and its build helper is killed when indexNode.buildWatchdog.abort is set.
+func (it *indexBuildTask) watchBuild(ctx context.Context) func() {
+	stallTimeout := it.node.params.IndexNodeCfg.BuildWatchdogStallTimeout.GetAsDuration(time.Second)
+	if stallTimeout <= 0 {
+		return func() {}
+	}
+	w := &buildWatchdog{stallTimeout: stallTimeout, cpuTime: it.buildCPUTime, lastProgress: time.Now()}
+	var err error
+	if w.lastCPUTime, err = w.cpuTime(); err != nil {
+		log.Ctx(ctx).Warn("IndexNode failed to watch the build", zap.Int64("buildID", it.BuildID), zap.Error(err))
+		return func() {}
+	}
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		it.runWatchdog(ctx, w, done)
+	}()
+	return func() {
+		close(done)
+		wg.Wait()
+	}
+}
+
+func (it *indexBuildTask) runWatchdog(ctx context.Context, w *buildWatchdog, done <-chan struct{}) {
+	ticker := time.NewTicker(buildWatchdogInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			stalled, err := w.check(now)
+			if err != nil {
+				log.Ctx(ctx).Warn("IndexNode failed to sample the build", zap.Int64("buildID", it.BuildID), zap.Error(err))
+				return
+			}
+			if stalled {
+				it.onBuildStalled(ctx, w.stallTimeout)
+			}
+		}
+	}
+}
+
+// onBuildStalled records the stalled build. When indexNode.buildWatchdog.abort is set and the build runs in
+// a build helper, the task is failed and the helper is killed by the canceled context of the task, the slot
+// and the local files are released once the build returns. A CGO call in IndexNode can't be interrupted,
+// so the build stalled in process is only flagged.
+func (it *indexBuildTask) onBuildStalled(ctx context.Context, stallTimeout time.Duration) {
+	reason := fmt.Sprintf("%s: no progress for %s", errBuilderStalled, stallTimeout)
+	it.warn(ctx, "%s", reason)
+	if !it.node.params.IndexNodeCfg.BuildWatchdogAbort.GetAsBool() || atomic.LoadInt64(&it.sandboxPID) <= 0 {
+		return
+	}
+	if it.node.abortTask(it.ClusterID, it.BuildID, reason) {
+		countAbortedTask()
+	}
+}
@@ -0,0 +1,59 @@
+//go:build linux
+// +build linux
+
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// processCPUTime returns the cpu time of all the threads of the process in clock ticks.
+func processCPUTime(pid int) (uint64, error) {
+	stat, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return 0, err
+	}
+	return parseStatCPUTime(string(stat))
+}
+
+// parseStatCPUTime returns the sum of utime and stime in a /proc stat line,
+// the fields are counted after the command name, which may contain spaces.
+func parseStatCPUTime(stat string) (uint64, error) {
+	end := strings.LastIndexByte(stat, ')')
+	if end < 0 {
+		return 0, fmt.Errorf("invalid process stat: %s", stat)
+	}
+	// the fields after the command start from the state, utime and stime are the 14th and 15th fields.
+	fields := strings.Fields(stat[end+1:])
+	if len(fields) < 13 {
+		return 0, fmt.Errorf("invalid process stat: %s", stat)
+	}
+	var cpuTime uint64
+	for _, field := range fields[11:13] {
+		value, err := strconv.ParseUint(field, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid process stat: %w", err)
+		}
+		cpuTime += value
+	}
+	return cpuTime, nil
+}
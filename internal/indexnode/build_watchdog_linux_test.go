@@ -0,0 +1,55 @@
+//go:build linux
+// +build linux
+
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseStatCPUTime(t *testing.T) {
+	cpuTime, err := parseStatCPUTime("42 (milvus run) R 1 42 42 0 -1 4194560 100 0 0 0 120 30 0 0 20 0 8 0")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(150), cpuTime)
+
+	_, err = parseStatCPUTime("42 milvus")
+	assert.Error(t, err)
+	_, err = parseStatCPUTime("42 (milvus) R 1")
+	assert.Error(t, err)
+
+	_, err = processCPUTime(os.Getpid())
+	assert.NoError(t, err)
+}
+
+func TestBuildCPUTime(t *testing.T) {
+	it := &indexBuildTask{}
+	cpuTime, err := it.buildCPUTime()
+	assert.NoError(t, err)
+	self, err := processCPUTime(os.Getpid())
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, self, cpuTime)
+
+	// the helper exited since the pid was published
+	it.sandboxPID = 1 << 30
+	_, err = it.buildCPUTime()
+	assert.NoError(t, err)
+}
@@ -0,0 +1,94 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestBuildWatchdogCheck(t *testing.T) {
+	cpuTime := uint64(0)
+	start := time.Now()
+	w := &buildWatchdog{
+		stallTimeout: time.Minute,
+		cpuTime:      func() (uint64, error) { return cpuTime, nil },
+		lastProgress: start,
+	}
+
+	stalled, err := w.check(start.Add(30 * time.Second))
+	assert.NoError(t, err)
+	assert.False(t, stalled)
+	cpuTime = 10
+	stalled, err = w.check(start.Add(50 * time.Second))
+	assert.NoError(t, err)
+	assert.False(t, stalled)
+	// the progress at 50s resets the stall timeout
+	stalled, err = w.check(start.Add(100 * time.Second))
+	assert.NoError(t, err)
+	assert.False(t, stalled)
+	stalled, err = w.check(start.Add(110 * time.Second))
+	assert.NoError(t, err)
+	assert.True(t, stalled)
+	// reported once
+	stalled, err = w.check(start.Add(200 * time.Second))
+	assert.NoError(t, err)
+	assert.False(t, stalled)
+
+	w.cpuTime = func() (uint64, error) { return 0, errors.New("mock") }
+	_, err = w.check(start.Add(300 * time.Second))
+	assert.Error(t, err)
+}
+
+func TestBuildStalled(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	ctx := context.Background()
+	node := &IndexNode{
+		params:   params,
+		sched:    NewTaskScheduler(ctx, params),
+		reporter: newJobResultReporter(params),
+	}
+	canceled := false
+	node.loadOrStoreTask("cluster", 1, &taskInfo{cancel: func() { canceled = true }, phase: taskBuilding})
+	released := false
+	node.sched.acquireSlot("cluster/1", "", func() { released = true })
+	it := &indexBuildTask{ClusterID: "cluster", BuildID: 1, node: node}
+
+	it.onBuildStalled(ctx, time.Minute)
+	assert.Equal(t, commonpb.IndexState_InProgress, node.loadTaskState("cluster", 1))
+	assert.Equal(t, []string{"builder stalled: no progress for 1m0s"}, node.tasks.info(taskKey{ClusterID: "cluster", BuildID: 1}).warnings)
+
+	params.Save(params.IndexNodeCfg.BuildWatchdogAbort.Key, "true")
+	defer params.Reset(params.IndexNodeCfg.BuildWatchdogAbort.Key)
+	// the build in IndexNode can't be interrupted, it's only flagged
+	it.onBuildStalled(ctx, time.Minute)
+	assert.Equal(t, commonpb.IndexState_InProgress, node.loadTaskState("cluster", 1))
+	assert.False(t, canceled)
+
+	it.sandboxPID = 42
+	it.onBuildStalled(ctx, time.Minute)
+	assert.Equal(t, commonpb.IndexState_Failed, node.loadTaskState("cluster", 1))
+	assert.True(t, canceled)
+	assert.False(t, released)
+}
@@ -0,0 +1,257 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// builderWorkerStartTimeout is how long a started builder worker has to connect to IndexNode.
+var builderWorkerStartTimeout = 30 * time.Second
+
+// sandboxWorkerArgs are the arguments of the milvus binary running a builder worker, followed by the socket path.
+var sandboxWorkerArgs = []string{"indexnode", "sandbox", "-socket"}
+
+// builderWorker is a long-lived build helper process running the sandboxed builds one at a time,
+// the jobs and the results are exchanged over a unix socket. It's started on the first build taking it.
+type builderWorker struct {
+	id   int
+	cmd  *exec.Cmd
+	conn net.Conn
+	enc  *gob.Encoder
+	dec  *gob.Decoder
+}
+
+// builderPool is the pool of indexNode.sandbox.workers builder workers. IndexNode handles the RPCs and schedules
+// the tasks, the CGO builds run in the workers, so a crashing build takes down its worker only, which is
+// restarted by the next build, and the resource limits of indexNode.sandbox are set on the worker for every build.
+type builderPool struct {
+	params *paramtable.ComponentParam
+	dir    string
+	// idle holds the workers not building.
+	idle   chan *builderWorker
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// newBuilderPool returns the builder pool of the node, nil if a helper is started for every sandboxed build.
+func newBuilderPool(params *paramtable.ComponentParam) *builderPool {
+	size := params.IndexNodeCfg.SandboxWorkers.GetAsInt()
+	if size <= 0 {
+		return nil
+	}
+	p := &builderPool{
+		params: params,
+		dir:    filepath.Join(params.LocalStorageCfg.Path.GetValue(), buildSandboxDir, "workers"),
+		idle:   make(chan *builderWorker, size),
+	}
+	p.ctx, p.cancel = context.WithCancel(context.Background())
+	for id := 0; id < size; id++ {
+		p.idle <- &builderWorker{id: id}
+	}
+	return p
+}
+
+// Close kills the workers, the builds running in them fail.
+func (p *builderPool) Close() {
+	p.cancel()
+	for {
+		select {
+		case w := <-p.idle:
+			p.stop(w)
+		default:
+			return
+		}
+	}
+}
+
+// build runs the job in an idle worker with the limits, the pid of the worker is published to pid while building.
+func (p *builderPool) build(ctx context.Context, limits sandboxLimits, job *sandboxJob, pid *int64) ([]*storage.Blob, error) {
+	var w *builderWorker
+	select {
+	case w = <-p.idle:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-p.ctx.Done():
+		return nil, p.ctx.Err()
+	}
+	defer func() { p.idle <- w }()
+	if w.cmd == nil {
+		if err := p.start(w); err != nil {
+			return nil, err
+		}
+	}
+
+	atomic.StoreInt64(pid, int64(w.cmd.Process.Pid))
+	defer atomic.StoreInt64(pid, 0)
+	result := &sandboxResult{}
+	done := make(chan error, 1)
+	go func() {
+		err := w.enc.Encode(limits)
+		if err == nil {
+			err = w.enc.Encode(job)
+		}
+		if err == nil {
+			err = w.dec.Decode(result)
+		}
+		done <- err
+	}()
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		// the CGO build can't be interrupted, the worker is killed with it.
+		p.stop(w)
+		<-done
+		return nil, ctx.Err()
+	}
+	if err != nil {
+		state := p.stop(w)
+		return nil, fmt.Errorf("%w: %s, %s", errBuildSandboxCrashed, state, sandboxStderrTail(p.stderrPath(w)))
+	}
+	if result.Err != "" {
+		return nil, errors.New(result.Err)
+	}
+	return result.Blobs, nil
+}
+
+func (p *builderPool) stderrPath(w *builderWorker) string {
+	return filepath.Join(p.dir, fmt.Sprintf("worker-%d.stderr", w.id))
+}
+
+// start starts the worker and waits for it to connect to the socket of it.
+func (p *builderPool) start(w *builderWorker) error {
+	if err := os.MkdirAll(p.dir, 0o755); err != nil {
+		return err
+	}
+	socket := filepath.Join(p.dir, fmt.Sprintf("worker-%d.sock", w.id))
+	if err := os.Remove(socket); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	listener, err := net.ListenUnix("unix", &net.UnixAddr{Name: socket, Net: "unix"})
+	if err != nil {
+		return err
+	}
+	defer listener.Close()
+	if err := listener.SetDeadline(time.Now().Add(builderWorkerStartTimeout)); err != nil {
+		return err
+	}
+
+	executable, err := sandboxExecutable()
+	if err != nil {
+		return err
+	}
+	stderr, err := os.Create(p.stderrPath(w))
+	if err != nil {
+		return err
+	}
+	defer stderr.Close()
+	cmd := exec.CommandContext(p.ctx, executable, append(append([]string{}, sandboxWorkerArgs...), socket)...)
+	cmd.Stderr = stderr
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	conn, err := listener.Accept()
+	if err != nil {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+		return fmt.Errorf("builder worker failed to connect: %w, %s", err, sandboxStderrTail(p.stderrPath(w)))
+	}
+	w.cmd, w.conn = cmd, conn
+	w.enc, w.dec = gob.NewEncoder(conn), gob.NewDecoder(conn)
+	return nil
+}
+
+// stop kills the worker if it's started and returns how it exited, the next build starts it again.
+func (p *builderPool) stop(w *builderWorker) string {
+	if w.cmd == nil {
+		return ""
+	}
+	w.conn.Close()
+	_ = w.cmd.Process.Kill()
+	_ = w.cmd.Wait()
+	state := w.cmd.ProcessState.String()
+	w.cmd, w.conn, w.enc, w.dec = nil, nil, nil, nil
+	return state
+}
+
+// RunBuildWorker runs a builder worker connected to the socket of IndexNode, it builds the received jobs
+// one at a time until IndexNode closes the connection. The error of a build is reported in its result,
+// the other errors stop the worker.
+func RunBuildWorker(socket string) error {
+	conn, err := net.Dial("unix", socket)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	node := &IndexNode{params: paramtable.Get()}
+	node.initKnowhere()
+
+	enc, dec := gob.NewEncoder(conn), gob.NewDecoder(conn)
+	for {
+		limits := sandboxLimits{}
+		if err := dec.Decode(&limits); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		job := &sandboxJob{}
+		if err := dec.Decode(job); err != nil {
+			return err
+		}
+		result := &sandboxResult{}
+		if err := setSandboxLimits(limits); err != nil {
+			result.Err = err.Error()
+		} else if result.Blobs, err = buildSandboxJob(job); err != nil {
+			result.Err = err.Error()
+		}
+		if err := enc.Encode(result); err != nil {
+			return err
+		}
+		// return the memory of the build before the next one is limited
+		job, result = nil, nil
+		debug.FreeOSMemory()
+	}
+}
+
+// sandboxStderrTail returns the end of the stderr file of a helper, where the runtime reports the reason of a crash.
+func sandboxStderrTail(path string) string {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err.Error()
+	}
+	if len(content) > sandboxStderrLength {
+		content = content[len(content)-sandboxStderrLength:]
+	}
+	return strings.TrimSpace(string(content))
+}
@@ -0,0 +1,130 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/indexcgowrapper"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// TestBuildWorkerHelper is the builder worker started by TestBuilderPool, the socket path is the last argument.
+func TestBuildWorkerHelper(t *testing.T) {
+	if os.Getenv(sandboxHelperEnv) == "" {
+		return
+	}
+	defer registerSandboxMockEngines()()
+	assert.NoError(t, RunBuildWorker(os.Args[len(os.Args)-1]))
+}
+
+func TestBuilderPool(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	params.Save(params.LocalStorageCfg.Path.Key, t.TempDir())
+	defer params.Reset(params.LocalStorageCfg.Path.Key)
+	assert.Nil(t, newBuilderPool(params))
+	params.Save(params.IndexNodeCfg.SandboxWorkers.Key, "1")
+	defer params.Reset(params.IndexNodeCfg.SandboxWorkers.Key)
+
+	defer func(executable func() (string, error), args []string) {
+		sandboxExecutable, sandboxWorkerArgs = executable, args
+	}(sandboxExecutable, sandboxWorkerArgs)
+	t.Setenv(sandboxHelperEnv, "1")
+	sandboxExecutable = os.Executable
+	sandboxWorkerArgs = []string{"-test.run=^TestBuildWorkerHelper$", "--"}
+
+	ctx := context.Background()
+	pool := newBuilderPool(params)
+	defer pool.Close()
+	workerPID := func() int {
+		w := <-pool.idle
+		defer func() { pool.idle <- w }()
+		if w.cmd == nil {
+			return 0
+		}
+		return w.cmd.Process.Pid
+	}
+	build := func(ctx context.Context, version string) ([]*storage.Blob, error) {
+		var pid int64
+		job := &sandboxJob{
+			EngineVersion: version,
+			DType:         schemapb.DataType_FloatVector,
+			Dataset:       indexcgowrapper.GenFloatVecDataset([]float32{1, 2, 3, 4}),
+		}
+		blobs, err := pool.build(ctx, sandboxLimits{}, job, &pid)
+		assert.Equal(t, int64(0), pid)
+		return blobs, err
+	}
+
+	blobs, err := build(ctx, "sandbox-mock")
+	assert.NoError(t, err)
+	assert.Equal(t, []*storage.Blob{{Key: "index", Value: []byte("index")}}, blobs)
+	pid := workerPID()
+	assert.NotZero(t, pid)
+
+	// the failed build keeps the worker
+	_, err = build(ctx, "sandbox-fail")
+	assert.EqualError(t, err, "mock train")
+	assert.Equal(t, pid, workerPID())
+
+	// the crashed build stops the worker, the next build starts another one
+	_, err = build(ctx, "sandbox-crash")
+	assert.ErrorIs(t, err, errBuildSandboxCrashed)
+	assert.Contains(t, err.Error(), "exit status 2")
+	assert.Zero(t, workerPID())
+	_, err = build(ctx, "sandbox-mock")
+	assert.NoError(t, err)
+	assert.NotEqual(t, pid, workerPID())
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = build(canceled, "sandbox-mock")
+	assert.ErrorIs(t, err, context.Canceled)
+
+	pool.Close()
+	_, err = build(ctx, "sandbox-mock")
+	assert.Error(t, err)
+}
+
+func TestBuilderPoolStartFailed(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	params.Save(params.LocalStorageCfg.Path.Key, t.TempDir())
+	defer params.Reset(params.LocalStorageCfg.Path.Key)
+	params.Save(params.IndexNodeCfg.SandboxWorkers.Key, "1")
+	defer params.Reset(params.IndexNodeCfg.SandboxWorkers.Key)
+
+	defer func(executable func() (string, error), args []string, timeout time.Duration) {
+		sandboxExecutable, sandboxWorkerArgs, builderWorkerStartTimeout = executable, args, timeout
+	}(sandboxExecutable, sandboxWorkerArgs, builderWorkerStartTimeout)
+	sandboxExecutable = func() (string, error) { return "/bin/sh", nil }
+	sandboxWorkerArgs = []string{"-c", "echo no socket >&2", "sh"}
+	builderWorkerStartTimeout = time.Second
+
+	pool := newBuilderPool(params)
+	defer pool.Close()
+	var pid int64
+	_, err := pool.build(context.Background(), sandboxLimits{}, &sandboxJob{}, &pid)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "no socket")
+}
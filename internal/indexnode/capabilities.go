@@ -0,0 +1,83 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+// binlogDataFormat is the only input data format IndexNode reads now.
+const binlogDataFormat = "binlog"
+
+// nvidiaDevicePath exists when a nvidia gpu is visible to the process.
+var nvidiaDevicePath = "/dev/nvidiactl"
+
+// supportedIndexTypes are the index types this IndexNode is able to build, the disk index is not included,
+// the index of AUTOINDEX is chosen by the node.
+var supportedIndexTypes = []string{
+	indexparamcheck.IndexFaissIDMap,
+	indexparamcheck.IndexFaissIvfFlat,
+	indexparamcheck.IndexFaissIvfPQ,
+	indexparamcheck.IndexFaissIvfSQ8,
+	indexparamcheck.IndexFaissBinIDMap,
+	indexparamcheck.IndexFaissBinIvfFlat,
+	indexparamcheck.IndexHNSW,
+	indexparamcheck.IndexANNOY,
+	autoIndexType,
+}
+
+func hasGPU() bool {
+	_, err := os.Stat(nvidiaDevicePath)
+	return err == nil
+}
+
+// getCapabilities returns what this IndexNode is able to execute.
+func getCapabilities(params *paramtable.ComponentParam) *indexpb.NodeCapabilities {
+	indexTypes := make([]string, 0, len(supportedIndexTypes)+1)
+	indexTypes = append(indexTypes, supportedIndexTypes...)
+	enableDisk := params.IndexNodeCfg.EnableDisk.GetAsBool()
+	if enableDisk {
+		indexTypes = append(indexTypes, indexparamcheck.IndexDISKANN)
+	}
+	return &indexpb.NodeCapabilities{
+		IndexTypes:     indexTypes,
+		DiskIndex:      enableDisk,
+		Gpu:            hasGPU(),
+		MaxDim:         params.ProxyCfg.MaxDimension.GetAsInt64(),
+		DataFormats:    []string{binlogDataFormat},
+		Version:        common.Version.String(),
+		EngineVersions: supportedEngineVersions(),
+	}
+}
+
+// capabilitiesMetadata returns the session metadata advertising the capabilities.
+func capabilitiesMetadata(params *paramtable.ComponentParam) (map[string]string, error) {
+	value, err := json.Marshal(getCapabilities(params))
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{
+		sessionutil.CapabilitiesMetadataKey: string(value),
+	}, nil
+}
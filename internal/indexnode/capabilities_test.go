@@ -0,0 +1,67 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+func TestGetCapabilities(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	t.Run("disk disabled", func(t *testing.T) {
+		params.Save(params.IndexNodeCfg.EnableDisk.Key, "false")
+
+		capabilities := getCapabilities(params)
+		assert.False(t, capabilities.GetDiskIndex())
+		assert.NotContains(t, capabilities.GetIndexTypes(), indexparamcheck.IndexDISKANN)
+		assert.Contains(t, capabilities.GetIndexTypes(), indexparamcheck.IndexHNSW)
+		assert.Contains(t, capabilities.GetIndexTypes(), autoIndexType)
+		assert.Equal(t, params.ProxyCfg.MaxDimension.GetAsInt64(), capabilities.GetMaxDim())
+		assert.Equal(t, []string{binlogDataFormat}, capabilities.GetDataFormats())
+	})
+
+	t.Run("disk enabled", func(t *testing.T) {
+		params.Save(params.IndexNodeCfg.EnableDisk.Key, "true")
+
+		capabilities := getCapabilities(params)
+		assert.True(t, capabilities.GetDiskIndex())
+		assert.Contains(t, capabilities.GetIndexTypes(), indexparamcheck.IndexDISKANN)
+	})
+
+	t.Run("gpu", func(t *testing.T) {
+		origin := nvidiaDevicePath
+		defer func() { nvidiaDevicePath = origin }()
+		nvidiaDevicePath = t.TempDir()
+		assert.True(t, getCapabilities(params).GetGpu())
+	})
+
+	t.Run("metadata", func(t *testing.T) {
+		metadata, err := capabilitiesMetadata(params)
+		assert.NoError(t, err)
+		capabilities := &indexpb.NodeCapabilities{}
+		assert.NoError(t, json.Unmarshal([]byte(metadata[sessionutil.CapabilitiesMetadataKey]), capabilities))
+		assert.ElementsMatch(t, getCapabilities(params).GetIndexTypes(), capabilities.GetIndexTypes())
+	})
+}
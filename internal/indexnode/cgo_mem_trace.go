@@ -0,0 +1,98 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+)
+
+// cgoMemTracer is the BuildHook recording the C heap allocation delta of every stage of the build tasks,
+// most of the memory of a build is allocated by knowhere where the Go heap profiling is blind.
+// The C heap is shared by all the tasks, the deltas of the tasks running in parallel include each other.
+type cgoMemTracer struct {
+	allocated func() (uint64, bool)
+	record    func(key taskKey, delta *indexpb.StageMemDelta)
+
+	mu sync.Mutex
+	// starts are the C heap allocated at the start of the running stages, keyed by task and stage.
+	starts map[string]uint64
+}
+
+func newCGOMemTracer(node *IndexNode) *cgoMemTracer {
+	return &cgoMemTracer{
+		allocated: cHeapAllocated,
+		record:    node.storeTaskStageMemDelta,
+		starts:    make(map[string]uint64),
+	}
+}
+
+func stageTraceKey(info BuildStageInfo) string {
+	return info.Task + "@" + info.Stage
+}
+
+// taskKeyOf returns the key of the task the stage belongs to, the tasks are named ClusterID/BuildID.
+func taskKeyOf(info BuildStageInfo) (taskKey, bool) {
+	suffix := strings.TrimPrefix(info.Task, info.ClusterID+"/")
+	if suffix == info.Task {
+		return taskKey{}, false
+	}
+	buildID, err := strconv.ParseInt(suffix, 10, 64)
+	if err != nil {
+		return taskKey{}, false
+	}
+	return taskKey{ClusterID: info.ClusterID, BuildID: buildID}, true
+}
+
+func (t *cgoMemTracer) Before(ctx context.Context, info BuildStageInfo) error {
+	allocated, ok := t.allocated()
+	if !ok {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.starts[stageTraceKey(info)] = allocated
+	return nil
+}
+
+func (t *cgoMemTracer) After(ctx context.Context, info BuildStageInfo, err error) error {
+	t.mu.Lock()
+	start, ok := t.starts[stageTraceKey(info)]
+	delete(t.starts, stageTraceKey(info))
+	t.mu.Unlock()
+	if !ok {
+		return err
+	}
+	end, ok := t.allocated()
+	if !ok {
+		return err
+	}
+	delta := &indexpb.StageMemDelta{Stage: info.Stage, AllocatedDelta: int64(end) - int64(start)}
+	log.Ctx(ctx).Debug("IndexNode trace C heap of build stage", zap.String("task", info.Task),
+		zap.String("stage", info.Stage), zap.Uint64("allocated", end), zap.Int64("delta", delta.AllocatedDelta))
+	if key, ok := taskKeyOf(info); ok {
+		t.record(key, delta)
+	}
+	return err
+}
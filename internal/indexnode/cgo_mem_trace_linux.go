@@ -0,0 +1,73 @@
+//go:build linux
+// +build linux
+
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+/*
+#cgo LDFLAGS: -ldl
+
+#define _GNU_SOURCE
+#include <dlfcn.h>
+#include <malloc.h>
+#include <stddef.h>
+#include <stdint.h>
+
+typedef int (*mallctl_fn)(const char*, void*, size_t*, void*, size_t);
+
+// c_heap_allocated reads the bytes allocated from the C heap, from jemalloc when it's preloaded
+// or from glibc otherwise, it returns 0 if neither is available.
+static int c_heap_allocated(uint64_t* allocated) {
+	mallctl_fn mallctl = (mallctl_fn)dlsym(RTLD_DEFAULT, "mallctl");
+	if (mallctl != NULL) {
+		// the stats of jemalloc are cached until the epoch is refreshed.
+		uint64_t epoch = 1;
+		size_t size = sizeof(epoch);
+		mallctl("epoch", &epoch, &size, &epoch, size);
+		size_t value = 0;
+		size = sizeof(value);
+		if (mallctl("stats.allocated", &value, &size, NULL, 0) != 0) {
+			return 0;
+		}
+		*allocated = value;
+		return 1;
+	}
+#if defined(__GLIBC__) && (__GLIBC__ > 2 || (__GLIBC__ == 2 && __GLIBC_MINOR__ >= 33))
+	struct mallinfo2 info = mallinfo2();
+	*allocated = (uint64_t)info.uordblks + (uint64_t)info.hblkhd;
+	return 1;
+#elif defined(__GLIBC__)
+	// the fields of mallinfo are int, they wrap around beyond 2GB.
+	struct mallinfo info = mallinfo();
+	*allocated = (uint64_t)(unsigned int)info.uordblks + (uint64_t)(unsigned int)info.hblkhd;
+	return 1;
+#else
+	return 0;
+#endif
+}
+*/
+import "C"
+
+// cHeapAllocated returns the bytes allocated from the C heap, it's false if the allocator doesn't report it.
+func cHeapAllocated() (uint64, bool) {
+	var allocated C.uint64_t
+	if C.c_heap_allocated(&allocated) == 0 {
+		return 0, false
+	}
+	return uint64(allocated), true
+}
@@ -0,0 +1,83 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestTaskKeyOf(t *testing.T) {
+	key, ok := taskKeyOf(BuildStageInfo{Task: "cluster/10", ClusterID: "cluster"})
+	assert.True(t, ok)
+	assert.Equal(t, taskKey{ClusterID: "cluster", BuildID: 10}, key)
+
+	_, ok = taskKeyOf(BuildStageInfo{Task: "other/10", ClusterID: "cluster"})
+	assert.False(t, ok)
+	_, ok = taskKeyOf(BuildStageInfo{Task: "cluster/verify", ClusterID: "cluster"})
+	assert.False(t, ok)
+}
+
+func TestCGOMemTracer(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	ctx := context.Background()
+	node := &IndexNode{params: params}
+	key := taskKey{ClusterID: "cluster", BuildID: 10}
+	node.tasks.loadOrStore(key, &taskInfo{})
+	allocated, ok := uint64(100), true
+	tracer := newCGOMemTracer(node)
+	tracer.allocated = func() (uint64, bool) { return allocated, ok }
+
+	info := BuildStageInfo{Task: "cluster/10", ClusterID: "cluster", Stage: taskBuilding.String()}
+	assert.NoError(t, tracer.Before(ctx, info))
+	allocated = 1100
+	stageErr := errors.New("stage failed")
+	assert.Equal(t, stageErr, tracer.After(ctx, info, stageErr))
+
+	info.Stage = taskSaving.String()
+	assert.NoError(t, tracer.Before(ctx, info))
+	allocated = 600
+	assert.NoError(t, tracer.After(ctx, info, nil))
+	assert.Empty(t, tracer.starts)
+	assert.Equal(t, []*indexpb.StageMemDelta{
+		{Stage: taskBuilding.String(), AllocatedDelta: 1000},
+		{Stage: taskSaving.String(), AllocatedDelta: -500},
+	}, node.tasks.info(key).stageMemDeltas)
+
+	// the stages are not traced when the allocator doesn't report the C heap.
+	ok = false
+	assert.NoError(t, tracer.Before(ctx, info))
+	assert.NoError(t, tracer.After(ctx, info, nil))
+	assert.Len(t, node.tasks.info(key).stageMemDeltas, 2)
+}
+
+func TestCHeapAllocated(t *testing.T) {
+	allocated, ok := cHeapAllocated()
+	if runtime.GOOS != "linux" {
+		assert.False(t, ok)
+		return
+	}
+	assert.True(t, ok)
+	assert.Greater(t, allocated, uint64(0))
+}
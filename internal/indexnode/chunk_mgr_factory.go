@@ -5,9 +5,14 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/golang/protobuf/proto"
+
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/contextutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"go.uber.org/zap"
 )
 
 type StorageFactory interface {
@@ -15,25 +20,64 @@ type StorageFactory interface {
 }
 
 type chunkMgr struct {
+	params *paramtable.ComponentParam
 	cached sync.Map
 }
 
+// NewChunkManager returns the chunk manager of the storage config, the chunk managers are cached
+// per cluster carried by ctx, so the clusters sharing the IndexNode may use their own bucket and root path.
+// The config with zone endpoints prefers the endpoint of the zone of the node and fails over to the others.
 func (m *chunkMgr) NewChunkManager(ctx context.Context, config *indexpb.StorageConfig) (storage.ChunkManager, error) {
-	key := m.cacheKey(config.StorageType, config.BucketName, config.Address)
+	endpoints := zoneEndpoints(m.params, config)
+	if len(endpoints) == 0 {
+		return m.newEndpointChunkManager(ctx, config)
+	}
+	return newZoneChunkManager(ctx, endpoints, func(ctx context.Context, address string) (storage.ChunkManager, error) {
+		endpointConfig := proto.Clone(config).(*indexpb.StorageConfig)
+		endpointConfig.Address = address
+		endpointConfig.ZoneEndpoints = nil
+		return m.newEndpointChunkManager(ctx, endpointConfig)
+	})
+}
+
+func (m *chunkMgr) newEndpointChunkManager(ctx context.Context, config *indexpb.StorageConfig) (storage.ChunkManager, error) {
+	clusterID := contextutil.ClusterID(ctx)
+	key := m.cacheKey(clusterID, config)
 	if v, ok := m.cached.Load(key); ok {
 		return v.(storage.ChunkManager), nil
 	}
 
-	chunkManagerFactory := storage.NewChunkManagerFactoryWithParam(Params)
-	mgr, err := chunkManagerFactory.NewPersistentStorageChunkManager(ctx)
+	mgr, err := m.newChunkManagerFactory(config).NewPersistentStorageChunkManager(ctx)
 	if err != nil {
 		return nil, err
 	}
 	v, _ := m.cached.LoadOrStore(key, mgr)
-	log.Ctx(ctx).Info("index node successfully init chunk manager")
+	log.Ctx(ctx).Info("index node successfully init chunk manager", zap.String("clusterID", clusterID),
+		zap.String("bucket", config.GetBucketName()), zap.String("rootPath", config.GetRootPath()))
 	return v.(storage.ChunkManager), nil
 }
 
-func (m *chunkMgr) cacheKey(storageType, bucket, address string) string {
-	return fmt.Sprintf("%s/%s/%s", storageType, bucket, address)
+// newChunkManagerFactory uses the remote storage of the config, the local storage is always
+// the one configured for IndexNode.
+func (m *chunkMgr) newChunkManagerFactory(config *indexpb.StorageConfig) *storage.ChunkManagerFactory {
+	if config.GetStorageType() == "" || config.GetStorageType() == "local" {
+		return storage.NewChunkManagerFactoryWithParam(m.params)
+	}
+	return storage.NewChunkManagerFactory(config.GetStorageType(),
+		storage.RootPath(config.GetRootPath()),
+		storage.Address(config.GetAddress()),
+		storage.AccessKeyID(config.GetAccessKeyID()),
+		storage.SecretAccessKeyID(config.GetSecretAccessKey()),
+		storage.UseSSL(config.GetUseSSL()),
+		storage.BucketName(config.GetBucketName()),
+		storage.UseIAM(config.GetUseIAM()),
+		storage.CloudProvider(m.params.MinioCfg.CloudProvider.GetValue()),
+		storage.IAMEndpoint(config.GetIAMEndpoint()),
+		storage.CreateBucket(true),
+	)
+}
+
+func (m *chunkMgr) cacheKey(clusterID string, config *indexpb.StorageConfig) string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s", clusterID, config.GetStorageType(), config.GetBucketName(),
+		config.GetAddress(), config.GetRootPath())
 }
@@ -0,0 +1,115 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/indexmanifest"
+	"github.com/milvus-io/milvus/internal/util/retry"
+)
+
+// chunkedSerializer returns the engine if indexNode.upload.chunkedSerialize is set and the engine is able to
+// serialize the index file by file.
+func (it *indexBuildTask) chunkedSerializer() ChunkedSerializer {
+	if !it.node.params.IndexNodeCfg.UploadChunkedSerialize.GetAsBool() {
+		return nil
+	}
+	serializer, _ := it.engine.(ChunkedSerializer)
+	return serializer
+}
+
+// saveIndexFilesChunked serializes the index one file at a time, and encodes and uploads every file before
+// serializing the next one, so the peak memory of saving is one index file instead of the whole index.
+// The files are uploaded one by one instead of in parallel.
+func (it *indexBuildTask) saveIndexFilesChunked(ctx context.Context) error {
+	defer func() {
+		if err := it.engine.Delete(); err != nil {
+			log.Ctx(ctx).Error("IndexNode indexBuildTask Execute CIndexDelete failed", zap.Error(err))
+		}
+	}()
+
+	// the size of the index files is unknown until they're serialized.
+	key := taskKey{ClusterID: it.ClusterID, BuildID: it.BuildID}
+	it.node.uploads.startTask(key, -1)
+	defer it.node.uploads.finishTask(key)
+
+	savePaths := make([]string, 0)
+	saveFileKeys := make([]string, 0)
+	saveFileSizes := make([]uint64, 0)
+	manifestFiles := make([]indexmanifest.File, 0)
+	upload := func(blob *storage.Blob) error {
+		savePath := it.indexFilePath(blob.Key)
+		saveFn := func() error {
+			return it.cm.Write(ctx, savePath, blob.Value)
+		}
+		release, err := it.node.uploads.acquire(ctx, key)
+		if err != nil {
+			return err
+		}
+		err = retry.Do(ctx, saveFn, retry.Attempts(5))
+		release(int64(len(blob.Value)))
+		if err != nil {
+			log.Ctx(ctx).Warn("index node save index file failed", zap.Error(err), zap.String("savePath", savePath))
+			return err
+		}
+		if it.node.manifestSigner != nil {
+			manifestFiles = append(manifestFiles, indexmanifest.NewFile(blob.Key, blob.Value))
+		}
+		savePaths = append(savePaths, savePath)
+		saveFileKeys = append(saveFileKeys, blob.Key)
+		saveFileSizes = append(saveFileSizes, uint64(len(blob.Value)))
+		return nil
+	}
+
+	codec := storage.NewIndexFileBinlogCodec()
+	// the index params come first, as the codec serializes them.
+	indexParamBlob, err := codec.SerializeIndexParams(it.req.GetBuildID(), it.req.GetIndexVersion(), it.collectionID,
+		it.partitionID, it.segmentID, it.fieldID, it.newIndexParams, it.req.GetIndexName(), it.req.GetIndexID())
+	if err != nil {
+		return err
+	}
+	if err := upload(indexParamBlob); err != nil {
+		return err
+	}
+
+	it.serializedSize = 0
+	encodeAndUpload := func(blob *storage.Blob) error {
+		// use serialized size before encoding
+		it.serializedSize += uint64(len(blob.Value))
+		encoded, err := codec.SerializeFile(it.req.GetBuildID(), it.req.GetIndexVersion(), it.collectionID,
+			it.partitionID, it.segmentID, it.fieldID, it.req.GetIndexName(), it.req.GetIndexID(), blob)
+		if err != nil {
+			return err
+		}
+		return upload(encoded)
+	}
+	if err := it.chunked.SerializeEach(encodeAndUpload); err != nil {
+		log.Ctx(ctx).Warn("IndexNode serialize and upload index files failed", zap.Int64("buildID", it.BuildID), zap.Error(err))
+		return err
+	}
+	if it.pkOffsets != nil {
+		if err := encodeAndUpload(it.pkOffsets); err != nil {
+			return err
+		}
+	}
+	return it.finishSaveIndexFiles(ctx, savePaths, saveFileKeys, saveFileSizes, manifestFiles)
+}
@@ -0,0 +1,108 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
+)
+
+type chunkedMockEngine struct {
+	mockBuildEngine
+	deleted int
+	// serialized is the number of files serialized when each file is uploaded.
+	serialized []int
+}
+
+func (e *chunkedMockEngine) SerializeEach(fn func(blob *storage.Blob) error) error {
+	for i, key := range []string{"index_0", "index_1"} {
+		e.serialized = append(e.serialized, i+1)
+		if err := fn(&storage.Blob{Key: key, Value: []byte(key)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *chunkedMockEngine) Delete() error {
+	e.deleted++
+	return nil
+}
+
+func TestSaveIndexFilesChunked(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	ctx := context.Background()
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	node := &IndexNode{params: params}
+	node.loadOrStoreTask("cluster", 1, &taskInfo{phase: taskSaving})
+	engine := &chunkedMockEngine{}
+	it := &indexBuildTask{
+		ClusterID:      "cluster",
+		BuildID:        1,
+		node:           node,
+		cm:             cm,
+		engine:         engine,
+		req:            &indexpb.CreateJobRequest{ClusterID: "cluster", BuildID: 1, IndexID: 2, IndexVersion: 3, IndexName: "idx"},
+		newIndexParams: map[string]string{"index_type": "HNSW"},
+		tr:             timerecord.NewTimeRecorder("test"),
+	}
+	assert.Nil(t, it.chunkedSerializer())
+
+	params.Save(params.IndexNodeCfg.UploadChunkedSerialize.Key, "true")
+	assert.Same(t, engine, it.chunkedSerializer())
+	it.engine = &mockBuildEngine{}
+	assert.Nil(t, it.chunkedSerializer())
+	it.engine = engine
+
+	it.chunked = it.chunkedSerializer()
+	require.NoError(t, it.SaveIndexFiles(ctx))
+	assert.Equal(t, 1, engine.deleted)
+	assert.Equal(t, 3, len(it.savePaths))
+
+	info := node.tasks.info(taskKey{ClusterID: "cluster", BuildID: 1})
+	assert.Equal(t, []string{storage.IndexParamsKey, "index_0", "index_1"}, info.fileKeys)
+	// the raw sizes of the index files
+	assert.Equal(t, uint64(len("index_0")+len("index_1")), info.serializedSize)
+
+	blobs := make([]*storage.Blob, 0, len(it.savePaths))
+	for i, savePath := range it.savePaths {
+		value, err := cm.Read(ctx, savePath)
+		require.NoError(t, err)
+		assert.Equal(t, info.fileSizes[i], uint64(len(value)))
+		blobs = append(blobs, &storage.Blob{Key: info.fileKeys[i], Value: value})
+	}
+	_, _, _, _, _, _, indexParams, indexName, indexID, datas, err := storage.NewIndexFileBinlogCodec().DeserializeImpl(blobs)
+	require.NoError(t, err)
+	assert.Equal(t, "HNSW", indexParams["index_type"])
+	assert.Equal(t, "idx", indexName)
+	assert.Equal(t, int64(2), indexID)
+	assert.Equal(t, 2, len(datas))
+	assert.Equal(t, []byte("index_1"), datas[1].Value)
+
+	// the index is released by Reset if the task fails before saving it.
+	it.chunked = engine
+	it.Reset()
+	assert.Equal(t, 2, engine.deleted)
+}
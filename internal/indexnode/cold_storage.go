@@ -0,0 +1,112 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+const (
+	storageTierHot  = "hot"
+	storageTierCold = "cold"
+)
+
+var errRestoreTimeout = errors.New("archived binlogs not restored in time")
+
+// coldPaths returns the restorers of the binlogs in the cold roots by their paths.
+func (mcm *multiRootChunkManager) coldPaths() map[string]storage.ObjectRestorer {
+	paths := make(map[string]storage.ObjectRestorer)
+	for filePath, name := range mcm.pathRoots {
+		if restorer, ok := mcm.restorers[name]; ok {
+			paths[filePath] = restorer
+		}
+	}
+	return paths
+}
+
+// awaitRestore restores the archived binlogs of the cold roots of the job, the task waits in AwaitingRestore
+// until all of them are restored and then goes back to Loading. The task is abandoned for retry if the restore
+// doesn't finish within indexNode.coldStorage.restoreTimeout.
+func (it *indexBuildTask) awaitRestore(ctx context.Context) error {
+	mcm, ok := it.cm.(*multiRootChunkManager)
+	if !ok {
+		return nil
+	}
+	pending := mcm.coldPaths()
+	if err := removeRestored(ctx, pending); err != nil || len(pending) == 0 {
+		return err
+	}
+
+	params := it.node.params.IndexNodeCfg
+	days, tier := params.ColdRestoreDays.GetAsInt(), params.ColdRestoreTier.GetValue()
+	for filePath, restorer := range pending {
+		if err := restorer.Restore(ctx, filePath, days, tier); err != nil {
+			return fmt.Errorf("fail to restore the archived binlog %s: %w", filePath, err)
+		}
+	}
+	if err := it.SetPhase(taskAwaitingRestore, ""); err != nil {
+		return err
+	}
+	logger := log.Ctx(ctx).With(zap.Int64("buildID", it.BuildID))
+	logger.Info("index build task awaits the archived binlogs to be restored", zap.Int("binlogs", len(pending)),
+		zap.Int("days", days), zap.String("tier", tier))
+
+	start := time.Now()
+	timeout := params.ColdRestoreTimeout.GetAsDuration(time.Second)
+	ticker := time.NewTicker(params.ColdRestorePollInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for len(pending) > 0 {
+		if time.Since(start) >= timeout {
+			return fmt.Errorf("%w: %d binlogs still archived after %s", errRestoreTimeout, len(pending), timeout)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		if err := removeRestored(ctx, pending); err != nil {
+			return err
+		}
+	}
+	logger.Info("archived binlogs restored", zap.Duration("wait", time.Since(start)))
+	return it.SetPhase(taskLoading, "")
+}
+
+// removeRestored removes the binlogs restored from pending.
+func removeRestored(ctx context.Context, pending map[string]storage.ObjectRestorer) error {
+	for filePath, restorer := range pending {
+		restored, err := restorer.Restored(ctx, filePath)
+		if err != nil {
+			if errors.Is(err, storage.ErrNoSuchKey) {
+				return ErrNoSuchKey
+			}
+			return err
+		}
+		if restored {
+			delete(pending, filePath)
+		}
+	}
+	return nil
+}
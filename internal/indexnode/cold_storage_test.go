@@ -0,0 +1,145 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// mockRestorer restores an archived binlog after it's polled restoreAfter times.
+type mockRestorer struct {
+	mu           sync.Mutex
+	archived     map[string]int
+	restoreAfter int
+	requested    []string
+}
+
+func (r *mockRestorer) Restore(ctx context.Context, filePath string, days int, tier string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requested = append(r.requested, filePath)
+	return nil
+}
+
+func (r *mockRestorer) Restored(ctx context.Context, filePath string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	polls, ok := r.archived[filePath]
+	if !ok {
+		return true, nil
+	}
+	r.archived[filePath] = polls + 1
+	return polls >= r.restoreAfter, nil
+}
+
+func TestAwaitRestore(t *testing.T) {
+	ctx := context.Background()
+	params := paramtable.Get().Namespace()
+	params.Save(params.IndexNodeCfg.ColdRestorePollInterval.Key, "0.01")
+	node := &IndexNode{
+		params:   params,
+		reporter: newJobResultReporter(params),
+	}
+	var transitions []string
+	node.registerPhaseHook(func(key taskKey, from, to taskPhase, failReason string) {
+		transitions = append(transitions, from.String()+"->"+to.String())
+	})
+	newTask := func(buildID UniqueID, restorer *mockRestorer) *indexBuildTask {
+		node.loadOrStoreTask("cluster", buildID, &taskInfo{phase: taskLoading})
+		mcm := newMultiRootChunkManager(&memRootChunkManager{}, map[string]storage.ChunkManager{
+			"archive": &memRootChunkManager{},
+		}, map[string]string{"a": "archive", "b": "archive"})
+		mcm.restorers = map[string]storage.ObjectRestorer{"archive": restorer}
+		return &indexBuildTask{
+			ctx:       ctx,
+			node:      node,
+			ClusterID: "cluster",
+			BuildID:   buildID,
+			cm:        mcm,
+			req:       &indexpb.CreateJobRequest{ClusterID: "cluster", BuildID: buildID},
+		}
+	}
+
+	t.Run("not archived", func(t *testing.T) {
+		restorer := &mockRestorer{archived: map[string]int{}}
+		transitions = nil
+		assert.NoError(t, newTask(1, restorer).awaitRestore(ctx))
+		assert.Empty(t, restorer.requested)
+		assert.Empty(t, transitions)
+	})
+
+	t.Run("restored", func(t *testing.T) {
+		restorer := &mockRestorer{archived: map[string]int{"b": 0}, restoreAfter: 3}
+		transitions = nil
+		it := newTask(2, restorer)
+		assert.NoError(t, it.awaitRestore(ctx))
+		assert.Equal(t, []string{"b"}, restorer.requested)
+		assert.Equal(t, []string{"Loading->AwaitingRestore", "AwaitingRestore->Loading"}, transitions)
+		assert.Equal(t, commonpb.IndexState_InProgress, node.loadTaskState("cluster", 2))
+	})
+
+	t.Run("awaiting", func(t *testing.T) {
+		restorer := &mockRestorer{archived: map[string]int{"a": 0}, restoreAfter: 1 << 30}
+		params.Save(params.IndexNodeCfg.ColdRestoreTimeout.Key, "0.05")
+		defer params.Reset(params.IndexNodeCfg.ColdRestoreTimeout.Key)
+		it := newTask(3, restorer)
+		done := make(chan error, 1)
+		go func() { done <- it.awaitRestore(ctx) }()
+		assert.Eventually(t, func() bool {
+			infos := make(map[UniqueID]*taskInfo)
+			node.foreachTaskInfo(func(clusterID string, buildID UniqueID, info *taskInfo) {
+				if buildID == 3 {
+					infos[buildID] = &taskInfo{phase: info.phase}
+				}
+			})
+			ret, _ := listTaskInfos(infos, &indexpb.QueryJobsRequest{})
+			return len(ret) == 1 && ret[0].GetAwaitingRestore()
+		}, time.Second, 5*time.Millisecond)
+		err := <-done
+		assert.True(t, errors.Is(err, errRestoreTimeout))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		it := newTask(4, &mockRestorer{})
+		it.cm.(*multiRootChunkManager).restorers["archive"] = &missingRestorer{}
+		assert.ErrorIs(t, it.awaitRestore(ctx), ErrNoSuchKey)
+	})
+
+	it := newTask(5, &mockRestorer{})
+	it.cm = &memRootChunkManager{}
+	require.NoError(t, it.awaitRestore(ctx))
+}
+
+type missingRestorer struct {
+	storage.ObjectRestorer
+}
+
+func (r *missingRestorer) Restored(ctx context.Context, filePath string) (bool, error) {
+	return false, storage.WrapErrNoSuchKey(filePath)
+}
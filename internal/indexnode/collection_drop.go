@@ -0,0 +1,225 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	v3rpc "go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	kvmetestore "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/util/cache"
+)
+
+const (
+	// collectionDropRewatchInterval is the wait before watching the collection meta again after the watch failed.
+	collectionDropRewatchInterval = time.Second
+	// droppedCollectionRecords is the number of the dropped collections remembered for the tasks loading later.
+	droppedCollectionRecords = 10000
+	// droppedIndexFilesTimeout bounds the removal of the index files uploaded by an aborted build.
+	droppedIndexFilesTimeout = time.Minute
+)
+
+// collectionDropWatcher watches the collection meta of RootCoord in etcd and aborts the in progress tasks of a
+// collection as soon as it's being dropped, without waiting for IndexCoord to drop the jobs. The index files
+// the aborted builds uploaded are removed once their goroutines exit.
+type collectionDropWatcher struct {
+	node *IndexNode
+	// prefix is the etcd prefix of the collection meta keys.
+	prefix  string
+	dropped cache.Cache[UniqueID, struct{}]
+	wg      sync.WaitGroup
+}
+
+// newCollectionDropWatcher returns nil if the watch is disabled.
+func newCollectionDropWatcher(node *IndexNode) *collectionDropWatcher {
+	if !node.params.IndexNodeCfg.CollectionDropWatchEnable.GetAsBool() {
+		return nil
+	}
+	return &collectionDropWatcher{
+		node:    node,
+		prefix:  path.Join(node.params.EtcdCfg.MetaRootPath.GetValue(), kvmetestore.CollectionMetaPrefix) + "/",
+		dropped: cache.NewCache[UniqueID, struct{}](cache.WithMaximumSize[UniqueID, struct{}](droppedCollectionRecords)),
+	}
+}
+
+// Start starts the watch loop, it exits when ctx is done.
+func (w *collectionDropWatcher) Start(ctx context.Context) {
+	if w.node.etcdCli == nil {
+		log.Warn("IndexNode has no etcd client, the dropped collections are not watched")
+		return
+	}
+	w.wg.Add(1)
+	go w.loop(ctx)
+}
+
+// Close waits for the watch loop to exit.
+func (w *collectionDropWatcher) Close() {
+	w.wg.Wait()
+	w.dropped.Close()
+}
+
+func (w *collectionDropWatcher) loop(ctx context.Context) {
+	defer w.wg.Done()
+	var revision int64
+	for {
+		revision = w.watch(ctx, revision)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(collectionDropRewatchInterval):
+		}
+	}
+}
+
+// watch watches the collection meta from the revision until the watch fails, and returns the revision to watch
+// again from. The collections which are being dropped are handled first if revision is 0.
+func (w *collectionDropWatcher) watch(ctx context.Context, revision int64) int64 {
+	if revision == 0 {
+		var resp *clientv3.GetResponse
+		err := w.node.ioLanes.metaLane().run(ctx, func() error {
+			var err error
+			resp, err = w.node.etcdCli.Get(ctx, w.prefix, clientv3.WithPrefix())
+			return err
+		})
+		if err != nil {
+			log.Warn("IndexNode load the collection meta failed", zap.String("prefix", w.prefix), zap.Error(err))
+			return 0
+		}
+		for _, kv := range resp.Kvs {
+			if collectionID, ok := w.droppedCollection(kv, false); ok {
+				w.onDropped(collectionID)
+			}
+		}
+		revision = resp.Header.GetRevision() + 1
+	}
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	for resp := range w.node.etcdCli.Watch(watchCtx, w.prefix, clientv3.WithPrefix(), clientv3.WithRev(revision)) {
+		if err := resp.Err(); err != nil {
+			log.Warn("IndexNode watch the collection meta failed", zap.String("prefix", w.prefix), zap.Error(err))
+			if err == v3rpc.ErrCompacted {
+				// the events since the revision are gone, reload the collection meta.
+				return 0
+			}
+			return revision
+		}
+		for _, event := range resp.Events {
+			if collectionID, ok := w.droppedCollection(event.Kv, event.Type == mvccpb.DELETE); ok {
+				w.onDropped(collectionID)
+			}
+		}
+		revision = resp.Header.GetRevision() + 1
+	}
+	return revision
+}
+
+// droppedCollection returns the collection of the meta key if the collection is being dropped or dropped: the meta
+// is deleted or replaced by a tombstone, or its state is Dropping or Dropped.
+func (w *collectionDropWatcher) droppedCollection(kv *mvccpb.KeyValue, deleted bool) (UniqueID, bool) {
+	collectionID, err := strconv.ParseInt(strings.TrimPrefix(string(kv.Key), w.prefix), 10, 64)
+	if err != nil {
+		// not the meta of a collection.
+		return 0, false
+	}
+	if deleted || kvmetestore.IsTombstone(string(kv.Value)) {
+		return collectionID, true
+	}
+	info := &etcdpb.CollectionInfo{}
+	if err := proto.Unmarshal(kv.Value, info); err != nil {
+		log.Warn("IndexNode decode the collection meta failed", zap.String("key", string(kv.Key)), zap.Error(err))
+		return 0, false
+	}
+	state := info.GetState()
+	return collectionID, state == etcdpb.CollectionState_CollectionDropping || state == etcdpb.CollectionState_CollectionDropped
+}
+
+func (w *collectionDropWatcher) onDropped(collectionID UniqueID) {
+	if _, ok := w.dropped.GetIfPresent(collectionID); !ok {
+		log.Info("IndexNode abort the tasks of the dropped collection", zap.Int64("collectionID", collectionID))
+	}
+	w.dropped.Put(collectionID, struct{}{})
+	w.node.abortCollectionTasks(collectionID)
+}
+
+// isDropped reports whether the collection is known to be dropped.
+func (w *collectionDropWatcher) isDropped(collectionID UniqueID) bool {
+	if w == nil || collectionID == 0 {
+		return false
+	}
+	_, ok := w.dropped.GetIfPresent(collectionID)
+	return ok
+}
+
+// abortCollectionTasks force fails the in progress tasks of the collection.
+func (i *IndexNode) abortCollectionTasks(collectionID UniqueID) {
+	keys := make([]taskKey, 0)
+	i.tasks.foreach(func(key taskKey, info *taskInfo) {
+		if info.collectionID == collectionID && !info.phase.isTerminal() {
+			keys = append(keys, key)
+		}
+	})
+	for _, key := range keys {
+		i.abortDroppedTask(key, collectionID)
+	}
+}
+
+// abortDroppedTask force fails the task of the dropped collection, its goroutine releases its build slot and local
+// files once it exits.
+func (i *IndexNode) abortDroppedTask(key taskKey, collectionID UniqueID) {
+	reason := fmt.Sprintf("collection %d is dropped", collectionID)
+	if !i.abortTask(key.ClusterID, key.BuildID, reason) {
+		return
+	}
+	log.Info("IndexNode abort the task of the dropped collection", zap.String("ClusterID", key.ClusterID),
+		zap.Int64("buildID", key.BuildID), zap.Int64("collectionID", collectionID))
+	i.recordPreemption(key, reason)
+	countAbortedTask()
+}
+
+// removeDroppedIndexFiles removes the index files uploaded by the unfinished task if its collection is dropped,
+// it's called once the goroutine of the task exits, so no file is uploaded after the removal.
+func (it *indexBuildTask) removeDroppedIndexFiles() {
+	if it.node == nil || !it.node.collectionDrops.isDropped(it.collectionID) || it.req == nil || it.cm == nil ||
+		compactingIndex(it.req) || it.node.loadTaskState(it.ClusterID, it.BuildID) == commonpb.IndexState_Finished {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), droppedIndexFilesTimeout)
+	defer cancel()
+	// the rebuilds in place only upload to their staging directories.
+	prefix := it.indexFilePath("") + "/"
+	if err := it.cm.RemoveWithPrefix(ctx, prefix); err != nil {
+		log.Warn("IndexNode remove the index files of the dropped collection failed", zap.Int64("buildID", it.BuildID),
+			zap.String("prefix", prefix), zap.Error(err))
+		return
+	}
+	log.Info("IndexNode removed the index files of the dropped collection", zap.Int64("buildID", it.BuildID),
+		zap.Int64("collectionID", it.collectionID), zap.String("prefix", prefix))
+}
@@ -0,0 +1,162 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	kvmetestore "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func newTestCollectionDropWatcher(t *testing.T) (*IndexNode, *collectionDropWatcher) {
+	params := paramtable.Get().Namespace()
+	params.Save(params.IndexNodeCfg.CollectionDropWatchEnable.Key, "true")
+	params.Save(params.LocalStorageCfg.Path.Key, t.TempDir())
+	node := &IndexNode{
+		params:   params,
+		sched:    NewTaskScheduler(context.Background(), params),
+		reporter: newJobResultReporter(params),
+	}
+	node.collectionDrops = newCollectionDropWatcher(node)
+	node.collectionDrops.prefix = "indexnode-test/" + t.Name() + "/root-coord/collection/"
+	return node, node.collectionDrops
+}
+
+func collectionMeta(t *testing.T, state etcdpb.CollectionState) []byte {
+	value, err := proto.Marshal(&etcdpb.CollectionInfo{ID: 100, State: state})
+	require.NoError(t, err)
+	return value
+}
+
+func TestDroppedCollection(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	assert.Nil(t, newCollectionDropWatcher(&IndexNode{params: params}))
+	assert.False(t, (*collectionDropWatcher)(nil).isDropped(100))
+
+	_, w := newTestCollectionDropWatcher(t)
+	defer w.Close()
+	key := []byte(w.prefix + "100")
+	for _, c := range []struct {
+		kv      *mvccpb.KeyValue
+		deleted bool
+		dropped bool
+	}{
+		{&mvccpb.KeyValue{Key: key, Value: collectionMeta(t, etcdpb.CollectionState_CollectionCreated)}, false, false},
+		{&mvccpb.KeyValue{Key: key, Value: collectionMeta(t, etcdpb.CollectionState_CollectionDropping)}, false, true},
+		{&mvccpb.KeyValue{Key: key, Value: collectionMeta(t, etcdpb.CollectionState_CollectionDropped)}, false, true},
+		{&mvccpb.KeyValue{Key: key, Value: kvmetestore.ConstructTombstone()}, false, true},
+		{&mvccpb.KeyValue{Key: key}, true, true},
+		{&mvccpb.KeyValue{Key: key, Value: []byte("broken")}, false, false},
+		{&mvccpb.KeyValue{Key: []byte(w.prefix + "100/other"), Value: kvmetestore.ConstructTombstone()}, false, false},
+	} {
+		collectionID, dropped := w.droppedCollection(c.kv, c.deleted)
+		assert.Equal(t, c.dropped, dropped)
+		if dropped {
+			assert.Equal(t, UniqueID(100), collectionID)
+		}
+	}
+}
+
+func TestCollectionDropWatcher(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	node, w := newTestCollectionDropWatcher(t)
+	node.etcdCli = getEtcdClient()
+	node.loadOrStoreTask("cluster", 1, &taskInfo{phase: taskBuilding, collectionID: 100, cancel: func() {}})
+	node.loadOrStoreTask("cluster", 2, &taskInfo{phase: taskBuilding, collectionID: 200})
+	node.loadOrStoreTask("cluster", 3, &taskInfo{phase: taskFinished, collectionID: 100})
+	_, err := node.etcdCli.Put(ctx, w.prefix+"100", string(collectionMeta(t, etcdpb.CollectionState_CollectionCreated)))
+	require.NoError(t, err)
+	defer node.etcdCli.Delete(context.Background(), w.prefix, clientv3.WithPrefix())
+
+	w.Start(ctx)
+	defer func() {
+		cancel()
+		w.Close()
+	}()
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, commonpb.IndexState_InProgress, node.loadTaskState("cluster", 1))
+
+	_, err = node.etcdCli.Put(ctx, w.prefix+"100", string(collectionMeta(t, etcdpb.CollectionState_CollectionDropping)))
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		return node.loadTaskState("cluster", 1) == commonpb.IndexState_Failed
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, commonpb.IndexState_InProgress, node.loadTaskState("cluster", 2))
+	assert.Equal(t, commonpb.IndexState_Finished, node.loadTaskState("cluster", 3))
+	assert.True(t, w.isDropped(100))
+
+	// a task learning its collection after the drop is aborted then.
+	node.loadOrStoreTask("cluster", 4, &taskInfo{phase: taskLoading})
+	node.storeTaskCollection("cluster", 4, 100)
+	assert.Equal(t, commonpb.IndexState_Failed, node.loadTaskState("cluster", 4))
+}
+
+func TestRemoveDroppedIndexFiles(t *testing.T) {
+	ctx := context.Background()
+	node, w := newTestCollectionDropWatcher(t)
+	defer w.Close()
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	newTask := func(buildID UniqueID) *indexBuildTask {
+		node.loadOrStoreTask("cluster", buildID, &taskInfo{phase: taskFailed, collectionID: 100})
+		return &indexBuildTask{
+			ClusterID:    "cluster",
+			BuildID:      buildID,
+			cm:           cm,
+			node:         node,
+			req:          &indexpb.CreateJobRequest{ClusterID: "cluster", BuildID: buildID, IndexVersion: 1},
+			collectionID: 100,
+			partitionID:  2,
+			segmentID:    3,
+		}
+	}
+	it := newTask(1)
+	filePath := it.indexFilePath("HNSW_1")
+	require.NoError(t, cm.Write(ctx, filePath, []byte("slice")))
+	exist := func() bool {
+		ok, err := cm.Exist(ctx, filePath)
+		return err == nil && ok
+	}
+
+	it.removeDroppedIndexFiles()
+	assert.True(t, exist())
+
+	w.dropped.Put(100, struct{}{})
+	finished := newTask(2)
+	node.tasks.update(taskKey{ClusterID: "cluster", BuildID: 2}, func(info *taskInfo) { info.phase = taskFinished })
+	finishedPath := finished.indexFilePath("HNSW_1")
+	require.NoError(t, cm.Write(ctx, finishedPath, []byte("slice")))
+	finished.removeDroppedIndexFiles()
+	ok, err := cm.Exist(ctx, finishedPath)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	it.removeDroppedIndexFiles()
+	assert.False(t, exist())
+}
@@ -0,0 +1,356 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/config"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/hardware"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+const (
+	// configGuardDir is the directory under the local storage path holding the last known good config.
+	configGuardDir = "index_config"
+	// configSnapshotFile is the file of the last known good config, the values are keyed by the param keys.
+	configSnapshotFile = "last_known_good.json"
+	// configGuardMinTasks is the number of the builds done after a change before the failure ratio counts.
+	configGuardMinTasks = 3
+)
+
+// refreshableItems returns the refreshable indexNode params by their keys. The params with formatters are left out,
+// their values can't be saved back as they are read.
+func refreshableItems(params *paramtable.ComponentParam) map[string]*paramtable.ParamItem {
+	items := make(map[string]*paramtable.ParamItem)
+	cfg := reflect.ValueOf(&params.IndexNodeCfg).Elem()
+	itemType := reflect.TypeOf(paramtable.ParamItem{})
+	for i := 0; i < cfg.NumField(); i++ {
+		field := cfg.Type().Field(i)
+		if field.Type != itemType || field.Tag.Get("refreshable") != "true" {
+			continue
+		}
+		item := cfg.Field(i).Addr().Interface().(*paramtable.ParamItem)
+		if item.Formatter == nil {
+			items[item.Key] = item
+		}
+	}
+	return items
+}
+
+// checkConfigValue checks the value has the type of the default value, the values of the string params
+// are not checked.
+func checkConfigValue(defaultValue, value string) error {
+	if strings.EqualFold(defaultValue, "true") || strings.EqualFold(defaultValue, "false") {
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("%q is not a bool", value)
+		}
+		return nil
+	}
+	if _, err := strconv.ParseFloat(defaultValue, 64); err == nil {
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Errorf("%q is not a number", value)
+		}
+	}
+	return nil
+}
+
+// configChanges returns the keys of the values differing between the configs in order.
+func configChanges(from, to map[string]string) []string {
+	keys := make([]string, 0)
+	for key, value := range to {
+		if fromValue, ok := from[key]; ok && fromValue != value {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// configGuard refuses the unsafe dynamic changes of the refreshable indexNode configs. The changes failing the type
+// checks of the params, or unsatisfiable by the resources of the node, are overridden by the last known good values
+// at once. The valid changes are observed for indexNode.configGuard.observeWindow, and rolled back as well if the
+// ratio of the failed builds reaches indexNode.configGuard.failureRatio meanwhile, they become the last known good
+// config otherwise. The last known good config is persisted, so the node restarted with a bad config rolls back too.
+type configGuard struct {
+	node         *IndexNode
+	items        map[string]*paramtable.ParamItem
+	snapshotPath string
+	memoryBytes  func() uint64
+	cpuNum       func() int
+
+	mu       sync.Mutex
+	lastGood map[string]string
+	// candidate is the changed config observed since changedAt, nil if the config is the last known good one.
+	candidate map[string]string
+	changedAt time.Time
+	finished  int
+	failed    int
+	// overridden are the keys of the values overridden by the last known good values.
+	overridden map[string]struct{}
+
+	// touched are the keys changed by the config sources since the last check. The event handlers run under the
+	// lock of the config manager, so they only take touchMu, which is never held while reading the configs.
+	touchMu sync.Mutex
+	touched map[string]struct{}
+	trigger chan struct{}
+
+	wg sync.WaitGroup
+}
+
+func newConfigGuard(node *IndexNode) *configGuard {
+	return &configGuard{
+		node:        node,
+		items:       refreshableItems(node.params),
+		memoryBytes: hardware.GetMemoryCount,
+		cpuNum:      hardware.GetCPUNum,
+		overridden:  make(map[string]struct{}),
+		touched:     make(map[string]struct{}),
+		trigger:     make(chan struct{}, 1),
+	}
+}
+
+// Start checks the configs against the last known good config persisted, and starts the checking loop
+// if indexNode.configGuard.enable is set.
+func (g *configGuard) Start(ctx context.Context) {
+	if !g.node.params.IndexNodeCfg.ConfigGuardEnable.GetAsBool() {
+		return
+	}
+	g.init()
+	for key := range g.items {
+		key := key
+		g.node.params.Watch(key, config.NewHandler("indexnode.configGuard."+key, func(*config.Event) {
+			g.touch(key)
+		}))
+	}
+	g.wg.Add(1)
+	go g.loop(ctx)
+}
+
+// Close waits for the checking loop to exit, the loop exits when the context passed to Start is done.
+func (g *configGuard) Close() {
+	g.wg.Wait()
+}
+
+func (g *configGuard) init() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.snapshotPath = path.Join(g.node.params.LocalStorageCfg.Path.GetValue(), configGuardDir, configSnapshotFile)
+	current := g.collect()
+	persisted, err := g.load()
+	if err != nil {
+		log.Warn("IndexNode load the last known good config failed", zap.String("path", g.snapshotPath), zap.Error(err))
+	}
+	g.lastGood = current
+	err = g.validate(current)
+	if err == nil {
+		g.persist()
+		return
+	}
+	if persisted == nil {
+		log.Warn("IndexNode starts with an invalid config and no last known good config", zap.Error(err))
+		return
+	}
+	for key, value := range persisted {
+		if _, ok := g.items[key]; ok {
+			g.lastGood[key] = value
+		}
+	}
+	g.rollback(current, err.Error())
+}
+
+func (g *configGuard) loop(ctx context.Context) {
+	defer g.wg.Done()
+	ticker := time.NewTicker(g.node.params.IndexNodeCfg.ConfigGuardInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			g.check(now)
+		case <-g.trigger:
+			g.check(time.Now())
+		}
+	}
+}
+
+// touch marks the key changed by a config source and wakes up the checking loop.
+func (g *configGuard) touch(key string) {
+	g.touchMu.Lock()
+	g.touched[key] = struct{}{}
+	g.touchMu.Unlock()
+	select {
+	case g.trigger <- struct{}{}:
+	default:
+	}
+}
+
+func (g *configGuard) takeTouched() []string {
+	g.touchMu.Lock()
+	defer g.touchMu.Unlock()
+	keys := make([]string, 0, len(g.touched))
+	for key := range g.touched {
+		keys = append(keys, key)
+	}
+	g.touched = make(map[string]struct{})
+	return keys
+}
+
+func (g *configGuard) check(now time.Time) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, key := range g.takeTouched() {
+		if _, ok := g.overridden[key]; ok {
+			// the source changed the overridden value again, the new value is checked instead.
+			g.node.params.Reset(key)
+			delete(g.overridden, key)
+		}
+	}
+	current := g.collect()
+	if err := g.validate(current); err != nil {
+		g.rollback(current, err.Error())
+		return
+	}
+	if len(configChanges(g.lastGood, current)) == 0 {
+		g.candidate = nil
+		return
+	}
+	if g.candidate == nil || len(configChanges(g.candidate, current)) > 0 {
+		g.candidate, g.changedAt, g.finished, g.failed = current, now, 0, 0
+		log.Info("IndexNode observe the changed configs", zap.Strings("keys", configChanges(g.lastGood, current)))
+		return
+	}
+	if done := g.finished + g.failed; done >= configGuardMinTasks &&
+		float64(g.failed)/float64(done) >= g.node.params.IndexNodeCfg.ConfigGuardFailureRatio.GetAsFloat() {
+		g.rollback(current, fmt.Sprintf("%d of the %d builds failed after the change", g.failed, done))
+		return
+	}
+	if now.Sub(g.changedAt) >= g.node.params.IndexNodeCfg.ConfigGuardObserveWindow.GetAsDuration(time.Second) {
+		log.Info("IndexNode take the changed configs as the last known good", zap.Strings("keys", configChanges(g.lastGood, current)))
+		g.lastGood, g.candidate = current, nil
+		g.persist()
+	}
+}
+
+// onPhase counts the builds done while a changed config is observed.
+func (g *configGuard) onPhase(key taskKey, from, to taskPhase, failReason string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.candidate == nil {
+		return
+	}
+	switch to {
+	case taskFinished:
+		g.finished++
+	case taskFailed:
+		g.failed++
+	}
+}
+
+func (g *configGuard) collect() map[string]string {
+	values := make(map[string]string, len(g.items))
+	for key, item := range g.items {
+		values[key] = item.GetValue()
+	}
+	return values
+}
+
+// validate checks the types of the values, and that the build arenas of the config fit
+// in the memory budget of indexNode.configGuard.memoryRatio.
+func (g *configGuard) validate(values map[string]string) error {
+	for key, item := range g.items {
+		if err := checkConfigValue(item.DefaultValue, values[key]); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+	}
+	cfg := &g.node.params.IndexNodeCfg
+	number := func(item *paramtable.ParamItem) float64 {
+		v, _ := strconv.ParseFloat(values[item.Key], 64)
+		return v
+	}
+	parallel := func(item *paramtable.ParamItem) float64 {
+		if v := number(item); v > 0 {
+			return v
+		}
+		return float64(g.cpuNum())
+	}
+	budget := float64(g.memoryBytes()) * cfg.ConfigGuardMemoryRatio.GetAsFloat()
+	if strings.TrimSpace(values[cfg.ArenaStages.Key]) != "" {
+		if need := parallel(&cfg.BuildTuneMaxParallel) * number(&cfg.ArenaChunkSize) * 1024 * 1024; need > budget {
+			return fmt.Errorf("the build arenas of %s and %s take %.0f bytes, over the memory budget of %.0f bytes",
+				cfg.BuildTuneMaxParallel.Key, cfg.ArenaChunkSize.Key, need, budget)
+		}
+	}
+	return nil
+}
+
+// rollback overrides the values differing from the last known good config, the observation of the change ends.
+func (g *configGuard) rollback(current map[string]string, reason string) {
+	keys := configChanges(g.lastGood, current)
+	for _, key := range keys {
+		g.node.params.Save(key, g.lastGood[key])
+		g.overridden[key] = struct{}{}
+	}
+	g.candidate = nil
+	log.Warn("IndexNode roll back the configs to the last known good", zap.Strings("keys", keys), zap.String("reason", reason))
+}
+
+func (g *configGuard) load() (map[string]string, error) {
+	data, err := os.ReadFile(g.snapshotPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]string)
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// persist writes the last known good config, the file is replaced at once so a crash never leaves half of it.
+func (g *configGuard) persist() {
+	data, err := json.Marshal(g.lastGood)
+	if err == nil {
+		err = os.MkdirAll(path.Dir(g.snapshotPath), os.ModePerm)
+	}
+	if err == nil {
+		tmpPath := g.snapshotPath + ".tmp"
+		if err = os.WriteFile(tmpPath, data, 0o644); err == nil {
+			err = os.Rename(tmpPath, g.snapshotPath)
+		}
+	}
+	if err != nil {
+		log.Warn("IndexNode persist the last known good config failed", zap.String("path", g.snapshotPath), zap.Error(err))
+	}
+}
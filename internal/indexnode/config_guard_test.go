@@ -0,0 +1,130 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestCheckConfigValue(t *testing.T) {
+	assert.NoError(t, checkConfigValue("false", "true"))
+	assert.Error(t, checkConfigValue("false", "yes"))
+	assert.NoError(t, checkConfigValue("4", "0.5"))
+	assert.Error(t, checkConfigValue("4", "four"))
+	assert.NoError(t, checkConfigValue("", "anything"))
+	assert.NoError(t, checkConfigValue("fail", "skip"))
+}
+
+func TestRefreshableItems(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	items := refreshableItems(params)
+	assert.Contains(t, items, params.IndexNodeCfg.UploadParallel.Key)
+	// not refreshable
+	assert.NotContains(t, items, params.IndexNodeCfg.BuildParallel.Key)
+	// formatted
+	assert.NotContains(t, items, params.IndexNodeCfg.MaxDiskUsagePercentage.Key)
+}
+
+func TestConfigGuard(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	params.Save(params.LocalStorageCfg.Path.Key, t.TempDir())
+	cfg := &params.IndexNodeCfg
+	node := &IndexNode{params: params}
+	guard := newConfigGuard(node)
+	guard.memoryBytes = func() uint64 { return 4 << 30 }
+	guard.cpuNum = func() int { return 4 }
+	guard.init()
+	persisted, err := guard.load()
+	require.NoError(t, err)
+	assert.Equal(t, "false", persisted[cfg.HandoffEnable.Key])
+
+	now := time.Now()
+	t.Run("invalid value", func(t *testing.T) {
+		params.Save(cfg.UploadParallel.Key, "many")
+		guard.check(now)
+		assert.Equal(t, "0", cfg.UploadParallel.GetValue())
+		assert.Contains(t, guard.overridden, cfg.UploadParallel.Key)
+
+		// the overridden value is dropped once the source changes it again
+		guard.touch(cfg.UploadParallel.Key)
+		guard.check(now)
+		assert.NotContains(t, guard.overridden, cfg.UploadParallel.Key)
+	})
+
+	t.Run("over memory budget", func(t *testing.T) {
+		// 8 builds of 1GB arena chunks are over the budget of 2GB
+		params.Save(cfg.ArenaStages.Key, "Loading")
+		params.Save(cfg.BuildTuneMaxParallel.Key, "8")
+		params.Save(cfg.ArenaChunkSize.Key, "1024")
+		guard.check(now)
+		assert.Equal(t, "", cfg.ArenaStages.GetValue())
+		assert.Equal(t, 0, cfg.BuildTuneMaxParallel.GetAsInt())
+		assert.Nil(t, guard.candidate)
+		for _, key := range []string{cfg.ArenaStages.Key, cfg.BuildTuneMaxParallel.Key, cfg.ArenaChunkSize.Key} {
+			params.Reset(key)
+		}
+	})
+
+	t.Run("degraded", func(t *testing.T) {
+		params.Save(cfg.HandoffEnable.Key, "true")
+		guard.check(now)
+		require.NotNil(t, guard.candidate)
+		assert.True(t, cfg.HandoffEnable.GetAsBool())
+		guard.onPhase(taskKey{BuildID: 1}, taskSaving, taskFinished, "")
+		guard.onPhase(taskKey{BuildID: 2}, taskSaving, taskFailed, "")
+		guard.onPhase(taskKey{BuildID: 3}, taskSaving, taskFailed, "")
+		guard.check(now.Add(time.Second))
+		assert.False(t, cfg.HandoffEnable.GetAsBool())
+		assert.Nil(t, guard.candidate)
+		params.Reset(cfg.HandoffEnable.Key)
+	})
+
+	t.Run("good change", func(t *testing.T) {
+		params.Save(cfg.JobLogMaxEntries.Key, "7")
+		defer params.Reset(cfg.JobLogMaxEntries.Key)
+		guard.check(now)
+		require.NotNil(t, guard.candidate)
+		guard.onPhase(taskKey{BuildID: 1}, taskSaving, taskFailed, "")
+		guard.check(now.Add(cfg.ConfigGuardObserveWindow.GetAsDuration(time.Second)))
+		assert.Nil(t, guard.candidate)
+		assert.Equal(t, "7", guard.lastGood[cfg.JobLogMaxEntries.Key])
+		persisted, err := guard.load()
+		require.NoError(t, err)
+		assert.Equal(t, "7", persisted[cfg.JobLogMaxEntries.Key])
+	})
+
+	t.Run("invalid on start", func(t *testing.T) {
+		params.Save(cfg.JobLogMaxEntries.Key, "lots")
+		defer params.Reset(cfg.JobLogMaxEntries.Key)
+		restarted := newConfigGuard(node)
+		restarted.init()
+		assert.Equal(t, "7", cfg.JobLogMaxEntries.GetValue())
+
+		require.NoError(t, os.Remove(restarted.snapshotPath))
+		params.Save(cfg.JobLogMaxEntries.Key, "lots")
+		restarted = newConfigGuard(node)
+		restarted.init()
+		assert.Equal(t, "lots", cfg.JobLogMaxEntries.GetValue())
+	})
+}
@@ -0,0 +1,115 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// maxCPUs bounds the CPU ids of the CPU sets.
+const maxCPUs = 1024
+
+// parseCPUSet parses the CPU ids in the list format of cpuset, e.g. "0-3,8".
+func parseCPUSet(value string) ([]int, error) {
+	cpus := make([]int, 0)
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		bounds := strings.SplitN(part, "-", 2)
+		first, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid cpu set %s: %w", value, err)
+		}
+		last := first
+		if len(bounds) == 2 {
+			if last, err = strconv.Atoi(strings.TrimSpace(bounds[1])); err != nil {
+				return nil, fmt.Errorf("invalid cpu set %s: %w", value, err)
+			}
+		}
+		if first < 0 || last < first || last >= maxCPUs {
+			return nil, fmt.Errorf("invalid cpu range %s in cpu set %s", part, value)
+		}
+		for cpu := first; cpu <= last; cpu++ {
+			cpus = append(cpus, cpu)
+		}
+	}
+	if len(cpus) == 0 {
+		return nil, fmt.Errorf("empty cpu set %s", value)
+	}
+	return cpus, nil
+}
+
+// buildCPUSet returns the CPUs to build on out of the allowed CPUs of the thread,
+// or nil if the builds are not restricted.
+func buildCPUSet(params *paramtable.ComponentParam, allowed []int) ([]int, error) {
+	if !params.IndexNodeCfg.CPUAffinityEnable.GetAsBool() {
+		return nil, nil
+	}
+	if value := params.IndexNodeCfg.CPUAffinityCPUSet.GetValue(); value != "" {
+		return parseCPUSet(value)
+	}
+	if paramtable.GetRole() != typeutil.StandaloneRole || len(allowed) < 2 {
+		return nil, nil
+	}
+	return allowed[len(allowed)/2:], nil
+}
+
+// isolateBuildThread locks the goroutine to its thread and restricts the thread to the CPU set of
+// indexNode.cpuAffinity, the build threads knowhere starts from it inherit the CPU set.
+// It returns the function restoring the thread, which must be called on the same goroutine.
+func isolateBuildThread(ctx context.Context, params *paramtable.ComponentParam) func() {
+	if !params.IndexNodeCfg.CPUAffinityEnable.GetAsBool() {
+		return func() {}
+	}
+	runtime.LockOSThread()
+	allowed, err := getThreadAffinity()
+	var cpus []int
+	if err == nil {
+		cpus, err = buildCPUSet(params, allowed)
+	}
+	if err == nil && cpus != nil {
+		err = setThreadAffinity(cpus)
+	}
+	if err != nil || cpus == nil {
+		if err != nil {
+			log.Ctx(ctx).Warn("IndexNode failed to isolate build thread", zap.Error(err))
+		}
+		runtime.UnlockOSThread()
+		return func() {}
+	}
+	log.Ctx(ctx).Debug("IndexNode isolate build thread", zap.Ints("cpus", cpus))
+	return func() {
+		if err := setThreadAffinity(allowed); err != nil {
+			// keep the thread locked, it exits with the goroutine instead of running other goroutines.
+			log.Ctx(ctx).Warn("IndexNode failed to restore build thread affinity", zap.Error(err))
+			return
+		}
+		runtime.UnlockOSThread()
+	}
+}
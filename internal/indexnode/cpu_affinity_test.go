@@ -0,0 +1,67 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func TestParseCPUSet(t *testing.T) {
+	cpus, err := parseCPUSet("0-3, 8,10-11")
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2, 3, 8, 10, 11}, cpus)
+
+	for _, value := range []string{"", ",", "a", "1-b", "3-1", "-1", "0-1024"} {
+		_, err = parseCPUSet(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestBuildCPUSet(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	allowed := []int{0, 1, 2, 3}
+	cpus, err := buildCPUSet(params, allowed)
+	assert.NoError(t, err)
+	assert.Nil(t, cpus)
+
+	params.Save(params.IndexNodeCfg.CPUAffinityEnable.Key, "true")
+	role := paramtable.GetRole()
+	defer paramtable.SetRole(role)
+
+	paramtable.SetRole(typeutil.IndexNodeRole)
+	cpus, err = buildCPUSet(params, allowed)
+	assert.NoError(t, err)
+	assert.Nil(t, cpus)
+
+	paramtable.SetRole(typeutil.StandaloneRole)
+	cpus, err = buildCPUSet(params, allowed)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 3}, cpus)
+	cpus, err = buildCPUSet(params, []int{0})
+	assert.NoError(t, err)
+	assert.Nil(t, cpus)
+
+	params.Save(params.IndexNodeCfg.CPUAffinityCPUSet.Key, "1-2")
+	cpus, err = buildCPUSet(params, allowed)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, cpus)
+}
@@ -0,0 +1,142 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// the modes of indexNode.rowCountCheck.mode, the unknown ones are strict.
+const (
+	rowCountCheckStrict  = "strict"
+	rowCountCheckLenient = "lenient"
+)
+
+// errDataMismatch is returned when the decoded binlogs disagree with the dim or the row count of the job,
+// retrying the job on the same binlogs would fail again.
+var errDataMismatch = errors.New("binlog data mismatch")
+
+// binlogDiagnostic describes what a single binlog decodes to.
+type binlogDiagnostic struct {
+	path     string
+	fieldIDs []storage.FieldID
+	numRows  int
+	dim      int
+	err      error
+}
+
+func (d binlogDiagnostic) String() string {
+	if d.err != nil {
+		return fmt.Sprintf("%s: decode failed: %v", d.path, d.err)
+	}
+	return fmt.Sprintf("%s: fields %v, %d rows, dim %d", d.path, d.fieldIDs, d.numRows, d.dim)
+}
+
+// vectorDim returns the dim of the vector field data, or 0 for the scalar field data.
+func vectorDim(data storage.FieldData) int {
+	switch f := data.(type) {
+	case *storage.FloatVectorFieldData:
+		return f.Dim
+	case *storage.BinaryVectorFieldData:
+		return f.Dim
+	default:
+		return 0
+	}
+}
+
+// diagnoseBinlogs decodes the binlogs one by one, it's only called on failure as it decodes them again.
+func diagnoseBinlogs(blobs []*Blob) []binlogDiagnostic {
+	var insertCodec storage.InsertCodec
+	diagnostics := make([]binlogDiagnostic, 0, len(blobs))
+	for _, blob := range blobs {
+		diagnostic := binlogDiagnostic{path: blob.Key}
+		_, _, _, insertData, err := insertCodec.DeserializeAll([]*Blob{blob})
+		if err != nil {
+			diagnostic.err = err
+			diagnostics = append(diagnostics, diagnostic)
+			continue
+		}
+		for fieldID, data := range insertData.Data {
+			diagnostic.fieldIDs = append(diagnostic.fieldIDs, fieldID)
+			diagnostic.numRows = data.RowNum()
+			diagnostic.dim = vectorDim(data)
+		}
+		diagnostics = append(diagnostics, diagnostic)
+	}
+	return diagnostics
+}
+
+// checkDecodedData returns an errDataMismatch error describing every binlog if the decoded data has more than
+// one field, or its dim or row count disagree with the job, and records the matching fail code of the task.
+// A row count mismatch is only a warning of the task under the lenient row count check.
+func (it *indexBuildTask) checkDecodedData(ctx context.Context, blobs []*Blob, insertData *storage.InsertData) error {
+	var reason string
+	rowsMismatch := false
+	code := commonpb.ErrorCode_IllegalRowRecord
+	expectedDim := int(it.statistic.Dim)
+	if len(insertData.Data) != 1 {
+		fieldIDs := make([]storage.FieldID, 0, len(insertData.Data))
+		for fieldID := range insertData.Data {
+			fieldIDs = append(fieldIDs, fieldID)
+		}
+		reason = fmt.Sprintf("expect only one field in deserialized insert data, got fields %v", fieldIDs)
+	} else {
+		for _, data := range insertData.Data {
+			if dim := vectorDim(data); expectedDim > 0 && dim > 0 && dim != expectedDim {
+				code = commonpb.ErrorCode_IllegalDimension
+				reason = fmt.Sprintf("dim of the binlogs is %d, the job expects %d", dim, expectedDim)
+			} else if numRows := it.req.GetNumRows(); numRows > 0 && int64(data.RowNum()) != numRows {
+				reason = fmt.Sprintf("the binlogs have %d rows, the job expects %d", data.RowNum(), numRows)
+				rowsMismatch = true
+			}
+		}
+	}
+	if reason == "" {
+		return nil
+	}
+	if rowsMismatch && it.node.params.IndexNodeCfg.RowCountCheckMode.GetValue() == rowCountCheckLenient {
+		it.warn(ctx, "%s, the index is built on the decoded rows", reason)
+		metrics.IndexNodeRowCountMismatchCounter.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), it.ClusterID).Inc()
+		return nil
+	}
+
+	diagnostics := diagnoseBinlogs(blobs)
+	firstBad := "unknown"
+	for _, diagnostic := range diagnostics {
+		if diagnostic.err != nil || len(diagnostic.fieldIDs) != 1 ||
+			(expectedDim > 0 && diagnostic.dim > 0 && diagnostic.dim != expectedDim) {
+			firstBad = diagnostic.path
+			break
+		}
+	}
+	details := make([]string, 0, len(diagnostics))
+	for _, diagnostic := range diagnostics {
+		details = append(details, diagnostic.String())
+	}
+	it.node.storeTaskFailCode(it.ClusterID, it.BuildID, code)
+	return fmt.Errorf("%w: %s, first bad binlog: %s, binlogs: [%s]", errDataMismatch, reason, firstBad,
+		strings.Join(details, "; "))
+}
@@ -0,0 +1,126 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
+)
+
+const testVectorFieldID = 101
+
+// genVectorBinlog serializes numRows vectors of the dim to a binlog of the key.
+func genVectorBinlog(t *testing.T, key string, numRows, dim int) *Blob {
+	insertCodec := storage.NewInsertCodec(&etcdpb.CollectionMeta{
+		ID: 1,
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: testVectorFieldID, Name: "vec", DataType: schemapb.DataType_FloatVector},
+			},
+		},
+	})
+	tsData := make([]int64, numRows)
+	insertData := &storage.InsertData{
+		Data: map[storage.FieldID]storage.FieldData{
+			common.TimeStampField: &storage.Int64FieldData{Data: tsData},
+			testVectorFieldID:     &storage.FloatVectorFieldData{Data: make([]float32, numRows*dim), Dim: dim},
+		},
+	}
+	blobs, _, err := insertCodec.Serialize(2, 3, insertData)
+	require.NoError(t, err)
+	require.Len(t, blobs, 1)
+	blobs[0].Key = key
+	return blobs[0]
+}
+
+func TestDecodeBlobsDataMismatch(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	newTask := func(numRows int64, dim int64) *indexBuildTask {
+		node := &IndexNode{
+			params:   params,
+			reporter: newJobResultReporter(params),
+		}
+		node.loadOrStoreTask("cluster", 1, &taskInfo{phase: taskLoading})
+		return &indexBuildTask{
+			ClusterID: "cluster",
+			BuildID:   1,
+			node:      node,
+			req:       &indexpb.CreateJobRequest{ClusterID: "cluster", BuildID: 1, NumRows: numRows},
+			statistic: indexpb.JobInfo{Dim: dim},
+			tr:        timerecord.NewTimeRecorder("test"),
+		}
+	}
+
+	t.Run("match", func(t *testing.T) {
+		it := newTask(30, 8)
+		blobs := []*Blob{genVectorBinlog(t, "log/1", 10, 8), genVectorBinlog(t, "log/2", 20, 8)}
+		assert.NoError(t, it.decodeBlobs(context.Background(), blobs))
+		assert.Equal(t, int64(30), it.statistic.NumRows)
+	})
+
+	t.Run("rows mismatch", func(t *testing.T) {
+		it := newTask(40, 8)
+		blobs := []*Blob{genVectorBinlog(t, "log/1", 10, 8), genVectorBinlog(t, "log/2", 20, 8)}
+		err := it.decodeBlobs(context.Background(), blobs)
+		assert.True(t, errors.Is(err, errDataMismatch))
+		assert.Contains(t, err.Error(), "the binlogs have 30 rows, the job expects 40")
+		assert.Contains(t, err.Error(), "log/1: fields [101], 10 rows, dim 8")
+		assert.Contains(t, err.Error(), "log/2: fields [101], 20 rows, dim 8")
+		assert.Contains(t, err.Error(), "first bad binlog: unknown")
+
+		assert.NoError(t, it.SetPhase(taskFailed, err.Error()))
+		info := it.node.reporter.pending[taskKey{ClusterID: "cluster", BuildID: 1}]
+		assert.Equal(t, commonpb.ErrorCode_IllegalRowRecord, info.GetFailCode())
+	})
+
+	t.Run("rows mismatch lenient", func(t *testing.T) {
+		params.Save(params.IndexNodeCfg.RowCountCheckMode.Key, rowCountCheckLenient)
+		defer params.Reset(params.IndexNodeCfg.RowCountCheckMode.Key)
+		it := newTask(40, 8)
+		blobs := []*Blob{genVectorBinlog(t, "log/1", 10, 8), genVectorBinlog(t, "log/2", 20, 8)}
+		assert.NoError(t, it.decodeBlobs(context.Background(), blobs))
+		assert.Equal(t, int64(30), it.statistic.NumRows)
+		assert.Equal(t, []string{"the binlogs have 30 rows, the job expects 40, the index is built on the decoded rows"},
+			it.node.tasks.info(taskKey{ClusterID: "cluster", BuildID: 1}).warnings)
+	})
+
+	t.Run("dim mismatch", func(t *testing.T) {
+		it := newTask(0, 8)
+		blobs := []*Blob{genVectorBinlog(t, "log/1", 10, 4), genVectorBinlog(t, "log/2", 10, 4)}
+		err := it.decodeBlobs(context.Background(), blobs)
+		assert.True(t, errors.Is(err, errDataMismatch))
+		assert.Contains(t, err.Error(), "dim of the binlogs is 4, the job expects 8")
+		assert.Contains(t, err.Error(), "first bad binlog: log/1")
+
+		assert.NoError(t, it.SetPhase(taskFailed, err.Error()))
+		info := it.node.reporter.pending[taskKey{ClusterID: "cluster", BuildID: 1}]
+		assert.Equal(t, commonpb.ErrorCode_IllegalDimension, info.GetFailCode())
+	})
+}
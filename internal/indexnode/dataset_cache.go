@@ -0,0 +1,286 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// sharedDataset is a decoded dataset shared read-only by the tasks building indexes of the same data.
+type sharedDataset struct {
+	clusterID    string
+	collectionID UniqueID
+	partitionID  UniqueID
+	segmentID    UniqueID
+	fieldID      UniqueID
+	numRows      int64
+	fieldData    storage.FieldData
+	pkOffsets    *Blob
+}
+
+func (d *sharedDataset) size() int64 {
+	size := int64(d.fieldData.GetMemorySize())
+	if d.pkOffsets != nil {
+		size += int64(len(d.pkOffsets.Value))
+	}
+	return size
+}
+
+type datasetCacheEntry struct {
+	key string
+	// ready is closed once the loading task published the dataset, which is nil if the load failed.
+	ready    chan struct{}
+	dataset  *sharedDataset
+	expireAt time.Time
+	elem     *list.Element
+}
+
+// datasetCache keeps the decoded datasets of the finished loads, so the jobs building several indexes of the
+// same data with different params, like the parameter sweeps of benchmarks, load and decode the binlogs once.
+// A job finding the same data being loaded by another task waits for it instead of loading it again.
+type datasetCache struct {
+	capacity int64
+	ttl      time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*datasetCacheEntry
+	// lru holds the loaded entries, the most recently used first.
+	lru  *list.List
+	size int64
+}
+
+// newDatasetCache returns nil if the cache is disabled.
+func newDatasetCache(params *paramtable.ComponentParam) *datasetCache {
+	capacity := params.IndexNodeCfg.DatasetCacheCapacity.GetAsInt64() * 1024 * 1024
+	if capacity <= 0 {
+		return nil
+	}
+	return &datasetCache{
+		capacity: capacity,
+		ttl:      params.IndexNodeCfg.DatasetCacheTTL.GetAsDuration(time.Second),
+		entries:  make(map[string]*datasetCacheEntry),
+		lru:      list.New(),
+	}
+}
+
+// datasetKey hashes everything determining the decoded dataset of the job: the binlogs read, the row filters
+// and the node settings checking the data. The index params are left out, they only matter to the build.
+func datasetKey(req *indexpb.CreateJobRequest, params *paramtable.ComponentParam) string {
+	h := sha256.New()
+	writeField := func(name, value string) {
+		h.Write([]byte(name + "=" + value + "\n"))
+	}
+	writePaths := func(name string, paths []string) {
+		sorted := append([]string(nil), paths...)
+		sort.Strings(sorted)
+		for _, p := range sorted {
+			writeField(name, p+"@"+req.GetDataPathRoots()[p])
+		}
+	}
+	writeField("cluster", req.GetClusterID())
+	writeField("bucket", req.GetStorageConfig().GetBucketName())
+	writeField("root", req.GetStorageConfig().GetRootPath())
+	writeField("rows", strconv.FormatInt(req.GetNumRows(), 10))
+	writeField("timestamp", strconv.FormatUint(req.GetDataTimestamp(), 10))
+	writeField("pkOffsets", strconv.FormatBool(req.GetEmitPkOffsets()))
+	writeField("rowCountCheck", params.IndexNodeCfg.RowCountCheckMode.GetValue())
+	writeField("nonFinite", params.IndexNodeCfg.NonFiniteVectorPolicy.GetValue())
+	writePaths("data", req.GetDataPaths())
+	writePaths("pk", req.GetPkDataPaths())
+	writeField("type", hashBuildParams(funcutil.KeyValuePair2Map(req.GetTypeParams()), nil))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// acquire returns the dataset of the key, waiting for the task loading it if there is one. If the dataset is
+// neither cached nor loading, it returns a nil dataset and the caller must load it and call publish with the
+// loaded dataset, or with nil if the load failed.
+func (c *datasetCache) acquire(ctx context.Context, key string) (dataset *sharedDataset, publish func(*sharedDataset), err error) {
+	for {
+		c.mu.Lock()
+		c.expireLocked(time.Now())
+		entry, ok := c.entries[key]
+		if !ok {
+			entry = &datasetCacheEntry{key: key, ready: make(chan struct{})}
+			c.entries[key] = entry
+			c.mu.Unlock()
+			return nil, func(dataset *sharedDataset) { c.publish(entry, dataset) }, nil
+		}
+		if entry.elem != nil {
+			c.lru.MoveToFront(entry.elem)
+			c.mu.Unlock()
+			return entry.dataset, nil, nil
+		}
+		c.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		case <-entry.ready:
+		}
+		if entry.dataset != nil {
+			return entry.dataset, nil, nil
+		}
+		// the load failed, the entry is gone and this task tries to load the dataset itself.
+	}
+}
+
+func (c *datasetCache) publish(entry *datasetCacheEntry, dataset *sharedDataset) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry.dataset = dataset
+	close(entry.ready)
+	if dataset == nil || dataset.size() > c.capacity {
+		delete(c.entries, entry.key)
+		return
+	}
+	entry.expireAt = time.Now().Add(c.ttl)
+	entry.elem = c.lru.PushFront(entry)
+	c.size += dataset.size()
+	for c.size > c.capacity {
+		c.removeLocked(c.lru.Back().Value.(*datasetCacheEntry))
+	}
+}
+
+func (c *datasetCache) expireLocked(now time.Time) {
+	for elem := c.lru.Back(); elem != nil; {
+		prev := elem.Prev()
+		if entry := elem.Value.(*datasetCacheEntry); now.After(entry.expireAt) {
+			c.removeLocked(entry)
+		}
+		elem = prev
+	}
+}
+
+func (c *datasetCache) removeLocked(entry *datasetCacheEntry) {
+	c.lru.Remove(entry.elem)
+	c.size -= entry.dataset.size()
+	delete(c.entries, entry.key)
+}
+
+// segments returns the segments of the cached datasets sorted by the cluster, segment and field, the datasets of
+// the same segment field loaded with different filters are reported once with the rows of the most recently used.
+func (c *datasetCache) segments() []metricsinfo.IndexNodeCachedSegment {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.expireLocked(time.Now())
+	segments := make([]metricsinfo.IndexNodeCachedSegment, 0, c.lru.Len())
+	type segmentField struct {
+		clusterID string
+		segmentID UniqueID
+		fieldID   UniqueID
+	}
+	seen := make(map[segmentField]bool, c.lru.Len())
+	for elem := c.lru.Front(); elem != nil; elem = elem.Next() {
+		dataset := elem.Value.(*datasetCacheEntry).dataset
+		key := segmentField{clusterID: dataset.clusterID, segmentID: dataset.segmentID, fieldID: dataset.fieldID}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		segments = append(segments, metricsinfo.IndexNodeCachedSegment{
+			ClusterID:    dataset.clusterID,
+			CollectionID: dataset.collectionID,
+			SegmentID:    dataset.segmentID,
+			FieldID:      dataset.fieldID,
+			NumRows:      dataset.numRows,
+		})
+	}
+	sort.Slice(segments, func(i, j int) bool {
+		a, b := segments[i], segments[j]
+		if a.ClusterID != b.ClusterID {
+			return a.ClusterID < b.ClusterID
+		}
+		if a.SegmentID != b.SegmentID {
+			return a.SegmentID < b.SegmentID
+		}
+		return a.FieldID < b.FieldID
+	})
+	return segments
+}
+
+// Close drops the cached datasets, the tasks still using them keep their references.
+func (c *datasetCache) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for c.lru.Len() > 0 {
+		c.removeLocked(c.lru.Back().Value.(*datasetCacheEntry))
+	}
+}
+
+// loadSharedDataset uses the dataset of an identical load cached by another task of the node. It reports
+// whether the dataset was found, otherwise publish must be called with the outcome of the load of the task
+// if it's not nil.
+func (it *indexBuildTask) loadSharedDataset(ctx context.Context) (loaded bool, publish func(*sharedDataset), err error) {
+	if it.node.datasets == nil {
+		return false, nil, nil
+	}
+	dataset, publish, err := it.node.datasets.acquire(ctx, datasetKey(it.req, it.node.params))
+	if err != nil || dataset == nil {
+		return false, publish, err
+	}
+	it.collectionID = dataset.collectionID
+	it.partitionID = dataset.partitionID
+	it.segmentID = dataset.segmentID
+	it.fieldID = dataset.fieldID
+	it.pkOffsets = dataset.pkOffsets
+	it.statistic.NumRows = dataset.numRows
+	it.datasetMu.Lock()
+	it.fieldData = dataset.fieldData
+	it.datasetMu.Unlock()
+	log.Ctx(ctx).Info("IndexNode reuse the dataset decoded by another task", zap.Int64("buildID", it.BuildID),
+		zap.Int64("segmentID", it.segmentID), zap.Int64("numRows", it.statistic.NumRows))
+	return true, nil, nil
+}
+
+// sharedDataset returns the decoded dataset of the task to share with the other tasks, nil if the data
+// isn't decoded.
+func (it *indexBuildTask) sharedDataset() *sharedDataset {
+	it.datasetMu.Lock()
+	defer it.datasetMu.Unlock()
+	if it.fieldData == nil {
+		return nil
+	}
+	return &sharedDataset{
+		clusterID:    it.ClusterID,
+		collectionID: it.collectionID,
+		partitionID:  it.partitionID,
+		segmentID:    it.segmentID,
+		fieldID:      it.fieldID,
+		numRows:      it.statistic.NumRows,
+		fieldData:    it.fieldData,
+		pkOffsets:    it.pkOffsets,
+	}
+}
@@ -0,0 +1,224 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestDatasetKey(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	newReq := func() *indexpb.CreateJobRequest {
+		return &indexpb.CreateJobRequest{
+			ClusterID:     "cluster",
+			BuildID:       1,
+			IndexVersion:  1,
+			DataPaths:     []string{"a", "b"},
+			StorageConfig: &indexpb.StorageConfig{BucketName: "bucket", RootPath: "files"},
+			IndexParams:   []*commonpb.KeyValuePair{{Key: "index_type", Value: "HNSW"}, {Key: "M", Value: "16"}},
+			TypeParams:    []*commonpb.KeyValuePair{{Key: "dim", Value: "8"}},
+		}
+	}
+	key := datasetKey(newReq(), params)
+
+	// the variants of a parameter sweep share the dataset.
+	req := newReq()
+	req.DataPaths = []string{"b", "a"}
+	req.BuildID = 2
+	req.IndexID = 3
+	req.IndexParams = []*commonpb.KeyValuePair{{Key: "index_type", Value: "IVF_FLAT"}, {Key: "nlist", Value: "128"}}
+	req.EngineVersion = "flat"
+	assert.Equal(t, key, datasetKey(req, params))
+
+	for _, change := range []func(req *indexpb.CreateJobRequest){
+		func(req *indexpb.CreateJobRequest) { req.DataPaths = append(req.DataPaths, "c") },
+		func(req *indexpb.CreateJobRequest) { req.StorageConfig.BucketName = "other" },
+		func(req *indexpb.CreateJobRequest) { req.DataTimestamp = 100 },
+		func(req *indexpb.CreateJobRequest) { req.EmitPkOffsets = true },
+		func(req *indexpb.CreateJobRequest) { req.TypeParams[0].Value = "16" },
+	} {
+		req := newReq()
+		change(req)
+		assert.NotEqual(t, key, datasetKey(req, params))
+	}
+
+	params.Save(params.IndexNodeCfg.NonFiniteVectorPolicy.Key, "zero")
+	defer params.Reset(params.IndexNodeCfg.NonFiniteVectorPolicy.Key)
+	assert.NotEqual(t, key, datasetKey(newReq(), params))
+}
+
+func TestDatasetCache(t *testing.T) {
+	ctx := context.Background()
+	params := paramtable.Get().Namespace()
+	assert.Nil(t, newDatasetCache(params))
+	params.Save(params.IndexNodeCfg.DatasetCacheCapacity.Key, "1")
+	defer params.Reset(params.IndexNodeCfg.DatasetCacheCapacity.Key)
+
+	newDataset := func(rows int) *sharedDataset {
+		return &sharedDataset{
+			segmentID: 1,
+			numRows:   int64(rows),
+			fieldData: &storage.FloatVectorFieldData{Dim: 1, Data: make([]float32, rows)},
+		}
+	}
+
+	t.Run("share", func(t *testing.T) {
+		cache := newDatasetCache(params)
+		defer cache.Close()
+		dataset, publish, err := cache.acquire(ctx, "a")
+		assert.NoError(t, err)
+		assert.Nil(t, dataset)
+		assert.NotNil(t, publish)
+
+		// the tasks arriving while the data is loaded wait for it.
+		waited := make(chan *sharedDataset)
+		go func() {
+			dataset, _, _ := cache.acquire(ctx, "a")
+			waited <- dataset
+		}()
+		loaded := newDataset(10)
+		publish(loaded)
+		assert.Same(t, loaded, <-waited)
+		dataset, publish, err = cache.acquire(ctx, "a")
+		assert.NoError(t, err)
+		assert.Same(t, loaded, dataset)
+		assert.Nil(t, publish)
+	})
+
+	t.Run("failed load", func(t *testing.T) {
+		cache := newDatasetCache(params)
+		defer cache.Close()
+		_, publish, _ := cache.acquire(ctx, "a")
+		acquired := make(chan func(*sharedDataset))
+		go func() {
+			_, publish, _ := cache.acquire(ctx, "a")
+			acquired <- publish
+		}()
+		publish(nil)
+		// the waiting task loads the data itself.
+		publish = <-acquired
+		assert.NotNil(t, publish)
+		publish(nil)
+
+		canceled, cancel := context.WithCancel(ctx)
+		_, publish, _ = cache.acquire(ctx, "a")
+		cancel()
+		_, _, err := cache.acquire(canceled, "a")
+		assert.ErrorIs(t, err, context.Canceled)
+		publish(nil)
+	})
+
+	t.Run("capacity", func(t *testing.T) {
+		cache := newDatasetCache(params)
+		defer cache.Close()
+		for _, key := range []string{"a", "b"} {
+			_, publish, _ := cache.acquire(ctx, key)
+			publish(newDataset(128*1024 - 1))
+		}
+		// half of the capacity each with the dim, the least recently used one is evicted.
+		dataset, _, _ := cache.acquire(ctx, "a")
+		assert.NotNil(t, dataset)
+		_, publish, _ := cache.acquire(ctx, "c")
+		publish(newDataset(128*1024 - 1))
+		dataset, _, _ = cache.acquire(ctx, "a")
+		assert.NotNil(t, dataset)
+		dataset, publish, _ = cache.acquire(ctx, "b")
+		assert.Nil(t, dataset)
+		// a dataset larger than the capacity is never kept.
+		publish(newDataset(512 * 1024))
+		dataset, publish, _ = cache.acquire(ctx, "b")
+		assert.Nil(t, dataset)
+		publish(nil)
+		assert.Equal(t, int64(2*128*1024*4), cache.size)
+	})
+
+	t.Run("expire", func(t *testing.T) {
+		cache := newDatasetCache(params)
+		defer cache.Close()
+		cache.ttl = time.Millisecond
+		_, publish, _ := cache.acquire(ctx, "a")
+		publish(newDataset(10))
+		time.Sleep(10 * time.Millisecond)
+		dataset, publish, _ := cache.acquire(ctx, "a")
+		assert.Nil(t, dataset)
+		publish(nil)
+		assert.Equal(t, int64(0), cache.size)
+	})
+
+	t.Run("segments", func(t *testing.T) {
+		var disabled *datasetCache
+		assert.Nil(t, disabled.segments())
+		cache := newDatasetCache(params)
+		defer cache.Close()
+		for key, dataset := range map[string]*sharedDataset{
+			"a": {clusterID: "cluster", collectionID: 100, segmentID: 2, fieldID: 101},
+			"b": {clusterID: "cluster", collectionID: 100, segmentID: 1, fieldID: 101},
+			"c": {clusterID: "cluster", collectionID: 100, segmentID: 2, fieldID: 101},
+			"d": {clusterID: "another", collectionID: 100, segmentID: 2, fieldID: 101},
+		} {
+			dataset.numRows = 10
+			dataset.fieldData = &storage.FloatVectorFieldData{Dim: 1, Data: make([]float32, 10)}
+			_, publish, _ := cache.acquire(ctx, key)
+			publish(dataset)
+		}
+		// the datasets of the same segment loaded with different filters are reported once.
+		assert.Equal(t, []metricsinfo.IndexNodeCachedSegment{
+			{ClusterID: "another", CollectionID: 100, SegmentID: 2, FieldID: 101, NumRows: 10},
+			{ClusterID: "cluster", CollectionID: 100, SegmentID: 1, FieldID: 101, NumRows: 10},
+			{ClusterID: "cluster", CollectionID: 100, SegmentID: 2, FieldID: 101, NumRows: 10},
+		}, cache.segments())
+
+		cache.Close()
+		assert.Empty(t, cache.segments())
+	})
+
+	t.Run("task", func(t *testing.T) {
+		node := &IndexNode{params: params, datasets: newDatasetCache(params)}
+		defer node.datasets.Close()
+		req := &indexpb.CreateJobRequest{ClusterID: "cluster", BuildID: 1, DataPaths: []string{"a"}}
+		loader := &indexBuildTask{BuildID: 1, ClusterID: "cluster", node: node, req: req}
+		loaded, publish, err := loader.loadSharedDataset(ctx)
+		assert.NoError(t, err)
+		assert.False(t, loaded)
+		loader.collectionID, loader.segmentID, loader.fieldID = 100, 1, 101
+		loader.statistic.NumRows = 2
+		loader.fieldData = &storage.FloatVectorFieldData{Dim: 1, Data: []float32{1, 2}}
+		publish(loader.sharedDataset())
+
+		it := &indexBuildTask{BuildID: 2, node: node, req: &indexpb.CreateJobRequest{ClusterID: "cluster", BuildID: 2,
+			DataPaths: []string{"a"}, IndexParams: []*commonpb.KeyValuePair{{Key: "index_type", Value: "IVF_FLAT"}}}}
+		loaded, _, err = it.loadSharedDataset(ctx)
+		assert.NoError(t, err)
+		assert.True(t, loaded)
+		assert.Equal(t, int64(100), it.collectionID)
+		assert.Equal(t, []metricsinfo.IndexNodeCachedSegment{{ClusterID: "cluster", CollectionID: 100, SegmentID: 1,
+			FieldID: 101, NumRows: 2}}, node.datasets.segments())
+		assert.Equal(t, int64(101), it.fieldID)
+		assert.Equal(t, int64(2), it.statistic.NumRows)
+		assert.Same(t, loader.fieldData, it.fieldData)
+	})
+}
@@ -0,0 +1,167 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+const decisionLogFile = "scheduler_decisions"
+
+// decisionKind is the kind of a scheduling decision.
+type decisionKind string
+
+const (
+	// decisionEnqueue is a task accepted into the queue.
+	decisionEnqueue decisionKind = "enqueue"
+	// decisionReject is a task rejected by the full queue.
+	decisionReject decisionKind = "reject"
+	// decisionPick is a queued task picked to run by fair share.
+	decisionPick decisionKind = "pick"
+	// decisionSlot is a build slot assigned to a picked task.
+	decisionSlot decisionKind = "slot"
+	// decisionPreempt is a running task aborted to free its build slot, under memory pressure or exceeding
+	// the max lifetime.
+	decisionPreempt decisionKind = "preempt"
+	// decisionWithdraw is a queued task removed from the queue without running, such as handed off to another node.
+	decisionWithdraw decisionKind = "withdraw"
+)
+
+// decisionTenant is the fair share state of a tenant with queued tasks when a task is picked.
+type decisionTenant struct {
+	Tenant string `json:"tenant"`
+	// Head is the earliest queued task of the tenant, the one picked if the tenant runs next.
+	Head   string  `json:"head"`
+	Queued int     `json:"queued"`
+	Pass   float64 `json:"pass"`
+	Weight float64 `json:"weight"`
+}
+
+// schedDecision is a scheduling decision with the inputs it's made from, a json line in the decision log.
+type schedDecision struct {
+	Seq    int64        `json:"seq"`
+	Time   time.Time    `json:"time"`
+	Kind   decisionKind `json:"kind"`
+	Task   string       `json:"task"`
+	Tenant string       `json:"tenant"`
+	Reason string       `json:"reason,omitempty"`
+	// Tenants are the tenants with queued tasks a pick chooses among, in the order of their earliest tasks.
+	Tenants []decisionTenant `json:"tenants,omitempty"`
+	// Skipped are the queued tasks a pick passes over as the build slots of their index types are full.
+	Skipped []string `json:"skipped,omitempty"`
+	// Parallel is the build parallelism and Slots the occupied build slots when a slot is assigned or a task
+	// is preempted.
+	Parallel int `json:"parallel,omitempty"`
+	Slots    int `json:"slots,omitempty"`
+}
+
+// readDecisionLog decodes the decisions of a decision log. The log of a crashed node may end with a partially
+// written line, which is dropped silently, a corrupt line in the middle fails the read.
+func readDecisionLog(reader io.Reader) ([]*schedDecision, error) {
+	r := bufio.NewReader(reader)
+	decisions := make([]*schedDecision, 0)
+	for {
+		line, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			return decisions, nil
+		}
+		if err != nil {
+			return decisions, err
+		}
+		d := &schedDecision{}
+		if err := json.Unmarshal(line, d); err != nil {
+			return decisions, fmt.Errorf("corrupt decision log line after %d decisions: %w", len(decisions), err)
+		}
+		decisions = append(decisions, d)
+	}
+}
+
+// decisionLog appends every scheduling decision of the node with its inputs as a json line to a local file,
+// for the replay harness to re-run the decisions. The file rotates once it exceeds maxSize keeping one old file.
+type decisionLog struct {
+	mu   sync.Mutex
+	file *rotatingFile
+	seq  int64
+}
+
+func openDecisionLog(dir string, maxSize int64) (*decisionLog, error) {
+	file, err := openRotatingFile(dir, decisionLogFile, maxSize)
+	if err != nil {
+		return nil, err
+	}
+	return &decisionLog{file: file}, nil
+}
+
+// record numbers the decision and appends it to the log, the decision is timed now unless it has the time
+// it's made at. The write errors are logged and never fail the scheduling.
+func (l *decisionLog) record(d *schedDecision) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.seq++
+	d.Seq = l.seq
+	if d.Time.IsZero() {
+		d.Time = time.Now()
+	}
+	buf, err := json.Marshal(d)
+	if err == nil {
+		err = l.file.write(append(buf, '\n'))
+	}
+	if err != nil {
+		log.RatedWarn(60, "IndexNode write scheduler decision log failed", zap.String("dir", l.file.dir), zap.Error(err))
+	}
+}
+
+func (l *decisionLog) Close() {
+	l.file.Close()
+}
+
+// initDecisionLog opens the decision log and records the scheduling decisions in it if the log is enabled.
+func (i *IndexNode) initDecisionLog() error {
+	if !i.params.IndexNodeCfg.DecisionLogEnable.GetAsBool() {
+		return nil
+	}
+	dir := i.params.IndexNodeCfg.DecisionLogPath.GetValue()
+	if dir == "" {
+		dir = path.Join(i.params.LocalStorageCfg.Path.GetValue(), "index_decisions")
+	}
+	decisions, err := openDecisionLog(dir, i.params.IndexNodeCfg.DecisionLogMaxSize.GetAsInt64()*1024*1024)
+	if err != nil {
+		return err
+	}
+	i.sched.decisions = decisions
+	log.Info("IndexNode scheduler decision log enabled", zap.String("dir", dir))
+	return nil
+}
+
+// recordPreemption records the force failed task in the decision log if it's enabled.
+func (i *IndexNode) recordPreemption(key taskKey, reason string) {
+	if i.sched.decisions == nil {
+		return
+	}
+	i.sched.decisions.record(&schedDecision{Kind: decisionPreempt, Task: fmt.Sprintf("%s/%d", key.ClusterID, key.BuildID),
+		Tenant: key.ClusterID, Reason: reason, Parallel: i.sched.getBuildParallel(), Slots: len(i.sched.slotNames())})
+}
@@ -0,0 +1,74 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestDecisionLog(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	params.Save(params.IndexNodeCfg.SchedulerTenantWeights.Key, `{"a":"2"}`)
+	dir := t.TempDir()
+	sched := NewTaskScheduler(context.Background(), params)
+	decisions, err := openDecisionLog(dir, 1<<20)
+	require.NoError(t, err)
+	sched.decisions = decisions
+	queue := sched.IndexBuildQueue.(*IndexTaskQueue)
+	queue.maxTaskNum = 3
+
+	assert.NoError(t, queue.addUnissuedTask(&fakeTask{id: 1, tenant: "a"}))
+	assert.NoError(t, queue.addUnissuedTask(&fakeTask{id: 2, tenant: "a"}))
+	assert.NoError(t, queue.addUnissuedTask(&fakeTask{id: 3, tenant: "b"}))
+	assert.Error(t, queue.addUnissuedTask(&fakeTask{id: 4, tenant: "b"}))
+	assert.Equal(t, "fake-task-1", queue.PopUnissuedTask().Name())
+	decisions.Close()
+
+	data, err := os.ReadFile(path.Join(dir, decisionLogFile))
+	require.NoError(t, err)
+	logged, err := readDecisionLog(bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Len(t, logged, 5)
+	for i, kind := range []decisionKind{decisionEnqueue, decisionEnqueue, decisionEnqueue, decisionReject, decisionPick} {
+		assert.Equal(t, int64(i+1), logged[i].Seq)
+		assert.Equal(t, kind, logged[i].Kind)
+		assert.False(t, logged[i].Time.IsZero())
+	}
+	assert.Equal(t, "fake-task-4", logged[3].Task)
+	assert.Equal(t, "queue is full", logged[3].Reason)
+	assert.Equal(t, "fake-task-1", logged[4].Task)
+	assert.Equal(t, []decisionTenant{
+		{Tenant: "a", Head: "fake-task-1", Queued: 2, Pass: 0, Weight: 2},
+		{Tenant: "b", Head: "fake-task-3", Queued: 1, Pass: 0, Weight: 1},
+	}, logged[4].Tenants)
+
+	// the partially written last line is dropped, a corrupt line in the middle fails the read
+	logged, err = readDecisionLog(bytes.NewReader(append(data, data[:10]...)))
+	assert.NoError(t, err)
+	assert.Len(t, logged, 5)
+	_, err = readDecisionLog(bytes.NewReader(append(append(data[:10:10], '\n'), data...)))
+	assert.Error(t, err)
+}
@@ -0,0 +1,275 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// simClock is the simulated clock of the replay, it's set to the time of every decision before re-running it.
+type simClock struct {
+	now time.Time
+}
+
+func (c *simClock) Now() time.Time {
+	return c.now
+}
+
+// replayTask stands for a logged task in the replay, it has only the name and the tenant the scheduling reads.
+type replayTask struct {
+	name   string
+	tenant string
+}
+
+var _ task = &replayTask{}
+
+func (t *replayTask) Ctx() context.Context {
+	return context.Background()
+}
+
+func (t *replayTask) Name() string {
+	return t.name
+}
+
+func (t *replayTask) Prepare(context.Context) error {
+	return nil
+}
+
+func (t *replayTask) LoadData(context.Context) error {
+	return nil
+}
+
+func (t *replayTask) BuildIndex(context.Context) error {
+	return nil
+}
+
+func (t *replayTask) SaveIndexFiles(context.Context) error {
+	return nil
+}
+
+func (t *replayTask) OnEnqueue(context.Context) error {
+	return nil
+}
+
+func (t *replayTask) SetPhase(phase taskPhase, reason string) error {
+	return nil
+}
+
+func (t *replayTask) GetState() commonpb.IndexState {
+	return commonpb.IndexState_Unissued
+}
+
+func (t *replayTask) Tenant() string {
+	return t.tenant
+}
+
+func (t *replayTask) IndexType() string {
+	return ""
+}
+
+func (t *replayTask) Reset() {
+}
+
+// TenantReplayStats are the scheduling stats of a tenant in a decision log replay.
+type TenantReplayStats struct {
+	Tenant    string
+	Enqueued  int
+	Rejected  int
+	Picked    int
+	Preempted int
+	Withdrawn int
+	MeanWait  time.Duration
+	MaxWait   time.Duration
+	// Queued is the number of the tasks still queued at the end of the replay, and OldestWait the wait of the
+	// earliest of them by then, a long one hints the tenant is starving.
+	Queued     int
+	OldestWait time.Duration
+
+	totalWait time.Duration
+}
+
+// DecisionReplayReport is the result of replaying a scheduler decision log.
+type DecisionReplayReport struct {
+	// Replayed is the number of the decisions reproduced.
+	Replayed int
+	// Divergence describes the first decision not reproduced, where the replay stops. It's empty if every
+	// decision is reproduced.
+	Divergence string
+	// Tenants are the stats of the tenants sorted by name.
+	Tenants []*TenantReplayStats
+}
+
+// String formats the report for printing.
+func (r *DecisionReplayReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "replayed decisions: %d\n", r.Replayed)
+	if r.Divergence != "" {
+		fmt.Fprintf(&b, "diverged: %s\n", r.Divergence)
+	}
+	for _, s := range r.Tenants {
+		fmt.Fprintf(&b, "tenant %s: enqueued %d, rejected %d, picked %d, preempted %d, withdrawn %d, mean wait %s, "+
+			"max wait %s, still queued %d, oldest wait %s\n", s.Tenant, s.Enqueued, s.Rejected, s.Picked, s.Preempted,
+			s.Withdrawn, s.MeanWait, s.MaxWait, s.Queued, s.OldestWait)
+	}
+	return b.String()
+}
+
+// replayDecisions re-runs the logged decisions in order through a task queue with a simulated clock, checking
+// every enqueue, rejection and pick is made again from the same fair share state. The tenant weights of params
+// are overridden by the logged ones.
+func replayDecisions(params *paramtable.ComponentParam, decisions []*schedDecision) *DecisionReplayReport {
+	clock := &simClock{}
+	queue := NewIndexBuildTaskQueue(nil, params)
+	queue.clock = clock.Now
+
+	report := &DecisionReplayReport{}
+	tenants := make(map[string]*TenantReplayStats)
+	stats := func(tenant string) *TenantReplayStats {
+		if _, ok := tenants[tenant]; !ok {
+			tenants[tenant] = &TenantReplayStats{Tenant: tenant}
+		}
+		return tenants[tenant]
+	}
+	// queued are the queued tasks, picked are the tasks picked and not assigned a slot yet.
+	queued := make(map[string]*queuedTask)
+	picked := make(map[string]bool)
+	replay := func(d *schedDecision) string {
+		clock.now = d.Time
+		switch d.Kind {
+		case decisionEnqueue:
+			t := &replayTask{name: d.Task, tenant: d.Tenant}
+			if err := queue.addUnissuedTask(t); err != nil {
+				return fmt.Sprintf("enqueue of %s failed: %s", d.Task, err.Error())
+			}
+			// the build loop is not running, drain the signal of the new task.
+			<-queue.utChan()
+			queued[d.Task] = &queuedTask{task: t, enqueueTime: d.Time}
+			stats(d.Tenant).Enqueued++
+		case decisionReject:
+			if err := queue.addUnissuedTask(&replayTask{name: d.Task, tenant: d.Tenant}); err == nil {
+				return fmt.Sprintf("%s is enqueued instead of rejected", d.Task)
+			}
+			stats(d.Tenant).Rejected++
+		case decisionPick:
+			weights := make(map[string]float64, len(d.Tenants))
+			for _, tenant := range d.Tenants {
+				weights[tenant.Tenant] = tenant.Weight
+			}
+			value, _ := json.Marshal(weights)
+			params.Save(params.IndexNodeCfg.SchedulerTenantWeights.Key, string(value))
+			queue.utLock.Lock()
+			state := queue.queuedTenantsLocked()
+			queue.utLock.Unlock()
+			if !reflect.DeepEqual(state, d.Tenants) {
+				return fmt.Sprintf("fair share state before picking %s is %+v, logged %+v", d.Task, state, d.Tenants)
+			}
+			skipped := make(map[string]bool, len(d.Skipped))
+			for _, name := range d.Skipped {
+				skipped[name] = true
+			}
+			t := queue.popAdmittedTask(func(t task) bool {
+				return !skipped[t.Name()]
+			})
+			if t == nil {
+				return fmt.Sprintf("no task is picked, logged %s", d.Task)
+			}
+			if t.Name() != d.Task {
+				return fmt.Sprintf("%s is picked, logged %s", t.Name(), d.Task)
+			}
+			s := stats(d.Tenant)
+			wait := d.Time.Sub(queued[d.Task].enqueueTime)
+			s.Picked++
+			s.totalWait += wait
+			if wait > s.MaxWait {
+				s.MaxWait = wait
+			}
+			delete(queued, d.Task)
+			picked[d.Task] = true
+		case decisionSlot:
+			if !picked[d.Task] {
+				return fmt.Sprintf("%s is assigned a slot without being picked", d.Task)
+			}
+			delete(picked, d.Task)
+		case decisionPreempt:
+			stats(d.Tenant).Preempted++
+		case decisionWithdraw:
+			removed := queue.removeUnissuedTasks(func(t task) bool {
+				return t.Name() == d.Task
+			}, d.Reason)
+			if len(removed) == 0 {
+				return fmt.Sprintf("%s is withdrawn without being queued", d.Task)
+			}
+			delete(queued, d.Task)
+			stats(d.Tenant).Withdrawn++
+		default:
+			return fmt.Sprintf("unknown decision kind %s", d.Kind)
+		}
+		return ""
+	}
+	for _, d := range decisions {
+		if divergence := replay(d); divergence != "" {
+			report.Divergence = fmt.Sprintf("seq %d: %s", d.Seq, divergence)
+			break
+		}
+		report.Replayed++
+	}
+
+	for _, qt := range queued {
+		s := stats(qt.Tenant())
+		s.Queued++
+		if wait := clock.now.Sub(qt.enqueueTime); wait > s.OldestWait {
+			s.OldestWait = wait
+		}
+	}
+	for _, s := range tenants {
+		if s.Picked > 0 {
+			s.MeanWait = s.totalWait / time.Duration(s.Picked)
+		}
+		report.Tenants = append(report.Tenants, s)
+	}
+	sort.Slice(report.Tenants, func(i, j int) bool { return report.Tenants[i].Tenant < report.Tenants[j].Tenant })
+	return report
+}
+
+// ReplayDecisionLog replays the scheduler decision log files in order, e.g. the rotated file and then the
+// current one, to diagnose the fairness and starvation of the queue the log was recorded from.
+func ReplayDecisionLog(files ...string) (*DecisionReplayReport, error) {
+	decisions := make([]*schedDecision, 0)
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		ds, err := readDecisionLog(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("read decision log %s failed: %w", file, err)
+		}
+		decisions = append(decisions, ds...)
+	}
+	return replayDecisions(paramtable.Get().Namespace(), decisions), nil
+}
@@ -0,0 +1,102 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestReplayDecisionLog(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	params.Save(params.IndexNodeCfg.SchedulerTenantWeights.Key, `{"a":"3"}`)
+	dir := t.TempDir()
+	sched := NewTaskScheduler(context.Background(), params)
+	decisions, err := openDecisionLog(dir, 1<<20)
+	require.NoError(t, err)
+	sched.decisions = decisions
+	queue := sched.IndexBuildQueue.(*IndexTaskQueue)
+	now := time.Now()
+	queue.clock = func() time.Time { return now }
+
+	for i := 0; i < 6; i++ {
+		assert.NoError(t, queue.addUnissuedTask(&fakeTask{id: i, tenant: "a"}))
+	}
+	assert.NoError(t, queue.addUnissuedTask(&fakeTask{id: 6, tenant: "b"}))
+	assert.NoError(t, queue.addUnissuedTask(&fakeTask{id: 7, tenant: "b"}))
+	for i := 0; i < 5; i++ {
+		now = now.Add(time.Second)
+		queue.PopUnissuedTask()
+	}
+	decisions.Close()
+
+	// the weights of the replaying node don't matter
+	report, err := ReplayDecisionLog(path.Join(dir, decisionLogFile))
+	require.NoError(t, err)
+	assert.Equal(t, 13, report.Replayed)
+	assert.Empty(t, report.Divergence)
+	require.Len(t, report.Tenants, 2)
+	a, b := report.Tenants[0], report.Tenants[1]
+	// a runs 3 times as many tasks as b
+	assert.Equal(t, TenantReplayStats{Tenant: "a", Enqueued: 6, Picked: 4, MeanWait: 3250 * time.Millisecond,
+		MaxWait: 5 * time.Second, Queued: 2, OldestWait: 5 * time.Second, totalWait: 13 * time.Second}, *a)
+	assert.Equal(t, TenantReplayStats{Tenant: "b", Enqueued: 2, Picked: 1, MeanWait: 2 * time.Second,
+		MaxWait: 2 * time.Second, Queued: 1, OldestWait: 5 * time.Second, totalWait: 2 * time.Second}, *b)
+	assert.Contains(t, report.String(), "tenant b: enqueued 2, rejected 0, picked 1")
+
+	// a logged pick the queue doesn't make again stops the replay
+	f, err := os.Open(path.Join(dir, decisionLogFile))
+	require.NoError(t, err)
+	ds, err := readDecisionLog(f)
+	f.Close()
+	require.NoError(t, err)
+	ds[9].Task = "fake-task-3"
+	report = replayDecisions(paramtable.Get().Namespace(), ds)
+	assert.Equal(t, 9, report.Replayed)
+	assert.Equal(t, "seq 10: fake-task-6 is picked, logged fake-task-3", report.Divergence)
+
+	_, err = ReplayDecisionLog(path.Join(dir, "not_exist"))
+	assert.Error(t, err)
+}
+
+func TestReplayWithdrawnTasks(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	now := time.Now()
+	decisions := []*schedDecision{
+		{Seq: 1, Time: now, Kind: decisionEnqueue, Task: "task-1", Tenant: "a"},
+		{Seq: 2, Time: now, Kind: decisionEnqueue, Task: "task-2", Tenant: "b"},
+		{Seq: 3, Time: now, Kind: decisionWithdraw, Task: "task-1", Tenant: "a", Reason: "handed off"},
+		{Seq: 4, Time: now, Kind: decisionPick, Task: "task-2", Tenant: "b",
+			Tenants: []decisionTenant{{Tenant: "b", Head: "task-2", Queued: 1, Weight: 1}}},
+		{Seq: 5, Time: now, Kind: decisionWithdraw, Task: "task-2", Tenant: "b", Reason: "handed off"},
+	}
+	report := replayDecisions(params, decisions)
+	assert.Equal(t, 4, report.Replayed)
+	assert.Equal(t, "seq 5: task-2 is withdrawn without being queued", report.Divergence)
+	require.Len(t, report.Tenants, 2)
+	assert.Equal(t, 1, report.Tenants[0].Withdrawn)
+	assert.Equal(t, 0, report.Tenants[0].Queued)
+	assert.Contains(t, report.String(), "tenant a: enqueued 1, rejected 0, picked 0, preempted 0, withdrawn 1")
+}
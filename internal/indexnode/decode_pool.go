@@ -0,0 +1,62 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"github.com/panjf2000/ants/v2"
+
+	"github.com/milvus-io/milvus/internal/util/concurrency"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// decodePool runs the binlog decoding of the tasks on its own bounded workers, so the decode CPU usage is
+// set by indexNode.decode.workers independently of the build slots, and the decode spikes don't interfere
+// with the running builds. It's nil if the tasks decode their binlogs in their own goroutines.
+type decodePool struct {
+	pool *concurrency.Pool
+}
+
+// newDecodePool creates the decode pool of indexNode.decode.workers workers, at most indexNode.decode.queueSize
+// tasks wait for an idle worker and the others fail to decode, 0 means no limit.
+func newDecodePool(params *paramtable.ComponentParam) (*decodePool, error) {
+	workers := params.IndexNodeCfg.DecodeWorkers.GetAsInt()
+	if workers <= 0 {
+		return nil, nil
+	}
+	pool, err := concurrency.NewPool(workers, ants.WithMaxBlockingTasks(params.IndexNodeCfg.DecodeQueueSize.GetAsInt()))
+	if err != nil {
+		return nil, err
+	}
+	return &decodePool{pool: pool}, nil
+}
+
+// run runs fn on a decode worker and waits for it to finish, fn runs in the calling goroutine if the pool is nil.
+func (p *decodePool) run(fn func() error) error {
+	if p == nil {
+		return fn()
+	}
+	_, err := p.pool.Submit(func() (interface{}, error) {
+		return nil, fn()
+	}).Await()
+	return err
+}
+
+func (p *decodePool) release() {
+	if p != nil {
+		p.pool.Release()
+	}
+}
@@ -0,0 +1,67 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestDecodePool(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	pool, err := newDecodePool(params)
+	assert.NoError(t, err)
+	assert.Nil(t, pool)
+	errDecode := errors.New("decode failed")
+	assert.ErrorIs(t, pool.run(func() error { return errDecode }), errDecode)
+	pool.release()
+
+	params.Save(params.IndexNodeCfg.DecodeWorkers.Key, "2")
+	params.Save(params.IndexNodeCfg.DecodeQueueSize.Key, "0")
+	pool, err = newDecodePool(params)
+	assert.NoError(t, err)
+	defer pool.release()
+
+	var running, maxRunning int32
+	wg := sync.WaitGroup{}
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, pool.run(func() error {
+				n := atomic.AddInt32(&running, 1)
+				for {
+					m := atomic.LoadInt32(&maxRunning)
+					if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+						break
+					}
+				}
+				atomic.AddInt32(&running, -1)
+				return nil
+			}))
+		}()
+	}
+	wg.Wait()
+	assert.LessOrEqual(t, maxRunning, int32(2))
+	assert.ErrorIs(t, pool.run(func() error { return errDecode }), errDecode)
+}
@@ -23,6 +23,8 @@ import (
 
 var (
 	ErrNoSuchKey = errors.New("NoSuchKey")
+
+	errTaskQueueFull = errors.New("IndexNode task queue is full")
 )
 
 // msgIndexNodeIsUnhealthy return a message tha IndexNode is not healthy.
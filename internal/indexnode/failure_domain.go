@@ -0,0 +1,70 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"errors"
+	"syscall"
+
+	"github.com/minio/minio-go/v7"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// globalStorageErrorCodes are the error codes of the object storage shared by all the nodes.
+var globalStorageErrorCodes = map[string]struct{}{
+	"NoSuchBucket":          {},
+	"AccessDenied":          {},
+	"InvalidAccessKeyId":    {},
+	"SignatureDoesNotMatch": {},
+}
+
+// failureDomain classifies the error failing a task by where it lies.
+func failureDomain(err error) indexpb.FailureDomain {
+	switch {
+	case errors.Is(err, ErrNoSuchKey), errors.Is(err, storage.ErrNoSuchKey), errors.Is(err, errDataMismatch),
+		errors.Is(err, errNonFiniteVector), errors.Is(err, errIndexCorrupted):
+		return indexpb.FailureDomain_DataFailure
+	case errors.Is(err, errBuildSandboxCrashed), errors.Is(err, errAutoIndex):
+		// the build exceeded the limits of the helper or no index suits the data, it would on any node
+		return indexpb.FailureDomain_DataFailure
+	case errors.Is(err, errStaleRebuild):
+		// the index has been swapped by a later rebuild on whatever node
+		return indexpb.FailureDomain_GlobalFailure
+	case errors.Is(err, syscall.ENOSPC), errors.Is(err, errNoStagingDir), errors.Is(err, ErrBuildRejected),
+		errors.Is(err, errBuilderStalled):
+		return indexpb.FailureDomain_NodeFailure
+	}
+	var storageErr minio.ErrorResponse
+	if errors.As(err, &storageErr) {
+		if _, ok := globalStorageErrorCodes[storageErr.Code]; ok {
+			return indexpb.FailureDomain_GlobalFailure
+		}
+	}
+	return indexpb.FailureDomain_UnknownFailure
+}
+
+// recordFailureDomain records the failure domain of the error failing the task, before the task is moved
+// to the failed or the retry phase so the domain is reported with it.
+func recordFailureDomain(t task, err error) {
+	it, ok := t.(*indexBuildTask)
+	if !ok || it.node == nil {
+		return
+	}
+	it.node.storeTaskFailureDomain(it.ClusterID, it.BuildID, failureDomain(err))
+}
@@ -0,0 +1,65 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestFailureDomain(t *testing.T) {
+	cases := []struct {
+		err    error
+		domain indexpb.FailureDomain
+	}{
+		{fmt.Errorf("%w: dim 8, expected 16", errDataMismatch), indexpb.FailureDomain_DataFailure},
+		{ErrNoSuchKey, indexpb.FailureDomain_DataFailure},
+		{fmt.Errorf("%w: bad slice meta", errIndexCorrupted), indexpb.FailureDomain_DataFailure},
+		{fmt.Errorf("%w: signal: killed", errBuildSandboxCrashed), indexpb.FailureDomain_DataFailure},
+		{&os.PathError{Op: "write", Path: "/var/lib/milvus/index", Err: syscall.ENOSPC}, indexpb.FailureDomain_NodeFailure},
+		{errNoStagingDir, indexpb.FailureDomain_NodeFailure},
+		{fmt.Errorf("%w: too large", ErrBuildRejected), indexpb.FailureDomain_NodeFailure},
+		{minio.ErrorResponse{Code: "NoSuchBucket", BucketName: "a-bucket"}, indexpb.FailureDomain_GlobalFailure},
+		{fmt.Errorf("save index file: %w", minio.ErrorResponse{Code: "AccessDenied"}), indexpb.FailureDomain_GlobalFailure},
+		{errStaleRebuild, indexpb.FailureDomain_GlobalFailure},
+		{minio.ErrorResponse{Code: "SlowDown"}, indexpb.FailureDomain_UnknownFailure},
+		{errors.New("connection reset"), indexpb.FailureDomain_UnknownFailure},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.domain, failureDomain(c.err), c.err.Error())
+	}
+}
+
+func TestRecordFailureDomain(t *testing.T) {
+	node := &IndexNode{params: paramtable.Get().Namespace()}
+	key := taskKey{ClusterID: "cluster", BuildID: 1}
+	node.tasks.loadOrStore(key, &taskInfo{phase: taskLoading})
+
+	recordFailureDomain(&indexBuildTask{node: node, ClusterID: key.ClusterID, BuildID: key.BuildID}, errDataMismatch)
+	assert.Equal(t, indexpb.FailureDomain_DataFailure, node.tasks.info(key).failureDomain)
+	// tasks of other types are not classified
+	recordFailureDomain(&fakeTask{}, errDataMismatch)
+}
@@ -0,0 +1,91 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// tenantWeight returns the build slot share weight of the tenant set by indexNode.scheduler.tenantWeights,
+// the tenants not configured or with invalid weights have the weight 1.
+func tenantWeight(params *paramtable.ComponentParam, tenant string) float64 {
+	weight, err := strconv.ParseFloat(params.IndexNodeCfg.SchedulerTenantWeights.GetAsJSONMap()[tenant], 64)
+	if err != nil || weight <= 0 {
+		return 1
+	}
+	return weight
+}
+
+// fairShare shares the build slots among the tenants in proportion to their weights by stride scheduling,
+// the tenant with the smallest pass runs next, and its pass advances by 1/weight per scheduled task.
+type fairShare struct {
+	params *paramtable.ComponentParam
+	passes map[string]float64
+	// tasks is the number of queued tasks of each tenant.
+	tasks map[string]int
+	// virtualTime is the pass of the last scheduled tenant, a tenant becoming active starts from it,
+	// so it cannot monopolize the slots with the credit accumulated while idle.
+	virtualTime float64
+}
+
+func newFairShare(params *paramtable.ComponentParam) *fairShare {
+	return &fairShare{
+		params: params,
+		passes: make(map[string]float64),
+		tasks:  make(map[string]int),
+	}
+}
+
+// enqueue registers a queued task of the tenant.
+func (f *fairShare) enqueue(tenant string) {
+	if f.tasks[tenant] == 0 {
+		f.passes[tenant] = f.virtualTime
+	}
+	f.tasks[tenant]++
+}
+
+// less reports whether the tenant a runs before the tenant b.
+func (f *fairShare) less(a, b string) bool {
+	return f.passes[a] < f.passes[b]
+}
+
+// weight returns the build slot share weight of the tenant.
+func (f *fairShare) weight(tenant string) float64 {
+	return tenantWeight(f.params, tenant)
+}
+
+// withdraw unregisters a queued task of the tenant leaving the queue without running, the tenant is not charged.
+func (f *fairShare) withdraw(tenant string) {
+	f.tasks[tenant]--
+	if f.tasks[tenant] <= 0 {
+		delete(f.tasks, tenant)
+		delete(f.passes, tenant)
+	}
+}
+
+// schedule charges the tenant for a scheduled task.
+func (f *fairShare) schedule(tenant string) {
+	f.virtualTime = f.passes[tenant]
+	f.passes[tenant] += 1 / f.weight(tenant)
+	f.tasks[tenant]--
+	if f.tasks[tenant] <= 0 {
+		delete(f.tasks, tenant)
+		delete(f.passes, tenant)
+	}
+}
@@ -0,0 +1,69 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestTenantWeight(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	assert.Equal(t, 1.0, tenantWeight(params, "a"))
+
+	params.Save(params.IndexNodeCfg.SchedulerTenantWeights.Key, `{"a":"2","b":"-1","c":"x"}`)
+	assert.Equal(t, 2.0, tenantWeight(params, "a"))
+	assert.Equal(t, 1.0, tenantWeight(params, "b"))
+	assert.Equal(t, 1.0, tenantWeight(params, "c"))
+	assert.Equal(t, 1.0, tenantWeight(params, "d"))
+}
+
+func TestFairShareQueue(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	params.Save(params.IndexNodeCfg.SchedulerTenantWeights.Key, `{"a":"2"}`)
+
+	queue := NewIndexBuildTaskQueue(nil, params)
+	for i := 0; i < 6; i++ {
+		assert.NoError(t, queue.addUnissuedTask(&fakeTask{tenant: "a"}))
+	}
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, queue.addUnissuedTask(&fakeTask{tenant: "b"}))
+	}
+	issued := make([]string, 0)
+	for _, t := range queue.issueOrder() {
+		issued = append(issued, t.Tenant())
+	}
+	tenants := make([]string, 0)
+	for i := 0; i < 9; i++ {
+		tenants = append(tenants, queue.PopUnissuedTask().Tenant())
+	}
+	assert.Equal(t, []string{"a", "b", "a", "a", "b", "a", "a", "b", "a"}, tenants)
+	assert.Equal(t, tenants, issued)
+	assert.Nil(t, queue.PopUnissuedTask())
+
+	// the tenants becoming active start from the current virtual time
+	for i := 0; i < 4; i++ {
+		assert.NoError(t, queue.addUnissuedTask(&fakeTask{tenant: "b"}))
+	}
+	assert.Equal(t, "b", queue.PopUnissuedTask().Tenant())
+	assert.NoError(t, queue.addUnissuedTask(&fakeTask{tenant: "c"}))
+	assert.Equal(t, "c", queue.PopUnissuedTask().Tenant())
+	assert.Equal(t, "b", queue.PopUnissuedTask().Tenant())
+}
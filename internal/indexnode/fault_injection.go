@@ -0,0 +1,99 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// faultInjector injects the faults configured by indexNode.faultInjection into the object storage accesses
+// and the scheduler, a seeded injector makes the faults reproducible. A nil injector injects nothing.
+type faultInjector struct {
+	params *paramtable.ComponentParam
+
+	mu  sync.Mutex
+	rnd *rand.Rand
+}
+
+var _ storage.FaultInjector = (*faultInjector)(nil)
+
+func newFaultInjector(params *paramtable.ComponentParam) *faultInjector {
+	seed := params.IndexNodeCfg.FaultInjectionSeed.GetAsInt64()
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	return &faultInjector{
+		params: params,
+		rnd:    rand.New(rand.NewSource(seed)),
+	}
+}
+
+func (f *faultInjector) enabled() bool {
+	return f != nil && f.params.IndexNodeCfg.FaultInjectionEnable.GetAsBool()
+}
+
+// hit reports whether an event with the probability happens.
+func (f *faultInjector) hit(probability float64) bool {
+	if !f.enabled() || probability <= 0 {
+		return false
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.rnd.Float64() < probability
+}
+
+func (f *faultInjector) Latency() time.Duration {
+	if !f.enabled() {
+		return 0
+	}
+	return f.params.IndexNodeCfg.FaultInjectionStorageLatency.GetAsDuration(time.Millisecond)
+}
+
+func (f *faultInjector) Fail() bool {
+	return f.hit(f.params.IndexNodeCfg.FaultInjectionStorageErrorRate.GetAsFloat())
+}
+
+func (f *faultInjector) PartialWrite() bool {
+	return f.hit(f.params.IndexNodeCfg.FaultInjectionStoragePartialWriteRate.GetAsFloat())
+}
+
+// schedulingDelay returns a random delay before a task starts to run.
+func (f *faultInjector) schedulingDelay() time.Duration {
+	if !f.enabled() {
+		return 0
+	}
+	maxDelay := f.params.IndexNodeCfg.FaultInjectionSchedulerMaxDelay.GetAsInt64()
+	if maxDelay <= 0 {
+		return 0
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return time.Duration(f.rnd.Int63n(maxDelay+1)) * time.Millisecond
+}
+
+// wrapChunkManager injects the storage faults into cm when fault injection is enabled.
+func (f *faultInjector) wrapChunkManager(cm storage.ChunkManager) storage.ChunkManager {
+	if !f.enabled() {
+		return cm
+	}
+	return storage.NewFaultInjectionChunkManager(cm, f)
+}
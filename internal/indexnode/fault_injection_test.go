@@ -0,0 +1,62 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestFaultInjector(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+
+	t.Run("disabled", func(t *testing.T) {
+		var nilInjector *faultInjector
+		assert.False(t, nilInjector.Fail())
+		assert.Equal(t, time.Duration(0), nilInjector.schedulingDelay())
+		assert.Equal(t, cm, nilInjector.wrapChunkManager(cm))
+
+		injector := newFaultInjector(params)
+		assert.Equal(t, time.Duration(0), injector.Latency())
+		assert.Equal(t, cm, injector.wrapChunkManager(cm))
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		params.Save(params.IndexNodeCfg.FaultInjectionEnable.Key, "true")
+		params.Save(params.IndexNodeCfg.FaultInjectionSeed.Key, "42")
+		params.Save(params.IndexNodeCfg.FaultInjectionStorageErrorRate.Key, "0.5")
+		params.Save(params.IndexNodeCfg.FaultInjectionStorageLatency.Key, "10")
+		params.Save(params.IndexNodeCfg.FaultInjectionSchedulerMaxDelay.Key, "100")
+
+		// the same seed generates the same faults
+		first, second := newFaultInjector(params), newFaultInjector(params)
+		for i := 0; i < 100; i++ {
+			assert.Equal(t, first.Fail(), second.Fail())
+		}
+		assert.False(t, first.PartialWrite())
+		assert.Equal(t, 10*time.Millisecond, first.Latency())
+		assert.LessOrEqual(t, first.schedulingDelay(), 100*time.Millisecond)
+		_, ok := first.wrapChunkManager(cm).(*storage.FaultInjectionChunkManager)
+		assert.True(t, ok)
+	})
+}
@@ -0,0 +1,174 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/indexcgowrapper"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+const (
+	// faissIndexKey is the binary set key knowhere serializes the faiss indexes with.
+	faissIndexKey = "IVF"
+	// sliceMetaKey is the binary set key of the slice meta, describing the index files sliced by segcore.
+	sliceMetaKey = "SLICE_META"
+
+	// the faiss metric types, as written in the index header.
+	faissMetricInnerProduct int32 = 0
+	faissMetricL2           int32 = 1
+)
+
+// flatEngine builds the FLAT index of float vectors without knowhere. A FLAT index holds nothing but the vectors,
+// so the engine writes them in the faiss IndexFlat layout knowhere loads the index from, making the build I/O bound.
+type flatEngine struct {
+	dim        int
+	metricType int32
+	vectors    []float32
+	// sliceSize is the max size in bytes of the index file slices.
+	sliceSize int64
+}
+
+var _ BuildEngine = (*flatEngine)(nil)
+
+// newFlatEngine returns the flat engine if it's able to build the index of the params, i.e. a FLAT index of
+// float vectors with the L2 or IP metric, and indexNode.flatFastPath.enable is set.
+func newFlatEngine(params *paramtable.ComponentParam, dType schemapb.DataType, typeParams, indexParams map[string]string) (*flatEngine, bool) {
+	if !params.IndexNodeCfg.FlatFastPathEnable.GetAsBool() || dType != schemapb.DataType_FloatVector ||
+		indexParams[common.IndexTypeKey] != indexparamcheck.IndexFaissIDMap {
+		return nil, false
+	}
+	var metricType int32
+	switch indexParams[common.MetricTypeKey] {
+	case indexparamcheck.L2:
+		metricType = faissMetricL2
+	case indexparamcheck.IP:
+		metricType = faissMetricInnerProduct
+	default:
+		return nil, false
+	}
+	dim, err := strconv.Atoi(typeParams["dim"])
+	if err != nil || dim <= 0 {
+		return nil, false
+	}
+	return &flatEngine{dim: dim, metricType: metricType, sliceSize: params.CommonCfg.IndexSliceSize.GetAsInt64() << 20}, true
+}
+
+// Train does nothing, a FLAT index needs no training.
+func (e *flatEngine) Train(dataset *indexcgowrapper.Dataset) error {
+	return nil
+}
+
+func (e *flatEngine) Add(dataset *indexcgowrapper.Dataset) error {
+	vectors, ok := dataset.RawData().([]float32)
+	if !ok || len(vectors)%e.dim != 0 {
+		return fmt.Errorf("invalid float vectors of dim %d for FLAT index", e.dim)
+	}
+	e.vectors = append(e.vectors, vectors...)
+	return nil
+}
+
+// Serialize writes the vectors as a faiss IndexFlat, which is
+//
+//	| fourcc | d int32 | ntotal int64 | 1<<20 int64 | 1<<20 int64 | is_trained bool | metric_type int32 |
+//	| number of floats uint64 | vectors |
+//
+// in little endian, and slices it like segcore does.
+func (e *flatEngine) Serialize() ([]*storage.Blob, error) {
+	fourcc := "IxF2"
+	if e.metricType == faissMetricInnerProduct {
+		fourcc = "IxFI"
+	}
+	const headerSize = 4 + 4 + 8 + 8 + 8 + 1 + 4 + 8
+	buf := make([]byte, headerSize+4*len(e.vectors))
+	copy(buf, fourcc)
+	common.Endian.PutUint32(buf[4:], uint32(e.dim))
+	common.Endian.PutUint64(buf[8:], uint64(len(e.vectors)/e.dim))
+	common.Endian.PutUint64(buf[16:], 1<<20)
+	common.Endian.PutUint64(buf[24:], 1<<20)
+	buf[32] = 1
+	common.Endian.PutUint32(buf[33:], uint32(e.metricType))
+	common.Endian.PutUint64(buf[37:], uint64(len(e.vectors)))
+	for i, v := range e.vectors {
+		common.Endian.PutUint32(buf[headerSize+4*i:], math.Float32bits(v))
+	}
+	return sliceIndexFile(faissIndexKey, buf, e.sliceSize)
+}
+
+func (e *flatEngine) Delete() error {
+	e.vectors = nil
+	return nil
+}
+
+// sliceMeta is the slice meta of an index file sliced by segcore.
+type sliceMeta struct {
+	Name     string `json:"name"`
+	SliceNum int    `json:"slice_num"`
+	TotalLen int64  `json:"total_len"`
+}
+
+// sliceIndexFile slices the index file larger than sliceSize into the files key_0, key_1, ... and describes them
+// in the slice meta, the same as segcore, so the index is assembled on loading.
+func sliceIndexFile(key string, data []byte, sliceSize int64) ([]*storage.Blob, error) {
+	return sliceIndexBlobs([]*storage.Blob{{Key: key, Value: data, Size: int64(len(data))}}, sliceSize)
+}
+
+// sliceIndexBlobs slices the index files larger than sliceSize like sliceIndexFile, the sliced files are described
+// by a single slice meta.
+func sliceIndexBlobs(blobs []*storage.Blob, sliceSize int64) ([]*storage.Blob, error) {
+	ret := make([]*storage.Blob, 0, len(blobs))
+	metas := make([]sliceMeta, 0)
+	for _, blob := range blobs {
+		data := blob.Value
+		if int64(len(data)) <= sliceSize {
+			ret = append(ret, &storage.Blob{Key: blob.Key, Value: data, Size: int64(len(data))})
+			continue
+		}
+		sliceNum := 0
+		for start := int64(0); start < int64(len(data)); start += sliceSize {
+			end := start + sliceSize
+			if end > int64(len(data)) {
+				end = int64(len(data))
+			}
+			ret = append(ret, &storage.Blob{
+				Key:   fmt.Sprintf("%s_%d", blob.Key, sliceNum),
+				Value: data[start:end],
+				Size:  end - start,
+			})
+			sliceNum++
+		}
+		metas = append(metas, sliceMeta{Name: blob.Key, SliceNum: sliceNum, TotalLen: int64(len(data))})
+	}
+	if len(metas) == 0 {
+		return ret, nil
+	}
+	meta, err := json.Marshal(map[string][]sliceMeta{"meta": metas})
+	if err != nil {
+		return nil, err
+	}
+	// segcore writes the meta as a C string
+	meta = append(meta, 0)
+	return append(ret, &storage.Blob{Key: sliceMetaKey, Value: meta, Size: int64(len(meta))}), nil
+}
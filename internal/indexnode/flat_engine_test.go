@@ -0,0 +1,104 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/util/indexcgowrapper"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestNewFlatEngine(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	typeParams := map[string]string{"dim": "2"}
+	flatParams := func(metricType string) map[string]string {
+		return map[string]string{common.IndexTypeKey: "FLAT", common.MetricTypeKey: metricType}
+	}
+	engine, ok := newFlatEngine(params, schemapb.DataType_FloatVector, typeParams, flatParams("L2"))
+	assert.True(t, ok)
+	assert.Equal(t, faissMetricL2, engine.metricType)
+	engine, ok = newFlatEngine(params, schemapb.DataType_FloatVector, typeParams, flatParams("IP"))
+	assert.True(t, ok)
+	assert.Equal(t, faissMetricInnerProduct, engine.metricType)
+
+	_, ok = newFlatEngine(params, schemapb.DataType_BinaryVector, typeParams, flatParams("HAMMING"))
+	assert.False(t, ok)
+	_, ok = newFlatEngine(params, schemapb.DataType_FloatVector, typeParams,
+		map[string]string{common.IndexTypeKey: "IVF_FLAT", common.MetricTypeKey: "L2"})
+	assert.False(t, ok)
+	_, ok = newFlatEngine(params, schemapb.DataType_FloatVector, map[string]string{}, flatParams("L2"))
+	assert.False(t, ok)
+
+	params.Save(params.IndexNodeCfg.FlatFastPathEnable.Key, "false")
+	_, ok = newFlatEngine(params, schemapb.DataType_FloatVector, typeParams, flatParams("L2"))
+	assert.False(t, ok)
+}
+
+func TestFlatEngineSerialize(t *testing.T) {
+	engine := &flatEngine{dim: 2, metricType: faissMetricInnerProduct, sliceSize: 1 << 20}
+	assert.NoError(t, engine.Train(nil))
+	assert.Error(t, engine.Add(indexcgowrapper.GenFloatVecDataset([]float32{1, 2, 3})))
+	assert.NoError(t, engine.Add(indexcgowrapper.GenFloatVecDataset([]float32{1, 2, 3, 4})))
+	assert.NoError(t, engine.Add(indexcgowrapper.GenFloatVecDataset([]float32{5, 6})))
+
+	blobs, err := engine.Serialize()
+	require.NoError(t, err)
+	require.Len(t, blobs, 1)
+	assert.Equal(t, faissIndexKey, blobs[0].Key)
+	data := blobs[0].Value
+	assert.Equal(t, int64(len(data)), blobs[0].Size)
+	assert.Equal(t, "IxFI", string(data[:4]))
+	assert.Equal(t, uint32(2), common.Endian.Uint32(data[4:]))
+	assert.Equal(t, uint64(3), common.Endian.Uint64(data[8:]))
+	assert.Equal(t, byte(1), data[32])
+	assert.Equal(t, uint32(faissMetricInnerProduct), common.Endian.Uint32(data[33:]))
+	assert.Equal(t, uint64(6), common.Endian.Uint64(data[37:]))
+	assert.Len(t, data, 45+6*4)
+	assert.Equal(t, float32(6), math.Float32frombits(common.Endian.Uint32(data[len(data)-4:])))
+
+	assert.NoError(t, engine.Delete())
+	assert.Nil(t, engine.vectors)
+}
+
+func TestSliceIndexFile(t *testing.T) {
+	data := []byte("0123456789")
+	blobs, err := sliceIndexFile("IVF", data, 10)
+	assert.NoError(t, err)
+	assert.Len(t, blobs, 1)
+
+	blobs, err = sliceIndexFile("IVF", data, 4)
+	assert.NoError(t, err)
+	require.Len(t, blobs, 4)
+	assert.Equal(t, "IVF_0", blobs[0].Key)
+	assert.Equal(t, "IVF_2", blobs[2].Key)
+	assert.Equal(t, data, bytes.Join([][]byte{blobs[0].Value, blobs[1].Value, blobs[2].Value}, nil))
+	assert.Equal(t, sliceMetaKey, blobs[3].Key)
+	meta := blobs[3].Value
+	assert.Equal(t, byte(0), meta[len(meta)-1])
+	assert.Equal(t, `{"meta":[{"name":"IVF","slice_num":3,"total_len":10}]}`, string(meta[:len(meta)-1]))
+	var decoded map[string][]sliceMeta
+	assert.NoError(t, json.Unmarshal(meta[:len(meta)-1], &decoded))
+}
@@ -0,0 +1,191 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+const (
+	// handoffTimeout bounds the time to stage the datasets of all the running tasks on stopping.
+	handoffTimeout = 30 * time.Second
+)
+
+// stagedDatasetKey returns the path of the staged dataset of the job, it's the same on every node
+// the job is assigned to. DataCoord removes the staged datasets of the jobs no longer in progress.
+func stagedDatasetKey(cm storage.ChunkManager, clusterID string, buildID UniqueID) string {
+	return path.Join(cm.RootPath(), common.IndexStagingPath, clusterID, strconv.FormatInt(buildID, 10))
+}
+
+// stageDataset writes the decoded vectors of the task as a binlog to the staging path, so the node the job is
+// reassigned to skips loading and decoding the binlogs. It does nothing if the data is not loaded yet,
+// or the pk offsets are emitted as the staged binlog can't carry them.
+func (it *indexBuildTask) stageDataset(ctx context.Context) error {
+	it.datasetMu.Lock()
+	defer it.datasetMu.Unlock()
+	if it.fieldData == nil || it.cm == nil || it.staged || it.pkOffsets != nil {
+		return nil
+	}
+	var dataType schemapb.DataType
+	switch it.fieldData.(type) {
+	case *storage.FloatVectorFieldData:
+		dataType = schemapb.DataType_FloatVector
+	case *storage.BinaryVectorFieldData:
+		dataType = schemapb.DataType_BinaryVector
+	default:
+		return nil
+	}
+	insertCodec := storage.NewInsertCodec(&etcdpb.CollectionMeta{
+		ID: it.collectionID,
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{{FieldID: it.fieldID, DataType: dataType}},
+		},
+	})
+	// the timestamps are required by the codec but never read back
+	timestamps := make([]int64, it.fieldData.RowNum())
+	blobs, _, err := insertCodec.Serialize(it.partitionID, it.segmentID, &storage.InsertData{
+		Data: map[storage.FieldID]storage.FieldData{
+			common.TimeStampField: &storage.Int64FieldData{Data: timestamps},
+			it.fieldID:            it.fieldData,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if len(blobs) != 1 {
+		return fmt.Errorf("expect one staged binlog, got %d", len(blobs))
+	}
+	key := stagedDatasetKey(it.cm, it.ClusterID, it.BuildID)
+	if err := it.cm.Write(ctx, key, blobs[0].Value); err != nil {
+		return err
+	}
+	it.staged = true
+	log.Ctx(ctx).Info("IndexNode staged the dataset for handoff", zap.Int64("buildID", it.BuildID),
+		zap.String("path", key), zap.Int("size", len(blobs[0].Value)))
+	return nil
+}
+
+// loadStagedDataset decodes the dataset staged by the node the job was assigned to before, it returns false
+// if there is no usable staged dataset and the binlogs should be loaded.
+func (it *indexBuildTask) loadStagedDataset(ctx context.Context) (bool, error) {
+	if !it.node.params.IndexNodeCfg.HandoffEnable.GetAsBool() {
+		return false, nil
+	}
+	key := stagedDatasetKey(it.cm, it.ClusterID, it.BuildID)
+	exist, err := it.cm.Exist(ctx, key)
+	if err != nil || !exist {
+		if err != nil {
+			log.Ctx(ctx).Warn("IndexNode check staged dataset failed, load the binlogs", zap.String("path", key), zap.Error(err))
+		}
+		return false, nil
+	}
+	value, err := it.cm.Read(ctx, key)
+	if err != nil {
+		log.Ctx(ctx).Warn("IndexNode read staged dataset failed, load the binlogs", zap.String("path", key), zap.Error(err))
+		return false, nil
+	}
+	err = it.decodeBlobs(ctx, []*Blob{{Key: key, Value: value}})
+	if err != nil && !errors.Is(err, errBruteForce) {
+		log.Ctx(ctx).Warn("IndexNode decode staged dataset failed, load the binlogs", zap.String("path", key), zap.Error(err))
+		it.cm.Remove(ctx, key)
+		return false, nil
+	}
+	it.node.storeTaskCollection(it.ClusterID, it.BuildID, it.collectionID)
+	log.Ctx(ctx).Info("IndexNode load data from the staged dataset", zap.Int64("buildID", it.BuildID),
+		zap.String("path", key), zap.Int64("numRows", it.statistic.NumRows))
+	// the dataset is in memory now, the binlogs are still there if this node fails too.
+	if err := it.cm.Remove(ctx, key); err != nil {
+		log.Ctx(ctx).Warn("IndexNode remove staged dataset failed", zap.String("path", key), zap.Error(err))
+	}
+	return true, err
+}
+
+// removeStagedDataset removes the dataset staged by this node.
+func (it *indexBuildTask) removeStagedDataset(ctx context.Context) {
+	it.datasetMu.Lock()
+	defer it.datasetMu.Unlock()
+	if !it.staged || it.cm == nil {
+		return
+	}
+	key := stagedDatasetKey(it.cm, it.ClusterID, it.BuildID)
+	if err := it.cm.Remove(ctx, key); err != nil {
+		log.Ctx(ctx).Warn("IndexNode remove staged dataset failed", zap.String("path", key), zap.Error(err))
+		return
+	}
+	it.staged = false
+}
+
+// handoffTasks stages the datasets of the tasks still running when the graceful stop times out,
+// which would be discarded otherwise.
+func (i *IndexNode) handoffTasks() {
+	if !i.params.IndexNodeCfg.HandoffEnable.GetAsBool() || !i.hasInProgressTask() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(i.loopCtx, handoffTimeout)
+	defer cancel()
+	for _, t := range i.sched.IndexBuildQueue.ListActiveTasks() {
+		it, ok := t.(*indexBuildTask)
+		if !ok {
+			continue
+		}
+		if err := it.stageDataset(ctx); err != nil {
+			log.Warn("IndexNode stage dataset for handoff failed", zap.Error(err))
+		}
+	}
+}
+
+// handoffQueuedJobs removes the build tasks of the cluster still queued from the node and returns their requests,
+// the reservation tokens taken on this node are cleared.
+func (i *IndexNode) handoffQueuedJobs(clusterID string) []*indexpb.CreateJobRequest {
+	tasks := i.sched.IndexBuildQueue.removeUnissuedTasks(func(t task) bool {
+		it, ok := t.(*indexBuildTask)
+		return ok && it.ClusterID == clusterID
+	}, "handed off")
+	jobs := make([]*indexpb.CreateJobRequest, 0, len(tasks))
+	keys := make([]taskKey, 0, len(tasks))
+	for _, t := range tasks {
+		it := t.(*indexBuildTask)
+		it.datasetMu.Lock()
+		job := proto.Clone(it.req).(*indexpb.CreateJobRequest)
+		it.datasetMu.Unlock()
+		job.ReservationToken = ""
+		jobs = append(jobs, job)
+		keys = append(keys, taskKey{ClusterID: it.ClusterID, BuildID: it.BuildID})
+	}
+	for _, info := range i.deleteTaskInfos(keys) {
+		if info.cancel != nil {
+			info.cancel()
+		}
+	}
+	i.jobLogs.remove(keys...)
+	return jobs
+}
@@ -0,0 +1,151 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
+)
+
+func TestHandoffDataset(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	ctx := context.Background()
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	newTask := func() *indexBuildTask {
+		node := &IndexNode{
+			params:   params,
+			reporter: newJobResultReporter(params),
+		}
+		node.loadOrStoreTask("cluster", 1, &taskInfo{phase: taskLoading})
+		return &indexBuildTask{
+			ctx:       ctx,
+			cm:        cm,
+			ClusterID: "cluster",
+			BuildID:   1,
+			node:      node,
+			req:       &indexpb.CreateJobRequest{ClusterID: "cluster", BuildID: 1, NumRows: 3},
+			tr:        timerecord.NewTimeRecorder("test"),
+		}
+	}
+	key := stagedDatasetKey(cm, "cluster", 1)
+
+	source := newTask()
+	// nothing to stage before the data is loaded
+	assert.NoError(t, source.stageDataset(ctx))
+	exist, err := cm.Exist(ctx, key)
+	require.NoError(t, err)
+	assert.False(t, exist)
+
+	source.collectionID, source.partitionID, source.segmentID, source.fieldID = 10, 20, 30, 101
+	source.fieldData = &storage.FloatVectorFieldData{Dim: 2, Data: []float32{1, 2, 3, 4, 5, 6}}
+	assert.NoError(t, source.stageDataset(ctx))
+	exist, err = cm.Exist(ctx, key)
+	require.NoError(t, err)
+	assert.True(t, exist)
+
+	// the staged dataset is ignored unless handoff is enabled
+	target := newTask()
+	loaded, err := target.loadStagedDataset(ctx)
+	assert.False(t, loaded)
+	assert.NoError(t, err)
+
+	params.Save(params.IndexNodeCfg.HandoffEnable.Key, "true")
+	loaded, err = target.loadStagedDataset(ctx)
+	assert.True(t, loaded)
+	assert.NoError(t, err)
+	assert.Equal(t, source.fieldData, target.fieldData)
+	assert.Equal(t, int64(101), target.fieldID)
+	assert.Equal(t, int64(10), target.collectionID)
+	assert.Equal(t, int64(30), target.segmentID)
+	exist, err = cm.Exist(ctx, key)
+	require.NoError(t, err)
+	assert.False(t, exist)
+
+	loaded, err = newTask().loadStagedDataset(ctx)
+	assert.False(t, loaded)
+	assert.NoError(t, err)
+
+	// the source removes the staged dataset if the job finishes there after all
+	source.staged = false
+	assert.NoError(t, source.stageDataset(ctx))
+	exist, err = cm.Exist(ctx, key)
+	require.NoError(t, err)
+	assert.True(t, exist)
+	source.node.tasks.info(taskKey{ClusterID: "cluster", BuildID: 1}).phase = taskSaving
+	assert.NoError(t, source.SetPhase(taskFinished, ""))
+	exist, err = cm.Exist(ctx, key)
+	require.NoError(t, err)
+	assert.False(t, exist)
+}
+
+func TestHandoffQueuedJobs(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	ctx := context.Background()
+	node := &IndexNode{
+		params:   params,
+		reporter: newJobResultReporter(params),
+		sched:    NewTaskScheduler(ctx, params),
+	}
+	queue := node.sched.IndexBuildQueue.(*IndexTaskQueue)
+	cancelled := make([]UniqueID, 0)
+	addTask := func(clusterID string, buildID UniqueID) {
+		node.loadOrStoreTask(clusterID, buildID, &taskInfo{
+			phase: taskPending,
+			cancel: func() {
+				cancelled = append(cancelled, buildID)
+			},
+		})
+		require.NoError(t, queue.addUnissuedTask(&indexBuildTask{
+			ident:     fmt.Sprintf("%s/%d", clusterID, buildID),
+			ClusterID: clusterID,
+			BuildID:   buildID,
+			node:      node,
+			req:       &indexpb.CreateJobRequest{ClusterID: clusterID, BuildID: buildID, ReservationToken: "token"},
+		}))
+	}
+	addTask("cluster", 1)
+	addTask("other", 2)
+	addTask("cluster", 3)
+	require.NoError(t, queue.addUnissuedTask(&replayTask{name: "verify", tenant: "cluster"}))
+
+	jobs := node.handoffQueuedJobs("cluster")
+	require.Len(t, jobs, 2)
+	assert.Equal(t, int64(1), jobs[0].GetBuildID())
+	assert.Equal(t, int64(3), jobs[1].GetBuildID())
+	assert.Empty(t, jobs[0].GetReservationToken())
+	assert.Equal(t, []UniqueID{1, 3}, cancelled)
+	assert.Equal(t, commonpb.IndexState_IndexStateNone, node.loadTaskState("cluster", 1))
+	assert.Equal(t, commonpb.IndexState_InProgress, node.loadTaskState("other", 2))
+
+	names := make([]string, 0)
+	for _, ut := range queue.ListUnissuedTasks() {
+		names = append(names, ut.Name())
+	}
+	assert.Equal(t, []string{"other/2", "verify"}, names)
+	assert.Equal(t, map[string]int{"other": 1, "cluster": 1}, queue.shares.tasks)
+	assert.Empty(t, node.handoffQueuedJobs("cluster"))
+}
@@ -0,0 +1,116 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+const (
+	// hedgeLatencyWindow is the number of recent read latencies the p95 latency is estimated from.
+	hedgeLatencyWindow = 1000
+	// hedgeMinSamples is the number of reads observed before any read is hedged.
+	hedgeMinSamples = 20
+	// hedgeMaxTokens bounds the hedged reads saved up while the reads are fast.
+	hedgeMaxTokens = 10
+)
+
+// hedgePolicy hedges the storage reads running longer than the p95 latency of the recent reads.
+// The hedged reads of all the tasks share one budget, every read earns indexNode.storage.hedge.budgetRatio
+// token and a hedged read costs one, so a slow object store is not flooded by the hedged reads.
+type hedgePolicy struct {
+	params *paramtable.ComponentParam
+
+	mu        sync.Mutex
+	latencies []time.Duration
+	next      int
+	tokens    float64
+}
+
+var _ storage.HedgePolicy = (*hedgePolicy)(nil)
+
+func newHedgePolicy(params *paramtable.ComponentParam) *hedgePolicy {
+	return &hedgePolicy{
+		params:    params,
+		latencies: make([]time.Duration, 0, hedgeLatencyWindow),
+	}
+}
+
+func (p *hedgePolicy) enabled() bool {
+	return p != nil && p.params.IndexNodeCfg.StorageHedgeEnable.GetAsBool()
+}
+
+// Delay returns the p95 latency of the recent reads, at least indexNode.storage.hedge.minDelay.
+func (p *hedgePolicy) Delay() time.Duration {
+	if !p.enabled() {
+		return 0
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.tokens += p.params.IndexNodeCfg.StorageHedgeBudgetRatio.GetAsFloat()
+	if p.tokens > hedgeMaxTokens {
+		p.tokens = hedgeMaxTokens
+	}
+	if len(p.latencies) < hedgeMinSamples {
+		return 0
+	}
+	sorted := make([]time.Duration, len(p.latencies))
+	copy(sorted, p.latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	delay := sorted[len(sorted)*95/100]
+	if minDelay := p.params.IndexNodeCfg.StorageHedgeMinDelay.GetAsDuration(time.Millisecond); delay < minDelay {
+		return minDelay
+	}
+	return delay
+}
+
+func (p *hedgePolicy) Acquire() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.tokens < 1 {
+		return false
+	}
+	p.tokens--
+	metrics.IndexNodeStorageHedgedReadCounter.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Inc()
+	return true
+}
+
+func (p *hedgePolicy) Observe(latency time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.latencies) < hedgeLatencyWindow {
+		p.latencies = append(p.latencies, latency)
+		return
+	}
+	p.latencies[p.next] = latency
+	p.next = (p.next + 1) % hedgeLatencyWindow
+}
+
+// wrapChunkManager hedges the slow reads of cm when hedging is enabled.
+func (p *hedgePolicy) wrapChunkManager(cm storage.ChunkManager) storage.ChunkManager {
+	if !p.enabled() {
+		return cm
+	}
+	return storage.NewHedgedChunkManager(cm, p)
+}
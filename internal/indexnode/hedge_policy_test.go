@@ -0,0 +1,65 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestHedgePolicy(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	p := newHedgePolicy(params)
+	assert.Equal(t, time.Duration(0), p.Delay())
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	assert.Same(t, cm, p.wrapChunkManager(cm))
+
+	params.Save(params.IndexNodeCfg.StorageHedgeEnable.Key, "true")
+	params.Save(params.IndexNodeCfg.StorageHedgeBudgetRatio.Key, "0.5")
+	_, ok := p.wrapChunkManager(cm).(*storage.HedgedChunkManager)
+	assert.True(t, ok)
+
+	// no hedging before enough reads are observed
+	for i := 1; i < hedgeMinSamples; i++ {
+		p.Observe(time.Duration(i) * time.Millisecond)
+	}
+	assert.Equal(t, time.Duration(0), p.Delay())
+	for i := 0; i < 100-hedgeMinSamples+1; i++ {
+		p.Observe(time.Duration(hedgeMinSamples+i) * time.Millisecond)
+	}
+	// the p95 latency of 1ms to 100ms
+	assert.Equal(t, 96*time.Millisecond, p.Delay())
+	params.Save(params.IndexNodeCfg.StorageHedgeMinDelay.Key, "200")
+	assert.Equal(t, 200*time.Millisecond, p.Delay())
+
+	// 4 reads earned 2 tokens
+	p.Delay()
+	assert.True(t, p.Acquire())
+	assert.True(t, p.Acquire())
+	assert.False(t, p.Acquire())
+
+	// the window keeps the recent latencies
+	for i := 0; i < hedgeLatencyWindow; i++ {
+		p.Observe(time.Second)
+	}
+	assert.Equal(t, time.Second, p.Delay())
+}
@@ -0,0 +1,178 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/compressor"
+	"github.com/milvus-io/milvus/internal/util/indexmanifest"
+	"github.com/milvus-io/milvus/internal/util/indexpointer"
+)
+
+// compactingIndex reports whether the job rewrites the files of an existing index instead of building it.
+func compactingIndex(req *indexpb.CreateJobRequest) bool {
+	return len(req.GetCompactIndexPaths()) > 0
+}
+
+// decompressIndexBlobs decompresses the index files compressed by SaveIndexFiles, the keys of the compressed
+// files end with the codec.
+func decompressIndexBlobs(blobs []*storage.Blob) ([]*storage.Blob, error) {
+	suffix := "." + string(compressor.CompressTypeZstd)
+	ret := make([]*storage.Blob, 0, len(blobs))
+	for _, blob := range blobs {
+		if !strings.HasSuffix(blob.Key, suffix) {
+			ret = append(ret, blob)
+			continue
+		}
+		value, err := compressor.ZstdDecompressBytes(blob.Value, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress index file %s: %w", blob.Key, err)
+		}
+		ret = append(ret, &storage.Blob{
+			Key:   strings.TrimSuffix(blob.Key, suffix),
+			Value: value,
+			Size:  int64(len(value)),
+		})
+	}
+	return ret, nil
+}
+
+// assembleIndexSlices restores the index files sliced by segcore from their slices and the slice meta,
+// the files not sliced are returned as is.
+func assembleIndexSlices(blobs []*storage.Blob) ([]*storage.Blob, error) {
+	var metaBlob *storage.Blob
+	byKey := make(map[string]*storage.Blob, len(blobs))
+	for _, blob := range blobs {
+		if blob.Key == sliceMetaKey {
+			metaBlob = blob
+			continue
+		}
+		byKey[blob.Key] = blob
+	}
+	if metaBlob == nil {
+		return blobs, nil
+	}
+
+	var meta map[string][]sliceMeta
+	if err := json.Unmarshal(bytes.TrimRight(metaBlob.Value, "\x00"), &meta); err != nil {
+		return nil, fmt.Errorf("failed to decode index slice meta: %w", err)
+	}
+	ret := make([]*storage.Blob, 0, len(blobs))
+	for _, file := range meta["meta"] {
+		data := make([]byte, 0, file.TotalLen)
+		for i := 0; i < file.SliceNum; i++ {
+			key := fmt.Sprintf("%s_%d", file.Name, i)
+			slice, ok := byKey[key]
+			if !ok {
+				return nil, fmt.Errorf("slice %s of index file %s is missing", key, file.Name)
+			}
+			delete(byKey, key)
+			data = append(data, slice.Value...)
+		}
+		if int64(len(data)) != file.TotalLen {
+			return nil, fmt.Errorf("index file %s has %d bytes in its slices, expected %d", file.Name, len(data), file.TotalLen)
+		}
+		ret = append(ret, &storage.Blob{Key: file.Name, Value: data, Size: int64(len(data))})
+	}
+	for _, blob := range blobs {
+		if _, ok := byKey[blob.Key]; ok {
+			ret = append(ret, blob)
+		}
+	}
+	return ret, nil
+}
+
+// loadIndexFiles reads the files of the existing index to compact, restores the files compressed
+// by SaveIndexFiles, and takes the ids and the index params of the index from them. The signed manifest is
+// dropped, the compacted files are signed again on saving.
+func (it *indexBuildTask) loadIndexFiles(ctx context.Context) error {
+	blobs := make([]*storage.Blob, 0, len(it.req.GetCompactIndexPaths()))
+	for _, filePath := range it.req.GetCompactIndexPaths() {
+		key := path.Base(filePath)
+		if key == indexmanifest.Key || key == indexpointer.Key {
+			continue
+		}
+		data, err := it.cm.Read(ctx, filePath)
+		if err != nil {
+			log.Ctx(ctx).Warn("IndexNode read index file to compact failed", zap.String("path", filePath), zap.Error(err))
+			if errors.Is(err, ErrNoSuchKey) {
+				return ErrNoSuchKey
+			}
+			return err
+		}
+		blobs = append(blobs, &storage.Blob{Key: key, Value: data, Size: int64(len(data))})
+	}
+	blobs, err := decompressIndexBlobs(blobs)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errIndexCorrupted, err)
+	}
+	codec := storage.NewIndexFileBinlogCodec()
+	_, _, collectionID, partitionID, segmentID, fieldID, indexParams, _, _, datas, err := codec.DeserializeImpl(blobs)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errIndexCorrupted, err)
+	}
+	it.collectionID, it.partitionID, it.segmentID, it.fieldID = collectionID, partitionID, segmentID, fieldID
+	it.newIndexParams = indexParams
+	it.statistic.NumRows = it.req.GetNumRows()
+	// the index files hold the binary set of the index until it's compacted
+	it.indexBlobs = datas
+	it.node.storeTaskCollection(it.ClusterID, it.BuildID, it.collectionID)
+	log.Ctx(ctx).Info("IndexNode loaded the index files to compact", zap.Int64("buildID", it.BuildID),
+		zap.Int("files", len(datas)), zap.Int64("segmentID", it.segmentID))
+	return nil
+}
+
+// compactIndexFiles slices the binary set of the index again by common.indexSliceSize, the files are compressed
+// with the codec of the node on saving.
+func (it *indexBuildTask) compactIndexFiles(ctx context.Context) error {
+	files, err := assembleIndexSlices(it.indexBlobs)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errIndexCorrupted, err)
+	}
+	sliced, err := sliceIndexBlobs(files, it.node.params.CommonCfg.IndexSliceSize.GetAsInt64()<<20)
+	if err != nil {
+		return err
+	}
+	it.serializedSize = 0
+	for _, blob := range sliced {
+		it.serializedSize += uint64(len(blob.Value))
+	}
+	codec := storage.NewIndexFileBinlogCodec()
+	indexBlobs, err := codec.Serialize(it.req.GetBuildID(), it.req.GetIndexVersion(), it.collectionID, it.partitionID,
+		it.segmentID, it.fieldID, it.newIndexParams, it.req.GetIndexName(), it.req.GetIndexID(), sliced)
+	if err != nil {
+		return err
+	}
+	observeLatency(ctx, metrics.IndexNodeEncodeIndexFileLatency.WithLabelValues(it.metricLabels()...), it.tr.Record("index compact done"))
+	log.Ctx(ctx).Info("IndexNode compacted the index files", zap.Int64("buildID", it.BuildID),
+		zap.Int("originalFileNum", len(it.indexBlobs)), zap.Int("compactedFileNum", len(sliced)))
+	it.indexBlobs = indexBlobs
+	return nil
+}
@@ -0,0 +1,122 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/compressor"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
+)
+
+func TestAssembleIndexSlices(t *testing.T) {
+	blobs, err := sliceIndexBlobs([]*storage.Blob{
+		{Key: "IVF", Value: []byte("0123456789")},
+		{Key: "SMALL", Value: []byte("01")},
+		{Key: "HNSW", Value: []byte("abcdefgh")},
+	}, 4)
+	require.NoError(t, err)
+	// IVF and HNSW are sliced and described by a single slice meta
+	assert.Len(t, blobs, 3+1+2+1)
+
+	files, err := assembleIndexSlices(blobs)
+	require.NoError(t, err)
+	contents := make(map[string]string)
+	for _, file := range files {
+		contents[file.Key] = string(file.Value)
+	}
+	assert.Equal(t, map[string]string{"IVF": "0123456789", "SMALL": "01", "HNSW": "abcdefgh"}, contents)
+
+	// a missing slice
+	_, err = assembleIndexSlices(blobs[1:])
+	assert.Error(t, err)
+
+	notSliced := []*storage.Blob{{Key: "IVF", Value: []byte("0123")}}
+	files, err = assembleIndexSlices(notSliced)
+	assert.NoError(t, err)
+	assert.Equal(t, notSliced, files)
+}
+
+func TestDecompressIndexBlobs(t *testing.T) {
+	compressed, err := compressIndexBlobs([]*storage.Blob{{Key: "IVF", Value: []byte("0123456789")}},
+		string(compressor.CompressTypeZstd), encodeZstd)
+	require.NoError(t, err)
+	blobs, err := decompressIndexBlobs(append(compressed, &storage.Blob{Key: "SLICE_META", Value: []byte("{}")}))
+	require.NoError(t, err)
+	require.Len(t, blobs, 2)
+	assert.Equal(t, "IVF", blobs[0].Key)
+	assert.Equal(t, []byte("0123456789"), blobs[0].Value)
+	assert.Equal(t, "SLICE_META", blobs[1].Key)
+
+	_, err = decompressIndexBlobs([]*storage.Blob{{Key: "IVF.zstd", Value: []byte("not zstd")}})
+	assert.Error(t, err)
+}
+
+func TestCompactIndexFiles(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	ctx := context.Background()
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+
+	// the legacy index sliced into 4 bytes slices
+	sliced, err := sliceIndexBlobs([]*storage.Blob{{Key: "IVF", Value: []byte("0123456789")}}, 4)
+	require.NoError(t, err)
+	indexParams := map[string]string{"index_type": "IVF_FLAT", "nlist": "8"}
+	indexBlobs, err := storage.NewIndexFileBinlogCodec().Serialize(1, 1, 10, 20, 30, 100, indexParams, "idx", 2, sliced)
+	require.NoError(t, err)
+	paths := make([]string, 0, len(indexBlobs))
+	for _, blob := range indexBlobs {
+		filePath := path.Join(cm.RootPath(), "index_files/1/1/20/30", blob.Key)
+		require.NoError(t, cm.Write(ctx, filePath, blob.Value))
+		paths = append(paths, filePath)
+	}
+
+	it := &indexBuildTask{
+		node:    &IndexNode{params: params},
+		cm:      cm,
+		req:     &indexpb.CreateJobRequest{BuildID: 1, IndexVersion: 1, IndexID: 2, IndexName: "idx", CompactIndexPaths: paths},
+		BuildID: 1,
+		tr:      timerecord.NewTimeRecorder("test"),
+	}
+	require.True(t, compactingIndex(it.req))
+	require.NoError(t, it.loadIndexFiles(ctx))
+	assert.Equal(t, UniqueID(20), it.partitionID)
+	assert.Equal(t, UniqueID(30), it.segmentID)
+	assert.Equal(t, indexParams, it.newIndexParams)
+	assert.Len(t, it.indexBlobs, 4)
+
+	require.NoError(t, it.compactIndexFiles(ctx))
+	datas, decodedParams, _, _, err := storage.NewIndexFileBinlogCodec().Deserialize(it.indexBlobs)
+	require.NoError(t, err)
+	assert.Equal(t, indexParams, decodedParams)
+	require.Len(t, datas, 1)
+	assert.Equal(t, "IVF", datas[0].Key)
+	assert.Equal(t, []byte("0123456789"), datas[0].Value)
+	assert.Equal(t, uint64(10), it.serializedSize)
+
+	t.Run("missing file", func(t *testing.T) {
+		it.req.CompactIndexPaths = append(paths, path.Join(cm.RootPath(), "index_files/1/1/20/30/missing"))
+		assert.Error(t, it.loadIndexFiles(ctx))
+	})
+}
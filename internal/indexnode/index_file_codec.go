@@ -0,0 +1,110 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/compressor"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// IndexFileEncoder compresses the content of an index file.
+type IndexFileEncoder func(data []byte) ([]byte, error)
+
+var (
+	indexFileCodecsMu sync.RWMutex
+	indexFileCodecs   = map[string]IndexFileEncoder{
+		string(compressor.CompressTypeZstd): encodeZstd,
+	}
+)
+
+// RegisterIndexFileCodec makes a codec selectable by indexNode.upload.codec, registering an existing codec replaces it.
+func RegisterIndexFileCodec(name string, encoder IndexFileEncoder) {
+	indexFileCodecsMu.Lock()
+	defer indexFileCodecsMu.Unlock()
+	indexFileCodecs[name] = encoder
+}
+
+func encodeZstd(data []byte) ([]byte, error) {
+	c, err := compressor.NewZstdCompressor(nil, zstd.WithEncoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+	return c.CompressBytes(data, nil), nil
+}
+
+// clusterIndexFileCodecs returns the codecs every QueryNode of the cluster is able to read.
+func clusterIndexFileCodecs(params *paramtable.ComponentParam) map[string]struct{} {
+	codecs := make(map[string]struct{})
+	for _, codec := range params.CommonCfg.IndexFileCodecs.GetAsStrings() {
+		if codec = strings.TrimSpace(codec); codec != "" {
+			codecs[codec] = struct{}{}
+		}
+	}
+	return codecs
+}
+
+// negotiateIndexFileCodec returns the codec to compress the index files with, it's empty if the configured codec
+// is not registered or not readable by every QueryNode of the cluster, so an older QueryNode never fails to load
+// the index files during a rolling upgrade.
+func negotiateIndexFileCodec(params *paramtable.ComponentParam) (string, IndexFileEncoder, error) {
+	codec := strings.TrimSpace(params.IndexNodeCfg.UploadCodec.GetValue())
+	if codec == "" {
+		return "", nil, nil
+	}
+	indexFileCodecsMu.RLock()
+	encoder, ok := indexFileCodecs[codec]
+	indexFileCodecsMu.RUnlock()
+	if !ok {
+		return "", nil, fmt.Errorf("index file codec %s is not supported", codec)
+	}
+	clusterCodecs := clusterIndexFileCodecs(params)
+	if _, ok := clusterCodecs[codec]; !ok {
+		readable := make([]string, 0, len(clusterCodecs))
+		for c := range clusterCodecs {
+			readable = append(readable, c)
+		}
+		sort.Strings(readable)
+		return "", nil, fmt.Errorf("index file codec %s is not readable by the cluster, the cluster reads %v", codec, readable)
+	}
+	return codec, encoder, nil
+}
+
+// compressIndexBlobs compresses the index files with the codec, the keys of the compressed files end with the codec.
+func compressIndexBlobs(blobs []*storage.Blob, codec string, encoder IndexFileEncoder) ([]*storage.Blob, error) {
+	compressed := make([]*storage.Blob, 0, len(blobs))
+	for _, blob := range blobs {
+		value, err := encoder(blob.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compress index file %s with %s: %w", blob.Key, codec, err)
+		}
+		compressed = append(compressed, &storage.Blob{
+			Key:   blob.Key + "." + codec,
+			Value: value,
+			Size:  int64(len(value)),
+		})
+	}
+	return compressed, nil
+}
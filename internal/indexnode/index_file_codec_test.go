@@ -0,0 +1,67 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestNegotiateIndexFileCodec(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	codec, encoder, err := negotiateIndexFileCodec(params)
+	assert.NoError(t, err)
+	assert.Empty(t, codec)
+	assert.Nil(t, encoder)
+
+	params.Save(params.IndexNodeCfg.UploadCodec.Key, "zstd")
+	// the older QueryNodes don't read zstd.
+	_, encoder, err = negotiateIndexFileCodec(params)
+	assert.ErrorContains(t, err, "not readable")
+	assert.Nil(t, encoder)
+
+	params.Save(params.CommonCfg.IndexFileCodecs.Key, "lz4, zstd")
+	codec, encoder, err = negotiateIndexFileCodec(params)
+	assert.NoError(t, err)
+	assert.Equal(t, "zstd", codec)
+	assert.NotNil(t, encoder)
+
+	params.Save(params.IndexNodeCfg.UploadCodec.Key, "lz4")
+	_, _, err = negotiateIndexFileCodec(params)
+	assert.ErrorContains(t, err, "not supported")
+}
+
+func TestCompressIndexBlobs(t *testing.T) {
+	data := []byte("index file index file index file")
+	blobs, err := compressIndexBlobs([]*storage.Blob{{Key: "IVF", Value: data}}, "zstd", encodeZstd)
+	assert.NoError(t, err)
+	assert.Len(t, blobs, 1)
+	assert.Equal(t, "IVF.zstd", blobs[0].Key)
+	assert.Equal(t, int64(len(blobs[0].Value)), blobs[0].Size)
+
+	decoder, err := zstd.NewReader(nil)
+	assert.NoError(t, err)
+	defer decoder.Close()
+	decoded, err := decoder.DecodeAll(blobs[0].Value, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, data, decoded)
+}
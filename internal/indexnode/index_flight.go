@@ -0,0 +1,265 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/flight"
+	"github.com/apache/arrow/go/v8/arrow/ipc"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+// indexInventorySchema is the columnar layout of the built indexes served by the Flight endpoint, one row per index.
+var indexInventorySchema = arrow.NewSchema([]arrow.Field{
+	{Name: "cluster_id", Type: arrow.BinaryTypes.String},
+	{Name: "build_id", Type: arrow.PrimitiveTypes.Int64},
+	{Name: "collection_id", Type: arrow.PrimitiveTypes.Int64},
+	{Name: "index_type", Type: arrow.BinaryTypes.String},
+	{Name: "num_rows", Type: arrow.PrimitiveTypes.Int64},
+	{Name: "dim", Type: arrow.PrimitiveTypes.Int64},
+	{Name: "serialized_size", Type: arrow.PrimitiveTypes.Uint64},
+	{Name: "mem_size", Type: arrow.PrimitiveTypes.Uint64},
+	{Name: "file_keys", Type: arrow.ListOf(arrow.BinaryTypes.String)},
+	{Name: "file_sizes", Type: arrow.ListOf(arrow.PrimitiveTypes.Uint64)},
+	{Name: "start_time", Type: arrow.FixedWidthTypes.Timestamp_us},
+	{Name: "end_time", Type: arrow.FixedWidthTypes.Timestamp_us},
+}, nil)
+
+// indexInventoryEntry is the manifest and the stats of an index built by the node.
+type indexInventoryEntry struct {
+	clusterID      string
+	buildID        UniqueID
+	collectionID   UniqueID
+	indexType      string
+	numRows        int64
+	dim            int64
+	serializedSize uint64
+	memSize        uint64
+	fileKeys       []string
+	fileSizes      []uint64
+	// startTime and endTime are in microseconds.
+	startTime int64
+	endTime   int64
+}
+
+// indexInventory returns the indexes built by the node for the cluster, or for all the clusters if clusterID is
+// empty, ordered by the cluster and the build. The brute-force tasks have no index files, so they are skipped.
+func (i *IndexNode) indexInventory(clusterID string) []indexInventoryEntry {
+	entries := make([]indexInventoryEntry, 0)
+	i.foreachTaskInfo(func(ClusterID string, buildID UniqueID, info *taskInfo) {
+		if info.phase != taskFinished || info.bruteForce || (clusterID != "" && ClusterID != clusterID) {
+			return
+		}
+		statistic := info.statistic
+		entries = append(entries, indexInventoryEntry{
+			clusterID:      ClusterID,
+			buildID:        buildID,
+			collectionID:   info.collectionID,
+			indexType:      funcutil.KeyValuePair2Map(statistic.GetIndexParams())["index_type"],
+			numRows:        statistic.GetNumRows(),
+			dim:            statistic.GetDim(),
+			serializedSize: info.serializedSize,
+			memSize:        info.memSize,
+			fileKeys:       append([]string(nil), info.fileKeys...),
+			fileSizes:      append([]uint64(nil), info.fileSizes...),
+			startTime:      statistic.GetStartTime(),
+			endTime:        statistic.GetEndTime(),
+		})
+	})
+	sort.Slice(entries, func(a, b int) bool {
+		if entries[a].clusterID != entries[b].clusterID {
+			return entries[a].clusterID < entries[b].clusterID
+		}
+		return entries[a].buildID < entries[b].buildID
+	})
+	return entries
+}
+
+// buildInventoryRecords converts the entries to record batches of at most batchRows rows, the caller releases them.
+func buildInventoryRecords(mem memory.Allocator, entries []indexInventoryEntry, batchRows int) []arrow.Record {
+	if batchRows <= 0 {
+		batchRows = len(entries)
+	}
+	records := make([]arrow.Record, 0)
+	builder := array.NewRecordBuilder(mem, indexInventorySchema)
+	defer builder.Release()
+	for start := 0; start < len(entries); start += batchRows {
+		end := start + batchRows
+		if end > len(entries) {
+			end = len(entries)
+		}
+		for _, entry := range entries[start:end] {
+			builder.Field(0).(*array.StringBuilder).Append(entry.clusterID)
+			builder.Field(1).(*array.Int64Builder).Append(entry.buildID)
+			builder.Field(2).(*array.Int64Builder).Append(entry.collectionID)
+			builder.Field(3).(*array.StringBuilder).Append(entry.indexType)
+			builder.Field(4).(*array.Int64Builder).Append(entry.numRows)
+			builder.Field(5).(*array.Int64Builder).Append(entry.dim)
+			builder.Field(6).(*array.Uint64Builder).Append(entry.serializedSize)
+			builder.Field(7).(*array.Uint64Builder).Append(entry.memSize)
+			keys := builder.Field(8).(*array.ListBuilder)
+			keys.Append(true)
+			keys.ValueBuilder().(*array.StringBuilder).AppendValues(entry.fileKeys, nil)
+			sizes := builder.Field(9).(*array.ListBuilder)
+			sizes.Append(true)
+			sizes.ValueBuilder().(*array.Uint64Builder).AppendValues(entry.fileSizes, nil)
+			builder.Field(10).(*array.TimestampBuilder).Append(arrow.Timestamp(entry.startTime))
+			builder.Field(11).(*array.TimestampBuilder).Append(arrow.Timestamp(entry.endTime))
+		}
+		records = append(records, builder.NewRecord())
+	}
+	return records
+}
+
+// indexFlightServer is the read-only Arrow Flight endpoint serving the indexes built by the node, for the analytics
+// pipelines tracking the index inventory of large fleets. A flight is the inventory of a cluster, its ticket is the
+// cluster ID, and the empty ticket gets the inventory of all the clusters. The endpoint listens on
+// indexNode.flight.port, it is disabled if the port is 0.
+type indexFlightServer struct {
+	flight.BaseFlightServer
+	node *IndexNode
+	mem  memory.Allocator
+
+	server flight.Server
+	wg     sync.WaitGroup
+}
+
+func newIndexFlightServer(node *IndexNode) *indexFlightServer {
+	return &indexFlightServer{
+		node: node,
+		mem:  memory.NewGoAllocator(),
+	}
+}
+
+// Start listens on the configured port and serves the Flight requests in the background.
+func (s *indexFlightServer) Start(ctx context.Context) error {
+	port := s.node.params.IndexNodeCfg.FlightPort.GetAsInt()
+	if port <= 0 {
+		return nil
+	}
+	server := flight.NewFlightServer()
+	if err := server.Init(fmt.Sprintf(":%d", port)); err != nil {
+		return err
+	}
+	server.RegisterFlightService(s)
+	s.server = server
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		if err := server.Serve(); err != nil {
+			log.Ctx(ctx).Warn("IndexNode flight server stopped", zap.Error(err))
+		}
+	}()
+	log.Ctx(ctx).Info("IndexNode flight server started", zap.String("addr", server.Addr().String()))
+	return nil
+}
+
+// Close stops the server after the running streams finish.
+func (s *indexFlightServer) Close() {
+	if s.server != nil {
+		s.server.Shutdown()
+	}
+	s.wg.Wait()
+}
+
+func (s *indexFlightServer) flightInfo(clusterID string, entries []indexInventoryEntry) *flight.FlightInfo {
+	return &flight.FlightInfo{
+		Schema:           flight.SerializeSchema(indexInventorySchema, s.mem),
+		FlightDescriptor: &flight.FlightDescriptor{Type: flight.DescriptorPATH, Path: []string{clusterID}},
+		Endpoint:         []*flight.FlightEndpoint{{Ticket: &flight.Ticket{Ticket: []byte(clusterID)}}},
+		TotalRecords:     int64(len(entries)),
+		TotalBytes:       -1,
+	}
+}
+
+// descriptorCluster returns the cluster ID named by the path descriptor.
+func descriptorCluster(desc *flight.FlightDescriptor) (string, error) {
+	if desc.GetType() != flight.DescriptorPATH || len(desc.GetPath()) > 1 {
+		return "", status.Error(codes.InvalidArgument, "the descriptor must be the path of a cluster ID")
+	}
+	if len(desc.GetPath()) == 0 {
+		return "", nil
+	}
+	return desc.GetPath()[0], nil
+}
+
+// ListFlights lists a flight per cluster with built indexes.
+func (s *indexFlightServer) ListFlights(_ *flight.Criteria, stream flight.FlightService_ListFlightsServer) error {
+	clusters := make(map[string][]indexInventoryEntry)
+	order := make([]string, 0)
+	for _, entry := range s.node.indexInventory("") {
+		if _, ok := clusters[entry.clusterID]; !ok {
+			order = append(order, entry.clusterID)
+		}
+		clusters[entry.clusterID] = append(clusters[entry.clusterID], entry)
+	}
+	for _, clusterID := range order {
+		if err := stream.Send(s.flightInfo(clusterID, clusters[clusterID])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetFlightInfo returns the flight of the cluster named by the descriptor.
+func (s *indexFlightServer) GetFlightInfo(_ context.Context, desc *flight.FlightDescriptor) (*flight.FlightInfo, error) {
+	clusterID, err := descriptorCluster(desc)
+	if err != nil {
+		return nil, err
+	}
+	return s.flightInfo(clusterID, s.node.indexInventory(clusterID)), nil
+}
+
+// GetSchema returns the schema of the inventory, it is the same for all the clusters.
+func (s *indexFlightServer) GetSchema(_ context.Context, desc *flight.FlightDescriptor) (*flight.SchemaResult, error) {
+	if _, err := descriptorCluster(desc); err != nil {
+		return nil, err
+	}
+	return &flight.SchemaResult{Schema: flight.SerializeSchema(indexInventorySchema, s.mem)}, nil
+}
+
+// DoGet streams the inventory of the cluster of the ticket in batches of indexNode.flight.batchRows rows.
+func (s *indexFlightServer) DoGet(ticket *flight.Ticket, stream flight.FlightService_DoGetServer) error {
+	entries := s.node.indexInventory(string(ticket.GetTicket()))
+	records := buildInventoryRecords(s.mem, entries, s.node.params.IndexNodeCfg.FlightBatchRows.GetAsInt())
+	defer func() {
+		for _, record := range records {
+			record.Release()
+		}
+	}()
+
+	writer := flight.NewRecordWriter(stream, ipc.WithSchema(indexInventorySchema))
+	defer writer.Close()
+	for _, record := range records {
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/flight"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+)
+
+func TestIndexInventory(t *testing.T) {
+	statistic := &indexpb.JobInfo{
+		NumRows:     1000,
+		Dim:         128,
+		StartTime:   10,
+		EndTime:     20,
+		IndexParams: []*commonpb.KeyValuePair{{Key: "index_type", Value: "IVF_FLAT"}},
+	}
+	node := &IndexNode{}
+	for key, info := range map[taskKey]*taskInfo{
+		{ClusterID: "b", BuildID: 1}: {phase: taskFinished, collectionID: 100, statistic: statistic,
+			fileKeys: []string{"f1", "f2"}, fileSizes: []uint64{1, 2}, serializedSize: 3, memSize: 4},
+		{ClusterID: "a", BuildID: 2}: {phase: taskFinished, collectionID: 200, statistic: statistic},
+		{ClusterID: "a", BuildID: 1}: {phase: taskFinished, collectionID: 200, statistic: statistic},
+		{ClusterID: "a", BuildID: 3}: {phase: taskBuilding, statistic: statistic},
+		{ClusterID: "a", BuildID: 4}: {phase: taskFinished, bruteForce: true, statistic: statistic},
+	} {
+		node.tasks.loadOrStore(key, info)
+	}
+
+	entries := node.indexInventory("")
+	assert.Equal(t, 3, len(entries))
+	assert.Equal(t, "a", entries[0].clusterID)
+	assert.Equal(t, UniqueID(1), entries[0].buildID)
+	assert.Equal(t, UniqueID(2), entries[1].buildID)
+	assert.Equal(t, "b", entries[2].clusterID)
+	assert.Equal(t, "IVF_FLAT", entries[2].indexType)
+	assert.Equal(t, []string{"f1", "f2"}, entries[2].fileKeys)
+	assert.Equal(t, uint64(3), entries[2].serializedSize)
+
+	entries = node.indexInventory("b")
+	assert.Equal(t, 1, len(entries))
+	assert.Equal(t, UniqueID(100), entries[0].collectionID)
+}
+
+func TestBuildInventoryRecords(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	entries := []indexInventoryEntry{
+		{clusterID: "a", buildID: 1, indexType: "HNSW", fileKeys: []string{"f1", "f2"}, fileSizes: []uint64{1, 2}},
+		{clusterID: "a", buildID: 2, indexType: "HNSW", fileKeys: []string{"f3"}, fileSizes: []uint64{3}},
+		{clusterID: "a", buildID: 3, indexType: "IVF_FLAT"},
+	}
+	records := buildInventoryRecords(mem, entries, 2)
+	assert.Equal(t, 2, len(records))
+	assert.Equal(t, int64(2), records[0].NumRows())
+	assert.Equal(t, int64(1), records[1].NumRows())
+	assert.True(t, records[0].Schema().Equal(indexInventorySchema))
+
+	buildIDs := records[0].Column(1).(*array.Int64)
+	assert.Equal(t, []int64{1, 2}, buildIDs.Int64Values())
+	fileKeys := records[0].Column(8).(*array.List)
+	assert.Equal(t, []int32{0, 2, 3}, fileKeys.Offsets())
+	assert.Equal(t, "f3", fileKeys.ListValues().(*array.String).Value(2))
+	for _, record := range records {
+		record.Release()
+	}
+
+	assert.Empty(t, buildInventoryRecords(mem, nil, 0))
+}
+
+func TestDescriptorCluster(t *testing.T) {
+	clusterID, err := descriptorCluster(&flight.FlightDescriptor{Type: flight.DescriptorPATH, Path: []string{"a"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "a", clusterID)
+
+	clusterID, err = descriptorCluster(&flight.FlightDescriptor{Type: flight.DescriptorPATH})
+	assert.NoError(t, err)
+	assert.Equal(t, "", clusterID)
+
+	_, err = descriptorCluster(&flight.FlightDescriptor{Type: flight.DescriptorCMD, Cmd: []byte("a")})
+	assert.Error(t, err)
+}
@@ -0,0 +1,163 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/indexpointer"
+	"github.com/milvus-io/milvus/internal/util/metautil"
+	"github.com/milvus-io/milvus/internal/util/retry"
+)
+
+// errStaleRebuild is returned when the index has been swapped by a later in-place rebuild,
+// the index files of the stale rebuild are dropped.
+var errStaleRebuild = errors.New("stale in-place rebuild")
+
+// checkRebuildInPlace rejects the in-place rebuilds and compactions of the disk index, whose files are written
+// by knowhere to the index directory directly.
+func checkRebuildInPlace(req *indexpb.CreateJobRequest) error {
+	if !req.GetRebuildInPlace() && !compactingIndex(req) {
+		return nil
+	}
+	for _, param := range req.GetIndexParams() {
+		if param.GetKey() == "index_type" && param.GetValue() == indexparamcheck.IndexDISKANN {
+			return fmt.Errorf("%s index cannot be rebuilt in place", indexparamcheck.IndexDISKANN)
+		}
+	}
+	return nil
+}
+
+// newRebuildVersion returns the version staging the index files of the job if it's rebuilt or compacted in place,
+// otherwise 0.
+func newRebuildVersion(req *indexpb.CreateJobRequest) int64 {
+	if !req.GetRebuildInPlace() && !compactingIndex(req) {
+		return 0
+	}
+	return time.Now().UnixNano()
+}
+
+func (it *indexBuildTask) indexDir() string {
+	return metautil.BuildSegmentIndexDir(it.cm.RootPath(), it.req.GetBuildID(), it.req.GetIndexVersion(),
+		it.partitionID, it.segmentID)
+}
+
+// indexFilePath returns the path the index file of the key is saved to, the staging directory of the rebuild
+// version if the index is rebuilt in place.
+func (it *indexBuildTask) indexFilePath(key string) string {
+	if it.rebuildVersion == 0 {
+		return path.Join(it.indexDir(), key)
+	}
+	return path.Join(indexpointer.StagingDir(it.indexDir(), it.rebuildVersion), key)
+}
+
+// swapIndexPointer makes the staged index files of the keys visible by replacing the index pointer,
+// the files it replaces are deleted after indexNode.rebuild.deleteDelay.
+func (it *indexBuildTask) swapIndexPointer(ctx context.Context, keys []string) error {
+	indexDir := it.indexDir()
+	old, err := indexpointer.Read(ctx, it.cm, indexDir)
+	if err != nil {
+		log.Ctx(ctx).Warn("IndexNode read index pointer failed", zap.String("indexDir", indexDir), zap.Error(err))
+		return err
+	}
+	pointer := &indexpointer.Pointer{Version: it.rebuildVersion, Keys: keys}
+	if old != nil && old.Version >= pointer.Version {
+		it.node.retirer.retire(it.cm, pointer.Paths(indexDir), 0)
+		return fmt.Errorf("%w: the index has been rebuilt by version %d after version %d", errStaleRebuild, old.Version, pointer.Version)
+	}
+	var replaced []string
+	if old != nil {
+		replaced = old.Paths(indexDir)
+	} else {
+		files, _, err := it.cm.ListWithPrefix(ctx, indexDir+"/", true)
+		if err != nil {
+			log.Ctx(ctx).Warn("IndexNode list replaced index files failed", zap.String("indexDir", indexDir), zap.Error(err))
+			return err
+		}
+		for _, file := range files {
+			if !indexpointer.IsStaged(indexDir, file) && file != path.Join(indexDir, indexpointer.Key) {
+				replaced = append(replaced, file)
+			}
+		}
+	}
+	writeFn := func() error {
+		return indexpointer.Write(ctx, it.cm, indexDir, pointer)
+	}
+	if err := retry.Do(ctx, writeFn, retry.Attempts(5)); err != nil {
+		log.Ctx(ctx).Warn("IndexNode swap index pointer failed", zap.String("indexDir", indexDir), zap.Error(err))
+		return err
+	}
+	log.Ctx(ctx).Info("IndexNode swapped the index rebuilt in place", zap.Int64("buildID", it.BuildID),
+		zap.Int64("version", pointer.Version), zap.Int("replacedFiles", len(replaced)))
+	it.node.retirer.retire(it.cm, replaced, it.node.params.IndexNodeCfg.RebuildDeleteDelay.GetAsDuration(time.Second))
+	return nil
+}
+
+// indexFileRetirer deletes the index files replaced by the in-place rebuilds after a delay, so the readers
+// which resolved the files before the swap finish loading them. The deletions pending when the node stops
+// are dropped and the files are left in the storage.
+type indexFileRetirer struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newIndexFileRetirer() *indexFileRetirer {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &indexFileRetirer{ctx: ctx, cancel: cancel}
+}
+
+// retire deletes the files after the delay.
+func (r *indexFileRetirer) retire(cm storage.ChunkManager, paths []string, delay time.Duration) {
+	if len(paths) == 0 {
+		return
+	}
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-r.ctx.Done():
+			log.Warn("IndexNode stopped before deleting the replaced index files", zap.Strings("paths", paths))
+			return
+		case <-timer.C:
+		}
+		if err := cm.MultiRemove(r.ctx, paths); err != nil {
+			log.Warn("IndexNode delete replaced index files failed", zap.Strings("paths", paths), zap.Error(err))
+			return
+		}
+		log.Info("IndexNode deleted replaced index files", zap.Int("files", len(paths)))
+	}()
+}
+
+// Close drops the pending deletions and waits for the running ones.
+func (r *indexFileRetirer) Close() {
+	r.cancel()
+	r.wg.Wait()
+}
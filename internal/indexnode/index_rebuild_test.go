@@ -0,0 +1,101 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/indexpointer"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestCheckRebuildInPlace(t *testing.T) {
+	diskParams := []*commonpb.KeyValuePair{{Key: "index_type", Value: indexparamcheck.IndexDISKANN}}
+	assert.NoError(t, checkRebuildInPlace(&indexpb.CreateJobRequest{IndexParams: diskParams}))
+	assert.Error(t, checkRebuildInPlace(&indexpb.CreateJobRequest{IndexParams: diskParams, RebuildInPlace: true}))
+	assert.NoError(t, checkRebuildInPlace(&indexpb.CreateJobRequest{RebuildInPlace: true,
+		IndexParams: []*commonpb.KeyValuePair{{Key: "index_type", Value: indexparamcheck.IndexHNSW}}}))
+
+	assert.Zero(t, newRebuildVersion(&indexpb.CreateJobRequest{}))
+	assert.NotZero(t, newRebuildVersion(&indexpb.CreateJobRequest{RebuildInPlace: true}))
+}
+
+func TestSwapIndexPointer(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	params.Save(params.IndexNodeCfg.RebuildDeleteDelay.Key, "0")
+
+	ctx := context.Background()
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	node := &IndexNode{params: params, retirer: newIndexFileRetirer()}
+	defer node.retirer.Close()
+	it := &indexBuildTask{
+		BuildID:     1,
+		cm:          cm,
+		node:        node,
+		req:         &indexpb.CreateJobRequest{BuildID: 1, IndexVersion: 1},
+		partitionID: 2,
+		segmentID:   3,
+	}
+	exist := func(filePath string) func() bool {
+		return func() bool {
+			ok, err := cm.Exist(ctx, filePath)
+			return err == nil && ok
+		}
+	}
+	originalPath := it.indexFilePath("HNSW")
+	require.NoError(t, cm.Write(ctx, originalPath, []byte("original")))
+
+	rebuild := func(version int64) (string, error) {
+		it.rebuildVersion = version
+		stagedPath := it.indexFilePath("HNSW")
+		require.NoError(t, cm.Write(ctx, stagedPath, []byte("rebuilt")))
+		return stagedPath, it.swapIndexPointer(ctx, []string{"HNSW"})
+	}
+
+	firstPath, err := rebuild(100)
+	assert.NoError(t, err)
+	assert.Equal(t, path.Join(it.indexDir(), "rebuild", "100", "HNSW"), firstPath)
+	paths, err := indexpointer.ResolvePaths(ctx, cm, it.indexDir(), []string{"HNSW"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{firstPath}, paths)
+	assert.Eventually(t, func() bool { return !exist(originalPath)() }, time.Second, 10*time.Millisecond)
+	assert.True(t, exist(firstPath)())
+
+	secondPath, err := rebuild(200)
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool { return !exist(firstPath)() }, time.Second, 10*time.Millisecond)
+	assert.True(t, exist(secondPath)())
+
+	// the rebuild swapped by a later version is dropped
+	stalePath, err := rebuild(150)
+	assert.True(t, errors.Is(err, errStaleRebuild))
+	assert.Eventually(t, func() bool { return !exist(stalePath)() }, time.Second, 10*time.Millisecond)
+	paths, err = indexpointer.ResolvePaths(ctx, cm, it.indexDir(), []string{"HNSW"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{secondPath}, paths)
+}
@@ -0,0 +1,41 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/indexparams"
+)
+
+// estimateLoadMemSize estimates the memory needed to load the built index. A memory index is loaded entirely,
+// so it takes its serialized size. A disk index only keeps the PQ codes and the search cache in memory,
+// whose budgets are the ratios of the raw vector data size set on the index params.
+func estimateLoadMemSize(indexType string, indexParams map[string]string, numRows, dim int64, serializedSize uint64) uint64 {
+	if indexType != indexparamcheck.IndexDISKANN {
+		return serializedSize
+	}
+	rawDataSize := float64(numRows * dim * 4)
+	ratio := 0.0
+	for _, key := range []string{indexparams.PQCodeBudgetRatioKey, indexparams.SearchCacheBudgetRatioKey} {
+		if value, err := strconv.ParseFloat(indexParams[key], 64); err == nil {
+			ratio += value
+		}
+	}
+	return uint64(rawDataSize * ratio)
+}
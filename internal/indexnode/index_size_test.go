@@ -0,0 +1,38 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/indexparams"
+)
+
+func TestEstimateLoadMemSize(t *testing.T) {
+	assert.Equal(t, uint64(1024), estimateLoadMemSize(indexparamcheck.IndexHNSW, nil, 100, 8, 1024))
+
+	params := map[string]string{
+		indexparams.PQCodeBudgetRatioKey:      "0.125",
+		indexparams.SearchCacheBudgetRatioKey: "0.125",
+	}
+	// raw data size is 1000 * 128 * 4 bytes, a quarter of it is kept in memory.
+	assert.Equal(t, uint64(128000), estimateLoadMemSize(indexparamcheck.IndexDISKANN, params, 1000, 128, 1<<20))
+	assert.Equal(t, uint64(0), estimateLoadMemSize(indexparamcheck.IndexDISKANN, nil, 1000, 128, 1<<20))
+}
@@ -0,0 +1,79 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// indexTypeSlots accounts the build slots of a schedule round by index type. The index types with a build
+// parallelism set by indexNode.scheduler.indexTypeParallel have build slots of their own, the other index types
+// share the indexNode.scheduler.buildParallel build slots, since the memory and cpu profiles of the builds of
+// different index types differ wildly. The overrides are read every round, so they take effect from the next
+// round once they are changed.
+type indexTypeSlots struct {
+	// parallel is the build parallelism of the index types with overrides.
+	parallel map[string]int
+	// shared is the build parallelism shared by the index types without overrides.
+	shared  int
+	running map[string]int
+}
+
+// newIndexTypeSlots creates the build slot accounting of a round, running are the index types of the tasks
+// holding build slots.
+func newIndexTypeSlots(params *paramtable.ComponentParam, buildParallel int, running []string) *indexTypeSlots {
+	s := &indexTypeSlots{
+		parallel: make(map[string]int),
+		shared:   buildParallel,
+		running:  make(map[string]int),
+	}
+	for indexType, value := range params.IndexNodeCfg.SchedulerIndexTypeParallel.GetAsJSONMap() {
+		// the index types with invalid parallelism share the build slots.
+		if parallel, err := strconv.Atoi(value); err == nil && parallel > 0 && indexType != "" {
+			s.parallel[indexType] = parallel
+		}
+	}
+	for _, indexType := range running {
+		s.take(indexType)
+	}
+	return s
+}
+
+// pool returns the name of the build slot pool of the index type, the empty name is the shared pool.
+func (s *indexTypeSlots) pool(indexType string) string {
+	if _, ok := s.parallel[indexType]; ok {
+		return indexType
+	}
+	return ""
+}
+
+// admit returns whether the build slots of the index type of the task have room.
+func (s *indexTypeSlots) admit(t task) bool {
+	pool := s.pool(t.IndexType())
+	limit, ok := s.parallel[pool]
+	if !ok {
+		limit = s.shared
+	}
+	return s.running[pool] < limit
+}
+
+// take occupies a build slot of the index type.
+func (s *indexTypeSlots) take(indexType string) {
+	s.running[s.pool(indexType)]++
+}
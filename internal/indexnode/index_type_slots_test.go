@@ -0,0 +1,95 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func enqueueIndexTypeTasks(t *testing.T, queue TaskQueue, indexTypes ...string) {
+	for i, indexType := range indexTypes {
+		require.NoError(t, queue.addUnissuedTask(&fakeTask{id: i, tenant: "a", indexType: indexType}))
+	}
+}
+
+func roundIndexTypes(tasks []task) map[string]int {
+	indexTypes := make(map[string]int)
+	for _, t := range tasks {
+		indexTypes[t.IndexType()]++
+	}
+	return indexTypes
+}
+
+func TestScheduleIndexTypeSlots(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	params.Save(params.IndexNodeCfg.BuildParallel.Key, "1")
+	params.Save(params.IndexNodeCfg.SchedulerIndexTypeParallel.Key, `{"IVF_FLAT": 3, "HNSW": 1, "DISKANN": "x", "FLAT": 0}`)
+	sched := NewTaskScheduler(context.Background(), params)
+	enqueueIndexTypeTasks(t, sched.IndexBuildQueue, "IVF_FLAT", "IVF_FLAT", "HNSW", "IVF_FLAT", "HNSW", "IVF_FLAT",
+		"DISKANN", "FLAT")
+
+	// the index types with invalid overrides share the build slot.
+	assert.Equal(t, map[string]int{"IVF_FLAT": 3, "HNSW": 1, "DISKANN": 1}, roundIndexTypes(sched.scheduleIndexBuildTask()))
+	assert.Equal(t, map[string]int{"IVF_FLAT": 1, "HNSW": 1, "FLAT": 1}, roundIndexTypes(sched.scheduleIndexBuildTask()))
+	assert.Empty(t, sched.scheduleIndexBuildTask())
+
+	t.Run("hot reload", func(t *testing.T) {
+		enqueueIndexTypeTasks(t, sched.IndexBuildQueue, "HNSW", "HNSW", "HNSW")
+		params.Save(params.IndexNodeCfg.SchedulerIndexTypeParallel.Key, `{"HNSW": 2}`)
+		assert.Equal(t, map[string]int{"HNSW": 2}, roundIndexTypes(sched.scheduleIndexBuildTask()))
+		params.Save(params.IndexNodeCfg.SchedulerIndexTypeParallel.Key, `{}`)
+		assert.Equal(t, map[string]int{"HNSW": 1}, roundIndexTypes(sched.scheduleIndexBuildTask()))
+	})
+
+	t.Run("occupied slots", func(t *testing.T) {
+		params.Save(params.IndexNodeCfg.SchedulerIndexTypeParallel.Key, `{"HNSW": 1}`)
+		release := sched.acquireSlot("running", "HNSW", func() {})
+		enqueueIndexTypeTasks(t, sched.IndexBuildQueue, "HNSW", "IVF_FLAT")
+		assert.Equal(t, map[string]int{"IVF_FLAT": 1}, roundIndexTypes(sched.scheduleIndexBuildTask()))
+		release()
+		assert.Equal(t, map[string]int{"HNSW": 1}, roundIndexTypes(sched.scheduleIndexBuildTask()))
+	})
+}
+
+func TestReplayIndexTypeSlots(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	params.Save(params.IndexNodeCfg.BuildParallel.Key, "1")
+	params.Save(params.IndexNodeCfg.SchedulerIndexTypeParallel.Key, `{"HNSW": 1}`)
+	dir := t.TempDir()
+	sched := NewTaskScheduler(context.Background(), params)
+	decisions, err := openDecisionLog(dir, 1<<20)
+	require.NoError(t, err)
+	sched.decisions = decisions
+	enqueueIndexTypeTasks(t, sched.IndexBuildQueue, "HNSW", "HNSW", "IVF_FLAT")
+	assert.Len(t, sched.scheduleIndexBuildTask(), 2)
+	decisions.Close()
+
+	// the replay passes over the task skipped for its full build slots.
+	report, err := ReplayDecisionLog(path.Join(dir, decisionLogFile))
+	require.NoError(t, err)
+	assert.Empty(t, report.Divergence)
+	require.Len(t, report.Tenants, 1)
+	assert.Equal(t, 2, report.Tenants[0].Picked)
+	assert.Equal(t, 1, report.Tenants[0].Queued)
+}
@@ -0,0 +1,298 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/indexmanifest"
+	"github.com/milvus-io/milvus/internal/util/indexpointer"
+	"github.com/milvus-io/milvus/internal/util/metautil"
+)
+
+// errIndexCorrupted is returned when the verified index files are missing, disagree with their manifest
+// or meta, or fail to load. The verification job fails with it instead of being retried.
+var errIndexCorrupted = errors.New("index corrupted")
+
+// checkVerifyRequest rejects the VerifyIndex requests which cannot be verified.
+func checkVerifyRequest(req *indexpb.VerifyIndexRequest) error {
+	if len(req.GetIndexFileKeys()) == 0 {
+		return errors.New("no index file to verify")
+	}
+	if len(req.GetIndexFileSizes()) > 0 && len(req.GetIndexFileSizes()) != len(req.GetIndexFileKeys()) {
+		return fmt.Errorf("%d index file sizes mismatch %d index files", len(req.GetIndexFileSizes()), len(req.GetIndexFileKeys()))
+	}
+	if req.GetLoad() {
+		if _, err := getBuildEngineFactory(req.GetEngineVersion()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// indexVerifyTask checks the integrity of the index files of a finished build, it runs in the build slots like
+// the build tasks: the files are downloaded and checked against the manifest and the meta in LoadData,
+// and loaded by the build engine in BuildIndex if requested. Nothing is saved.
+type indexVerifyTask struct {
+	ident  string
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	ClusterID string
+	JobID     UniqueID
+	node      *IndexNode
+	req       *indexpb.VerifyIndexRequest
+	cm        storage.ChunkManager
+
+	// paths are the paths of the index files of the keys, resolved through the index pointer in Prepare.
+	paths map[string]string
+	// blobs are the downloaded index files kept for the load.
+	blobs []*storage.Blob
+}
+
+var _ task = (*indexVerifyTask)(nil)
+
+func (vt *indexVerifyTask) Ctx() context.Context {
+	return vt.ctx
+}
+
+func (vt *indexVerifyTask) Name() string {
+	return vt.ident
+}
+
+func (vt *indexVerifyTask) Tenant() string {
+	return vt.ClusterID
+}
+
+func (vt *indexVerifyTask) IndexType() string {
+	return funcutil.KeyValuePair2Map(vt.req.GetIndexParams())["index_type"]
+}
+
+func (vt *indexVerifyTask) GetState() commonpb.IndexState {
+	return vt.node.loadTaskState(vt.ClusterID, vt.JobID)
+}
+
+func (vt *indexVerifyTask) SetPhase(phase taskPhase, failReason string) error {
+	return vt.node.transitTaskPhase(vt.ClusterID, vt.JobID, phase, failReason)
+}
+
+func (vt *indexVerifyTask) OnEnqueue(ctx context.Context) error {
+	log.Ctx(ctx).Info("IndexNode index verify task enqueue", zap.Int64("jobID", vt.JobID),
+		zap.Int64("buildID", vt.req.GetBuildID()), zap.Int("files", len(vt.req.GetIndexFileKeys())))
+	return nil
+}
+
+func (vt *indexVerifyTask) Reset() {
+	vt.ident = ""
+	vt.ctx = nil
+	vt.cancel = nil
+	vt.cm = nil
+	vt.paths = nil
+	vt.blobs = nil
+}
+
+// warn records what the verification could not check, the index is still reported healthy.
+func (vt *indexVerifyTask) warn(ctx context.Context, format string, args ...interface{}) {
+	warning := fmt.Sprintf(format, args...)
+	log.Ctx(ctx).Warn("IndexNode index verify degraded", zap.Int64("jobID", vt.JobID), zap.String("warning", warning))
+	vt.node.storeTaskWarning(vt.ClusterID, vt.JobID, warning)
+}
+
+// Prepare resolves the paths of the index files, the files of an index rebuilt in place are staged aside.
+func (vt *indexVerifyTask) Prepare(ctx context.Context) error {
+	indexDir := metautil.BuildSegmentIndexDir(vt.cm.RootPath(), vt.req.GetBuildID(), vt.req.GetIndexVersion(),
+		vt.req.GetPartitionID(), vt.req.GetSegmentID())
+	paths, err := indexpointer.ResolvePaths(ctx, vt.cm, indexDir, vt.req.GetIndexFileKeys())
+	if err != nil {
+		return err
+	}
+	vt.paths = make(map[string]string, len(paths))
+	for i, key := range vt.req.GetIndexFileKeys() {
+		vt.paths[key] = paths[i]
+	}
+	return nil
+}
+
+// readIndexFile reads the index file of the key, it reports false if the file is missing.
+func (vt *indexVerifyTask) readIndexFile(ctx context.Context, key string) ([]byte, bool, error) {
+	filePath := vt.paths[key]
+	data, err := vt.cm.Read(ctx, filePath)
+	if err == nil {
+		return data, true, nil
+	}
+	// the chunk managers fail reading a missing file with different errors.
+	if exist, existErr := vt.cm.Exist(ctx, filePath); existErr == nil && !exist {
+		return nil, false, nil
+	}
+	return nil, false, err
+}
+
+// LoadData downloads the index files and checks them against the sizes of the meta and the manifest,
+// all the problems found are reported together.
+func (vt *indexVerifyTask) LoadData(ctx context.Context) error {
+	problems := make([]string, 0)
+	manifest, err := vt.readManifest(ctx)
+	if err != nil {
+		if !errors.Is(err, errIndexCorrupted) {
+			return err
+		}
+		problems = append(problems, err.Error())
+	} else if manifest == nil {
+		vt.warn(ctx, "the index has no manifest, only the file sizes are checked")
+	} else {
+		problems = append(problems, vt.checkManifestIdentity(manifest)...)
+	}
+
+	listed := make(map[string]struct{}, len(vt.req.GetIndexFileKeys()))
+	for i, key := range vt.req.GetIndexFileKeys() {
+		if key == indexmanifest.Key {
+			continue
+		}
+		listed[key] = struct{}{}
+		data, exist, err := vt.readIndexFile(ctx, key)
+		if err != nil {
+			return err
+		}
+		if !exist {
+			problems = append(problems, fmt.Sprintf("%s is missing", key))
+			continue
+		}
+		if sizes := vt.req.GetIndexFileSizes(); len(sizes) > 0 && uint64(len(data)) != sizes[i] {
+			problems = append(problems, fmt.Sprintf("%s has %d bytes, the meta records %d bytes", key, len(data), sizes[i]))
+		}
+		if manifest != nil {
+			if err := manifest.VerifyFile(key, data); err != nil {
+				problems = append(problems, err.Error())
+			}
+		}
+		if vt.req.GetLoad() {
+			vt.blobs = append(vt.blobs, &storage.Blob{Key: key, Value: data})
+		}
+	}
+	if manifest != nil {
+		for _, file := range manifest.Files {
+			if _, ok := listed[file.Key]; !ok {
+				problems = append(problems, fmt.Sprintf("%s of the manifest is not in the meta", file.Key))
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", errIndexCorrupted, strings.Join(problems, "; "))
+	}
+	log.Ctx(ctx).Info("IndexNode index files verified", zap.Int64("jobID", vt.JobID),
+		zap.Int64("buildID", vt.req.GetBuildID()), zap.Bool("manifest", manifest != nil))
+	return nil
+}
+
+// readManifest returns the manifest of the index, or nil if the index has none. The signature is verified
+// if the node signs the manifests, otherwise the manifest is only used to detect the corrupted files.
+func (vt *indexVerifyTask) readManifest(ctx context.Context) (*indexmanifest.Manifest, error) {
+	if !funcutil.SliceContain(vt.req.GetIndexFileKeys(), indexmanifest.Key) {
+		return nil, nil
+	}
+	data, exist, err := vt.readIndexFile(ctx, indexmanifest.Key)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, fmt.Errorf("%w: the manifest is missing", errIndexCorrupted)
+	}
+	var manifest *indexmanifest.Manifest
+	if verifier, ok := indexmanifest.VerifierOf(vt.node.manifestSigner); ok {
+		manifest, err = indexmanifest.Verify(data, verifier)
+	} else {
+		vt.warn(ctx, "the manifest signature is not verified, manifest signing is disabled on the node")
+		manifest, err = indexmanifest.Parse(data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errIndexCorrupted, err)
+	}
+	return manifest, nil
+}
+
+// checkManifestIdentity returns the ids of the manifest disagreeing with the verified index.
+func (vt *indexVerifyTask) checkManifestIdentity(manifest *indexmanifest.Manifest) []string {
+	problems := make([]string, 0)
+	for _, id := range []struct {
+		name               string
+		expected, recorded int64
+	}{
+		{"buildID", vt.req.GetBuildID(), manifest.BuildID},
+		{"indexID", vt.req.GetIndexID(), manifest.IndexID},
+		{"index version", vt.req.GetIndexVersion(), manifest.IndexVersion},
+		{"collectionID", vt.req.GetCollectionID(), manifest.CollectionID},
+		{"partitionID", vt.req.GetPartitionID(), manifest.PartitionID},
+		{"segmentID", vt.req.GetSegmentID(), manifest.SegmentID},
+		{"fieldID", vt.req.GetFieldID(), manifest.FieldID},
+	} {
+		if id.expected != id.recorded {
+			problems = append(problems, fmt.Sprintf("the manifest records %s %d instead of %d", id.name, id.recorded, id.expected))
+		}
+	}
+	return problems
+}
+
+// BuildIndex loads the index with the build engine if the request asks for it,
+// and runs the sanity queries of the engine.
+func (vt *indexVerifyTask) BuildIndex(ctx context.Context) error {
+	if !vt.req.GetLoad() {
+		return nil
+	}
+	factory, err := getBuildEngineFactory(vt.req.GetEngineVersion())
+	if err != nil {
+		return err
+	}
+	engine, err := factory(vt.req.GetFieldType(), funcutil.KeyValuePair2Map(vt.req.GetTypeParams()),
+		funcutil.KeyValuePair2Map(vt.req.GetIndexParams()), vt.req.GetStorageConfig())
+	if err != nil {
+		return err
+	}
+	defer engine.Delete()
+	loader, ok := engine.(IndexLoader)
+	if !ok {
+		vt.warn(ctx, "the engine is not able to load the index, the load is skipped")
+		return nil
+	}
+	if err := loader.Load(vt.blobs); err != nil {
+		return fmt.Errorf("%w: load index failed: %v", errIndexCorrupted, err)
+	}
+	vt.blobs = nil
+	querier, ok := engine.(SanityQuerier)
+	if !ok {
+		log.Ctx(ctx).Info("IndexNode index loaded, the engine has no sanity queries", zap.Int64("jobID", vt.JobID))
+		return nil
+	}
+	if err := querier.SanityQuery(); err != nil {
+		return fmt.Errorf("%w: sanity query failed: %v", errIndexCorrupted, err)
+	}
+	log.Ctx(ctx).Info("IndexNode index loaded and queried", zap.Int64("jobID", vt.JobID))
+	return nil
+}
+
+func (vt *indexVerifyTask) SaveIndexFiles(ctx context.Context) error {
+	return nil
+}
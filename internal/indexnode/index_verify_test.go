@@ -0,0 +1,180 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/indexmanifest"
+	"github.com/milvus-io/milvus/internal/util/metautil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+type mockLoadEngine struct {
+	mockBuildEngine
+	loaded   []*storage.Blob
+	queryErr error
+}
+
+func (e *mockLoadEngine) Load(blobs []*storage.Blob) error {
+	e.loaded = blobs
+	return nil
+}
+
+func (e *mockLoadEngine) SanityQuery() error {
+	return e.queryErr
+}
+
+func TestCheckVerifyRequest(t *testing.T) {
+	assert.Error(t, checkVerifyRequest(&indexpb.VerifyIndexRequest{}))
+	assert.Error(t, checkVerifyRequest(&indexpb.VerifyIndexRequest{IndexFileKeys: []string{"a", "b"}, IndexFileSizes: []uint64{1}}))
+	assert.Error(t, checkVerifyRequest(&indexpb.VerifyIndexRequest{IndexFileKeys: []string{"a"}, Load: true, EngineVersion: "unknown"}))
+	assert.NoError(t, checkVerifyRequest(&indexpb.VerifyIndexRequest{IndexFileKeys: []string{"a"}, IndexFileSizes: []uint64{1}, Load: true}))
+}
+
+func TestIndexVerifyTask(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	ctx := context.Background()
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	signer := indexmanifest.NewHMACKey([]byte("secret"))
+	files := map[string][]byte{"HNSW": []byte("index data"), "SLICE_META": []byte("meta")}
+	manifest := &indexmanifest.Manifest{BuildID: 1, IndexID: 2, IndexVersion: 1, CollectionID: 3, PartitionID: 4, SegmentID: 5, FieldID: 100}
+	for key, value := range files {
+		manifest.Files = append(manifest.Files, indexmanifest.NewFile(key, value))
+	}
+	manifestData, err := indexmanifest.Sign(manifest, signer)
+	require.NoError(t, err)
+	files[indexmanifest.Key] = manifestData
+	write := func(key string, value []byte) {
+		require.NoError(t, cm.Write(ctx, metautil.BuildSegmentIndexFilePath(cm.RootPath(), 1, 1, 4, 5, key), value))
+	}
+	for key, value := range files {
+		write(key, value)
+	}
+
+	newTask := func(req *indexpb.VerifyIndexRequest) *indexVerifyTask {
+		node := &IndexNode{params: params, manifestSigner: signer}
+		node.loadOrStoreTask("cluster", 10, &taskInfo{phase: taskPending})
+		vt := &indexVerifyTask{ClusterID: "cluster", JobID: 10, node: node, req: req, cm: cm}
+		require.NoError(t, vt.Prepare(ctx))
+		return vt
+	}
+	newRequest := func() *indexpb.VerifyIndexRequest {
+		return &indexpb.VerifyIndexRequest{
+			BuildID: 1, IndexID: 2, IndexVersion: 1, CollectionID: 3, PartitionID: 4, SegmentID: 5, FieldID: 100,
+			IndexFileKeys:  []string{"HNSW", "SLICE_META", indexmanifest.Key},
+			IndexFileSizes: []uint64{10, 4, uint64(len(manifestData))},
+		}
+	}
+
+	t.Run("healthy", func(t *testing.T) {
+		vt := newTask(newRequest())
+		assert.NoError(t, vt.LoadData(ctx))
+		assert.NoError(t, vt.BuildIndex(ctx))
+		assert.Empty(t, vt.node.tasks.info(taskKey{ClusterID: "cluster", BuildID: 10}).warnings)
+
+		// the manifest is still checked without the key to verify its signature
+		vt = newTask(newRequest())
+		vt.node.manifestSigner = nil
+		assert.NoError(t, vt.LoadData(ctx))
+		assert.Len(t, vt.node.tasks.info(taskKey{ClusterID: "cluster", BuildID: 10}).warnings, 1)
+	})
+
+	t.Run("no manifest", func(t *testing.T) {
+		req := newRequest()
+		req.IndexFileKeys, req.IndexFileSizes = req.IndexFileKeys[:2], req.IndexFileSizes[:2]
+		vt := newTask(req)
+		assert.NoError(t, vt.LoadData(ctx))
+		assert.Equal(t, []string{"the index has no manifest, only the file sizes are checked"},
+			vt.node.tasks.info(taskKey{ClusterID: "cluster", BuildID: 10}).warnings)
+
+		req.IndexFileSizes[1] = 5
+		err := newTask(req).LoadData(ctx)
+		assert.True(t, errors.Is(err, errIndexCorrupted))
+		assert.Contains(t, err.Error(), "SLICE_META has 4 bytes, the meta records 5 bytes")
+	})
+
+	t.Run("corrupted", func(t *testing.T) {
+		write("HNSW", []byte("index dat4"))
+		defer write("HNSW", files["HNSW"])
+		err := newTask(newRequest()).LoadData(ctx)
+		assert.True(t, errors.Is(err, errIndexCorrupted))
+		assert.Contains(t, err.Error(), "HNSW has 10 bytes with sha256")
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		req := newRequest()
+		req.IndexFileKeys = append(req.IndexFileKeys, "IVF")
+		req.IndexFileSizes = append(req.IndexFileSizes, 1)
+		err := newTask(req).LoadData(ctx)
+		assert.True(t, errors.Is(err, errIndexCorrupted))
+		assert.Contains(t, err.Error(), "IVF is missing")
+
+		req = newRequest()
+		req.IndexFileKeys, req.IndexFileSizes = req.IndexFileKeys[1:], req.IndexFileSizes[1:]
+		err = newTask(req).LoadData(ctx)
+		assert.True(t, errors.Is(err, errIndexCorrupted))
+		assert.Contains(t, err.Error(), "HNSW of the manifest is not in the meta")
+	})
+
+	t.Run("other build", func(t *testing.T) {
+		req := newRequest()
+		req.IndexID = 7
+		err := newTask(req).LoadData(ctx)
+		assert.True(t, errors.Is(err, errIndexCorrupted))
+		assert.Contains(t, err.Error(), "the manifest records indexID 2 instead of 7")
+
+		vt := newTask(newRequest())
+		vt.node.manifestSigner = indexmanifest.NewHMACKey([]byte("other"))
+		err = vt.LoadData(ctx)
+		assert.True(t, errors.Is(err, errIndexCorrupted))
+		assert.Contains(t, err.Error(), indexmanifest.ErrInvalidSignature.Error())
+	})
+
+	t.Run("load", func(t *testing.T) {
+		engine := &mockLoadEngine{}
+		RegisterBuildEngine("verify-mock", func(schemapb.DataType, map[string]string, map[string]string,
+			*indexpb.StorageConfig) (BuildEngine, error) {
+			return engine, nil
+		})
+		defer func() {
+			buildEnginesMu.Lock()
+			delete(buildEngines, "verify-mock")
+			buildEnginesMu.Unlock()
+		}()
+		req := newRequest()
+		req.Load = true
+		req.EngineVersion = "verify-mock"
+		vt := newTask(req)
+		assert.NoError(t, vt.LoadData(ctx))
+		assert.NoError(t, vt.BuildIndex(ctx))
+		assert.Len(t, engine.loaded, 2)
+
+		engine.queryErr = errors.New("mock")
+		vt = newTask(req)
+		assert.NoError(t, vt.LoadData(ctx))
+		assert.True(t, errors.Is(vt.BuildIndex(ctx), errIndexCorrupted))
+	})
+}
@@ -45,8 +45,10 @@ import (
 	"github.com/milvus-io/milvus/internal/util/commonpbutil"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/hardware"
+	"github.com/milvus-io/milvus/internal/util/indexmanifest"
 	"github.com/milvus-io/milvus/internal/util/initcore"
 	"github.com/milvus-io/milvus/internal/util/lifetime"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -64,9 +66,6 @@ var _ types.IndexNode = (*IndexNode)(nil)
 // make sure IndexNode implements types.IndexNodeComponent
 var _ types.IndexNodeComponent = (*IndexNode)(nil)
 
-// Params is a GlobalParamTable singleton of indexnode
-var Params *paramtable.ComponentParam = paramtable.Get()
-
 type taskKey struct {
 	ClusterID string
 	BuildID   UniqueID
@@ -79,7 +78,32 @@ type IndexNode struct {
 	loopCtx    context.Context
 	loopCancel func()
 
-	sched *TaskScheduler
+	// params is the param table of the node, every config of the node is read from it.
+	params *paramtable.ComponentParam
+
+	sched    *TaskScheduler
+	tuner    *buildTuner
+	reporter *jobResultReporter
+	reaper   *taskReaper
+	// memGuard abandons builds and shrinks the build parallelism under memory pressure.
+	memGuard *memoryGuard
+	// subErrors keeps the last errors of the subcomponents reported by GetComponentStates.
+	subErrors *subcomponentErrors
+	// taskLabels are the task label values of the build latency metrics.
+	taskLabels *taskMetricLabels
+	// configGuard refuses the unsafe dynamic config changes and rolls back the ones degrading the node.
+	configGuard *configGuard
+	// replicator copies the index files of the jobs with a replica storage to the replica.
+	replicator *indexReplicator
+	// flight serves the built indexes to the analytics pipelines over Arrow Flight.
+	flight *indexFlightServer
+	// builders runs the sandboxed builds in long-lived builder workers, nil if a helper is started for every build.
+	builders *builderPool
+	// retirer deletes the index files replaced by the in-place rebuilds.
+	retirer *indexFileRetirer
+	// audits writes the audit records of the finished tasks.
+	audits *auditWriter
+	faults *faultInjector
 
 	once     sync.Once
 	stopOnce sync.Once
@@ -91,27 +115,99 @@ type IndexNode struct {
 	etcdCli *clientv3.Client
 	address string
 
-	initOnce  sync.Once
-	stateLock sync.Mutex
-	tasks     map[taskKey]*taskInfo
+	initOnce sync.Once
+	tasks    taskMap
+	// phaseHooksLock guards phaseHooks, the hooks are called under the lock of the shard of the task.
+	phaseHooksLock sync.RWMutex
+	phaseHooks     []taskPhaseHook
+	// limiters rate limits the storage requests of each cluster.
+	limiters *tenantLimiters
+	// hedges hedges the slow storage reads of all the tasks within one budget.
+	hedges *hedgePolicy
+	// uploads shares the upload slots among the saving tasks.
+	uploads *uploadScheduler
+	// jobLogs keeps the log entries of the tasks for WatchJobLog.
+	jobLogs *jobLogHub
+	// logLevel restores the log level changed by SetLogLevel.
+	logLevel *logLevelOverride
+	// suspension fences the node set by SetSuspended.
+	suspension *nodeSuspension
+	// reservations are the build slots reserved by ReserveSlots.
+	reservations *slotReservations
+	// decoders decodes the binlogs of the tasks, nil if the tasks decode in their own goroutines.
+	decoders *decodePool
+	// journal records the task phase transitions for post-crash forensics, it's nil if disabled.
+	journal *taskJournal
+	// buildResults answers the repeated jobs of the finished builds, it's nil if disabled.
+	buildResults *buildResultCache
+	// datasets shares the decoded datasets across the tasks loading the same data, it's nil if disabled.
+	datasets *datasetCache
+	// retention evicts the infos of the completed tasks beyond the retention policy.
+	retention *taskRetention
+	// collectionDrops aborts the tasks of the dropped collections, it's nil if disabled.
+	collectionDrops *collectionDropWatcher
+	// ioCgroups sets the io.weight of the node by the job class of its spilling builds.
+	ioCgroups *ioCgroups
+	// ioLanes separate the etcd requests of the node from its object storage requests.
+	ioLanes *ioLanes
+	// binlogReaders streams the binlogs of the jobs from the DataNodes which wrote them, nil if no creator is set.
+	binlogReaders *binlogReaders
+	// manifestSigner signs the manifests of the index files, nil if signing is disabled.
+	manifestSigner indexmanifest.Signer
+	// staging stripes the local build files across the staging directories.
+	staging *stagingDirs
+	// warmup is the result of the warm-up benchmark, it's set before the node becomes healthy
+	// and nil if the benchmark is disabled.
+	warmup *metricsinfo.IndexNodeWarmup
+	// previousNodeID is the server id of the previous run of the node, 0 if reconciling is disabled
+	// or the node runs for the first time.
+	previousNodeID UniqueID
 }
 
-// NewIndexNode creates a new IndexNode component.
-func NewIndexNode(ctx context.Context, factory dependency.Factory) *IndexNode {
+// NewIndexNode creates a new IndexNode component reading its configs from params.
+func NewIndexNode(ctx context.Context, factory dependency.Factory, params *paramtable.ComponentParam) *IndexNode {
 	log.Debug("New IndexNode ...")
 	rand.Seed(time.Now().UnixNano())
 	ctx1, cancel := context.WithCancel(ctx)
 	b := &IndexNode{
 		loopCtx:        ctx1,
 		loopCancel:     cancel,
+		params:         params,
 		factory:        factory,
-		storageFactory: &chunkMgr{},
-		tasks:          map[taskKey]*taskInfo{},
+		storageFactory: &chunkMgr{params: params},
 		lifetime:       lifetime.NewLifetime(commonpb.StateCode_Abnormal),
 	}
-	sc := NewTaskScheduler(b.loopCtx)
+	sc := NewTaskScheduler(b.loopCtx, params)
+	b.subErrors = newSubcomponentErrors()
+	sc.subErrors = b.subErrors
+	b.faults = newFaultInjector(params)
+	b.limiters = newTenantLimiters(params)
+	b.ioLanes = newIOLanes(params)
+	b.hedges = newHedgePolicy(params)
+	b.uploads = newUploadScheduler(params)
+	b.jobLogs = newJobLogHub(params)
+	b.retention = newTaskRetention(b)
+	b.collectionDrops = newCollectionDropWatcher(b)
+	b.logLevel = newLogLevelOverride(params)
+	b.suspension = newNodeSuspension(params)
+	b.reservations = newSlotReservations()
+	b.taskLabels = newTaskMetricLabels(params)
+	b.registerPhaseHook(b.jobLogs.onPhase)
+	sc.faults = b.faults
 
 	b.sched = sc
+	b.tuner = newBuildTuner(sc)
+	b.reporter = newJobResultReporter(params)
+	b.reaper = newTaskReaper(b)
+	b.memGuard = newMemoryGuard(b)
+	b.configGuard = newConfigGuard(b)
+	b.replicator = newIndexReplicator(b)
+	b.flight = newIndexFlightServer(b)
+	b.builders = newBuilderPool(params)
+	b.registerPhaseHook(b.configGuard.onPhase)
+	b.retirer = newIndexFileRetirer()
+	b.audits = newAuditWriter()
+	b.ioCgroups = newIOCgroups(params)
 	return b
 }
 
@@ -122,6 +218,7 @@ func (i *IndexNode) Register() error {
 	//start liveness check
 	go i.session.LivenessCheck(i.loopCtx, func() {
 		log.Error("Index Node disconnected from etcd, process will exit", zap.Int64("Server Id", i.session.ServerID))
+		i.subErrors.record(subcomponentSession, errors.New("disconnected from etcd"))
 		if err := i.Stop(); err != nil {
 			log.Fatal("failed to stop server", zap.Error(err))
 		}
@@ -136,30 +233,55 @@ func (i *IndexNode) Register() error {
 }
 
 func (i *IndexNode) initKnowhere() {
-	cEasyloggingYaml := C.CString(path.Join(Params.BaseTable.GetConfigDir(), paramtable.DefaultEasyloggingYaml))
-	C.IndexBuilderInit(cEasyloggingYaml)
-	C.free(unsafe.Pointer(cEasyloggingYaml))
+	C.IndexBuilderInit(nil)
+	initKnowhereLog(i.params)
 
 	// override index builder SIMD type
-	cSimdType := C.CString(Params.CommonCfg.SimdType.GetValue())
+	cSimdType := C.CString(i.params.CommonCfg.SimdType.GetValue())
 	C.IndexBuilderSetSimdType(cSimdType)
 	C.free(unsafe.Pointer(cSimdType))
 
 	// override segcore index slice size
-	cIndexSliceSize := C.int64_t(Params.CommonCfg.IndexSliceSize.GetAsInt64())
+	cIndexSliceSize := C.int64_t(i.params.CommonCfg.IndexSliceSize.GetAsInt64())
 	C.InitIndexSliceSize(cIndexSliceSize)
 
-	cThreadCoreCoefficient := C.int64_t(Params.CommonCfg.ThreadCoreCoefficient.GetAsInt64())
+	cThreadCoreCoefficient := C.int64_t(i.params.CommonCfg.ThreadCoreCoefficient.GetAsInt64())
 	C.InitThreadCoreCoefficient(cThreadCoreCoefficient)
 
 	cCPUNum := C.int(hardware.GetCPUNum())
 	C.InitCpuNum(cCPUNum)
 
-	initcore.InitLocalStorageConfig(Params)
+	initcore.InitLocalStorageConfig(i.params)
+}
+
+// initKnowhereLog configures the knowhere logs by indexNode.knowhereLog.
+func initKnowhereLog(params *paramtable.ComponentParam) {
+	global, modules, err := knowhereLogConfs(params)
+	if err != nil {
+		log.Warn("IndexNode invalid knowhere log config, the knowhere logs keep the default config", zap.Error(err))
+		return
+	}
+	cConf := C.CString(global)
+	maxAge := params.IndexNodeCfg.KnowhereLogMaxAge.GetAsInt64() * int64(24*time.Hour/time.Second)
+	C.IndexBuilderInitLog(cConf, C.int64_t(maxAge))
+	C.free(unsafe.Pointer(cConf))
+	for module, conf := range modules {
+		cModule := C.CString(module)
+		cConf := C.CString(conf)
+		C.IndexBuilderSetLoggerConf(cModule, cConf)
+		C.free(unsafe.Pointer(cModule))
+		C.free(unsafe.Pointer(cConf))
+	}
+	log.Info("IndexNode knowhere log configured", zap.String("level", params.IndexNodeCfg.KnowhereLogLevel.GetValue()),
+		zap.String("file", params.IndexNodeCfg.KnowhereLogFile.GetValue()), zap.Int("modules", len(modules)))
 }
 
 func (i *IndexNode) initSession() error {
-	i.session = sessionutil.NewSession(i.loopCtx, Params.EtcdCfg.MetaRootPath.GetValue(), i.etcdCli)
+	metadata, err := capabilitiesMetadata(i.params)
+	if err != nil {
+		return err
+	}
+	i.session = sessionutil.NewSession(i.loopCtx, i.params.EtcdCfg.MetaRootPath.GetValue(), i.etcdCli, sessionutil.WithMetadata(metadata))
 	if i.session == nil {
 		return errors.New("failed to initialize session")
 	}
@@ -181,6 +303,14 @@ func (i *IndexNode) Init() error {
 		}
 		log.Info("IndexNode init session successful", zap.Int64("serverID", i.session.ServerID))
 
+		if i.params.IndexNodeCfg.ReconcileEnable.GetAsBool() {
+			i.previousNodeID, err = recordNodeID(i.params.LocalStorageCfg.Path.GetValue(), i.session.ServerID)
+			if err != nil {
+				log.Warn("IndexNode record node id failed, skip reconciling jobs", zap.Error(err))
+				i.previousNodeID = 0
+			}
+		}
+
 		if err != nil {
 			log.Error("IndexNode NewMinIOKV failed", zap.Error(err))
 			initErr = err
@@ -189,6 +319,42 @@ func (i *IndexNode) Init() error {
 
 		log.Info("IndexNode NewMinIOKV succeeded")
 
+		if err := i.initTaskJournal(); err != nil {
+			log.Error("IndexNode open task journal failed", zap.Error(err))
+			initErr = err
+			return
+		}
+		if err := i.initDecisionLog(); err != nil {
+			log.Error("IndexNode open scheduler decision log failed", zap.Error(err))
+			initErr = err
+			return
+		}
+		i.buildResults = newBuildResultCache(i.params)
+		i.datasets = newDatasetCache(i.params)
+		if i.staging, err = newStagingDirs(i.params); err != nil {
+			log.Error("IndexNode init staging dirs failed", zap.Error(err))
+			initErr = err
+			return
+		}
+		if i.manifestSigner, err = newManifestSigner(i.params); err != nil {
+			log.Error("IndexNode init index manifest signer failed", zap.Error(err))
+			initErr = err
+			return
+		}
+		if i.decoders, err = newDecodePool(i.params); err != nil {
+			log.Error("IndexNode init decode pool failed", zap.Error(err))
+			initErr = err
+			return
+		}
+		if err := loadBuildHookPlugin(i.params); err != nil {
+			log.Error("IndexNode load build hook plugin failed", zap.Error(err))
+			initErr = err
+			return
+		}
+		if i.params.IndexNodeCfg.CGOMemTraceEnable.GetAsBool() {
+			RegisterBuildHook(newCGOMemTracer(i))
+		}
+
 		i.initKnowhere()
 	})
 
@@ -202,9 +368,33 @@ func (i *IndexNode) Start() error {
 	var startErr error
 	i.once.Do(func() {
 		startErr = i.sched.Start()
+		i.tuner.Start(i.loopCtx)
+		i.reporter.Start(i.loopCtx)
+		i.reaper.Start(i.loopCtx)
+		i.memGuard.Start(i.loopCtx)
+		i.configGuard.Start(i.loopCtx)
+		i.replicator.Start(i.loopCtx)
+		if err := i.flight.Start(i.loopCtx); err != nil {
+			log.Warn("IndexNode failed to start the flight server", zap.Error(err))
+		}
+		i.staging.Start(i.loopCtx)
+		if i.collectionDrops != nil {
+			i.collectionDrops.Start(i.loopCtx)
+		}
+
+		// the benchmark runs before the node takes any task, so no real work disturbs it.
+		if i.params.IndexNodeCfg.WarmupEnable.GetAsBool() {
+			newEngine, _ := getBuildEngineFactory("")
+			i.warmup = runWarmup(newEngine, path.Join(i.params.LocalStorageCfg.Path.GetValue(), "index_warmup"),
+				i.params.IndexNodeCfg.WarmupBuildRows.GetAsInt())
+		}
 
 		i.UpdateStateCode(commonpb.StateCode_Healthy)
 		log.Info("IndexNode", zap.Any("State", i.lifetime.GetState().String()))
+
+		if i.previousNodeID != 0 && i.previousNodeID != i.session.ServerID {
+			go i.reconcileJobs(i.loopCtx, i.params.CommonCfg.ClusterPrefix.GetValue(), i.previousNodeID)
+		}
 	})
 
 	log.Info("IndexNode start finished", zap.Error(startErr))
@@ -219,8 +409,10 @@ func (i *IndexNode) Stop() error {
 		err := i.session.GoingStop()
 		if err != nil {
 			log.Warn("session fail to go stopping state", zap.Error(err))
+			i.subErrors.record(subcomponentSession, err)
 		} else {
 			i.waitTaskFinish()
+			i.handoffTasks()
 		}
 		i.lifetime.Wait()
 
@@ -238,6 +430,62 @@ func (i *IndexNode) Stop() error {
 		if i.sched != nil {
 			i.sched.Close()
 		}
+		if i.tuner != nil {
+			i.tuner.Close()
+		}
+		if i.reporter != nil {
+			i.reporter.Close()
+		}
+		if i.reaper != nil {
+			i.reaper.Close()
+		}
+		if i.memGuard != nil {
+			i.memGuard.Close()
+		}
+		if i.configGuard != nil {
+			i.configGuard.Close()
+		}
+		if i.replicator != nil {
+			i.replicator.Close()
+		}
+		if i.flight != nil {
+			i.flight.Close()
+		}
+		if i.builders != nil {
+			i.builders.Close()
+		}
+		if i.retirer != nil {
+			i.retirer.Close()
+		}
+		if i.audits != nil {
+			i.audits.Close()
+		}
+		if i.journal != nil {
+			i.journal.Close()
+		}
+		if i.sched.decisions != nil {
+			i.sched.decisions.Close()
+		}
+		if i.buildResults != nil {
+			i.buildResults.Close()
+		}
+		if i.datasets != nil {
+			i.datasets.Close()
+		}
+		if i.retention != nil {
+			i.retention.Close()
+		}
+		if i.collectionDrops != nil {
+			i.collectionDrops.Close()
+		}
+		if i.binlogReaders != nil {
+			i.binlogReaders.close()
+		}
+		if i.staging != nil {
+			i.staging.Close()
+		}
+		i.decoders.release()
+		i.logLevel.reset()
 		i.session.Revoke(time.Second)
 
 		log.Info("Index node stopped.")
@@ -245,6 +493,22 @@ func (i *IndexNode) Stop() error {
 	return nil
 }
 
+// enqueueTask adds the task to the build queue, it waits up to indexNode.scheduler.enqueueTimeout
+// for the full queue to have room, and fails at once if the timeout is 0.
+func (i *IndexNode) enqueueTask(ctx context.Context, t task) error {
+	var err error
+	timeout := i.params.IndexNodeCfg.SchedulerEnqueueTimeout.GetAsDuration(time.Millisecond)
+	if timeout <= 0 {
+		err = i.sched.IndexBuildQueue.TryEnqueue(t)
+	} else {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		err = i.sched.IndexBuildQueue.EnqueueWithTimeout(ctx, t)
+	}
+	i.subErrors.record(subcomponentScheduler, err)
+	return err
+}
+
 // UpdateStateCode updates the component state of IndexNode.
 func (i *IndexNode) UpdateStateCode(code commonpb.StateCode) {
 	i.lifetime.SetState(code)
@@ -255,6 +519,17 @@ func (i *IndexNode) SetEtcdClient(client *clientv3.Client) {
 	i.etcdCli = client
 }
 
+// SetDataCoord sets the DataCoord client used to report the results of finished jobs.
+func (i *IndexNode) SetDataCoord(dataCoord types.DataCoord) error {
+	switch {
+	case dataCoord == nil, i.reporter.dataCoord != nil:
+		return errors.New("nil parameter or repeatedly set")
+	default:
+		i.reporter.dataCoord = dataCoord
+		return nil
+	}
+}
+
 // GetComponentStates gets the component states of IndexNode.
 func (i *IndexNode) GetComponentStates(ctx context.Context) (*milvuspb.ComponentStates, error) {
 	log.RatedInfo(10, "get IndexNode components states ...")
@@ -267,11 +542,12 @@ func (i *IndexNode) GetComponentStates(ctx context.Context) (*milvuspb.Component
 		NodeID:    nodeID,
 		Role:      typeutil.IndexNodeRole,
 		StateCode: i.lifetime.GetState(),
+		ExtraInfo: i.suspension.extraInfo(),
 	}
 
 	ret := &milvuspb.ComponentStates{
 		State:              stateInfo,
-		SubcomponentStates: nil, // todo add subcomponents states
+		SubcomponentStates: i.subcomponentStates(nodeID, stateInfo.StateCode),
 		Status: &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_Success,
 		},
@@ -327,7 +603,7 @@ func (i *IndexNode) ShowConfigurations(ctx context.Context, req *internalpb.Show
 	}
 
 	configList := make([]*commonpb.KeyValuePair, 0)
-	for key, value := range Params.GetComponentConfigurations("indexnode", req.Pattern) {
+	for key, value := range i.params.GetComponentConfigurations("indexnode", req.Pattern) {
 		configList = append(configList,
 			&commonpb.KeyValuePair{
 				Key:   key,
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 )
 
 type mockIndexNodeComponent struct {
@@ -13,12 +14,12 @@ type mockIndexNodeComponent struct {
 var _ types.IndexNodeComponent = &mockIndexNodeComponent{}
 
 func NewMockIndexNodeComponent(ctx context.Context) (types.IndexNodeComponent, error) {
-	Params.Init()
+	paramtable.Init()
 	factory := &mockFactory{
 		chunkMgr: &mockChunkmgr{},
 	}
 
-	node := NewIndexNode(ctx, factory)
+	node := NewIndexNode(ctx, factory, paramtable.Get())
 
 	startEmbedEtcd()
 	etcdCli := getEtcdClient()
@@ -47,10 +47,19 @@ type Mock struct {
 	CallSetEtcdClient   func(etcdClient *clientv3.Client)
 	CallUpdateStateCode func(stateCode commonpb.StateCode)
 
-	CallCreateJob   func(ctx context.Context, req *indexpb.CreateJobRequest) (*commonpb.Status, error)
-	CallQueryJobs   func(ctx context.Context, in *indexpb.QueryJobsRequest) (*indexpb.QueryJobsResponse, error)
-	CallDropJobs    func(ctx context.Context, in *indexpb.DropJobsRequest) (*commonpb.Status, error)
-	CallGetJobStats func(ctx context.Context, in *indexpb.GetJobStatsRequest) (*indexpb.GetJobStatsResponse, error)
+	CallCreateJob        func(ctx context.Context, req *indexpb.CreateJobRequest) (*commonpb.Status, error)
+	CallQueryJobs        func(ctx context.Context, in *indexpb.QueryJobsRequest) (*indexpb.QueryJobsResponse, error)
+	CallDropJobs         func(ctx context.Context, in *indexpb.DropJobsRequest) (*commonpb.Status, error)
+	CallGetJobStats      func(ctx context.Context, in *indexpb.GetJobStatsRequest) (*indexpb.GetJobStatsResponse, error)
+	CallGetCapabilities  func(ctx context.Context, in *indexpb.GetCapabilitiesRequest) (*indexpb.GetCapabilitiesResponse, error)
+	CallWatchJobLog      func(req *indexpb.WatchJobLogRequest, stream indexpb.IndexNode_WatchJobLogServer) error
+	CallSetLogLevel      func(ctx context.Context, req *indexpb.SetLogLevelRequest) (*commonpb.Status, error)
+	CallEstimateWaitTime func(ctx context.Context, req *indexpb.EstimateWaitTimeRequest) (*indexpb.EstimateWaitTimeResponse, error)
+	CallVerifyIndex      func(ctx context.Context, req *indexpb.VerifyIndexRequest) (*commonpb.Status, error)
+	CallSetSuspended     func(ctx context.Context, req *indexpb.SetSuspendedRequest) (*commonpb.Status, error)
+	CallReserveSlots     func(ctx context.Context, req *indexpb.ReserveSlotsRequest) (*indexpb.ReserveSlotsResponse, error)
+	CallHandoffJobs      func(ctx context.Context, req *indexpb.HandoffJobsRequest) (*indexpb.HandoffJobsResponse, error)
+	CallSelfCheck        func(ctx context.Context, req *indexpb.SelfCheckRequest) (*indexpb.SelfCheckResponse, error)
 
 	CallGetMetrics         func(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 	CallShowConfigurations func(ctx context.Context, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error)
@@ -143,6 +152,63 @@ func NewIndexNodeMock() *Mock {
 				},
 			}, nil
 		},
+		CallGetCapabilities: func(ctx context.Context, in *indexpb.GetCapabilitiesRequest) (*indexpb.GetCapabilitiesResponse, error) {
+			return &indexpb.GetCapabilitiesResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_Success,
+				},
+				Capabilities: getCapabilities(paramtable.Get()),
+			}, nil
+		},
+		CallWatchJobLog: func(req *indexpb.WatchJobLogRequest, stream indexpb.IndexNode_WatchJobLogServer) error {
+			return nil
+		},
+		CallSetLogLevel: func(ctx context.Context, req *indexpb.SetLogLevelRequest) (*commonpb.Status, error) {
+			return &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			}, nil
+		},
+		CallEstimateWaitTime: func(ctx context.Context, req *indexpb.EstimateWaitTimeRequest) (*indexpb.EstimateWaitTimeResponse, error) {
+			return &indexpb.EstimateWaitTimeResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_Success,
+				},
+			}, nil
+		},
+		CallVerifyIndex: func(ctx context.Context, req *indexpb.VerifyIndexRequest) (*commonpb.Status, error) {
+			return &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			}, nil
+		},
+		CallSetSuspended: func(ctx context.Context, req *indexpb.SetSuspendedRequest) (*commonpb.Status, error) {
+			return &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			}, nil
+		},
+		CallReserveSlots: func(ctx context.Context, req *indexpb.ReserveSlotsRequest) (*indexpb.ReserveSlotsResponse, error) {
+			return &indexpb.ReserveSlotsResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_Success,
+				},
+				Accepted: true,
+				Token:    "mock-token",
+			}, nil
+		},
+		CallHandoffJobs: func(ctx context.Context, req *indexpb.HandoffJobsRequest) (*indexpb.HandoffJobsResponse, error) {
+			return &indexpb.HandoffJobsResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_Success,
+				},
+			}, nil
+		},
+		CallSelfCheck: func(ctx context.Context, req *indexpb.SelfCheckRequest) (*indexpb.SelfCheckResponse, error) {
+			return &indexpb.SelfCheckResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_Success,
+				},
+				Passed: true,
+			}, nil
+		},
 		CallGetMetrics: func(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 			return getMockSystemInfoMetrics(ctx, req, nil)
 		},
@@ -191,9 +257,19 @@ func (m *Mock) GetAddress() string {
 func (m *Mock) SetEtcdClient(etcdClient *clientv3.Client) {
 }
 
+func (m *Mock) SetDataCoord(dataCoord types.DataCoord) error {
+	return nil
+}
+
+func (m *Mock) SetBinlogReaderCreator(creator func(ctx context.Context, addr string) (types.BinlogReader, error)) {
+}
+
 func (m *Mock) UpdateStateCode(stateCode commonpb.StateCode) {
 }
 
+func (m *Mock) DropPanickedJob(clusterID string, jobID int64) {
+}
+
 func (m *Mock) CreateJob(ctx context.Context, req *indexpb.CreateJobRequest) (*commonpb.Status, error) {
 	return m.CallCreateJob(ctx, req)
 }
@@ -210,6 +286,42 @@ func (m *Mock) GetJobStats(ctx context.Context, req *indexpb.GetJobStatsRequest)
 	return m.CallGetJobStats(ctx, req)
 }
 
+func (m *Mock) GetCapabilities(ctx context.Context, req *indexpb.GetCapabilitiesRequest) (*indexpb.GetCapabilitiesResponse, error) {
+	return m.CallGetCapabilities(ctx, req)
+}
+
+func (m *Mock) SetLogLevel(ctx context.Context, req *indexpb.SetLogLevelRequest) (*commonpb.Status, error) {
+	return m.CallSetLogLevel(ctx, req)
+}
+
+func (m *Mock) EstimateWaitTime(ctx context.Context, req *indexpb.EstimateWaitTimeRequest) (*indexpb.EstimateWaitTimeResponse, error) {
+	return m.CallEstimateWaitTime(ctx, req)
+}
+
+func (m *Mock) VerifyIndex(ctx context.Context, req *indexpb.VerifyIndexRequest) (*commonpb.Status, error) {
+	return m.CallVerifyIndex(ctx, req)
+}
+
+func (m *Mock) SetSuspended(ctx context.Context, req *indexpb.SetSuspendedRequest) (*commonpb.Status, error) {
+	return m.CallSetSuspended(ctx, req)
+}
+
+func (m *Mock) ReserveSlots(ctx context.Context, req *indexpb.ReserveSlotsRequest) (*indexpb.ReserveSlotsResponse, error) {
+	return m.CallReserveSlots(ctx, req)
+}
+
+func (m *Mock) HandoffJobs(ctx context.Context, req *indexpb.HandoffJobsRequest) (*indexpb.HandoffJobsResponse, error) {
+	return m.CallHandoffJobs(ctx, req)
+}
+
+func (m *Mock) SelfCheck(ctx context.Context, req *indexpb.SelfCheckRequest) (*indexpb.SelfCheckResponse, error) {
+	return m.CallSelfCheck(ctx, req)
+}
+
+func (m *Mock) WatchJobLog(req *indexpb.WatchJobLogRequest, stream indexpb.IndexNode_WatchJobLogServer) error {
+	return m.CallWatchJobLog(req, stream)
+}
+
 func (m *Mock) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return m.CallGetMetrics(ctx, req)
 }
@@ -242,8 +354,8 @@ func getMockSystemInfoMetrics(
 			Type:        typeutil.IndexNodeRole,
 		},
 		SystemConfigurations: metricsinfo.IndexNodeConfiguration{
-			MinioBucketName: Params.MinioCfg.BucketName.GetValue(),
-			SimdType:        Params.CommonCfg.SimdType.GetValue(),
+			MinioBucketName: paramtable.Get().MinioCfg.BucketName.GetValue(),
+			SimdType:        paramtable.Get().CommonCfg.SimdType.GetValue(),
 		},
 	}
 
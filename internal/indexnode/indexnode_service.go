@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"go.opentelemetry.io/otel"
@@ -34,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/contextutil"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
@@ -60,7 +62,12 @@ func (i *IndexNode) CreateJob(ctx context.Context, req *indexpb.CreateJobRequest
 		zap.Strings("DataPaths", req.DataPaths),
 		zap.Any("TypeParams", req.TypeParams),
 		zap.Any("IndexParams", req.IndexParams),
-		zap.Int64("num_rows", req.GetNumRows()))
+		zap.Int64("num_rows", req.GetNumRows()),
+		zap.String("EngineVersion", req.GetEngineVersion()),
+		zap.Int32("Priority", req.GetPriority()),
+		zap.Int("StorageRoots", len(req.GetStorageRoots())),
+		zap.Uint64("DataTimestamp", req.GetDataTimestamp()),
+		zap.String("JobClass", req.GetJobClass()))
 	ctx, sp := otel.Tracer(typeutil.IndexNodeRole).Start(ctx, "IndexNode-CreateIndex", trace.WithAttributes(
 		attribute.Int64("IndexBuildID", req.BuildID),
 		attribute.String("ClusterID", req.ClusterID),
@@ -68,18 +75,109 @@ func (i *IndexNode) CreateJob(ctx context.Context, req *indexpb.CreateJobRequest
 	defer sp.End()
 	metrics.IndexNodeBuildIndexTaskCounter.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.TotalLabel).Inc()
 
-	taskCtx, taskCancel := context.WithCancel(i.loopCtx)
+	if _, err := getBuildEngineFactory(req.GetEngineVersion()); err != nil {
+		log.Ctx(ctx).Warn("IndexNode reject the task pinning an unsupported engine version", zap.String("ClusterID", req.ClusterID),
+			zap.Int64("IndexBuildID", req.BuildID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_BuildIndexError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if err := checkJobClass(req); err != nil {
+		log.Ctx(ctx).Warn("IndexNode reject the task of an unknown job class", zap.String("ClusterID", req.ClusterID),
+			zap.Int64("IndexBuildID", req.BuildID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_BuildIndexError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if err := checkPkOffsets(req); err != nil {
+		log.Ctx(ctx).Warn("IndexNode reject the task emitting pk offsets", zap.String("ClusterID", req.ClusterID),
+			zap.Int64("IndexBuildID", req.BuildID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_BuildIndexError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if err := checkBuildLimits(i.params, req); err != nil {
+		log.Ctx(ctx).Warn("IndexNode reject the task exceeding the build limits", zap.String("ClusterID", req.ClusterID),
+			zap.Int64("IndexBuildID", req.BuildID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_BuildIndexError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if err := checkStorageRoots(req); err != nil {
+		log.Ctx(ctx).Warn("IndexNode reject the task with invalid storage roots", zap.String("ClusterID", req.ClusterID),
+			zap.Int64("IndexBuildID", req.BuildID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_BuildIndexError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if err := checkRebuildInPlace(req); err != nil {
+		log.Ctx(ctx).Warn("IndexNode reject the in-place rebuild", zap.String("ClusterID", req.ClusterID),
+			zap.Int64("IndexBuildID", req.BuildID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_BuildIndexError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if err := i.suspension.check(); err != nil {
+		log.Ctx(ctx).Info("IndexNode reject the task while suspended", zap.String("ClusterID", req.ClusterID),
+			zap.Int64("IndexBuildID", req.BuildID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_BuildIndexError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if err := checkMaintenanceWindow(i.params, req, time.Now()); err != nil {
+		log.Ctx(ctx).Info("IndexNode reject the task in the maintenance window", zap.String("ClusterID", req.ClusterID),
+			zap.Int64("IndexBuildID", req.BuildID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_BuildIndexError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if usesPresignedURLs(req) {
+		if err := checkPresignedJob(req); err != nil {
+			log.Ctx(ctx).Warn("IndexNode reject the task with pre-signed urls", zap.String("ClusterID", req.ClusterID),
+				zap.Int64("IndexBuildID", req.BuildID), zap.Error(err))
+			return &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_BuildIndexError,
+				Reason:    err.Error(),
+			}, nil
+		}
+	}
+	// the duplicated job doesn't consume the reservation, it's rejected by loadOrStoreTask below.
+	if token := req.GetReservationToken(); token != "" && !i.hasTask(req.ClusterID, req.BuildID) {
+		if err := i.reservations.take(token, taskKey{ClusterID: req.ClusterID, BuildID: req.BuildID}); err != nil {
+			log.Ctx(ctx).Warn("IndexNode reject the task of an expired reservation", zap.String("ClusterID", req.ClusterID),
+				zap.Int64("IndexBuildID", req.BuildID), zap.Error(err))
+			return &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_BuildIndexError,
+				Reason:    err.Error(),
+			}, nil
+		}
+	}
+	// the cluster id is carried by the task context down to the storage layer to scope the storage requests,
+	// and the span context links the stage latencies recorded by the task to the trace of the job.
+	clusterCtx := trace.ContextWithSpanContext(contextutil.WithClusterID(i.loopCtx, req.ClusterID), sp.SpanContext())
+	taskCtx, taskCancel := context.WithCancel(i.jobLogs.capture(clusterCtx, taskKey{ClusterID: req.ClusterID, BuildID: req.BuildID}))
 	if oldInfo := i.loadOrStoreTask(req.ClusterID, req.BuildID, &taskInfo{
-		cancel: taskCancel,
-		state:  commonpb.IndexState_InProgress}); oldInfo != nil {
+		cancel:    taskCancel,
+		phase:     taskPending,
+		priority:  req.GetPriority(),
+		startTime: time.Now()}); oldInfo != nil {
 		log.Ctx(ctx).Warn("duplicated index build task", zap.String("ClusterID", req.ClusterID), zap.Int64("BuildID", req.BuildID))
 		return &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_BuildIndexError,
 			Reason:    "duplicated index build task",
 		}, nil
 	}
-	cm, err := i.storageFactory.NewChunkManager(i.loopCtx, req.StorageConfig)
+	cm, err := i.newJobChunkManager(clusterCtx, req)
 	if err != nil {
+		i.subErrors.record(subcomponentStorage, err)
 		log.Ctx(ctx).Error("create chunk manager failed", zap.String("Bucket", req.StorageConfig.BucketName),
 			zap.String("AccessKey", req.StorageConfig.AccessKeyID),
 			zap.String("ClusterID", req.ClusterID), zap.Int64("IndexBuildID", req.BuildID))
@@ -88,6 +186,23 @@ func (i *IndexNode) CreateJob(ctx context.Context, req *indexpb.CreateJobRequest
 			Reason:    "create chunk manager failed",
 		}, nil
 	}
+	cm = i.hedges.wrapChunkManager(i.faults.wrapChunkManager(newTenantChunkManager(newLaneChunkManager(cm, i.ioLanes.storageLane()), i.limiters)))
+	if cm, err = i.openStorageRoots(clusterCtx, req, cm); err != nil {
+		log.Ctx(ctx).Error("open storage roots failed", zap.String("ClusterID", req.ClusterID),
+			zap.Int64("IndexBuildID", req.BuildID), zap.Error(err))
+		i.abortTask(req.ClusterID, req.BuildID, err.Error())
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_BuildIndexError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if i.answerFromBuildResult(clusterCtx, req, cm) {
+		taskCancel()
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		}, nil
+	}
 	task := &indexBuildTask{
 		ident:          fmt.Sprintf("%s/%d", req.ClusterID, req.BuildID),
 		ctx:            taskCtx,
@@ -100,12 +215,14 @@ func (i *IndexNode) CreateJob(ctx context.Context, req *indexpb.CreateJobRequest
 		nodeID:         i.GetNodeID(),
 		tr:             timerecord.NewTimeRecorder(fmt.Sprintf("IndexBuildID: %d, ClusterID: %s", req.BuildID, req.ClusterID)),
 		serializedSize: 0,
+		requester:      getRequester(ctx),
+		rebuildVersion: newRebuildVersion(req),
 	}
 	ret := &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
 		Reason:    "",
 	}
-	if err := i.sched.IndexBuildQueue.Enqueue(task); err != nil {
+	if err := i.enqueueTask(ctx, task); err != nil {
 		log.Ctx(ctx).Warn("IndexNode failed to schedule", zap.Int64("IndexBuildID", req.BuildID), zap.String("ClusterID", req.ClusterID), zap.Error(err))
 		ret.ErrorCode = commonpb.ErrorCode_UnexpectedError
 		ret.Reason = err.Error()
@@ -128,14 +245,25 @@ func (i *IndexNode) QueryJobs(ctx context.Context, req *indexpb.QueryJobsRequest
 		}, nil
 	}
 	defer i.lifetime.Done()
+	if i.retention != nil {
+		i.retention.maybeEvict()
+	}
 	infos := make(map[UniqueID]*taskInfo)
 	i.foreachTaskInfo(func(ClusterID string, buildID UniqueID, info *taskInfo) {
 		if ClusterID == req.ClusterID {
 			infos[buildID] = &taskInfo{
-				state:          info.state,
+				phase:          info.phase,
 				fileKeys:       common.CloneStringList(info.fileKeys),
+				fileSizes:      append([]uint64(nil), info.fileSizes...),
 				serializedSize: info.serializedSize,
+				memSize:        info.memSize,
+				bruteForce:     info.bruteForce,
 				failReason:     info.failReason,
+				failCode:       info.failCode,
+				failureDomain:  info.failureDomain,
+				warnings:       common.CloneStringList(info.warnings),
+				startTime:      info.startTime,
+				collectionID:   info.collectionID,
 			}
 		}
 	})
@@ -147,6 +275,13 @@ func (i *IndexNode) QueryJobs(ctx context.Context, req *indexpb.QueryJobsRequest
 		ClusterID:  req.ClusterID,
 		IndexInfos: make([]*indexpb.IndexTaskInfo, 0, len(req.BuildIDs)),
 	}
+	queued := i.sched.queuedJobs(req.GetClusterID())
+	if len(req.GetBuildIDs()) == 0 {
+		ret.IndexInfos, ret.Total = listTaskInfos(infos, req)
+		setQueuedJobs(ret.IndexInfos, queued)
+		return ret, nil
+	}
+	retention := i.retention
 	for i, buildID := range req.BuildIDs {
 		ret.IndexInfos = append(ret.IndexInfos, &indexpb.IndexTaskInfo{
 			BuildID:        buildID,
@@ -155,15 +290,25 @@ func (i *IndexNode) QueryJobs(ctx context.Context, req *indexpb.QueryJobsRequest
 			SerializedSize: 0,
 		})
 		if info, ok := infos[buildID]; ok {
-			ret.IndexInfos[i].State = info.state
+			ret.IndexInfos[i].State = info.phase.indexState()
 			ret.IndexInfos[i].IndexFileKeys = info.fileKeys
 			ret.IndexInfos[i].SerializedSize = info.serializedSize
+			ret.IndexInfos[i].IndexFileSizes = info.fileSizes
+			ret.IndexInfos[i].MemSize = info.memSize
+			ret.IndexInfos[i].BruteForce = info.bruteForce
 			ret.IndexInfos[i].FailReason = info.failReason
+			ret.IndexInfos[i].FailCode = info.failCode
+			ret.IndexInfos[i].FailureDomain = info.failureDomain
+			ret.IndexInfos[i].Warnings = info.warnings
+			ret.IndexInfos[i].AwaitingRestore = info.phase == taskAwaitingRestore
 			log.RatedDebug(5, "querying index build task", zap.String("ClusterID", req.ClusterID),
-				zap.Int64("IndexBuildID", buildID), zap.String("state", info.state.String()),
+				zap.Int64("IndexBuildID", buildID), zap.String("state", info.phase.indexState().String()),
 				zap.String("fail reason", info.failReason))
+		} else if retention != nil {
+			ret.IndexInfos[i].EvictedState = retention.evictedState(taskKey{ClusterID: req.ClusterID, BuildID: buildID})
 		}
 	}
+	setQueuedJobs(ret.IndexInfos, queued)
 	return ret, nil
 }
 
@@ -188,6 +333,7 @@ func (i *IndexNode) DropJobs(ctx context.Context, req *indexpb.DropJobsRequest)
 			info.cancel()
 		}
 	}
+	i.jobLogs.remove(keys...)
 	log.Ctx(ctx).Info("drop index build jobs success", zap.String("ClusterID", req.ClusterID),
 		zap.Int64s("IndexBuildIDs", req.BuildIDs))
 	return &commonpb.Status{
@@ -196,6 +342,23 @@ func (i *IndexNode) DropJobs(ctx context.Context, req *indexpb.DropJobsRequest)
 	}, nil
 }
 
+// DropPanickedJob drops the job like DropJobs if it's still pending, a job the scheduler has picked was set up
+// by an earlier request.
+func (i *IndexNode) DropPanickedJob(clusterID string, jobID UniqueID) {
+	key := taskKey{ClusterID: clusterID, BuildID: jobID}
+	info := i.tasks.deleteIf(key, func(info *taskInfo) bool {
+		return info.phase == taskPending
+	})
+	if info == nil {
+		return
+	}
+	if info.cancel != nil {
+		info.cancel()
+	}
+	i.jobLogs.remove(key)
+	log.Warn("IndexNode drop the job of the panicked request", zap.String("ClusterID", clusterID), zap.Int64("jobID", jobID))
+}
+
 func (i *IndexNode) GetJobStats(ctx context.Context, req *indexpb.GetJobStatsRequest) (*indexpb.GetJobStatsResponse, error) {
 	if !i.lifetime.Add(commonpbutil.IsHealthyOrStopping) {
 		stateCode := i.lifetime.GetState()
@@ -212,12 +375,21 @@ func (i *IndexNode) GetJobStats(ctx context.Context, req *indexpb.GetJobStatsReq
 	jobInfos := make([]*indexpb.JobInfo, 0)
 	i.foreachTaskInfo(func(ClusterID string, buildID UniqueID, info *taskInfo) {
 		if info.statistic != nil {
-			jobInfos = append(jobInfos, proto.Clone(info.statistic).(*indexpb.JobInfo))
+			jobInfo := proto.Clone(info.statistic).(*indexpb.JobInfo)
+			for _, delta := range info.stageMemDeltas {
+				jobInfo.StageMemDeltas = append(jobInfo.StageMemDeltas, proto.Clone(delta).(*indexpb.StageMemDelta))
+			}
+			jobInfos = append(jobInfos, jobInfo)
 		}
 	})
 	slots := 0
-	if i.sched.buildParallel > unissued+active {
-		slots = i.sched.buildParallel - unissued - active
+	buildParallel := i.sched.getBuildParallel()
+	if suspended, _, _ := i.suspension.state(); suspended {
+		// the suspended node is never assigned a job.
+		buildParallel = 0
+	}
+	if reserved := i.reservations.count(); buildParallel > unissued+active+reserved {
+		slots = buildParallel - unissued - active - reserved
 	}
 	log.Ctx(ctx).Info("Get Index Job Stats", zap.Int("Unissued", unissued), zap.Int("Active", active), zap.Int("Slot", slots))
 	return &indexpb.GetJobStatsResponse{
@@ -230,7 +402,288 @@ func (i *IndexNode) GetJobStats(ctx context.Context, req *indexpb.GetJobStatsReq
 		EnqueueJobNum:    int64(unissued),
 		TaskSlots:        int64(slots),
 		JobInfos:         jobInfos,
-		EnableDisk:       Params.IndexNodeCfg.EnableDisk.GetAsBool(),
+		EnableDisk:       i.params.IndexNodeCfg.EnableDisk.GetAsBool(),
+	}, nil
+}
+
+// GetCapabilities returns the capabilities of IndexNode.
+func (i *IndexNode) GetCapabilities(ctx context.Context, req *indexpb.GetCapabilitiesRequest) (*indexpb.GetCapabilitiesResponse, error) {
+	if !i.lifetime.Add(commonpbutil.IsHealthyOrStopping) {
+		stateCode := i.lifetime.GetState()
+		log.Ctx(ctx).Warn("index node not ready", zap.String("state", stateCode.String()))
+		return &indexpb.GetCapabilitiesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "state code is not healthy",
+			},
+		}, nil
+	}
+	defer i.lifetime.Done()
+	return &indexpb.GetCapabilitiesResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Capabilities: getCapabilities(i.params),
+	}, nil
+}
+
+// WatchJobLog streams the log entries of the task kept by indexNode.jobLog.enable, the entries logged so far first,
+// then the new ones until the task is done or dropped.
+func (i *IndexNode) WatchJobLog(req *indexpb.WatchJobLogRequest, stream indexpb.IndexNode_WatchJobLogServer) error {
+	// the stream doesn't hold the lifetime, so a long watch doesn't hold off stopping the node.
+	if !commonpbutil.IsHealthyOrStopping(i.lifetime.GetState()) {
+		return errIndexNodeIsUnhealthy(paramtable.GetNodeID())
+	}
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	go func() {
+		select {
+		case <-i.loopCtx.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	key := taskKey{ClusterID: req.GetClusterID(), BuildID: req.GetBuildID()}
+	log.Ctx(ctx).Info("IndexNode watch job log", zap.String("ClusterID", key.ClusterID), zap.Int64("BuildID", key.BuildID))
+	return i.jobLogs.watch(ctx, key, stream.Send)
+}
+
+// SetLogLevel changes the log level of IndexNode for the duration of the request, then the previous level is restored.
+func (i *IndexNode) SetLogLevel(ctx context.Context, req *indexpb.SetLogLevelRequest) (*commonpb.Status, error) {
+	if !i.lifetime.Add(commonpbutil.IsHealthyOrStopping) {
+		stateCode := i.lifetime.GetState()
+		log.Ctx(ctx).Warn("index node not ready", zap.String("state", stateCode.String()))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "state code is not healthy",
+		}, nil
+	}
+	defer i.lifetime.Done()
+	if _, err := i.logLevel.set(req.GetLevel(), time.Duration(req.GetDuration())*time.Second); err != nil {
+		log.Ctx(ctx).Warn("IndexNode set log level failed", zap.String("level", req.GetLevel()), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// SetSuspended suspends IndexNode or lifts the suspension, the suspended IndexNode rejects the new jobs.
+func (i *IndexNode) SetSuspended(ctx context.Context, req *indexpb.SetSuspendedRequest) (*commonpb.Status, error) {
+	if !i.lifetime.Add(commonpbutil.IsHealthyOrStopping) {
+		stateCode := i.lifetime.GetState()
+		log.Ctx(ctx).Warn("index node not ready", zap.String("state", stateCode.String()))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "state code is not healthy",
+		}, nil
+	}
+	defer i.lifetime.Done()
+	i.suspension.set(req.GetSuspended(), req.GetReason())
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// ReserveSlots reserves a build slot for the job if IndexNode takes it, the reservation is taken
+// by the CreateJob carrying its token.
+func (i *IndexNode) ReserveSlots(ctx context.Context, req *indexpb.ReserveSlotsRequest) (*indexpb.ReserveSlotsResponse, error) {
+	if !i.lifetime.Add(commonpbutil.IsHealthy) {
+		stateCode := i.lifetime.GetState()
+		log.Ctx(ctx).Warn("index node not ready", zap.String("state", stateCode.String()), zap.String("ClusterID", req.GetClusterID()), zap.Int64("IndexBuildID", req.GetBuildID()))
+		return &indexpb.ReserveSlotsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "state code is not healthy",
+			},
+		}, nil
+	}
+	defer i.lifetime.Done()
+	token, reason := i.reserveSlots(req)
+	return &indexpb.ReserveSlotsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Accepted: token != "",
+		Token:    token,
+		Reason:   reason,
+		TtlMs:    i.params.IndexNodeCfg.ReservationTTL.GetAsDuration(time.Second).Milliseconds(),
+	}, nil
+}
+
+// HandoffJobs drops the queued jobs of the cluster not started yet and returns their requests,
+// so the coordinator reassigns them when the node is being removed.
+func (i *IndexNode) HandoffJobs(ctx context.Context, req *indexpb.HandoffJobsRequest) (*indexpb.HandoffJobsResponse, error) {
+	if !i.lifetime.Add(commonpbutil.IsHealthyOrStopping) {
+		stateCode := i.lifetime.GetState()
+		log.Ctx(ctx).Warn("index node not ready", zap.String("state", stateCode.String()), zap.String("ClusterID", req.GetClusterID()))
+		return &indexpb.HandoffJobsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "state code is not healthy",
+			},
+		}, nil
+	}
+	defer i.lifetime.Done()
+	jobs := i.handoffQueuedJobs(req.GetClusterID())
+	buildIDs := make([]UniqueID, 0, len(jobs))
+	for _, job := range jobs {
+		buildIDs = append(buildIDs, job.GetBuildID())
+	}
+	log.Ctx(ctx).Info("IndexNode handed off the queued jobs", zap.String("ClusterID", req.GetClusterID()),
+		zap.Int64s("IndexBuildIDs", buildIDs))
+	return &indexpb.HandoffJobsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Jobs: jobs,
+	}, nil
+}
+
+// SelfCheck runs a tiny end-to-end build with synthetic vectors and reports whether every dependency of the
+// node passes. It runs on the calling goroutine, outside of the task scheduler.
+func (i *IndexNode) SelfCheck(ctx context.Context, req *indexpb.SelfCheckRequest) (*indexpb.SelfCheckResponse, error) {
+	if !i.lifetime.Add(commonpbutil.IsHealthy) {
+		stateCode := i.lifetime.GetState()
+		log.Ctx(ctx).Warn("index node not ready", zap.String("state", stateCode.String()), zap.String("ClusterID", req.GetClusterID()))
+		return &indexpb.SelfCheckResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "state code is not healthy",
+			},
+		}, nil
+	}
+	defer i.lifetime.Done()
+	results := i.selfCheck(ctx, req)
+	passed := true
+	for _, result := range results {
+		passed = passed && result.GetPassed()
+	}
+	log.Ctx(ctx).Info("IndexNode self check finished", zap.String("ClusterID", req.GetClusterID()),
+		zap.Bool("passed", passed), zap.Any("results", results))
+	return &indexpb.SelfCheckResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Passed:  passed,
+		Results: results,
+	}, nil
+}
+
+// EstimateWaitTime returns the estimated queue wait of a job created now and the wait SLO of the queue.
+func (i *IndexNode) EstimateWaitTime(ctx context.Context, req *indexpb.EstimateWaitTimeRequest) (*indexpb.EstimateWaitTimeResponse, error) {
+	if !i.lifetime.Add(commonpbutil.IsHealthyOrStopping) {
+		stateCode := i.lifetime.GetState()
+		log.Ctx(ctx).Warn("index node not ready", zap.String("state", stateCode.String()))
+		return &indexpb.EstimateWaitTimeResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "state code is not healthy",
+			},
+		}, nil
+	}
+	defer i.lifetime.Done()
+	wait, queued := i.sched.estimateWait()
+	build, _ := i.sched.baselines.estimateJob(&indexpb.CreateJobRequest{
+		NumRows:     req.GetNumRows(),
+		IndexParams: req.GetIndexParams(),
+		TypeParams:  req.GetTypeParams(),
+	})
+	return &indexpb.EstimateWaitTimeResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		WaitMs:            wait.Milliseconds(),
+		QueuedJobs:        int64(queued),
+		TaskSlots:         int64(i.sched.getBuildParallel()),
+		SloViolationRatio: i.sched.waits.violationRatio(),
+		BuildMs:           build.Milliseconds(),
+	}, nil
+}
+
+// VerifyIndex schedules a job checking the integrity of the index files of a finished build,
+// the result is queried by QueryJobs with the jobID.
+func (i *IndexNode) VerifyIndex(ctx context.Context, req *indexpb.VerifyIndexRequest) (*commonpb.Status, error) {
+	if !i.lifetime.Add(commonpbutil.IsHealthy) {
+		stateCode := i.lifetime.GetState()
+		log.Ctx(ctx).Warn("index node not ready", zap.String("state", stateCode.String()), zap.String("ClusterID", req.GetClusterID()), zap.Int64("jobID", req.GetJobID()))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "state code is not healthy",
+		}, nil
+	}
+	defer i.lifetime.Done()
+	log.Ctx(ctx).Info("IndexNode verifying index ...",
+		zap.String("ClusterID", req.GetClusterID()),
+		zap.Int64("jobID", req.GetJobID()),
+		zap.Int64("IndexBuildID", req.GetBuildID()),
+		zap.Int64("IndexVersion", req.GetIndexVersion()),
+		zap.Strings("IndexFileKeys", req.GetIndexFileKeys()),
+		zap.Bool("load", req.GetLoad()))
+	if err := i.suspension.check(); err != nil {
+		log.Ctx(ctx).Info("IndexNode reject the verify job while suspended", zap.String("ClusterID", req.GetClusterID()),
+			zap.Int64("jobID", req.GetJobID()), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if err := checkVerifyRequest(req); err != nil {
+		log.Ctx(ctx).Warn("IndexNode reject the verify job", zap.String("ClusterID", req.GetClusterID()),
+			zap.Int64("jobID", req.GetJobID()), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	clusterCtx := contextutil.WithClusterID(i.loopCtx, req.GetClusterID())
+	taskCtx, taskCancel := context.WithCancel(i.jobLogs.capture(clusterCtx, taskKey{ClusterID: req.GetClusterID(), BuildID: req.GetJobID()}))
+	if oldInfo := i.loadOrStoreTask(req.GetClusterID(), req.GetJobID(), &taskInfo{
+		cancel:       taskCancel,
+		phase:        taskPending,
+		startTime:    time.Now(),
+		collectionID: req.GetCollectionID()}); oldInfo != nil {
+		log.Ctx(ctx).Warn("duplicated index verify job", zap.String("ClusterID", req.GetClusterID()), zap.Int64("jobID", req.GetJobID()))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "duplicated index verify job",
+		}, nil
+	}
+	cm, err := i.storageFactory.NewChunkManager(clusterCtx, req.GetStorageConfig())
+	if err != nil {
+		i.subErrors.record(subcomponentStorage, err)
+		log.Ctx(ctx).Error("create chunk manager failed", zap.String("Bucket", req.GetStorageConfig().GetBucketName()),
+			zap.String("ClusterID", req.GetClusterID()), zap.Int64("jobID", req.GetJobID()), zap.Error(err))
+		i.abortTask(req.GetClusterID(), req.GetJobID(), "create chunk manager failed")
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "create chunk manager failed",
+		}, nil
+	}
+	cm = i.hedges.wrapChunkManager(i.faults.wrapChunkManager(newTenantChunkManager(newLaneChunkManager(cm, i.ioLanes.storageLane()), i.limiters)))
+	task := &indexVerifyTask{
+		ident:     fmt.Sprintf("%s/%d", req.GetClusterID(), req.GetJobID()),
+		ctx:       taskCtx,
+		cancel:    taskCancel,
+		ClusterID: req.GetClusterID(),
+		JobID:     req.GetJobID(),
+		node:      i,
+		req:       req,
+		cm:        cm,
+	}
+	if err := i.enqueueTask(ctx, task); err != nil {
+		log.Ctx(ctx).Warn("IndexNode failed to schedule the verify job", zap.String("ClusterID", req.GetClusterID()),
+			zap.Int64("jobID", req.GetJobID()), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
 	}, nil
 }
 
@@ -281,6 +734,10 @@ func (i *IndexNode) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequ
 		return metrics, nil
 	}
 
+	if metricType == metricsinfo.SchedulerSnapshotMetrics {
+		return getSchedulerSnapshotMetrics(ctx, req, i)
+	}
+
 	log.Ctx(ctx).RatedWarn(60, "IndexNode.GetMetrics failed, request metric type is not implemented yet",
 		zap.Int64("nodeID", paramtable.GetNodeID()),
 		zap.String("req", req.Request),
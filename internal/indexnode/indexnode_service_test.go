@@ -30,20 +30,22 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/util/metautil"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func genStorageConfig() *indexpb.StorageConfig {
+	params := paramtable.Get()
 	return &indexpb.StorageConfig{
-		Address:         Params.MinioCfg.Address.GetValue(),
-		AccessKeyID:     Params.MinioCfg.AccessKeyID.GetValue(),
-		SecretAccessKey: Params.MinioCfg.SecretAccessKey.GetValue(),
-		BucketName:      Params.MinioCfg.BucketName.GetValue(),
-		RootPath:        Params.MinioCfg.RootPath.GetValue(),
-		IAMEndpoint:     Params.MinioCfg.IAMEndpoint.GetValue(),
-		UseSSL:          Params.MinioCfg.UseSSL.GetAsBool(),
-		UseIAM:          Params.MinioCfg.UseIAM.GetAsBool(),
+		Address:         params.MinioCfg.Address.GetValue(),
+		AccessKeyID:     params.MinioCfg.AccessKeyID.GetValue(),
+		SecretAccessKey: params.MinioCfg.SecretAccessKey.GetValue(),
+		BucketName:      params.MinioCfg.BucketName.GetValue(),
+		RootPath:        params.MinioCfg.RootPath.GetValue(),
+		IAMEndpoint:     params.MinioCfg.IAMEndpoint.GetValue(),
+		UseSSL:          params.MinioCfg.UseSSL.GetAsBool(),
+		UseIAM:          params.MinioCfg.UseIAM.GetAsBool(),
 	}
 }
 
@@ -174,6 +176,41 @@ func TestIndexNodeSimple(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, status.ErrorCode, commonpb.ErrorCode_Success)
 	})
+
+	t.Run("create duplicated job with reservation token", func(t *testing.T) {
+		reservations := in.(*mockIndexNodeComponent).reservations
+		key := taskKey{ClusterID: clusterID, BuildID: buildID + 1}
+		token, reason := reservations.reserve(key, 0, 1, time.Minute)
+		require.NotEmpty(t, token, reason)
+		createReq := &indexpb.CreateJobRequest{
+			ClusterID:        clusterID,
+			IndexFilePrefix:  idxFilePrefix,
+			BuildID:          buildID + 1,
+			DataPaths:        []string{dataPath(collID, partID, segID)},
+			IndexID:          idxID,
+			IndexName:        idxName,
+			IndexParams:      indexParams,
+			TypeParams:       typeParams,
+			StorageConfig:    genStorageConfig(),
+			ReservationToken: token,
+		}
+		status, err := in.CreateJob(ctx, createReq)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		assert.Zero(t, reservations.count())
+
+		// the same job again is rejected as a duplicate, not as an expired reservation.
+		status, err = in.CreateJob(ctx, createReq)
+		assert.NoError(t, err)
+		assert.Equal(t, "duplicated index build task", status.GetReason())
+
+		// the duplicated job doesn't consume a live reservation.
+		createReq.ReservationToken, _ = reservations.reserve(key, 0, 1, time.Minute)
+		status, err = in.CreateJob(ctx, createReq)
+		assert.NoError(t, err)
+		assert.Equal(t, "duplicated index build task", status.GetReason())
+		assert.Equal(t, 1, reservations.count())
+	})
 }
 
 type testTask struct {
@@ -348,7 +385,7 @@ Loop:
 	// stop indexnode
 	assert.Nil(t, in.Stop())
 	node := in.(*mockIndexNodeComponent).IndexNode
-	assert.Equal(t, 0, len(node.tasks))
+	assert.Equal(t, 0, node.tasks.len())
 	assert.Equal(t, commonpb.StateCode_Abnormal, node.lifetime.GetState())
 }
 
@@ -373,6 +410,10 @@ func TestAbnormalIndexNode(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, jobNumRsp.Status.ErrorCode, commonpb.ErrorCode_UnexpectedError)
 
+	capabilitiesResp, err := in.GetCapabilities(ctx, &indexpb.GetCapabilitiesRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, capabilitiesResp.Status.ErrorCode, commonpb.ErrorCode_UnexpectedError)
+
 	metricsResp, err := in.GetMetrics(ctx, &milvuspb.GetMetricsRequest{})
 	assert.Nil(t, err)
 	assert.Equal(t, metricsResp.Status.ErrorCode, commonpb.ErrorCode_UnexpectedError)
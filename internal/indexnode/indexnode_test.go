@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -454,14 +455,14 @@ import (
 //}
 
 func TestComponentState(t *testing.T) {
+	params := paramtable.Get().Namespace()
 	var (
 		factory = &mockFactory{
 			chunkMgr: &mockChunkmgr{},
 		}
 		ctx = context.TODO()
 	)
-	Params.Init()
-	in := NewIndexNode(ctx, factory)
+	in := NewIndexNode(ctx, factory, params)
 	in.SetEtcdClient(getEtcdClient())
 	state, err := in.GetComponentStates(ctx)
 	assert.Nil(t, err)
@@ -489,28 +490,28 @@ func TestComponentState(t *testing.T) {
 }
 
 func TestGetTimeTickChannel(t *testing.T) {
+	params := paramtable.Get().Namespace()
 	var (
 		factory = &mockFactory{
 			chunkMgr: &mockChunkmgr{},
 		}
 		ctx = context.TODO()
 	)
-	Params.Init()
-	in := NewIndexNode(ctx, factory)
+	in := NewIndexNode(ctx, factory, params)
 	ret, err := in.GetTimeTickChannel(ctx)
 	assert.Nil(t, err)
 	assert.Equal(t, ret.Status.ErrorCode, commonpb.ErrorCode_Success)
 }
 
 func TestGetStatisticChannel(t *testing.T) {
+	params := paramtable.Get().Namespace()
 	var (
 		factory = &mockFactory{
 			chunkMgr: &mockChunkmgr{},
 		}
 		ctx = context.TODO()
 	)
-	Params.Init()
-	in := NewIndexNode(ctx, factory)
+	in := NewIndexNode(ctx, factory, params)
 
 	ret, err := in.GetStatisticsChannel(ctx)
 	assert.Nil(t, err)
@@ -518,26 +519,26 @@ func TestGetStatisticChannel(t *testing.T) {
 }
 
 func TestIndexTaskWhenStoppingNode(t *testing.T) {
+	params := paramtable.Get().Namespace()
 	var (
 		factory = &mockFactory{
 			chunkMgr: &mockChunkmgr{},
 		}
 		ctx = context.TODO()
 	)
-	Params.Init()
-	in := NewIndexNode(ctx, factory)
+	in := NewIndexNode(ctx, factory, params)
 
 	in.loadOrStoreTask("cluster-1", 1, &taskInfo{
-		state: commonpb.IndexState_InProgress,
+		phase: taskSaving,
 	})
 	in.loadOrStoreTask("cluster-2", 2, &taskInfo{
-		state: commonpb.IndexState_Finished,
+		phase: taskFinished,
 	})
 
 	assert.True(t, in.hasInProgressTask())
 	go func() {
 		time.Sleep(2 * time.Second)
-		in.storeTaskState("cluster-1", 1, commonpb.IndexState_Finished, "")
+		in.transitTaskPhase("cluster-1", 1, taskFinished, "")
 	}()
 	noTaskChan := make(chan struct{})
 	go func() {
@@ -552,14 +553,14 @@ func TestIndexTaskWhenStoppingNode(t *testing.T) {
 }
 
 func TestGetSetAddress(t *testing.T) {
+	params := paramtable.Get().Namespace()
 	var (
 		factory = &mockFactory{
 			chunkMgr: &mockChunkmgr{},
 		}
 		ctx = context.TODO()
 	)
-	Params.Init()
-	in := NewIndexNode(ctx, factory)
+	in := NewIndexNode(ctx, factory, params)
 	in.SetAddress("address")
 	assert.Equal(t, "address", in.GetAddress())
 }
@@ -576,6 +577,7 @@ func TestInitErr(t *testing.T) {
 }
 
 func setup() {
+	paramtable.Init()
 	startEmbedEtcd()
 }
 
@@ -584,6 +586,11 @@ func teardown() {
 }
 
 func TestMain(m *testing.M) {
+	// the build helpers started by the sandbox tests run the helper test only, without the embedded etcd.
+	if os.Getenv(sandboxHelperEnv) != "" {
+		paramtable.Init()
+		os.Exit(m.Run())
+	}
 	setup()
 	code := m.Run()
 	teardown()
@@ -0,0 +1,157 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// the child cgroups of indexNode.ioPriority.cgroup by the job class of the builds.
+const (
+	ioCgroupForeground = "foreground"
+	ioCgroupBackground = "background"
+)
+
+// ioCgroups keeps IndexNode in the child cgroup of indexNode.ioPriority.cgroup of the highest job class among
+// its running DiskANN builds, whose io.weight is set by the job class. The io controller of cgroup v2 applies to
+// whole processes, the build threads of knowhere can't be put in a cgroup of their own, so IndexNode moves to the
+// background cgroup only while all its spilling builds are background ones.
+type ioCgroups struct {
+	params *paramtable.ComponentParam
+	pid    int
+
+	mu sync.Mutex
+	// dir is the cgroup set up for the builds, empty until the first build entering it.
+	dir string
+	// current is the child cgroup IndexNode is in.
+	current string
+	running map[string]int
+}
+
+func newIOCgroups(params *paramtable.ComponentParam) *ioCgroups {
+	return &ioCgroups{
+		params:  params,
+		pid:     os.Getpid(),
+		running: make(map[string]int),
+	}
+}
+
+func writeCgroupFile(path string, value string) error {
+	return os.WriteFile(path, []byte(value), 0o644)
+}
+
+// setup creates the child cgroups of the job classes and moves IndexNode into the foreground one, then enables
+// the io controller for them. A cgroup with processes can't enable the controllers of its children, so the cgroup
+// must be delegated to IndexNode and hold no other process.
+func (c *ioCgroups) setup(dir string) error {
+	for _, class := range []string{ioCgroupForeground, ioCgroupBackground} {
+		if err := os.MkdirAll(filepath.Join(dir, class), 0o755); err != nil {
+			return err
+		}
+	}
+	if err := writeCgroupFile(filepath.Join(dir, ioCgroupForeground, "cgroup.procs"), strconv.Itoa(c.pid)); err != nil {
+		return fmt.Errorf("move IndexNode into the io cgroup: %w", err)
+	}
+	c.current = ioCgroupForeground
+	if err := writeCgroupFile(filepath.Join(dir, "cgroup.subtree_control"), "+io"); err != nil {
+		return fmt.Errorf("enable the io controller: %w", err)
+	}
+	c.dir = dir
+	return nil
+}
+
+// applyLocked sets the io.weight of the child cgroups and moves IndexNode into the cgroup of the running builds.
+func (c *ioCgroups) applyLocked() error {
+	cfg := &c.params.IndexNodeCfg
+	weights := map[string]string{
+		ioCgroupForeground: cfg.IOPriorityForeground.GetValue(),
+		ioCgroupBackground: cfg.IOPriorityBackground.GetValue(),
+	}
+	for class, value := range weights {
+		weight, err := strconv.Atoi(value)
+		if err != nil || weight < 1 || weight > 10000 {
+			return fmt.Errorf("invalid io weight %q of the %s builds, expected 1 to 10000", value, class)
+		}
+		if err := writeCgroupFile(filepath.Join(c.dir, class, "io.weight"), "default "+value); err != nil {
+			return err
+		}
+	}
+	target := ioCgroupForeground
+	if c.running[ioCgroupForeground] == 0 && c.running[ioCgroupBackground] > 0 {
+		target = ioCgroupBackground
+	}
+	if target == c.current {
+		return nil
+	}
+	if err := writeCgroupFile(filepath.Join(c.dir, target, "cgroup.procs"), strconv.Itoa(c.pid)); err != nil {
+		return err
+	}
+	c.current = target
+	return nil
+}
+
+// enter counts a running build of the class and moves IndexNode into the cgroup of the running builds,
+// the returned function counts the build out.
+func (c *ioCgroups) enter(ctx context.Context, class string) func() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.dir == "" {
+		if err := c.setup(c.params.IndexNodeCfg.IOPriorityCgroup.GetValue()); err != nil {
+			log.Ctx(ctx).Warn("IndexNode failed to set up the io cgroups", zap.Error(err))
+			return func() {}
+		}
+	}
+	c.running[class]++
+	if err := c.applyLocked(); err != nil {
+		log.Ctx(ctx).Warn("IndexNode failed to apply the io cgroups", zap.String("class", class), zap.Error(err))
+	}
+	return func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.running[class]--
+		if err := c.applyLocked(); err != nil {
+			log.Ctx(ctx).Warn("IndexNode failed to apply the io cgroups", zap.String("class", class), zap.Error(err))
+		}
+	}
+}
+
+// applyBuildIOPriority counts the build in the io cgroup of its job class if it spills to the local disk,
+// so the spilling foreground and background builds sharing a disk with the WAL or other components don't starve
+// each other. The returned function counts the build out.
+func (it *indexBuildTask) applyBuildIOPriority(ctx context.Context) func() {
+	cfg := &it.node.params.IndexNodeCfg
+	if !cfg.IOPriorityEnable.GetAsBool() || cfg.IOPriorityCgroup.GetValue() == "" ||
+		it.newIndexParams["index_type"] != indexparamcheck.IndexDISKANN {
+		return func() {}
+	}
+	class := ioCgroupForeground
+	if it.isBackground() {
+		class = ioCgroupBackground
+	}
+	return it.node.ioCgroups.enter(ctx, class)
+}
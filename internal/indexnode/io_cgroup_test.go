@@ -0,0 +1,86 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestBuildIOPriority(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	ctx := context.Background()
+	dir := t.TempDir()
+	node := &IndexNode{params: params, ioCgroups: newIOCgroups(params)}
+	node.ioCgroups.pid = 42
+	newTask := func(jobClass string) *indexBuildTask {
+		return &indexBuildTask{
+			node:           node,
+			req:            &indexpb.CreateJobRequest{JobClass: jobClass},
+			newIndexParams: map[string]string{"index_type": indexparamcheck.IndexDISKANN},
+		}
+	}
+	readFile := func(elem ...string) string {
+		data, err := os.ReadFile(filepath.Join(append([]string{dir}, elem...)...))
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	newTask("").applyBuildIOPriority(ctx)()
+	assert.NoDirExists(t, filepath.Join(dir, ioCgroupForeground))
+
+	params.Save(params.IndexNodeCfg.IOPriorityEnable.Key, "true")
+	defer params.Reset(params.IndexNodeCfg.IOPriorityEnable.Key)
+	params.Save(params.IndexNodeCfg.IOPriorityCgroup.Key, dir)
+	defer params.Reset(params.IndexNodeCfg.IOPriorityCgroup.Key)
+
+	// IndexNode stays in the foreground cgroup while a foreground build spills
+	exitBackground := newTask(backgroundJobClass).applyBuildIOPriority(ctx)
+	assert.Equal(t, "+io", readFile("cgroup.subtree_control"))
+	assert.Equal(t, "default 100", readFile(ioCgroupForeground, "io.weight"))
+	assert.Equal(t, "default 10", readFile(ioCgroupBackground, "io.weight"))
+	assert.Equal(t, "42", readFile(ioCgroupBackground, "cgroup.procs"))
+	assert.Equal(t, ioCgroupBackground, node.ioCgroups.current)
+
+	exitForeground := newTask("").applyBuildIOPriority(ctx)
+	assert.Equal(t, ioCgroupForeground, node.ioCgroups.current)
+	exitBackground()
+	assert.Equal(t, ioCgroupForeground, node.ioCgroups.current)
+	exitForeground()
+	assert.Equal(t, ioCgroupForeground, node.ioCgroups.current)
+
+	// the builds not spilling to the local disk don't count
+	it := newTask(backgroundJobClass)
+	it.newIndexParams["index_type"] = indexparamcheck.IndexHNSW
+	it.applyBuildIOPriority(ctx)()
+	assert.Equal(t, ioCgroupForeground, node.ioCgroups.current)
+
+	params.Save(params.IndexNodeCfg.IOPriorityBackground.Key, "0")
+	defer params.Reset(params.IndexNodeCfg.IOPriorityBackground.Key)
+	newTask(backgroundJobClass).applyBuildIOPriority(ctx)()
+	assert.Equal(t, ioCgroupForeground, node.ioCgroups.current)
+	assert.Equal(t, "default 10", readFile(ioCgroupBackground, "io.weight"))
+}
@@ -0,0 +1,218 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+const (
+	ioLaneMeta    = "meta"
+	ioLaneStorage = "storage"
+)
+
+// ioLane bounds the concurrent requests of one kind of I/O of the node. The metadata requests to etcd and
+// the bulk object storage requests run in their own lanes, so a saturated storage lane queues the storage
+// requests only and never delays the small frequent metadata requests the liveness of the node depends on.
+type ioLane struct {
+	name string
+	// slots is nil if the lane is unbounded.
+	slots chan struct{}
+}
+
+func newIOLane(name string, size int) *ioLane {
+	lane := &ioLane{name: name}
+	if size > 0 {
+		lane.slots = make(chan struct{}, size)
+	}
+	return lane
+}
+
+// acquire waits for a slot of the lane, the returned func releases it.
+func (l *ioLane) acquire(ctx context.Context) (func(), error) {
+	if l == nil || l.slots == nil {
+		return func() {}, nil
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return l.release, nil
+	default:
+	}
+	start := time.Now()
+	defer func() {
+		observeLatency(ctx, metrics.IndexNodeIOLaneWaitLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), l.name),
+			time.Since(start))
+	}()
+	select {
+	case l.slots <- struct{}{}:
+		return l.release, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (l *ioLane) release() {
+	<-l.slots
+}
+
+// run runs fn in a slot of the lane.
+func (l *ioLane) run(ctx context.Context, fn func() error) error {
+	release, err := l.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return fn()
+}
+
+// ioLanes are the I/O lanes of the node, of indexNode.io.metaConcurrency and indexNode.io.storageConcurrency
+// slots, 0 means unbounded.
+type ioLanes struct {
+	meta    *ioLane
+	storage *ioLane
+}
+
+func newIOLanes(params *paramtable.ComponentParam) *ioLanes {
+	return &ioLanes{
+		meta:    newIOLane(ioLaneMeta, params.IndexNodeCfg.IOMetaConcurrency.GetAsInt()),
+		storage: newIOLane(ioLaneStorage, params.IndexNodeCfg.IOStorageConcurrency.GetAsInt()),
+	}
+}
+
+// metaLane returns the lane of the etcd requests, nil if the lanes are not set.
+func (l *ioLanes) metaLane() *ioLane {
+	if l == nil {
+		return nil
+	}
+	return l.meta
+}
+
+// storageLane returns the lane of the object storage requests, nil if the lanes are not set.
+func (l *ioLanes) storageLane() *ioLane {
+	if l == nil {
+		return nil
+	}
+	return l.storage
+}
+
+// laneChunkManager runs the requests of the chunk manager in the storage lane.
+type laneChunkManager struct {
+	storage.ChunkManager
+	lane *ioLane
+}
+
+var _ storage.ChunkManager = (*laneChunkManager)(nil)
+
+// newLaneChunkManager returns cm itself if the lane is unbounded.
+func newLaneChunkManager(cm storage.ChunkManager, lane *ioLane) storage.ChunkManager {
+	if lane == nil || lane.slots == nil {
+		return cm
+	}
+	return &laneChunkManager{ChunkManager: cm, lane: lane}
+}
+
+func (lcm *laneChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	return lcm.lane.run(ctx, func() error {
+		return lcm.ChunkManager.Write(ctx, filePath, content)
+	})
+}
+
+func (lcm *laneChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
+	return lcm.lane.run(ctx, func() error {
+		return lcm.ChunkManager.MultiWrite(ctx, contents)
+	})
+}
+
+func (lcm *laneChunkManager) Exist(ctx context.Context, filePath string) (exist bool, err error) {
+	err = lcm.lane.run(ctx, func() error {
+		exist, err = lcm.ChunkManager.Exist(ctx, filePath)
+		return err
+	})
+	return exist, err
+}
+
+func (lcm *laneChunkManager) Read(ctx context.Context, filePath string) (data []byte, err error) {
+	err = lcm.lane.run(ctx, func() error {
+		data, err = lcm.ChunkManager.Read(ctx, filePath)
+		return err
+	})
+	return data, err
+}
+
+func (lcm *laneChunkManager) MultiRead(ctx context.Context, filePaths []string) (data [][]byte, err error) {
+	err = lcm.lane.run(ctx, func() error {
+		data, err = lcm.ChunkManager.MultiRead(ctx, filePaths)
+		return err
+	})
+	return data, err
+}
+
+func (lcm *laneChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) (data []byte, err error) {
+	err = lcm.lane.run(ctx, func() error {
+		data, err = lcm.ChunkManager.ReadAt(ctx, filePath, off, length)
+		return err
+	})
+	return data, err
+}
+
+func (lcm *laneChunkManager) Size(ctx context.Context, filePath string) (size int64, err error) {
+	err = lcm.lane.run(ctx, func() error {
+		size, err = lcm.ChunkManager.Size(ctx, filePath)
+		return err
+	})
+	return size, err
+}
+
+func (lcm *laneChunkManager) ListWithPrefix(ctx context.Context, prefix string, recursive bool) (paths []string, modTimes []time.Time, err error) {
+	err = lcm.lane.run(ctx, func() error {
+		paths, modTimes, err = lcm.ChunkManager.ListWithPrefix(ctx, prefix, recursive)
+		return err
+	})
+	return paths, modTimes, err
+}
+
+func (lcm *laneChunkManager) ReadWithPrefix(ctx context.Context, prefix string) (paths []string, data [][]byte, err error) {
+	err = lcm.lane.run(ctx, func() error {
+		paths, data, err = lcm.ChunkManager.ReadWithPrefix(ctx, prefix)
+		return err
+	})
+	return paths, data, err
+}
+
+func (lcm *laneChunkManager) Remove(ctx context.Context, filePath string) error {
+	return lcm.lane.run(ctx, func() error {
+		return lcm.ChunkManager.Remove(ctx, filePath)
+	})
+}
+
+func (lcm *laneChunkManager) MultiRemove(ctx context.Context, filePaths []string) error {
+	return lcm.lane.run(ctx, func() error {
+		return lcm.ChunkManager.MultiRemove(ctx, filePaths)
+	})
+}
+
+func (lcm *laneChunkManager) RemoveWithPrefix(ctx context.Context, prefix string) error {
+	return lcm.lane.run(ctx, func() error {
+		return lcm.ChunkManager.RemoveWithPrefix(ctx, prefix)
+	})
+}
@@ -0,0 +1,125 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestIOLane(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unbounded", func(t *testing.T) {
+		lane := newIOLane(ioLaneStorage, 0)
+		for i := 0; i < 10; i++ {
+			_, err := lane.acquire(ctx)
+			assert.NoError(t, err)
+		}
+		var nilLane *ioLane
+		assert.NoError(t, nilLane.run(ctx, func() error { return nil }))
+	})
+
+	t.Run("bounded", func(t *testing.T) {
+		lane := newIOLane(ioLaneStorage, 1)
+		release, err := lane.acquire(ctx)
+		require.NoError(t, err)
+
+		waitCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		_, err = lane.acquire(waitCtx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		acquired := make(chan struct{})
+		go func() {
+			_ = lane.run(ctx, func() error { return nil })
+			close(acquired)
+		}()
+		select {
+		case <-acquired:
+			t.Fatal("acquired a slot of a full lane")
+		case <-time.After(50 * time.Millisecond):
+		}
+		release()
+		<-acquired
+	})
+
+	t.Run("separate lanes", func(t *testing.T) {
+		params := paramtable.Get().Namespace()
+		params.Save(params.IndexNodeCfg.IOMetaConcurrency.Key, "2")
+		params.Save(params.IndexNodeCfg.IOStorageConcurrency.Key, "1")
+		lanes := newIOLanes(params)
+		release, err := lanes.storageLane().acquire(ctx)
+		require.NoError(t, err)
+		defer release()
+
+		metaCtx, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+		assert.NoError(t, lanes.metaLane().run(metaCtx, func() error { return nil }))
+	})
+
+	t.Run("nil lanes", func(t *testing.T) {
+		var lanes *ioLanes
+		assert.Nil(t, lanes.metaLane())
+		assert.Nil(t, lanes.storageLane())
+	})
+}
+
+func TestLaneChunkManager(t *testing.T) {
+	ctx := context.Background()
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	assert.Equal(t, cm, newLaneChunkManager(cm, nil))
+	assert.Equal(t, cm, newLaneChunkManager(cm, newIOLane(ioLaneStorage, 0)))
+
+	lane := newIOLane(ioLaneStorage, 1)
+	lcm := newLaneChunkManager(cm, lane)
+	require.NoError(t, lcm.Write(ctx, "a/1", []byte("one")))
+	require.NoError(t, lcm.MultiWrite(ctx, map[string][]byte{"a/2": []byte("two")}))
+	exist, err := lcm.Exist(ctx, "a/1")
+	assert.NoError(t, err)
+	assert.True(t, exist)
+	data, err := lcm.Read(ctx, "a/1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("one"), data)
+	size, err := lcm.Size(ctx, "a/2")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, size)
+	paths, _, err := lcm.ListWithPrefix(ctx, "a/", true)
+	assert.NoError(t, err)
+	assert.Len(t, paths, 2)
+
+	// the requests wait while the lane is full.
+	release, err := lane.acquire(ctx)
+	require.NoError(t, err)
+	waitCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = lcm.Read(waitCtx, "a/1")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	release()
+
+	assert.NoError(t, lcm.RemoveWithPrefix(ctx, "a/"))
+	exist, err = lcm.Exist(ctx, "a/1")
+	assert.NoError(t, err)
+	assert.False(t, exist)
+}
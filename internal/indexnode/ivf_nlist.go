@@ -0,0 +1,66 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"math"
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+)
+
+// nlistRowsFactor is the factor of the square root of the row count to recommend nlist.
+const nlistRowsFactor = 4
+
+var ivfIndexTypes = map[string]struct{}{
+	indexparamcheck.IndexFaissIvfFlat:    {},
+	indexparamcheck.IndexFaissIvfPQ:      {},
+	indexparamcheck.IndexFaissIvfSQ8:     {},
+	indexparamcheck.IndexFaissIvfSQ8H:    {},
+	indexparamcheck.IndexFaissBinIvfFlat: {},
+}
+
+// recommendNList returns 4*sqrt(numRows) clipped to the valid nlist range, and never more than numRows.
+func recommendNList(numRows int64) int64 {
+	nlist := int64(nlistRowsFactor * math.Sqrt(float64(numRows)))
+	if nlist > numRows {
+		nlist = numRows
+	}
+	if nlist > indexparamcheck.MaxNList {
+		nlist = indexparamcheck.MaxNList
+	}
+	if nlist < indexparamcheck.MinNList {
+		nlist = indexparamcheck.MinNList
+	}
+	return nlist
+}
+
+// fillNList sets the recommended nlist of numRows rows to the IVF index params which omit nlist or set it to 0,
+// the chosen value is recorded in the index params of the build stats. It does nothing if numRows is unknown.
+func (it *indexBuildTask) fillNList(ctx context.Context, numRows int64) {
+	if _, ok := ivfIndexTypes[it.newIndexParams["index_type"]]; !ok || numRows <= 0 {
+		return
+	}
+	if nlist, err := strconv.ParseInt(it.newIndexParams[indexparamcheck.NLIST], 10, 64); err == nil && nlist != 0 {
+		return
+	}
+	nlist := strconv.FormatInt(recommendNList(numRows), 10)
+	it.newIndexParams[indexparamcheck.NLIST] = nlist
+	it.setStatisticIndexParam(indexparamcheck.NLIST, nlist)
+	it.warn(ctx, "nlist is not set, %s is chosen for %d rows", nlist, numRows)
+}
@@ -0,0 +1,92 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestRecommendNList(t *testing.T) {
+	assert.Equal(t, int64(1), recommendNList(1))
+	assert.Equal(t, int64(10), recommendNList(10))
+	assert.Equal(t, int64(4000), recommendNList(1000000))
+	assert.Equal(t, int64(indexparamcheck.MaxNList), recommendNList(1<<40))
+}
+
+func TestFillNList(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	ctx := context.Background()
+	newTask := func(indexParams map[string]string) *indexBuildTask {
+		node := &IndexNode{params: params}
+		node.loadOrStoreTask("cluster", 1, &taskInfo{phase: taskPending})
+		it := &indexBuildTask{
+			ClusterID: "cluster",
+			BuildID:   1,
+			node:      node,
+			req:       &indexpb.CreateJobRequest{NumRows: 10000},
+		}
+		for key, value := range indexParams {
+			it.req.IndexParams = append(it.req.IndexParams, &commonpb.KeyValuePair{Key: key, Value: value})
+		}
+		assert.NoError(t, it.Prepare(ctx))
+		return it
+	}
+
+	t.Run("omitted", func(t *testing.T) {
+		it := newTask(map[string]string{"index_type": indexparamcheck.IndexFaissIvfFlat})
+		assert.Equal(t, "400", it.newIndexParams[indexparamcheck.NLIST])
+		assert.Contains(t, it.statistic.IndexParams, &commonpb.KeyValuePair{Key: indexparamcheck.NLIST, Value: "400"})
+		assert.Equal(t, []string{"nlist is not set, 400 is chosen for 10000 rows"}, it.node.tasks.info(taskKey{ClusterID: "cluster", BuildID: 1}).warnings)
+	})
+
+	t.Run("zero", func(t *testing.T) {
+		it := newTask(map[string]string{"index_type": indexparamcheck.IndexFaissIvfPQ, "nlist": "0"})
+		assert.Equal(t, "400", it.newIndexParams[indexparamcheck.NLIST])
+		assert.Len(t, it.statistic.IndexParams, 2)
+	})
+
+	t.Run("specified", func(t *testing.T) {
+		it := newTask(map[string]string{"index_type": indexparamcheck.IndexFaissIvfSQ8, "nlist": "128"})
+		assert.Equal(t, "128", it.newIndexParams[indexparamcheck.NLIST])
+		assert.Empty(t, it.node.tasks.info(taskKey{ClusterID: "cluster", BuildID: 1}).warnings)
+	})
+
+	t.Run("not ivf", func(t *testing.T) {
+		it := newTask(map[string]string{"index_type": indexparamcheck.IndexHNSW})
+		_, ok := it.newIndexParams[indexparamcheck.NLIST]
+		assert.False(t, ok)
+	})
+
+	t.Run("row count unknown", func(t *testing.T) {
+		it := newTask(map[string]string{"index_type": indexparamcheck.IndexFaissIvfFlat})
+		it.req.NumRows = 0
+		delete(it.newIndexParams, indexparamcheck.NLIST)
+		it.fillNList(ctx, it.req.GetNumRows())
+		_, ok := it.newIndexParams[indexparamcheck.NLIST]
+		assert.False(t, ok)
+		it.fillNList(ctx, 100)
+		assert.Equal(t, "40", it.newIndexParams[indexparamcheck.NLIST])
+	})
+}
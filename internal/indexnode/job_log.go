@@ -0,0 +1,224 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// jobLog is the log entries of a task kept in memory.
+type jobLog struct {
+	entries []*indexpb.JobLogEntry
+	// dropped is the number of the oldest entries dropped beyond indexNode.jobLog.maxEntries.
+	dropped int
+	done    bool
+	// updated is closed and replaced whenever an entry is appended or the task is done.
+	updated chan struct{}
+}
+
+func (l *jobLog) notify() {
+	close(l.updated)
+	l.updated = make(chan struct{})
+}
+
+// jobLogHub keeps the log entries of the tasks, so they can be streamed by WatchJobLog without access to
+// the log files of the node. The entries of a task are kept until the task is dropped.
+type jobLogHub struct {
+	params *paramtable.ComponentParam
+
+	mu   sync.Mutex
+	logs map[taskKey]*jobLog
+}
+
+func newJobLogHub(params *paramtable.ComponentParam) *jobLogHub {
+	return &jobLogHub{
+		params: params,
+		logs:   make(map[taskKey]*jobLog),
+	}
+}
+
+// capture returns a context whose logs are kept as the entries of the task if indexNode.jobLog.enable is set.
+func (h *jobLogHub) capture(ctx context.Context, key taskKey) context.Context {
+	if h == nil || !h.params.IndexNodeCfg.JobLogEnable.GetAsBool() {
+		return ctx
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.logs[key]; !ok {
+		h.logs[key] = &jobLog{updated: make(chan struct{})}
+	}
+	// the entries follow the log level of the node.
+	return log.WithCore(ctx, &jobLogCore{
+		LevelEnabler: zap.LevelEnablerFunc(func(level zapcore.Level) bool { return level >= log.GetLevel() }),
+		hub:          h,
+		key:          key,
+	})
+}
+
+func (h *jobLogHub) append(key taskKey, entry *indexpb.JobLogEntry) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	l, ok := h.logs[key]
+	if !ok {
+		return
+	}
+	l.entries = append(l.entries, entry)
+	if maxEntries := h.params.IndexNodeCfg.JobLogMaxEntries.GetAsInt(); maxEntries > 0 && len(l.entries) > maxEntries {
+		overflow := len(l.entries) - maxEntries
+		l.entries = append([]*indexpb.JobLogEntry(nil), l.entries[overflow:]...)
+		l.dropped += overflow
+	}
+	l.notify()
+}
+
+// onPhase ends the streams of the task once it's done.
+func (h *jobLogHub) onPhase(key taskKey, from, to taskPhase, failReason string) {
+	if !to.isTerminal() {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if l, ok := h.logs[key]; ok && !l.done {
+		l.done = true
+		l.notify()
+	}
+}
+
+// remove drops the entries of the tasks, the streams of them end.
+func (h *jobLogHub) remove(keys ...taskKey) {
+	if h == nil {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, key := range keys {
+		if l, ok := h.logs[key]; ok {
+			delete(h.logs, key)
+			l.done = true
+			l.notify()
+		}
+	}
+}
+
+// read returns the entries of the task from the offset on, the offset after them, the channel closed
+// on the next update and whether the task is done. It returns false if the entries of the task are not kept.
+func (h *jobLogHub) read(key taskKey, offset int) ([]*indexpb.JobLogEntry, int, <-chan struct{}, bool, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	l, ok := h.logs[key]
+	if !ok {
+		return nil, offset, nil, true, false
+	}
+	if offset < l.dropped {
+		offset = l.dropped
+	}
+	entries := append([]*indexpb.JobLogEntry(nil), l.entries[offset-l.dropped:]...)
+	return entries, l.dropped + len(l.entries), l.updated, l.done, true
+}
+
+// watch sends the entries of the task logged so far, then the new ones until the task is done or dropped,
+// or ctx is done.
+func (h *jobLogHub) watch(ctx context.Context, key taskKey, send func(*indexpb.JobLogEntry) error) error {
+	if h == nil {
+		return fmt.Errorf("no log of index build task %s/%d is kept", key.ClusterID, key.BuildID)
+	}
+	offset := 0
+	for {
+		entries, next, updated, done, ok := h.read(key, offset)
+		if !ok && offset == 0 {
+			return fmt.Errorf("no log of index build task %s/%d is kept", key.ClusterID, key.BuildID)
+		}
+		for _, entry := range entries {
+			if err := send(entry); err != nil {
+				return err
+			}
+		}
+		if done {
+			return nil
+		}
+		offset = next
+		select {
+		case <-updated:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// jobLogCore is the zap core appending the logs of a task to its entries.
+type jobLogCore struct {
+	zapcore.LevelEnabler
+	hub    *jobLogHub
+	key    taskKey
+	fields []zapcore.Field
+}
+
+func (c *jobLogCore) With(fields []zapcore.Field) zapcore.Core {
+	clone := *c
+	clone.fields = make([]zapcore.Field, 0, len(c.fields)+len(fields))
+	clone.fields = append(append(clone.fields, c.fields...), fields...)
+	return &clone
+}
+
+func (c *jobLogCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c *jobLogCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	encoder := zapcore.NewMapObjectEncoder()
+	for _, field := range c.fields {
+		field.AddTo(encoder)
+	}
+	for _, field := range fields {
+		field.AddTo(encoder)
+	}
+	keys := make([]string, 0, len(encoder.Fields))
+	for key := range encoder.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := make([]*commonpb.KeyValuePair, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, &commonpb.KeyValuePair{Key: key, Value: fmt.Sprint(encoder.Fields[key])})
+	}
+	c.hub.append(c.key, &indexpb.JobLogEntry{
+		Time:    entry.Time.UnixNano() / int64(time.Millisecond),
+		Level:   entry.Level.String(),
+		Message: entry.Message,
+		Fields:  pairs,
+	})
+	return nil
+}
+
+func (c *jobLogCore) Sync() error {
+	return nil
+}
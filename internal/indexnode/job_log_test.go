@@ -0,0 +1,98 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestJobLogHub(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	key := taskKey{ClusterID: "cluster", BuildID: 1}
+	hub := newJobLogHub(params)
+	ctx := context.Background()
+	assert.Equal(t, ctx, hub.capture(ctx, key))
+	assert.Error(t, hub.watch(ctx, key, func(*indexpb.JobLogEntry) error { return nil }))
+
+	params.Save(params.IndexNodeCfg.JobLogEnable.Key, "true")
+	params.Save(params.IndexNodeCfg.JobLogMaxEntries.Key, "2")
+	defer log.SetLevel(log.GetLevel())
+	log.SetLevel(zapcore.InfoLevel)
+	taskCtx := hub.capture(ctx, key)
+	log.Ctx(taskCtx).Info("dropped")
+	log.Ctx(taskCtx).Info("load data", zap.Int64("buildID", 1))
+	log.Ctx(taskCtx).Debug("debug is below the log level")
+	log.Ctx(taskCtx).With(zap.String("phase", "build")).Warn("build degraded", zap.Int("threads", 4))
+
+	received := make(chan *indexpb.JobLogEntry, 10)
+	watched := make(chan error, 1)
+	go func() {
+		watched <- hub.watch(ctx, key, func(entry *indexpb.JobLogEntry) error {
+			received <- entry
+			return nil
+		})
+	}()
+	entry := <-received
+	assert.Equal(t, "load data", entry.GetMessage())
+	assert.Equal(t, "info", entry.GetLevel())
+	assert.Equal(t, "buildID", entry.GetFields()[0].GetKey())
+	assert.Equal(t, "1", entry.GetFields()[0].GetValue())
+	entry = <-received
+	assert.Equal(t, "build degraded", entry.GetMessage())
+	assert.Equal(t, "warn", entry.GetLevel())
+	assert.Equal(t, "phase", entry.GetFields()[0].GetKey())
+	assert.Equal(t, "threads", entry.GetFields()[1].GetKey())
+
+	log.Ctx(taskCtx).Info("save index files")
+	entry = <-received
+	assert.Equal(t, "save index files", entry.GetMessage())
+	hub.onPhase(key, taskSaving, taskFinished, "")
+	select {
+	case err := <-watched:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("the watch doesn't end with the task")
+	}
+
+	// the entries are kept until the task is dropped
+	count := 0
+	require.NoError(t, hub.watch(ctx, key, func(*indexpb.JobLogEntry) error {
+		count++
+		return nil
+	}))
+	assert.Equal(t, 2, count)
+	hub.remove(key)
+	assert.Error(t, hub.watch(ctx, key, func(*indexpb.JobLogEntry) error { return nil }))
+
+	// a running task is watched until the context is done
+	other := taskKey{ClusterID: "cluster", BuildID: 2}
+	hub.capture(ctx, other)
+	cancelCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, hub.watch(cancelCtx, other, func(*indexpb.JobLogEntry) error { return nil }), context.DeadlineExceeded)
+}
@@ -0,0 +1,134 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/retry"
+)
+
+// jobResultReporter pushes the results of finished jobs to DataCoord.
+// Results are deduplicated by task key, only the latest result of a task is sent. Results which can not be
+// delivered after retries are dropped, DataCoord falls back to polling QueryJobs for them.
+type jobResultReporter struct {
+	params *paramtable.ComponentParam
+
+	dataCoord types.DataCoord
+
+	mu      sync.Mutex
+	pending map[taskKey]*indexpb.IndexTaskInfo
+
+	notifyChan chan struct{}
+	wg         sync.WaitGroup
+}
+
+func newJobResultReporter(params *paramtable.ComponentParam) *jobResultReporter {
+	return &jobResultReporter{
+		params:     params,
+		pending:    make(map[taskKey]*indexpb.IndexTaskInfo),
+		notifyChan: make(chan struct{}, 1),
+	}
+}
+
+func (r *jobResultReporter) enabled() bool {
+	return r.dataCoord != nil && r.params.IndexNodeCfg.ResultCallbackEnable.GetAsBool()
+}
+
+// Start starts the reporting loop, it exits when ctx is done.
+func (r *jobResultReporter) Start(ctx context.Context) {
+	r.wg.Add(1)
+	go r.loop(ctx)
+}
+
+// Close waits for the reporting loop to exit.
+func (r *jobResultReporter) Close() {
+	r.wg.Wait()
+}
+
+// report queues the result of a finished task, it never blocks.
+func (r *jobResultReporter) report(ClusterID string, info *indexpb.IndexTaskInfo) {
+	if !r.enabled() {
+		return
+	}
+	r.mu.Lock()
+	r.pending[taskKey{ClusterID: ClusterID, BuildID: info.GetBuildID()}] = info
+	r.mu.Unlock()
+
+	select {
+	case r.notifyChan <- struct{}{}:
+	default:
+	}
+}
+
+func (r *jobResultReporter) loop(ctx context.Context) {
+	defer r.wg.Done()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-r.notifyChan:
+			r.flush(ctx)
+		}
+	}
+}
+
+// flush sends all pending results grouped by cluster.
+func (r *jobResultReporter) flush(ctx context.Context) {
+	r.mu.Lock()
+	batches := make(map[string][]*indexpb.IndexTaskInfo)
+	for key, info := range r.pending {
+		batches[key.ClusterID] = append(batches[key.ClusterID], info)
+	}
+	r.pending = make(map[taskKey]*indexpb.IndexTaskInfo)
+	r.mu.Unlock()
+
+	for ClusterID, infos := range batches {
+		req := &indexpb.ReportJobResultsRequest{
+			ClusterID:  ClusterID,
+			NodeID:     paramtable.GetNodeID(),
+			IndexInfos: infos,
+		}
+		err := retry.Do(ctx, func() error {
+			status, err := r.dataCoord.ReportJobResults(ctx, req)
+			if err != nil {
+				return err
+			}
+			if status.GetErrorCode() != commonpb.ErrorCode_Success {
+				return errors.New(status.GetReason())
+			}
+			return nil
+		}, retry.Attempts(uint(r.params.IndexNodeCfg.ResultCallbackRetryTimes.GetAsInt())), retry.Sleep(100*time.Millisecond))
+		if err != nil {
+			log.Ctx(ctx).Warn("IndexNode report job results failed, leave them to polling", zap.String("ClusterID", ClusterID),
+				zap.Int("jobNum", len(infos)), zap.Error(err))
+			continue
+		}
+		log.Ctx(ctx).Debug("IndexNode report job results success", zap.String("ClusterID", ClusterID),
+			zap.Int("jobNum", len(infos)))
+	}
+}
@@ -0,0 +1,102 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+type reportDataCoord struct {
+	types.DataCoord
+
+	mu       sync.Mutex
+	failures int
+	reported []*indexpb.ReportJobResultsRequest
+}
+
+func (c *reportDataCoord) ReportJobResults(ctx context.Context, req *indexpb.ReportJobResultsRequest) (*commonpb.Status, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.failures > 0 {
+		c.failures--
+		return nil, errors.New("mock error")
+	}
+	c.reported = append(c.reported, req)
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (c *reportDataCoord) reportedNum() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.reported)
+}
+
+func TestJobResultReporter(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	params.Save(params.IndexNodeCfg.ResultCallbackEnable.Key, "true")
+
+	t.Run("disabled without datacoord", func(t *testing.T) {
+		r := newJobResultReporter(params)
+		r.report("cluster", &indexpb.IndexTaskInfo{BuildID: 1, State: commonpb.IndexState_Finished})
+		assert.Equal(t, 0, len(r.pending))
+	})
+
+	t.Run("dedup", func(t *testing.T) {
+		r := newJobResultReporter(params)
+		r.dataCoord = &reportDataCoord{}
+		r.report("cluster", &indexpb.IndexTaskInfo{BuildID: 1, State: commonpb.IndexState_Failed})
+		r.report("cluster", &indexpb.IndexTaskInfo{BuildID: 1, State: commonpb.IndexState_Finished})
+		r.report("cluster", &indexpb.IndexTaskInfo{BuildID: 2, State: commonpb.IndexState_Finished})
+		assert.Equal(t, 2, len(r.pending))
+		assert.Equal(t, commonpb.IndexState_Finished, r.pending[taskKey{ClusterID: "cluster", BuildID: 1}].GetState())
+	})
+
+	t.Run("report with retry", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		dc := &reportDataCoord{failures: 2}
+		r := newJobResultReporter(params)
+		r.dataCoord = dc
+		r.Start(ctx)
+		r.report("cluster1", &indexpb.IndexTaskInfo{BuildID: 1, State: commonpb.IndexState_Finished})
+		assert.Eventually(t, func() bool {
+			return dc.reportedNum() == 1
+		}, 5*time.Second, 10*time.Millisecond)
+		cancel()
+		r.Close()
+		assert.Equal(t, "cluster1", dc.reported[0].GetClusterID())
+		assert.Equal(t, int64(1), dc.reported[0].GetIndexInfos()[0].GetBuildID())
+	})
+
+	t.Run("set datacoord", func(t *testing.T) {
+		node := &IndexNode{params: params, reporter: newJobResultReporter(params)}
+		assert.Error(t, node.SetDataCoord(nil))
+		assert.NoError(t, node.SetDataCoord(&reportDataCoord{}))
+		assert.Error(t, node.SetDataCoord(&reportDataCoord{}))
+	})
+}
@@ -0,0 +1,93 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// knowhereLogLevels are the easylogging levels from the most verbose one,
+// a level threshold enables the levels from it on.
+var knowhereLogLevels = []string{"TRACE", "DEBUG", "INFO", "WARNING", "ERROR", "FATAL"}
+
+// knowhereLogThreshold returns the index of the level in knowhereLogLevels, the zap level names are accepted.
+func knowhereLogThreshold(level string) (int, error) {
+	switch strings.ToLower(level) {
+	case "warn":
+		return 3, nil
+	case "panic":
+		return 5, nil
+	}
+	for i, name := range knowhereLogLevels {
+		if strings.EqualFold(level, name) {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid knowhere log level %s", level)
+}
+
+// knowhereLogConf returns the easylogging configuration of the level, the logs are written to the file
+// rotated at maxSize bytes if the file is set, otherwise to the standard output.
+func knowhereLogConf(level string, file string, maxSize int64) (string, error) {
+	threshold, err := knowhereLogThreshold(level)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	b.WriteString("* GLOBAL:\n")
+	b.WriteString("    FORMAT = \"%datetime | %level | %logger | %msg\"\n")
+	if file != "" {
+		fmt.Fprintf(&b, "    FILENAME = \"%s\"\n", file)
+		b.WriteString("    TO_FILE = true\n")
+		b.WriteString("    TO_STANDARD_OUTPUT = false\n")
+		fmt.Fprintf(&b, "    MAX_LOG_FILE_SIZE = %d\n", maxSize)
+	} else {
+		b.WriteString("    TO_FILE = false\n")
+		b.WriteString("    TO_STANDARD_OUTPUT = true\n")
+	}
+	b.WriteString("    SUBSECOND_PRECISION = 3\n")
+	b.WriteString("    PERFORMANCE_TRACKING = false\n")
+	for i, name := range knowhereLogLevels {
+		fmt.Fprintf(&b, "* %s:\n    ENABLED = %t\n", name, i >= threshold)
+	}
+	// the verbose logs go with the debug logs.
+	fmt.Fprintf(&b, "* VERBOSE:\n    ENABLED = %t\n", threshold <= 1)
+	return b.String(), nil
+}
+
+// knowhereLogConfs returns the easylogging configuration of all the loggers set by indexNode.knowhereLog.level,
+// and the configurations of the loggers set by indexNode.knowhereLog.moduleLevels by their ids.
+func knowhereLogConfs(params *paramtable.ComponentParam) (string, map[string]string, error) {
+	file := params.IndexNodeCfg.KnowhereLogFile.GetValue()
+	maxSize := params.IndexNodeCfg.KnowhereLogMaxSize.GetAsInt64() * 1024 * 1024
+	global, err := knowhereLogConf(params.IndexNodeCfg.KnowhereLogLevel.GetValue(), file, maxSize)
+	if err != nil {
+		return "", nil, err
+	}
+	modules := make(map[string]string)
+	for module, level := range params.IndexNodeCfg.KnowhereLogModuleLevels.GetAsJSONMap() {
+		conf, err := knowhereLogConf(level, file, maxSize)
+		if err != nil {
+			return "", nil, fmt.Errorf("logger %s: %w", module, err)
+		}
+		modules[module] = conf
+	}
+	return global, modules, nil
+}
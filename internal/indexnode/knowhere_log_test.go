@@ -0,0 +1,74 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestKnowhereLogThreshold(t *testing.T) {
+	for level, threshold := range map[string]int{"trace": 0, "debug": 1, "INFO": 2, "warn": 3, "WARNING": 3, "error": 4, "panic": 5, "fatal": 5} {
+		actual, err := knowhereLogThreshold(level)
+		assert.NoError(t, err)
+		assert.Equal(t, threshold, actual, level)
+	}
+	_, err := knowhereLogThreshold("verbose")
+	assert.Error(t, err)
+}
+
+func TestKnowhereLogConf(t *testing.T) {
+	conf, err := knowhereLogConf("warn", "", 0)
+	assert.NoError(t, err)
+	assert.Contains(t, conf, "TO_STANDARD_OUTPUT = true\n")
+	assert.Contains(t, conf, "* INFO:\n    ENABLED = false\n")
+	assert.Contains(t, conf, "* WARNING:\n    ENABLED = true\n")
+	assert.Contains(t, conf, "* VERBOSE:\n    ENABLED = false\n")
+	assert.NotContains(t, conf, "FILENAME")
+
+	conf, err = knowhereLogConf("debug", "/var/lib/milvus/logs/knowhere.log", 1024)
+	assert.NoError(t, err)
+	assert.Contains(t, conf, "FILENAME = \"/var/lib/milvus/logs/knowhere.log\"\n")
+	assert.Contains(t, conf, "TO_FILE = true\n")
+	assert.Contains(t, conf, "MAX_LOG_FILE_SIZE = 1024\n")
+	assert.Contains(t, conf, "* TRACE:\n    ENABLED = false\n")
+	assert.Contains(t, conf, "* DEBUG:\n    ENABLED = true\n")
+	assert.Contains(t, conf, "* VERBOSE:\n    ENABLED = true\n")
+
+	_, err = knowhereLogConf("verbose", "", 0)
+	assert.Error(t, err)
+}
+
+func TestKnowhereLogConfs(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	global, modules, err := knowhereLogConfs(params)
+	assert.NoError(t, err)
+	assert.Contains(t, global, "* INFO:\n    ENABLED = true\n")
+	assert.Empty(t, modules)
+
+	params.Save(params.IndexNodeCfg.KnowhereLogModuleLevels.Key, `{"knowhere": "error"}`)
+	_, modules, err = knowhereLogConfs(params)
+	assert.NoError(t, err)
+	assert.Contains(t, modules["knowhere"], "* WARNING:\n    ENABLED = false\n")
+
+	params.Save(params.IndexNodeCfg.KnowhereLogModuleLevels.Key, `{"knowhere": "loud"}`)
+	_, _, err = knowhereLogConfs(params)
+	assert.Error(t, err)
+}
@@ -0,0 +1,97 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// logLevelOverride changes the log level of the node for a bounded duration, so a stuck build can be debugged
+// without restarting the node, and the verbose logging can't be left on by mistake.
+type logLevelOverride struct {
+	params *paramtable.ComponentParam
+
+	mu sync.Mutex
+	// base is the level to restore, it's the level before the first of the overlapping overrides.
+	base  zapcore.Level
+	timer *time.Timer
+}
+
+func newLogLevelOverride(params *paramtable.ComponentParam) *logLevelOverride {
+	return &logLevelOverride{params: params}
+}
+
+// set changes the log level to level for duration, at most indexNode.logLevel.maxDuration, 0 means the max.
+// A new override replaces the running one and restarts the duration.
+func (o *logLevelOverride) set(level string, duration time.Duration) (time.Duration, error) {
+	var l zapcore.Level
+	if err := l.UnmarshalText([]byte(level)); err != nil {
+		return 0, fmt.Errorf("invalid log level %q: %w", level, err)
+	}
+	if l > zapcore.ErrorLevel {
+		return 0, fmt.Errorf("invalid log level %q: the level can't be above error", level)
+	}
+	maxDuration := o.params.IndexNodeCfg.LogLevelMaxDuration.GetAsDuration(time.Second)
+	if duration <= 0 || duration > maxDuration {
+		duration = maxDuration
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.timer == nil {
+		o.base = log.GetLevel()
+	} else {
+		o.timer.Stop()
+	}
+	log.SetLevel(l)
+	var timer *time.Timer
+	timer = time.AfterFunc(duration, func() {
+		o.mu.Lock()
+		defer o.mu.Unlock()
+		if o.timer == timer {
+			o.restoreLocked()
+		}
+	})
+	o.timer = timer
+	log.Info("IndexNode log level changed", zap.String("level", l.String()), zap.Duration("duration", duration),
+		zap.String("restore", o.base.String()))
+	return duration, nil
+}
+
+// reset restores the log level at once if it's overridden.
+func (o *logLevelOverride) reset() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.timer != nil {
+		o.timer.Stop()
+		o.restoreLocked()
+	}
+}
+
+func (o *logLevelOverride) restoreLocked() {
+	log.SetLevel(o.base)
+	o.timer = nil
+	log.Info("IndexNode log level restored", zap.String("level", o.base.String()))
+}
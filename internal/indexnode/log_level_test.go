@@ -0,0 +1,61 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestLogLevelOverride(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	log.SetLevel(zapcore.InfoLevel)
+	defer log.SetLevel(zapcore.InfoLevel)
+	o := newLogLevelOverride(params)
+
+	_, err := o.set("verbose", time.Second)
+	assert.Error(t, err)
+	_, err = o.set("fatal", time.Second)
+	assert.Error(t, err)
+	assert.Equal(t, zapcore.InfoLevel, log.GetLevel())
+
+	// the duration is bounded by the max duration
+	duration, err := o.set("debug", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, duration)
+	assert.Equal(t, zapcore.DebugLevel, log.GetLevel())
+	o.reset()
+	assert.Equal(t, zapcore.InfoLevel, log.GetLevel())
+
+	// the overlapping overrides restore the level before the first one
+	_, err = o.set("debug", time.Hour)
+	assert.NoError(t, err)
+	_, err = o.set("warn", 50*time.Millisecond)
+	assert.NoError(t, err)
+	assert.Equal(t, zapcore.WarnLevel, log.GetLevel())
+	assert.Eventually(t, func() bool {
+		return log.GetLevel() == zapcore.InfoLevel
+	}, 5*time.Second, 10*time.Millisecond)
+	o.reset()
+	assert.Equal(t, zapcore.InfoLevel, log.GetLevel())
+}
@@ -0,0 +1,108 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// timeWindow is a daily time window, start and end are the offsets from midnight,
+// the window wraps around midnight if end is before start.
+type timeWindow struct {
+	start time.Duration
+	end   time.Duration
+}
+
+func (w timeWindow) String() string {
+	format := func(d time.Duration) string {
+		return fmt.Sprintf("%02d:%02d", int(d.Hours()), int(d.Minutes())%60)
+	}
+	return format(w.start) + "-" + format(w.end)
+}
+
+func (w timeWindow) contains(now time.Time) bool {
+	offset := time.Duration(now.Hour())*time.Hour + time.Duration(now.Minute())*time.Minute +
+		time.Duration(now.Second())*time.Second
+	if w.start < w.end {
+		return offset >= w.start && offset < w.end
+	}
+	return offset >= w.start || offset < w.end
+}
+
+// parseTimeWindows parses the comma separated windows like "08:00-12:00,22:00-02:00".
+func parseTimeWindows(value string) ([]timeWindow, error) {
+	windows := make([]timeWindow, 0)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		bounds := strings.Split(item, "-")
+		if len(bounds) != 2 {
+			return nil, fmt.Errorf("invalid time window %s", item)
+		}
+		var window timeWindow
+		for i, bound := range bounds {
+			t, err := time.Parse("15:04", strings.TrimSpace(bound))
+			if err != nil {
+				return nil, fmt.Errorf("invalid time window %s: %w", item, err)
+			}
+			offset := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+			if i == 0 {
+				window.start = offset
+			} else {
+				window.end = offset
+			}
+		}
+		if window.start == window.end {
+			return nil, fmt.Errorf("invalid time window %s: empty window", item)
+		}
+		windows = append(windows, window)
+	}
+	return windows, nil
+}
+
+// checkMaintenanceWindow returns an error if the job is not accepted at now, which is in a maintenance window
+// set by indexNode.maintenance.windows and the job is not high priority or no job is accepted in the windows.
+// The invalid windows are ignored.
+func checkMaintenanceWindow(params *paramtable.ComponentParam, req *indexpb.CreateJobRequest, now time.Time) error {
+	value := params.IndexNodeCfg.MaintenanceWindows.GetValue()
+	windows, err := parseTimeWindows(value)
+	if err != nil {
+		log.RatedWarn(60, "IndexNode ignore invalid maintenance windows", zap.String("windows", value), zap.Error(err))
+		return nil
+	}
+	for _, window := range windows {
+		if !window.contains(now) {
+			continue
+		}
+		if req.GetPriority() > 0 && params.IndexNodeCfg.MaintenanceAcceptHighPriority.GetAsBool() {
+			return nil
+		}
+		return fmt.Errorf("IndexNode is in the maintenance window %s, job of priority %d rejected",
+			window, req.GetPriority())
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestParseTimeWindows(t *testing.T) {
+	windows, err := parseTimeWindows("")
+	assert.NoError(t, err)
+	assert.Empty(t, windows)
+
+	windows, err = parseTimeWindows("08:00-12:30, 22:00-02:00")
+	assert.NoError(t, err)
+	assert.Equal(t, []timeWindow{
+		{start: 8 * time.Hour, end: 12*time.Hour + 30*time.Minute},
+		{start: 22 * time.Hour, end: 2 * time.Hour},
+	}, windows)
+	assert.Equal(t, "22:00-02:00", windows[1].String())
+
+	at := func(hour, minute int) time.Time {
+		return time.Date(2023, 1, 1, hour, minute, 0, 0, time.Local)
+	}
+	assert.True(t, windows[0].contains(at(8, 0)))
+	assert.True(t, windows[0].contains(at(12, 29)))
+	assert.False(t, windows[0].contains(at(12, 30)))
+	assert.True(t, windows[1].contains(at(23, 0)))
+	assert.True(t, windows[1].contains(at(1, 59)))
+	assert.False(t, windows[1].contains(at(2, 0)))
+
+	for _, value := range []string{"08:00", "08:00-25:00", "08:00-08:00", "a-b"} {
+		_, err = parseTimeWindows(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestCheckMaintenanceWindow(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	inWindow := time.Date(2023, 1, 1, 23, 0, 0, 0, time.Local)
+	outOfWindow := time.Date(2023, 1, 1, 12, 0, 0, 0, time.Local)
+	normal := &indexpb.CreateJobRequest{}
+	high := &indexpb.CreateJobRequest{Priority: 1}
+	assert.NoError(t, checkMaintenanceWindow(params, normal, inWindow))
+
+	params.Save(params.IndexNodeCfg.MaintenanceWindows.Key, "22:00-06:00")
+	assert.Error(t, checkMaintenanceWindow(params, normal, inWindow))
+	assert.NoError(t, checkMaintenanceWindow(params, high, inWindow))
+	assert.NoError(t, checkMaintenanceWindow(params, normal, outOfWindow))
+
+	params.Save(params.IndexNodeCfg.MaintenanceAcceptHighPriority.Key, "false")
+	assert.Error(t, checkMaintenanceWindow(params, high, inWindow))
+
+	// invalid windows are ignored
+	params.Save(params.IndexNodeCfg.MaintenanceWindows.Key, "22:00")
+	assert.NoError(t, checkMaintenanceWindow(params, normal, inWindow))
+}
@@ -0,0 +1,78 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/indexmanifest"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/retry"
+)
+
+// newManifestSigner returns the signer of indexNode.manifestSigning, or nil if signing is disabled.
+func newManifestSigner(params *paramtable.ComponentParam) (indexmanifest.Signer, error) {
+	algorithm := params.IndexNodeCfg.ManifestSigningAlgorithm.GetValue()
+	if algorithm == "" {
+		return nil, nil
+	}
+	return indexmanifest.NewSigner(algorithm, params.IndexNodeCfg.ManifestSigningKey.GetValue())
+}
+
+// hashIndexFile reads the saved index file back to get its manifest entry, for the files knowhere writes.
+func (it *indexBuildTask) hashIndexFile(ctx context.Context, key string, filePath string) (indexmanifest.File, error) {
+	reader, err := it.cm.Reader(ctx, filePath)
+	if err != nil {
+		return indexmanifest.File{}, err
+	}
+	defer reader.Close()
+	return indexmanifest.NewFileFromReader(key, reader)
+}
+
+// saveSignedManifest signs the manifest of the index files and saves it next to them,
+// it returns the path and the size of the manifest file.
+func (it *indexBuildTask) saveSignedManifest(ctx context.Context, files []indexmanifest.File) (string, uint64, error) {
+	manifest := &indexmanifest.Manifest{
+		BuildID:      it.req.GetBuildID(),
+		IndexID:      it.req.GetIndexID(),
+		IndexVersion: it.req.GetIndexVersion(),
+		CollectionID: it.collectionID,
+		PartitionID:  it.partitionID,
+		SegmentID:    it.segmentID,
+		FieldID:      it.fieldID,
+		Files:        files,
+		AutoIndex:    it.autoIndex.manifest(),
+	}
+	value, err := indexmanifest.Sign(manifest, it.node.manifestSigner)
+	if err != nil {
+		return "", 0, err
+	}
+	manifestPath := it.indexFilePath(indexmanifest.Key)
+	saveFn := func() error {
+		return it.cm.Write(ctx, manifestPath, value)
+	}
+	if err := retry.Do(ctx, saveFn, retry.Attempts(5)); err != nil {
+		log.Ctx(ctx).Warn("index node save index manifest failed", zap.Error(err), zap.String("savePath", manifestPath))
+		return "", 0, err
+	}
+	log.Ctx(ctx).Info("IndexNode save signed index manifest", zap.Int64("buildID", it.BuildID),
+		zap.String("algorithm", it.node.manifestSigner.Algorithm()), zap.Int("files", len(files)))
+	return manifestPath, uint64(len(value)), nil
+}
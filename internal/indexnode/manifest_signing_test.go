@@ -0,0 +1,86 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/indexmanifest"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestSaveSignedManifest(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	signer, err := newManifestSigner(params)
+	assert.NoError(t, err)
+	assert.Nil(t, signer)
+
+	params.Save(params.IndexNodeCfg.ManifestSigningAlgorithm.Key, indexmanifest.AlgorithmHMACSHA256)
+	_, err = newManifestSigner(params)
+	assert.Error(t, err)
+	key := base64.StdEncoding.EncodeToString([]byte("secret"))
+	params.Save(params.IndexNodeCfg.ManifestSigningKey.Key, key)
+	signer, err = newManifestSigner(params)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	it := &indexBuildTask{
+		BuildID:   1,
+		cm:        cm,
+		node:      &IndexNode{params: params, manifestSigner: signer},
+		req:       &indexpb.CreateJobRequest{BuildID: 1, IndexID: 2, IndexVersion: 3},
+		segmentID: 4,
+	}
+	require.NoError(t, cm.Write(ctx, "files/HNSW", []byte("index data")))
+	file, err := it.hashIndexFile(ctx, "HNSW", "files/HNSW")
+	require.NoError(t, err)
+	assert.Equal(t, indexmanifest.NewFile("HNSW", []byte("index data")), file)
+
+	manifestPath, size, err := it.saveSignedManifest(ctx, []indexmanifest.File{file})
+	require.NoError(t, err)
+	data, err := cm.Read(ctx, manifestPath)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(len(data)), size)
+
+	verifier, err := indexmanifest.NewVerifier(indexmanifest.AlgorithmHMACSHA256, key)
+	require.NoError(t, err)
+	manifest, err := indexmanifest.Verify(data, verifier)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), manifest.BuildID)
+	assert.Equal(t, int64(4), manifest.SegmentID)
+	assert.NoError(t, manifest.VerifyFile("HNSW", []byte("index data")))
+	assert.Nil(t, manifest.AutoIndex)
+
+	it.autoIndex = &autoIndexDecision{policy: "default", choice: AutoIndexChoice{
+		IndexParams: map[string]string{"index_type": "HNSW", "M": "16"}, Reason: "1000 rows of dim 8"}}
+	manifestPath, _, err = it.saveSignedManifest(ctx, []indexmanifest.File{file})
+	require.NoError(t, err)
+	data, err = cm.Read(ctx, manifestPath)
+	require.NoError(t, err)
+	manifest, err = indexmanifest.Verify(data, verifier)
+	require.NoError(t, err)
+	assert.Equal(t, &indexmanifest.AutoIndex{Policy: "default", IndexParams: map[string]string{"index_type": "HNSW", "M": "16"},
+		Reason: "1000 rows of dim 8"}, manifest.AutoIndex)
+}
@@ -0,0 +1,205 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+// memoryPressurePaths are the memory pressure stall information files of the cgroup and of the host.
+var memoryPressurePaths = []string{"/sys/fs/cgroup/memory.pressure", "/proc/pressure/memory"}
+
+// parseMemoryPressure returns the "some avg10" value of the pressure stall information.
+func parseMemoryPressure(data []byte) (float64, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || fields[0] != "some" {
+			continue
+		}
+		for _, field := range fields[1:] {
+			if value := strings.TrimPrefix(field, "avg10="); value != field {
+				return strconv.ParseFloat(value, 64)
+			}
+		}
+	}
+	return 0, errors.New("no some avg10 in the memory pressure")
+}
+
+// readMemoryPressure reads the memory pressure from the first readable file of memoryPressurePaths.
+func readMemoryPressure() (float64, error) {
+	var err error
+	for _, path := range memoryPressurePaths {
+		var data []byte
+		if data, err = os.ReadFile(path); err == nil {
+			return parseMemoryPressure(data)
+		}
+	}
+	return 0, err
+}
+
+// memoryGuard trades build throughput for the survival of the node under memory pressure. When the pressure
+// stays above indexNode.memoryPressure.threshold for indexNode.memoryPressure.sustain, it abandons the sandboxed
+// build of the lowest priority, so IndexCoord retries it, and decreases the build parallelism by one, again
+// for every sustained period. The parallelism taken is given back one by one as the pressure stays low.
+type memoryGuard struct {
+	node         *IndexNode
+	readPressure func() (float64, error)
+
+	highSince time.Time
+	lowSince  time.Time
+	// shrunk is the build parallelism taken by the guard.
+	shrunk int
+
+	wg sync.WaitGroup
+}
+
+func newMemoryGuard(node *IndexNode) *memoryGuard {
+	return &memoryGuard{
+		node:         node,
+		readPressure: readMemoryPressure,
+	}
+}
+
+// Start starts the pressure checking loop if indexNode.memoryPressure.enable is set.
+func (g *memoryGuard) Start(ctx context.Context) {
+	if !g.node.params.IndexNodeCfg.MemoryPressureEnable.GetAsBool() {
+		return
+	}
+	if _, err := g.readPressure(); err != nil {
+		log.Warn("IndexNode memory pressure is not available", zap.Error(err))
+		return
+	}
+	g.wg.Add(1)
+	go g.loop(ctx)
+}
+
+// Close waits for the checking loop to exit, the loop exits when the context passed to Start is done.
+func (g *memoryGuard) Close() {
+	g.wg.Wait()
+}
+
+func (g *memoryGuard) loop(ctx context.Context) {
+	defer g.wg.Done()
+	ticker := time.NewTicker(g.node.params.IndexNodeCfg.MemoryPressureInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			pressure, err := g.readPressure()
+			if err != nil {
+				log.Warn("IndexNode read memory pressure failed", zap.Error(err))
+				continue
+			}
+			g.check(now, pressure)
+		}
+	}
+}
+
+func (g *memoryGuard) check(now time.Time, pressure float64) {
+	sustain := g.node.params.IndexNodeCfg.MemoryPressureSustain.GetAsDuration(time.Second)
+	if pressure >= g.node.params.IndexNodeCfg.MemoryPressureThreshold.GetAsFloat() {
+		g.lowSince = time.Time{}
+		if g.highSince.IsZero() {
+			g.highSince = now
+		}
+		if now.Sub(g.highSince) < sustain {
+			return
+		}
+		g.highSince = now
+		g.relieve(pressure)
+		return
+	}
+	g.highSince = time.Time{}
+	if g.shrunk == 0 {
+		return
+	}
+	if g.lowSince.IsZero() {
+		g.lowSince = now
+	}
+	if now.Sub(g.lowSince) < sustain {
+		return
+	}
+	g.lowSince = now
+	g.shrunk--
+	parallel := g.node.sched.getBuildParallel() + 1
+	g.node.sched.setBuildParallel(parallel)
+	log.Info("IndexNode memory pressure subsided, restore build parallelism", zap.Float64("pressure", pressure),
+		zap.Int("buildParallel", parallel))
+}
+
+func (g *memoryGuard) relieve(pressure float64) {
+	if parallel := g.node.sched.getBuildParallel(); parallel > 1 {
+		g.node.sched.setBuildParallel(parallel - 1)
+		g.shrunk++
+	}
+	key, ok := g.node.memoryPressureVictim()
+	log.Warn("IndexNode under sustained memory pressure", zap.Float64("pressure", pressure),
+		zap.Int("buildParallel", g.node.sched.getBuildParallel()), zap.Bool("abandon", ok),
+		zap.String("ClusterID", key.ClusterID), zap.Int64("buildID", key.BuildID))
+	reason := fmt.Sprintf("abandoned under memory pressure %.2f", pressure)
+	if ok && g.node.abandonTask(key, reason) {
+		g.node.recordPreemption(key, reason)
+		countAbortedTask()
+	}
+}
+
+// memoryPressureVictim returns the build of the lowest priority running in a build helper, the latest one among
+// them, which loses the least work. Abandoning the build kills its helper and gives its memory back at once,
+// while a CGO build in IndexNode keeps its memory until the call returns, so it's never picked.
+// The priority is read from the task info, the request of a task finishing meanwhile is released by Reset.
+func (i *IndexNode) memoryPressureVictim() (taskKey, bool) {
+	var (
+		victim         taskKey
+		found          bool
+		victimPriority int32
+		victimStart    time.Time
+	)
+	for _, t := range i.sched.IndexBuildQueue.ListActiveTasks() {
+		it, ok := t.(*indexBuildTask)
+		if !ok || atomic.LoadInt64(&it.sandboxPID) <= 0 {
+			continue
+		}
+		key := taskKey{ClusterID: it.ClusterID, BuildID: it.BuildID}
+		i.tasks.update(key, func(info *taskInfo) {
+			if info.phase.isTerminal() {
+				return
+			}
+			if !found || info.priority < victimPriority ||
+				(info.priority == victimPriority && info.startTime.After(victimStart)) {
+				victim, found, victimPriority, victimStart = key, true, info.priority, info.startTime
+			}
+		})
+	}
+	return victim, found
+}
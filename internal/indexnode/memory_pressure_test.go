@@ -0,0 +1,120 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestParseMemoryPressure(t *testing.T) {
+	pressure, err := parseMemoryPressure([]byte("some avg10=42.50 avg60=10.00 avg300=1.00 total=123\n" +
+		"full avg10=20.00 avg60=5.00 avg300=0.50 total=45\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, 42.5, pressure)
+
+	_, err = parseMemoryPressure([]byte("full avg10=20.00 avg60=5.00 avg300=0.50 total=45\n"))
+	assert.Error(t, err)
+	_, err = parseMemoryPressure([]byte("some avg10=abc\n"))
+	assert.Error(t, err)
+}
+
+func TestMemoryGuard(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	params.Save(params.LocalStorageCfg.Path.Key, t.TempDir())
+
+	ctx := context.Background()
+	node := &IndexNode{
+		params:   params,
+		sched:    NewTaskScheduler(ctx, params),
+		reporter: newJobResultReporter(params),
+	}
+	node.sched.setBuildParallel(2)
+	now := time.Now()
+	canceled := make(map[UniqueID]bool)
+	for _, task := range []struct {
+		buildID   UniqueID
+		priority  int32
+		startTime time.Time
+		sandboxed bool
+	}{
+		{1, 0, now.Add(-time.Hour), true},
+		{2, 1, now, true},
+		{3, 0, now.Add(-time.Minute), true},
+		// the build in IndexNode can't be killed
+		{4, 0, now, false},
+	} {
+		buildID := task.buildID
+		node.loadOrStoreTask("cluster", buildID, &taskInfo{
+			cancel:    func() { canceled[buildID] = true },
+			phase:     taskBuilding,
+			priority:  task.priority,
+			startTime: task.startTime,
+		})
+		// the request is released by Reset when the task finishes, the victim is picked without it.
+		it := &indexBuildTask{
+			ident:     fmt.Sprintf("cluster/%d", buildID),
+			ClusterID: "cluster",
+			BuildID:   buildID,
+		}
+		if task.sandboxed {
+			it.sandboxPID = 100 + buildID
+		}
+		node.sched.IndexBuildQueue.AddActiveTask(it)
+	}
+	g := newMemoryGuard(node)
+
+	// the pressure must be sustained
+	g.check(now, 50)
+	g.check(now.Add(10*time.Second), 50)
+	assert.Equal(t, 2, node.sched.getBuildParallel())
+	g.check(now.Add(20*time.Second), 10)
+	g.check(now.Add(30*time.Second), 50)
+	g.check(now.Add(50*time.Second), 50)
+	assert.Equal(t, 2, node.sched.getBuildParallel())
+
+	// the latest build of the lowest priority is abandoned
+	g.check(now.Add(60*time.Second), 50)
+	assert.Equal(t, 1, node.sched.getBuildParallel())
+	assert.Equal(t, commonpb.IndexState_Retry, node.loadTaskState("cluster", 3))
+	assert.True(t, canceled[3])
+	assert.Equal(t, commonpb.IndexState_InProgress, node.loadTaskState("cluster", 1))
+
+	// the parallelism doesn't go below 1
+	g.check(now.Add(90*time.Second), 50)
+	assert.Equal(t, 1, node.sched.getBuildParallel())
+	assert.Equal(t, commonpb.IndexState_Retry, node.loadTaskState("cluster", 1))
+	assert.Equal(t, commonpb.IndexState_InProgress, node.loadTaskState("cluster", 2))
+	assert.Equal(t, commonpb.IndexState_InProgress, node.loadTaskState("cluster", 4))
+	assert.False(t, canceled[4])
+
+	// the parallelism is restored once the pressure subsides for the sustain duration
+	g.check(now.Add(100*time.Second), 10)
+	g.check(now.Add(120*time.Second), 10)
+	assert.Equal(t, 1, node.sched.getBuildParallel())
+	g.check(now.Add(130*time.Second), 10)
+	assert.Equal(t, 2, node.sched.getBuildParallel())
+	g.check(now.Add(200*time.Second), 10)
+	assert.Equal(t, 2, node.sched.getBuildParallel())
+}
@@ -53,10 +53,16 @@ func getSystemInfoMetrics(
 			ID:          node.session.ServerID,
 		},
 		SystemConfigurations: metricsinfo.IndexNodeConfiguration{
-			MinioBucketName: Params.MinioCfg.BucketName.GetValue(),
-			SimdType:        Params.CommonCfg.SimdType.GetValue(),
+			MinioBucketName: node.params.MinioCfg.BucketName.GetValue(),
+			SimdType:        node.params.CommonCfg.SimdType.GetValue(),
 		},
+		Warmup: node.warmup,
 	}
+	if node.sched != nil {
+		nodeInfos.ScalingHint = node.sched.scalingHint(nodeInfos.HardwareInfos)
+		nodeInfos.BuildBaselines = node.sched.baselines.report()
+	}
+	nodeInfos.CachedSegments = node.datasets.segments()
 
 	metricsinfo.FillDeployMetricsWithEnv(&nodeInfos.SystemInfo)
 
@@ -0,0 +1,102 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// the policies of indexNode.nonFiniteVector.policy, the unknown ones fail the task.
+const (
+	nonFinitePolicyFail = "fail"
+	nonFinitePolicyZero = "zero"
+)
+
+// errNonFiniteVector is returned when the float vectors have NaN or Inf values under the fail policy,
+// retrying the job on the same binlogs would fail again.
+var errNonFiniteVector = errors.New("float vector has NaN or Inf values")
+
+// nonFiniteStats counts the NaN and Inf values of the float vectors.
+type nonFiniteStats struct {
+	rows     int
+	values   int
+	firstRow int
+}
+
+func isNonFinite(value float32) bool {
+	return math.IsNaN(float64(value)) || math.IsInf(float64(value), 0)
+}
+
+// countNonFinite counts the rows and the values which are NaN or Inf.
+func countNonFinite(data *storage.FloatVectorFieldData) nonFiniteStats {
+	stats := nonFiniteStats{firstRow: -1}
+	for row := 0; row < data.RowNum(); row++ {
+		values := 0
+		for _, value := range data.Data[row*data.Dim : (row+1)*data.Dim] {
+			if isNonFinite(value) {
+				values++
+			}
+		}
+		if values > 0 {
+			if stats.rows == 0 {
+				stats.firstRow = row
+			}
+			stats.rows++
+			stats.values += values
+		}
+	}
+	return stats
+}
+
+// zeroNonFiniteValues replaces the NaN and Inf values of the float vectors with 0 in place.
+func zeroNonFiniteValues(data *storage.FloatVectorFieldData) {
+	for i, value := range data.Data {
+		if isNonFinite(value) {
+			data.Data[i] = 0
+		}
+	}
+}
+
+// handleNonFiniteVectors applies indexNode.nonFiniteVector.policy to the float vectors having NaN or Inf values,
+// which knowhere doesn't define the behavior on. It returns the field data to build the index on.
+func (it *indexBuildTask) handleNonFiniteVectors(ctx context.Context, data storage.FieldData) (storage.FieldData, error) {
+	floatData, ok := data.(*storage.FloatVectorFieldData)
+	if !ok {
+		return data, nil
+	}
+	stats := countNonFinite(floatData)
+	if stats.rows == 0 {
+		return data, nil
+	}
+	numRows := floatData.RowNum()
+	switch policy := it.node.params.IndexNodeCfg.NonFiniteVectorPolicy.GetValue(); policy {
+	case nonFinitePolicyZero:
+		zeroNonFiniteValues(floatData)
+		it.warn(ctx, "%d NaN or Inf values in %d of %d rows replaced with 0", stats.values, stats.rows, numRows)
+		return floatData, nil
+	default:
+		it.node.storeTaskFailCode(it.ClusterID, it.BuildID, commonpb.ErrorCode_IllegalArgument)
+		return nil, fmt.Errorf("%w: %d values in %d of %d rows, the first is row %d, the policy is %s",
+			errNonFiniteVector, stats.values, stats.rows, numRows, stats.firstRow, policy)
+	}
+}
@@ -0,0 +1,91 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestCountNonFinite(t *testing.T) {
+	nan, inf := float32(math.NaN()), float32(math.Inf(-1))
+	data := &storage.FloatVectorFieldData{Dim: 2, Data: []float32{1, 2, nan, 3, 4, 5, inf, nan}}
+	assert.Equal(t, nonFiniteStats{rows: 2, values: 3, firstRow: 1}, countNonFinite(data))
+	assert.Equal(t, nonFiniteStats{firstRow: -1}, countNonFinite(&storage.FloatVectorFieldData{Dim: 2, Data: []float32{1, 2}}))
+
+	zeroNonFiniteValues(data)
+	assert.Equal(t, []float32{1, 2, 0, 3, 4, 5, 0, 0}, data.Data)
+}
+
+func TestHandleNonFiniteVectors(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	ctx := context.Background()
+	key := taskKey{ClusterID: "cluster", BuildID: 1}
+	newTask := func() *indexBuildTask {
+		node := &IndexNode{params: params}
+		node.loadOrStoreTask("cluster", 1, &taskInfo{phase: taskLoading})
+		return &indexBuildTask{ClusterID: "cluster", BuildID: 1, node: node}
+	}
+	newData := func() *storage.FloatVectorFieldData {
+		return &storage.FloatVectorFieldData{Dim: 2, Data: []float32{1, 2, float32(math.NaN()), 3, 4, 5, 6, 7}}
+	}
+
+	t.Run("finite", func(t *testing.T) {
+		it := newTask()
+		data := &storage.FloatVectorFieldData{Dim: 2, Data: []float32{1, 2}}
+		ret, err := it.handleNonFiniteVectors(ctx, data)
+		assert.NoError(t, err)
+		assert.Same(t, data, ret)
+		binaryData := &storage.BinaryVectorFieldData{Dim: 8, Data: []byte{0xff}}
+		binaryRet, err := it.handleNonFiniteVectors(ctx, binaryData)
+		assert.NoError(t, err)
+		assert.Same(t, binaryData, binaryRet)
+		assert.Empty(t, it.node.tasks.info(key).warnings)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		it := newTask()
+		_, err := it.handleNonFiniteVectors(ctx, newData())
+		assert.True(t, errors.Is(err, errNonFiniteVector))
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, it.node.tasks.info(key).failCode)
+	})
+
+	t.Run("skip", func(t *testing.T) {
+		params.Save(params.IndexNodeCfg.NonFiniteVectorPolicy.Key, "skip")
+		defer params.Reset(params.IndexNodeCfg.NonFiniteVectorPolicy.Key)
+		it := newTask()
+		_, err := it.handleNonFiniteVectors(ctx, newData())
+		assert.True(t, errors.Is(err, errNonFiniteVector))
+	})
+
+	t.Run("zero", func(t *testing.T) {
+		params.Save(params.IndexNodeCfg.NonFiniteVectorPolicy.Key, nonFinitePolicyZero)
+		it := newTask()
+		ret, err := it.handleNonFiniteVectors(ctx, newData())
+		assert.NoError(t, err)
+		assert.Equal(t, []float32{1, 2, 0, 3, 4, 5, 6, 7}, ret.(*storage.FloatVectorFieldData).Data)
+		assert.Equal(t, []string{"1 NaN or Inf values in 1 of 4 rows replaced with 0"}, it.node.tasks.info(key).warnings)
+	})
+}
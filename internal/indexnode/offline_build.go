@@ -0,0 +1,195 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/hardware"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
+)
+
+const (
+	s3URIScheme         = "s3://"
+	offlineBuildID      = 1
+	offlineClusterID    = "offline"
+	offlineIndexVersion = 1
+)
+
+// OfflineBuildParams are the params of an offline build, passed as json on the command line.
+type OfflineBuildParams struct {
+	TypeParams    map[string]string `json:"type_params"`
+	IndexParams   map[string]string `json:"index_params"`
+	EngineVersion string            `json:"engine_version,omitempty"`
+}
+
+// ParseOfflineBuildParams parses the json params of an offline build.
+func ParseOfflineBuildParams(value string) (*OfflineBuildParams, error) {
+	params := &OfflineBuildParams{}
+	if err := json.Unmarshal([]byte(value), params); err != nil {
+		return nil, fmt.Errorf("invalid build params: %w", err)
+	}
+	if params.IndexParams["index_type"] == "" {
+		return nil, fmt.Errorf("invalid build params: index_type is missing in index_params")
+	}
+	return params, nil
+}
+
+// OfflineBuildStats are the timing and memory stats of an offline build.
+type OfflineBuildStats struct {
+	NumRows          int64
+	Dim              int64
+	IndexFiles       []string
+	SerializedSize   uint64
+	PrepareCost      time.Duration
+	LoadCost         time.Duration
+	BuildCost        time.Duration
+	SaveCost         time.Duration
+	MemoryBefore     uint64
+	MemoryAfterLoad  uint64
+	MemoryAfterBuild uint64
+}
+
+// String formats the stats for printing.
+func (s *OfflineBuildStats) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "rows: %d, dim: %d\n", s.NumRows, s.Dim)
+	fmt.Fprintf(&b, "prepare: %s, load: %s, build: %s, save: %s\n", s.PrepareCost, s.LoadCost, s.BuildCost, s.SaveCost)
+	fmt.Fprintf(&b, "used memory before load: %d, after load: %d, after build: %d\n",
+		s.MemoryBefore, s.MemoryAfterLoad, s.MemoryAfterBuild)
+	fmt.Fprintf(&b, "serialized size: %d, index files:\n", s.SerializedSize)
+	for _, file := range s.IndexFiles {
+		fmt.Fprintf(&b, "\t%s\n", file)
+	}
+	return b.String()
+}
+
+// newInputChunkManager returns the chunk manager to read the binlogs of input and the binlog prefix,
+// input is either a local directory or a s3 uri like s3://bucket/prefix read with the minio configs of params.
+func newInputChunkManager(ctx context.Context, params *paramtable.ComponentParam, input string) (storage.ChunkManager, string, error) {
+	if !strings.HasPrefix(input, s3URIScheme) {
+		dir, err := filepath.Abs(input)
+		if err != nil {
+			return nil, "", err
+		}
+		return storage.NewLocalChunkManager(storage.RootPath(dir)), dir + string(filepath.Separator), nil
+	}
+	bucket, prefix, _ := strings.Cut(strings.TrimPrefix(input, s3URIScheme), "/")
+	if bucket == "" {
+		return nil, "", fmt.Errorf("invalid s3 uri %s", input)
+	}
+	cm, err := storage.NewChunkManagerFactory("minio",
+		storage.RootPath(""),
+		storage.Address(params.MinioCfg.Address.GetValue()),
+		storage.AccessKeyID(params.MinioCfg.AccessKeyID.GetValue()),
+		storage.SecretAccessKeyID(params.MinioCfg.SecretAccessKey.GetValue()),
+		storage.UseSSL(params.MinioCfg.UseSSL.GetAsBool()),
+		storage.BucketName(bucket),
+		storage.UseIAM(params.MinioCfg.UseIAM.GetAsBool()),
+		storage.CloudProvider(params.MinioCfg.CloudProvider.GetValue()),
+		storage.IAMEndpoint(params.MinioCfg.IAMEndpoint.GetValue()),
+	).NewPersistentStorageChunkManager(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return cm, prefix, nil
+}
+
+// RunOfflineBuild builds the index of the binlogs under input through the same task code path as CreateJob,
+// and saves the index files under the local output directory. input must hold the binlogs of one field of a segment.
+// The build runs in its own process, it reads the configs from the param table of the process.
+func RunOfflineBuild(ctx context.Context, input string, output string, params *OfflineBuildParams) (*OfflineBuildStats, error) {
+	node := &IndexNode{params: paramtable.Get()}
+	inputCM, prefix, err := newInputChunkManager(ctx, node.params, input)
+	if err != nil {
+		return nil, err
+	}
+	dataPaths, _, err := inputCM.ListWithPrefix(ctx, prefix, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(dataPaths) == 0 {
+		return nil, fmt.Errorf("no binlog found under %s", input)
+	}
+	outputDir, err := filepath.Abs(output)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &indexpb.CreateJobRequest{
+		ClusterID:     offlineClusterID,
+		BuildID:       offlineBuildID,
+		DataPaths:     dataPaths,
+		IndexVersion:  offlineIndexVersion,
+		IndexParams:   funcutil.Map2KeyValuePair(params.IndexParams),
+		TypeParams:    funcutil.Map2KeyValuePair(params.TypeParams),
+		EngineVersion: params.EngineVersion,
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	it := &indexBuildTask{
+		ident:     fmt.Sprintf("%s/%d", req.ClusterID, req.BuildID),
+		ctx:       ctx,
+		cancel:    cancel,
+		BuildID:   req.BuildID,
+		ClusterID: req.ClusterID,
+		node:      node,
+		req:       req,
+		cm:        inputCM,
+		tr:        timerecord.NewTimeRecorder("offline build"),
+	}
+	it.statistic.StartTime = time.Now().UnixMicro()
+	it.node.initKnowhere()
+
+	stats := &OfflineBuildStats{MemoryBefore: hardware.GetUsedMemoryCount()}
+	tr := timerecord.NewTimeRecorder("offline build stats")
+	if err := it.Prepare(ctx); err != nil {
+		return nil, err
+	}
+	stats.PrepareCost = tr.RecordSpan()
+	if err := it.LoadData(ctx); err != nil {
+		return nil, err
+	}
+	stats.LoadCost = tr.RecordSpan()
+	stats.MemoryAfterLoad = hardware.GetUsedMemoryCount()
+	if err := it.BuildIndex(ctx); err != nil {
+		return nil, err
+	}
+	stats.BuildCost = tr.RecordSpan()
+	stats.MemoryAfterBuild = hardware.GetUsedMemoryCount()
+	// the index files are always saved locally, never next to the input binlogs.
+	it.cm = storage.NewLocalChunkManager(storage.RootPath(outputDir))
+	if err := it.SaveIndexFiles(ctx); err != nil {
+		return nil, err
+	}
+	stats.SaveCost = tr.RecordSpan()
+
+	stats.NumRows = it.statistic.NumRows
+	stats.Dim = it.statistic.Dim
+	stats.IndexFiles = it.savePaths
+	stats.SerializedSize = it.serializedSize
+	return stats, nil
+}
@@ -0,0 +1,59 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestParseOfflineBuildParams(t *testing.T) {
+	params, err := ParseOfflineBuildParams(`{"type_params": {"dim": "8"}, "index_params": {"index_type": "HNSW"}}`)
+	assert.NoError(t, err)
+	assert.Equal(t, "8", params.TypeParams["dim"])
+	assert.Equal(t, "HNSW", params.IndexParams["index_type"])
+
+	_, err = ParseOfflineBuildParams(`{"type_params": {"dim": "8"}}`)
+	assert.Error(t, err)
+	_, err = ParseOfflineBuildParams("garbage")
+	assert.Error(t, err)
+}
+
+func TestOfflineBuildInput(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	ctx := context.Background()
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "1"), []byte("binlog"), 0644))
+
+	cm, prefix, err := newInputChunkManager(ctx, params, dir)
+	assert.NoError(t, err)
+	paths, _, err := cm.ListWithPrefix(ctx, prefix, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "1")}, paths)
+
+	_, _, err = newInputChunkManager(ctx, params, "s3://")
+	assert.Error(t, err)
+
+	_, err = RunOfflineBuild(ctx, t.TempDir(), t.TempDir(), &OfflineBuildParams{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,104 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// paramProfile is the node-level profile of a build param, Default is set if the job omits the param,
+// and the numeric values of the job are clamped to [Min, Max].
+type paramProfile struct {
+	Default json.Number `json:"default"`
+	Min     *float64    `json:"min"`
+	Max     *float64    `json:"max"`
+}
+
+// paramProfiles returns the build param profiles of the index type set by indexNode.paramProfiles,
+// it returns nil if the profiles are not valid json.
+func paramProfiles(params *paramtable.ComponentParam, indexType string) map[string]paramProfile {
+	value := params.IndexNodeCfg.ParamProfiles.GetValue()
+	profiles := make(map[string]map[string]paramProfile)
+	if err := json.Unmarshal([]byte(value), &profiles); err != nil {
+		log.Warn("IndexNode ignores invalid param profiles", zap.String("value", value), zap.Error(err))
+		return nil
+	}
+	return profiles[indexType]
+}
+
+// applyParamProfiles merges the param profiles of the index type under the index params of the job,
+// the applied values are recorded in the index params of the build stats.
+func (it *indexBuildTask) applyParamProfiles(ctx context.Context) {
+	for key, profile := range paramProfiles(it.node.params, it.newIndexParams["index_type"]) {
+		value, ok := it.newIndexParams[key]
+		if !ok {
+			if profile.Default == "" {
+				continue
+			}
+			it.newIndexParams[key] = profile.Default.String()
+			it.setStatisticIndexParam(key, profile.Default.String())
+			continue
+		}
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			continue
+		}
+		clamped := number
+		if profile.Min != nil && clamped < *profile.Min {
+			clamped = *profile.Min
+		}
+		if profile.Max != nil && clamped > *profile.Max {
+			clamped = *profile.Max
+		}
+		if clamped == number {
+			continue
+		}
+		it.newIndexParams[key] = strconv.FormatFloat(clamped, 'f', -1, 64)
+		it.setStatisticIndexParam(key, it.newIndexParams[key])
+		it.warn(ctx, "%s %s is out of the range %s of the node, %s is used", key, value, profile.rangeString(), it.newIndexParams[key])
+	}
+}
+
+func (p paramProfile) rangeString() string {
+	bound := func(b *float64) string {
+		if b == nil {
+			return ""
+		}
+		return strconv.FormatFloat(*b, 'f', -1, 64)
+	}
+	return fmt.Sprintf("[%s, %s]", bound(p.Min), bound(p.Max))
+}
+
+// setStatisticIndexParam sets the index param recorded in the build stats to value.
+func (it *indexBuildTask) setStatisticIndexParam(key, value string) {
+	indexParams := make([]*commonpb.KeyValuePair, 0, len(it.statistic.IndexParams)+1)
+	for _, kv := range it.statistic.IndexParams {
+		if kv.GetKey() != key {
+			indexParams = append(indexParams, kv)
+		}
+	}
+	it.statistic.IndexParams = append(indexParams, &commonpb.KeyValuePair{Key: key, Value: value})
+}
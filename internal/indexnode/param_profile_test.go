@@ -0,0 +1,64 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestApplyParamProfiles(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	node := &IndexNode{params: params}
+	node.loadOrStoreTask("cluster", 1, &taskInfo{phase: taskPending})
+	it := &indexBuildTask{
+		ClusterID: "cluster",
+		BuildID:   1,
+		node:      node,
+		newIndexParams: map[string]string{
+			"index_type": indexparamcheck.IndexHNSW,
+			"M":          "64",
+		},
+	}
+	it.statistic.IndexParams = []*commonpb.KeyValuePair{{Key: "M", Value: "64"}}
+	it.applyParamProfiles(context.Background())
+	assert.Equal(t, "64", it.newIndexParams["M"])
+
+	params.Save(params.IndexNodeCfg.ParamProfiles.Key, `{"HNSW": {"efConstruction": {"default": 360}, "M": {"min": 8, "max": 48}}}`)
+	it.applyParamProfiles(context.Background())
+	assert.Equal(t, "360", it.newIndexParams["efConstruction"])
+	assert.Equal(t, "48", it.newIndexParams["M"])
+	assert.ElementsMatch(t, []*commonpb.KeyValuePair{{Key: "M", Value: "48"}, {Key: "efConstruction", Value: "360"}}, it.statistic.IndexParams)
+	assert.Equal(t, []string{"M 64 is out of the range [8, 48] of the node, 48 is used"}, node.tasks.info(taskKey{ClusterID: "cluster", BuildID: 1}).warnings)
+
+	// the params within the range and of the other index types are kept
+	it.newIndexParams["efConstruction"] = "100"
+	it.applyParamProfiles(context.Background())
+	assert.Equal(t, "100", it.newIndexParams["efConstruction"])
+	it.newIndexParams = map[string]string{"index_type": indexparamcheck.IndexFaissIvfFlat, "M": "64"}
+	it.applyParamProfiles(context.Background())
+	assert.Equal(t, map[string]string{"index_type": indexparamcheck.IndexFaissIvfFlat, "M": "64"}, it.newIndexParams)
+
+	params.Save(params.IndexNodeCfg.ParamProfiles.Key, "invalid")
+	assert.Nil(t, paramProfiles(params, indexparamcheck.IndexHNSW))
+}
@@ -0,0 +1,165 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+// pkOffsetsKey is the key of the index file mapping the primary keys of the indexed rows to their row offsets
+// in the segment, it only exists when the job sets emit_pk_offsets. The file starts with the data type of the
+// primary key as one byte, then the entries sorted by the primary key and the offset for binary search.
+// An entry is the key followed by the offset as little endian int64, an int64 key is a little endian int64
+// and a varchar key is its length as little endian uint32 followed by its bytes.
+const pkOffsetsKey = "PK_OFFSETS"
+
+// checkPkOffsets returns an error if the job emits the pk offsets without the binlogs of the primary key.
+func checkPkOffsets(req *indexpb.CreateJobRequest) error {
+	if !req.GetEmitPkOffsets() {
+		return nil
+	}
+	if len(req.GetPkDataPaths()) == 0 {
+		return errors.New("the pk offsets are built from the binlogs of the primary key field")
+	}
+	return nil
+}
+
+// readBinlogs reads the binlogs of the paths in parallel.
+func (it *indexBuildTask) readBinlogs(ctx context.Context, paths []string) ([]*Blob, error) {
+	blobs := make([]*Blob, len(paths))
+	loadKey := func(idx int) error {
+		value, err := it.cm.Read(ctx, paths[idx])
+		if err != nil {
+			return err
+		}
+		blobs[idx] = &Blob{Key: paths[idx], Value: value}
+		return nil
+	}
+	if err := funcutil.ProcessFuncParallel(len(paths), runtime.GOMAXPROCS(0), loadKey, "readBinlogs"); err != nil {
+		return nil, err
+	}
+	return blobs, nil
+}
+
+// decodeFieldBinlogs decodes the binlogs of a single field pinned to the data timestamp of the job.
+func (it *indexBuildTask) decodeFieldBinlogs(ctx context.Context, paths []string) (storage.FieldData, error) {
+	blobs, err := it.readBinlogs(ctx, paths)
+	if err != nil {
+		return nil, err
+	}
+	if blobs, err = it.pinBinlogs(ctx, blobs); err != nil {
+		return nil, err
+	}
+	var insertCodec storage.InsertCodec
+	_, _, _, insertData, err := insertCodec.DeserializeAll(blobs)
+	if err != nil {
+		return nil, err
+	}
+	for _, data := range insertData.Data {
+		return data, nil
+	}
+	return nil, fmt.Errorf("no field in the binlogs %v", paths)
+}
+
+// loadPks decodes the primary keys of the segment for the pk offsets.
+func (it *indexBuildTask) loadPks(ctx context.Context) (storage.FieldData, error) {
+	if it.pks == nil {
+		pks, err := it.decodeFieldBinlogs(ctx, it.req.GetPkDataPaths())
+		if err != nil {
+			return nil, err
+		}
+		it.pks = pks
+	}
+	return it.pks, nil
+}
+
+// serializePkOffsets returns the PK_OFFSETS index file of the primary keys of the rows at the offsets.
+func serializePkOffsets(pks storage.FieldData, offsets []int64) (*Blob, error) {
+	sorted := append([]int64(nil), offsets...)
+	var buf bytes.Buffer
+	switch data := pks.(type) {
+	case *storage.Int64FieldData:
+		sort.SliceStable(sorted, func(i, j int) bool {
+			return data.Data[sorted[i]] < data.Data[sorted[j]]
+		})
+		buf.Grow(1 + 16*len(sorted))
+		buf.WriteByte(byte(schemapb.DataType_Int64))
+		entry := make([]byte, 16)
+		for _, offset := range sorted {
+			common.Endian.PutUint64(entry, uint64(data.Data[offset]))
+			common.Endian.PutUint64(entry[8:], uint64(offset))
+			buf.Write(entry)
+		}
+	case *storage.StringFieldData:
+		sort.SliceStable(sorted, func(i, j int) bool {
+			return data.Data[sorted[i]] < data.Data[sorted[j]]
+		})
+		buf.WriteByte(byte(schemapb.DataType_VarChar))
+		entry := make([]byte, 8)
+		for _, offset := range sorted {
+			common.Endian.PutUint32(entry, uint32(len(data.Data[offset])))
+			buf.Write(entry[:4])
+			buf.WriteString(data.Data[offset])
+			common.Endian.PutUint64(entry, uint64(offset))
+			buf.Write(entry)
+		}
+	default:
+		return nil, fmt.Errorf("primary key of %T is not supported", pks)
+	}
+	return &Blob{Key: pkOffsetsKey, Value: buf.Bytes()}, nil
+}
+
+// buildPkOffsets returns the PK_OFFSETS index file of the numRows indexed rows, nil if the job doesn't emit it.
+// The primary keys are released then.
+func (it *indexBuildTask) buildPkOffsets(ctx context.Context, numRows int) (*Blob, error) {
+	pks := it.pks
+	it.pks = nil
+	if !it.req.GetEmitPkOffsets() || pks == nil {
+		return nil, nil
+	}
+	if pks.RowNum() != numRows {
+		it.node.storeTaskFailCode(it.ClusterID, it.BuildID, commonpb.ErrorCode_IllegalRowRecord)
+		return nil, fmt.Errorf("%w: the primary key binlogs have %d rows, the vector binlogs have %d",
+			errDataMismatch, pks.RowNum(), numRows)
+	}
+	offsets := make([]int64, numRows)
+	for row := range offsets {
+		offsets[row] = int64(row)
+	}
+	blob, err := serializePkOffsets(pks, offsets)
+	if err != nil {
+		return nil, err
+	}
+	log.Ctx(ctx).Info("IndexNode build pk offsets", zap.Int64("buildID", it.BuildID), zap.Int("numRows", len(offsets)),
+		zap.Int("size", len(blob.Value)))
+	return blob, nil
+}
@@ -0,0 +1,92 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestCheckPkOffsets(t *testing.T) {
+	req := &indexpb.CreateJobRequest{}
+	assert.NoError(t, checkPkOffsets(req))
+	req.EmitPkOffsets = true
+	assert.Error(t, checkPkOffsets(req))
+	req.PkDataPaths = []string{"pk/0"}
+	assert.NoError(t, checkPkOffsets(req))
+}
+
+func TestSerializePkOffsets(t *testing.T) {
+	blob, err := serializePkOffsets(&storage.Int64FieldData{Data: []int64{7, 3, 5}}, []int64{0, 1, 2})
+	assert.NoError(t, err)
+	assert.Equal(t, pkOffsetsKey, blob.Key)
+	assert.Equal(t, []byte{byte(schemapb.DataType_Int64),
+		3, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0,
+		5, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0,
+		7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	}, blob.Value)
+
+	blob, err = serializePkOffsets(&storage.StringFieldData{Data: []string{"b", "a", "c"}}, []int64{0, 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{byte(schemapb.DataType_VarChar),
+		1, 0, 0, 0, 'b', 0, 0, 0, 0, 0, 0, 0, 0,
+		1, 0, 0, 0, 'c', 2, 0, 0, 0, 0, 0, 0, 0,
+	}, blob.Value)
+
+	_, err = serializePkOffsets(&storage.FloatFieldData{Data: []float32{1}}, []int64{0})
+	assert.Error(t, err)
+}
+
+func TestBuildPkOffsets(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	ctx := context.Background()
+	node := &IndexNode{params: params}
+	node.loadOrStoreTask("cluster", 1, &taskInfo{phase: taskLoading})
+	it := &indexBuildTask{
+		ClusterID: "cluster",
+		BuildID:   1,
+		node:      node,
+		req:       &indexpb.CreateJobRequest{},
+		pks:       &storage.Int64FieldData{Data: []int64{10, 11, 12}},
+	}
+	// the primary keys are released without the pk offsets.
+	blob, err := it.buildPkOffsets(ctx, 3)
+	assert.NoError(t, err)
+	assert.Nil(t, blob)
+	assert.Nil(t, it.pks)
+
+	it.req.EmitPkOffsets = true
+	it.pks = &storage.Int64FieldData{Data: []int64{10, 11, 12}}
+	blob, err = it.buildPkOffsets(ctx, 3)
+	assert.NoError(t, err)
+	assert.Len(t, blob.Value, 1+3*16)
+	assert.Nil(t, it.pks)
+
+	it.pks = &storage.Int64FieldData{Data: []int64{10, 11}}
+	_, err = it.buildPkOffsets(ctx, 3)
+	assert.True(t, errors.Is(err, errDataMismatch))
+	assert.Equal(t, commonpb.ErrorCode_IllegalRowRecord, node.tasks.info(taskKey{ClusterID: "cluster", BuildID: 1}).failCode)
+}
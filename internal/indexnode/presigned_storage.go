@@ -0,0 +1,318 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/mmap"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+)
+
+var (
+	errPresignedURLMissing  = errors.New("no pre-signed url")
+	errPresignedUnsupported = errors.New("not supported with pre-signed urls")
+)
+
+// usesPresignedURLs tells whether the objects of the job are accessed through pre-signed urls.
+func usesPresignedURLs(req *indexpb.CreateJobRequest) bool {
+	return len(req.GetPresignedGetUrls()) > 0 || req.GetPresignedUpload() != nil
+}
+
+// checkPresignedJob rejects the jobs with pre-signed urls needing the objects to be listed or deleted,
+// or another storage than the urls.
+func checkPresignedJob(req *indexpb.CreateJobRequest) error {
+	for _, param := range req.GetIndexParams() {
+		// knowhere uploads the files of a disk index with the credentials of the storage config.
+		if param.GetKey() == "index_type" && param.GetValue() == indexparamcheck.IndexDISKANN {
+			return fmt.Errorf("%s index is %w", indexparamcheck.IndexDISKANN, errPresignedUnsupported)
+		}
+	}
+	switch {
+	case len(req.GetStorageRoots()) > 0:
+		return fmt.Errorf("storage roots are %w", errPresignedUnsupported)
+	case req.GetRebuildInPlace():
+		return fmt.Errorf("rebuild in place is %w", errPresignedUnsupported)
+	case req.GetReplicaStorageConfig() != nil:
+		return fmt.Errorf("replica storage is %w", errPresignedUnsupported)
+	case len(req.GetCompactIndexPaths()) > 0:
+		return fmt.Errorf("index compaction is %w", errPresignedUnsupported)
+	}
+	return nil
+}
+
+// newJobChunkManager returns the chunk manager of the pre-signed urls of the job if it has any, the one of
+// its storage config otherwise.
+func (i *IndexNode) newJobChunkManager(ctx context.Context, req *indexpb.CreateJobRequest) (storage.ChunkManager, error) {
+	if usesPresignedURLs(req) {
+		return newPresignedChunkManager(req), nil
+	}
+	return i.storageFactory.NewChunkManager(ctx, req.GetStorageConfig())
+}
+
+// presignedChunkManager reads the objects of a job through the pre-signed GET urls of the job and writes
+// them through its upload grant, so the node never holds the credentials of the storage. It's created per job
+// and never cached, as the urls are scoped to the job and expire. The urls and the form data carry the
+// signatures, so they are never logged or put into the errors, the errors name the paths instead.
+type presignedChunkManager struct {
+	rootPath string
+	getURLs  map[string]string
+	upload   *indexpb.PresignedUpload
+	client   *http.Client
+}
+
+var _ storage.ChunkManager = (*presignedChunkManager)(nil)
+
+func newPresignedChunkManager(req *indexpb.CreateJobRequest) *presignedChunkManager {
+	return &presignedChunkManager{
+		rootPath: req.GetStorageConfig().GetRootPath(),
+		getURLs:  req.GetPresignedGetUrls(),
+		upload:   req.GetPresignedUpload(),
+		client:   http.DefaultClient,
+	}
+}
+
+func (cm *presignedChunkManager) RootPath() string {
+	return cm.rootPath
+}
+
+func (cm *presignedChunkManager) Path(ctx context.Context, filePath string) (string, error) {
+	if _, ok := cm.getURLs[filePath]; !ok {
+		return "", fmt.Errorf("%w to GET %s", errPresignedURLMissing, filePath)
+	}
+	return filePath, nil
+}
+
+// do sends the request of the method for filePath to rawURL, the error of a failed request has the url left out.
+func (cm *presignedChunkManager) do(ctx context.Context, method, rawURL, filePath string, header http.Header, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, body)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pre-signed url to %s %s", method, filePath)
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	resp, err := cm.client.Do(req)
+	if err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return nil, fmt.Errorf("%s %s failed: %w", method, filePath, err)
+	}
+	return resp, nil
+}
+
+// get reads filePath, the whole object if rangeHeader is empty.
+func (cm *presignedChunkManager) get(ctx context.Context, filePath string, rangeHeader string) (*http.Response, error) {
+	header := http.Header{}
+	if rangeHeader != "" {
+		header.Set("Range", rangeHeader)
+	}
+	rawURL, ok := cm.getURLs[filePath]
+	if !ok {
+		return nil, fmt.Errorf("%w to GET %s", errPresignedURLMissing, filePath)
+	}
+	resp, err := cm.do(ctx, http.MethodGet, rawURL, filePath, header, nil)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusPartialContent:
+		return resp, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, storage.WrapErrNoSuchKey(filePath)
+	case http.StatusRequestedRangeNotSatisfiable:
+		// the range of an empty object, its size is in Content-Range.
+		return resp, nil
+	default:
+		resp.Body.Close()
+		return nil, fmt.Errorf("GET %s failed: %s", filePath, resp.Status)
+	}
+}
+
+// Size reads the first byte of the object, as a pre-signed GET url doesn't sign HEAD requests.
+func (cm *presignedChunkManager) Size(ctx context.Context, filePath string) (int64, error) {
+	resp, err := cm.get(ctx, filePath, "bytes=0-0")
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		return resp.ContentLength, nil
+	}
+	// Content-Range is "bytes 0-0/size" or "bytes */size".
+	contentRange := resp.Header.Get("Content-Range")
+	size, err := strconv.ParseInt(contentRange[strings.LastIndex(contentRange, "/")+1:], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("GET %s returned invalid content range %q", filePath, contentRange)
+	}
+	return size, nil
+}
+
+// Write posts the object with the form data of the upload grant, its key must start with the granted prefix.
+func (cm *presignedChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	if cm.upload == nil || !strings.HasPrefix(filePath, cm.upload.GetKeyPrefix()) {
+		return fmt.Errorf("%w to upload %s", errPresignedURLMissing, filePath)
+	}
+	body := &bytes.Buffer{}
+	form := multipart.NewWriter(body)
+	for key, value := range cm.upload.GetFormData() {
+		// the key of the form data is the granted prefix.
+		if key == "key" {
+			continue
+		}
+		if err := form.WriteField(key, value); err != nil {
+			return err
+		}
+	}
+	if err := form.WriteField("key", filePath); err != nil {
+		return err
+	}
+	// the file must be the last field of the form.
+	file, err := form.CreateFormFile("file", path.Base(filePath))
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(content); err != nil {
+		return err
+	}
+	if err := form.Close(); err != nil {
+		return err
+	}
+	header := http.Header{}
+	header.Set("Content-Type", form.FormDataContentType())
+	resp, err := cm.do(ctx, http.MethodPost, cm.upload.GetUrl(), filePath, header, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("POST %s failed: %s", filePath, resp.Status)
+	}
+	return nil
+}
+
+func (cm *presignedChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
+	for filePath, content := range contents {
+		if err := cm.Write(ctx, filePath, content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Exist returns false for a path without a GET url, the job can't see the object.
+func (cm *presignedChunkManager) Exist(ctx context.Context, filePath string) (bool, error) {
+	if _, ok := cm.getURLs[filePath]; !ok {
+		return false, nil
+	}
+	_, err := cm.Size(ctx, filePath)
+	if errors.Is(err, storage.ErrNoSuchKey) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (cm *presignedChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	resp, err := cm.get(ctx, filePath, "")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return io.ReadAll(resp.Body)
+}
+
+func (cm *presignedChunkManager) Reader(ctx context.Context, filePath string) (storage.FileReader, error) {
+	resp, err := cm.get(ctx, filePath, "")
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (cm *presignedChunkManager) MultiRead(ctx context.Context, filePaths []string) ([][]byte, error) {
+	contents := make([][]byte, 0, len(filePaths))
+	for _, filePath := range filePaths {
+		content, err := cm.Read(ctx, filePath)
+		if err != nil {
+			return nil, err
+		}
+		contents = append(contents, content)
+	}
+	return contents, nil
+}
+
+func (cm *presignedChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	if off < 0 || length < 0 {
+		return nil, io.EOF
+	}
+	resp, err := cm.get(ctx, filePath, fmt.Sprintf("bytes=%d-%d", off, off+length-1))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+		return nil, io.EOF
+	}
+	if resp.StatusCode == http.StatusOK {
+		// the server ignored the range.
+		if _, err := io.CopyN(io.Discard, resp.Body, off); err != nil {
+			return nil, err
+		}
+	}
+	return storage.Read(resp.Body, length)
+}
+
+func (cm *presignedChunkManager) ListWithPrefix(ctx context.Context, prefix string, recursive bool) ([]string, []time.Time, error) {
+	return nil, nil, fmt.Errorf("listing %s is %w", prefix, errPresignedUnsupported)
+}
+
+func (cm *presignedChunkManager) ReadWithPrefix(ctx context.Context, prefix string) ([]string, [][]byte, error) {
+	return nil, nil, fmt.Errorf("listing %s is %w", prefix, errPresignedUnsupported)
+}
+
+func (cm *presignedChunkManager) Mmap(ctx context.Context, filePath string) (*mmap.ReaderAt, error) {
+	return nil, fmt.Errorf("mmap of %s is %w", filePath, errPresignedUnsupported)
+}
+
+func (cm *presignedChunkManager) Remove(ctx context.Context, filePath string) error {
+	return fmt.Errorf("removing %s is %w", filePath, errPresignedUnsupported)
+}
+
+func (cm *presignedChunkManager) MultiRemove(ctx context.Context, filePaths []string) error {
+	return fmt.Errorf("removing %d objects is %w", len(filePaths), errPresignedUnsupported)
+}
+
+func (cm *presignedChunkManager) RemoveWithPrefix(ctx context.Context, prefix string) error {
+	return fmt.Errorf("removing %s is %w", prefix, errPresignedUnsupported)
+}
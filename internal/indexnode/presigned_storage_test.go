@@ -0,0 +1,203 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// presignedServer serves the objects of the GET requests signed by the path, and uploads the objects posted
+// with the policy granting uploadPrefix.
+type presignedServer struct {
+	mu           sync.Mutex
+	objects      map[string][]byte
+	uploadPrefix string
+}
+
+func (s *presignedServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch r.Method {
+	case http.MethodPost:
+		file, _, err := r.FormFile("file")
+		key := r.FormValue("key")
+		if err != nil || r.FormValue("policy") != "grant:"+s.uploadPrefix || !strings.HasPrefix(key, s.uploadPrefix) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		content, _ := io.ReadAll(file)
+		s.objects["/"+key] = content
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodGet:
+		if r.URL.Query().Get("signature") != r.Method+r.URL.Path {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		content, ok := s.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		http.ServeContent(w, r, r.URL.Path, time.Time{}, bytes.NewReader(content))
+	}
+}
+
+func presignedURL(server *httptest.Server, method, filePath string) string {
+	return server.URL + "/" + filePath + "?signature=" + method + "/" + filePath
+}
+
+func TestCheckPresignedJob(t *testing.T) {
+	assert.False(t, usesPresignedURLs(&indexpb.CreateJobRequest{}))
+	assert.True(t, usesPresignedURLs(&indexpb.CreateJobRequest{PresignedGetUrls: map[string]string{"a": "url"}}))
+	assert.True(t, usesPresignedURLs(&indexpb.CreateJobRequest{PresignedUpload: &indexpb.PresignedUpload{}}))
+	assert.NoError(t, checkPresignedJob(&indexpb.CreateJobRequest{}))
+	for _, req := range []*indexpb.CreateJobRequest{
+		{StorageRoots: []*indexpb.StorageRoot{{Name: "legacy"}}},
+		{RebuildInPlace: true},
+		{ReplicaStorageConfig: &indexpb.StorageConfig{}},
+		{CompactIndexPaths: []string{"a"}},
+		{IndexParams: []*commonpb.KeyValuePair{{Key: "index_type", Value: "DISKANN"}}},
+	} {
+		assert.ErrorIs(t, checkPresignedJob(req), errPresignedUnsupported)
+	}
+}
+
+func TestPresignedChunkManager(t *testing.T) {
+	ctx := context.Background()
+	objects := &presignedServer{
+		objects:      map[string][]byte{"/root/binlog": []byte("0123456789"), "/root/empty": {}},
+		uploadPrefix: "root/index/",
+	}
+	server := httptest.NewServer(objects)
+	defer server.Close()
+
+	req := &indexpb.CreateJobRequest{
+		StorageConfig: &indexpb.StorageConfig{RootPath: "root"},
+		PresignedGetUrls: map[string]string{
+			"root/binlog":  presignedURL(server, http.MethodGet, "root/binlog"),
+			"root/empty":   presignedURL(server, http.MethodGet, "root/empty"),
+			"root/missing": presignedURL(server, http.MethodGet, "root/missing"),
+			"root/index/1": presignedURL(server, http.MethodGet, "root/index/1"),
+			"root/denied":  presignedURL(server, http.MethodPost, "root/denied"),
+		},
+		PresignedUpload: &indexpb.PresignedUpload{
+			Url:       server.URL,
+			FormData:  map[string]string{"key": "root/index/", "policy": "grant:root/index/"},
+			KeyPrefix: "root/index/",
+		},
+	}
+	cm, err := (&IndexNode{}).newJobChunkManager(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, "root", cm.RootPath())
+
+	t.Run("read", func(t *testing.T) {
+		content, err := cm.Read(ctx, "root/binlog")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("0123456789"), content)
+		contents, err := cm.MultiRead(ctx, []string{"root/binlog", "root/empty"})
+		assert.NoError(t, err)
+		assert.Equal(t, [][]byte{[]byte("0123456789"), {}}, contents)
+		content, err = cm.ReadAt(ctx, "root/binlog", 2, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("234"), content)
+		reader, err := cm.Reader(ctx, "root/binlog")
+		require.NoError(t, err)
+		content, _ = io.ReadAll(reader)
+		reader.Close()
+		assert.Equal(t, []byte("0123456789"), content)
+
+		size, err := cm.Size(ctx, "root/binlog")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(10), size)
+		size, err = cm.Size(ctx, "root/empty")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), size)
+
+		exist, err := cm.Exist(ctx, "root/binlog")
+		assert.NoError(t, err)
+		assert.True(t, exist)
+		exist, err = cm.Exist(ctx, "root/missing")
+		assert.NoError(t, err)
+		assert.False(t, exist)
+		// the objects without urls are invisible to the job.
+		exist, err = cm.Exist(ctx, "root/unsigned")
+		assert.NoError(t, err)
+		assert.False(t, exist)
+	})
+
+	t.Run("write", func(t *testing.T) {
+		// the keys under the granted prefix are known only once written.
+		assert.NoError(t, cm.MultiWrite(ctx, map[string][]byte{"root/index/1": []byte("index"), "root/index/2": {}}))
+		content, err := cm.Read(ctx, "root/index/1")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("index"), content)
+		assert.Equal(t, []byte{}, objects.objects["/root/index/2"])
+		assert.ErrorIs(t, cm.Write(ctx, "root/binlog", []byte("overwrite")), errPresignedURLMissing)
+		assert.ErrorIs(t, newPresignedChunkManager(&indexpb.CreateJobRequest{}).Write(ctx, "root/index/1", nil), errPresignedURLMissing)
+
+		// an upload refused by the storage fails and has the form data left out.
+		denied := newPresignedChunkManager(&indexpb.CreateJobRequest{PresignedUpload: &indexpb.PresignedUpload{
+			Url:       server.URL,
+			FormData:  map[string]string{"policy": "signature"},
+			KeyPrefix: "root/",
+		}})
+		err = denied.Write(ctx, "root/index/3", []byte("index"))
+		assert.Error(t, err)
+		assert.NotContains(t, err.Error(), "signature")
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := cm.Read(ctx, "root/missing")
+		assert.ErrorIs(t, err, storage.ErrNoSuchKey)
+		_, err = cm.Read(ctx, "root/unsigned")
+		assert.ErrorIs(t, err, errPresignedURLMissing)
+		_, err = cm.Path(ctx, "root/unsigned")
+		assert.ErrorIs(t, err, errPresignedURLMissing)
+		// the errors never carry the signatures.
+		_, err = cm.Read(ctx, "root/denied")
+		assert.Error(t, err)
+		assert.NotContains(t, err.Error(), "signature")
+
+		closed := httptest.NewServer(objects)
+		closed.Close()
+		offline := newPresignedChunkManager(&indexpb.CreateJobRequest{PresignedGetUrls: map[string]string{
+			"root/binlog": presignedURL(closed, http.MethodGet, "root/binlog")}})
+		_, err = offline.Read(ctx, "root/binlog")
+		assert.Error(t, err)
+		assert.NotContains(t, err.Error(), "signature")
+
+		_, _, err = cm.ListWithPrefix(ctx, "root/", true)
+		assert.ErrorIs(t, err, errPresignedUnsupported)
+		assert.ErrorIs(t, cm.RemoveWithPrefix(ctx, "root/"), errPresignedUnsupported)
+		assert.ErrorIs(t, cm.Remove(ctx, "root/index/1"), errPresignedUnsupported)
+	})
+}
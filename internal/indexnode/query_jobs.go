@@ -0,0 +1,141 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"sort"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+)
+
+// matchTaskInfo reports whether the task matches the filters of the QueryJobs request.
+func matchTaskInfo(info *taskInfo, req *indexpb.QueryJobsRequest) bool {
+	if len(req.GetStates()) > 0 {
+		matched := false
+		for _, state := range req.GetStates() {
+			if state == info.phase.indexState() {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if req.GetCollectionID() != 0 && info.collectionID != req.GetCollectionID() {
+		return false
+	}
+	createTime := info.startTime.UnixMilli()
+	if req.GetStartTime() != 0 && createTime < req.GetStartTime() {
+		return false
+	}
+	if req.GetEndTime() != 0 && createTime > req.GetEndTime() {
+		return false
+	}
+	return true
+}
+
+// listTaskInfos returns the page of the tasks matching the filters of req in the ascending order of buildID,
+// and the number of the matched tasks.
+func listTaskInfos(infos map[UniqueID]*taskInfo, req *indexpb.QueryJobsRequest) ([]*indexpb.IndexTaskInfo, int64) {
+	buildIDs := make([]UniqueID, 0, len(infos))
+	for buildID, info := range infos {
+		if matchTaskInfo(info, req) {
+			buildIDs = append(buildIDs, buildID)
+		}
+	}
+	sort.Slice(buildIDs, func(i, j int) bool { return buildIDs[i] < buildIDs[j] })
+
+	total := int64(len(buildIDs))
+	offset := req.GetOffset()
+	if offset < 0 {
+		offset = 0
+	}
+	if offset > total {
+		offset = total
+	}
+	end := total
+	if req.GetLimit() > 0 && offset+req.GetLimit() < total {
+		end = offset + req.GetLimit()
+	}
+
+	ret := make([]*indexpb.IndexTaskInfo, 0, end-offset)
+	for _, buildID := range buildIDs[offset:end] {
+		info := infos[buildID]
+		ret = append(ret, &indexpb.IndexTaskInfo{
+			BuildID:         buildID,
+			State:           info.phase.indexState(),
+			IndexFileKeys:   info.fileKeys,
+			IndexFileSizes:  info.fileSizes,
+			SerializedSize:  info.serializedSize,
+			MemSize:         info.memSize,
+			BruteForce:      info.bruteForce,
+			FailReason:      info.failReason,
+			FailCode:        info.failCode,
+			FailureDomain:   info.failureDomain,
+			Warnings:        info.warnings,
+			AwaitingRestore: info.phase == taskAwaitingRestore,
+		})
+	}
+	return ret, total
+}
+
+// queuedJob is the position of a queued build job in the queue of the node and its estimated time to finish.
+type queuedJob struct {
+	position int
+	priority int32
+	eta      time.Duration
+}
+
+// queuedJobs returns the queued build jobs of the cluster by their buildIDs. The running tasks and the tasks issued
+// before a job are ahead of it, the job finishes after the waves of them through the build slots and its own run.
+// The runs are estimated by the build throughput baselines.
+func (sched *TaskScheduler) queuedJobs(clusterID string) map[UniqueID]queuedJob {
+	order := sched.IndexBuildQueue.issueOrder()
+	_, active := sched.IndexBuildQueue.GetTaskNum()
+	meanCost := sched.meanCost()
+	parallel := sched.getBuildParallel()
+	jobs := make(map[UniqueID]queuedJob)
+	for position, t := range order {
+		it, ok := t.(*indexBuildTask)
+		if !ok || it.ClusterID != clusterID || it.req == nil {
+			continue
+		}
+		cost, ok := sched.baselines.estimateJob(it.req)
+		if !ok {
+			cost = meanCost
+		}
+		jobs[it.BuildID] = queuedJob{
+			position: position + 1,
+			priority: it.req.GetPriority(),
+			eta:      estimateWait(active+position, parallel, meanCost) + cost,
+		}
+	}
+	return jobs
+}
+
+// setQueuedJobs fills the queue positions and the ETAs of the queued tasks.
+func setQueuedJobs(infos []*indexpb.IndexTaskInfo, queued map[UniqueID]queuedJob) {
+	for _, info := range infos {
+		if job, ok := queued[info.GetBuildID()]; ok {
+			info.QueuePosition = int64(job.position)
+			info.Priority = job.priority
+			info.EtaMs = job.eta.Milliseconds()
+		}
+	}
+}
@@ -0,0 +1,95 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+)
+
+func TestListTaskInfos(t *testing.T) {
+	now := time.Now()
+	infos := map[UniqueID]*taskInfo{
+		1: {phase: taskFinished, collectionID: 100, startTime: now.Add(-time.Hour)},
+		2: {phase: taskFailed, collectionID: 100, startTime: now.Add(-time.Minute), failReason: "failed"},
+		3: {phase: taskBuilding, collectionID: 200, startTime: now},
+		4: {phase: taskFinished, collectionID: 200, startTime: now},
+	}
+	buildIDsOf := func(ret []*indexpb.IndexTaskInfo) []UniqueID {
+		buildIDs := make([]UniqueID, 0, len(ret))
+		for _, info := range ret {
+			buildIDs = append(buildIDs, info.GetBuildID())
+		}
+		return buildIDs
+	}
+
+	t.Run("no filter", func(t *testing.T) {
+		ret, total := listTaskInfos(infos, &indexpb.QueryJobsRequest{})
+		assert.Equal(t, int64(4), total)
+		assert.Equal(t, []UniqueID{1, 2, 3, 4}, buildIDsOf(ret))
+		assert.Equal(t, "failed", ret[1].GetFailReason())
+		assert.Equal(t, commonpb.IndexState_InProgress, ret[2].GetState())
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		ret, total := listTaskInfos(infos, &indexpb.QueryJobsRequest{States: []commonpb.IndexState{commonpb.IndexState_Finished}})
+		assert.Equal(t, int64(2), total)
+		assert.Equal(t, []UniqueID{1, 4}, buildIDsOf(ret))
+
+		ret, _ = listTaskInfos(infos, &indexpb.QueryJobsRequest{CollectionID: 200})
+		assert.Equal(t, []UniqueID{3, 4}, buildIDsOf(ret))
+
+		ret, _ = listTaskInfos(infos, &indexpb.QueryJobsRequest{
+			StartTime: now.Add(-2 * time.Minute).UnixMilli(),
+			EndTime:   now.Add(-time.Second).UnixMilli(),
+		})
+		assert.Equal(t, []UniqueID{2}, buildIDsOf(ret))
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		ret, total := listTaskInfos(infos, &indexpb.QueryJobsRequest{Offset: 1, Limit: 2})
+		assert.Equal(t, int64(4), total)
+		assert.Equal(t, []UniqueID{2, 3}, buildIDsOf(ret))
+
+		ret, _ = listTaskInfos(infos, &indexpb.QueryJobsRequest{Offset: 3, Limit: 2})
+		assert.Equal(t, []UniqueID{4}, buildIDsOf(ret))
+
+		ret, total = listTaskInfos(infos, &indexpb.QueryJobsRequest{Offset: 10})
+		assert.Equal(t, int64(4), total)
+		assert.Empty(t, ret)
+	})
+}
+
+func TestSetQueuedJobs(t *testing.T) {
+	infos := []*indexpb.IndexTaskInfo{
+		{BuildID: 1, State: commonpb.IndexState_InProgress},
+		{BuildID: 2, State: commonpb.IndexState_Finished},
+	}
+	setQueuedJobs(infos, map[UniqueID]queuedJob{
+		1: {position: 3, priority: 5, eta: 90 * time.Second},
+	})
+	assert.Equal(t, int64(3), infos[0].GetQueuePosition())
+	assert.Equal(t, int32(5), infos[0].GetPriority())
+	assert.Equal(t, int64(90000), infos[0].GetEtaMs())
+	assert.Zero(t, infos[1].GetQueuePosition())
+	assert.Zero(t, infos[1].GetEtaMs())
+}
@@ -0,0 +1,135 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/retry"
+)
+
+// nodeIDFile keeps the server id of the last run of the node under the local storage path, the
+// session gets a new id on every restart, so it's the only way to find the jobs of the previous run.
+const nodeIDFile = "index_node_id"
+
+const reconcileFailReason = "IndexNode restarted"
+
+// recordNodeID saves nodeID into the file under dir and returns the id saved by the previous run,
+// 0 if the node never saved one.
+func recordNodeID(dir string, nodeID UniqueID) (UniqueID, error) {
+	filePath := path.Join(dir, nodeIDFile)
+	var previousID UniqueID
+	content, err := os.ReadFile(filePath)
+	switch {
+	case err == nil:
+		previousID, err = strconv.ParseInt(strings.TrimSpace(string(content)), 10, 64)
+		if err != nil {
+			return 0, err
+		}
+	case !os.IsNotExist(err):
+		return 0, err
+	}
+
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return 0, err
+	}
+	if err := os.WriteFile(filePath, []byte(strconv.FormatInt(nodeID, 10)), 0o644); err != nil {
+		return 0, err
+	}
+	return previousID, nil
+}
+
+// reconcileJobs asks DataCoord for the jobs still assigned to previousID. The jobs the node still
+// runs are kept, the others are reported as Retry so DataCoord reassigns them right away instead of
+// waiting for the session of the previous run to expire.
+func (i *IndexNode) reconcileJobs(ctx context.Context, clusterID string, previousID UniqueID) {
+	dataCoord := i.reporter.dataCoord
+	if dataCoord == nil {
+		log.Ctx(ctx).Warn("IndexNode skip reconciling jobs, DataCoord is not set", zap.Int64("previousID", previousID))
+		return
+	}
+
+	var buildIDs []UniqueID
+	err := retry.Do(ctx, func() error {
+		resp, err := dataCoord.ListNodeJobs(ctx, &indexpb.ListNodeJobsRequest{
+			ClusterID: clusterID,
+			NodeID:    previousID,
+		})
+		if err != nil {
+			return err
+		}
+		if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return errors.New(resp.GetStatus().GetReason())
+		}
+		buildIDs = resp.GetBuildIDs()
+		return nil
+	}, retry.Sleep(time.Second))
+	if err != nil {
+		log.Ctx(ctx).Warn("IndexNode list jobs of the previous run failed, leave them to DataCoord",
+			zap.Int64("previousID", previousID), zap.Error(err))
+		return
+	}
+
+	disowned := make([]*indexpb.IndexTaskInfo, 0, len(buildIDs))
+	for _, buildID := range buildIDs {
+		if i.loadTaskState(clusterID, buildID) != commonpb.IndexState_IndexStateNone {
+			log.Ctx(ctx).Info("IndexNode re-adopt job of the previous run", zap.Int64("buildID", buildID))
+			continue
+		}
+		disowned = append(disowned, &indexpb.IndexTaskInfo{
+			BuildID:    buildID,
+			State:      commonpb.IndexState_Retry,
+			FailReason: reconcileFailReason,
+		})
+	}
+	if len(disowned) == 0 {
+		return
+	}
+
+	err = retry.Do(ctx, func() error {
+		status, err := dataCoord.ReportJobResults(ctx, &indexpb.ReportJobResultsRequest{
+			ClusterID:  clusterID,
+			NodeID:     previousID,
+			IndexInfos: disowned,
+		})
+		if err != nil {
+			return err
+		}
+		if status.GetErrorCode() != commonpb.ErrorCode_Success {
+			return errors.New(status.GetReason())
+		}
+		return nil
+	}, retry.Sleep(time.Second))
+	if err != nil {
+		log.Ctx(ctx).Warn("IndexNode disown jobs of the previous run failed, leave them to DataCoord",
+			zap.Int64("previousID", previousID), zap.Int("jobNum", len(disowned)), zap.Error(err))
+		return
+	}
+	log.Ctx(ctx).Info("IndexNode disowned jobs of the previous run", zap.Int64("previousID", previousID),
+		zap.Int("jobNum", len(disowned)))
+}
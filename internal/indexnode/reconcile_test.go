@@ -0,0 +1,113 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+type reconcileDataCoord struct {
+	reportDataCoord
+
+	listErr  error
+	buildIDs []UniqueID
+	listed   *indexpb.ListNodeJobsRequest
+}
+
+func (c *reconcileDataCoord) ListNodeJobs(ctx context.Context, req *indexpb.ListNodeJobsRequest) (*indexpb.ListNodeJobsResponse, error) {
+	c.listed = req
+	if c.listErr != nil {
+		return nil, c.listErr
+	}
+	return &indexpb.ListNodeJobsResponse{
+		Status:   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		BuildIDs: c.buildIDs,
+	}, nil
+}
+
+func TestRecordNodeID(t *testing.T) {
+	dir := t.TempDir()
+
+	previousID, err := recordNodeID(dir, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(0), previousID)
+
+	previousID, err = recordNodeID(dir, 11)
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(10), previousID)
+
+	previousID, err = recordNodeID(dir, 12)
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(11), previousID)
+}
+
+func TestReconcileJobs(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	ctx := context.Background()
+
+	t.Run("disown and re-adopt", func(t *testing.T) {
+		i := &IndexNode{params: params, reporter: newJobResultReporter(params)}
+		i.loadOrStoreTask("cluster", 2, &taskInfo{})
+		dc := &reconcileDataCoord{buildIDs: []UniqueID{1, 2, 3}}
+		i.reporter.dataCoord = dc
+
+		i.reconcileJobs(ctx, "cluster", 7)
+		assert.Equal(t, UniqueID(7), dc.listed.GetNodeID())
+		assert.Equal(t, "cluster", dc.listed.GetClusterID())
+		assert.Equal(t, 1, dc.reportedNum())
+		req := dc.reported[0]
+		assert.Equal(t, UniqueID(7), req.GetNodeID())
+		assert.Equal(t, 2, len(req.GetIndexInfos()))
+		for _, info := range req.GetIndexInfos() {
+			assert.NotEqual(t, UniqueID(2), info.GetBuildID())
+			assert.Equal(t, commonpb.IndexState_Retry, info.GetState())
+		}
+	})
+
+	t.Run("nothing to disown", func(t *testing.T) {
+		i := &IndexNode{params: params, reporter: newJobResultReporter(params)}
+		dc := &reconcileDataCoord{}
+		i.reporter.dataCoord = dc
+
+		i.reconcileJobs(ctx, "cluster", 7)
+		assert.Equal(t, 0, dc.reportedNum())
+	})
+
+	t.Run("list failed", func(t *testing.T) {
+		i := &IndexNode{params: params, reporter: newJobResultReporter(params)}
+		dc := &reconcileDataCoord{listErr: errors.New("mock error"), buildIDs: []UniqueID{1}}
+		i.reporter.dataCoord = dc
+
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		i.reconcileJobs(ctx, "cluster", 7)
+		assert.Equal(t, 0, dc.reportedNum())
+	})
+
+	t.Run("without datacoord", func(t *testing.T) {
+		i := &IndexNode{params: params, reporter: newJobResultReporter(params)}
+		i.reconcileJobs(ctx, "cluster", 7)
+	})
+}
@@ -0,0 +1,212 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/contextutil"
+)
+
+// errIndexFileRemoved is returned when the index file to copy is removed from the primary storage, e.g. by the
+// garbage collection of a dropped index.
+var errIndexFileRemoved = errors.New("index file removed")
+
+// replicaJob is the copy of the index files of a job to its replica storage.
+type replicaJob struct {
+	primary *indexpb.StorageConfig
+	replica *indexpb.StorageConfig
+	// paths are the index files not copied yet.
+	paths    []string
+	attempts int
+	next     time.Time
+}
+
+// indexReplicator copies the saved index files of the jobs with a replica storage to the replica asynchronously,
+// the build doesn't wait for the copy. The jobs whose copy fails are reconciled every
+// indexNode.replication.reconcileInterval, the files already copied are skipped, and given up after
+// indexNode.replication.maxAttempts.
+type indexReplicator struct {
+	node *IndexNode
+
+	mu      sync.Mutex
+	pending map[taskKey]*replicaJob
+
+	notifyChan chan struct{}
+	wg         sync.WaitGroup
+}
+
+func newIndexReplicator(node *IndexNode) *indexReplicator {
+	return &indexReplicator{
+		node:       node,
+		pending:    make(map[taskKey]*replicaJob),
+		notifyChan: make(chan struct{}, 1),
+	}
+}
+
+// Start starts the replication loop, it exits when ctx is done.
+func (r *indexReplicator) Start(ctx context.Context) {
+	r.wg.Add(1)
+	go r.loop(ctx)
+}
+
+// Close waits for the replication loop to exit.
+func (r *indexReplicator) Close() {
+	r.wg.Wait()
+}
+
+// enqueue queues the copy of the saved index files of the job if it has a replica storage, it never blocks.
+func (r *indexReplicator) enqueue(key taskKey, req *indexpb.CreateJobRequest, savePaths []string) {
+	if r == nil || req.GetReplicaStorageConfig() == nil || len(savePaths) == 0 {
+		return
+	}
+	r.mu.Lock()
+	r.pending[key] = &replicaJob{
+		primary: req.GetStorageConfig(),
+		replica: req.GetReplicaStorageConfig(),
+		paths:   append([]string{}, savePaths...),
+	}
+	r.mu.Unlock()
+
+	select {
+	case r.notifyChan <- struct{}{}:
+	default:
+	}
+}
+
+// pendingNum returns the number of the jobs not replicated yet.
+func (r *indexReplicator) pendingNum() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.pending)
+}
+
+func (r *indexReplicator) loop(ctx context.Context) {
+	defer r.wg.Done()
+	ticker := time.NewTicker(r.node.params.IndexNodeCfg.ReplicationReconcileInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-r.notifyChan:
+		case <-ticker.C:
+		}
+		r.reconcile(ctx)
+	}
+}
+
+// reconcile copies the jobs due, the jobs failing are retried after indexNode.replication.reconcileInterval.
+func (r *indexReplicator) reconcile(ctx context.Context) {
+	now := time.Now()
+	r.mu.Lock()
+	due := make(map[taskKey]*replicaJob)
+	for key, job := range r.pending {
+		if !job.next.After(now) {
+			due[key] = job
+		}
+	}
+	r.mu.Unlock()
+
+	for key, job := range due {
+		err := r.replicate(ctx, key, job)
+		if ctx.Err() != nil {
+			return
+		}
+		r.mu.Lock()
+		// the job may be enqueued again by a rebuild meanwhile
+		if r.pending[key] != job {
+			r.mu.Unlock()
+			continue
+		}
+		job.attempts++
+		logger := log.Ctx(ctx).With(zap.String("ClusterID", key.ClusterID), zap.Int64("buildID", key.BuildID))
+		switch {
+		case err == nil:
+			delete(r.pending, key)
+			logger.Info("IndexNode replicated the index files", zap.Int("attempts", job.attempts))
+		case errors.Is(err, errIndexFileRemoved):
+			delete(r.pending, key)
+			logger.Warn("index files removed before replicated, skip the replication", zap.Error(err))
+		case job.attempts >= r.node.params.IndexNodeCfg.ReplicationMaxAttempts.GetAsInt():
+			delete(r.pending, key)
+			logger.Warn("IndexNode gave up replicating the index files", zap.Int("attempts", job.attempts),
+				zap.Strings("missed", job.paths), zap.Error(err))
+		default:
+			job.next = time.Now().Add(r.node.params.IndexNodeCfg.ReplicationReconcileInterval.GetAsDuration(time.Second))
+			logger.Warn("IndexNode replicate index files failed, reconcile later", zap.Int("attempts", job.attempts),
+				zap.Int("missed", len(job.paths)), zap.Error(err))
+		}
+		r.mu.Unlock()
+	}
+}
+
+// replicate copies the index files of the job not copied yet, the files in the replica with the same size
+// as in the primary storage are taken as copied. The copied files are removed from the paths of the job.
+func (r *indexReplicator) replicate(ctx context.Context, key taskKey, job *replicaJob) error {
+	ctx = contextutil.WithClusterID(ctx, key.ClusterID)
+	primary, err := r.node.storageFactory.NewChunkManager(ctx, job.primary)
+	if err != nil {
+		return err
+	}
+	replica, err := r.node.storageFactory.NewChunkManager(ctx, job.replica)
+	if err != nil {
+		return err
+	}
+	for len(job.paths) > 0 {
+		if err := copyIndexFile(ctx, primary, replica, job.paths[0]); err != nil {
+			return err
+		}
+		job.paths = job.paths[1:]
+	}
+	return nil
+}
+
+// copyIndexFile copies the index file to the same path relative to the root path in the replica.
+func copyIndexFile(ctx context.Context, primary, replica storage.ChunkManager, filePath string) error {
+	replicaPath := path.Join(replica.RootPath(), strings.TrimPrefix(strings.TrimPrefix(filePath, primary.RootPath()), "/"))
+	exist, err := primary.Exist(ctx, filePath)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return fmt.Errorf("%w: %s", errIndexFileRemoved, filePath)
+	}
+	size, err := primary.Size(ctx, filePath)
+	if err != nil {
+		return err
+	}
+	if replicaSize, err := replica.Size(ctx, replicaPath); err == nil && replicaSize == size {
+		return nil
+	}
+	data, err := primary.Read(ctx, filePath)
+	if err != nil {
+		return err
+	}
+	return replica.Write(ctx, replicaPath, data)
+}
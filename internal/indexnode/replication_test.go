@@ -0,0 +1,109 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// bucketStorageFactory returns the chunk manager of the bucket of the config.
+type bucketStorageFactory struct {
+	buckets map[string]storage.ChunkManager
+}
+
+func (f *bucketStorageFactory) NewChunkManager(_ context.Context, config *indexpb.StorageConfig) (storage.ChunkManager, error) {
+	if cm, ok := f.buckets[config.GetBucketName()]; ok {
+		return cm, nil
+	}
+	return nil, errors.New("bucket unavailable")
+}
+
+func TestIndexReplicator(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	ctx := context.Background()
+	primary := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	replica := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	factory := &bucketStorageFactory{buckets: map[string]storage.ChunkManager{"primary": primary}}
+	node := &IndexNode{params: params, storageFactory: factory}
+
+	filePath := path.Join(primary.RootPath(), "index_files/1/1/2/3/HNSW")
+	require.NoError(t, primary.Write(ctx, filePath, []byte("index")))
+	req := &indexpb.CreateJobRequest{
+		StorageConfig:        &indexpb.StorageConfig{BucketName: "primary"},
+		ReplicaStorageConfig: &indexpb.StorageConfig{BucketName: "replica"},
+	}
+	key := taskKey{ClusterID: "cluster", BuildID: 1}
+
+	t.Run("no replica", func(t *testing.T) {
+		r := newIndexReplicator(node)
+		r.enqueue(key, &indexpb.CreateJobRequest{StorageConfig: req.GetStorageConfig()}, []string{filePath})
+		assert.Equal(t, 0, r.pendingNum())
+
+		var nilReplicator *indexReplicator
+		nilReplicator.enqueue(key, req, []string{filePath})
+	})
+
+	t.Run("reconcile", func(t *testing.T) {
+		r := newIndexReplicator(node)
+		r.enqueue(key, req, []string{filePath})
+		r.reconcile(ctx)
+		// the replica is unavailable, the job is reconciled later
+		assert.Equal(t, 1, r.pendingNum())
+		assert.Equal(t, 1, r.pending[key].attempts)
+		r.reconcile(ctx)
+		assert.Equal(t, 1, r.pending[key].attempts)
+
+		factory.buckets["replica"] = replica
+		defer delete(factory.buckets, "replica")
+		r.pending[key].next = r.pending[key].next.AddDate(0, 0, -1)
+		r.reconcile(ctx)
+		assert.Equal(t, 0, r.pendingNum())
+		data, err := replica.Read(ctx, path.Join(replica.RootPath(), "index_files/1/1/2/3/HNSW"))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("index"), data)
+	})
+
+	t.Run("give up", func(t *testing.T) {
+		params.Save(params.IndexNodeCfg.ReplicationMaxAttempts.Key, "1")
+		defer params.Reset(params.IndexNodeCfg.ReplicationMaxAttempts.Key)
+
+		r := newIndexReplicator(node)
+		r.enqueue(key, req, []string{filePath})
+		r.reconcile(ctx)
+		assert.Equal(t, 0, r.pendingNum())
+	})
+
+	t.Run("removed", func(t *testing.T) {
+		factory.buckets["replica"] = replica
+		defer delete(factory.buckets, "replica")
+
+		r := newIndexReplicator(node)
+		r.enqueue(key, req, []string{path.Join(primary.RootPath(), "index_files/1/1/2/3/removed")})
+		r.reconcile(ctx)
+		assert.Equal(t, 0, r.pendingNum())
+	})
+}
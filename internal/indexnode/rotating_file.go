@@ -0,0 +1,101 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"os"
+	"path"
+	"sync"
+)
+
+// rotatingFile is a local file appended without buffering, so the writes survive the process being killed.
+// It rotates once it exceeds maxSize keeping one old file with the suffix ".1".
+type rotatingFile struct {
+	mu      sync.Mutex
+	dir     string
+	name    string
+	maxSize int64
+	file    *os.File
+	size    int64
+}
+
+func openRotatingFile(dir string, name string, maxSize int64) (*rotatingFile, error) {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	f := &rotatingFile{dir: dir, name: name, maxSize: maxSize}
+	if err := f.openFile(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *rotatingFile) openFile() error {
+	file, err := os.OpenFile(path.Join(f.dir, f.name), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	stat, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	f.file, f.size = file, stat.Size()
+	return nil
+}
+
+func (f *rotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+	filePath := path.Join(f.dir, f.name)
+	if err := os.Rename(filePath, filePath+".1"); err != nil {
+		return err
+	}
+	return f.openFile()
+}
+
+// write appends buf to the file, rotating the file first if buf doesn't fit, a failed rotation is returned
+// even if buf is appended to the old file. It's a no-op after Close.
+func (f *rotatingFile) write(buf []byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.file == nil {
+		return nil
+	}
+	var rotateErr error
+	if f.size > 0 && f.size+int64(len(buf)) > f.maxSize {
+		if rotateErr = f.rotate(); rotateErr != nil && f.file == nil {
+			return rotateErr
+		}
+	}
+	n, err := f.file.Write(buf)
+	f.size += int64(n)
+	if err != nil {
+		return err
+	}
+	return rotateErr
+}
+
+func (f *rotatingFile) Close() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.file != nil {
+		f.file.Close()
+		f.file = nil
+	}
+}
@@ -0,0 +1,79 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"math"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+// desiredReplicas returns the number of replicas to finish the queued and running jobs within the wait target,
+// every replica running slots jobs at a time, each taking meanRun. It asks for one more replica if the node
+// has queued jobs and less cpu or memory headroom than minHeadroom, as the slots it has may not be usable.
+// It returns 0 if the node has no job.
+func desiredReplicas(queued, running, slots int, meanRun, waitTarget time.Duration, cpuHeadroom, memoryHeadroom, minHeadroom float64) int {
+	jobs := queued + running
+	if jobs == 0 {
+		return 0
+	}
+	if slots <= 0 {
+		slots = 1
+	}
+	// a replica runs at least one wave of its slots within the target.
+	window := waitTarget
+	if window < meanRun {
+		window = meanRun
+	}
+	replicas := 1
+	if window > 0 {
+		replicas = int(math.Ceil(float64(jobs) * float64(meanRun) / (float64(slots) * float64(window))))
+		if replicas < 1 {
+			replicas = 1
+		}
+	}
+	if queued > 0 && (cpuHeadroom < minHeadroom || memoryHeadroom < minHeadroom) {
+		replicas++
+	}
+	return replicas
+}
+
+// scalingHint returns the scaling hint of the node with the hardware usage for the external autoscalers.
+func (sched *TaskScheduler) scalingHint(hardware metricsinfo.HardwareMetrics) *metricsinfo.IndexNodeScalingHint {
+	queued, running := sched.IndexBuildQueue.GetTaskNum()
+	slots := sched.getBuildParallel()
+	meanRun := sched.waits.meanRun()
+	meanCost := sched.meanCost()
+	cpuHeadroom := 100 - hardware.CPUCoreUsage
+	memoryHeadroom := 100.0
+	if hardware.Memory > 0 {
+		memoryHeadroom = 100 - float64(hardware.MemoryUsage)*100/float64(hardware.Memory)
+	}
+	return &metricsinfo.IndexNodeScalingHint{
+		DesiredReplicas: desiredReplicas(queued, running, slots, meanCost,
+			sched.params.IndexNodeCfg.SchedulerWaitTarget.GetAsDuration(time.Second), cpuHeadroom, memoryHeadroom,
+			sched.params.IndexNodeCfg.AutoscaleMinHeadroom.GetAsFloat()),
+		QueuedJobs:                queued,
+		RunningJobs:               running,
+		TaskSlots:                 slots,
+		MeanBuildMilliseconds:     meanRun.Milliseconds(),
+		EstimatedWaitMilliseconds: estimateWait(queued+running, slots, meanCost).Milliseconds(),
+		CPUHeadroom:               cpuHeadroom,
+		MemoryHeadroom:            memoryHeadroom,
+	}
+}
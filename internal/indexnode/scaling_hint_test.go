@@ -0,0 +1,57 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestDesiredReplicas(t *testing.T) {
+	assert.Equal(t, 0, desiredReplicas(0, 0, 2, time.Minute, time.Minute, 50, 50, 10))
+	// no build has finished yet
+	assert.Equal(t, 1, desiredReplicas(10, 2, 2, 0, time.Minute, 50, 50, 10))
+	assert.Equal(t, 1, desiredReplicas(0, 2, 2, time.Minute, time.Minute, 50, 50, 10))
+	// 12 jobs of 1 minute drain in 3 minutes on 4 slots, a replica with 2 slots runs 2 of them in the 1 minute target
+	assert.Equal(t, 6, desiredReplicas(10, 2, 2, time.Minute, time.Minute, 50, 50, 10))
+	assert.Equal(t, 2, desiredReplicas(10, 2, 2, time.Minute, 3*time.Minute, 50, 50, 10))
+	// the builds longer than the target can't be sped up by more replicas than the jobs
+	assert.Equal(t, 6, desiredReplicas(10, 2, 2, 10*time.Minute, time.Minute, 50, 50, 10))
+	assert.Equal(t, 12, desiredReplicas(10, 2, 0, 10*time.Minute, time.Minute, 50, 50, 10))
+	// a node short of headroom asks for one more replica if it has queued jobs
+	assert.Equal(t, 3, desiredReplicas(10, 2, 2, time.Minute, 3*time.Minute, 5, 50, 10))
+	assert.Equal(t, 3, desiredReplicas(10, 2, 2, time.Minute, 3*time.Minute, 50, 5, 10))
+	assert.Equal(t, 1, desiredReplicas(0, 2, 2, time.Minute, time.Minute, 5, 5, 10))
+}
+
+func TestScalingHint(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	sched := NewTaskScheduler(context.TODO(), params)
+	sched.waits.observeRun(2 * time.Minute)
+	hint := sched.scalingHint(metricsinfo.HardwareMetrics{CPUCoreUsage: 30, Memory: 100, MemoryUsage: 80})
+	assert.Equal(t, 0, hint.DesiredReplicas)
+	assert.Equal(t, int64(120000), hint.MeanBuildMilliseconds)
+	assert.Equal(t, 70.0, hint.CPUHeadroom)
+	assert.Equal(t, 20.0, hint.MemoryHeadroom)
+	assert.Equal(t, params.IndexNodeCfg.BuildParallel.GetAsInt(), hint.TaskSlots)
+}
@@ -0,0 +1,186 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// snapshotFormatKey is the key of the output format in the scheduler snapshot request, "json" or "dot".
+const snapshotFormatKey = "format"
+
+// taskSnapshot is a queued or running task in the scheduler snapshot.
+type taskSnapshot struct {
+	Name   string `json:"name"`
+	Tenant string `json:"tenant"`
+	// Phase is the stage of a running task.
+	Phase string `json:"phase,omitempty"`
+	// Wait is how long a queued task has been waiting for a build slot.
+	Wait string `json:"wait,omitempty"`
+	// Elapsed is how long ago a running task was received.
+	Elapsed   string `json:"elapsed,omitempty"`
+	HoldsSlot bool   `json:"holds_slot"`
+}
+
+// tenantSnapshot is the fair share state of a tenant with queued tasks.
+type tenantSnapshot struct {
+	Tenant string  `json:"tenant"`
+	Queued int     `json:"queued"`
+	Pass   float64 `json:"pass"`
+	Weight float64 `json:"weight"`
+}
+
+// schedulerSnapshot dumps the scheduler internals for the support tooling.
+type schedulerSnapshot struct {
+	BuildParallel int              `json:"build_parallel"`
+	VirtualTime   float64          `json:"virtual_time"`
+	Queued        []taskSnapshot   `json:"queued"`
+	Running       []taskSnapshot   `json:"running"`
+	Tenants       []tenantSnapshot `json:"tenants"`
+	// Slots are the tasks holding build slots, a reaped task no longer holds its slot while still running.
+	Slots []string `json:"slots"`
+}
+
+// snapshotScheduler takes a snapshot of the scheduler, the queue, the slots and the tasks are locked one by one,
+// so the snapshot may be slightly inconsistent if the tasks move meanwhile.
+func (i *IndexNode) snapshotScheduler() *schedulerSnapshot {
+	now := time.Now()
+	queued, tenants, virtualTime := i.sched.IndexBuildQueue.snapshot(now)
+	slots := i.sched.slotNames()
+	sort.Strings(slots)
+	holdsSlot := make(map[string]bool, len(slots))
+	for _, name := range slots {
+		holdsSlot[name] = true
+	}
+
+	tasks := i.snapshotTasks(now)
+	running := make([]taskSnapshot, 0)
+	for _, t := range i.sched.IndexBuildQueue.ListActiveTasks() {
+		snapshot, ok := tasks[t.Name()]
+		if !ok {
+			snapshot = taskSnapshot{Name: t.Name(), Tenant: t.Tenant()}
+		}
+		snapshot.HoldsSlot = holdsSlot[t.Name()]
+		running = append(running, snapshot)
+	}
+	sort.Slice(running, func(a, b int) bool { return running[a].Name < running[b].Name })
+	sort.Slice(tenants, func(a, b int) bool { return tenants[a].Tenant < tenants[b].Tenant })
+
+	return &schedulerSnapshot{
+		BuildParallel: i.sched.getBuildParallel(),
+		VirtualTime:   virtualTime,
+		Queued:        queued,
+		Running:       running,
+		Tenants:       tenants,
+		Slots:         slots,
+	}
+}
+
+// dot renders the snapshot as a graph in the DOT language, the tenants point to their queued tasks
+// and the scheduler points to the running tasks.
+func (s *schedulerSnapshot) dot() string {
+	var b strings.Builder
+	b.WriteString("digraph scheduler {\n")
+	fmt.Fprintf(&b, "  %q [shape=box, label=%q];\n", "scheduler",
+		fmt.Sprintf("scheduler\nbuild_parallel=%d", s.BuildParallel))
+	for _, tenant := range s.Tenants {
+		fmt.Fprintf(&b, "  %q [shape=ellipse, label=%q];\n", "tenant:"+tenant.Tenant,
+			fmt.Sprintf("%s\nqueued=%d pass=%s weight=%s", tenant.Tenant, tenant.Queued,
+				strconv.FormatFloat(tenant.Pass, 'g', -1, 64), strconv.FormatFloat(tenant.Weight, 'g', -1, 64)))
+	}
+	for _, t := range s.Queued {
+		fmt.Fprintf(&b, "  %q [label=%q];\n", "task:"+t.Name, t.Name)
+		fmt.Fprintf(&b, "  %q -> %q [label=%q];\n", "tenant:"+t.Tenant, "task:"+t.Name, "waiting "+t.Wait)
+	}
+	for _, t := range s.Running {
+		fmt.Fprintf(&b, "  %q [style=filled, label=%q];\n", "task:"+t.Name, fmt.Sprintf("%s\n%s %s", t.Name, t.Phase, t.Elapsed))
+		edge := "running"
+		if t.HoldsSlot {
+			edge = "slot"
+		}
+		fmt.Fprintf(&b, "  %q -> %q [label=%q];\n", "scheduler", "task:"+t.Name, edge)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// getSchedulerSnapshotMetrics returns the scheduler snapshot in the format of the request, json by default.
+func getSchedulerSnapshotMetrics(
+	ctx context.Context,
+	req *milvuspb.GetMetricsRequest,
+	node *IndexNode,
+) (*milvuspb.GetMetricsResponse, error) {
+	componentName := metricsinfo.ConstructComponentName(typeutil.IndexNodeRole, paramtable.GetNodeID())
+	snapshot := node.snapshotScheduler()
+
+	m := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(req.GetRequest()), &m); err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ComponentName: componentName,
+		}, nil
+	}
+	var resp string
+	switch format := m[snapshotFormatKey]; format {
+	case nil, "json":
+		bs, err := json.Marshal(snapshot)
+		if err != nil {
+			return &milvuspb.GetMetricsResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    err.Error(),
+				},
+				ComponentName: componentName,
+			}, nil
+		}
+		resp = string(bs)
+	case "dot":
+		resp = snapshot.dot()
+	default:
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("unsupported scheduler snapshot format %v", format),
+			},
+			ComponentName: componentName,
+		}, nil
+	}
+
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Response:      resp,
+		ComponentName: componentName,
+	}, nil
+}
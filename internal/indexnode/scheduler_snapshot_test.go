@@ -0,0 +1,81 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestSchedulerSnapshot(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	ctx := context.Background()
+	node := &IndexNode{
+		params: params,
+		sched:  NewTaskScheduler(ctx, params),
+	}
+	queue := node.sched.IndexBuildQueue
+	assert.NoError(t, queue.addUnissuedTask(&fakeTask{id: 1, tenant: "a"}))
+	assert.NoError(t, queue.addUnissuedTask(&fakeTask{id: 2, tenant: "b"}))
+	assert.NoError(t, queue.addUnissuedTask(&fakeTask{id: 3, tenant: "a"}))
+
+	node.loadOrStoreTask("cluster", 1, &taskInfo{phase: taskBuilding, startTime: time.Now()})
+	running := &indexBuildTask{ident: "cluster/1", ClusterID: "cluster", BuildID: 1, node: node}
+	queue.AddActiveTask(running)
+	node.sched.acquireSlot(running.Name(), "", func() {})
+
+	snapshot := node.snapshotScheduler()
+	assert.Equal(t, node.sched.getBuildParallel(), snapshot.BuildParallel)
+	assert.Equal(t, 3, len(snapshot.Queued))
+	assert.Equal(t, "fake-task-2", snapshot.Queued[1].Name)
+	assert.Equal(t, "b", snapshot.Queued[1].Tenant)
+	assert.Equal(t, []tenantSnapshot{{Tenant: "a", Queued: 2, Weight: 1}, {Tenant: "b", Queued: 1, Weight: 1}}, snapshot.Tenants)
+	assert.Equal(t, 1, len(snapshot.Running))
+	assert.Equal(t, "Building", snapshot.Running[0].Phase)
+	assert.True(t, snapshot.Running[0].HoldsSlot)
+	assert.Equal(t, []string{"cluster/1"}, snapshot.Slots)
+
+	dot := snapshot.dot()
+	assert.True(t, strings.HasPrefix(dot, "digraph scheduler {"))
+	assert.Contains(t, dot, `"tenant:a" -> "task:fake-task-3"`)
+	assert.Contains(t, dot, `"scheduler" -> "task:cluster/1" [label="slot"]`)
+
+	resp, err := getSchedulerSnapshotMetrics(ctx, &milvuspb.GetMetricsRequest{Request: `{"metric_type": "scheduler_snapshot"}`}, node)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	decoded := &schedulerSnapshot{}
+	assert.NoError(t, json.Unmarshal([]byte(resp.GetResponse()), decoded))
+	assert.Equal(t, 3, len(decoded.Queued))
+
+	resp, err = getSchedulerSnapshotMetrics(ctx, &milvuspb.GetMetricsRequest{Request: `{"metric_type": "scheduler_snapshot", "format": "dot"}`}, node)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.True(t, strings.HasPrefix(resp.GetResponse(), "digraph"))
+
+	resp, err = getSchedulerSnapshotMetrics(ctx, &milvuspb.GetMetricsRequest{Request: `{"metric_type": "scheduler_snapshot", "format": "xml"}`}, node)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+}
@@ -0,0 +1,32 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import "errors"
+
+// errScratchEncryptionUnsupported is returned by the disk index builds when indexNode.scratchEncryption.enable
+// is set, the knowhere linked into IndexNode can't encrypt the local files spilled by the build, so the build
+// fails instead of writing the vectors to the local disk in plaintext.
+var errScratchEncryptionUnsupported = errors.New("encrypting the local files of the disk index build is not supported by knowhere")
+
+// checkScratchEncryption fails the disk index build if the local files of it are required to be encrypted.
+func (it *indexBuildTask) checkScratchEncryption() error {
+	if it.node.params.IndexNodeCfg.ScratchEncryptionEnable.GetAsBool() {
+		return errScratchEncryptionUnsupported
+	}
+	return nil
+}
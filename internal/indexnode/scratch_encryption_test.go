@@ -0,0 +1,35 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestCheckScratchEncryption(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	it := &indexBuildTask{node: &IndexNode{params: params}}
+	assert.NoError(t, it.checkScratchEncryption())
+
+	params.Save(params.IndexNodeCfg.ScratchEncryptionEnable.Key, "true")
+	defer params.Reset(params.IndexNodeCfg.ScratchEncryptionEnable.Key)
+	assert.ErrorIs(t, it.checkScratchEncryption(), errScratchEncryptionUnsupported)
+}
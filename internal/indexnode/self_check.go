@@ -0,0 +1,139 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/contextutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+const (
+	// selfCheckPath is the path under the storage root and the local storage path holding the files of
+	// the self checks.
+	selfCheckPath = "index_selfcheck"
+	// selfCheckMaxRows bounds the synthetic vectors of a self check, so it never competes with the builds.
+	selfCheckMaxRows = 100000
+
+	selfCheckKnowhere  = "knowhere"
+	selfCheckLocalDisk = "local_disk"
+	selfCheckStorage   = "storage"
+)
+
+func newSelfCheckResult(dependency string, start time.Time, err error) *indexpb.SelfCheckResult {
+	result := &indexpb.SelfCheckResult{
+		Dependency: dependency,
+		Passed:     err == nil,
+		ElapsedMs:  time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		result.Reason = err.Error()
+	}
+	return result
+}
+
+// selfCheck builds an index of synthetic vectors with the engine of the request, writes and reads back a file
+// on the local disk, and writes the index files to the storage of the request, reads them back and deletes them.
+// The storage is not checked if the request has no storage config.
+func (i *IndexNode) selfCheck(ctx context.Context, req *indexpb.SelfCheckRequest) []*indexpb.SelfCheckResult {
+	rows := int(req.GetRows())
+	if rows <= 0 {
+		rows = i.params.IndexNodeCfg.WarmupBuildRows.GetAsInt()
+	}
+	if rows > selfCheckMaxRows {
+		rows = selfCheckMaxRows
+	}
+
+	start := time.Now()
+	var blobs []*storage.Blob
+	newEngine, err := getBuildEngineFactory(req.GetEngineVersion())
+	if err == nil {
+		blobs, _, err = warmupBuild(newEngine, rows)
+	}
+	results := []*indexpb.SelfCheckResult{newSelfCheckResult(selfCheckKnowhere, start, err)}
+
+	start = time.Now()
+	_, _, err = warmupDisk(path.Join(i.params.LocalStorageCfg.Path.GetValue(), selfCheckPath))
+	results = append(results, newSelfCheckResult(selfCheckLocalDisk, start, err))
+
+	if req.GetStorageConfig() != nil {
+		start = time.Now()
+		err = i.selfCheckStorage(ctx, req, blobs)
+		results = append(results, newSelfCheckResult(selfCheckStorage, start, err))
+	}
+	return results
+}
+
+// selfCheckStorage writes the blobs to the storage, reads them back and deletes them. A synthetic file is written
+// instead if the build failed.
+func (i *IndexNode) selfCheckStorage(ctx context.Context, req *indexpb.SelfCheckRequest, blobs []*storage.Blob) error {
+	cm, err := i.storageFactory.NewChunkManager(contextutil.WithClusterID(ctx, req.GetClusterID()), req.GetStorageConfig())
+	if err != nil {
+		return fmt.Errorf("create chunk manager: %w", err)
+	}
+	if len(blobs) == 0 {
+		blobs = []*storage.Blob{{Key: "synthetic", Value: []byte(time.Now().String())}}
+	}
+	dir := path.Join(cm.RootPath(), selfCheckPath, strconv.FormatInt(paramtable.GetNodeID(), 10))
+	written := make([]string, 0, len(blobs))
+	var checkErr error
+	for _, blob := range blobs {
+		filePath := path.Join(dir, blob.Key)
+		if err := cm.Write(ctx, filePath, blob.Value); err != nil {
+			checkErr = fmt.Errorf("write %s: %w", filePath, err)
+			break
+		}
+		written = append(written, filePath)
+		value, err := cm.Read(ctx, filePath)
+		if err != nil {
+			checkErr = fmt.Errorf("read %s: %w", filePath, err)
+			break
+		}
+		if !bytes.Equal(value, blob.Value) {
+			checkErr = fmt.Errorf("read %s: %d bytes read back differ from the %d bytes written", filePath, len(value), len(blob.Value))
+			break
+		}
+	}
+	// the written files are deleted even if the check failed.
+	for _, filePath := range written {
+		err := cm.Remove(ctx, filePath)
+		if err == nil {
+			var exist bool
+			if exist, err = cm.Exist(ctx, filePath); err == nil && exist {
+				err = fmt.Errorf("the file still exists")
+			}
+		}
+		if err != nil {
+			log.Ctx(ctx).Warn("IndexNode delete self check file failed", zap.String("path", filePath), zap.Error(err))
+			if checkErr == nil {
+				checkErr = fmt.Errorf("delete %s: %w", filePath, err)
+			}
+		}
+	}
+	return checkErr
+}
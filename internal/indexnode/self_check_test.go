@@ -0,0 +1,84 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+type selfCheckStorageFactory struct {
+	cm  storage.ChunkManager
+	err error
+}
+
+func (f *selfCheckStorageFactory) NewChunkManager(context.Context, *indexpb.StorageConfig) (storage.ChunkManager, error) {
+	return f.cm, f.err
+}
+
+func TestSelfCheck(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	ctx := context.Background()
+	params.Save(params.LocalStorageCfg.Path.Key, t.TempDir())
+	defer params.Reset(params.LocalStorageCfg.Path.Key)
+	RegisterBuildEngine("selfcheck-mock", func(schemapb.DataType, map[string]string, map[string]string,
+		*indexpb.StorageConfig) (BuildEngine, error) {
+		return &mockBuildEngine{}, nil
+	})
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	factory := &selfCheckStorageFactory{cm: cm}
+	node := &IndexNode{params: params, storageFactory: factory}
+
+	passed := func(results []*indexpb.SelfCheckResult) map[string]bool {
+		m := make(map[string]bool)
+		for _, result := range results {
+			m[result.GetDependency()] = result.GetPassed()
+		}
+		return m
+	}
+	req := &indexpb.SelfCheckRequest{ClusterID: "cluster", Rows: 10, EngineVersion: "selfcheck-mock"}
+	results := node.selfCheck(ctx, req)
+	assert.Equal(t, map[string]bool{selfCheckKnowhere: true, selfCheckLocalDisk: true}, passed(results))
+
+	req.StorageConfig = &indexpb.StorageConfig{}
+	results = node.selfCheck(ctx, req)
+	assert.Equal(t, map[string]bool{selfCheckKnowhere: true, selfCheckLocalDisk: true, selfCheckStorage: true}, passed(results))
+	// the files are deleted
+	keys, _, err := cm.ListWithPrefix(ctx, cm.RootPath(), true)
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	// the storage is checked with a synthetic file if the build fails
+	req.EngineVersion = "unknown"
+	results = node.selfCheck(ctx, req)
+	assert.Equal(t, map[string]bool{selfCheckKnowhere: false, selfCheckLocalDisk: true, selfCheckStorage: true}, passed(results))
+	assert.Contains(t, results[0].GetReason(), "not supported")
+
+	factory.err = errors.New("no bucket")
+	results = node.selfCheck(ctx, req)
+	assert.False(t, results[2].GetPassed())
+	assert.Contains(t, results[2].GetReason(), "no bucket")
+}
@@ -0,0 +1,173 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/hardware"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+)
+
+// buildMemFactor is the memory to build an index in multiples of its raw vector data, which is held
+// along with the index being built.
+const buildMemFactor = 2
+
+var errReservationExpired = errors.New("slot reservation expired")
+
+// estimateBuildMemSize estimates the memory to build the index of the job from its raw vector data size,
+// it's 0 if the dim or the row count of the job is unknown.
+func estimateBuildMemSize(req *indexpb.CreateJobRequest) uint64 {
+	dim, err := strconv.ParseInt(funcutil.KeyValuePair2Map(req.GetTypeParams())["dim"], 10, 64)
+	if err != nil {
+		return 0
+	}
+	indexType := funcutil.KeyValuePair2Map(req.GetIndexParams())["index_type"]
+	return rawVectorSize(indexType, dim, req.GetNumRows()) * buildMemFactor
+}
+
+// rawVectorSize returns the size of the raw vectors the index of the index type is built from,
+// it's 0 if the dim or the row count is unknown.
+func rawVectorSize(indexType string, dim, numRows int64) uint64 {
+	if dim <= 0 || numRows <= 0 {
+		return 0
+	}
+	rowSize := dim * 4
+	switch indexType {
+	case indexparamcheck.IndexFaissBinIDMap, indexparamcheck.IndexFaissBinIvfFlat:
+		rowSize = dim / 8
+	}
+	return uint64(numRows * rowSize)
+}
+
+type slotReservation struct {
+	key     taskKey
+	memSize uint64
+	expire  time.Time
+}
+
+// slotReservations keeps the build slots reserved by ReserveSlots for the coming CreateJob, so IndexCoord
+// doesn't assign a job the node would only queue. The reservations expire after indexNode.reservation.ttl.
+type slotReservations struct {
+	freeMemory func() uint64
+
+	mu     sync.Mutex
+	seq    int64
+	tokens map[string]*slotReservation
+}
+
+func newSlotReservations() *slotReservations {
+	return &slotReservations{
+		freeMemory: hardware.GetFreeMemoryCount,
+		tokens:     make(map[string]*slotReservation),
+	}
+}
+
+// liveLocked prunes the expired reservations and returns the number of the live ones and their memory.
+func (r *slotReservations) liveLocked(now time.Time) (int, uint64) {
+	var memSize uint64
+	for token, reservation := range r.tokens {
+		if now.After(reservation.expire) {
+			delete(r.tokens, token)
+			continue
+		}
+		memSize += reservation.memSize
+	}
+	return len(r.tokens), memSize
+}
+
+// count returns the number of the live reservations.
+func (r *slotReservations) count() int {
+	if r == nil {
+		return 0
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n, _ := r.liveLocked(time.Now())
+	return n
+}
+
+// reserve reserves a slot for the job if one of the free slots is not reserved yet and the free memory
+// not reserved yet fits the job, it returns the token of the reservation or the reason of the denial.
+func (r *slotReservations) reserve(key taskKey, memSize uint64, freeSlots int, ttl time.Duration) (string, string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	reserved, reservedMem := r.liveLocked(now)
+	if freeSlots-reserved <= 0 {
+		return "", fmt.Sprintf("no free build slot, %d free slots are reserved", freeSlots)
+	}
+	if free := r.freeMemory(); memSize > 0 && memSize+reservedMem > free {
+		return "", fmt.Sprintf("the job needs about %d bytes of memory, %d of the free %d bytes are reserved",
+			memSize, reservedMem, free)
+	}
+	r.seq++
+	token := fmt.Sprintf("%s/%d/%d", key.ClusterID, key.BuildID, r.seq)
+	r.tokens[token] = &slotReservation{key: key, memSize: memSize, expire: now.Add(ttl)}
+	return token, ""
+}
+
+// take consumes the reservation of the token for the job.
+func (r *slotReservations) take(token string, key taskKey) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.liveLocked(time.Now())
+	reservation, ok := r.tokens[token]
+	if !ok || reservation.key != key {
+		return fmt.Errorf("%w: %s", errReservationExpired, token)
+	}
+	delete(r.tokens, token)
+	return nil
+}
+
+// reserveSlots decides whether the node takes the job and reserves a slot for it if so.
+func (i *IndexNode) reserveSlots(req *indexpb.ReserveSlotsRequest) (string, string) {
+	job := &indexpb.CreateJobRequest{
+		ClusterID:   req.GetClusterID(),
+		BuildID:     req.GetBuildID(),
+		NumRows:     req.GetNumRows(),
+		IndexParams: req.GetIndexParams(),
+		TypeParams:  req.GetTypeParams(),
+		Priority:    req.GetPriority(),
+	}
+	if err := i.suspension.check(); err != nil {
+		return "", err.Error()
+	}
+	if err := checkBuildLimits(i.params, job); err != nil {
+		return "", err.Error()
+	}
+	if err := checkMaintenanceWindow(i.params, job, time.Now()); err != nil {
+		return "", err.Error()
+	}
+	unissued, active := i.sched.IndexBuildQueue.GetTaskNum()
+	freeSlots := i.sched.getBuildParallel() - unissued - active
+	key := taskKey{ClusterID: req.GetClusterID(), BuildID: req.GetBuildID()}
+	token, reason := i.reservations.reserve(key, estimateBuildMemSize(job), freeSlots,
+		i.params.IndexNodeCfg.ReservationTTL.GetAsDuration(time.Second))
+	log.Debug("IndexNode reserve slots", zap.String("ClusterID", key.ClusterID), zap.Int64("buildID", key.BuildID),
+		zap.Int("freeSlots", freeSlots), zap.Bool("accepted", token != ""), zap.String("reason", reason))
+	return token, reason
+}
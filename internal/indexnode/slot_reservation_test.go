@@ -0,0 +1,82 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+)
+
+func TestEstimateBuildMemSize(t *testing.T) {
+	req := &indexpb.CreateJobRequest{
+		NumRows:     1000,
+		TypeParams:  []*commonpb.KeyValuePair{{Key: "dim", Value: "128"}},
+		IndexParams: []*commonpb.KeyValuePair{{Key: "index_type", Value: "IVF_FLAT"}},
+	}
+	assert.Equal(t, uint64(1000*128*4*buildMemFactor), estimateBuildMemSize(req))
+
+	req.IndexParams = []*commonpb.KeyValuePair{{Key: "index_type", Value: "BIN_IVF_FLAT"}}
+	assert.Equal(t, uint64(1000*16*buildMemFactor), estimateBuildMemSize(req))
+
+	req.NumRows = 0
+	assert.Zero(t, estimateBuildMemSize(req))
+	req.NumRows = 1000
+	req.TypeParams = nil
+	assert.Zero(t, estimateBuildMemSize(req))
+}
+
+func TestSlotReservations(t *testing.T) {
+	r := newSlotReservations()
+	r.freeMemory = func() uint64 { return 100 }
+	key1 := taskKey{ClusterID: "cluster", BuildID: 1}
+	key2 := taskKey{ClusterID: "cluster", BuildID: 2}
+
+	token, reason := r.reserve(key1, 60, 2, time.Minute)
+	assert.NotEmpty(t, token)
+	assert.Empty(t, reason)
+	assert.Equal(t, 1, r.count())
+
+	// the memory not reserved yet doesn't fit the job.
+	token2, reason := r.reserve(key2, 60, 2, time.Minute)
+	assert.Empty(t, token2)
+	assert.Contains(t, reason, "memory")
+
+	// the only free slot is reserved.
+	token2, reason = r.reserve(key2, 0, 1, time.Minute)
+	assert.Empty(t, token2)
+	assert.Contains(t, reason, "slot")
+
+	assert.True(t, errors.Is(r.take(token, key2), errReservationExpired))
+	assert.NoError(t, r.take(token, key1))
+	assert.True(t, errors.Is(r.take(token, key1), errReservationExpired))
+	assert.Zero(t, r.count())
+
+	token, _ = r.reserve(key1, 0, 1, time.Millisecond)
+	assert.NotEmpty(t, token)
+	time.Sleep(5 * time.Millisecond)
+	assert.Zero(t, r.count())
+	assert.True(t, errors.Is(r.take(token, key1), errReservationExpired))
+
+	var nilReservations *slotReservations
+	assert.Zero(t, nilReservations.count())
+}
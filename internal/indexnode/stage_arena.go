@@ -0,0 +1,128 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// stageArena allocates the large transient buffers of a build stage from memory mapped outside of the Go heap,
+// the memory is unmapped when the stage releases the arena, so the RSS drops between the builds at once
+// instead of waiting for the GC. The buffers must not be referenced after the release.
+// A nil arena allocates from the Go heap and releases nothing.
+type stageArena struct {
+	mu        sync.Mutex
+	chunkSize int
+	// chunks are the mappings in allocation order, the small buffers are bumped from the last one.
+	chunks [][]byte
+	used   int
+	mapped int
+}
+
+// newStageArena returns the arena of the phase, or nil if indexNode.arena.stages doesn't enable it.
+func newStageArena(params *paramtable.ComponentParam, phase taskPhase) *stageArena {
+	for _, stage := range strings.Split(params.IndexNodeCfg.ArenaStages.GetValue(), ",") {
+		if strings.EqualFold(strings.TrimSpace(stage), phase.String()) {
+			return &stageArena{
+				chunkSize: params.IndexNodeCfg.ArenaChunkSize.GetAsInt() * 1024 * 1024,
+			}
+		}
+	}
+	return nil
+}
+
+// alloc returns a zeroed buffer of size bytes. The buffers of a quarter chunk or larger get their own mappings,
+// the buffer falls back to the Go heap if the memory can't be mapped.
+func (a *stageArena) alloc(size int) []byte {
+	if a == nil || size <= 0 {
+		return make([]byte, size)
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if size >= a.chunkSize/4 {
+		mem, err := mapMemory(size)
+		if err != nil {
+			log.Warn("IndexNode arena failed to map memory", zap.Int("size", size), zap.Error(err))
+			return make([]byte, size)
+		}
+		// keep the bump chunk last.
+		a.chunks = append([][]byte{mem}, a.chunks...)
+		a.mapped += size
+		return mem
+	}
+	if len(a.chunks) == 0 || a.used+size > len(a.chunks[len(a.chunks)-1]) {
+		mem, err := mapMemory(a.chunkSize)
+		if err != nil {
+			log.Warn("IndexNode arena failed to map memory", zap.Int("size", a.chunkSize), zap.Error(err))
+			return make([]byte, size)
+		}
+		a.chunks = append(a.chunks, mem)
+		a.used = 0
+		a.mapped += a.chunkSize
+	}
+	chunk := a.chunks[len(a.chunks)-1]
+	buf := chunk[a.used : a.used+size : a.used+size]
+	a.used += size
+	return buf
+}
+
+// release unmaps all the memory of the arena, the arena can be used again afterwards.
+func (a *stageArena) release() {
+	if a == nil {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, chunk := range a.chunks {
+		if err := unmapMemory(chunk); err != nil {
+			log.Warn("IndexNode arena failed to unmap memory", zap.Int("size", len(chunk)), zap.Error(err))
+		}
+	}
+	if a.mapped > 0 {
+		log.Debug("IndexNode arena released", zap.Int("mappedSize", a.mapped), zap.Int("chunkNum", len(a.chunks)))
+	}
+	a.chunks = nil
+	a.used = 0
+	a.mapped = 0
+}
+
+// readFile reads the whole file into a buffer of the arena.
+func (a *stageArena) readFile(ctx context.Context, cm storage.ChunkManager, filePath string) ([]byte, error) {
+	size, err := cm.Size(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := cm.Reader(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	buf := a.alloc(int(size))
+	if _, err := io.ReadFull(reader, buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
@@ -0,0 +1,87 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestStageArena(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	assert.Nil(t, newStageArena(params, taskLoading))
+	var nilArena *stageArena
+	assert.Len(t, nilArena.alloc(10), 10)
+	nilArena.release()
+
+	params.Save(params.IndexNodeCfg.ArenaStages.Key, "loading, Saving")
+	params.Save(params.IndexNodeCfg.ArenaChunkSize.Key, "1")
+	assert.Nil(t, newStageArena(params, taskBuilding))
+	arena := newStageArena(params, taskLoading)
+	assert.NotNil(t, arena)
+
+	small := arena.alloc(1000)
+	assert.Len(t, small, 1000)
+	assert.Equal(t, 1000, cap(small))
+	small[999] = 1
+	// a large buffer gets its own mapping, and the small buffers keep bumping from the chunk
+	large := arena.alloc(512 * 1024)
+	assert.Len(t, large, 512*1024)
+	next := arena.alloc(10)
+	assert.Equal(t, 2, len(arena.chunks))
+	assert.Equal(t, 1010, arena.used)
+	assert.Equal(t, byte(0), next[0])
+	// a new chunk is mapped when the chunk is full
+	for i := 0; i < 4; i++ {
+		arena.alloc(250 * 1024)
+	}
+	assert.Equal(t, 2, len(arena.chunks))
+	arena.alloc(250 * 1024)
+	assert.Equal(t, 3, len(arena.chunks))
+	assert.Equal(t, 1024*1024*2+512*1024, arena.mapped)
+
+	arena.release()
+	assert.Empty(t, arena.chunks)
+	assert.Equal(t, 0, arena.mapped)
+	assert.Len(t, arena.alloc(10), 10)
+	arena.release()
+}
+
+func TestStageArenaReadFile(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	ctx := context.Background()
+	root := t.TempDir()
+	cm := storage.NewLocalChunkManager(storage.RootPath(root))
+	filePath := path.Join(root, "binlog")
+	assert.NoError(t, cm.Write(ctx, filePath, []byte("binlog content")))
+
+	params.Save(params.IndexNodeCfg.ArenaStages.Key, "Loading")
+	arena := newStageArena(params, taskLoading)
+	defer arena.release()
+	value, err := arena.readFile(ctx, cm, filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("binlog content"), value)
+
+	_, err = arena.readFile(ctx, cm, path.Join(root, "missing"))
+	assert.Error(t, err)
+}
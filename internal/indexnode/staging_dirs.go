@@ -0,0 +1,204 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+const stagingProbeFile = ".probe"
+
+var errNoStagingDir = errors.New("no healthy staging directory has enough quota")
+
+// stagingDirConfig is a local staging directory of indexNode.staging.dirs.
+type stagingDirConfig struct {
+	Path   string  `json:"path"`
+	Weight float64 `json:"weight"`
+	// Quota is the max estimated disk usage in bytes of the builds in the directory, 0 means unlimited.
+	Quota int64 `json:"quota"`
+}
+
+type stagingDir struct {
+	stagingDirConfig
+	healthy bool
+	// reserved is the estimated disk usage of the running builds in the directory.
+	reserved int64
+	// current is the smooth weighted round robin counter of the directory.
+	current float64
+}
+
+// stagingDirs stripes the local build files across the staging directories in proportion to their weights
+// by smooth weighted round robin. The directories are probed periodically, the failed ones are out of
+// rotation until they are healthy again.
+type stagingDirs struct {
+	params *paramtable.ComponentParam
+
+	mu   sync.Mutex
+	dirs []*stagingDir
+	wg   sync.WaitGroup
+}
+
+// parseStagingDirs parses the staging directories in json, the weights default to 1.
+func parseStagingDirs(value string) ([]*stagingDir, error) {
+	if value == "" {
+		return nil, nil
+	}
+	configs := make([]stagingDirConfig, 0)
+	if err := json.Unmarshal([]byte(value), &configs); err != nil {
+		return nil, fmt.Errorf("invalid staging dirs %s: %w", value, err)
+	}
+	dirs := make([]*stagingDir, 0, len(configs))
+	for _, config := range configs {
+		if config.Path == "" || config.Weight < 0 || config.Quota < 0 {
+			return nil, fmt.Errorf("invalid staging dir %+v", config)
+		}
+		if config.Weight == 0 {
+			config.Weight = 1
+		}
+		dirs = append(dirs, &stagingDir{stagingDirConfig: config})
+	}
+	return dirs, nil
+}
+
+// newStagingDirs creates the staging directories set by indexNode.staging.dirs and probes them.
+func newStagingDirs(params *paramtable.ComponentParam) (*stagingDirs, error) {
+	dirs, err := parseStagingDirs(params.IndexNodeCfg.StagingDirs.GetValue())
+	if err != nil {
+		return nil, err
+	}
+	s := &stagingDirs{params: params, dirs: dirs}
+	s.probe()
+	return s, nil
+}
+
+// enabled reports whether the staging directories are set, the local storage path is used otherwise.
+func (s *stagingDirs) enabled() bool {
+	return s != nil && len(s.dirs) > 0
+}
+
+// roots returns the paths of all the staging directories.
+func (s *stagingDirs) roots() []string {
+	if s == nil {
+		return nil
+	}
+	roots := make([]string, 0, len(s.dirs))
+	for _, dir := range s.dirs {
+		roots = append(roots, dir.Path)
+	}
+	return roots
+}
+
+// acquire picks the directory for a build using size bytes and reserves the size in it.
+func (s *stagingDirs) acquire(size int64) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var chosen *stagingDir
+	total := 0.0
+	for _, dir := range s.dirs {
+		if !dir.healthy || (dir.Quota > 0 && dir.reserved+size > dir.Quota) {
+			continue
+		}
+		dir.current += dir.Weight
+		total += dir.Weight
+		if chosen == nil || dir.current > chosen.current {
+			chosen = dir
+		}
+	}
+	if chosen == nil {
+		return "", fmt.Errorf("%w, %d bytes required", errNoStagingDir, size)
+	}
+	chosen.current -= total
+	chosen.reserved += size
+	return chosen.Path, nil
+}
+
+// release releases the size reserved in the directory by acquire.
+func (s *stagingDirs) release(dirPath string, size int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, dir := range s.dirs {
+		if dir.Path == dirPath {
+			dir.reserved -= size
+			return
+		}
+	}
+}
+
+// probe writes and removes a probe file in every directory to check its health.
+func (s *stagingDirs) probe() {
+	for _, dir := range s.dirs {
+		err := os.MkdirAll(dir.Path, os.ModePerm)
+		if err == nil {
+			probeFile := path.Join(dir.Path, stagingProbeFile)
+			if err = os.WriteFile(probeFile, []byte(time.Now().String()), 0o644); err == nil {
+				err = os.Remove(probeFile)
+			}
+		}
+		s.mu.Lock()
+		healthy := err == nil
+		if healthy != dir.healthy {
+			if healthy {
+				log.Info("IndexNode staging dir is healthy, put it into rotation", zap.String("path", dir.Path))
+			} else {
+				log.Warn("IndexNode staging dir failed, remove it from rotation", zap.String("path", dir.Path), zap.Error(err))
+			}
+			dir.healthy = healthy
+			dir.current = 0
+		}
+		s.mu.Unlock()
+	}
+}
+
+// Start starts the probing loop if the staging directories are set, it exits when ctx is done.
+func (s *stagingDirs) Start(ctx context.Context) {
+	if !s.enabled() {
+		return
+	}
+	s.wg.Add(1)
+	go s.loop(ctx)
+}
+
+// Close waits for the probing loop to exit.
+func (s *stagingDirs) Close() {
+	s.wg.Wait()
+}
+
+func (s *stagingDirs) loop(ctx context.Context) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(s.params.IndexNodeCfg.StagingProbeInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.probe()
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestParseStagingDirs(t *testing.T) {
+	dirs, err := parseStagingDirs("")
+	assert.NoError(t, err)
+	assert.Empty(t, dirs)
+
+	dirs, err = parseStagingDirs(`[{"path": "/a", "weight": 2, "quota": 100}, {"path": "/b"}]`)
+	assert.NoError(t, err)
+	assert.Len(t, dirs, 2)
+	assert.Equal(t, stagingDirConfig{Path: "/a", Weight: 2, Quota: 100}, dirs[0].stagingDirConfig)
+	assert.Equal(t, stagingDirConfig{Path: "/b", Weight: 1}, dirs[1].stagingDirConfig)
+
+	for _, value := range []string{`{`, `[{"weight": 1}]`, `[{"path": "/a", "weight": -1}]`, `[{"path": "/a", "quota": -1}]`} {
+		_, err = parseStagingDirs(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestStagingDirs(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	root := t.TempDir()
+	blocker := path.Join(root, "blocker")
+	assert.NoError(t, os.WriteFile(blocker, nil, 0o644))
+	a, b, broken := path.Join(root, "a"), path.Join(root, "b"), path.Join(blocker, "c")
+
+	params.Save(params.IndexNodeCfg.StagingDirs.Key, fmt.Sprintf(
+		`[{"path": "%s", "weight": 3, "quota": 1000}, {"path": "%s"}, {"path": "%s"}]`, a, b, broken))
+	s, err := newStagingDirs(params)
+	assert.NoError(t, err)
+	assert.True(t, s.enabled())
+	assert.Equal(t, []string{a, b, broken}, s.roots())
+
+	// the broken dir is out of rotation, a is picked three times as often as b
+	picked := make(map[string]int)
+	for i := 0; i < 8; i++ {
+		dir, err := s.acquire(10)
+		assert.NoError(t, err)
+		picked[dir]++
+	}
+	assert.Equal(t, map[string]int{a: 6, b: 2}, picked)
+
+	// a is out of quota
+	for i := 0; i < 4; i++ {
+		dir, err := s.acquire(950)
+		assert.NoError(t, err)
+		assert.Equal(t, b, dir)
+		s.release(dir, 950)
+	}
+
+	// b is removed from rotation once it fails
+	assert.NoError(t, os.RemoveAll(b))
+	assert.NoError(t, os.WriteFile(b, nil, 0o644))
+	s.probe()
+	s.release(a, 60)
+	dir, err := s.acquire(1000)
+	assert.NoError(t, err)
+	assert.Equal(t, a, dir)
+	_, err = s.acquire(1)
+	assert.True(t, errors.Is(err, errNoStagingDir))
+
+	assert.False(t, (*stagingDirs)(nil).enabled())
+	assert.Empty(t, (*stagingDirs)(nil).roots())
+}
@@ -0,0 +1,182 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"golang.org/x/exp/mmap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// checkStorageRoots returns an error if the storage roots of the job are malformed or a binlog path
+// of the job is mapped to a storage root the job doesn't have.
+func checkStorageRoots(req *indexpb.CreateJobRequest) error {
+	names := make(map[string]struct{}, len(req.GetStorageRoots()))
+	for _, root := range req.GetStorageRoots() {
+		if root.GetName() == "" {
+			return fmt.Errorf("invalid storage root: empty name")
+		}
+		if _, ok := names[root.GetName()]; ok {
+			return fmt.Errorf("invalid storage root %s: duplicated name", root.GetName())
+		}
+		if root.GetStorageConfig() == nil {
+			return fmt.Errorf("invalid storage root %s: no storage config", root.GetName())
+		}
+		if tier := root.GetTier(); tier != "" && tier != storageTierHot && tier != storageTierCold {
+			return fmt.Errorf("invalid storage root %s: unknown tier %s", root.GetName(), tier)
+		}
+		names[root.GetName()] = struct{}{}
+	}
+	for path, name := range req.GetDataPathRoots() {
+		if _, ok := names[name]; !ok {
+			return fmt.Errorf("binlog %s is mapped to the unknown storage root %s", path, name)
+		}
+	}
+	return nil
+}
+
+// openStorageRoots returns the chunk manager reading the binlogs of the job from their storage roots, it's cm
+// itself if the job has no binlog out of storage_config. Only the roots mapped by the binlogs are opened.
+func (i *IndexNode) openStorageRoots(ctx context.Context, req *indexpb.CreateJobRequest, cm storage.ChunkManager) (storage.ChunkManager, error) {
+	if len(req.GetDataPathRoots()) == 0 {
+		return cm, nil
+	}
+	used := make(map[string]struct{})
+	for _, name := range req.GetDataPathRoots() {
+		used[name] = struct{}{}
+	}
+	roots := make(map[string]storage.ChunkManager, len(used))
+	restorers := make(map[string]storage.ObjectRestorer)
+	for _, root := range req.GetStorageRoots() {
+		if _, ok := used[root.GetName()]; !ok {
+			continue
+		}
+		rootCM, err := i.storageFactory.NewChunkManager(ctx, root.GetStorageConfig())
+		if err != nil {
+			return nil, fmt.Errorf("fail to open the storage root %s: %w", root.GetName(), err)
+		}
+		if root.GetTier() == storageTierCold {
+			if restorer, ok := rootCM.(storage.ObjectRestorer); ok {
+				restorers[root.GetName()] = restorer
+			} else {
+				log.Ctx(ctx).Warn("the storage of the cold root can't restore the archived binlogs, read them as is",
+					zap.String("root", root.GetName()))
+			}
+		}
+		roots[root.GetName()] = i.hedges.wrapChunkManager(i.faults.wrapChunkManager(newTenantChunkManager(newLaneChunkManager(rootCM, i.ioLanes.storageLane()), i.limiters)))
+	}
+	mcm := newMultiRootChunkManager(cm, roots, req.GetDataPathRoots())
+	mcm.restorers = restorers
+	return mcm, nil
+}
+
+// multiRootChunkManager reads the binlogs of a job spanning several storage roots, such as a migrated collection
+// with the old binlogs in a legacy bucket. The reads of the mapped paths go to the chunk managers of their roots,
+// everything else, including all the writes, goes to the chunk manager of storage_config.
+type multiRootChunkManager struct {
+	storage.ChunkManager
+	roots     map[string]storage.ChunkManager
+	pathRoots map[string]string
+	// restorers restore the archived binlogs of the cold roots, see awaitRestore.
+	restorers map[string]storage.ObjectRestorer
+}
+
+var _ storage.ChunkManager = (*multiRootChunkManager)(nil)
+
+func newMultiRootChunkManager(cm storage.ChunkManager, roots map[string]storage.ChunkManager, pathRoots map[string]string) *multiRootChunkManager {
+	return &multiRootChunkManager{
+		ChunkManager: cm,
+		roots:        roots,
+		pathRoots:    pathRoots,
+	}
+}
+
+// rootOf returns the name of the root of the path, it's empty for storage_config.
+func (mcm *multiRootChunkManager) rootOf(filePath string) string {
+	if name, ok := mcm.pathRoots[filePath]; ok {
+		if _, ok := mcm.roots[name]; ok {
+			return name
+		}
+	}
+	return ""
+}
+
+// of returns the chunk manager of the root of the path.
+func (mcm *multiRootChunkManager) of(filePath string) storage.ChunkManager {
+	if name := mcm.rootOf(filePath); name != "" {
+		return mcm.roots[name]
+	}
+	return mcm.ChunkManager
+}
+
+func (mcm *multiRootChunkManager) Path(ctx context.Context, filePath string) (string, error) {
+	return mcm.of(filePath).Path(ctx, filePath)
+}
+
+func (mcm *multiRootChunkManager) Size(ctx context.Context, filePath string) (int64, error) {
+	return mcm.of(filePath).Size(ctx, filePath)
+}
+
+func (mcm *multiRootChunkManager) Exist(ctx context.Context, filePath string) (bool, error) {
+	return mcm.of(filePath).Exist(ctx, filePath)
+}
+
+func (mcm *multiRootChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	return mcm.of(filePath).Read(ctx, filePath)
+}
+
+func (mcm *multiRootChunkManager) Reader(ctx context.Context, filePath string) (storage.FileReader, error) {
+	return mcm.of(filePath).Reader(ctx, filePath)
+}
+
+func (mcm *multiRootChunkManager) Mmap(ctx context.Context, filePath string) (*mmap.ReaderAt, error) {
+	return mcm.of(filePath).Mmap(ctx, filePath)
+}
+
+func (mcm *multiRootChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	return mcm.of(filePath).ReadAt(ctx, filePath, off, length)
+}
+
+// MultiRead reads the paths of each root in one request, the data is in the order of filePaths.
+func (mcm *multiRootChunkManager) MultiRead(ctx context.Context, filePaths []string) ([][]byte, error) {
+	groups := make(map[string][]int)
+	for idx, filePath := range filePaths {
+		name := mcm.rootOf(filePath)
+		groups[name] = append(groups[name], idx)
+	}
+	data := make([][]byte, len(filePaths))
+	for _, indexes := range groups {
+		paths := make([]string, len(indexes))
+		for j, idx := range indexes {
+			paths[j] = filePaths[idx]
+		}
+		values, err := mcm.of(paths[0]).MultiRead(ctx, paths)
+		if err != nil {
+			return nil, err
+		}
+		for j, idx := range indexes {
+			data[idx] = values[j]
+		}
+	}
+	return data, nil
+}
@@ -0,0 +1,109 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// memRootChunkManager is a storage root holding the files in memory, the other requests panic.
+type memRootChunkManager struct {
+	storage.ChunkManager
+	files map[string][]byte
+}
+
+func (m *memRootChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	data, ok := m.files[filePath]
+	if !ok {
+		return nil, storage.WrapErrNoSuchKey(filePath)
+	}
+	return data, nil
+}
+
+func (m *memRootChunkManager) Size(ctx context.Context, filePath string) (int64, error) {
+	data, err := m.Read(ctx, filePath)
+	return int64(len(data)), err
+}
+
+func (m *memRootChunkManager) MultiRead(ctx context.Context, filePaths []string) ([][]byte, error) {
+	data := make([][]byte, len(filePaths))
+	for i, filePath := range filePaths {
+		var err error
+		if data[i], err = m.Read(ctx, filePath); err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+func TestCheckStorageRoots(t *testing.T) {
+	req := &indexpb.CreateJobRequest{DataPaths: []string{"a", "b"}}
+	assert.NoError(t, checkStorageRoots(req))
+
+	req.StorageRoots = []*indexpb.StorageRoot{{Name: "legacy", StorageConfig: &indexpb.StorageConfig{}}}
+	req.DataPathRoots = map[string]string{"b": "legacy"}
+	assert.NoError(t, checkStorageRoots(req))
+
+	req.DataPathRoots["a"] = "other"
+	assert.EqualError(t, checkStorageRoots(req), "binlog a is mapped to the unknown storage root other")
+	delete(req.DataPathRoots, "a")
+
+	req.StorageRoots = append(req.StorageRoots, &indexpb.StorageRoot{Name: "legacy", StorageConfig: &indexpb.StorageConfig{}})
+	assert.EqualError(t, checkStorageRoots(req), "invalid storage root legacy: duplicated name")
+	req.StorageRoots[1] = &indexpb.StorageRoot{Name: "other"}
+	assert.EqualError(t, checkStorageRoots(req), "invalid storage root other: no storage config")
+	req.StorageRoots[1] = &indexpb.StorageRoot{StorageConfig: &indexpb.StorageConfig{}}
+	assert.EqualError(t, checkStorageRoots(req), "invalid storage root: empty name")
+	req.StorageRoots[1] = &indexpb.StorageRoot{Name: "archive", StorageConfig: &indexpb.StorageConfig{}, Tier: "glacier"}
+	assert.EqualError(t, checkStorageRoots(req), "invalid storage root archive: unknown tier glacier")
+	req.StorageRoots[1].Tier = storageTierCold
+	assert.NoError(t, checkStorageRoots(req))
+}
+
+func TestMultiRootChunkManager(t *testing.T) {
+	ctx := context.Background()
+	current := &memRootChunkManager{files: map[string][]byte{"a": []byte("a1"), "c": []byte("c1")}}
+	legacy := &memRootChunkManager{files: map[string][]byte{"b": []byte("b22"), "a": []byte("stale")}}
+	cm := newMultiRootChunkManager(current, map[string]storage.ChunkManager{"legacy": legacy},
+		map[string]string{"b": "legacy", "d": "missing"})
+
+	data, err := cm.Read(ctx, "a")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("a1"), data)
+	data, err = cm.Read(ctx, "b")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("b22"), data)
+	size, err := cm.Size(ctx, "b")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, size)
+
+	values, err := cm.MultiRead(ctx, []string{"b", "a", "c"})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("b22"), []byte("a1"), []byte("c1")}, values)
+
+	// the paths mapped to a root not opened are read from storage_config.
+	_, err = cm.Read(ctx, "d")
+	assert.ErrorIs(t, err, storage.ErrNoSuchKey)
+	_, err = cm.MultiRead(ctx, []string{"a", "d"})
+	assert.Error(t, err)
+}
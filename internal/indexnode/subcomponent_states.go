@@ -0,0 +1,118 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+)
+
+const (
+	subcomponentScheduler = "scheduler"
+	subcomponentStorage   = "storage"
+	subcomponentKnowhere  = "knowhere"
+	subcomponentSession   = "session"
+
+	lastErrorInfoKey     = "last_error"
+	lastErrorTimeInfoKey = "last_error_time"
+
+	// subcomponentErrorWindow is how long a subcomponent is reported abnormal after its last error.
+	subcomponentErrorWindow = time.Minute
+)
+
+// subcomponents are the subcomponents reported by GetComponentStates in order.
+var subcomponents = []string{subcomponentScheduler, subcomponentStorage, subcomponentKnowhere, subcomponentSession}
+
+type subcomponentError struct {
+	err string
+	at  time.Time
+}
+
+// subcomponentErrors keeps the last error of every subcomponent of the node. A nil one records nothing.
+type subcomponentErrors struct {
+	mu   sync.Mutex
+	errs map[string]subcomponentError
+}
+
+func newSubcomponentErrors() *subcomponentErrors {
+	return &subcomponentErrors{errs: make(map[string]subcomponentError)}
+}
+
+func (s *subcomponentErrors) record(name string, err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errs[name] = subcomponentError{err: err.Error(), at: time.Now()}
+}
+
+// recordStage records the error failing the stage of a task against the subcomponent the stage depends on,
+// the loading and saving stages depend on the storage and the building stage on knowhere.
+func (s *subcomponentErrors) recordStage(phase taskPhase, err error) {
+	switch phase {
+	case taskLoading, taskSaving:
+		s.record(subcomponentStorage, err)
+	case taskBuilding:
+		s.record(subcomponentKnowhere, err)
+	}
+}
+
+func (s *subcomponentErrors) last(name string) (subcomponentError, bool) {
+	if s == nil {
+		return subcomponentError{}, false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.errs[name]
+	return e, ok
+}
+
+// subcomponentStates returns the states of the subcomponents of the node. A subcomponent takes the state of the node,
+// but it's abnormal in the healthy node within subcomponentErrorWindow after its last error, the session is abnormal
+// too while it's not registered. The last errors are reported in the extra info.
+func (i *IndexNode) subcomponentStates(nodeID UniqueID, nodeState commonpb.StateCode) []*milvuspb.ComponentInfo {
+	now := time.Now()
+	states := make([]*milvuspb.ComponentInfo, 0, len(subcomponents))
+	for _, name := range subcomponents {
+		state := &milvuspb.ComponentInfo{
+			NodeID:    nodeID,
+			Role:      name,
+			StateCode: nodeState,
+		}
+		lastErr, ok := i.subErrors.last(name)
+		if ok {
+			state.ExtraInfo = []*commonpb.KeyValuePair{
+				{Key: lastErrorInfoKey, Value: lastErr.err},
+				{Key: lastErrorTimeInfoKey, Value: lastErr.at.Format(time.RFC3339)},
+			}
+		}
+		if nodeState == commonpb.StateCode_Healthy {
+			if ok && now.Sub(lastErr.at) < subcomponentErrorWindow {
+				state.StateCode = commonpb.StateCode_Abnormal
+			}
+			if name == subcomponentSession && (i.session == nil || !i.session.Registered()) {
+				state.StateCode = commonpb.StateCode_Abnormal
+			}
+		}
+		states = append(states, state)
+	}
+	return states
+}
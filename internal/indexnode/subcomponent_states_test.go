@@ -0,0 +1,68 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+func TestSubcomponentStates(t *testing.T) {
+	node := &IndexNode{subErrors: newSubcomponentErrors(), session: &sessionutil.Session{}}
+	node.session.UpdateRegistered(true)
+
+	states := node.subcomponentStates(1, commonpb.StateCode_Healthy)
+	assert.Len(t, states, len(subcomponents))
+	for i, state := range states {
+		assert.Equal(t, subcomponents[i], state.GetRole())
+		assert.Equal(t, commonpb.StateCode_Healthy, state.GetStateCode())
+		assert.Empty(t, state.GetExtraInfo())
+	}
+
+	node.subErrors.recordStage(taskSaving, errors.New("access denied"))
+	node.subErrors.recordStage(taskPreparing, errors.New("ignored"))
+	states = node.subcomponentStates(1, commonpb.StateCode_Healthy)
+	assert.Equal(t, commonpb.StateCode_Healthy, states[0].GetStateCode())
+	assert.Equal(t, commonpb.StateCode_Abnormal, states[1].GetStateCode())
+	assert.Equal(t, lastErrorInfoKey, states[1].GetExtraInfo()[0].GetKey())
+	assert.Equal(t, "access denied", states[1].GetExtraInfo()[0].GetValue())
+
+	// the last error is still reported after the window, the subcomponent is healthy again
+	node.subErrors.errs[subcomponentStorage] = subcomponentError{err: "access denied", at: time.Now().Add(-subcomponentErrorWindow)}
+	states = node.subcomponentStates(1, commonpb.StateCode_Healthy)
+	assert.Equal(t, commonpb.StateCode_Healthy, states[1].GetStateCode())
+	assert.Len(t, states[1].GetExtraInfo(), 2)
+
+	// the subcomponents follow the node which is not healthy
+	states = node.subcomponentStates(1, commonpb.StateCode_Stopping)
+	assert.Equal(t, commonpb.StateCode_Stopping, states[1].GetStateCode())
+
+	node.session.UpdateRegistered(false)
+	states = node.subcomponentStates(1, commonpb.StateCode_Healthy)
+	assert.Equal(t, commonpb.StateCode_Abnormal, states[3].GetStateCode())
+
+	var nilErrors *subcomponentErrors
+	nilErrors.record(subcomponentScheduler, errors.New("ignored"))
+	_, ok := nilErrors.last(subcomponentScheduler)
+	assert.False(t, ok)
+}
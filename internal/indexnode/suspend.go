@@ -0,0 +1,107 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// The keys of the ExtraInfo of GetComponentStates reporting the suspension of the node, the state code of
+// a suspended node stays Healthy so its session is kept and it isn't replaced.
+const (
+	suspendedInfoKey      = "suspended"
+	suspendReasonInfoKey  = "suspend_reason"
+	suspendedSinceInfoKey = "suspended_since"
+)
+
+// nodeSuspension fences the node for investigation, the suspended node rejects the new jobs and
+// reports no task slot, the running jobs go on. The node is suspended by SetSuspended or indexNode.suspend.enable.
+type nodeSuspension struct {
+	params *paramtable.ComponentParam
+
+	mu        sync.Mutex
+	suspended bool
+	reason    string
+	since     time.Time
+}
+
+func newNodeSuspension(params *paramtable.ComponentParam) *nodeSuspension {
+	return &nodeSuspension{params: params}
+}
+
+// set suspends the node with the reason or lifts the suspension set before.
+func (s *nodeSuspension) set(suspended bool, reason string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if suspended && !s.suspended {
+		s.since = time.Now()
+	}
+	s.suspended = suspended
+	s.reason = reason
+	if !suspended {
+		s.since = time.Time{}
+	}
+	log.Info("IndexNode suspension changed", zap.Bool("suspended", suspended), zap.String("reason", reason))
+}
+
+// state returns whether the node is suspended, why and since when, the suspension set by SetSuspended
+// takes precedence over the one of indexNode.suspend.enable which has no start time.
+func (s *nodeSuspension) state() (bool, string, time.Time) {
+	if s != nil {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if s.suspended {
+			return true, s.reason, s.since
+		}
+	}
+	if s.params.IndexNodeCfg.SuspendEnable.GetAsBool() {
+		return true, "suspended by indexNode.suspend.enable", time.Time{}
+	}
+	return false, "", time.Time{}
+}
+
+// check returns an error if the node is suspended.
+func (s *nodeSuspension) check() error {
+	if suspended, reason, _ := s.state(); suspended {
+		return fmt.Errorf("IndexNode is suspended: %s", reason)
+	}
+	return nil
+}
+
+// extraInfo returns the ExtraInfo of GetComponentStates describing the suspension, nil if not suspended.
+func (s *nodeSuspension) extraInfo() []*commonpb.KeyValuePair {
+	suspended, reason, since := s.state()
+	if !suspended {
+		return nil
+	}
+	info := []*commonpb.KeyValuePair{
+		{Key: suspendedInfoKey, Value: "true"},
+		{Key: suspendReasonInfoKey, Value: reason},
+	}
+	if !since.IsZero() {
+		info = append(info, &commonpb.KeyValuePair{Key: suspendedSinceInfoKey, Value: since.Format(time.RFC3339)})
+	}
+	return info
+}
@@ -0,0 +1,59 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestNodeSuspension(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	s := newNodeSuspension(params)
+	assert.NoError(t, s.check())
+	assert.Nil(t, s.extraInfo())
+
+	s.set(true, "suspect disk")
+	suspended, reason, since := s.state()
+	assert.True(t, suspended)
+	assert.Equal(t, "suspect disk", reason)
+	assert.False(t, since.IsZero())
+	assert.ErrorContains(t, s.check(), "suspect disk")
+	info := s.extraInfo()
+	assert.Len(t, info, 3)
+	assert.Equal(t, suspendedInfoKey, info[0].GetKey())
+	assert.Equal(t, "true", info[0].GetValue())
+	assert.Equal(t, "suspect disk", info[1].GetValue())
+
+	// suspending again keeps the start time.
+	s.set(true, "suspect memory")
+	_, reason, again := s.state()
+	assert.Equal(t, "suspect memory", reason)
+	assert.Equal(t, since, again)
+
+	s.set(false, "")
+	assert.NoError(t, s.check())
+
+	params.Save(params.IndexNodeCfg.SuspendEnable.Key, "true")
+	assert.Error(t, s.check())
+	assert.Len(t, s.extraInfo(), 2)
+	var nilSuspension *nodeSuspension
+	assert.Error(t, nilSuspension.check())
+}
@@ -24,6 +24,7 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
@@ -36,16 +37,17 @@ import (
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/indexcgowrapper"
+	"github.com/milvus-io/milvus/internal/util/indexmanifest"
 	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
 	"github.com/milvus-io/milvus/internal/util/indexparams"
 	"github.com/milvus-io/milvus/internal/util/metautil"
-	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 )
 
 var (
 	errCancel      = fmt.Errorf("canceled")
+	errBruteForce  = errors.New("too few rows to build index")
 	diskUsageRatio = 4.0
 )
 
@@ -53,13 +55,29 @@ type Blob = storage.Blob
 
 type taskInfo struct {
 	cancel         context.CancelFunc
-	state          commonpb.IndexState
+	phase          taskPhase
 	fileKeys       []string
 	serializedSize uint64
+	fileSizes      []uint64
+	memSize        uint64
+	bruteForce     bool
 	failReason     string
+	failCode       commonpb.ErrorCode
+	failureDomain  indexpb.FailureDomain
+	// warnings describe what the build degraded silently.
+	warnings []string
+	// priority is the priority of the job, kept here as the request of the task is released by Reset.
+	priority  int32
+	startTime time.Time
+	// endTime is when the task completed, the retention policy evicts the infos of the completed tasks by it.
+	endTime time.Time
+	// collectionID is known once the data of the task is loaded.
+	collectionID UniqueID
 
 	// task statistics
 	statistic *indexpb.JobInfo
+	// stageMemDeltas are the C heap deltas of the stages recorded by the cgoMemTracer.
+	stageMemDeltas []*indexpb.StageMemDelta
 }
 
 type task interface {
@@ -70,8 +88,13 @@ type task interface {
 	BuildIndex(context.Context) error
 	SaveIndexFiles(context.Context) error
 	OnEnqueue(context.Context) error
-	SetState(state commonpb.IndexState, failReason string)
+	// SetPhase moves the task to the phase, it fails if the transition is illegal.
+	SetPhase(phase taskPhase, failReason string) error
 	GetState() commonpb.IndexState
+	// Tenant is the tenant sharing the build slots fairly with the others.
+	Tenant() string
+	// IndexType is the index type of the task, the build slots are accounted by it.
+	IndexType() string
 	Reset()
 }
 
@@ -82,7 +105,8 @@ type indexBuildTask struct {
 	ctx    context.Context
 
 	cm             storage.ChunkManager
-	index          indexcgowrapper.CodecIndex
+	engine         BuildEngine
+	chunked        ChunkedSerializer // the engine when the index is serialized file by file in SaveIndexFiles
 	savePaths      []string
 	req            *indexpb.CreateJobRequest
 	BuildID        UniqueID
@@ -97,23 +121,66 @@ type indexBuildTask struct {
 	newTypeParams  map[string]string
 	newIndexParams map[string]string
 	serializedSize uint64
+	memSize        uint64
 	tr             *timerecord.TimeRecorder
 	statistic      indexpb.JobInfo
 	node           *IndexNode
+	requester      string
+	// datasetMu guards fieldData, req and cm against the handoff on stopping, which stages the dataset
+	// outside of the task goroutine. The task goroutine reads them without the lock as it's the only writer.
+	datasetMu sync.Mutex
+	// staged is set once the dataset has been staged for handoff.
+	staged bool
+	// stagingDir is the staging directory of the local build files, stagingSize is reserved in it.
+	stagingDir  string
+	stagingSize int64
+	// autoIndex is the index chosen for an AUTOINDEX job, nil for the other jobs.
+	autoIndex *autoIndexDecision
+	// sandboxPID is the pid of the running build helper of a sandboxed build, it's read atomically by the watchdog.
+	sandboxPID int64
+	// validity is the validity of the rows of the nullable vector field, nil if the field is not nullable.
+	validity []bool
+	// pks are the primary keys of the segment, they're loaded for the pk offsets of the job
+	// and released once the pk offsets are built.
+	pks storage.FieldData
+	// pkOffsets is the PK_OFFSETS index file, nil if the job doesn't emit it.
+	pkOffsets *Blob
+	// rebuildVersion stages the index files of the in-place rebuild, 0 if the job is not rebuilt in place.
+	rebuildVersion int64
 }
 
 func (it *indexBuildTask) Reset() {
+	it.datasetMu.Lock()
+	defer it.datasetMu.Unlock()
+	it.removeDroppedIndexFiles()
+	// the local files of a task canceled before it finished are removed once its goroutine exits, when nothing
+	// writes them anymore.
+	if it.node != nil && it.ctx != nil && it.ctx.Err() != nil &&
+		it.node.loadTaskState(it.ClusterID, it.BuildID) != commonpb.IndexState_Finished {
+		it.node.removeLocalIndexFiles(it.BuildID)
+	}
+	if it.stagingDir != "" {
+		it.node.staging.release(it.stagingDir, it.stagingSize)
+		it.stagingDir = ""
+	}
 	it.ident = ""
 	it.cancel = nil
 	it.ctx = nil
 	it.cm = nil
-	it.index = nil
+	if it.chunked != nil {
+		// the index is not released yet if the task failed before saving it.
+		it.engine.Delete()
+		it.chunked = nil
+	}
+	it.engine = nil
 	it.savePaths = nil
 	it.req = nil
 	it.fieldData = nil
 	it.indexBlobs = nil
 	it.newTypeParams = nil
 	it.newIndexParams = nil
+	it.pks = nil
+	it.pkOffsets = nil
 	it.tr = nil
 	it.node = nil
 }
@@ -128,8 +195,28 @@ func (it *indexBuildTask) Name() string {
 	return it.ident
 }
 
-func (it *indexBuildTask) SetState(state commonpb.IndexState, failReason string) {
-	it.node.storeTaskState(it.ClusterID, it.BuildID, state, failReason)
+func (it *indexBuildTask) SetPhase(phase taskPhase, failReason string) error {
+	if err := it.node.transitTaskPhase(it.ClusterID, it.BuildID, phase, failReason); err != nil {
+		return err
+	}
+	if phase.isTerminal() {
+		it.writeAuditRecord(phase.indexState(), failReason)
+	}
+	if phase == taskFinished {
+		// the job finished here after all, nobody needs the staged dataset.
+		it.removeStagedDataset(it.ctx)
+	}
+	return nil
+}
+
+// Tenant is the cluster which submits the task.
+func (it *indexBuildTask) Tenant() string {
+	return it.ClusterID
+}
+
+// IndexType is the index type of the job, AUTOINDEX if the node chooses it.
+func (it *indexBuildTask) IndexType() string {
+	return funcutil.KeyValuePair2Map(it.req.GetIndexParams())["index_type"]
 }
 
 func (it *indexBuildTask) GetState() commonpb.IndexState {
@@ -173,14 +260,71 @@ func (it *indexBuildTask) Prepare(ctx context.Context) error {
 			// ignore error
 		}
 	}
+	it.applyParamProfiles(ctx)
+	it.fillNList(ctx, it.req.GetNumRows())
+	if compactingIndex(it.req) {
+		log.Ctx(ctx).Info("Successfully prepare indexBuildTask compacting the index files", zap.Int64("buildID", it.BuildID))
+		return nil
+	}
+	if err := it.checkBruteForce(it.req.GetNumRows()); err != nil {
+		return err
+	}
 	log.Ctx(ctx).Info("Successfully prepare indexBuildTask", zap.Int64("buildID", it.BuildID),
 		zap.Int64("Collection", it.collectionID), zap.Int64("SegmentIf", it.segmentID))
 	return nil
 }
 
+// warn records a warning of the build, which is reported with the result of the task.
+func (it *indexBuildTask) warn(ctx context.Context, format string, args ...interface{}) {
+	warning := fmt.Sprintf(format, args...)
+	log.Ctx(ctx).Warn("IndexNode build degraded", zap.Int64("buildID", it.BuildID), zap.String("warning", warning))
+	it.node.storeTaskWarning(it.ClusterID, it.BuildID, warning)
+}
+
+// checkBruteForce returns errBruteForce if numRows is below indexNode.bruteForceRowThreshold,
+// where an index buys nothing over brute force search. It does nothing if numRows is unknown.
+func (it *indexBuildTask) checkBruteForce(numRows int64) error {
+	threshold := it.node.params.IndexNodeCfg.BruteForceRowThreshold.GetAsInt64()
+	if numRows <= 0 || numRows >= threshold {
+		return nil
+	}
+	it.node.storeTaskBruteForce(it.ClusterID, it.BuildID)
+	return fmt.Errorf("%w: %d rows, the threshold is %d", errBruteForce, numRows, threshold)
+}
+
 func (it *indexBuildTask) LoadData(ctx context.Context) error {
+	if compactingIndex(it.req) {
+		return it.loadIndexFiles(ctx)
+	}
+	if loaded, err := it.loadStagedDataset(ctx); loaded {
+		return err
+	}
+	if err := it.awaitRestore(ctx); err != nil {
+		return err
+	}
+	loaded, publish, err := it.loadSharedDataset(ctx)
+	if err != nil {
+		return err
+	}
+	if loaded {
+		it.node.storeTaskCollection(it.ClusterID, it.BuildID, it.collectionID)
+		return it.prepareDataset(ctx)
+	}
+	if publish != nil {
+		// the dataset is shared even if the task fails after decoding, like a segment too small to index.
+		defer func() { publish(it.sharedDataset()) }()
+	}
+	// the binlogs are decoded into new buffers, so they are released once the data is loaded.
+	arena := newStageArena(it.node.params, taskLoading)
+	defer arena.release()
 	getValueByPath := func(path string) ([]byte, error) {
-		data, err := it.cm.Read(ctx, path)
+		var data []byte
+		var err error
+		if arena != nil {
+			data, err = arena.readFile(ctx, it.cm, path)
+		} else {
+			data, err = it.cm.Read(ctx, path)
+		}
 		if err != nil {
 			if errors.Is(err, ErrNoSuchKey) {
 				return nil, ErrNoSuchKey
@@ -203,9 +347,14 @@ func (it *indexBuildTask) LoadData(ctx context.Context) error {
 	toLoadDataPaths := it.req.GetDataPaths()
 	keys := make([]string, len(toLoadDataPaths))
 	blobs := make([]*Blob, len(toLoadDataPaths))
+	streamed := it.streamBinlogs(ctx, toLoadDataPaths)
 
 	loadKey := func(idx int) error {
 		keys[idx] = toLoadDataPaths[idx]
+		if value, ok := streamed[toLoadDataPaths[idx]]; ok {
+			blobs[idx] = &Blob{Key: toLoadDataPaths[idx], Value: value}
+			return nil
+		}
 		blob, err := getBlobByPath(toLoadDataPaths[idx])
 		if err != nil {
 			return err
@@ -216,20 +365,29 @@ func (it *indexBuildTask) LoadData(ctx context.Context) error {
 	// Use runtime.GOMAXPROCS(0) instead of runtime.NumCPU()
 	// to respect CPU quota of container/pod
 	// gomaxproc will be set by `automaxproc`, passing 0 will just retrieve the value
-	err := funcutil.ProcessFuncParallel(len(toLoadDataPaths), runtime.GOMAXPROCS(0), loadKey, "loadKey")
+	err = funcutil.ProcessFuncParallel(len(toLoadDataPaths), it.clampParallel(runtime.GOMAXPROCS(0)), loadKey, "loadKey")
 	if err != nil {
 		log.Ctx(ctx).Warn("loadKey failed", zap.Error(err))
 		return err
 	}
 
 	loadFieldDataLatency := it.tr.CtxRecord(ctx, "load field data done")
-	metrics.IndexNodeLoadFieldLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Observe(float64(loadFieldDataLatency.Milliseconds()))
+
+	if it.req.GetEmitPkOffsets() {
+		if _, err = it.loadPks(ctx); err != nil {
+			log.Ctx(ctx).Warn("load primary keys failed", zap.Error(err))
+			return err
+		}
+	}
 
 	err = it.decodeBlobs(ctx, blobs)
+	// the collection of the task is known once the binlogs are decoded
+	observeLatency(ctx, metrics.IndexNodeLoadFieldLatency.WithLabelValues(it.metricLabels()...), loadFieldDataLatency)
 	if err != nil {
 		log.Ctx(ctx).Info("failed to decode blobs", zap.Int64("buildID", it.BuildID),
 			zap.Int64("Collection", it.collectionID), zap.Int64("SegmentIf", it.segmentID), zap.Error(err))
 	} else {
+		it.node.storeTaskCollection(it.ClusterID, it.BuildID, it.collectionID)
 		log.Ctx(ctx).Info("Successfully load data", zap.Int64("buildID", it.BuildID),
 			zap.Int64("Collection", it.collectionID), zap.Int64("SegmentIf", it.segmentID))
 	}
@@ -237,19 +395,39 @@ func (it *indexBuildTask) LoadData(ctx context.Context) error {
 }
 
 func (it *indexBuildTask) BuildIndex(ctx context.Context) error {
+	if compactingIndex(it.req) {
+		return it.compactIndexFiles(ctx)
+	}
+	defer isolateBuildThread(ctx, it.node.params)()
+	defer it.watchBuild(ctx)()
+	it.deprioritizeBuildThread(ctx)
+	defer it.applyBuildIOPriority(ctx)()
+
 	// support build diskann index
 	indexType := it.newIndexParams["index_type"]
 	if indexType == indexparamcheck.IndexDISKANN {
 		return it.BuildDiskAnnIndex(ctx)
 	}
 
+	newEngine, err := getBuildEngineFactory(it.req.GetEngineVersion())
+	if err != nil {
+		log.Ctx(ctx).Error("failed to get the build engine", zap.Error(err))
+		return err
+	}
+	if it.sandboxed() {
+		newEngine = newSandboxEngine(ctx, it.node.params, it.node.builders, it.req.GetEngineVersion(), &it.sandboxPID)
+	}
 	dataset := indexcgowrapper.GenDataset(it.fieldData)
 	dType := dataset.DType
-	var err error
 	if dType != schemapb.DataType_None {
-		it.index, err = indexcgowrapper.NewCgoIndex(dType, it.newTypeParams, it.newIndexParams, it.req.GetStorageConfig())
+		if engine, ok := newFlatEngine(it.node.params, dType, it.newTypeParams, it.newIndexParams); ok && it.req.GetEngineVersion() == "" {
+			log.Ctx(ctx).Info("IndexNode build FLAT index by copying the vectors", zap.Int64("buildID", it.BuildID))
+			it.engine = engine
+		} else {
+			it.engine, err = newEngine(dType, it.newTypeParams, it.newIndexParams, it.req.GetStorageConfig())
+		}
 		if err == nil {
-			err = it.index.Build(dataset)
+			err = it.buildWithEngine(ctx, dataset)
 		}
 
 		if err != nil {
@@ -259,14 +437,24 @@ func (it *indexBuildTask) BuildIndex(ctx context.Context) error {
 	}
 
 	buildIndexLatency := it.tr.Record("build index done")
-	metrics.IndexNodeKnowhereBuildIndexLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Observe(float64(buildIndexLatency.Milliseconds()))
+	observeLatency(ctx, metrics.IndexNodeKnowhereBuildIndexLatency.WithLabelValues(it.metricLabels()...), buildIndexLatency)
+	it.observeBuildThroughput(indexType, buildIndexLatency)
 
-	indexBlobs, err := it.index.Serialize()
+	if it.chunked = it.chunkedSerializer(); it.chunked != nil {
+		log.Ctx(ctx).Info("Successfully build index, it's serialized file by file while saving", zap.Int64("buildID", it.BuildID),
+			zap.Int64("Collection", it.collectionID), zap.Int64("SegmentID", it.segmentID))
+		return nil
+	}
+
+	indexBlobs, err := it.engine.Serialize()
 	if err != nil {
 		log.Ctx(ctx).Error("IndexNode index Serialize failed", zap.Error(err))
 		return err
 	}
 	it.tr.Record("index serialize done")
+	if it.pkOffsets != nil {
+		indexBlobs = append(indexBlobs, it.pkOffsets)
+	}
 
 	// use serialized size before encoding
 	it.serializedSize = 0
@@ -275,7 +463,7 @@ func (it *indexBuildTask) BuildIndex(ctx context.Context) error {
 	}
 
 	// early release index for gc, and we can ensure that Delete is idempotent.
-	if err := it.index.Delete(); err != nil {
+	if err := it.engine.Delete(); err != nil {
 		log.Ctx(ctx).Error("IndexNode indexBuildTask Execute CIndexDelete failed", zap.Error(err))
 	}
 
@@ -297,39 +485,70 @@ func (it *indexBuildTask) BuildIndex(ctx context.Context) error {
 		return err
 	}
 	encodeIndexFileDur := it.tr.Record("index codec serialize done")
-	metrics.IndexNodeEncodeIndexFileLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Observe(float64(encodeIndexFileDur.Milliseconds()))
+	observeLatency(ctx, metrics.IndexNodeEncodeIndexFileLatency.WithLabelValues(it.metricLabels()...), encodeIndexFileDur)
 	it.indexBlobs = serializedIndexBlobs
 	log.Ctx(ctx).Info("Successfully build index", zap.Int64("buildID", it.BuildID),
 		zap.Int64("Collection", it.collectionID), zap.Int64("SegmentID", it.segmentID))
 	return nil
 }
 
+// buildWithEngine trains the engine, then adds the dataset into the index.
+func (it *indexBuildTask) buildWithEngine(ctx context.Context, dataset *indexcgowrapper.Dataset) error {
+	if err := it.engine.Train(dataset); err != nil {
+		return err
+	}
+	return it.engine.Add(dataset)
+}
+
 func (it *indexBuildTask) BuildDiskAnnIndex(ctx context.Context) error {
 	// check index node support disk index
-	if !Params.IndexNodeCfg.EnableDisk.GetAsBool() {
+	if !it.node.params.IndexNodeCfg.EnableDisk.GetAsBool() {
 		log.Ctx(ctx).Error("IndexNode don't support build disk index",
 			zap.String("index type", it.newIndexParams["index_type"]),
-			zap.Bool("enable disk", Params.IndexNodeCfg.EnableDisk.GetAsBool()))
+			zap.Bool("enable disk", it.node.params.IndexNodeCfg.EnableDisk.GetAsBool()))
 		return errors.New("index node don't support build disk index")
 	}
 
-	// check load size and size of field data
-	localUsedSize, err := indexcgowrapper.GetLocalUsedSize()
-	if err != nil {
-		log.Ctx(ctx).Error("IndexNode get local used size failed")
-		return errors.New("index node get local used size failed")
-	}
+	buildLocalSize := int64(float64(it.fieldData.GetMemorySize()) * diskUsageRatio)
+	if it.node.staging.enabled() {
+		// the quotas of the staging dirs take the place of the disk capacity of the local storage.
+		stagingDir, err := it.node.staging.acquire(buildLocalSize)
+		if err != nil {
+			log.Ctx(ctx).Error("IndexNode acquire staging dir failed", zap.Error(err))
+			return err
+		}
+		it.datasetMu.Lock()
+		it.stagingDir, it.stagingSize = stagingDir, buildLocalSize
+		it.datasetMu.Unlock()
+		log.Ctx(ctx).Info("IndexNode build disk index in staging dir", zap.Int64("buildID", it.BuildID),
+			zap.String("dir", stagingDir), zap.Int64("size", buildLocalSize))
+	} else {
+		// check load size and size of field data
+		localUsedSize, err := indexcgowrapper.GetLocalUsedSize()
+		if err != nil {
+			log.Ctx(ctx).Error("IndexNode get local used size failed")
+			return errors.New("index node get local used size failed")
+		}
 
-	usedLocalSizeWhenBuild := int64(float64(it.fieldData.GetMemorySize())*diskUsageRatio) + localUsedSize
-	maxUsedLocalSize := int64(Params.IndexNodeCfg.DiskCapacityLimit.GetAsFloat() * Params.IndexNodeCfg.MaxDiskUsagePercentage.GetAsFloat())
+		usedLocalSizeWhenBuild := buildLocalSize + localUsedSize
+		maxUsedLocalSize := int64(it.node.params.IndexNodeCfg.DiskCapacityLimit.GetAsFloat() * it.node.params.IndexNodeCfg.MaxDiskUsagePercentage.GetAsFloat())
 
-	if usedLocalSizeWhenBuild > maxUsedLocalSize {
-		log.Ctx(ctx).Error("IndexNode don't has enough disk size to build disk ann index",
-			zap.Int64("usedLocalSizeWhenBuild", usedLocalSizeWhenBuild),
-			zap.Int64("maxUsedLocalSize", maxUsedLocalSize))
-		return errors.New("index node don't has enough disk size to build disk ann index")
+		if usedLocalSizeWhenBuild > maxUsedLocalSize {
+			log.Ctx(ctx).Error("IndexNode don't has enough disk size to build disk ann index",
+				zap.Int64("usedLocalSizeWhenBuild", usedLocalSizeWhenBuild),
+				zap.Int64("maxUsedLocalSize", maxUsedLocalSize))
+			return errors.New("index node don't has enough disk size to build disk ann index")
+		}
 	}
 
+	if version := it.req.GetEngineVersion(); version != "" && version != defaultEngineVersion {
+		log.Ctx(ctx).Error("IndexNode only builds disk index with the default engine", zap.String("engineVersion", version))
+		return fmt.Errorf("engine version %s does not support disk index", version)
+	}
+	if err := it.checkScratchEncryption(); err != nil {
+		log.Ctx(ctx).Error("IndexNode can't encrypt the local files of disk index", zap.Error(err))
+		return err
+	}
 	dataset := indexcgowrapper.GenDataset(it.fieldData)
 	dType := dataset.DType
 	if dType != schemapb.DataType_None {
@@ -341,13 +560,23 @@ func (it *indexBuildTask) BuildDiskAnnIndex(ctx context.Context) error {
 		it.newIndexParams["index_build_id"] = strconv.FormatInt(it.req.GetBuildID(), 10)
 		it.newIndexParams["index_id"] = strconv.FormatInt(it.req.IndexID, 10)
 		it.newIndexParams["index_version"] = strconv.FormatInt(it.req.GetIndexVersion(), 10)
+		if it.stagingDir != "" {
+			it.newIndexParams["index_local_root_path"] = it.stagingDir
+		}
 
-		err = indexparams.SetDiskIndexBuildParams(it.newIndexParams, it.statistic.NumRows)
+		err := indexparams.SetDiskIndexBuildParams(it.newIndexParams, it.statistic.NumRows)
 		if err != nil {
 			log.Ctx(ctx).Error("failed to fill disk index params", zap.Error(err))
 			return err
 		}
-		jsonIndexParams, err := json.Marshal(it.newIndexParams)
+		// the thread caps apply to this build only, newIndexParams is saved as the params of the index.
+		buildParams := make(map[string]string, len(it.newIndexParams))
+		for key, value := range it.newIndexParams {
+			buildParams[key] = value
+		}
+		it.applyBuildThreadRatio(ctx, buildParams)
+		it.applyBackgroundThreads(ctx, buildParams)
+		jsonIndexParams, err := json.Marshal(buildParams)
 		if err != nil {
 			log.Ctx(ctx).Error("failed to json marshal index params", zap.Error(err))
 			return err
@@ -356,15 +585,17 @@ func (it *indexBuildTask) BuildDiskAnnIndex(ctx context.Context) error {
 			zap.Int64("buildID", it.BuildID),
 			zap.String("index params", string(jsonIndexParams)))
 
-		it.index, err = indexcgowrapper.NewCgoIndex(dType, it.newTypeParams, it.newIndexParams, it.req.GetStorageConfig())
+		var index indexcgowrapper.CodecIndex
+		index, err = indexcgowrapper.NewCgoIndex(dType, it.newTypeParams, buildParams, it.req.GetStorageConfig())
 		if err != nil {
 			log.Ctx(ctx).Error("failed to create index", zap.Error(err))
 		} else {
-			err = it.index.Build(dataset)
+			it.engine = &knowhereEngine{index: index}
+			err = index.Build(dataset)
 		}
 
 		if err != nil {
-			if it.index != nil && it.index.CleanLocalData() != nil {
+			if index != nil && index.CleanLocalData() != nil {
 				log.Ctx(ctx).Error("failed to clean cached data on disk after build index failed",
 					zap.Int64("buildID", it.BuildID),
 					zap.Int64("index version", it.req.GetIndexVersion()))
@@ -375,9 +606,10 @@ func (it *indexBuildTask) BuildDiskAnnIndex(ctx context.Context) error {
 	}
 
 	buildIndexLatency := it.tr.Record("build index done")
-	metrics.IndexNodeKnowhereBuildIndexLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Observe(float64(buildIndexLatency.Milliseconds()))
+	observeLatency(ctx, metrics.IndexNodeKnowhereBuildIndexLatency.WithLabelValues(it.metricLabels()...), buildIndexLatency)
+	it.observeBuildThroughput(indexparamcheck.IndexDISKANN, buildIndexLatency)
 
-	fileInfos, err := it.index.GetIndexFileInfo()
+	fileInfos, err := it.engine.(*knowhereEngine).index.GetIndexFileInfo()
 	if err != nil {
 		log.Ctx(ctx).Error("IndexNode index Serialize failed", zap.Error(err))
 		return err
@@ -395,53 +627,158 @@ func (it *indexBuildTask) BuildDiskAnnIndex(ctx context.Context) error {
 	}
 
 	// early release index for gc, and we can ensure that Delete is idempotent.
-	if err := it.index.Delete(); err != nil {
+	if err := it.engine.Delete(); err != nil {
 		log.Ctx(it.ctx).Error("IndexNode indexBuildTask Execute CIndexDelete failed", zap.Error(err))
 	}
 
 	encodeIndexFileDur := it.tr.Record("index codec serialize done")
-	metrics.IndexNodeEncodeIndexFileLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Observe(float64(encodeIndexFileDur.Milliseconds()))
+	observeLatency(ctx, metrics.IndexNodeEncodeIndexFileLatency.WithLabelValues(it.metricLabels()...), encodeIndexFileDur)
 	return nil
 }
 
+// observeBuildThroughput records the build throughput of the task in the baselines of the scheduler.
+func (it *indexBuildTask) observeBuildThroughput(indexType string, latency time.Duration) {
+	if it.node == nil || it.node.sched == nil {
+		return
+	}
+	it.node.sched.baselines.observe(indexType, it.statistic.Dim, it.statistic.NumRows, latency)
+}
+
+// applyBuildThreadRatio scales down the number of knowhere build threads in the build params when the build tuner
+// backs off. Only DiskANN takes the number of build threads from the build params, the in-memory indexes build on
+// the global knowhere thread pool, so the tuner holds them back by the build parallelism alone.
+func (it *indexBuildTask) applyBuildThreadRatio(ctx context.Context, buildParams map[string]string) {
+	if it.node.tuner == nil {
+		return
+	}
+	ratio := it.node.tuner.getThreadRatio()
+	numThreads, err := strconv.Atoi(buildParams[indexparams.NumBuildThreadKey])
+	if ratio >= 1 || err != nil {
+		return
+	}
+	scaled := int(float64(numThreads) * ratio)
+	if scaled < 1 {
+		scaled = 1
+	}
+	buildParams[indexparams.NumBuildThreadKey] = strconv.Itoa(scaled)
+	it.warn(ctx, "build threads scaled down from %d to %d due to node load", numThreads, scaled)
+}
+
 func (it *indexBuildTask) SaveIndexFiles(ctx context.Context) error {
 	// support build diskann index
 	indexType := it.newIndexParams["index_type"]
 	if indexType == indexparamcheck.IndexDISKANN {
 		return it.SaveDiskAnnIndexFiles(ctx)
 	}
+	if it.chunked != nil {
+		return it.saveIndexFilesChunked(ctx)
+	}
 
-	blobCnt := len(it.indexBlobs)
+	indexBlobs := it.indexBlobs
+	if codec, encoder, err := negotiateIndexFileCodec(it.node.params); err != nil {
+		it.warn(ctx, "index files are not compressed: %v", err)
+	} else if encoder != nil {
+		blobs, err := compressIndexBlobs(indexBlobs, codec, encoder)
+		if err != nil {
+			log.Ctx(ctx).Warn("failed to compress index files", zap.Int64("buildID", it.BuildID), zap.Error(err))
+			return err
+		}
+		indexBlobs = blobs
+	}
+
+	key := taskKey{ClusterID: it.ClusterID, BuildID: it.BuildID}
+	var uploadSize int64
+	for _, blob := range indexBlobs {
+		uploadSize += int64(len(blob.Value))
+	}
+	it.node.uploads.startTask(key, uploadSize)
+	defer it.node.uploads.finishTask(key)
+
+	blobCnt := len(indexBlobs)
 	savePaths := make([]string, blobCnt)
 	saveFileKeys := make([]string, blobCnt)
+	saveFileSizes := make([]uint64, blobCnt)
+	manifestFiles := make([]indexmanifest.File, blobCnt)
+	uploaded := newUploadedSlices()
 
 	saveIndexFile := func(idx int) error {
-		blob := it.indexBlobs[idx]
-		savePath := metautil.BuildSegmentIndexFilePath(it.cm.RootPath(), it.req.BuildID,
-			it.req.IndexVersion, it.partitionID, it.segmentID, blob.Key)
+		blob := indexBlobs[idx]
+		if it.node.manifestSigner != nil {
+			manifestFiles[idx] = indexmanifest.NewFile(blob.Key, blob.Value)
+		}
+		savePath := it.indexFilePath(blob.Key)
+		if uploaded.present(ctx, it.cm, savePath, blob.Value) {
+			return nil
+		}
 		saveFn := func() error {
 			return it.cm.Write(ctx, savePath, blob.Value)
 		}
-		if err := retry.Do(ctx, saveFn, retry.Attempts(5)); err != nil {
+		release, err := it.node.uploads.acquire(ctx, key)
+		if err != nil {
+			return err
+		}
+		err = retry.Do(ctx, saveFn, retry.Attempts(5))
+		release(int64(len(blob.Value)))
+		if err != nil {
 			log.Ctx(ctx).Warn("index node save index file failed", zap.Error(err), zap.String("savePath", savePath))
 			return err
 		}
+		uploaded.record(savePath, blob.Value)
 		savePaths[idx] = savePath
 		saveFileKeys[idx] = blob.Key
+		saveFileSizes[idx] = uint64(len(blob.Value))
 		return nil
 	}
 
+	uploadParallel := it.node.params.IndexNodeCfg.UploadParallel.GetAsInt()
+	if uploadParallel <= 0 {
+		uploadParallel = runtime.NumCPU()
+	}
+	uploadParallel = it.clampParallel(uploadParallel)
+	err := funcutil.ProcessFuncParallel(blobCnt, uploadParallel, saveIndexFile, "saveIndexFile")
+	// resume the upload skipping the slices already uploaded, the multi-GB indexes are not uploaded
+	// from the start again for a flaky link.
+	for attempt := 1; err != nil && ctx.Err() == nil && attempt <= it.node.params.IndexNodeCfg.UploadResumeAttempts.GetAsInt(); attempt++ {
+		log.Ctx(ctx).Warn("resume the upload of index files", zap.Int64("buildID", it.BuildID),
+			zap.Int("attempt", attempt), zap.Error(err))
+		err = funcutil.ProcessFuncParallel(blobCnt, uploadParallel, saveIndexFile, "saveIndexFile")
+	}
 	// If an error occurs, return the error that the task state will be set to retry.
-	if err := funcutil.ProcessFuncParallel(blobCnt, runtime.NumCPU(), saveIndexFile, "saveIndexFile"); err != nil {
+	if err != nil {
 		log.Ctx(ctx).Error("saveIndexFile fail")
 		return err
 	}
+	return it.finishSaveIndexFiles(ctx, savePaths, saveFileKeys, saveFileSizes, manifestFiles)
+}
+
+// finishSaveIndexFiles saves the signed manifest of the saved index files if signing is enabled,
+// and records the index files and the statistics of the task.
+func (it *indexBuildTask) finishSaveIndexFiles(ctx context.Context, savePaths, saveFileKeys []string,
+	saveFileSizes []uint64, manifestFiles []indexmanifest.File) error {
+	if it.node.manifestSigner != nil {
+		manifestPath, manifestSize, err := it.saveSignedManifest(ctx, manifestFiles)
+		if err != nil {
+			return err
+		}
+		savePaths = append(savePaths, manifestPath)
+		saveFileKeys = append(saveFileKeys, indexmanifest.Key)
+		saveFileSizes = append(saveFileSizes, manifestSize)
+	}
+	if it.rebuildVersion != 0 {
+		if err := it.swapIndexPointer(ctx, saveFileKeys); err != nil {
+			return err
+		}
+	}
 	it.savePaths = savePaths
+	it.node.replicator.enqueue(taskKey{ClusterID: it.ClusterID, BuildID: it.BuildID}, it.req, savePaths)
 	it.statistic.EndTime = time.Now().UnixMicro()
-	it.node.storeIndexFilesAndStatistic(it.ClusterID, it.BuildID, saveFileKeys, it.serializedSize, &it.statistic)
+	it.memSize = estimateLoadMemSize(it.newIndexParams["index_type"], it.newIndexParams,
+		it.statistic.NumRows, it.statistic.Dim, it.serializedSize)
+	it.node.storeIndexFilesAndStatistic(it.ClusterID, it.BuildID, saveFileKeys, saveFileSizes, it.serializedSize, it.memSize, &it.statistic)
+	it.rememberBuildResult(savePaths, saveFileKeys, saveFileSizes)
 	log.Ctx(ctx).Info("save index files done", zap.Strings("IndexFiles", savePaths))
 	saveIndexFileDur := it.tr.Record("index file save done")
-	metrics.IndexNodeSaveIndexFileLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Observe(float64(saveIndexFileDur.Milliseconds()))
+	observeLatency(ctx, metrics.IndexNodeSaveIndexFileLatency.WithLabelValues(it.metricLabels()...), saveIndexFileDur)
 	it.tr.Elapse("index building all done")
 	log.Ctx(ctx).Info("Successfully save index files", zap.Int64("buildID", it.BuildID), zap.Int64("Collection", it.collectionID),
 		zap.Int64("partition", it.partitionID), zap.Int64("SegmentId", it.segmentID))
@@ -451,10 +788,12 @@ func (it *indexBuildTask) SaveIndexFiles(ctx context.Context) error {
 func (it *indexBuildTask) SaveDiskAnnIndexFiles(ctx context.Context) error {
 	savePaths := make([]string, len(it.indexBlobs))
 	saveFileKeys := make([]string, len(it.indexBlobs))
+	saveFileSizes := make([]uint64, len(it.indexBlobs))
 
 	for i, blob := range it.indexBlobs {
 		savePath := blob.Key
 		savePaths[i] = savePath
+		saveFileSizes[i] = uint64(blob.Size)
 
 		// TODO: unify blob key to file key instead of full path
 		parts := strings.Split(blob.Key, "/")
@@ -494,14 +833,52 @@ func (it *indexBuildTask) SaveDiskAnnIndexFiles(ctx context.Context) error {
 	}
 
 	saveFileKeys = append(saveFileKeys, indexParamBlob.Key)
+	saveFileSizes = append(saveFileSizes, uint64(len(indexParamBlob.Value)))
 	savePaths = append(savePaths, indexParamPath)
+
+	if it.pkOffsets != nil {
+		pkOffsetsPath := metautil.BuildSegmentIndexFilePath(it.cm.RootPath(), it.req.BuildID, it.req.IndexVersion,
+			it.partitionID, it.segmentID, it.pkOffsets.Key)
+		saveFn := func() error {
+			return it.cm.Write(ctx, pkOffsetsPath, it.pkOffsets.Value)
+		}
+		if err := retry.Do(ctx, saveFn, retry.Attempts(5)); err != nil {
+			log.Ctx(ctx).Warn("index node save pk offsets file failed", zap.Error(err), zap.String("savePath", pkOffsetsPath))
+			return err
+		}
+		saveFileKeys = append(saveFileKeys, it.pkOffsets.Key)
+		saveFileSizes = append(saveFileSizes, uint64(len(it.pkOffsets.Value)))
+		savePaths = append(savePaths, pkOffsetsPath)
+	}
+	if it.node.manifestSigner != nil {
+		manifestFiles := make([]indexmanifest.File, 0, len(savePaths))
+		for i, savePath := range savePaths {
+			file, err := it.hashIndexFile(ctx, saveFileKeys[i], savePath)
+			if err != nil {
+				log.Ctx(ctx).Warn("index node hash index file failed", zap.Error(err), zap.String("path", savePath))
+				return err
+			}
+			manifestFiles = append(manifestFiles, file)
+		}
+		manifestPath, manifestSize, err := it.saveSignedManifest(ctx, manifestFiles)
+		if err != nil {
+			return err
+		}
+		savePaths = append(savePaths, manifestPath)
+		saveFileKeys = append(saveFileKeys, indexmanifest.Key)
+		saveFileSizes = append(saveFileSizes, manifestSize)
+	}
 	it.savePaths = savePaths
+	it.node.replicator.enqueue(taskKey{ClusterID: it.ClusterID, BuildID: it.BuildID}, it.req, savePaths)
 
 	it.statistic.EndTime = time.Now().UnixMicro()
-	it.node.storeIndexFilesAndStatistic(it.ClusterID, it.BuildID, saveFileKeys, it.serializedSize, &it.statistic)
+	it.memSize = estimateLoadMemSize(indexparamcheck.IndexDISKANN, it.newIndexParams,
+		it.statistic.NumRows, it.statistic.Dim, it.serializedSize)
+	it.node.storeIndexFilesAndStatistic(it.ClusterID, it.BuildID, saveFileKeys, saveFileSizes, it.serializedSize, it.memSize, &it.statistic)
+	it.rememberBuildResult(savePaths, saveFileKeys, saveFileSizes)
 	log.Ctx(ctx).Info("save index files done", zap.Strings("IndexFiles", savePaths))
 	saveIndexFileDur := it.tr.Record("index file save done")
-	metrics.IndexNodeSaveIndexFileLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Observe(float64(saveIndexFileDur.Milliseconds()))
+	observeLatency(ctx, metrics.IndexNodeSaveIndexFileLatency.WithLabelValues(it.metricLabels()...), saveIndexFileDur)
 	it.tr.Elapse("index building all done")
 	log.Ctx(ctx).Info("IndexNode CreateIndex successfully ", zap.Int64("collect", it.collectionID),
 		zap.Int64("partition", it.partitionID), zap.Int64("segment", it.segmentID))
@@ -509,16 +886,21 @@ func (it *indexBuildTask) SaveDiskAnnIndexFiles(ctx context.Context) error {
 }
 
 func (it *indexBuildTask) decodeBlobs(ctx context.Context, blobs []*storage.Blob) error {
-	var insertCodec storage.InsertCodec
-	collectionID, partitionID, segmentID, insertData, err2 := insertCodec.DeserializeAll(blobs)
+	var collectionID, partitionID, segmentID UniqueID
+	var insertData *storage.InsertData
+	err2 := it.node.decoders.run(func() error {
+		var err error
+		collectionID, partitionID, segmentID, insertData, err = it.deserializeBinlogs(ctx, blobs)
+		return err
+	})
 	if err2 != nil {
 		return err2
 	}
-	decodeDuration := it.tr.RecordSpan().Milliseconds()
-	metrics.IndexNodeDecodeFieldLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Observe(float64(decodeDuration))
+	decodeDuration := it.tr.RecordSpan()
+	observeLatency(ctx, metrics.IndexNodeDecodeFieldLatency.WithLabelValues(it.node.taskLabels.labels(collectionID, it.req)...), decodeDuration)
 
-	if len(insertData.Data) != 1 {
-		return errors.New("we expect only one field in deserialized insert data")
+	if err := it.checkDecodedData(ctx, blobs, insertData); err != nil {
+		return err
 	}
 	it.collectionID = collectionID
 	it.partitionID = partitionID
@@ -541,8 +923,26 @@ func (it *indexBuildTask) decodeBlobs(ctx context.Context, blobs []*storage.Blob
 		fieldID = fID
 		break
 	}
+	if data, err2 = it.handleNonFiniteVectors(ctx, data); err2 != nil {
+		return err2
+	}
+	if it.pkOffsets, err2 = it.buildPkOffsets(ctx, data.RowNum()); err2 != nil {
+		return err2
+	}
 	it.statistic.NumRows = int64(data.RowNum())
 	it.fieldID = fieldID
+	it.datasetMu.Lock()
 	it.fieldData = data
-	return nil
+	it.datasetMu.Unlock()
+	return it.prepareDataset(ctx)
+}
+
+// prepareDataset settles the index params depending on the decoded dataset.
+func (it *indexBuildTask) prepareDataset(ctx context.Context) error {
+	if err := it.chooseAutoIndex(ctx); err != nil {
+		return err
+	}
+	// the row count is unknown in Prepare if the job doesn't carry it.
+	it.fillNList(ctx, it.statistic.NumRows)
+	return it.checkBruteForce(it.statistic.NumRows)
 }
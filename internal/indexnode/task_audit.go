@@ -0,0 +1,220 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/contextutil"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+const (
+	auditWriteTimeout = 10 * time.Second
+	// auditQueueSize is the max audit records waiting to be written, the records beyond it are dropped.
+	auditQueueSize = 1024
+)
+
+// taskAuditRecord is the audit record of a finished index build task.
+type taskAuditRecord struct {
+	ClusterID      string            `json:"cluster_id"`
+	BuildID        int64             `json:"build_id"`
+	IndexID        int64             `json:"index_id"`
+	IndexName      string            `json:"index_name"`
+	IndexVersion   int64             `json:"index_version"`
+	CollectionID   int64             `json:"collection_id"`
+	PartitionID    int64             `json:"partition_id"`
+	SegmentID      int64             `json:"segment_id"`
+	FieldID        int64             `json:"field_id"`
+	Requester      string            `json:"requester"`
+	NodeID         int64             `json:"node_id"`
+	NodeVersion    string            `json:"node_version"`
+	EngineVersion  string            `json:"engine_version"`
+	State          string            `json:"state"`
+	FailReason     string            `json:"fail_reason,omitempty"`
+	TypeParams     map[string]string `json:"type_params"`
+	IndexParams    map[string]string `json:"index_params"`
+	NumRows        int64             `json:"num_rows"`
+	Dim            int64             `json:"dim"`
+	EnqueueTime    int64             `json:"enqueue_time"`
+	FinishTime     int64             `json:"finish_time"`
+	ElapsedMs      int64             `json:"elapsed_ms"`
+	IndexFiles     []string          `json:"index_files"`
+	SerializedSize uint64            `json:"serialized_size"`
+	MemSize        uint64            `json:"mem_size"`
+}
+
+// getRequester returns the address of the grpc peer which sent the request.
+func getRequester(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "unknown"
+	}
+	return p.Addr.String()
+}
+
+func (it *indexBuildTask) auditRecord(state commonpb.IndexState, failReason string) *taskAuditRecord {
+	record := &taskAuditRecord{
+		ClusterID:      it.ClusterID,
+		BuildID:        it.BuildID,
+		IndexID:        it.req.GetIndexID(),
+		IndexName:      it.req.GetIndexName(),
+		IndexVersion:   it.req.GetIndexVersion(),
+		CollectionID:   it.collectionID,
+		PartitionID:    it.partitionID,
+		SegmentID:      it.segmentID,
+		FieldID:        it.fieldID,
+		Requester:      it.requester,
+		NodeID:         it.nodeID,
+		NodeVersion:    common.Version.String(),
+		EngineVersion:  it.engineVersion(),
+		State:          state.String(),
+		FailReason:     failReason,
+		TypeParams:     funcutil.KeyValuePair2Map(it.req.GetTypeParams()),
+		IndexParams:    funcutil.KeyValuePair2Map(it.req.GetIndexParams()),
+		NumRows:        it.statistic.NumRows,
+		Dim:            it.statistic.Dim,
+		EnqueueTime:    it.statistic.StartTime,
+		FinishTime:     time.Now().UnixMicro(),
+		IndexFiles:     it.savePaths,
+		SerializedSize: it.serializedSize,
+		MemSize:        it.memSize,
+	}
+	if it.tr != nil {
+		record.ElapsedMs = it.tr.ElapseSpan().Milliseconds()
+	}
+	return record
+}
+
+func (it *indexBuildTask) engineVersion() string {
+	if version := it.req.GetEngineVersion(); version != "" {
+		return version
+	}
+	return defaultEngineVersion
+}
+
+// writeAuditRecord queues the audit record of the finished task to be persisted by the audit writer of the node,
+// the record is dropped if the queue is full. Failures are only logged.
+func (it *indexBuildTask) writeAuditRecord(state commonpb.IndexState, failReason string) {
+	if !it.node.params.IndexNodeCfg.AuditEnable.GetAsBool() || it.cm == nil {
+		return
+	}
+	record := it.auditRecord(state, failReason)
+	value, err := json.Marshal(record)
+	if err != nil {
+		log.Warn("IndexNode marshal audit record failed", zap.Int64("buildID", it.BuildID), zap.Error(err))
+		return
+	}
+	write := &auditWrite{
+		cm:        it.cm,
+		clusterID: it.ClusterID,
+		buildID:   it.BuildID,
+		filePath: path.Join(it.cm.RootPath(), it.node.params.IndexNodeCfg.AuditPathPrefix.GetValue(), it.ClusterID,
+			strconv.FormatInt(it.BuildID, 10), fmt.Sprintf("%d.json", it.req.GetIndexVersion())),
+		value: value,
+	}
+	if !it.node.audits.enqueue(write) {
+		log.Warn("IndexNode dropped audit record, the audit queue is full", zap.Int64("buildID", it.BuildID),
+			zap.String("path", write.filePath))
+	}
+}
+
+// auditWrite is an audit record waiting to be written.
+type auditWrite struct {
+	cm        storage.ChunkManager
+	clusterID string
+	buildID   int64
+	filePath  string
+	value     []byte
+}
+
+func (w *auditWrite) write() {
+	// the task context may have been canceled, the record is still written for canceled tasks.
+	ctx, cancel := context.WithTimeout(contextutil.WithClusterID(context.Background(), w.clusterID), auditWriteTimeout)
+	defer cancel()
+	if err := w.cm.Write(ctx, w.filePath, w.value); err != nil {
+		log.Warn("IndexNode write audit record failed", zap.Int64("buildID", w.buildID),
+			zap.String("path", w.filePath), zap.Error(err))
+		return
+	}
+	log.Debug("IndexNode write audit record done", zap.Int64("buildID", w.buildID), zap.String("path", w.filePath))
+}
+
+// auditWriter writes the audit records on a goroutine of its own, so a slow object storage doesn't hold the
+// scheduler and the build slot of the task setting its terminal phase.
+type auditWriter struct {
+	mu     sync.RWMutex
+	closed bool
+	writes chan *auditWrite
+	wg     sync.WaitGroup
+}
+
+func newAuditWriter() *auditWriter {
+	w := &auditWriter{writes: make(chan *auditWrite, auditQueueSize)}
+	w.wg.Add(1)
+	go w.loop()
+	return w
+}
+
+func (w *auditWriter) loop() {
+	defer w.wg.Done()
+	for write := range w.writes {
+		write.write()
+	}
+}
+
+// enqueue queues the write, it returns false if the queue is full or the writer is closed.
+func (w *auditWriter) enqueue(write *auditWrite) bool {
+	if w == nil {
+		return false
+	}
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return false
+	}
+	select {
+	case w.writes <- write:
+		return true
+	default:
+		return false
+	}
+}
+
+// Close writes the queued records and stops the writer.
+func (w *auditWriter) Close() {
+	w.mu.Lock()
+	if !w.closed {
+		w.closed = true
+		close(w.writes)
+	}
+	w.mu.Unlock()
+	w.wg.Wait()
+}
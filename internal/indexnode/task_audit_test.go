@@ -0,0 +1,93 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
+)
+
+func TestWriteAuditRecord(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	ctx := context.Background()
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	it := &indexBuildTask{
+		node:      &IndexNode{params: params, audits: newAuditWriter()},
+		cm:        cm,
+		BuildID:   10,
+		ClusterID: "cluster",
+		req: &indexpb.CreateJobRequest{
+			IndexID:      1,
+			IndexName:    "idx",
+			IndexVersion: 2,
+			TypeParams:   []*commonpb.KeyValuePair{{Key: "dim", Value: "8"}},
+			IndexParams:  []*commonpb.KeyValuePair{{Key: "index_type", Value: "IVF_FLAT"}},
+		},
+		savePaths: []string{"file1", "file2"},
+		requester: "127.0.0.1:1234",
+		tr:        timerecord.NewTimeRecorder("audit"),
+	}
+	filePath := path.Join(cm.RootPath(), "index_audit", "cluster", "10", "2.json")
+
+	t.Run("disabled", func(t *testing.T) {
+		it.writeAuditRecord(commonpb.IndexState_Finished, "")
+		exist, err := cm.Exist(ctx, filePath)
+		assert.NoError(t, err)
+		assert.False(t, exist)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		params.Save(params.IndexNodeCfg.AuditEnable.Key, "true")
+
+		it.writeAuditRecord(commonpb.IndexState_Failed, "mock fail")
+		// the queued record is written before the writer stops.
+		it.node.audits.Close()
+		value, err := cm.Read(ctx, filePath)
+		assert.NoError(t, err)
+		record := &taskAuditRecord{}
+		assert.NoError(t, json.Unmarshal(value, record))
+		assert.Equal(t, int64(10), record.BuildID)
+		assert.Equal(t, "127.0.0.1:1234", record.Requester)
+		assert.Equal(t, commonpb.IndexState_Failed.String(), record.State)
+		assert.Equal(t, "mock fail", record.FailReason)
+		assert.Equal(t, "IVF_FLAT", record.IndexParams["index_type"])
+		assert.Equal(t, []string{"file1", "file2"}, record.IndexFiles)
+	})
+
+	t.Run("dropped", func(t *testing.T) {
+		assert.False(t, it.node.audits.enqueue(&auditWrite{}))
+		var writer *auditWriter
+		assert.False(t, writer.enqueue(&auditWrite{}))
+		full := &auditWriter{writes: make(chan *auditWrite, 1)}
+		assert.True(t, full.enqueue(&auditWrite{}))
+		assert.False(t, full.enqueue(&auditWrite{}))
+	})
+
+	t.Run("requester", func(t *testing.T) {
+		assert.Equal(t, "unknown", getRequester(ctx))
+	})
+}
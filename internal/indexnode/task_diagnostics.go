@@ -0,0 +1,137 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"runtime/pprof"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/contextutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+const (
+	diagnosticsWriteTimeout = 30 * time.Second
+	// diagnosticsMaxGoroutineDump bounds the goroutine dump in a diagnostic bundle.
+	diagnosticsMaxGoroutineDump = 4 << 20
+)
+
+var errTaskPanic = errors.New("index build task panicked")
+
+// taskPanicError is the panic of a task stage recovered by the scheduler, with the stack where it panicked.
+type taskPanicError struct {
+	value interface{}
+	stack []byte
+}
+
+func (e *taskPanicError) Error() string {
+	return fmt.Sprintf("%s: %v", errTaskPanic.Error(), e.value)
+}
+
+func (e *taskPanicError) Unwrap() error {
+	return errTaskPanic
+}
+
+// diagnosable is the task which uploads a diagnostic bundle when it fails fatally.
+type diagnosable interface {
+	// uploadDiagnostics uploads the diagnostic bundle of the failure in the phase, and returns its path.
+	uploadDiagnostics(phase taskPhase, cause error) (string, error)
+}
+
+// diagnose returns the fail reason of the fatal error of the task, which refers to the diagnostic bundle
+// uploaded for it when indexNode.diagnostics.enable is set.
+func diagnose(params *paramtable.ComponentParam, t task, phase taskPhase, cause error) string {
+	d, ok := t.(diagnosable)
+	if !ok || !params.IndexNodeCfg.DiagnosticsEnable.GetAsBool() {
+		return cause.Error()
+	}
+	filePath, err := d.uploadDiagnostics(phase, cause)
+	if err != nil {
+		log.Warn("IndexNode upload diagnostic bundle failed", zap.String("task", t.Name()), zap.Error(err))
+		return cause.Error()
+	}
+	return fmt.Sprintf("%s, diagnostics: %s", cause.Error(), filePath)
+}
+
+// diagnosticBundle is the state of a task captured when it fails fatally.
+type diagnosticBundle struct {
+	Task       *taskAuditRecord       `json:"task"`
+	Phase      string                 `json:"phase"`
+	LoadedRows int                    `json:"loaded_rows"`
+	IndexFiles int                    `json:"index_files"`
+	PanicStack string                 `json:"panic_stack,omitempty"`
+	Goroutines string                 `json:"goroutines"`
+	Logs       []*indexpb.JobLogEntry `json:"logs,omitempty"`
+}
+
+func (it *indexBuildTask) diagnosticBundle(phase taskPhase, cause error) *diagnosticBundle {
+	bundle := &diagnosticBundle{
+		Task:       it.auditRecord(commonpb.IndexState_Failed, cause.Error()),
+		Phase:      phase.String(),
+		IndexFiles: len(it.indexBlobs),
+	}
+	if it.fieldData != nil {
+		bundle.LoadedRows = it.fieldData.RowNum()
+	}
+	var panicErr *taskPanicError
+	if errors.As(cause, &panicErr) {
+		bundle.PanicStack = string(panicErr.stack)
+	}
+	var dump bytes.Buffer
+	if err := pprof.Lookup("goroutine").WriteTo(&dump, 2); err == nil {
+		if dump.Len() > diagnosticsMaxGoroutineDump {
+			dump.Truncate(diagnosticsMaxGoroutineDump)
+		}
+		bundle.Goroutines = dump.String()
+	}
+	if it.node != nil && it.node.jobLogs != nil {
+		bundle.Logs, _, _, _, _ = it.node.jobLogs.read(taskKey{ClusterID: it.ClusterID, BuildID: it.BuildID}, 0)
+	}
+	return bundle
+}
+
+func (it *indexBuildTask) uploadDiagnostics(phase taskPhase, cause error) (string, error) {
+	if it.cm == nil {
+		return "", errors.New("no storage to upload the diagnostic bundle")
+	}
+	value, err := json.Marshal(it.diagnosticBundle(phase, cause))
+	if err != nil {
+		return "", err
+	}
+	// the bundle is written even if the task context has been canceled.
+	ctx, cancel := context.WithTimeout(contextutil.WithClusterID(context.Background(), it.ClusterID), diagnosticsWriteTimeout)
+	defer cancel()
+	filePath := path.Join(it.cm.RootPath(), it.node.params.IndexNodeCfg.DiagnosticsPathPrefix.GetValue(), it.ClusterID,
+		strconv.FormatInt(it.BuildID, 10), fmt.Sprintf("%d-%d.json", it.nodeID, time.Now().UnixMilli()))
+	if err := it.cm.Write(ctx, filePath, value); err != nil {
+		return "", err
+	}
+	log.Info("IndexNode uploaded diagnostic bundle", zap.Int64("buildID", it.BuildID), zap.String("path", filePath))
+	return filePath, nil
+}
@@ -0,0 +1,94 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+type panicTask struct {
+	*fakeTask
+}
+
+func (t *panicTask) BuildIndex(ctx context.Context) error {
+	panic("index out of range")
+}
+
+func TestProcessTaskPanic(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	sched := NewTaskScheduler(context.TODO(), params)
+	it := &panicTask{fakeTask: newTask(fakeTaskSavedIndexes, nil, commonpb.IndexState_Failed).(*fakeTask)}
+	assert.NoError(t, it.OnEnqueue(context.TODO()))
+	sched.processTask(it, sched.IndexBuildQueue)
+	assert.Equal(t, commonpb.IndexState_Failed, it.GetState())
+	assert.Equal(t, "index build task panicked: index out of range", it.failReason)
+}
+
+func TestUploadDiagnostics(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	ctx := context.Background()
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	node := &IndexNode{params: params, jobLogs: newJobLogHub(params)}
+	it := &indexBuildTask{
+		cm:        cm,
+		node:      node,
+		BuildID:   10,
+		ClusterID: "cluster",
+		req: &indexpb.CreateJobRequest{
+			IndexParams: []*commonpb.KeyValuePair{{Key: "index_type", Value: "IVF_FLAT"}},
+		},
+		fieldData: &storage.FloatVectorFieldData{Dim: 1, Data: make([]float32, 100)},
+	}
+	key := taskKey{ClusterID: "cluster", BuildID: 10}
+	node.jobLogs.logs[key] = &jobLog{updated: make(chan struct{})}
+	node.jobLogs.append(key, &indexpb.JobLogEntry{Message: "loading data"})
+	cause := &taskPanicError{value: "index out of range", stack: []byte("goroutine 1 [running]")}
+
+	assert.Equal(t, cause.Error(), diagnose(params, it, taskBuilding, cause))
+	assert.Equal(t, "data mismatch", diagnose(params, &fakeTask{}, taskBuilding, errors.New("data mismatch")))
+
+	params.Save(params.IndexNodeCfg.DiagnosticsEnable.Key, "true")
+	reason := diagnose(params, it, taskBuilding, cause)
+	assert.True(t, strings.HasPrefix(reason, cause.Error()+", diagnostics: "+cm.RootPath()+"/index_diagnostics/cluster/10/"))
+
+	value, err := cm.Read(ctx, strings.TrimPrefix(reason, cause.Error()+", diagnostics: "))
+	assert.NoError(t, err)
+	bundle := &diagnosticBundle{}
+	assert.NoError(t, json.Unmarshal(value, bundle))
+	assert.Equal(t, int64(10), bundle.Task.BuildID)
+	assert.Equal(t, cause.Error(), bundle.Task.FailReason)
+	assert.Equal(t, "IVF_FLAT", bundle.Task.IndexParams["index_type"])
+	assert.Equal(t, taskBuilding.String(), bundle.Phase)
+	assert.Equal(t, 100, bundle.LoadedRows)
+	assert.Equal(t, "goroutine 1 [running]", bundle.PanicStack)
+	assert.Contains(t, bundle.Goroutines, "TestUploadDiagnostics")
+	assert.Equal(t, "loading data", bundle.Logs[0].GetMessage())
+
+	it.cm = nil
+	assert.Equal(t, cause.Error(), diagnose(params, it, taskBuilding, cause))
+}
@@ -0,0 +1,180 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"path"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+const (
+	taskJournalFile = "task_journal"
+	// maxJournalErrLen bounds the fail reason kept in a journal record.
+	maxJournalErrLen = 1024
+)
+
+// journalRecord is a task phase transition in the task journal. It's encoded as
+//
+//	| payload length uint16 | unix nano int64 | build id int64 | from uint8 | to uint8 |
+//	| cluster id length uint16 | cluster id | fail reason length uint16 | fail reason | crc32 of payload uint32 |
+//
+// in little endian (common.Endian), the payload is everything between the length and the checksum.
+type journalRecord struct {
+	ts         time.Time
+	key        taskKey
+	from       taskPhase
+	to         taskPhase
+	failReason string
+}
+
+func (r *journalRecord) marshal() []byte {
+	clusterID, failReason := r.key.ClusterID, r.failReason
+	if len(failReason) > maxJournalErrLen {
+		failReason = failReason[:maxJournalErrLen]
+	}
+	payloadLen := 8 + 8 + 1 + 1 + 2 + len(clusterID) + 2 + len(failReason)
+	buf := make([]byte, 2+payloadLen+4)
+	common.Endian.PutUint16(buf, uint16(payloadLen))
+	common.Endian.PutUint64(buf[2:], uint64(r.ts.UnixNano()))
+	common.Endian.PutUint64(buf[10:], uint64(r.key.BuildID))
+	buf[18], buf[19] = byte(r.from), byte(r.to)
+	offset := 20
+	for _, s := range []string{clusterID, failReason} {
+		common.Endian.PutUint16(buf[offset:], uint16(len(s)))
+		offset += 2 + copy(buf[offset+2:], s)
+	}
+	common.Endian.PutUint32(buf[offset:], crc32.ChecksumIEEE(buf[2:offset]))
+	return buf
+}
+
+var errCorruptJournal = errors.New("corrupt task journal record")
+
+func unmarshalJournalRecord(payload []byte) (*journalRecord, error) {
+	readString := func(buf []byte) (string, []byte, error) {
+		if len(buf) < 2 {
+			return "", nil, errCorruptJournal
+		}
+		n := int(common.Endian.Uint16(buf))
+		if len(buf) < 2+n {
+			return "", nil, errCorruptJournal
+		}
+		return string(buf[2 : 2+n]), buf[2+n:], nil
+	}
+	if len(payload) < 18 {
+		return nil, errCorruptJournal
+	}
+	r := &journalRecord{
+		ts:   time.Unix(0, int64(common.Endian.Uint64(payload))),
+		key:  taskKey{BuildID: int64(common.Endian.Uint64(payload[8:]))},
+		from: taskPhase(payload[16]),
+		to:   taskPhase(payload[17]),
+	}
+	var err error
+	rest := payload[18:]
+	if r.key.ClusterID, rest, err = readString(rest); err != nil {
+		return nil, err
+	}
+	if r.failReason, _, err = readString(rest); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// readTaskJournal decodes the records of a task journal. The journal of a crashed node may end with
+// a partially written record, which is dropped silently, a corrupt record in the middle fails the read.
+func readTaskJournal(reader io.Reader) ([]*journalRecord, error) {
+	r := bufio.NewReader(reader)
+	records := make([]*journalRecord, 0)
+	for {
+		var header [2]byte
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return records, nil
+			}
+			return records, err
+		}
+		buf := make([]byte, int(common.Endian.Uint16(header[:]))+4)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return records, nil
+			}
+			return records, err
+		}
+		payload := buf[:len(buf)-4]
+		if crc32.ChecksumIEEE(payload) != common.Endian.Uint32(buf[len(buf)-4:]) {
+			return records, fmt.Errorf("%w: checksum mismatch after %d records", errCorruptJournal, len(records))
+		}
+		record, err := unmarshalJournalRecord(payload)
+		if err != nil {
+			return records, err
+		}
+		records = append(records, record)
+	}
+}
+
+// taskJournal appends a record per task phase transition to a local file, which rotates once it exceeds
+// maxSize keeping one old file. The records are written without buffering, so they survive the process
+// being killed.
+type taskJournal struct {
+	*rotatingFile
+}
+
+func openTaskJournal(dir string, maxSize int64) (*taskJournal, error) {
+	file, err := openRotatingFile(dir, taskJournalFile, maxSize)
+	if err != nil {
+		return nil, err
+	}
+	return &taskJournal{rotatingFile: file}, nil
+}
+
+// record is the taskPhaseHook appending the transition to the journal, the write errors are logged
+// and never fail the transition.
+func (j *taskJournal) record(key taskKey, from, to taskPhase, failReason string) {
+	buf := (&journalRecord{ts: time.Now(), key: key, from: from, to: to, failReason: failReason}).marshal()
+	if err := j.write(buf); err != nil {
+		log.RatedWarn(60, "IndexNode write task journal failed", zap.String("dir", j.dir), zap.Error(err))
+	}
+}
+
+// initTaskJournal opens the task journal and records the task phase transitions in it if the journal is enabled.
+func (i *IndexNode) initTaskJournal() error {
+	if !i.params.IndexNodeCfg.JournalEnable.GetAsBool() {
+		return nil
+	}
+	dir := i.params.IndexNodeCfg.JournalPath.GetValue()
+	if dir == "" {
+		dir = path.Join(i.params.LocalStorageCfg.Path.GetValue(), "index_journal")
+	}
+	journal, err := openTaskJournal(dir, i.params.IndexNodeCfg.JournalMaxSize.GetAsInt64()*1024*1024)
+	if err != nil {
+		return err
+	}
+	i.journal = journal
+	i.registerPhaseHook(journal.record)
+	log.Info("IndexNode task journal enabled", zap.String("dir", dir))
+	return nil
+}
@@ -0,0 +1,91 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskJournal(t *testing.T) {
+	dir := t.TempDir()
+	record := &journalRecord{key: taskKey{ClusterID: "cluster", BuildID: 1}, from: taskBuilding, to: taskAbandoned,
+		failReason: "out of memory"}
+	recordSize := int64(len(record.marshal()))
+
+	journal, err := openTaskJournal(dir, 3*recordSize)
+	require.NoError(t, err)
+	for buildID := int64(1); buildID <= 5; buildID++ {
+		journal.record(taskKey{ClusterID: "cluster", BuildID: buildID}, taskBuilding, taskAbandoned, "out of memory")
+	}
+	journal.Close()
+	// records after closing are dropped
+	journal.record(taskKey{ClusterID: "cluster", BuildID: 6}, taskPending, taskPreparing, "")
+
+	readFile := func(name string) []*journalRecord {
+		data, err := os.ReadFile(path.Join(dir, name))
+		require.NoError(t, err)
+		records, err := readTaskJournal(bytes.NewReader(data))
+		require.NoError(t, err)
+		return records
+	}
+	old, current := readFile(taskJournalFile+".1"), readFile(taskJournalFile)
+	assert.Len(t, old, 3)
+	assert.Len(t, current, 2)
+	assert.Equal(t, int64(4), current[0].key.BuildID)
+	assert.Equal(t, "cluster", current[0].key.ClusterID)
+	assert.Equal(t, taskBuilding, current[0].from)
+	assert.Equal(t, taskAbandoned, current[0].to)
+	assert.Equal(t, "out of memory", current[0].failReason)
+	assert.False(t, current[0].ts.IsZero())
+
+	// reopening appends to the current file
+	journal, err = openTaskJournal(dir, 10*recordSize)
+	require.NoError(t, err)
+	journal.record(taskKey{ClusterID: "cluster", BuildID: 6}, taskPending, taskPreparing, "")
+	journal.Close()
+	assert.Len(t, readFile(taskJournalFile), 3)
+}
+
+func TestReadTaskJournal(t *testing.T) {
+	var buf bytes.Buffer
+	for _, reason := range []string{"", string(make([]byte, 2*maxJournalErrLen))} {
+		buf.Write((&journalRecord{key: taskKey{ClusterID: "cluster", BuildID: 1}, failReason: reason}).marshal())
+	}
+	data := buf.Bytes()
+	records, err := readTaskJournal(bytes.NewReader(data))
+	assert.NoError(t, err)
+	assert.Len(t, records, 2)
+	assert.Len(t, records[1].failReason, maxJournalErrLen)
+
+	// a partially written record at the tail is dropped
+	records, err = readTaskJournal(bytes.NewReader(data[:len(data)-3]))
+	assert.NoError(t, err)
+	assert.Len(t, records, 1)
+
+	corrupted := append([]byte(nil), data...)
+	corrupted[10]++
+	records, err = readTaskJournal(bytes.NewReader(corrupted))
+	assert.True(t, errors.Is(err, errCorruptJournal))
+	assert.Empty(t, records)
+}
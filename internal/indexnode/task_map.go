@@ -0,0 +1,143 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import "sync"
+
+// taskMapShards is the number of shards of taskMap, a power of 2.
+const taskMapShards = 64
+
+type taskShard struct {
+	mu    sync.Mutex
+	tasks map[taskKey]*taskInfo
+}
+
+// taskMap keeps the task infos of the node sharded by the hash of their keys, so the phase transitions
+// of the building tasks and the QueryJobs polls of other tasks don't wait on one lock. The zero value is
+// an empty map ready to use.
+type taskMap struct {
+	shards [taskMapShards]taskShard
+}
+
+func (m *taskMap) shard(key taskKey) *taskShard {
+	// FNV-1a of the cluster and the build id.
+	hash := uint32(2166136261)
+	for idx := 0; idx < len(key.ClusterID); idx++ {
+		hash ^= uint32(key.ClusterID[idx])
+		hash *= 16777619
+	}
+	for shift := 0; shift < 64; shift += 8 {
+		hash ^= uint32(byte(key.BuildID >> shift))
+		hash *= 16777619
+	}
+	return &m.shards[hash&(taskMapShards-1)]
+}
+
+// loadOrStore stores the info if the key is absent, it returns the existing info otherwise.
+func (m *taskMap) loadOrStore(key taskKey, info *taskInfo) *taskInfo {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if oldInfo, ok := s.tasks[key]; ok {
+		return oldInfo
+	}
+	if s.tasks == nil {
+		s.tasks = make(map[taskKey]*taskInfo)
+	}
+	s.tasks[key] = info
+	return nil
+}
+
+// update calls fn with the info of the key under the lock of its shard, it reports whether the key is found.
+func (m *taskMap) update(key taskKey, fn func(info *taskInfo)) bool {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	info, ok := s.tasks[key]
+	if ok {
+		fn(info)
+	}
+	return ok
+}
+
+// foreach calls fn with every info under the lock of its shard, the shards are locked one by one, so fn
+// must not touch the map.
+func (m *taskMap) foreach(fn func(key taskKey, info *taskInfo)) {
+	for idx := range m.shards {
+		s := &m.shards[idx]
+		s.mu.Lock()
+		for key, info := range s.tasks {
+			fn(key, info)
+		}
+		s.mu.Unlock()
+	}
+}
+
+// delete removes the keys and returns the removed infos.
+func (m *taskMap) delete(keys []taskKey) []*taskInfo {
+	deleted := make([]*taskInfo, 0, len(keys))
+	for _, key := range keys {
+		s := m.shard(key)
+		s.mu.Lock()
+		if info, ok := s.tasks[key]; ok {
+			deleted = append(deleted, info)
+			delete(s.tasks, key)
+		}
+		s.mu.Unlock()
+	}
+	return deleted
+}
+
+// deleteIf removes the info of the key if pred holds for it, and returns the removed info.
+func (m *taskMap) deleteIf(key taskKey, pred func(info *taskInfo) bool) *taskInfo {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	info, ok := s.tasks[key]
+	if !ok || !pred(info) {
+		return nil
+	}
+	delete(s.tasks, key)
+	return info
+}
+
+// clear removes all the infos and returns them.
+func (m *taskMap) clear() []*taskInfo {
+	deleted := make([]*taskInfo, 0)
+	for idx := range m.shards {
+		s := &m.shards[idx]
+		s.mu.Lock()
+		tasks := s.tasks
+		s.tasks = nil
+		s.mu.Unlock()
+		for _, info := range tasks {
+			deleted = append(deleted, info)
+		}
+	}
+	return deleted
+}
+
+func (m *taskMap) len() int {
+	num := 0
+	for idx := range m.shards {
+		s := &m.shards[idx]
+		s.mu.Lock()
+		num += len(s.tasks)
+		s.mu.Unlock()
+	}
+	return num
+}
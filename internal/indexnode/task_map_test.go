@@ -0,0 +1,154 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// info returns the info of the key, nil if it's absent.
+func (m *taskMap) info(key taskKey) *taskInfo {
+	var found *taskInfo
+	m.update(key, func(info *taskInfo) { found = info })
+	return found
+}
+
+func TestTaskMap(t *testing.T) {
+	var m taskMap
+	assert.Equal(t, 0, m.len())
+	assert.False(t, m.update(taskKey{ClusterID: "a", BuildID: 1}, func(*taskInfo) { t.Fatal("no task") }))
+
+	keys := make([]taskKey, 0)
+	for _, cluster := range []string{"a", "b"} {
+		for buildID := UniqueID(0); buildID < 100; buildID++ {
+			key := taskKey{ClusterID: cluster, BuildID: buildID}
+			keys = append(keys, key)
+			assert.Nil(t, m.loadOrStore(key, &taskInfo{phase: taskPending}))
+		}
+	}
+	info := m.info(keys[0])
+	assert.Same(t, info, m.loadOrStore(keys[0], &taskInfo{}))
+	assert.Equal(t, 200, m.len())
+
+	// the keys are spread over the shards.
+	used := 0
+	for idx := range m.shards {
+		if len(m.shards[idx].tasks) > 0 {
+			used++
+		}
+	}
+	assert.Greater(t, used, taskMapShards/2)
+
+	assert.True(t, m.update(keys[1], func(info *taskInfo) { info.phase = taskLoading }))
+	assert.Equal(t, taskLoading, m.info(keys[1]).phase)
+
+	visited := 0
+	m.foreach(func(key taskKey, info *taskInfo) { visited++ })
+	assert.Equal(t, 200, visited)
+
+	deleted := m.delete([]taskKey{keys[0], keys[0], {ClusterID: "c", BuildID: 1}})
+	assert.Equal(t, []*taskInfo{info}, deleted)
+	assert.Nil(t, m.info(keys[0]))
+	assert.Len(t, m.clear(), 199)
+	assert.Equal(t, 0, m.len())
+	assert.Nil(t, m.loadOrStore(keys[0], info))
+}
+
+// lockedTaskMap is the tasks map behind a single lock, the baseline of the benchmarks.
+type lockedTaskMap struct {
+	mu    sync.Mutex
+	tasks map[taskKey]*taskInfo
+}
+
+func (m *lockedTaskMap) update(key taskKey, fn func(info *taskInfo)) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	info, ok := m.tasks[key]
+	if ok {
+		fn(info)
+	}
+	return ok
+}
+
+func (m *lockedTaskMap) foreach(fn func(key taskKey, info *taskInfo)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for key, info := range m.tasks {
+		fn(key, info)
+	}
+}
+
+type taskStore interface {
+	update(key taskKey, fn func(info *taskInfo)) bool
+	foreach(fn func(key taskKey, info *taskInfo))
+}
+
+// benchmarkTaskStore changes random tasks from parallel goroutines, like the phase transitions of the building
+// tasks, while every 1000th access scans all the tasks like a QueryJobs poll. The p99 latency of the changes
+// is mostly the time spent waiting for the lock.
+func benchmarkTaskStore(b *testing.B, numTasks int, store taskStore) {
+	var mu sync.Mutex
+	latencies := make([]time.Duration, 0, b.N)
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		r := rand.New(rand.NewSource(rand.Int63()))
+		local := make([]time.Duration, 0, 1024)
+		for n := 0; pb.Next(); n++ {
+			if n%1000 == 0 {
+				store.foreach(func(key taskKey, info *taskInfo) { _ = info.phase.indexState() })
+				continue
+			}
+			key := taskKey{ClusterID: "cluster", BuildID: UniqueID(r.Intn(numTasks))}
+			start := time.Now()
+			store.update(key, func(info *taskInfo) { info.phase = taskBuilding })
+			local = append(local, time.Since(start))
+		}
+		mu.Lock()
+		latencies = append(latencies, local...)
+		mu.Unlock()
+	})
+	b.StopTimer()
+	if len(latencies) > 0 {
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+		b.ReportMetric(float64(latencies[len(latencies)*99/100].Nanoseconds()), "p99-ns")
+	}
+}
+
+func BenchmarkTaskMap(b *testing.B) {
+	for _, numTasks := range []int{1000, 10000, 100000} {
+		sharded := &taskMap{}
+		locked := &lockedTaskMap{tasks: make(map[taskKey]*taskInfo)}
+		for buildID := 0; buildID < numTasks; buildID++ {
+			key := taskKey{ClusterID: "cluster", BuildID: UniqueID(buildID)}
+			sharded.loadOrStore(key, &taskInfo{phase: taskPending})
+			locked.tasks[key] = &taskInfo{phase: taskPending}
+		}
+		b.Run(fmt.Sprintf("sharded/%d", numTasks), func(b *testing.B) {
+			benchmarkTaskStore(b, numTasks, sharded)
+		})
+		b.Run(fmt.Sprintf("single-lock/%d", numTasks), func(b *testing.B) {
+			benchmarkTaskStore(b, numTasks, locked)
+		})
+	}
+}
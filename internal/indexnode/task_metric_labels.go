@@ -0,0 +1,128 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// The task label dimensions of the build latency metrics listed by indexNode.metrics.taskLabels.
+const (
+	taskLabelCollectionID = "collectionID"
+	taskLabelIndexType    = "indexType"
+	taskLabelSizeClass    = "segmentSizeClass"
+)
+
+const (
+	// collapsedLabelValue is the value of a task label once its dimension has indexNode.metrics.maxLabelValues values.
+	collapsedLabelValue = "other"
+	// unknownLabelValue is the value of a task label not known yet, e.g. the collection of undecoded binlogs.
+	unknownLabelValue = "unknown"
+)
+
+// sizeClasses are the upper bounds of the row counts of the segment size classes.
+var sizeClasses = []int64{100000, 1000000, 10000000}
+
+// segmentSizeClass returns the size class of the segment by its row count, e.g. "100001-1000000".
+func segmentSizeClass(rows int64) string {
+	if rows <= 0 {
+		return unknownLabelValue
+	}
+	return rangeClass(rows, sizeClasses)
+}
+
+// taskMetricLabels emits the task label dimensions enabled by indexNode.metrics.taskLabels on the build latency
+// metrics, the disabled ones are emitted as "all". The new values of a dimension having indexNode.metrics.maxLabelValues
+// values are collapsed into "other", so the series of the metrics stay bounded however many collections there are.
+type taskMetricLabels struct {
+	params *paramtable.ComponentParam
+
+	mu     sync.Mutex
+	values map[string]map[string]struct{}
+}
+
+func newTaskMetricLabels(params *paramtable.ComponentParam) *taskMetricLabels {
+	return &taskMetricLabels{
+		params: params,
+		values: make(map[string]map[string]struct{}),
+	}
+}
+
+// enabled returns the enabled task label dimensions.
+func (l *taskMetricLabels) enabled() map[string]bool {
+	enabled := make(map[string]bool)
+	for _, dimension := range strings.Split(l.params.IndexNodeCfg.MetricsTaskLabels.GetValue(), ",") {
+		if dimension = strings.TrimSpace(dimension); dimension != "" {
+			enabled[dimension] = true
+		}
+	}
+	return enabled
+}
+
+// value returns the label value of the dimension, the dimension keeps the new value unless it has too many values.
+func (l *taskMetricLabels) value(dimension, value string) string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	values, ok := l.values[dimension]
+	if !ok {
+		values = make(map[string]struct{})
+		l.values[dimension] = values
+	}
+	if _, ok := values[value]; ok {
+		return value
+	}
+	if len(values) >= l.params.IndexNodeCfg.MetricsMaxLabelValues.GetAsInt() {
+		return collapsedLabelValue
+	}
+	values[value] = struct{}{}
+	return value
+}
+
+// labels returns the label values of the build latency metrics of the job of the collection, 0 is an unknown collection.
+func (l *taskMetricLabels) labels(collectionID UniqueID, req *indexpb.CreateJobRequest) []string {
+	labels := []string{strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.AllLabel, metrics.AllLabel, metrics.AllLabel}
+	if l == nil {
+		return labels
+	}
+	enabled := l.enabled()
+	if enabled[taskLabelCollectionID] {
+		collection := unknownLabelValue
+		if collectionID != 0 {
+			collection = l.value(taskLabelCollectionID, strconv.FormatInt(collectionID, 10))
+		}
+		labels[1] = collection
+	}
+	if enabled[taskLabelIndexType] {
+		labels[2] = l.value(taskLabelIndexType, funcutil.KeyValuePair2Map(req.GetIndexParams())["index_type"])
+	}
+	if enabled[taskLabelSizeClass] {
+		labels[3] = segmentSizeClass(req.GetNumRows())
+	}
+	return labels
+}
+
+// metricLabels returns the label values of the build latency metrics of the task.
+func (it *indexBuildTask) metricLabels() []string {
+	return it.node.taskLabels.labels(it.collectionID, it.req)
+}
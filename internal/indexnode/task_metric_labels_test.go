@@ -0,0 +1,77 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestSegmentSizeClass(t *testing.T) {
+	assert.Equal(t, unknownLabelValue, segmentSizeClass(0))
+	assert.Equal(t, "1-100000", segmentSizeClass(100000))
+	assert.Equal(t, "100001-1000000", segmentSizeClass(500000))
+	assert.Equal(t, "10000001+", segmentSizeClass(20000000))
+}
+
+func TestTaskMetricLabels(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	req := &indexpb.CreateJobRequest{
+		NumRows:     2000,
+		IndexParams: []*commonpb.KeyValuePair{{Key: "index_type", Value: "HNSW"}},
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		labels := newTaskMetricLabels(params)
+		assert.Equal(t, []string{nodeID, metrics.AllLabel, metrics.AllLabel, metrics.AllLabel}, labels.labels(100, req))
+
+		var nilLabels *taskMetricLabels
+		assert.Equal(t, []string{nodeID, metrics.AllLabel, metrics.AllLabel, metrics.AllLabel}, nilLabels.labels(100, req))
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		params.Save(params.IndexNodeCfg.MetricsTaskLabels.Key, "collectionID, segmentSizeClass")
+		defer params.Reset(params.IndexNodeCfg.MetricsTaskLabels.Key)
+
+		labels := newTaskMetricLabels(params)
+		assert.Equal(t, []string{nodeID, "100", metrics.AllLabel, "1-100000"}, labels.labels(100, req))
+		assert.Equal(t, []string{nodeID, unknownLabelValue, metrics.AllLabel, "1-100000"}, labels.labels(0, req))
+	})
+
+	t.Run("collapsed", func(t *testing.T) {
+		params.Save(params.IndexNodeCfg.MetricsTaskLabels.Key, "collectionID,indexType")
+		defer params.Reset(params.IndexNodeCfg.MetricsTaskLabels.Key)
+		params.Save(params.IndexNodeCfg.MetricsMaxLabelValues.Key, "2")
+		defer params.Reset(params.IndexNodeCfg.MetricsMaxLabelValues.Key)
+
+		labels := newTaskMetricLabels(params)
+		assert.Equal(t, "1", labels.labels(1, req)[1])
+		assert.Equal(t, "2", labels.labels(2, req)[1])
+		assert.Equal(t, collapsedLabelValue, labels.labels(3, req)[1])
+		// the values seen before the dimension is full keep their label
+		assert.Equal(t, "1", labels.labels(1, req)[1])
+		assert.Equal(t, "HNSW", labels.labels(3, req)[2])
+	})
+}
@@ -0,0 +1,104 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+const taskReapInterval = 10 * time.Second
+
+// taskReaper force fails the tasks exceeding the max task lifetime, so that IndexCoord reassigns the jobs of the
+// hanging builds. The goroutine of a reaped task keeps running until the build engine returns, it keeps the build
+// slot and the local index files of the task until then, so the node doesn't admit more builds than it can run.
+type taskReaper struct {
+	node *IndexNode
+	wg   sync.WaitGroup
+}
+
+func newTaskReaper(node *IndexNode) *taskReaper {
+	return &taskReaper{
+		node: node,
+	}
+}
+
+// Start starts the reaping loop, it exits when ctx is done.
+func (r *taskReaper) Start(ctx context.Context) {
+	r.wg.Add(1)
+	go r.loop(ctx)
+}
+
+// Close waits for the reaping loop to exit.
+func (r *taskReaper) Close() {
+	r.wg.Wait()
+}
+
+func (r *taskReaper) loop(ctx context.Context) {
+	defer r.wg.Done()
+	ticker := time.NewTicker(taskReapInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.reap()
+		}
+	}
+}
+
+func (r *taskReaper) reap() {
+	maxLifetime := r.node.params.IndexNodeCfg.MaxTaskLifetime.GetAsDuration(time.Second)
+	if maxLifetime <= 0 {
+		return
+	}
+	for _, key := range r.node.reapExpiredTasks(maxLifetime) {
+		log.Warn("IndexNode reap task exceeding the max lifetime", zap.String("ClusterID", key.ClusterID),
+			zap.Int64("buildID", key.BuildID), zap.Duration("maxLifetime", maxLifetime))
+		r.node.recordPreemption(key, fmt.Sprintf("exceeded the max lifetime %s", maxLifetime))
+		countAbortedTask()
+	}
+}
+
+// countAbortedTask counts a force failed task in the failed tasks. Its build slot and local index files are
+// released by its own goroutine once it exits.
+func countAbortedTask() {
+	metrics.IndexNodeBuildIndexTaskCounter.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.FailLabel).Inc()
+}
+
+// removeLocalIndexFiles removes the local index files of the build.
+func (i *IndexNode) removeLocalIndexFiles(buildID UniqueID) {
+	for _, root := range append([]string{i.params.LocalStorageCfg.Path.GetValue()}, i.staging.roots()...) {
+		localPath := path.Join(root, common.SegmentIndexPath, strconv.FormatInt(buildID, 10))
+		if err := os.RemoveAll(localPath); err != nil {
+			log.Warn("IndexNode remove local index files of aborted task failed", zap.String("path", localPath), zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"os"
+	"path"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestTaskReaper(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	localPath := t.TempDir()
+	params.Save(params.LocalStorageCfg.Path.Key, localPath)
+
+	ctx := context.Background()
+	node := &IndexNode{
+		params:   params,
+		sched:    NewTaskScheduler(ctx, params),
+		reporter: newJobResultReporter(params),
+	}
+	reaper := newTaskReaper(node)
+
+	canceled := false
+	node.loadOrStoreTask("cluster", 1, &taskInfo{
+		cancel:    func() { canceled = true },
+		phase:     taskBuilding,
+		startTime: time.Now().Add(-time.Hour),
+	})
+	node.loadOrStoreTask("cluster", 2, &taskInfo{
+		phase:     taskBuilding,
+		startTime: time.Now(),
+	})
+	released := false
+	node.sched.acquireSlot("cluster/1", "", func() { released = true })
+	indexPath := path.Join(localPath, common.SegmentIndexPath, "1")
+	assert.NoError(t, os.MkdirAll(indexPath, 0755))
+
+	t.Run("disabled", func(t *testing.T) {
+		reaper.reap()
+		assert.Equal(t, commonpb.IndexState_InProgress, node.loadTaskState("cluster", 1))
+	})
+
+	t.Run("reap", func(t *testing.T) {
+		params.Save(params.IndexNodeCfg.MaxTaskLifetime.Key, "60")
+
+		reaper.reap()
+		assert.Equal(t, commonpb.IndexState_Failed, node.loadTaskState("cluster", 1))
+		assert.Equal(t, commonpb.IndexState_InProgress, node.loadTaskState("cluster", 2))
+		assert.True(t, canceled)
+		// the build is still running, it keeps its slot and local files until its goroutine exits.
+		assert.False(t, released)
+		assert.DirExists(t, indexPath)
+
+		// the phase of a reaped task is final
+		assert.Error(t, node.transitTaskPhase("cluster", 1, taskAbandoned, ""))
+		assert.Equal(t, commonpb.IndexState_Failed, node.loadTaskState("cluster", 1))
+	})
+}
+
+func TestResetAbortedTask(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	localPath := t.TempDir()
+	params.Save(params.LocalStorageCfg.Path.Key, localPath)
+	defer params.Reset(params.LocalStorageCfg.Path.Key)
+
+	node := &IndexNode{params: params}
+	newTask := func(buildID UniqueID, phase taskPhase) (*indexBuildTask, string) {
+		ctx, cancel := context.WithCancel(context.Background())
+		node.loadOrStoreTask("cluster", buildID, &taskInfo{cancel: cancel, phase: phase})
+		indexPath := path.Join(localPath, common.SegmentIndexPath, strconv.FormatInt(buildID, 10))
+		assert.NoError(t, os.MkdirAll(indexPath, 0755))
+		return &indexBuildTask{ClusterID: "cluster", BuildID: buildID, ctx: ctx, cancel: cancel, node: node}, indexPath
+	}
+
+	running, runningPath := newTask(1, taskBuilding)
+	running.Reset()
+	assert.DirExists(t, runningPath)
+
+	aborted, abortedPath := newTask(2, taskBuilding)
+	assert.True(t, node.abortTask("cluster", 2, "mock"))
+	aborted.Reset()
+	assert.NoDirExists(t, abortedPath)
+
+	finished, finishedPath := newTask(3, taskFinished)
+	finished.cancel()
+	finished.Reset()
+	assert.DirExists(t, finishedPath)
+}
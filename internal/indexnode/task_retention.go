@@ -0,0 +1,134 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/util/cache"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+const (
+	// taskRetentionInterval is the min interval between two evictions, which run lazily on CreateJob and QueryJobs.
+	taskRetentionInterval = time.Second
+	// evictedTaskRecords is the number of the evicted tasks whose states are remembered for QueryJobs.
+	evictedTaskRecords = 100000
+)
+
+// taskRetention evicts the infos of the completed tasks once they are older than indexNode.taskRetention.ttl,
+// or the oldest ones beyond indexNode.taskRetention.maxCount, so the infos of the tasks never dropped by
+// IndexCoord don't pile up. The states of the evicted tasks are remembered, so QueryJobs tells an evicted
+// task from an unknown one.
+type taskRetention struct {
+	node *IndexNode
+	// lastEviction is the unix nano time of the last eviction.
+	lastEviction int64
+	evicted      cache.Cache[taskKey, commonpb.IndexState]
+}
+
+func newTaskRetention(node *IndexNode) *taskRetention {
+	return &taskRetention{
+		node:    node,
+		evicted: cache.NewCache[taskKey, commonpb.IndexState](cache.WithMaximumSize[taskKey, commonpb.IndexState](evictedTaskRecords)),
+	}
+}
+
+// maybeEvict evicts the completed tasks beyond the retention policy, at most once in taskRetentionInterval.
+func (r *taskRetention) maybeEvict() {
+	now := time.Now()
+	last := atomic.LoadInt64(&r.lastEviction)
+	if now.UnixNano()-last < int64(taskRetentionInterval) || !atomic.CompareAndSwapInt64(&r.lastEviction, last, now.UnixNano()) {
+		return
+	}
+	r.evict(now)
+}
+
+// evict evicts the completed tasks beyond the retention policy and returns their keys.
+func (r *taskRetention) evict(now time.Time) []taskKey {
+	params := r.node.params
+	ttl := params.IndexNodeCfg.TaskRetentionTTL.GetAsDuration(time.Second)
+	maxCount := params.IndexNodeCfg.TaskRetentionMaxCount.GetAsInt()
+	if ttl <= 0 && maxCount <= 0 {
+		return nil
+	}
+	type completedTask struct {
+		key     taskKey
+		endTime time.Time
+	}
+	completed := make([]completedTask, 0)
+	r.node.tasks.foreach(func(key taskKey, info *taskInfo) {
+		if info.phase.isTerminal() {
+			completed = append(completed, completedTask{key: key, endTime: info.endTime})
+		}
+	})
+	sort.Slice(completed, func(i, j int) bool { return completed[i].endTime.Before(completed[j].endTime) })
+	num := 0
+	for num < len(completed) && ttl > 0 && now.Sub(completed[num].endTime) > ttl {
+		num++
+	}
+	if maxCount > 0 && len(completed)-num > maxCount {
+		num = len(completed) - maxCount
+	}
+	if num == 0 {
+		return nil
+	}
+
+	keys := make([]taskKey, 0, num)
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	for _, task := range completed[:num] {
+		// the task is evicted only if it's still completed, it may be dropped and created again meanwhile.
+		info := r.node.tasks.deleteIf(task.key, func(info *taskInfo) bool { return info.phase.isTerminal() })
+		if info == nil {
+			continue
+		}
+		state := info.phase.indexState()
+		r.evicted.Put(task.key, state)
+		metrics.IndexNodeEvictedTaskCounter.WithLabelValues(nodeID, state.String()).Inc()
+		keys = append(keys, task.key)
+	}
+	r.node.jobLogs.remove(keys...)
+	log.Info("IndexNode evict the infos of the completed tasks", zap.Int("num", len(keys)),
+		zap.Duration("ttl", ttl), zap.Int("maxCount", maxCount))
+	return keys
+}
+
+// evictedState returns the state of the task before its info was evicted, IndexStateNone if it wasn't evicted.
+func (r *taskRetention) evictedState(key taskKey) commonpb.IndexState {
+	state, ok := r.evicted.GetIfPresent(key)
+	if !ok {
+		return commonpb.IndexState_IndexStateNone
+	}
+	return state
+}
+
+// forget drops the evicted state of the task created again.
+func (r *taskRetention) forget(key taskKey) {
+	r.evicted.Invalidate(key)
+}
+
+func (r *taskRetention) Close() {
+	r.evicted.Close()
+}
@@ -0,0 +1,95 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestTaskRetention(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	node := &IndexNode{params: params, reporter: newJobResultReporter(params)}
+	retention := newTaskRetention(node)
+	defer retention.Close()
+
+	now := time.Now()
+	store := func(buildID UniqueID, phase taskPhase, age time.Duration) {
+		node.loadOrStoreTask("cluster", buildID, &taskInfo{phase: phase, endTime: now.Add(-age)})
+	}
+	store(1, taskFinished, 3*time.Hour)
+	store(2, taskFailed, 2*time.Hour)
+	store(3, taskFinished, time.Hour)
+	store(4, taskFinished, time.Minute)
+	store(5, taskBuilding, 0)
+	node.tasks.update(taskKey{ClusterID: "cluster", BuildID: 5}, func(info *taskInfo) { info.endTime = time.Time{} })
+
+	t.Run("disabled", func(t *testing.T) {
+		assert.Empty(t, retention.evict(now))
+		assert.Equal(t, 5, node.tasks.len())
+	})
+
+	t.Run("ttl", func(t *testing.T) {
+		params.Save(params.IndexNodeCfg.TaskRetentionTTL.Key, "5400")
+		defer params.Reset(params.IndexNodeCfg.TaskRetentionTTL.Key)
+		assert.ElementsMatch(t, []taskKey{{ClusterID: "cluster", BuildID: 1}, {ClusterID: "cluster", BuildID: 2}}, retention.evict(now))
+		assert.Equal(t, commonpb.IndexState_IndexStateNone, node.loadTaskState("cluster", 1))
+		assert.Equal(t, commonpb.IndexState_Finished, retention.evictedState(taskKey{ClusterID: "cluster", BuildID: 1}))
+		assert.Equal(t, commonpb.IndexState_Failed, retention.evictedState(taskKey{ClusterID: "cluster", BuildID: 2}))
+		assert.Equal(t, commonpb.IndexState_IndexStateNone, retention.evictedState(taskKey{ClusterID: "cluster", BuildID: 3}))
+		assert.Equal(t, commonpb.IndexState_IndexStateNone, retention.evictedState(taskKey{ClusterID: "cluster", BuildID: 100}))
+	})
+
+	t.Run("max count", func(t *testing.T) {
+		params.Save(params.IndexNodeCfg.TaskRetentionMaxCount.Key, "1")
+		defer params.Reset(params.IndexNodeCfg.TaskRetentionMaxCount.Key)
+		// the oldest completed tasks are evicted first, the in progress ones are never evicted.
+		assert.Equal(t, []taskKey{{ClusterID: "cluster", BuildID: 3}}, retention.evict(now))
+		assert.Equal(t, commonpb.IndexState_Finished, node.loadTaskState("cluster", 4))
+		assert.Equal(t, commonpb.IndexState_InProgress, node.loadTaskState("cluster", 5))
+		assert.Empty(t, retention.evict(now))
+	})
+
+	t.Run("lazy", func(t *testing.T) {
+		node.retention = retention
+		defer func() { node.retention = nil }()
+		params.Save(params.IndexNodeCfg.TaskRetentionMaxCount.Key, "1")
+		defer params.Reset(params.IndexNodeCfg.TaskRetentionMaxCount.Key)
+
+		// a task created again is no longer reported as evicted.
+		retention.lastEviction = 0
+		node.loadOrStoreTask("cluster", 1, &taskInfo{phase: taskFinished, endTime: now})
+		assert.Equal(t, commonpb.IndexState_IndexStateNone, retention.evictedState(taskKey{ClusterID: "cluster", BuildID: 1}))
+		assert.Equal(t, commonpb.IndexState_Finished, retention.evictedState(taskKey{ClusterID: "cluster", BuildID: 4}))
+		assert.Equal(t, commonpb.IndexState_Finished, node.loadTaskState("cluster", 1))
+
+		// the evictions are at most once in taskRetentionInterval.
+		node.loadOrStoreTask("cluster", 6, &taskInfo{phase: taskFinished, endTime: now.Add(time.Minute)})
+		assert.Equal(t, commonpb.IndexState_Finished, node.loadTaskState("cluster", 1))
+	})
+
+	t.Run("end time", func(t *testing.T) {
+		node.loadOrStoreTask("cluster", 7, &taskInfo{phase: taskBuilding})
+		assert.NoError(t, node.transitTaskPhase("cluster", 7, taskFailed, "failed"))
+		assert.False(t, node.tasks.info(taskKey{ClusterID: "cluster", BuildID: 7}).endTime.IsZero())
+	})
+}
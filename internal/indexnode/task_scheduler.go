@@ -20,13 +20,19 @@ import (
 	"container/list"
 	"context"
 	"errors"
+	"fmt"
 	"runtime/debug"
+	"strconv"
 	"sync"
+	"time"
 
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 )
 
 // TaskQueue is a queue used to store tasks.
@@ -36,10 +42,29 @@ type TaskQueue interface {
 	utFull() bool
 	addUnissuedTask(t task) error
 	PopUnissuedTask() task
+	// popAdmittedTask pops the unissued task like PopUnissuedTask among the tasks admit accepts.
+	popAdmittedTask(admit func(t task) bool) task
+	// removeUnissuedTasks removes the unissued tasks matching the filter from the queue and returns them.
+	removeUnissuedTasks(filter func(t task) bool, reason string) []task
 	AddActiveTask(t task)
 	PopActiveTask(tName string) task
-	Enqueue(t task) error
+	ListActiveTasks() []task
+	ListUnissuedTasks() []task
+	// TryEnqueue adds the task to the queue, it fails at once if the queue is full.
+	TryEnqueue(t task) error
+	// EnqueueWithTimeout adds the task to the queue, it waits for the queue to have room until ctx is done.
+	EnqueueWithTimeout(ctx context.Context, t task) error
 	GetTaskNum() (int, int)
+	// snapshot returns the unissued tasks in queue order and the fair share state of the tenants.
+	snapshot(now time.Time) ([]taskSnapshot, []tenantSnapshot, float64)
+	// issueOrder returns the unissued tasks in the order they are issued if no task is enqueued meanwhile.
+	issueOrder() []task
+}
+
+// queuedTask is an unissued task with the time it was enqueued.
+type queuedTask struct {
+	task
+	enqueueTime time.Time
 }
 
 // BaseTaskQueue is a basic instance of TaskQueue.
@@ -48,11 +73,18 @@ type IndexTaskQueue struct {
 	activeTasks   map[string]task
 	utLock        sync.Mutex
 	atLock        sync.Mutex
+	// shares picks the unissued task to run next among the tenants, guarded by utLock.
+	shares *fairShare
 
 	// maxTaskNum should keep still
 	maxTaskNum int64
 
 	utBufChan chan int // to block scheduler
+	// notFull is closed once a task leaves the full queue, guarded by utLock. It's nil if no one waits for room.
+	notFull chan struct{}
+
+	// clock is the time source of the queue waits, the replay harness replaces it with a simulated clock.
+	clock func() time.Time
 
 	sched *TaskScheduler
 }
@@ -74,15 +106,66 @@ func (queue *IndexTaskQueue) addUnissuedTask(t task) error {
 	defer queue.utLock.Unlock()
 
 	if queue.utFull() {
-		return errors.New("IndexNode task queue is full")
+		queue.recordDecision(&schedDecision{Time: queue.clock(), Kind: decisionReject, Task: t.Name(), Tenant: t.Tenant(),
+			Reason: "queue is full"})
+		return errTaskQueueFull
 	}
-	queue.unissuedTasks.PushBack(t)
-	queue.utBufChan <- 1
+	queue.pushLocked(t)
 	return nil
 }
 
-// PopUnissuedTask pops a task from tasks queue.
+// pushLocked appends the task to the unissued tasks and wakes up the build loop, it must be called with utLock held.
+func (queue *IndexTaskQueue) pushLocked(t task) {
+	now := queue.clock()
+	queue.unissuedTasks.PushBack(&queuedTask{task: t, enqueueTime: now})
+	queue.shares.enqueue(t.Tenant())
+	queue.recordDecision(&schedDecision{Time: now, Kind: decisionEnqueue, Task: t.Name(), Tenant: t.Tenant()})
+	// the wake-ups are only dropped if as many are pending as tasks fit in the queue, each of them
+	// issues at least one task, so the enqueue never blocks on the build loop.
+	select {
+	case queue.utBufChan <- 1:
+	default:
+	}
+}
+
+// recordDecision appends the scheduling decision to the decision log of the scheduler if it's enabled.
+func (queue *IndexTaskQueue) recordDecision(d *schedDecision) {
+	if queue.sched != nil && queue.sched.decisions != nil {
+		queue.sched.decisions.record(d)
+	}
+}
+
+// queuedTenantsLocked returns the fair share state of the tenants with queued tasks in the order of their
+// earliest queued tasks, the inputs a pick is made from. It must be called with utLock held.
+func (queue *IndexTaskQueue) queuedTenantsLocked() []decisionTenant {
+	index := make(map[string]int)
+	tenants := make([]decisionTenant, 0)
+	for e := queue.unissuedTasks.Front(); e != nil; e = e.Next() {
+		qt := e.Value.(*queuedTask)
+		if i, ok := index[qt.Tenant()]; ok {
+			tenants[i].Queued++
+			continue
+		}
+		index[qt.Tenant()] = len(tenants)
+		tenants = append(tenants, decisionTenant{
+			Tenant: qt.Tenant(),
+			Head:   qt.Name(),
+			Queued: 1,
+			Pass:   queue.shares.passes[qt.Tenant()],
+			Weight: queue.shares.weight(qt.Tenant()),
+		})
+	}
+	return tenants
+}
+
+// PopUnissuedTask pops the earliest task of the tenant with the smallest fair share pass from tasks queue.
 func (queue *IndexTaskQueue) PopUnissuedTask() task {
+	return queue.popAdmittedTask(nil)
+}
+
+// popAdmittedTask pops the earliest admitted task of the tenant with the smallest fair share pass among the
+// tenants with admitted tasks, a nil admit admits all the tasks.
+func (queue *IndexTaskQueue) popAdmittedTask(admit func(t task) bool) task {
 	queue.utLock.Lock()
 	defer queue.utLock.Unlock()
 
@@ -90,10 +173,91 @@ func (queue *IndexTaskQueue) PopUnissuedTask() task {
 		return nil
 	}
 
-	ft := queue.unissuedTasks.Front()
-	queue.unissuedTasks.Remove(ft)
+	logged := queue.sched != nil && queue.sched.decisions != nil
+	var tenants []decisionTenant
+	if logged {
+		tenants = queue.queuedTenantsLocked()
+	}
+	var chosen *list.Element
+	var skipped []string
+	for e := queue.unissuedTasks.Front(); e != nil; e = e.Next() {
+		qt := e.Value.(*queuedTask)
+		if admit != nil && !admit(qt.task) {
+			if logged {
+				skipped = append(skipped, qt.Name())
+			}
+			continue
+		}
+		if chosen == nil || queue.shares.less(qt.Tenant(), chosen.Value.(*queuedTask).Tenant()) {
+			chosen = e
+		}
+	}
+	if chosen == nil {
+		return nil
+	}
+	queue.unissuedTasks.Remove(chosen)
+	if queue.notFull != nil {
+		close(queue.notFull)
+		queue.notFull = nil
+	}
+	qt := chosen.Value.(*queuedTask)
+	queue.shares.schedule(qt.Tenant())
+	now := queue.clock()
+	queue.recordDecision(&schedDecision{Time: now, Kind: decisionPick, Task: qt.Name(), Tenant: qt.Tenant(), Tenants: tenants,
+		Skipped: skipped})
+	wait := now.Sub(qt.enqueueTime)
+	observeLatency(qt.Ctx(), metrics.IndexNodeTaskWaitLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), qt.Tenant()), wait)
+	if queue.sched != nil {
+		queue.sched.waits.observeWait(wait)
+	}
 
-	return ft.Value.(task)
+	return qt.task
+}
+
+// ListActiveTasks returns the tasks being processed.
+func (queue *IndexTaskQueue) ListActiveTasks() []task {
+	queue.atLock.Lock()
+	defer queue.atLock.Unlock()
+	tasks := make([]task, 0, len(queue.activeTasks))
+	for _, t := range queue.activeTasks {
+		tasks = append(tasks, t)
+	}
+	return tasks
+}
+
+// ListUnissuedTasks returns the tasks waiting in the queue, in queue order.
+func (queue *IndexTaskQueue) ListUnissuedTasks() []task {
+	queue.utLock.Lock()
+	defer queue.utLock.Unlock()
+	tasks := make([]task, 0, queue.unissuedTasks.Len())
+	for e := queue.unissuedTasks.Front(); e != nil; e = e.Next() {
+		tasks = append(tasks, e.Value.(*queuedTask).task)
+	}
+	return tasks
+}
+
+func (queue *IndexTaskQueue) removeUnissuedTasks(filter func(t task) bool, reason string) []task {
+	queue.utLock.Lock()
+	defer queue.utLock.Unlock()
+
+	removed := make([]task, 0)
+	for e := queue.unissuedTasks.Front(); e != nil; {
+		next := e.Next()
+		qt := e.Value.(*queuedTask)
+		if filter(qt.task) {
+			queue.unissuedTasks.Remove(e)
+			queue.shares.withdraw(qt.Tenant())
+			queue.recordDecision(&schedDecision{Time: queue.clock(), Kind: decisionWithdraw, Task: qt.Name(),
+				Tenant: qt.Tenant(), Reason: reason})
+			removed = append(removed, qt.task)
+		}
+		e = next
+	}
+	if len(removed) > 0 && queue.notFull != nil {
+		close(queue.notFull)
+		queue.notFull = nil
+	}
+	return removed
 }
 
 // AddActiveTask adds a task to activeTasks.
@@ -124,8 +288,8 @@ func (queue *IndexTaskQueue) PopActiveTask(tName string) task {
 	return nil
 }
 
-// Enqueue adds a task to TaskQueue.
-func (queue *IndexTaskQueue) Enqueue(t task) error {
+// TryEnqueue adds a task to TaskQueue, it returns errTaskQueueFull at once if the queue is full.
+func (queue *IndexTaskQueue) TryEnqueue(t task) error {
 	err := t.OnEnqueue(t.Ctx())
 	if err != nil {
 		return err
@@ -133,6 +297,38 @@ func (queue *IndexTaskQueue) Enqueue(t task) error {
 	return queue.addUnissuedTask(t)
 }
 
+// EnqueueWithTimeout adds a task to TaskQueue, if the queue is full it waits for a task to be issued
+// until ctx is done, and returns errTaskQueueFull then.
+func (queue *IndexTaskQueue) EnqueueWithTimeout(ctx context.Context, t task) error {
+	err := t.OnEnqueue(t.Ctx())
+	if err != nil {
+		return err
+	}
+	for {
+		queue.utLock.Lock()
+		if !queue.utFull() {
+			queue.pushLocked(t)
+			queue.utLock.Unlock()
+			return nil
+		}
+		if queue.notFull == nil {
+			queue.notFull = make(chan struct{})
+		}
+		notFull := queue.notFull
+		queue.utLock.Unlock()
+
+		select {
+		case <-notFull:
+		case <-ctx.Done():
+			queue.utLock.Lock()
+			queue.recordDecision(&schedDecision{Time: queue.clock(), Kind: decisionReject, Task: t.Name(), Tenant: t.Tenant(),
+				Reason: "queue is full until timeout"})
+			queue.utLock.Unlock()
+			return fmt.Errorf("%w after waiting: %s", errTaskQueueFull, ctx.Err())
+		}
+	}
+}
+
 func (queue *IndexTaskQueue) GetTaskNum() (int, int) {
 	queue.utLock.Lock()
 	defer queue.utLock.Unlock()
@@ -150,13 +346,69 @@ func (queue *IndexTaskQueue) GetTaskNum() (int, int) {
 	return utNum, atNum
 }
 
-// NewIndexBuildTaskQueue creates a new IndexBuildTaskQueue.
-func NewIndexBuildTaskQueue(sched *TaskScheduler) *IndexTaskQueue {
+func (queue *IndexTaskQueue) snapshot(now time.Time) ([]taskSnapshot, []tenantSnapshot, float64) {
+	queue.utLock.Lock()
+	defer queue.utLock.Unlock()
+
+	queued := make([]taskSnapshot, 0, queue.unissuedTasks.Len())
+	for e := queue.unissuedTasks.Front(); e != nil; e = e.Next() {
+		qt := e.Value.(*queuedTask)
+		queued = append(queued, taskSnapshot{
+			Name:   qt.Name(),
+			Tenant: qt.Tenant(),
+			Wait:   now.Sub(qt.enqueueTime).String(),
+		})
+	}
+	tenants := make([]tenantSnapshot, 0, len(queue.shares.tasks))
+	for tenant, num := range queue.shares.tasks {
+		tenants = append(tenants, tenantSnapshot{
+			Tenant: tenant,
+			Queued: num,
+			Pass:   queue.shares.passes[tenant],
+			Weight: queue.shares.weight(tenant),
+		})
+	}
+	return queued, tenants, queue.shares.virtualTime
+}
+
+// issueOrder replays the fair share picks of PopUnissuedTask on a copy of the passes of the tenants.
+func (queue *IndexTaskQueue) issueOrder() []task {
+	queue.utLock.Lock()
+	defer queue.utLock.Unlock()
+
+	passes := make(map[string]float64, len(queue.shares.passes))
+	for tenant, pass := range queue.shares.passes {
+		passes[tenant] = pass
+	}
+	remaining := make([]*queuedTask, 0, queue.unissuedTasks.Len())
+	for e := queue.unissuedTasks.Front(); e != nil; e = e.Next() {
+		remaining = append(remaining, e.Value.(*queuedTask))
+	}
+	order := make([]task, 0, len(remaining))
+	for len(remaining) > 0 {
+		chosen := 0
+		for i := 1; i < len(remaining); i++ {
+			if passes[remaining[i].Tenant()] < passes[remaining[chosen].Tenant()] {
+				chosen = i
+			}
+		}
+		qt := remaining[chosen]
+		order = append(order, qt.task)
+		passes[qt.Tenant()] += 1 / queue.shares.weight(qt.Tenant())
+		remaining = append(remaining[:chosen], remaining[chosen+1:]...)
+	}
+	return order
+}
+
+// NewIndexBuildTaskQueue creates a new IndexBuildTaskQueue sharing the build slots among the tenants by params.
+func NewIndexBuildTaskQueue(sched *TaskScheduler, params *paramtable.ComponentParam) *IndexTaskQueue {
 	return &IndexTaskQueue{
 		unissuedTasks: list.New(),
+		shares:        newFairShare(params),
 		activeTasks:   make(map[string]task),
 		maxTaskNum:    1024,
 		utBufChan:     make(chan int, 1024),
+		clock:         time.Now,
 		sched:         sched,
 	}
 }
@@ -165,43 +417,127 @@ func NewIndexBuildTaskQueue(sched *TaskScheduler) *IndexTaskQueue {
 type TaskScheduler struct {
 	IndexBuildQueue TaskQueue
 
-	buildParallel int
+	buildParallel atomic.Int32
 	wg            sync.WaitGroup
 	ctx           context.Context
 	cancel        context.CancelFunc
+
+	// slots holds the build slots occupied by running tasks.
+	slotLock sync.Mutex
+	slots    map[string]*buildSlot
+
+	params *paramtable.ComponentParam
+	faults *faultInjector
+	// subErrors records the errors failing the tasks against the subcomponents of the node.
+	subErrors *subcomponentErrors
+	// waits tracks the queue waits and the run times of the recent tasks.
+	waits *waitTracker
+	// baselines are the build throughputs of the recent tasks, the build time of a task is estimated by them.
+	baselines *buildBaselines
+	// decisions records the scheduling decisions for replay, it's nil if indexNode.scheduler.decisionLog.enable
+	// is not set.
+	decisions *decisionLog
 }
 
-// NewTaskScheduler creates a new task scheduler of indexing tasks.
-func NewTaskScheduler(ctx context.Context) *TaskScheduler {
+// NewTaskScheduler creates a new task scheduler of indexing tasks reading its configs from params.
+func NewTaskScheduler(ctx context.Context, params *paramtable.ComponentParam) *TaskScheduler {
 	ctx1, cancel := context.WithCancel(ctx)
 	s := &TaskScheduler{
-		ctx:           ctx1,
-		cancel:        cancel,
-		buildParallel: Params.IndexNodeCfg.BuildParallel.GetAsInt(),
+		ctx:       ctx1,
+		cancel:    cancel,
+		params:    params,
+		slots:     make(map[string]*buildSlot),
+		waits:     newWaitTracker(params),
+		baselines: newBuildBaselines(),
 	}
-	s.IndexBuildQueue = NewIndexBuildTaskQueue(s)
+	s.buildParallel.Store(params.IndexNodeCfg.BuildParallel.GetAsInt32())
+	s.IndexBuildQueue = NewIndexBuildTaskQueue(s, params)
 
 	return s
 }
 
+// getBuildParallel returns the max number of tasks built concurrently.
+func (sched *TaskScheduler) getBuildParallel() int {
+	return int(sched.buildParallel.Load())
+}
+
+// setBuildParallel updates the max number of tasks built concurrently, it takes effect from the next schedule round.
+func (sched *TaskScheduler) setBuildParallel(parallel int) {
+	sched.buildParallel.Store(int32(parallel))
+}
+
+// buildSlot is the build slot occupied by a running task.
+type buildSlot struct {
+	indexType string
+	release   func()
+}
+
+// acquireSlot registers the build slot of the task, the returned function releases the slot and is idempotent.
+func (sched *TaskScheduler) acquireSlot(tName string, indexType string, done func()) func() {
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			sched.slotLock.Lock()
+			delete(sched.slots, tName)
+			sched.slotLock.Unlock()
+			done()
+		})
+	}
+	sched.slotLock.Lock()
+	sched.slots[tName] = &buildSlot{indexType: indexType, release: release}
+	sched.slotLock.Unlock()
+	return release
+}
+
+// slotNames returns the names of the tasks holding build slots.
+func (sched *TaskScheduler) slotNames() []string {
+	sched.slotLock.Lock()
+	defer sched.slotLock.Unlock()
+	names := make([]string, 0, len(sched.slots))
+	for name := range sched.slots {
+		names = append(names, name)
+	}
+	return names
+}
+
+// slotIndexTypes returns the index types of the tasks holding build slots.
+func (sched *TaskScheduler) slotIndexTypes() []string {
+	sched.slotLock.Lock()
+	defer sched.slotLock.Unlock()
+	indexTypes := make([]string, 0, len(sched.slots))
+	for _, slot := range sched.slots {
+		indexTypes = append(indexTypes, slot.indexType)
+	}
+	return indexTypes
+}
+
+// scheduleIndexBuildTask pops the tasks of the next round as long as the build slots of their index types
+// have room.
 func (sched *TaskScheduler) scheduleIndexBuildTask() []task {
 	ret := make([]task, 0)
-	for i := 0; i < sched.buildParallel; i++ {
-		t := sched.IndexBuildQueue.PopUnissuedTask()
+	slots := newIndexTypeSlots(sched.params, sched.getBuildParallel(), sched.slotIndexTypes())
+	for {
+		t := sched.IndexBuildQueue.popAdmittedTask(slots.admit)
 		if t == nil {
 			return ret
 		}
+		slots.take(t.IndexType())
 		ret = append(ret, t)
 	}
-	return ret
 }
 
 func (sched *TaskScheduler) processTask(t task, q TaskQueue) {
-	wrap := func(fn func(ctx context.Context) error) error {
+	wrap := func(fn func(ctx context.Context) error) (err error) {
 		select {
 		case <-t.Ctx().Done():
 			return errCancel
 		default:
+			// a panicking stage fails the task instead of crashing the node with the other tasks.
+			defer func() {
+				if r := recover(); r != nil {
+					err = &taskPanicError{value: r, stack: debug.Stack()}
+				}
+			}()
 			return fn(t.Ctx())
 		}
 	}
@@ -212,22 +548,89 @@ func (sched *TaskScheduler) processTask(t task, q TaskQueue) {
 	}()
 	sched.IndexBuildQueue.AddActiveTask(t)
 	defer sched.IndexBuildQueue.PopActiveTask(t.Name())
+	if delay := sched.faults.schedulingDelay(); delay > 0 {
+		log.Ctx(t.Ctx()).Debug("inject scheduling delay", zap.String("task", t.Name()), zap.Duration("delay", delay))
+		select {
+		case <-t.Ctx().Done():
+		case <-time.After(delay):
+		}
+	}
 	log.Ctx(t.Ctx()).Debug("process task", zap.String("task", t.Name()))
-	pipelines := []func(context.Context) error{t.Prepare, t.LoadData, t.BuildIndex, t.SaveIndexFiles}
-	for _, fn := range pipelines {
-		if err := wrap(fn); err != nil {
-			if err == errCancel {
+	start := time.Now()
+	stages := []struct {
+		phase taskPhase
+		fn    func(context.Context) error
+	}{
+		{taskPreparing, t.Prepare},
+		{taskLoading, t.LoadData},
+		{taskBuilding, t.BuildIndex},
+		{taskSaving, t.SaveIndexFiles},
+	}
+	hooks := getBuildHooks()
+	for _, stage := range stages {
+		if err := t.SetPhase(stage.phase, ""); err != nil {
+			// the task has been terminated outside of the pipeline, e.g. reaped for exceeding its lifetime.
+			log.Ctx(t.Ctx()).Warn("index build task stopped", zap.String("task", t.Name()), zap.Error(err))
+			return
+		}
+		info := BuildStageInfo{Task: t.Name(), ClusterID: t.Tenant(), Stage: stage.phase.String()}
+		if err := wrap(withBuildHooks(hooks, info, stage.fn)); err != nil {
+			if !errors.Is(err, errBruteForce) && err != errCancel {
+				recordFailureDomain(t, err)
+			}
+			if errors.Is(err, errBruteForce) {
+				log.Ctx(t.Ctx()).Info("index build task skipped, the segment is searched by brute force",
+					zap.String("task", t.Name()), zap.Error(err))
+				t.SetPhase(taskFinished, "")
+			} else if err == errCancel {
 				log.Ctx(t.Ctx()).Warn("index build task canceled", zap.String("task", t.Name()))
-				t.SetState(commonpb.IndexState_Failed, err.Error())
-			} else if errors.Is(err, ErrNoSuchKey) {
-				t.SetState(commonpb.IndexState_Failed, err.Error())
+				t.SetPhase(taskFailed, err.Error())
+			} else if errors.Is(err, ErrNoSuchKey) || errors.Is(err, errDataMismatch) ||
+				errors.Is(err, errNonFiniteVector) || errors.Is(err, ErrBuildRejected) ||
+				errors.Is(err, errIndexCorrupted) || errors.Is(err, errStaleRebuild) ||
+				errors.Is(err, errBuildSandboxCrashed) || errors.Is(err, errAutoIndex) {
+				t.SetPhase(taskFailed, diagnose(sched.params, t, stage.phase, err))
+			} else if errors.Is(err, errTaskPanic) {
+				log.Ctx(t.Ctx()).Error("index build task panicked", zap.String("task", t.Name()), zap.Error(err))
+				sched.subErrors.record(subcomponentScheduler, err)
+				t.SetPhase(taskFailed, diagnose(sched.params, t, stage.phase, err))
 			} else {
-				t.SetState(commonpb.IndexState_Retry, err.Error())
+				sched.subErrors.recordStage(stage.phase, err)
+				t.SetPhase(taskAbandoned, err.Error())
 			}
 			return
 		}
 	}
-	t.SetState(commonpb.IndexState_Finished, "")
+	t.SetPhase(taskFinished, "")
+	sched.waits.observeRun(time.Since(start))
+}
+
+// estimateWait returns the estimated wait of a task enqueued now and the number of the queued tasks.
+func (sched *TaskScheduler) estimateWait() (time.Duration, int) {
+	unissued, active := sched.IndexBuildQueue.GetTaskNum()
+	return estimateWait(unissued+active, sched.getBuildParallel(), sched.meanCost()), unissued
+}
+
+// meanCost returns the mean estimated run time of the queued and running tasks, a build task is estimated by
+// the build throughput baseline of its index type and dim class, the others take the mean run time of the recent
+// tasks. It's the mean run time if there is no task.
+func (sched *TaskScheduler) meanCost() time.Duration {
+	meanRun := sched.waits.meanRun()
+	tasks := append(sched.IndexBuildQueue.ListUnissuedTasks(), sched.IndexBuildQueue.ListActiveTasks()...)
+	if len(tasks) == 0 {
+		return meanRun
+	}
+	var total time.Duration
+	for _, t := range tasks {
+		cost := meanRun
+		if it, ok := t.(*indexBuildTask); ok && it.req != nil {
+			if estimated, ok := sched.baselines.estimateJob(it.req); ok {
+				cost = estimated
+			}
+		}
+		total += cost
+	}
+	return total / time.Duration(len(tasks))
 }
 
 func (sched *TaskScheduler) indexBuildLoop() {
@@ -242,10 +645,15 @@ func (sched *TaskScheduler) indexBuildLoop() {
 			var wg sync.WaitGroup
 			for _, t := range tasks {
 				wg.Add(1)
-				go func(group *sync.WaitGroup, t task) {
-					defer group.Done()
+				release := sched.acquireSlot(t.Name(), t.IndexType(), wg.Done)
+				if sched.decisions != nil {
+					sched.decisions.record(&schedDecision{Kind: decisionSlot, Task: t.Name(), Tenant: t.Tenant(),
+						Parallel: sched.getBuildParallel(), Slots: len(sched.slotNames())})
+				}
+				go func(t task) {
+					defer release()
 					sched.processTask(t, sched.IndexBuildQueue)
-				}(&wg, t)
+				}(t)
 			}
 			wg.Wait()
 		}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -70,9 +71,11 @@ type fakeTask struct {
 	ctx           context.Context
 	state         fakeTaskState
 	reterr        map[fakeTaskState]error
-	retstate      commonpb.IndexState
+	phase         taskPhase
 	expectedState commonpb.IndexState
 	failReason    string
+	tenant        string
+	indexType     string
 }
 
 var _ task = &fakeTask{}
@@ -120,13 +123,25 @@ func (t *fakeTask) Reset() {
 	_taskwg.Done()
 }
 
-func (t *fakeTask) SetState(state commonpb.IndexState, failReason string) {
-	t.retstate = state
+func (t *fakeTask) SetPhase(phase taskPhase, failReason string) error {
+	if err := checkTransition(t.phase, phase); err != nil {
+		return err
+	}
+	t.phase = phase
 	t.failReason = failReason
+	return nil
+}
+
+func (t *fakeTask) Tenant() string {
+	return t.tenant
+}
+
+func (t *fakeTask) IndexType() string {
+	return t.indexType
 }
 
 func (t *fakeTask) GetState() commonpb.IndexState {
-	return t.retstate
+	return t.phase.indexState()
 }
 
 var (
@@ -149,15 +164,15 @@ func newTask(cancelStage fakeTaskState, reterror map[fakeTaskState]error, expect
 			ch:           make(chan struct{}),
 		},
 		state:         fakeTaskInited,
-		retstate:      commonpb.IndexState_IndexStateNone,
+		phase:         taskPending,
 		expectedState: expectedState,
 	}
 }
 
 func TestIndexTaskScheduler(t *testing.T) {
-	Params.Init()
+	params := paramtable.Get().Namespace()
 
-	scheduler := NewTaskScheduler(context.TODO())
+	scheduler := NewTaskScheduler(context.TODO(), params)
 	scheduler.Start()
 
 	tasks := make([]task, 0)
@@ -172,7 +187,7 @@ func TestIndexTaskScheduler(t *testing.T) {
 		newTask(fakeTaskSavedIndexes, map[fakeTaskState]error{fakeTaskSavedIndexes: fmt.Errorf("auth failed")}, commonpb.IndexState_Retry))
 
 	for _, task := range tasks {
-		assert.Nil(t, scheduler.IndexBuildQueue.Enqueue(task))
+		assert.Nil(t, scheduler.IndexBuildQueue.TryEnqueue(task))
 	}
 	_taskwg.Wait()
 	scheduler.Close()
@@ -187,14 +202,14 @@ func TestIndexTaskScheduler(t *testing.T) {
 	assert.Equal(t, tasks[len(tasks)-1].GetState(), tasks[len(tasks)-1].(*fakeTask).expectedState)
 	assert.Equal(t, tasks[len(tasks)-1].Ctx().(*stagectx).curstate, fakeTaskState(fakeTaskSavedIndexes))
 
-	scheduler = NewTaskScheduler(context.TODO())
+	scheduler = NewTaskScheduler(context.TODO(), params)
 	tasks = make([]task, 0, 1024)
 	for i := 0; i < 1024; i++ {
 		tasks = append(tasks, newTask(fakeTaskSavedIndexes, nil, commonpb.IndexState_Finished))
-		assert.Nil(t, scheduler.IndexBuildQueue.Enqueue(tasks[len(tasks)-1]))
+		assert.Nil(t, scheduler.IndexBuildQueue.TryEnqueue(tasks[len(tasks)-1]))
 	}
 	failTask := newTask(fakeTaskSavedIndexes, nil, commonpb.IndexState_Finished)
-	err := scheduler.IndexBuildQueue.Enqueue(failTask)
+	err := scheduler.IndexBuildQueue.TryEnqueue(failTask)
 	assert.Error(t, err)
 	failTask.Reset()
 
@@ -206,3 +221,34 @@ func TestIndexTaskScheduler(t *testing.T) {
 		assert.Equal(t, task.GetState(), commonpb.IndexState_Finished)
 	}
 }
+
+func TestEnqueueWithTimeout(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	queue := NewTaskScheduler(context.TODO(), params).IndexBuildQueue.(*IndexTaskQueue)
+	queue.maxTaskNum = 2
+	assert.NoError(t, queue.TryEnqueue(&replayTask{name: "task-1", tenant: "a"}))
+	assert.NoError(t, queue.EnqueueWithTimeout(context.TODO(), &replayTask{name: "task-2", tenant: "a"}))
+	assert.ErrorIs(t, queue.TryEnqueue(&replayTask{name: "task-3", tenant: "a"}), errTaskQueueFull)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 50*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, queue.EnqueueWithTimeout(ctx, &replayTask{name: "task-3", tenant: "a"}), errTaskQueueFull)
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		queue.PopUnissuedTask()
+	}()
+	assert.NoError(t, queue.EnqueueWithTimeout(context.TODO(), &replayTask{name: "task-3", tenant: "a"}))
+	assert.Equal(t, []string{"task-2", "task-3"}, []string{queue.PopUnissuedTask().Name(), queue.PopUnissuedTask().Name()})
+}
+
+func TestEnqueueNotBlockedByWakeUps(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	queue := NewTaskScheduler(context.TODO(), params).IndexBuildQueue.(*IndexTaskQueue)
+	queue.utBufChan = make(chan int, 1)
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, queue.TryEnqueue(&replayTask{name: fmt.Sprintf("task-%d", i), tenant: "a"}))
+		queue.PopUnissuedTask()
+	}
+	assert.Len(t, queue.utBufChan, 1)
+}
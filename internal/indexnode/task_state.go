@@ -16,26 +16,98 @@
 
 package indexnode
 
-type TaskState int32
+import (
+	"fmt"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+)
+
+// taskPhase is the phase of an index build task in IndexNode, a task moves through the phases as:
+//
+//	Pending -> Preparing -> Loading -> Building -> Saving -> Finished
+//
+// and every non-terminal phase may end in Failed or Abandoned. A loading task waits in AwaitingRestore
+// while its archived binlogs are restored from the cold tier, and then goes back to Loading.
+type taskPhase int32
 
 const (
-	TaskStateNormal  TaskState = 0
-	TaskStateAbandon TaskState = 1
-	TaskStateRetry   TaskState = 2
-	TaskStateFailed  TaskState = 3
+	taskPending taskPhase = iota
+	taskPreparing
+	taskLoading
+	taskBuilding
+	taskSaving
+	taskFinished
+	taskFailed
+	// taskAbandoned means the task failed for a retryable reason, IndexCoord reassigns it.
+	taskAbandoned
+	taskAwaitingRestore
 )
 
-var TaskStateNames = map[TaskState]string{
-	0: "Normal",
-	1: "Abandon",
-	2: "Retry",
-	3: "Failed",
+var taskPhaseNames = map[taskPhase]string{
+	taskPending:         "Pending",
+	taskPreparing:       "Preparing",
+	taskLoading:         "Loading",
+	taskBuilding:        "Building",
+	taskSaving:          "Saving",
+	taskFinished:        "Finished",
+	taskFailed:          "Failed",
+	taskAbandoned:       "Abandoned",
+	taskAwaitingRestore: "AwaitingRestore",
+}
+
+func (p taskPhase) String() string {
+	if name, ok := taskPhaseNames[p]; ok {
+		return name
+	}
+	return fmt.Sprintf("Unknown(%d)", int32(p))
+}
+
+// isTerminal reports whether the phase is final, the phase of a terminal task can no longer change.
+func (p taskPhase) isTerminal() bool {
+	return p == taskFinished || p == taskFailed || p == taskAbandoned
 }
 
-func (x TaskState) String() string {
-	ret, ok := TaskStateNames[x]
-	if !ok {
-		return "None"
+// indexState converts the phase to the index state reported to IndexCoord.
+func (p taskPhase) indexState() commonpb.IndexState {
+	switch p {
+	case taskFinished:
+		return commonpb.IndexState_Finished
+	case taskFailed:
+		return commonpb.IndexState_Failed
+	case taskAbandoned:
+		return commonpb.IndexState_Retry
+	default:
+		return commonpb.IndexState_InProgress
 	}
-	return ret
 }
+
+// nextTaskPhases are the phases each non-terminal phase may advance to besides Failed and Abandoned.
+var nextTaskPhases = map[taskPhase]taskPhase{
+	taskPending:   taskPreparing,
+	taskPreparing: taskLoading,
+	taskLoading:   taskBuilding,
+	taskBuilding:  taskSaving,
+	taskSaving:    taskFinished,
+}
+
+// checkTransition returns an error if the task is not allowed to move from phase from to phase to.
+func checkTransition(from, to taskPhase) error {
+	if from.isTerminal() {
+		return fmt.Errorf("illegal task phase transition from terminal phase %s to %s", from, to)
+	}
+	if to == taskFailed || to == taskAbandoned || nextTaskPhases[from] == to {
+		return nil
+	}
+	if (from == taskLoading && to == taskAwaitingRestore) || (from == taskAwaitingRestore && to == taskLoading) {
+		return nil
+	}
+	// a task finishes early without building an index once its data turns out to be too small.
+	if to == taskFinished && (from == taskPreparing || from == taskLoading) {
+		return nil
+	}
+	return fmt.Errorf("illegal task phase transition from %s to %s", from, to)
+}
+
+// taskPhaseHook is called after a task moves from phase from to phase to, it's called with
+// the state lock held, so it must not access the task infos of IndexNode.
+type taskPhaseHook func(key taskKey, from, to taskPhase, failReason string)
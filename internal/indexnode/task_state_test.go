@@ -17,14 +17,99 @@
 package indexnode
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 )
 
-func TestTaskState_String(t *testing.T) {
-	assert.Equal(t, TaskStateNormal.String(), "Normal")
-	assert.Equal(t, TaskStateAbandon.String(), "Abandon")
-	assert.Equal(t, TaskStateRetry.String(), "Retry")
-	assert.Equal(t, TaskStateFailed.String(), "Failed")
+func TestCheckTransition(t *testing.T) {
+	assert.NoError(t, checkTransition(taskPending, taskPreparing))
+	assert.NoError(t, checkTransition(taskSaving, taskFinished))
+	assert.NoError(t, checkTransition(taskLoading, taskFailed))
+	assert.NoError(t, checkTransition(taskBuilding, taskAbandoned))
+	assert.NoError(t, checkTransition(taskPreparing, taskFinished))
+	assert.NoError(t, checkTransition(taskLoading, taskFinished))
+	assert.NoError(t, checkTransition(taskLoading, taskAwaitingRestore))
+	assert.NoError(t, checkTransition(taskAwaitingRestore, taskLoading))
+	assert.NoError(t, checkTransition(taskAwaitingRestore, taskAbandoned))
+
+	assert.Error(t, checkTransition(taskPending, taskBuilding))
+	assert.Error(t, checkTransition(taskAwaitingRestore, taskBuilding))
+	assert.Error(t, checkTransition(taskPreparing, taskAwaitingRestore))
+	assert.Error(t, checkTransition(taskBuilding, taskLoading))
+	assert.Error(t, checkTransition(taskPending, taskFinished))
+	assert.Error(t, checkTransition(taskBuilding, taskFinished))
+	assert.Error(t, checkTransition(taskFinished, taskFailed))
+	assert.Error(t, checkTransition(taskFailed, taskAbandoned))
+
+	assert.Equal(t, commonpb.IndexState_InProgress, taskPending.indexState())
+	assert.Equal(t, commonpb.IndexState_Retry, taskAbandoned.indexState())
+	assert.Equal(t, commonpb.IndexState_InProgress, taskAwaitingRestore.indexState())
+	assert.Equal(t, "Building", taskBuilding.String())
+}
+
+func TestTransitTaskPhase(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	node := &IndexNode{
+		params:   params,
+		reporter: newJobResultReporter(params),
+	}
+	transitions := make([]string, 0)
+	node.registerPhaseHook(func(key taskKey, from, to taskPhase, failReason string) {
+		transitions = append(transitions, from.String()+"->"+to.String())
+	})
+	node.loadOrStoreTask("cluster", 1, &taskInfo{phase: taskPending})
+
+	assert.Error(t, node.transitTaskPhase("cluster", 2, taskPreparing, ""))
+	assert.Error(t, node.transitTaskPhase("cluster", 1, taskLoading, ""))
+	assert.Equal(t, commonpb.IndexState_InProgress, node.loadTaskState("cluster", 1))
+
+	node.storeTaskWarning("cluster", 1, "nlist is not set")
+	for _, phase := range []taskPhase{taskPreparing, taskLoading, taskBuilding, taskSaving, taskFinished} {
+		assert.NoError(t, node.transitTaskPhase("cluster", 1, phase, ""))
+	}
+	assert.Equal(t, commonpb.IndexState_Finished, node.loadTaskState("cluster", 1))
+	assert.Equal(t, commonpb.IndexState_Finished, node.reporter.pending[taskKey{ClusterID: "cluster", BuildID: 1}].GetState())
+	assert.Equal(t, []string{"nlist is not set"}, node.reporter.pending[taskKey{ClusterID: "cluster", BuildID: 1}].GetWarnings())
+	assert.Equal(t, []string{"Pending->Preparing", "Preparing->Loading", "Loading->Building",
+		"Building->Saving", "Saving->Finished"}, transitions)
+
+	assert.Error(t, node.transitTaskPhase("cluster", 1, taskFailed, "late failure"))
+	assert.Equal(t, commonpb.IndexState_Finished, node.loadTaskState("cluster", 1))
+}
+
+func TestBruteForceTask(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	params.Save(params.IndexNodeCfg.BruteForceRowThreshold.Key, "100")
+
+	node := &IndexNode{
+		params:   params,
+		reporter: newJobResultReporter(params),
+	}
+	node.loadOrStoreTask("cluster", 1, &taskInfo{phase: taskPending})
+	it := &indexBuildTask{
+		ClusterID: "cluster",
+		BuildID:   1,
+		node:      node,
+		req:       &indexpb.CreateJobRequest{ClusterID: "cluster", BuildID: 1, NumRows: 10},
+	}
+
+	assert.NoError(t, it.SetPhase(taskPreparing, ""))
+	err := it.Prepare(context.Background())
+	assert.True(t, errors.Is(err, errBruteForce))
+	assert.NoError(t, it.SetPhase(taskFinished, ""))
+
+	info := node.reporter.pending[taskKey{ClusterID: "cluster", BuildID: 1}]
+	assert.Equal(t, commonpb.IndexState_Finished, info.GetState())
+	assert.True(t, info.GetBruteForce())
+	assert.Empty(t, info.GetIndexFileKeys())
+
+	it.req.NumRows = 100
+	assert.NoError(t, it.checkBruteForce(it.req.GetNumRows()))
 }
@@ -1,107 +1,228 @@
 package indexnode
 
 import (
+	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"go.uber.org/zap"
 )
 
 func (i *IndexNode) loadOrStoreTask(ClusterID string, buildID UniqueID, info *taskInfo) *taskInfo {
-	i.stateLock.Lock()
-	defer i.stateLock.Unlock()
 	key := taskKey{ClusterID: ClusterID, BuildID: buildID}
-	oldInfo, ok := i.tasks[key]
-	if ok {
-		return oldInfo
+	oldInfo := i.tasks.loadOrStore(key, info)
+	if oldInfo == nil && i.retention != nil {
+		i.retention.forget(key)
+		i.retention.maybeEvict()
 	}
-	i.tasks[key] = info
-	return nil
+	return oldInfo
+}
+
+// hasTask reports whether the task of the build is known to the node.
+func (i *IndexNode) hasTask(ClusterID string, buildID UniqueID) bool {
+	return i.tasks.update(taskKey{ClusterID: ClusterID, BuildID: buildID}, func(info *taskInfo) {})
 }
 
 func (i *IndexNode) loadTaskState(ClusterID string, buildID UniqueID) commonpb.IndexState {
+	state := commonpb.IndexState_IndexStateNone
+	i.tasks.update(taskKey{ClusterID: ClusterID, BuildID: buildID}, func(info *taskInfo) {
+		state = info.phase.indexState()
+	})
+	return state
+}
+
+// registerPhaseHook adds a hook called on every task phase transition, it must be called before any task is created.
+func (i *IndexNode) registerPhaseHook(hook taskPhaseHook) {
+	i.phaseHooksLock.Lock()
+	defer i.phaseHooksLock.Unlock()
+	i.phaseHooks = append(i.phaseHooks, hook)
+}
+
+// transitTaskPhase moves the task to the phase, illegal transitions are rejected and leave the task unchanged.
+func (i *IndexNode) transitTaskPhase(ClusterID string, buildID UniqueID, phase taskPhase, failReason string) error {
 	key := taskKey{ClusterID: ClusterID, BuildID: buildID}
-	i.stateLock.Lock()
-	defer i.stateLock.Unlock()
-	task, ok := i.tasks[key]
-	if !ok {
-		return commonpb.IndexState_IndexStateNone
+	var err error
+	if !i.tasks.update(key, func(info *taskInfo) { err = i.transitLocked(key, info, phase, failReason) }) {
+		return fmt.Errorf("index build task %s/%d not found", ClusterID, buildID)
 	}
-	return task.state
+	return err
 }
 
-func (i *IndexNode) storeTaskState(ClusterID string, buildID UniqueID, state commonpb.IndexState, failReason string) {
-	key := taskKey{ClusterID: ClusterID, BuildID: buildID}
-	i.stateLock.Lock()
-	defer i.stateLock.Unlock()
-	if task, ok := i.tasks[key]; ok {
-		log.Debug("IndexNode store task state", zap.String("clusterID", ClusterID), zap.Int64("buildID", buildID),
-			zap.String("state", state.String()), zap.String("fail reason", failReason))
-		task.state = state
-		task.failReason = failReason
+func (i *IndexNode) transitLocked(key taskKey, info *taskInfo, phase taskPhase, failReason string) error {
+	from := info.phase
+	if err := checkTransition(from, phase); err != nil {
+		log.Warn("IndexNode reject task phase transition", zap.String("clusterID", key.ClusterID),
+			zap.Int64("buildID", key.BuildID), zap.Error(err))
+		return err
+	}
+	log.Debug("IndexNode transit task phase", zap.String("clusterID", key.ClusterID), zap.Int64("buildID", key.BuildID),
+		zap.Stringer("from", from), zap.Stringer("to", phase), zap.String("fail reason", failReason))
+	info.phase = phase
+	info.failReason = failReason
+	if phase.isTerminal() {
+		info.endTime = time.Now()
+	}
+	metrics.IndexNodeTaskPhaseTransitionCounter.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10),
+		from.String(), phase.String()).Inc()
+	if phase == taskFinished || phase == taskFailed {
+		i.reporter.report(key.ClusterID, &indexpb.IndexTaskInfo{
+			BuildID:        key.BuildID,
+			State:          phase.indexState(),
+			IndexFileKeys:  common.CloneStringList(info.fileKeys),
+			IndexFileSizes: append([]uint64(nil), info.fileSizes...),
+			SerializedSize: info.serializedSize,
+			MemSize:        info.memSize,
+			FailReason:     failReason,
+			BruteForce:     info.bruteForce,
+			FailCode:       info.failCode,
+			FailureDomain:  info.failureDomain,
+			Warnings:       common.CloneStringList(info.warnings),
+		})
+	}
+	i.phaseHooksLock.RLock()
+	defer i.phaseHooksLock.RUnlock()
+	for _, hook := range i.phaseHooks {
+		hook(key, from, phase, failReason)
 	}
+	return nil
 }
 
 func (i *IndexNode) foreachTaskInfo(fn func(ClusterID string, buildID UniqueID, info *taskInfo)) {
-	i.stateLock.Lock()
-	defer i.stateLock.Unlock()
-	for key, info := range i.tasks {
+	i.tasks.foreach(func(key taskKey, info *taskInfo) {
 		fn(key.ClusterID, key.BuildID, info)
-	}
+	})
 }
 
-func (i *IndexNode) storeIndexFilesAndStatistic(ClusterID string, buildID UniqueID, fileKeys []string, serializedSize uint64, statistic *indexpb.JobInfo) {
-	key := taskKey{ClusterID: ClusterID, BuildID: buildID}
-	i.stateLock.Lock()
-	defer i.stateLock.Unlock()
-	if info, ok := i.tasks[key]; ok {
+// storeIndexFilesAndStatistic stores the index files with their sizes, the total serialized size
+// and the estimated memory size to load the index.
+func (i *IndexNode) storeIndexFilesAndStatistic(ClusterID string, buildID UniqueID, fileKeys []string, fileSizes []uint64,
+	serializedSize uint64, memSize uint64, statistic *indexpb.JobInfo) {
+	i.tasks.update(taskKey{ClusterID: ClusterID, BuildID: buildID}, func(info *taskInfo) {
 		info.fileKeys = common.CloneStringList(fileKeys)
+		info.fileSizes = append([]uint64(nil), fileSizes...)
 		info.serializedSize = serializedSize
+		info.memSize = memSize
 		info.statistic = proto.Clone(statistic).(*indexpb.JobInfo)
-		return
+	})
+}
+
+func (i *IndexNode) storeTaskBruteForce(ClusterID string, buildID UniqueID) {
+	i.tasks.update(taskKey{ClusterID: ClusterID, BuildID: buildID}, func(info *taskInfo) {
+		info.bruteForce = true
+	})
+}
+
+func (i *IndexNode) storeTaskFailCode(ClusterID string, buildID UniqueID, code commonpb.ErrorCode) {
+	i.tasks.update(taskKey{ClusterID: ClusterID, BuildID: buildID}, func(info *taskInfo) {
+		info.failCode = code
+	})
+}
+
+func (i *IndexNode) storeTaskFailureDomain(ClusterID string, buildID UniqueID, domain indexpb.FailureDomain) {
+	i.tasks.update(taskKey{ClusterID: ClusterID, BuildID: buildID}, func(info *taskInfo) {
+		info.failureDomain = domain
+	})
+}
+
+func (i *IndexNode) storeTaskWarning(ClusterID string, buildID UniqueID, warning string) {
+	i.tasks.update(taskKey{ClusterID: ClusterID, BuildID: buildID}, func(info *taskInfo) {
+		info.warnings = append(info.warnings, warning)
+	})
+}
+
+func (i *IndexNode) storeTaskStageMemDelta(key taskKey, delta *indexpb.StageMemDelta) {
+	i.tasks.update(key, func(info *taskInfo) {
+		info.stageMemDeltas = append(info.stageMemDeltas, delta)
+	})
+}
+
+func (i *IndexNode) storeTaskCollection(ClusterID string, buildID UniqueID, collectionID UniqueID) {
+	i.tasks.update(taskKey{ClusterID: ClusterID, BuildID: buildID}, func(info *taskInfo) {
+		info.collectionID = collectionID
+	})
+	// the collection may be dropped before the task learned it.
+	if i.collectionDrops.isDropped(collectionID) {
+		i.abortDroppedTask(taskKey{ClusterID: ClusterID, BuildID: buildID}, collectionID)
 	}
 }
 
 func (i *IndexNode) deleteTaskInfos(keys []taskKey) []*taskInfo {
-	i.stateLock.Lock()
-	defer i.stateLock.Unlock()
-	deleted := make([]*taskInfo, 0, len(keys))
-	for _, key := range keys {
-		info, ok := i.tasks[key]
-		if ok {
-			deleted = append(deleted, info)
-			delete(i.tasks, key)
-		}
-	}
-	return deleted
+	return i.tasks.delete(keys)
 }
 
 func (i *IndexNode) deleteAllTasks() []*taskInfo {
-	i.stateLock.Lock()
-	deletedTasks := i.tasks
-	i.tasks = make(map[taskKey]*taskInfo)
-	i.stateLock.Unlock()
-
-	deleted := make([]*taskInfo, 0, len(deletedTasks))
-	for _, info := range deletedTasks {
-		deleted = append(deleted, info)
-	}
-	return deleted
+	return i.tasks.clear()
 }
 
-func (i *IndexNode) hasInProgressTask() bool {
-	i.stateLock.Lock()
-	defer i.stateLock.Unlock()
-	for _, info := range i.tasks {
-		if info.state == commonpb.IndexState_InProgress {
-			return true
+// reapExpiredTasks force fails the in progress tasks which have been alive longer than maxLifetime,
+// the reaped tasks are canceled and their phases can no longer be changed.
+func (i *IndexNode) reapExpiredTasks(maxLifetime time.Duration) []taskKey {
+	reaped := make([]taskKey, 0)
+	i.tasks.foreach(func(key taskKey, info *taskInfo) {
+		if info.phase.isTerminal() || time.Since(info.startTime) < maxLifetime {
+			return
+		}
+		failReason := fmt.Sprintf("timeout: task exceeds the max lifetime %s", maxLifetime)
+		if i.abortLocked(key, info, failReason) {
+			reaped = append(reaped, key)
+		}
+	})
+	return reaped
+}
+
+// abortTask force fails the in progress task, it reports whether the task is aborted.
+func (i *IndexNode) abortTask(clusterID string, buildID UniqueID, failReason string) bool {
+	key := taskKey{ClusterID: clusterID, BuildID: buildID}
+	aborted := false
+	i.tasks.update(key, func(info *taskInfo) {
+		aborted = !info.phase.isTerminal() && i.abortLocked(key, info, failReason)
+	})
+	return aborted
+}
+
+// abandonTask gives up the in progress task for a retryable reason and cancels it, IndexCoord reassigns it.
+// It reports whether the task is abandoned.
+func (i *IndexNode) abandonTask(key taskKey, failReason string) bool {
+	abandoned := false
+	i.tasks.update(key, func(info *taskInfo) {
+		if info.phase.isTerminal() {
+			return
+		}
+		if err := i.transitLocked(key, info, taskAbandoned, failReason); err != nil {
+			return
 		}
+		if info.cancel != nil {
+			info.cancel()
+		}
+		abandoned = true
+	})
+	return abandoned
+}
+
+// abortLocked fails the task and cancels it, so that its phase can no longer be changed.
+func (i *IndexNode) abortLocked(key taskKey, info *taskInfo, failReason string) bool {
+	if err := i.transitLocked(key, info, taskFailed, failReason); err != nil {
+		return false
+	}
+	if info.cancel != nil {
+		info.cancel()
 	}
-	return false
+	return true
+}
+
+func (i *IndexNode) hasInProgressTask() bool {
+	inProgress := false
+	i.tasks.foreach(func(_ taskKey, info *taskInfo) {
+		inProgress = inProgress || !info.phase.isTerminal()
+	})
+	return inProgress
 }
 
 func (i *IndexNode) waitTaskFinish() {
@@ -109,7 +230,7 @@ func (i *IndexNode) waitTaskFinish() {
 		return
 	}
 
-	gracefulTimeout := Params.IndexNodeCfg.GracefulStopTimeout
+	gracefulTimeout := i.params.IndexNodeCfg.GracefulStopTimeout
 	timer := time.NewTimer(gracefulTimeout.GetAsDuration(time.Second))
 
 	for {
@@ -120,12 +241,30 @@ func (i *IndexNode) waitTaskFinish() {
 			}
 		case <-timer.C:
 			log.Warn("timeout, the index node has some progress task")
-			for _, info := range i.tasks {
-				if info.state == commonpb.IndexState_InProgress {
+			i.tasks.foreach(func(_ taskKey, info *taskInfo) {
+				if !info.phase.isTerminal() {
 					log.Warn("progress task", zap.Any("info", info))
 				}
-			}
+			})
 			return
 		}
 	}
 }
+
+// snapshotTasks returns the phases and ages of the in progress tasks by task name.
+func (i *IndexNode) snapshotTasks(now time.Time) map[string]taskSnapshot {
+	tasks := make(map[string]taskSnapshot)
+	i.tasks.foreach(func(key taskKey, info *taskInfo) {
+		if info.phase.isTerminal() {
+			return
+		}
+		name := fmt.Sprintf("%s/%d", key.ClusterID, key.BuildID)
+		tasks[name] = taskSnapshot{
+			Name:    name,
+			Tenant:  key.ClusterID,
+			Phase:   info.phase.String(),
+			Elapsed: now.Sub(info.startTime).String(),
+		}
+	})
+	return tasks
+}
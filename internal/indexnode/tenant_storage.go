@@ -0,0 +1,168 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/contextutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/ratelimitutil"
+)
+
+// tenantLimiterWaitInterval is the interval to retry a storage request throttled by the tenant rate limit.
+const tenantLimiterWaitInterval = 10 * time.Millisecond
+
+// tenantLimiters holds the storage request rate limiters of the clusters sharing the IndexNode.
+type tenantLimiters struct {
+	params *paramtable.ComponentParam
+
+	limiters sync.Map // cluster id -> *ratelimitutil.Limiter
+}
+
+func newTenantLimiters(params *paramtable.ComponentParam) *tenantLimiters {
+	return &tenantLimiters{params: params}
+}
+
+// wait blocks until the cluster is allowed to issue one more storage request, the rate is
+// indexNode.storage.tenantRequestRate and 0 means unlimited.
+func (l *tenantLimiters) wait(ctx context.Context, clusterID string) error {
+	if l == nil {
+		return nil
+	}
+	rate := l.params.IndexNodeCfg.StorageTenantRequestRate.GetAsFloat()
+	if rate <= 0 {
+		return nil
+	}
+	v, _ := l.limiters.LoadOrStore(clusterID, ratelimitutil.NewLimiter(ratelimitutil.Limit(rate), rate))
+	limiter := v.(*ratelimitutil.Limiter)
+	if limiter.Limit() != ratelimitutil.Limit(rate) {
+		limiter.SetLimit(ratelimitutil.Limit(rate))
+	}
+	for !limiter.AllowN(time.Now(), 1) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(tenantLimiterWaitInterval):
+		}
+	}
+	return nil
+}
+
+// tenantChunkManager scopes the storage requests to the cluster carried by the request context,
+// the requests are rate limited and counted per cluster.
+type tenantChunkManager struct {
+	storage.ChunkManager
+	limiters *tenantLimiters
+}
+
+var _ storage.ChunkManager = (*tenantChunkManager)(nil)
+
+func newTenantChunkManager(cm storage.ChunkManager, limiters *tenantLimiters) *tenantChunkManager {
+	return &tenantChunkManager{
+		ChunkManager: cm,
+		limiters:     limiters,
+	}
+}
+
+func (tcm *tenantChunkManager) before(ctx context.Context, op string) error {
+	clusterID := contextutil.ClusterID(ctx)
+	metrics.IndexNodeStorageRequestCounter.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), clusterID, op).Inc()
+	return tcm.limiters.wait(ctx, clusterID)
+}
+
+func (tcm *tenantChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	if err := tcm.before(ctx, "write"); err != nil {
+		return err
+	}
+	return tcm.ChunkManager.Write(ctx, filePath, content)
+}
+
+func (tcm *tenantChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
+	if err := tcm.before(ctx, "write"); err != nil {
+		return err
+	}
+	return tcm.ChunkManager.MultiWrite(ctx, contents)
+}
+
+func (tcm *tenantChunkManager) Exist(ctx context.Context, filePath string) (bool, error) {
+	if err := tcm.before(ctx, "stat"); err != nil {
+		return false, err
+	}
+	return tcm.ChunkManager.Exist(ctx, filePath)
+}
+
+func (tcm *tenantChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	if err := tcm.before(ctx, "read"); err != nil {
+		return nil, err
+	}
+	return tcm.ChunkManager.Read(ctx, filePath)
+}
+
+func (tcm *tenantChunkManager) MultiRead(ctx context.Context, filePaths []string) ([][]byte, error) {
+	if err := tcm.before(ctx, "read"); err != nil {
+		return nil, err
+	}
+	return tcm.ChunkManager.MultiRead(ctx, filePaths)
+}
+
+func (tcm *tenantChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	if err := tcm.before(ctx, "read"); err != nil {
+		return nil, err
+	}
+	return tcm.ChunkManager.ReadAt(ctx, filePath, off, length)
+}
+
+func (tcm *tenantChunkManager) ListWithPrefix(ctx context.Context, prefix string, recursive bool) ([]string, []time.Time, error) {
+	if err := tcm.before(ctx, "list"); err != nil {
+		return nil, nil, err
+	}
+	return tcm.ChunkManager.ListWithPrefix(ctx, prefix, recursive)
+}
+
+func (tcm *tenantChunkManager) ReadWithPrefix(ctx context.Context, prefix string) ([]string, [][]byte, error) {
+	if err := tcm.before(ctx, "read"); err != nil {
+		return nil, nil, err
+	}
+	return tcm.ChunkManager.ReadWithPrefix(ctx, prefix)
+}
+
+func (tcm *tenantChunkManager) Remove(ctx context.Context, filePath string) error {
+	if err := tcm.before(ctx, "remove"); err != nil {
+		return err
+	}
+	return tcm.ChunkManager.Remove(ctx, filePath)
+}
+
+func (tcm *tenantChunkManager) MultiRemove(ctx context.Context, filePaths []string) error {
+	if err := tcm.before(ctx, "remove"); err != nil {
+		return err
+	}
+	return tcm.ChunkManager.MultiRemove(ctx, filePaths)
+}
+
+func (tcm *tenantChunkManager) RemoveWithPrefix(ctx context.Context, prefix string) error {
+	if err := tcm.before(ctx, "remove"); err != nil {
+		return err
+	}
+	return tcm.ChunkManager.RemoveWithPrefix(ctx, prefix)
+}
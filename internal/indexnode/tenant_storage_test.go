@@ -0,0 +1,70 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/contextutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestTenantChunkManager(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	cm := newTenantChunkManager(storage.NewLocalChunkManager(storage.RootPath(t.TempDir())), newTenantLimiters(params))
+	ctx := contextutil.WithClusterID(context.Background(), "cluster-a")
+	counter := metrics.IndexNodeStorageRequestCounter.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), "cluster-a", "write")
+	before := testutil.ToFloat64(counter)
+
+	t.Run("unlimited", func(t *testing.T) {
+		assert.NoError(t, cm.Write(ctx, "a", []byte("a")))
+		value, err := cm.Read(ctx, "a")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("a"), value)
+		assert.Equal(t, before+1, testutil.ToFloat64(counter))
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		params.Save(params.IndexNodeCfg.StorageTenantRequestRate.Key, "1")
+
+		assert.NoError(t, cm.Write(ctx, "b", []byte("b")))
+		assert.NoError(t, cm.Write(ctx, "c", []byte("c")))
+		// the tokens of cluster-a are used up, the next request waits until ctx is done.
+		timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, cm.Write(timeoutCtx, "d", []byte("d")), context.DeadlineExceeded)
+
+		// the other clusters are not affected.
+		assert.NoError(t, cm.Write(contextutil.WithClusterID(context.Background(), "cluster-b"), "e", []byte("e")))
+	})
+}
+
+func TestChunkMgrCacheKey(t *testing.T) {
+	m := &chunkMgr{}
+	config := &indexpb.StorageConfig{StorageType: "minio", BucketName: "bucket", RootPath: "files"}
+	assert.NotEqual(t, m.cacheKey("cluster-a", config), m.cacheKey("cluster-b", config))
+	assert.Equal(t, m.cacheKey("cluster-a", config), m.cacheKey("cluster-a", config))
+}
@@ -0,0 +1,40 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// traceIDExemplarKey is the exemplar label carrying the trace id of the observed build.
+const traceIDExemplarKey = "trace_id"
+
+// observeLatency records the latency in milliseconds, and attaches the trace id of ctx as an exemplar if the
+// build is sampled, so a latency spike links to the trace of the build through the OpenMetrics exposition.
+func observeLatency(ctx context.Context, observer prometheus.Observer, latency time.Duration) {
+	value := float64(latency.Milliseconds())
+	sc := trace.SpanContextFromContext(ctx)
+	if exemplarObserver, ok := observer.(prometheus.ExemplarObserver); ok && sc.IsSampled() {
+		exemplarObserver.ObserveWithExemplar(value, prometheus.Labels{traceIDExemplarKey: sc.TraceID().String()})
+		return
+	}
+	observer.Observe(value)
+}
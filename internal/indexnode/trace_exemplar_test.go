@@ -0,0 +1,67 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type fakeExemplarObserver struct {
+	value    float64
+	exemplar prometheus.Labels
+}
+
+func (o *fakeExemplarObserver) Observe(value float64) {
+	o.value = value
+	o.exemplar = nil
+}
+
+func (o *fakeExemplarObserver) ObserveWithExemplar(value float64, exemplar prometheus.Labels) {
+	o.value = value
+	o.exemplar = exemplar
+}
+
+func TestObserveLatency(t *testing.T) {
+	observer := &fakeExemplarObserver{}
+	observeLatency(context.Background(), observer, 3*time.Millisecond)
+	assert.Equal(t, float64(3), observer.value)
+	assert.Nil(t, observer.exemplar)
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1, 2, 3},
+		SpanID:     trace.SpanID{4, 5, 6},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+	observeLatency(ctx, observer, 5*time.Millisecond)
+	assert.Equal(t, float64(5), observer.value)
+	assert.Equal(t, prometheus.Labels{traceIDExemplarKey: sc.TraceID().String()}, observer.exemplar)
+
+	// the unsampled builds carry no exemplar.
+	ctx = trace.ContextWithSpanContext(context.Background(), sc.WithTraceFlags(0))
+	observeLatency(ctx, observer, 7*time.Millisecond)
+	assert.Nil(t, observer.exemplar)
+
+	histogram := prometheus.NewHistogram(prometheus.HistogramOpts{Name: "test_latency"})
+	observeLatency(ctx, histogram, time.Millisecond)
+}
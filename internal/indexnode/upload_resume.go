@@ -0,0 +1,57 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"hash/crc32"
+	"sync"
+
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// uploadedSlices records the checksums of the index file slices uploaded by a task, so the resumed upload
+// skips the slices which are already there instead of uploading the whole index again.
+type uploadedSlices struct {
+	mu        sync.Mutex
+	checksums map[string]uint32
+}
+
+func newUploadedSlices() *uploadedSlices {
+	return &uploadedSlices{checksums: make(map[string]uint32)}
+}
+
+// record records the slice uploaded to path.
+func (s *uploadedSlices) record(path string, data []byte) {
+	checksum := crc32.ChecksumIEEE(data)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checksums[path] = checksum
+}
+
+// present tells whether the slice was uploaded to path with the same checksum, and its object is still there
+// with the size of the slice.
+func (s *uploadedSlices) present(ctx context.Context, cm storage.ChunkManager, path string, data []byte) bool {
+	s.mu.Lock()
+	checksum, ok := s.checksums[path]
+	s.mu.Unlock()
+	if !ok || checksum != crc32.ChecksumIEEE(data) {
+		return false
+	}
+	size, err := cm.Size(ctx, path)
+	return err == nil && size == int64(len(data))
+}
@@ -0,0 +1,52 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func TestUploadedSlices(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	cm := storage.NewLocalChunkManager(storage.RootPath(dir))
+	slices := newUploadedSlices()
+	slicePath := path.Join(dir, "index/slice_0")
+	data := []byte("index slice")
+
+	// the slice not uploaded yet.
+	assert.False(t, slices.present(ctx, cm, slicePath, data))
+
+	assert.NoError(t, cm.Write(ctx, slicePath, data))
+	slices.record(slicePath, data)
+	assert.True(t, slices.present(ctx, cm, slicePath, data))
+
+	// the content of the slice changed.
+	assert.False(t, slices.present(ctx, cm, slicePath, []byte("index slicf")))
+
+	// the object is gone or truncated.
+	assert.NoError(t, cm.Write(ctx, slicePath, data[:5]))
+	assert.False(t, slices.present(ctx, cm, slicePath, data))
+	assert.NoError(t, cm.Remove(ctx, slicePath))
+	assert.False(t, slices.present(ctx, cm, slicePath, data))
+}
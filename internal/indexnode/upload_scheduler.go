@@ -0,0 +1,156 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"math"
+	"sync"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// uploadScheduler shares the upload slots of the node among the saving tasks, the next free slot goes to
+// the task with the fewest bytes left to upload. When many tasks save at once, the tasks closest to completion
+// finish first and their indexes become available, instead of all the uploads finishing late together.
+type uploadScheduler struct {
+	params *paramtable.ComponentParam
+
+	mu      sync.Mutex
+	running int
+	// remaining is the bytes left to upload of each saving task, -1 if unknown as the task serializes
+	// the index file by file, such tasks are served after the others.
+	remaining map[taskKey]int64
+	waiters   []*uploadWaiter
+}
+
+type uploadWaiter struct {
+	key   taskKey
+	ready chan struct{}
+}
+
+func newUploadScheduler(params *paramtable.ComponentParam) *uploadScheduler {
+	return &uploadScheduler{
+		params:    params,
+		remaining: make(map[taskKey]int64),
+	}
+}
+
+func (s *uploadScheduler) enabled() bool {
+	return s != nil && s.params.IndexNodeCfg.UploadPriorityEnable.GetAsBool()
+}
+
+// startTask registers the bytes the task is going to upload, a negative size means unknown.
+func (s *uploadScheduler) startTask(key taskKey, size int64) {
+	if s == nil {
+		return
+	}
+	if size < 0 {
+		size = -1
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.remaining[key] = size
+}
+
+// finishTask unregisters the task once it's done uploading.
+func (s *uploadScheduler) finishTask(key taskKey) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.remaining, key)
+}
+
+// acquire blocks until the task is granted an upload slot, the returned function releases the slot
+// and charges the bytes uploaded to the task. It returns at once if the scheduling is disabled.
+func (s *uploadScheduler) acquire(ctx context.Context, key taskKey) (func(uploaded int64), error) {
+	if !s.enabled() {
+		return func(int64) {}, nil
+	}
+	release := func(uploaded int64) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.running--
+		if remaining, ok := s.remaining[key]; ok && remaining >= 0 {
+			s.remaining[key] = remaining - uploaded
+		}
+		s.dispatchLocked()
+	}
+
+	s.mu.Lock()
+	if len(s.waiters) == 0 && s.running < s.slots() {
+		s.running++
+		s.mu.Unlock()
+		return release, nil
+	}
+	waiter := &uploadWaiter{key: key, ready: make(chan struct{})}
+	s.waiters = append(s.waiters, waiter)
+	s.mu.Unlock()
+
+	select {
+	case <-waiter.ready:
+		return release, nil
+	case <-ctx.Done():
+	}
+	s.mu.Lock()
+	for i, w := range s.waiters {
+		if w == waiter {
+			s.waiters = append(s.waiters[:i], s.waiters[i+1:]...)
+			s.mu.Unlock()
+			return nil, ctx.Err()
+		}
+	}
+	s.mu.Unlock()
+	// the slot was granted while the task was cancelled.
+	release(0)
+	return nil, ctx.Err()
+}
+
+// slots returns the number of the concurrent uploads of the node set by indexNode.upload.priority.maxConcurrent.
+func (s *uploadScheduler) slots() int {
+	if slots := s.params.IndexNodeCfg.UploadPriorityMaxConcurrent.GetAsInt(); slots > 0 {
+		return slots
+	}
+	return 1
+}
+
+// dispatchLocked grants the free slots to the waiters of the tasks with the fewest bytes left to upload,
+// the waiters of the same task in the order they arrived.
+func (s *uploadScheduler) dispatchLocked() {
+	for s.running < s.slots() && len(s.waiters) > 0 {
+		next := 0
+		for i, w := range s.waiters {
+			if s.priority(w.key) < s.priority(s.waiters[next].key) {
+				next = i
+			}
+		}
+		waiter := s.waiters[next]
+		s.waiters = append(s.waiters[:next], s.waiters[next+1:]...)
+		s.running++
+		close(waiter.ready)
+	}
+}
+
+func (s *uploadScheduler) priority(key taskKey) int64 {
+	remaining, ok := s.remaining[key]
+	if !ok || remaining < 0 {
+		return math.MaxInt64
+	}
+	return remaining
+}
@@ -0,0 +1,90 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestUploadScheduler(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	ctx := context.Background()
+	s := newUploadScheduler(params)
+	large, small, unknown := taskKey{ClusterID: "c", BuildID: 1}, taskKey{ClusterID: "c", BuildID: 2}, taskKey{ClusterID: "c", BuildID: 3}
+
+	release, err := s.acquire(ctx, large)
+	require.NoError(t, err)
+	release(10)
+	assert.Equal(t, 0, s.running)
+
+	params.Save(params.IndexNodeCfg.UploadPriorityEnable.Key, "true")
+	params.Save(params.IndexNodeCfg.UploadPriorityMaxConcurrent.Key, "1")
+
+	s.startTask(large, 100)
+	s.startTask(small, 10)
+	s.startTask(unknown, -1)
+	releaseLarge, err := s.acquire(ctx, large)
+	require.NoError(t, err)
+
+	order := make(chan taskKey, 3)
+	for _, key := range []taskKey{unknown, large, small} {
+		go func(key taskKey) {
+			release, err := s.acquire(ctx, key)
+			assert.NoError(t, err)
+			order <- key
+			release(10)
+		}(key)
+		assert.Eventually(t, func() bool {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			return s.waiters[len(s.waiters)-1].key == key
+		}, time.Second, time.Millisecond)
+	}
+
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = s.acquire(cancelCtx, small)
+	assert.Error(t, err)
+
+	releaseLarge(50)
+	assert.Equal(t, small, <-order)
+	assert.Equal(t, large, <-order)
+	assert.Equal(t, unknown, <-order)
+	assert.Equal(t, int64(40), s.remaining[large])
+
+	s.finishTask(large)
+	assert.NotContains(t, s.remaining, large)
+	assert.Eventually(t, func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return s.running == 0 && len(s.waiters) == 0
+	}, time.Second, time.Millisecond)
+
+	var nilScheduler *uploadScheduler
+	nilScheduler.startTask(large, 1)
+	release, err = nilScheduler.acquire(ctx, large)
+	require.NoError(t, err)
+	release(1)
+	nilScheduler.finishTask(large)
+}
@@ -0,0 +1,134 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// waitSLOWindow is the number of recent tasks the wait SLO and the mean run time are computed over.
+const waitSLOWindow = 100
+
+// recentDurations keeps the last waitSLOWindow durations.
+type recentDurations struct {
+	values []time.Duration
+	next   int
+}
+
+func (r *recentDurations) add(d time.Duration) {
+	if len(r.values) < waitSLOWindow {
+		r.values = append(r.values, d)
+		return
+	}
+	r.values[r.next] = d
+	r.next = (r.next + 1) % waitSLOWindow
+}
+
+// waitTracker tracks the queue waits and the run times of the recent tasks, for the wait SLO of the queue
+// and the estimated wait of a new task, which the external autoscalers scale the nodes by.
+type waitTracker struct {
+	params *paramtable.ComponentParam
+
+	mu    sync.Mutex
+	waits recentDurations
+	runs  recentDurations
+}
+
+func newWaitTracker(params *paramtable.ComponentParam) *waitTracker {
+	return &waitTracker{params: params}
+}
+
+// observeWait records the queue wait of a task and updates the wait SLO violation ratio.
+func (w *waitTracker) observeWait(wait time.Duration) {
+	if w == nil {
+		return
+	}
+	w.mu.Lock()
+	w.waits.add(wait)
+	ratio := w.violationRatioLocked()
+	w.mu.Unlock()
+	metrics.IndexNodeTaskWaitSLOViolationRatio.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Set(ratio)
+}
+
+// observeRun records the run time of a finished task.
+func (w *waitTracker) observeRun(run time.Duration) {
+	if w == nil {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.runs.add(run)
+}
+
+// violationRatio returns the fraction of the recent tasks waiting longer than indexNode.scheduler.waitTarget.
+func (w *waitTracker) violationRatio() float64 {
+	if w == nil {
+		return 0
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.violationRatioLocked()
+}
+
+func (w *waitTracker) violationRatioLocked() float64 {
+	if len(w.waits.values) == 0 {
+		return 0
+	}
+	target := w.params.IndexNodeCfg.SchedulerWaitTarget.GetAsDuration(time.Second)
+	violations := 0
+	for _, wait := range w.waits.values {
+		if wait > target {
+			violations++
+		}
+	}
+	return float64(violations) / float64(len(w.waits.values))
+}
+
+// meanRun returns the mean run time of the recent finished tasks, 0 if no task has finished.
+func (w *waitTracker) meanRun() time.Duration {
+	if w == nil {
+		return 0
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.runs.values) == 0 {
+		return 0
+	}
+	var total time.Duration
+	for _, run := range w.runs.values {
+		total += run
+	}
+	return total / time.Duration(len(w.runs.values))
+}
+
+// estimateWait estimates the wait of a task enqueued behind ahead tasks on slots build slots, the tasks run
+// in waves of slots tasks, each taking meanRun.
+func estimateWait(ahead, slots int, meanRun time.Duration) time.Duration {
+	if slots <= 0 {
+		slots = 1
+	}
+	if ahead < slots {
+		return 0
+	}
+	waves := (ahead-slots)/slots + 1
+	return time.Duration(waves) * meanRun
+}
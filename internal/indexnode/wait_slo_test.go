@@ -0,0 +1,64 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestWaitTracker(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	var nilTracker *waitTracker
+	nilTracker.observeWait(time.Hour)
+	assert.Equal(t, 0.0, nilTracker.violationRatio())
+	assert.Equal(t, time.Duration(0), nilTracker.meanRun())
+
+	w := newWaitTracker(params)
+	assert.Equal(t, 0.0, w.violationRatio())
+	w.observeWait(time.Second)
+	w.observeWait(2 * time.Minute)
+	w.observeWait(time.Second)
+	w.observeWait(3 * time.Minute)
+	assert.Equal(t, 0.5, w.violationRatio())
+
+	params.Save(params.IndexNodeCfg.SchedulerWaitTarget.Key, "150")
+	assert.Equal(t, 0.25, w.violationRatio())
+
+	// only the recent waits count
+	for i := 0; i < waitSLOWindow; i++ {
+		w.observeWait(time.Second)
+	}
+	assert.Equal(t, 0.0, w.violationRatio())
+
+	w.observeRun(time.Minute)
+	w.observeRun(3 * time.Minute)
+	assert.Equal(t, 2*time.Minute, w.meanRun())
+}
+
+func TestEstimateWait(t *testing.T) {
+	assert.Equal(t, time.Duration(0), estimateWait(0, 2, time.Minute))
+	assert.Equal(t, time.Duration(0), estimateWait(1, 2, time.Minute))
+	assert.Equal(t, time.Minute, estimateWait(2, 2, time.Minute))
+	assert.Equal(t, time.Minute, estimateWait(3, 2, time.Minute))
+	assert.Equal(t, 2*time.Minute, estimateWait(4, 2, time.Minute))
+	assert.Equal(t, 3*time.Minute, estimateWait(3, 0, time.Minute))
+}
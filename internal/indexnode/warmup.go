@@ -0,0 +1,148 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"math/rand"
+	"os"
+	"path"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/indexcgowrapper"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+const (
+	// warmupDim is the dim of the synthetic vectors of the warm-up build.
+	warmupDim = 128
+	// warmupNList is the nlist of the warm-up IVF_FLAT index, so the build runs the SIMD distance kernels of k-means.
+	warmupNList = 16
+	// warmupDiskBytes is the size of the file written and read back by the warm-up.
+	warmupDiskBytes = 16 << 20
+	// warmupFileName is the name of the warm-up file under the local storage path.
+	warmupFileName = "indexnode_warmup"
+)
+
+// runWarmup builds a small synthetic index with the engine and writes, syncs and reads back a small file
+// in the directory, and records the timings as the baseline performance of the node. The object storage is
+// configured per job, so only the local disk is measured.
+func runWarmup(newEngine BuildEngineFactory, dir string, rows int) *metricsinfo.IndexNodeWarmup {
+	result := &metricsinfo.IndexNodeWarmup{
+		BuildRows: rows,
+		BuildDim:  warmupDim,
+		DiskBytes: warmupDiskBytes,
+	}
+	if _, elapsed, err := warmupBuild(newEngine, rows); err != nil {
+		result.Error = "build: " + err.Error()
+	} else {
+		result.BuildMilliseconds = elapsed.Milliseconds()
+	}
+	if writeElapsed, readElapsed, err := warmupDisk(dir); err != nil {
+		if result.Error != "" {
+			result.Error += "; "
+		}
+		result.Error += "disk: " + err.Error()
+	} else {
+		result.DiskWriteMilliseconds = writeElapsed.Milliseconds()
+		result.DiskReadMilliseconds = readElapsed.Milliseconds()
+	}
+	result.FinishedTime = time.Now().String()
+	log.Info("IndexNode warm-up finished", zap.Int64("buildMs", result.BuildMilliseconds),
+		zap.Int64("diskWriteMs", result.DiskWriteMilliseconds), zap.Int64("diskReadMs", result.DiskReadMilliseconds),
+		zap.String("error", result.Error))
+	return result
+}
+
+// warmupBuild returns the index files and the time to train, add and serialize an IVF_FLAT index of rows
+// random vectors.
+func warmupBuild(newEngine BuildEngineFactory, rows int) ([]*storage.Blob, time.Duration, error) {
+	rnd := rand.New(rand.NewSource(1))
+	vectors := make([]float32, rows*warmupDim)
+	for i := range vectors {
+		vectors[i] = rnd.Float32()
+	}
+	dataset := indexcgowrapper.GenDataset(&storage.FloatVectorFieldData{Data: vectors, Dim: warmupDim})
+	typeParams := map[string]string{common.DimKey: strconv.Itoa(warmupDim)}
+	indexParams := map[string]string{
+		"index_type":          indexparamcheck.IndexFaissIvfFlat,
+		common.MetricTypeKey:  "L2",
+		indexparamcheck.NLIST: strconv.Itoa(warmupNList),
+	}
+
+	start := time.Now()
+	engine, err := newEngine(schemapb.DataType_FloatVector, typeParams, indexParams, &indexpb.StorageConfig{})
+	if err != nil {
+		return nil, 0, err
+	}
+	defer engine.Delete()
+	if err := engine.Train(dataset); err != nil {
+		return nil, 0, err
+	}
+	if err := engine.Add(dataset); err != nil {
+		return nil, 0, err
+	}
+	blobs, err := engine.Serialize()
+	if err != nil {
+		return nil, 0, err
+	}
+	return blobs, time.Since(start), nil
+}
+
+// warmupDisk returns the time to write and sync a file of warmupDiskBytes in the directory and the time to read
+// it back, the file is removed afterwards. The read is likely served by the page cache.
+func warmupDisk(dir string) (time.Duration, time.Duration, error) {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return 0, 0, err
+	}
+	filePath := path.Join(dir, warmupFileName)
+	defer os.Remove(filePath)
+	data := make([]byte, warmupDiskBytes)
+	rand.New(rand.NewSource(1)).Read(data)
+
+	start := time.Now()
+	f, err := os.OpenFile(filePath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		return 0, 0, err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return 0, 0, err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return 0, 0, err
+	}
+	if err := f.Close(); err != nil {
+		return 0, 0, err
+	}
+	writeElapsed := time.Since(start)
+
+	start = time.Now()
+	if _, err := os.ReadFile(filePath); err != nil {
+		return 0, 0, err
+	}
+	return writeElapsed, time.Since(start), nil
+}
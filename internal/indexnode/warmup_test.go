@@ -0,0 +1,61 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"errors"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+)
+
+func TestRunWarmup(t *testing.T) {
+	engine := &mockBuildEngine{}
+	var gotIndexParams map[string]string
+	newEngine := func(dType schemapb.DataType, typeParams, indexParams map[string]string,
+		config *indexpb.StorageConfig) (BuildEngine, error) {
+		gotIndexParams = indexParams
+		return engine, nil
+	}
+	dir := path.Join(t.TempDir(), "warmup")
+	result := runWarmup(newEngine, dir, 100)
+	assert.Empty(t, result.Error)
+	assert.True(t, engine.trained)
+	assert.True(t, engine.added)
+	assert.Equal(t, "IVF_FLAT", gotIndexParams["index_type"])
+	assert.Equal(t, 100, result.BuildRows)
+	assert.Equal(t, int64(warmupDiskBytes), result.DiskBytes)
+	assert.NotEmpty(t, result.FinishedTime)
+	_, err := os.Stat(path.Join(dir, warmupFileName))
+	assert.True(t, os.IsNotExist(err))
+
+	failEngine := func(dType schemapb.DataType, typeParams, indexParams map[string]string,
+		config *indexpb.StorageConfig) (BuildEngine, error) {
+		return nil, errors.New("no simd")
+	}
+	file := path.Join(t.TempDir(), "file")
+	assert.NoError(t, os.WriteFile(file, nil, 0o644))
+	result = runWarmup(failEngine, file, 100)
+	assert.Contains(t, result.Error, "build: no simd")
+	assert.Contains(t, result.Error, "disk: ")
+	assert.Zero(t, result.BuildMilliseconds)
+}
@@ -0,0 +1,193 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// zoneEndpoints returns the storage endpoints of the config to try in order, the endpoint of the zone
+// of the node set by indexNode.zone first, then the others by zone, and the default address last.
+// It returns nil if the config has no zone endpoints.
+func zoneEndpoints(params *paramtable.ComponentParam, config *indexpb.StorageConfig) []string {
+	if len(config.GetZoneEndpoints()) == 0 || config.GetStorageType() == "" || config.GetStorageType() == "local" {
+		return nil
+	}
+	zone := params.IndexNodeCfg.Zone.GetValue()
+	endpoints := append([]*indexpb.ZoneEndpoint{}, config.GetZoneEndpoints()...)
+	sort.SliceStable(endpoints, func(i, j int) bool {
+		local1, local2 := endpoints[i].GetZone() == zone, endpoints[j].GetZone() == zone
+		if local1 != local2 {
+			return local1
+		}
+		return endpoints[i].GetZone() < endpoints[j].GetZone()
+	})
+	addresses := make([]string, 0, len(endpoints)+1)
+	seen := make(map[string]struct{}, len(endpoints)+1)
+	for _, endpoint := range append(endpoints, &indexpb.ZoneEndpoint{Address: config.GetAddress()}) {
+		if _, ok := seen[endpoint.GetAddress()]; ok || endpoint.GetAddress() == "" {
+			continue
+		}
+		seen[endpoint.GetAddress()] = struct{}{}
+		addresses = append(addresses, endpoint.GetAddress())
+	}
+	return addresses
+}
+
+// zoneChunkManager sends the storage requests to the endpoint of the zone of the node to save the cross zone
+// data transfer, and fails over to the endpoints of the other zones if it fails.
+// The requests without a fallback, like Reader and Mmap, go to the first reachable endpoint.
+type zoneChunkManager struct {
+	storage.ChunkManager
+	endpoints []string
+	open      func(ctx context.Context, address string) (storage.ChunkManager, error)
+}
+
+var _ storage.ChunkManager = (*zoneChunkManager)(nil)
+
+// newZoneChunkManager returns the chunk manager of the endpoints, open returns the chunk manager of an endpoint.
+func newZoneChunkManager(ctx context.Context, endpoints []string, open func(ctx context.Context, address string) (storage.ChunkManager, error)) (*zoneChunkManager, error) {
+	var err error
+	for _, address := range endpoints {
+		var cm storage.ChunkManager
+		if cm, err = open(ctx, address); err == nil {
+			return &zoneChunkManager{
+				ChunkManager: cm,
+				endpoints:    endpoints,
+				open:         open,
+			}, nil
+		}
+		log.Ctx(ctx).Warn("IndexNode storage endpoint unavailable", zap.String("address", address), zap.Error(err))
+	}
+	return nil, err
+}
+
+// do runs fn on the endpoints in order until it succeeds, the missing keys and the canceled requests
+// are not retried on the other endpoints.
+func (zcm *zoneChunkManager) do(ctx context.Context, fn func(cm storage.ChunkManager) error) error {
+	var err error
+	for i, address := range zcm.endpoints {
+		var cm storage.ChunkManager
+		if cm, err = zcm.open(ctx, address); err == nil {
+			err = fn(cm)
+			if err == nil || errors.Is(err, storage.ErrNoSuchKey) || ctx.Err() != nil {
+				return err
+			}
+		}
+		if i+1 < len(zcm.endpoints) {
+			log.Ctx(ctx).Warn("IndexNode storage request failed, fail over to the next endpoint", zap.String("address", address),
+				zap.String("next", zcm.endpoints[i+1]), zap.Error(err))
+		}
+	}
+	return err
+}
+
+func (zcm *zoneChunkManager) Size(ctx context.Context, filePath string) (size int64, err error) {
+	err = zcm.do(ctx, func(cm storage.ChunkManager) error {
+		size, err = cm.Size(ctx, filePath)
+		return err
+	})
+	return size, err
+}
+
+func (zcm *zoneChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	return zcm.do(ctx, func(cm storage.ChunkManager) error {
+		return cm.Write(ctx, filePath, content)
+	})
+}
+
+func (zcm *zoneChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
+	return zcm.do(ctx, func(cm storage.ChunkManager) error {
+		return cm.MultiWrite(ctx, contents)
+	})
+}
+
+func (zcm *zoneChunkManager) Exist(ctx context.Context, filePath string) (exist bool, err error) {
+	err = zcm.do(ctx, func(cm storage.ChunkManager) error {
+		exist, err = cm.Exist(ctx, filePath)
+		return err
+	})
+	return exist, err
+}
+
+func (zcm *zoneChunkManager) Read(ctx context.Context, filePath string) (data []byte, err error) {
+	err = zcm.do(ctx, func(cm storage.ChunkManager) error {
+		data, err = cm.Read(ctx, filePath)
+		return err
+	})
+	return data, err
+}
+
+func (zcm *zoneChunkManager) MultiRead(ctx context.Context, filePaths []string) (data [][]byte, err error) {
+	err = zcm.do(ctx, func(cm storage.ChunkManager) error {
+		data, err = cm.MultiRead(ctx, filePaths)
+		return err
+	})
+	return data, err
+}
+
+func (zcm *zoneChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) (data []byte, err error) {
+	err = zcm.do(ctx, func(cm storage.ChunkManager) error {
+		data, err = cm.ReadAt(ctx, filePath, off, length)
+		return err
+	})
+	return data, err
+}
+
+func (zcm *zoneChunkManager) ListWithPrefix(ctx context.Context, prefix string, recursive bool) (paths []string, modTimes []time.Time, err error) {
+	err = zcm.do(ctx, func(cm storage.ChunkManager) error {
+		paths, modTimes, err = cm.ListWithPrefix(ctx, prefix, recursive)
+		return err
+	})
+	return paths, modTimes, err
+}
+
+func (zcm *zoneChunkManager) ReadWithPrefix(ctx context.Context, prefix string) (paths []string, data [][]byte, err error) {
+	err = zcm.do(ctx, func(cm storage.ChunkManager) error {
+		paths, data, err = cm.ReadWithPrefix(ctx, prefix)
+		return err
+	})
+	return paths, data, err
+}
+
+func (zcm *zoneChunkManager) Remove(ctx context.Context, filePath string) error {
+	return zcm.do(ctx, func(cm storage.ChunkManager) error {
+		return cm.Remove(ctx, filePath)
+	})
+}
+
+func (zcm *zoneChunkManager) MultiRemove(ctx context.Context, filePaths []string) error {
+	return zcm.do(ctx, func(cm storage.ChunkManager) error {
+		return cm.MultiRemove(ctx, filePaths)
+	})
+}
+
+func (zcm *zoneChunkManager) RemoveWithPrefix(ctx context.Context, prefix string) error {
+	return zcm.do(ctx, func(cm storage.ChunkManager) error {
+		return cm.RemoveWithPrefix(ctx, prefix)
+	})
+}
@@ -0,0 +1,101 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestZoneEndpoints(t *testing.T) {
+	params := paramtable.Get().Namespace()
+	config := &indexpb.StorageConfig{StorageType: "minio", Address: "minio:9000"}
+	assert.Nil(t, zoneEndpoints(params, config))
+
+	config.ZoneEndpoints = []*indexpb.ZoneEndpoint{
+		{Zone: "zone-c", Address: "minio-c:9000"},
+		{Zone: "zone-b", Address: "minio-b:9000"},
+		{Zone: "zone-a", Address: "minio:9000"},
+	}
+	assert.Equal(t, []string{"minio:9000", "minio-b:9000", "minio-c:9000"}, zoneEndpoints(params, config))
+
+	params.Save(params.IndexNodeCfg.Zone.Key, "zone-b")
+	assert.Equal(t, []string{"minio-b:9000", "minio:9000", "minio-c:9000"}, zoneEndpoints(params, config))
+
+	config.StorageType = "local"
+	assert.Nil(t, zoneEndpoints(params, config))
+}
+
+type countingChunkManager struct {
+	storage.ChunkManager
+	reads int
+	err   error
+}
+
+func (cm *countingChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	cm.reads++
+	if cm.err != nil {
+		return nil, cm.err
+	}
+	return cm.ChunkManager.Read(ctx, filePath)
+}
+
+func TestZoneChunkManager(t *testing.T) {
+	ctx := context.Background()
+	remote := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	failing := &countingChunkManager{ChunkManager: remote, err: errors.New("connection refused")}
+	healthy := &countingChunkManager{ChunkManager: remote}
+	managers := map[string]storage.ChunkManager{"minio-a": failing, "minio-b": healthy}
+	open := func(ctx context.Context, address string) (storage.ChunkManager, error) {
+		if cm, ok := managers[address]; ok {
+			return cm, nil
+		}
+		return nil, errors.New("unreachable")
+	}
+
+	_, err := newZoneChunkManager(ctx, []string{"minio-x"}, open)
+	assert.Error(t, err)
+
+	cm, err := newZoneChunkManager(ctx, []string{"minio-x", "minio-a", "minio-b"}, open)
+	assert.NoError(t, err)
+	assert.Same(t, failing, cm.ChunkManager)
+
+	assert.NoError(t, cm.Write(ctx, "file", []byte("data")))
+	data, err := cm.Read(ctx, "file")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("data"), data)
+	assert.Equal(t, 1, failing.reads)
+	assert.Equal(t, 1, healthy.reads)
+
+	// the missing keys are not retried on the other endpoints
+	failing.err = storage.WrapErrNoSuchKey("missing")
+	_, err = cm.Read(ctx, "missing")
+	assert.True(t, errors.Is(err, storage.ErrNoSuchKey))
+	assert.Equal(t, 1, healthy.reads)
+
+	delete(managers, "minio-a")
+	delete(managers, "minio-b")
+	_, err = cm.Read(ctx, "file")
+	assert.EqualError(t, err, "unreachable")
+}
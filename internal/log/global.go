@@ -149,6 +149,15 @@ func WithFields(ctx context.Context, fields ...zap.Field) context.Context {
 	return context.WithValue(ctx, CtxLogKey, mLogger)
 }
 
+// WithCore returns a context whose logger also writes to the core, e.g. to capture the logs of a request.
+// The fields attached to the logger in ctx before are not passed to the core.
+func WithCore(ctx context.Context, core zapcore.Core) context.Context {
+	logger := Ctx(ctx).Logger.WithOptions(zap.WrapCore(func(c zapcore.Core) zapcore.Core {
+		return zapcore.NewTee(c, core)
+	}))
+	return context.WithValue(ctx, CtxLogKey, &MLogger{Logger: logger})
+}
+
 // Ctx returns a logger which will log contextual messages attached in ctx
 func Ctx(ctx context.Context) *MLogger {
 	if ctx == nil {
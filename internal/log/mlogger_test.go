@@ -7,6 +7,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
 )
 
 func TestExporterV2(t *testing.T) {
@@ -54,6 +56,27 @@ func TestExporterV2(t *testing.T) {
 	ts.assertLastMessageNotContains("field=test")
 }
 
+func TestWithCore(t *testing.T) {
+	ts := newTestLogSpy(t)
+	conf := &Config{Level: "debug", DisableTimestamp: true}
+	logger, properties, _ := InitTestLogger(ts, conf)
+	ReplaceGlobals(logger, properties)
+	replaceLeveledLoggers(logger)
+
+	core, observed := observer.New(zapcore.WarnLevel)
+	ctx := WithCore(WithTraceID(context.TODO(), "mock-trace"), core)
+	Ctx(ctx).Info("Info Test")
+	Ctx(ctx).With(zap.String("field", "test")).Warn("Warn Test")
+	Ctx(ctx).Sync()
+
+	ts.assertLastMessageContains("Warn Test")
+	entries := observed.AllUntimed()
+	assert.Len(t, entries, 1)
+	assert.Equal(t, "Warn Test", entries[0].Message)
+	// the fields attached before the core are not passed to it
+	assert.Equal(t, map[string]interface{}{"field": "test"}, entries[0].ContextMap())
+}
+
 func TestMLoggerRatedLog(t *testing.T) {
 	ts := newTestLogSpy(t)
 	conf := &Config{Level: "debug", DisableTimestamp: true}
@@ -21,6 +21,13 @@ type SegmentIndex struct {
 	CreateTime    uint64
 	IndexFileKeys []string
 	IndexSize     uint64
+	// IndexFileSizes are the sizes of IndexFileKeys, IndexMemSize is the estimated memory to load the index.
+	IndexFileSizes []uint64
+	IndexMemSize   uint64
+	// Warnings are the adjustments and data cleaning made by the successful build.
+	Warnings []string
+	// BruteForce is set if the segment is too small to build an index, it's searched by brute force.
+	BruteForce bool
 	// deprecated
 	WriteHandoff bool
 }
@@ -31,21 +38,25 @@ func UnmarshalSegmentIndexModel(segIndex *indexpb.SegmentIndex) *SegmentIndex {
 	}
 
 	return &SegmentIndex{
-		SegmentID:     segIndex.SegmentID,
-		CollectionID:  segIndex.CollectionID,
-		PartitionID:   segIndex.PartitionID,
-		NumRows:       segIndex.NumRows,
-		IndexID:       segIndex.IndexID,
-		BuildID:       segIndex.BuildID,
-		NodeID:        segIndex.NodeID,
-		IndexState:    segIndex.State,
-		FailReason:    segIndex.FailReason,
-		IndexVersion:  segIndex.IndexVersion,
-		IsDeleted:     segIndex.Deleted,
-		CreateTime:    segIndex.CreateTime,
-		IndexFileKeys: common.CloneStringList(segIndex.IndexFileKeys),
-		IndexSize:     segIndex.SerializeSize,
-		WriteHandoff:  segIndex.WriteHandoff,
+		SegmentID:      segIndex.SegmentID,
+		CollectionID:   segIndex.CollectionID,
+		PartitionID:    segIndex.PartitionID,
+		NumRows:        segIndex.NumRows,
+		IndexID:        segIndex.IndexID,
+		BuildID:        segIndex.BuildID,
+		NodeID:         segIndex.NodeID,
+		IndexState:     segIndex.State,
+		FailReason:     segIndex.FailReason,
+		IndexVersion:   segIndex.IndexVersion,
+		IsDeleted:      segIndex.Deleted,
+		CreateTime:     segIndex.CreateTime,
+		IndexFileKeys:  common.CloneStringList(segIndex.IndexFileKeys),
+		IndexSize:      segIndex.SerializeSize,
+		IndexFileSizes: append([]uint64(nil), segIndex.IndexFileSizes...),
+		IndexMemSize:   segIndex.MemSize,
+		Warnings:       common.CloneStringList(segIndex.Warnings),
+		BruteForce:     segIndex.BruteForce,
+		WriteHandoff:   segIndex.WriteHandoff,
 	}
 }
 
@@ -55,40 +66,48 @@ func MarshalSegmentIndexModel(segIdx *SegmentIndex) *indexpb.SegmentIndex {
 	}
 
 	return &indexpb.SegmentIndex{
-		CollectionID:  segIdx.CollectionID,
-		PartitionID:   segIdx.PartitionID,
-		SegmentID:     segIdx.SegmentID,
-		NumRows:       segIdx.NumRows,
-		IndexID:       segIdx.IndexID,
-		BuildID:       segIdx.BuildID,
-		NodeID:        segIdx.NodeID,
-		State:         segIdx.IndexState,
-		FailReason:    segIdx.FailReason,
-		IndexVersion:  segIdx.IndexVersion,
-		IndexFileKeys: common.CloneStringList(segIdx.IndexFileKeys),
-		Deleted:       segIdx.IsDeleted,
-		CreateTime:    segIdx.CreateTime,
-		SerializeSize: segIdx.IndexSize,
-		WriteHandoff:  segIdx.WriteHandoff,
+		CollectionID:   segIdx.CollectionID,
+		PartitionID:    segIdx.PartitionID,
+		SegmentID:      segIdx.SegmentID,
+		NumRows:        segIdx.NumRows,
+		IndexID:        segIdx.IndexID,
+		BuildID:        segIdx.BuildID,
+		NodeID:         segIdx.NodeID,
+		State:          segIdx.IndexState,
+		FailReason:     segIdx.FailReason,
+		IndexVersion:   segIdx.IndexVersion,
+		IndexFileKeys:  common.CloneStringList(segIdx.IndexFileKeys),
+		Deleted:        segIdx.IsDeleted,
+		CreateTime:     segIdx.CreateTime,
+		SerializeSize:  segIdx.IndexSize,
+		IndexFileSizes: append([]uint64(nil), segIdx.IndexFileSizes...),
+		MemSize:        segIdx.IndexMemSize,
+		Warnings:       common.CloneStringList(segIdx.Warnings),
+		BruteForce:     segIdx.BruteForce,
+		WriteHandoff:   segIdx.WriteHandoff,
 	}
 }
 
 func CloneSegmentIndex(segIndex *SegmentIndex) *SegmentIndex {
 	return &SegmentIndex{
-		SegmentID:     segIndex.SegmentID,
-		CollectionID:  segIndex.CollectionID,
-		PartitionID:   segIndex.PartitionID,
-		NumRows:       segIndex.NumRows,
-		IndexID:       segIndex.IndexID,
-		BuildID:       segIndex.BuildID,
-		NodeID:        segIndex.NodeID,
-		IndexState:    segIndex.IndexState,
-		FailReason:    segIndex.FailReason,
-		IndexVersion:  segIndex.IndexVersion,
-		IsDeleted:     segIndex.IsDeleted,
-		CreateTime:    segIndex.CreateTime,
-		IndexFileKeys: common.CloneStringList(segIndex.IndexFileKeys),
-		IndexSize:     segIndex.IndexSize,
-		WriteHandoff:  segIndex.WriteHandoff,
+		SegmentID:      segIndex.SegmentID,
+		CollectionID:   segIndex.CollectionID,
+		PartitionID:    segIndex.PartitionID,
+		NumRows:        segIndex.NumRows,
+		IndexID:        segIndex.IndexID,
+		BuildID:        segIndex.BuildID,
+		NodeID:         segIndex.NodeID,
+		IndexState:     segIndex.IndexState,
+		FailReason:     segIndex.FailReason,
+		IndexVersion:   segIndex.IndexVersion,
+		IsDeleted:      segIndex.IsDeleted,
+		CreateTime:     segIndex.CreateTime,
+		IndexFileKeys:  common.CloneStringList(segIndex.IndexFileKeys),
+		IndexSize:      segIndex.IndexSize,
+		IndexFileSizes: append([]uint64(nil), segIndex.IndexFileSizes...),
+		IndexMemSize:   segIndex.IndexMemSize,
+		Warnings:       common.CloneStringList(segIndex.Warnings),
+		BruteForce:     segIndex.BruteForce,
+		WriteHandoff:   segIndex.WriteHandoff,
 	}
 }
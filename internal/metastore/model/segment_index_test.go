@@ -54,3 +54,40 @@ func TestUnmarshalSegmentIndexModel(t *testing.T) {
 	assert.Equal(t, indexModel2.SegmentID, ret.SegmentID)
 	assert.Nil(t, UnmarshalSegmentIndexModel(nil))
 }
+
+func TestSegmentIndexModelSizes(t *testing.T) {
+	segIdx := &SegmentIndex{
+		SegmentID:      segmentID,
+		IndexFileKeys:  []string{"a", "b"},
+		IndexFileSizes: []uint64{10, 20},
+		IndexSize:      30,
+		IndexMemSize:   40,
+	}
+	ret := UnmarshalSegmentIndexModel(MarshalSegmentIndexModel(segIdx))
+	assert.Equal(t, segIdx.IndexFileSizes, ret.IndexFileSizes)
+	assert.Equal(t, segIdx.IndexMemSize, ret.IndexMemSize)
+
+	cloned := CloneSegmentIndex(segIdx)
+	assert.Equal(t, segIdx.IndexFileSizes, cloned.IndexFileSizes)
+	assert.Equal(t, segIdx.IndexMemSize, cloned.IndexMemSize)
+}
+
+func TestSegmentIndexModelWarnings(t *testing.T) {
+	segIdx := &SegmentIndex{
+		SegmentID: segmentID,
+		Warnings:  []string{"nlist is not set, 400 is chosen for 10000 rows"},
+	}
+	ret := UnmarshalSegmentIndexModel(MarshalSegmentIndexModel(segIdx))
+	assert.Equal(t, segIdx.Warnings, ret.Warnings)
+	assert.Equal(t, segIdx.Warnings, CloneSegmentIndex(segIdx).Warnings)
+}
+
+func TestSegmentIndexModelBruteForce(t *testing.T) {
+	segIdx := &SegmentIndex{
+		SegmentID:  segmentID,
+		IndexState: commonpb.IndexState_Finished,
+		BruteForce: true,
+	}
+	assert.True(t, UnmarshalSegmentIndexModel(MarshalSegmentIndexModel(segIdx)).BruteForce)
+	assert.True(t, CloneSegmentIndex(segIdx).BruteForce)
+}
@@ -37,7 +37,7 @@ var (
 			Name:      "load_field_latency",
 			Help:      "latency of loading the field data",
 			Buckets:   buckets,
-		}, []string{nodeIDLabelName})
+		}, []string{nodeIDLabelName, collectionIDLabelName, indexTypeLabelName, sizeClassLabelName})
 
 	IndexNodeDecodeFieldLatency = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -46,7 +46,7 @@ var (
 			Name:      "decode_field_latency",
 			Help:      "latency of decode field data",
 			Buckets:   buckets,
-		}, []string{nodeIDLabelName})
+		}, []string{nodeIDLabelName, collectionIDLabelName, indexTypeLabelName, sizeClassLabelName})
 
 	IndexNodeKnowhereBuildIndexLatency = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -55,7 +55,7 @@ var (
 			Name:      "build_index_latency",
 			Help:      "latency of building the index by knowhere",
 			Buckets:   buckets,
-		}, []string{nodeIDLabelName})
+		}, []string{nodeIDLabelName, collectionIDLabelName, indexTypeLabelName, sizeClassLabelName})
 
 	IndexNodeEncodeIndexFileLatency = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -64,7 +64,7 @@ var (
 			Name:      "encode_index_latency",
 			Help:      "latency of encoding the index file",
 			Buckets:   buckets,
-		}, []string{nodeIDLabelName})
+		}, []string{nodeIDLabelName, collectionIDLabelName, indexTypeLabelName, sizeClassLabelName})
 
 	IndexNodeSaveIndexFileLatency = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -73,7 +73,81 @@ var (
 			Name:      "save_index_latency",
 			Help:      "latency of saving the index file",
 			Buckets:   buckets,
+		}, []string{nodeIDLabelName, collectionIDLabelName, indexTypeLabelName, sizeClassLabelName})
+
+	IndexNodeBuildParallel = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexNodeRole,
+			Name:      "build_parallel",
+			Help:      "number of index build tasks allowed to run concurrently",
+		}, []string{nodeIDLabelName})
+
+	IndexNodeTaskPhaseTransitionCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexNodeRole,
+			Name:      "task_phase_transition_count",
+			Help:      "number of phase transitions of index build tasks",
+		}, []string{nodeIDLabelName, fromPhaseLabelName, toPhaseLabelName})
+
+	IndexNodeStorageRequestCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexNodeRole,
+			Name:      "storage_request_count",
+			Help:      "number of object storage requests issued by the index build tasks of each cluster",
+		}, []string{nodeIDLabelName, clusterIDLabelName, storageOpLabelName})
+
+	IndexNodeStorageHedgedReadCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexNodeRole,
+			Name:      "storage_hedged_read_count",
+			Help:      "number of hedged object storage reads issued for the slow reads",
+		}, []string{nodeIDLabelName})
+
+	IndexNodeRowCountMismatchCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexNodeRole,
+			Name:      "row_count_mismatch_count",
+			Help:      "number of jobs built on a decoded row count other than the num_rows of the job",
+		}, []string{nodeIDLabelName, clusterIDLabelName})
+
+	IndexNodeTaskWaitLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexNodeRole,
+			Name:      "task_wait_latency",
+			Help:      "latency of index build tasks waiting in the queue of each cluster",
+			Buckets:   buckets,
+		}, []string{nodeIDLabelName, clusterIDLabelName})
+
+	IndexNodeTaskWaitSLOViolationRatio = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexNodeRole,
+			Name:      "task_wait_slo_violation_ratio",
+			Help:      "fraction of the recent index build tasks waiting in the queue longer than the wait target",
 		}, []string{nodeIDLabelName})
+
+	IndexNodeEvictedTaskCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexNodeRole,
+			Name:      "evicted_task_count",
+			Help:      "number of the infos of the completed index build tasks evicted by the retention policy",
+		}, []string{nodeIDLabelName, indexTaskStatusLabelName})
+
+	IndexNodeIOLaneWaitLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexNodeRole,
+			Name:      "io_lane_wait_latency",
+			Help:      "latency of the metadata and storage requests waiting for a slot of their I/O lane",
+			Buckets:   buckets,
+		}, []string{nodeIDLabelName, ioLaneLabelName})
 )
 
 //RegisterIndexNode registers IndexNode metrics
@@ -84,4 +158,13 @@ func RegisterIndexNode(registry *prometheus.Registry) {
 	registry.MustRegister(IndexNodeKnowhereBuildIndexLatency)
 	registry.MustRegister(IndexNodeEncodeIndexFileLatency)
 	registry.MustRegister(IndexNodeSaveIndexFileLatency)
+	registry.MustRegister(IndexNodeBuildParallel)
+	registry.MustRegister(IndexNodeTaskPhaseTransitionCounter)
+	registry.MustRegister(IndexNodeStorageRequestCounter)
+	registry.MustRegister(IndexNodeStorageHedgedReadCounter)
+	registry.MustRegister(IndexNodeRowCountMismatchCounter)
+	registry.MustRegister(IndexNodeTaskWaitLatency)
+	registry.MustRegister(IndexNodeTaskWaitSLOViolationRatio)
+	registry.MustRegister(IndexNodeEvictedTaskCounter)
+	registry.MustRegister(IndexNodeIOLaneWaitLatency)
 }
@@ -75,6 +75,13 @@ const (
 	cacheNameLabelName       = "cache_name"
 	cacheStateLabelName      = "cache_state"
 	indexCountLabelName      = "indexed_field_count"
+	fromPhaseLabelName       = "from_phase"
+	toPhaseLabelName         = "to_phase"
+	clusterIDLabelName       = "cluster_id"
+	storageOpLabelName       = "storage_op"
+	indexTypeLabelName       = "index_type"
+	sizeClassLabelName       = "segment_size_class"
+	ioLaneLabelName          = "io_lane"
 	requestScope             = "scope"
 )
 
@@ -86,9 +93,10 @@ var (
 
 // Register serves prometheus http service
 func Register(r *prometheus.Registry) {
+	// OpenMetrics is negotiated by the scraper to expose the exemplars of the histograms.
 	management.Register(&management.HTTPHandler{
 		Path:    "/metrics",
-		Handler: promhttp.HandlerFor(r, promhttp.HandlerOpts{}),
+		Handler: promhttp.HandlerFor(r, promhttp.HandlerOpts{EnableOpenMetrics: true}),
 	})
 	management.Register(&management.HTTPHandler{
 		Path:    "/metrics_default",
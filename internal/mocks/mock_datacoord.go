@@ -1473,6 +1473,53 @@ func (_c *DataCoord_Init_Call) Return(_a0 error) *DataCoord_Init_Call {
 	return _c
 }
 
+// ListNodeJobs provides a mock function with given fields: ctx, req
+func (_m *DataCoord) ListNodeJobs(ctx context.Context, req *indexpb.ListNodeJobsRequest) (*indexpb.ListNodeJobsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *indexpb.ListNodeJobsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ListNodeJobsRequest) *indexpb.ListNodeJobsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.ListNodeJobsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.ListNodeJobsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoord_ListNodeJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNodeJobs'
+type DataCoord_ListNodeJobs_Call struct {
+	*mock.Call
+}
+
+// ListNodeJobs is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *indexpb.ListNodeJobsRequest
+func (_e *DataCoord_Expecter) ListNodeJobs(ctx interface{}, req interface{}) *DataCoord_ListNodeJobs_Call {
+	return &DataCoord_ListNodeJobs_Call{Call: _e.mock.On("ListNodeJobs", ctx, req)}
+}
+
+func (_c *DataCoord_ListNodeJobs_Call) Run(run func(ctx context.Context, req *indexpb.ListNodeJobsRequest)) *DataCoord_ListNodeJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.ListNodeJobsRequest))
+	})
+	return _c
+}
+
+func (_c *DataCoord_ListNodeJobs_Call) Return(_a0 *indexpb.ListNodeJobsResponse, _a1 error) *DataCoord_ListNodeJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // ManualCompaction provides a mock function with given fields: ctx, req
 func (_m *DataCoord) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -1603,6 +1650,53 @@ func (_c *DataCoord_Register_Call) Return(_a0 error) *DataCoord_Register_Call {
 	return _c
 }
 
+// ReportJobResults provides a mock function with given fields: ctx, req
+func (_m *DataCoord) ReportJobResults(ctx context.Context, req *indexpb.ReportJobResultsRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ReportJobResultsRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.ReportJobResultsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoord_ReportJobResults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportJobResults'
+type DataCoord_ReportJobResults_Call struct {
+	*mock.Call
+}
+
+// ReportJobResults is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *indexpb.ReportJobResultsRequest
+func (_e *DataCoord_Expecter) ReportJobResults(ctx interface{}, req interface{}) *DataCoord_ReportJobResults_Call {
+	return &DataCoord_ReportJobResults_Call{Call: _e.mock.On("ReportJobResults", ctx, req)}
+}
+
+func (_c *DataCoord_ReportJobResults_Call) Run(run func(ctx context.Context, req *indexpb.ReportJobResultsRequest)) *DataCoord_ReportJobResults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.ReportJobResultsRequest))
+	})
+	return _c
+}
+
+func (_c *DataCoord_ReportJobResults_Call) Return(_a0 *commonpb.Status, _a1 error) *DataCoord_ReportJobResults_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // SaveBinlogPaths provides a mock function with given fields: ctx, req
 func (_m *DataCoord) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPathsRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
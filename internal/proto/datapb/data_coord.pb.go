@@ -4474,6 +4474,117 @@ func (m *AddImportSegmentResponse) GetChannelPos() []byte {
 	return nil
 }
 
+type ReadBinlogsRequest struct {
+	Base      *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SegmentID int64             `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	// paths are the insert binlogs to read, the ones not kept by DataNode are skipped.
+	Paths                []string `protobuf:"bytes,3,rep,name=paths,proto3" json:"paths,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadBinlogsRequest) Reset()         { *m = ReadBinlogsRequest{} }
+func (m *ReadBinlogsRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadBinlogsRequest) ProtoMessage()    {}
+func (*ReadBinlogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{69}
+}
+
+func (m *ReadBinlogsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadBinlogsRequest.Unmarshal(m, b)
+}
+func (m *ReadBinlogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadBinlogsRequest.Marshal(b, m, deterministic)
+}
+func (m *ReadBinlogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadBinlogsRequest.Merge(m, src)
+}
+func (m *ReadBinlogsRequest) XXX_Size() int {
+	return xxx_messageInfo_ReadBinlogsRequest.Size(m)
+}
+func (m *ReadBinlogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadBinlogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadBinlogsRequest proto.InternalMessageInfo
+
+func (m *ReadBinlogsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ReadBinlogsRequest) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *ReadBinlogsRequest) GetPaths() []string {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
+type ReadBinlogsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Path                 string           `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Value                []byte           `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReadBinlogsResponse) Reset()         { *m = ReadBinlogsResponse{} }
+func (m *ReadBinlogsResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadBinlogsResponse) ProtoMessage()    {}
+func (*ReadBinlogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{70}
+}
+
+func (m *ReadBinlogsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadBinlogsResponse.Unmarshal(m, b)
+}
+func (m *ReadBinlogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadBinlogsResponse.Marshal(b, m, deterministic)
+}
+func (m *ReadBinlogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadBinlogsResponse.Merge(m, src)
+}
+func (m *ReadBinlogsResponse) XXX_Size() int {
+	return xxx_messageInfo_ReadBinlogsResponse.Size(m)
+}
+func (m *ReadBinlogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadBinlogsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadBinlogsResponse proto.InternalMessageInfo
+
+func (m *ReadBinlogsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ReadBinlogsResponse) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ReadBinlogsResponse) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
 type SaveImportSegmentRequest struct {
 	Base                 *commonpb.MsgBase       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	SegmentId            int64                   `protobuf:"varint,2,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
@@ -4492,7 +4603,7 @@ func (m *SaveImportSegmentRequest) Reset()         { *m = SaveImportSegmentReque
 func (m *SaveImportSegmentRequest) String() string { return proto.CompactTextString(m) }
 func (*SaveImportSegmentRequest) ProtoMessage()    {}
 func (*SaveImportSegmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{69}
+	return fileDescriptor_82cd95f524594f49, []int{71}
 }
 
 func (m *SaveImportSegmentRequest) XXX_Unmarshal(b []byte) error {
@@ -4581,7 +4692,7 @@ func (m *UnsetIsImportingStateRequest) Reset()         { *m = UnsetIsImportingSt
 func (m *UnsetIsImportingStateRequest) String() string { return proto.CompactTextString(m) }
 func (*UnsetIsImportingStateRequest) ProtoMessage()    {}
 func (*UnsetIsImportingStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{70}
+	return fileDescriptor_82cd95f524594f49, []int{72}
 }
 
 func (m *UnsetIsImportingStateRequest) XXX_Unmarshal(b []byte) error {
@@ -4628,7 +4739,7 @@ func (m *MarkSegmentsDroppedRequest) Reset()         { *m = MarkSegmentsDroppedR
 func (m *MarkSegmentsDroppedRequest) String() string { return proto.CompactTextString(m) }
 func (*MarkSegmentsDroppedRequest) ProtoMessage()    {}
 func (*MarkSegmentsDroppedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{71}
+	return fileDescriptor_82cd95f524594f49, []int{73}
 }
 
 func (m *MarkSegmentsDroppedRequest) XXX_Unmarshal(b []byte) error {
@@ -4676,7 +4787,7 @@ func (m *SegmentReferenceLock) Reset()         { *m = SegmentReferenceLock{} }
 func (m *SegmentReferenceLock) String() string { return proto.CompactTextString(m) }
 func (*SegmentReferenceLock) ProtoMessage()    {}
 func (*SegmentReferenceLock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{72}
+	return fileDescriptor_82cd95f524594f49, []int{74}
 }
 
 func (m *SegmentReferenceLock) XXX_Unmarshal(b []byte) error {
@@ -4733,7 +4844,7 @@ func (m *AlterCollectionRequest) Reset()         { *m = AlterCollectionRequest{}
 func (m *AlterCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*AlterCollectionRequest) ProtoMessage()    {}
 func (*AlterCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{73}
+	return fileDescriptor_82cd95f524594f49, []int{75}
 }
 
 func (m *AlterCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -4801,7 +4912,7 @@ func (m *GcConfirmRequest) Reset()         { *m = GcConfirmRequest{} }
 func (m *GcConfirmRequest) String() string { return proto.CompactTextString(m) }
 func (*GcConfirmRequest) ProtoMessage()    {}
 func (*GcConfirmRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{74}
+	return fileDescriptor_82cd95f524594f49, []int{76}
 }
 
 func (m *GcConfirmRequest) XXX_Unmarshal(b []byte) error {
@@ -4848,7 +4959,7 @@ func (m *GcConfirmResponse) Reset()         { *m = GcConfirmResponse{} }
 func (m *GcConfirmResponse) String() string { return proto.CompactTextString(m) }
 func (*GcConfirmResponse) ProtoMessage()    {}
 func (*GcConfirmResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{75}
+	return fileDescriptor_82cd95f524594f49, []int{77}
 }
 
 func (m *GcConfirmResponse) XXX_Unmarshal(b []byte) error {
@@ -4957,6 +5068,8 @@ func init() {
 	proto.RegisterType((*ResendSegmentStatsResponse)(nil), "milvus.proto.data.ResendSegmentStatsResponse")
 	proto.RegisterType((*AddImportSegmentRequest)(nil), "milvus.proto.data.AddImportSegmentRequest")
 	proto.RegisterType((*AddImportSegmentResponse)(nil), "milvus.proto.data.AddImportSegmentResponse")
+	proto.RegisterType((*ReadBinlogsRequest)(nil), "milvus.proto.data.ReadBinlogsRequest")
+	proto.RegisterType((*ReadBinlogsResponse)(nil), "milvus.proto.data.ReadBinlogsResponse")
 	proto.RegisterType((*SaveImportSegmentRequest)(nil), "milvus.proto.data.SaveImportSegmentRequest")
 	proto.RegisterType((*UnsetIsImportingStateRequest)(nil), "milvus.proto.data.UnsetIsImportingStateRequest")
 	proto.RegisterType((*MarkSegmentsDroppedRequest)(nil), "milvus.proto.data.MarkSegmentsDroppedRequest")
@@ -4969,299 +5082,304 @@ func init() {
 func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
 
 var fileDescriptor_82cd95f524594f49 = []byte{
-	// 4667 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7c, 0x4b, 0x8c, 0x1c, 0x49,
-	0x5a, 0xb0, 0xb3, 0x5e, 0x5d, 0xf5, 0xd5, 0xa3, 0xab, 0xc3, 0x9e, 0x76, 0xb9, 0x66, 0xfc, 0x4a,
-	0xdb, 0xe3, 0x1e, 0x8f, 0xc7, 0xf6, 0x78, 0xfe, 0xd1, 0xfa, 0x5f, 0xef, 0xcc, 0xe2, 0xee, 0x76,
-	0x7b, 0x0a, 0xdc, 0xbd, 0xbd, 0xd9, 0xed, 0x31, 0x9a, 0x45, 0x2a, 0x65, 0x57, 0x46, 0x57, 0xe7,
-	0x76, 0x55, 0x66, 0x39, 0x33, 0xab, 0xdb, 0xbd, 0x48, 0xec, 0x00, 0x12, 0xd2, 0x20, 0x04, 0x08,
-	0x09, 0x21, 0x6e, 0x88, 0xd3, 0xb2, 0x68, 0x11, 0xd2, 0xc2, 0x85, 0x0b, 0xd7, 0x11, 0x1c, 0x56,
-	0x08, 0x89, 0x23, 0x47, 0xe0, 0x8e, 0xc4, 0x89, 0x03, 0x8a, 0x47, 0x46, 0xbe, 0x22, 0xab, 0xb2,
-	0xab, 0xec, 0xb1, 0x04, 0xb7, 0x8a, 0xc8, 0x2f, 0xbe, 0xf8, 0x22, 0xbe, 0xf7, 0x17, 0x11, 0x05,
-	0x4d, 0x43, 0xf7, 0xf4, 0x6e, 0xcf, 0xb6, 0x1d, 0xe3, 0xce, 0xc8, 0xb1, 0x3d, 0x1b, 0x2d, 0x0d,
-	0xcd, 0xc1, 0xd1, 0xd8, 0x65, 0xad, 0x3b, 0xe4, 0x73, 0xbb, 0xd6, 0xb3, 0x87, 0x43, 0xdb, 0x62,
-	0x5d, 0xed, 0x86, 0x69, 0x79, 0xd8, 0xb1, 0xf4, 0x01, 0x6f, 0xd7, 0xc2, 0x03, 0xda, 0x35, 0xb7,
-	0x77, 0x80, 0x87, 0x3a, 0x6f, 0x2d, 0x99, 0x96, 0x81, 0x5f, 0x86, 0xf1, 0xab, 0x0b, 0x50, 0x7c,
-	0x3c, 0x1c, 0x79, 0x27, 0xea, 0xdf, 0x28, 0x50, 0xdb, 0x18, 0x8c, 0xdd, 0x03, 0x0d, 0xbf, 0x18,
-	0x63, 0xd7, 0x43, 0xf7, 0xa0, 0xb0, 0xa7, 0xbb, 0xb8, 0xa5, 0x5c, 0x51, 0x56, 0xaa, 0xf7, 0xdf,
-	0xb9, 0x13, 0x21, 0x84, 0x93, 0xb0, 0xe9, 0xf6, 0x57, 0x75, 0x17, 0x6b, 0x14, 0x12, 0x21, 0x28,
-	0x18, 0x7b, 0x9d, 0xf5, 0x56, 0xee, 0x8a, 0xb2, 0x92, 0xd7, 0xe8, 0x6f, 0x74, 0x09, 0xc0, 0xc5,
-	0xfd, 0x21, 0xb6, 0xbc, 0xce, 0xba, 0xdb, 0xca, 0x5f, 0xc9, 0xaf, 0xe4, 0xb5, 0x50, 0x0f, 0x52,
-	0xa1, 0xd6, 0xb3, 0x07, 0x03, 0xdc, 0xf3, 0x4c, 0xdb, 0xea, 0xac, 0xb7, 0x0a, 0x74, 0x6c, 0xa4,
-	0x0f, 0xb5, 0xa1, 0x6c, 0xba, 0x9d, 0xe1, 0xc8, 0x76, 0xbc, 0x56, 0xf1, 0x8a, 0xb2, 0x52, 0xd6,
-	0x44, 0x5b, 0xfd, 0x37, 0x05, 0xea, 0x9c, 0x6c, 0x77, 0x64, 0x5b, 0x2e, 0x46, 0x1f, 0x41, 0xc9,
-	0xf5, 0x74, 0x6f, 0xec, 0x72, 0xca, 0xdf, 0x96, 0x52, 0xbe, 0x43, 0x41, 0x34, 0x0e, 0x2a, 0x25,
-	0x3d, 0x4e, 0x5a, 0x5e, 0x42, 0x5a, 0x74, 0x79, 0x85, 0xc4, 0xf2, 0x56, 0x60, 0x71, 0x9f, 0x50,
-	0xb7, 0x13, 0x00, 0x15, 0x29, 0x50, 0xbc, 0x9b, 0x60, 0xf2, 0xcc, 0x21, 0xfe, 0xde, 0xfe, 0x0e,
-	0xd6, 0x07, 0xad, 0x12, 0x9d, 0x2b, 0xd4, 0xa3, 0xfe, 0x93, 0x02, 0x4d, 0x01, 0xee, 0xf3, 0xe8,
-	0x1c, 0x14, 0x7b, 0xf6, 0xd8, 0xf2, 0xe8, 0x52, 0xeb, 0x1a, 0x6b, 0xa0, 0xab, 0x50, 0xeb, 0x1d,
-	0xe8, 0x96, 0x85, 0x07, 0x5d, 0x4b, 0x1f, 0x62, 0xba, 0xa8, 0x8a, 0x56, 0xe5, 0x7d, 0x5b, 0xfa,
-	0x10, 0x67, 0x5a, 0xdb, 0x15, 0xa8, 0x8e, 0x74, 0xc7, 0x33, 0x23, 0x9c, 0x09, 0x77, 0x4d, 0x62,
-	0x0c, 0x99, 0xc1, 0xa4, 0xbf, 0x76, 0x75, 0xf7, 0xb0, 0xb3, 0xce, 0x57, 0x14, 0xe9, 0x53, 0xff,
-	0x4c, 0x81, 0xe5, 0x47, 0xae, 0x6b, 0xf6, 0xad, 0xc4, 0xca, 0x96, 0xa1, 0x64, 0xd9, 0x06, 0xee,
-	0xac, 0xd3, 0xa5, 0xe5, 0x35, 0xde, 0x42, 0x6f, 0x43, 0x65, 0x84, 0xb1, 0xd3, 0x75, 0xec, 0x81,
-	0xbf, 0xb0, 0x32, 0xe9, 0xd0, 0xec, 0x01, 0x46, 0xdf, 0x87, 0x25, 0x37, 0x86, 0x88, 0xc9, 0x5c,
-	0xf5, 0xfe, 0xb5, 0x3b, 0x09, 0x45, 0xba, 0x13, 0x9f, 0x54, 0x4b, 0x8e, 0x56, 0xbf, 0xcc, 0xc1,
-	0x59, 0x01, 0xc7, 0x68, 0x25, 0xbf, 0xc9, 0xce, 0xbb, 0xb8, 0x2f, 0xc8, 0x63, 0x8d, 0x2c, 0x3b,
-	0x2f, 0x58, 0x96, 0x0f, 0xb3, 0x2c, 0x8b, 0x1a, 0xc4, 0xf8, 0x51, 0x4c, 0xf2, 0xe3, 0x32, 0x54,
-	0xf1, 0xcb, 0x91, 0xe9, 0xe0, 0x2e, 0x11, 0x1c, 0xba, 0xe5, 0x05, 0x0d, 0x58, 0xd7, 0xae, 0x39,
-	0x0c, 0xeb, 0xc6, 0x42, 0x66, 0xdd, 0x50, 0xff, 0x5c, 0x81, 0xf3, 0x09, 0x2e, 0x71, 0x65, 0xd3,
-	0xa0, 0x49, 0x57, 0x1e, 0xec, 0x0c, 0x51, 0x3b, 0xb2, 0xe1, 0xef, 0x4e, 0xda, 0xf0, 0x00, 0x5c,
-	0x4b, 0x8c, 0x0f, 0x11, 0x99, 0xcb, 0x4e, 0xe4, 0x21, 0x9c, 0x7f, 0x82, 0x3d, 0x3e, 0x01, 0xf9,
-	0x86, 0xdd, 0xd9, 0x0d, 0x59, 0x54, 0xab, 0x73, 0x71, 0xad, 0x56, 0xff, 0x3a, 0x27, 0x74, 0x91,
-	0x4e, 0xd5, 0xb1, 0xf6, 0x6d, 0xf4, 0x0e, 0x54, 0x04, 0x08, 0x97, 0x8a, 0xa0, 0x03, 0x7d, 0x0b,
-	0x8a, 0x84, 0x52, 0x26, 0x12, 0x8d, 0xfb, 0x57, 0xe5, 0x6b, 0x0a, 0xe1, 0xd4, 0x18, 0x3c, 0xea,
-	0x40, 0xc3, 0xf5, 0x74, 0xc7, 0xeb, 0x8e, 0x6c, 0x97, 0xf2, 0x99, 0x0a, 0x4e, 0xf5, 0xbe, 0x1a,
-	0xc5, 0x20, 0xbc, 0xc0, 0xa6, 0xdb, 0xdf, 0xe6, 0x90, 0x5a, 0x9d, 0x8e, 0xf4, 0x9b, 0xe8, 0x31,
-	0xd4, 0xb0, 0x65, 0x04, 0x88, 0x0a, 0x99, 0x11, 0x55, 0xb1, 0x65, 0x08, 0x34, 0x01, 0x7f, 0x8a,
-	0xd9, 0xf9, 0xf3, 0x7b, 0x0a, 0xb4, 0x92, 0x0c, 0x9a, 0xc7, 0x64, 0x3f, 0x64, 0x83, 0x30, 0x63,
-	0xd0, 0x44, 0x0d, 0x17, 0x4c, 0xd2, 0xf8, 0x10, 0xf5, 0x8f, 0x15, 0x78, 0x2b, 0x20, 0x87, 0x7e,
-	0x7a, 0x5d, 0xd2, 0x82, 0x6e, 0x41, 0xd3, 0xb4, 0x7a, 0x83, 0xb1, 0x81, 0x9f, 0x59, 0x9f, 0x61,
-	0x7d, 0xe0, 0x1d, 0x9c, 0x50, 0x1e, 0x96, 0xb5, 0x44, 0xbf, 0xfa, 0xaf, 0x39, 0x58, 0x8e, 0xd3,
-	0x35, 0xcf, 0x26, 0xfd, 0x3f, 0x28, 0x9a, 0xd6, 0xbe, 0xed, 0xef, 0xd1, 0xa5, 0x09, 0x4a, 0x49,
-	0xe6, 0x62, 0xc0, 0xc8, 0x06, 0xe4, 0x9b, 0xb1, 0xde, 0x01, 0xee, 0x1d, 0x8e, 0x6c, 0x93, 0x1a,
-	0x2c, 0x82, 0xe2, 0x97, 0x24, 0x28, 0xe4, 0x14, 0xdf, 0x59, 0x63, 0x38, 0xd6, 0x04, 0x8a, 0xc7,
-	0x96, 0xe7, 0x9c, 0x68, 0x4b, 0xbd, 0x78, 0x7f, 0xfb, 0x00, 0x96, 0xe5, 0xc0, 0xa8, 0x09, 0xf9,
-	0x43, 0x7c, 0x42, 0x97, 0x5c, 0xd1, 0xc8, 0x4f, 0xf4, 0x00, 0x8a, 0x47, 0xfa, 0x60, 0x8c, 0xb9,
-	0x75, 0xc8, 0x22, 0xbe, 0x6c, 0xc0, 0xb7, 0x73, 0x0f, 0x14, 0x75, 0x08, 0x6f, 0x3f, 0xc1, 0x5e,
-	0xc7, 0x72, 0xb1, 0xe3, 0xad, 0x9a, 0xd6, 0xc0, 0xee, 0x6f, 0xeb, 0xde, 0xc1, 0x1c, 0xb6, 0x22,
-	0xa2, 0xf6, 0xb9, 0x98, 0xda, 0xab, 0x3f, 0x51, 0xe0, 0x1d, 0xf9, 0x7c, 0x9c, 0xab, 0x6d, 0x28,
-	0xef, 0x9b, 0x78, 0x60, 0x10, 0xd1, 0x51, 0xa8, 0xe8, 0x88, 0x36, 0xb1, 0x19, 0x23, 0x02, 0xcc,
-	0x99, 0x77, 0x35, 0x65, 0xa5, 0x3b, 0x9e, 0x63, 0x5a, 0xfd, 0xa7, 0xa6, 0xeb, 0x69, 0x0c, 0x3e,
-	0x24, 0x2a, 0xf9, 0xec, 0x1a, 0xfa, 0xbb, 0x0a, 0x5c, 0x7a, 0x82, 0xbd, 0x35, 0xe1, 0x72, 0xc8,
-	0x77, 0xd3, 0xf5, 0xcc, 0x9e, 0xfb, 0x6a, 0x43, 0xc2, 0x0c, 0xb1, 0x87, 0xfa, 0x07, 0x0a, 0x5c,
-	0x4e, 0x25, 0x86, 0x6f, 0x1d, 0x37, 0xa9, 0xbe, 0xc3, 0x91, 0x9b, 0xd4, 0x5f, 0xc1, 0x27, 0x9f,
-	0x13, 0xe6, 0x6f, 0xeb, 0xa6, 0xc3, 0x4c, 0xea, 0x8c, 0x0e, 0xe6, 0x67, 0x0a, 0x5c, 0x7c, 0x82,
-	0xbd, 0x6d, 0xdf, 0xdd, 0xbe, 0xc1, 0xdd, 0x21, 0x30, 0x21, 0xb7, 0xef, 0xc7, 0x9d, 0x91, 0x3e,
-	0xf5, 0xf7, 0x19, 0x3b, 0xa5, 0xf4, 0xbe, 0x91, 0x0d, 0xbc, 0x44, 0x35, 0x21, 0x64, 0x27, 0xb8,
-	0xc6, 0xf3, 0xed, 0x53, 0xbf, 0x2a, 0x42, 0xed, 0x73, 0x6e, 0x1a, 0xa8, 0x43, 0x8d, 0xef, 0x84,
-	0x22, 0x8f, 0x89, 0x42, 0xc1, 0x95, 0x2c, 0xde, 0x7a, 0x02, 0x75, 0x17, 0xe3, 0xc3, 0x59, 0xdc,
-	0x67, 0x8d, 0x0c, 0x14, 0x6e, 0xef, 0x29, 0x2c, 0x8d, 0x2d, 0x1a, 0xb5, 0x63, 0x83, 0xaf, 0x82,
-	0xed, 0xfc, 0x74, 0xb3, 0x9a, 0x1c, 0x88, 0x3e, 0xe3, 0x89, 0x41, 0x08, 0x57, 0x31, 0x13, 0xae,
-	0xf8, 0x30, 0xd4, 0x81, 0xa6, 0xe1, 0xd8, 0xa3, 0x11, 0x36, 0xba, 0xae, 0x8f, 0xaa, 0x94, 0x0d,
-	0x15, 0x1f, 0x27, 0x50, 0xdd, 0x83, 0xb3, 0x71, 0x4a, 0x3b, 0x06, 0x89, 0x15, 0x89, 0x78, 0xc9,
-	0x3e, 0xa1, 0xdb, 0xb0, 0x94, 0x84, 0x2f, 0x53, 0xf8, 0xe4, 0x07, 0xf4, 0x01, 0xa0, 0x18, 0xa9,
-	0x04, 0xbc, 0xc2, 0xc0, 0xa3, 0xc4, 0x70, 0x70, 0x9a, 0xb0, 0x46, 0xc1, 0x81, 0x81, 0xf3, 0x2f,
-	0x21, 0xf0, 0x0e, 0xf1, 0xb3, 0x11, 0x70, 0xb7, 0x55, 0xcd, 0xb6, 0x11, 0x51, 0x64, 0xae, 0xfa,
-	0x95, 0x02, 0xcb, 0xcf, 0x75, 0xaf, 0x77, 0xb0, 0x3e, 0xe4, 0x52, 0x3a, 0x87, 0x96, 0x7f, 0x02,
-	0x95, 0x23, 0x2e, 0x91, 0xbe, 0x29, 0xbf, 0x2c, 0x21, 0x28, 0x2c, 0xfb, 0x5a, 0x30, 0x82, 0x24,
-	0x49, 0xe7, 0x36, 0x42, 0xc9, 0xe2, 0x1b, 0xb0, 0x37, 0x53, 0xb2, 0x5c, 0xf5, 0x25, 0x00, 0x27,
-	0x6e, 0xd3, 0xed, 0xcf, 0x40, 0xd7, 0x03, 0x58, 0xe0, 0xd8, 0xb8, 0x41, 0x99, 0xc6, 0x30, 0x1f,
-	0x5c, 0xfd, 0x69, 0x09, 0xaa, 0xa1, 0x0f, 0xa8, 0x01, 0x39, 0x61, 0x29, 0x72, 0x92, 0xd5, 0xe5,
-	0xa6, 0xe7, 0x55, 0xf9, 0x64, 0x5e, 0x75, 0x03, 0x1a, 0x26, 0xf5, 0xe0, 0x5d, 0xce, 0x15, 0x1a,
-	0x3a, 0x57, 0xb4, 0x3a, 0xeb, 0xe5, 0x22, 0x82, 0x2e, 0x41, 0xd5, 0x1a, 0x0f, 0xbb, 0xf6, 0x7e,
-	0xd7, 0xb1, 0x8f, 0x5d, 0x9e, 0xa0, 0x55, 0xac, 0xf1, 0xf0, 0x7b, 0xfb, 0x9a, 0x7d, 0xec, 0x06,
-	0x39, 0x40, 0xe9, 0x94, 0x39, 0xc0, 0x25, 0xa8, 0x0e, 0xf5, 0x97, 0x04, 0x6b, 0xd7, 0x1a, 0x0f,
-	0x69, 0xee, 0x96, 0xd7, 0x2a, 0x43, 0xfd, 0xa5, 0x66, 0x1f, 0x6f, 0x8d, 0x87, 0x68, 0x05, 0x9a,
-	0x03, 0xdd, 0xf5, 0xba, 0xe1, 0xe4, 0xaf, 0x4c, 0x93, 0xbf, 0x06, 0xe9, 0x7f, 0x1c, 0x24, 0x80,
-	0xc9, 0x6c, 0xa2, 0x32, 0x47, 0x36, 0x61, 0x0c, 0x07, 0x01, 0x22, 0xc8, 0x9e, 0x4d, 0x18, 0xc3,
-	0x81, 0x40, 0xf3, 0x00, 0x16, 0xf6, 0x68, 0x5c, 0x34, 0x49, 0x59, 0x37, 0x48, 0x48, 0xc4, 0xc2,
-	0x27, 0xcd, 0x07, 0x47, 0xdf, 0x81, 0x0a, 0x75, 0x47, 0x74, 0x6c, 0x2d, 0xd3, 0xd8, 0x60, 0x00,
-	0x19, 0x6d, 0xe0, 0x81, 0xa7, 0xd3, 0xd1, 0xf5, 0x6c, 0xa3, 0xc5, 0x00, 0x62, 0x29, 0x7b, 0x0e,
-	0xd6, 0x3d, 0x6c, 0xac, 0x9e, 0xac, 0xd9, 0xc3, 0x91, 0x4e, 0x85, 0xa9, 0xd5, 0xa0, 0x61, 0xbd,
-	0xec, 0x13, 0x7a, 0x17, 0x1a, 0x3d, 0xd1, 0xda, 0x70, 0xec, 0x61, 0x6b, 0x91, 0xea, 0x51, 0xac,
-	0x17, 0x5d, 0x04, 0xf0, 0x6d, 0xa4, 0xee, 0xb5, 0x9a, 0x94, 0x8b, 0x15, 0xde, 0xf3, 0x88, 0xd6,
-	0x76, 0x4c, 0xb7, 0xcb, 0xaa, 0x28, 0xa6, 0xd5, 0x6f, 0x2d, 0xd1, 0x19, 0xab, 0x7e, 0xd9, 0xc5,
-	0xb4, 0xfa, 0xe8, 0x3c, 0x2c, 0x98, 0x6e, 0x77, 0x5f, 0x3f, 0xc4, 0x2d, 0x44, 0xbf, 0x96, 0x4c,
-	0x77, 0x43, 0x3f, 0xc4, 0xea, 0x8f, 0xe1, 0x5c, 0x20, 0x5d, 0x21, 0x4e, 0x26, 0x85, 0x42, 0x99,
-	0x55, 0x28, 0x26, 0x47, 0xc3, 0xbf, 0x28, 0xc0, 0xf2, 0x8e, 0x7e, 0x84, 0x5f, 0x7f, 0xe0, 0x9d,
-	0xc9, 0xac, 0x3d, 0x85, 0x25, 0x1a, 0x6b, 0xdf, 0x0f, 0xd1, 0x33, 0xc1, 0xa3, 0x87, 0x45, 0x21,
-	0x39, 0x10, 0x7d, 0x97, 0x84, 0x22, 0xb8, 0x77, 0xb8, 0x4d, 0x92, 0x17, 0xdf, 0x9b, 0x5f, 0x94,
-	0xe0, 0x59, 0x13, 0x50, 0x5a, 0x78, 0x04, 0xda, 0x86, 0xc5, 0x28, 0x1b, 0x7c, 0x3f, 0x7e, 0x73,
-	0x62, 0x66, 0x1b, 0xec, 0xbe, 0xd6, 0x88, 0x30, 0xc3, 0x45, 0x2d, 0x58, 0xe0, 0x4e, 0x98, 0xda,
-	0x8c, 0xb2, 0xe6, 0x37, 0xd1, 0x36, 0x9c, 0x65, 0x2b, 0xd8, 0xe1, 0x0a, 0xc1, 0x16, 0x5f, 0xce,
-	0xb4, 0x78, 0xd9, 0xd0, 0xa8, 0x3e, 0x55, 0x4e, 0xab, 0x4f, 0x2d, 0x58, 0xe0, 0x32, 0x4e, 0xed,
-	0x48, 0x59, 0xf3, 0x9b, 0x84, 0xcd, 0x81, 0xb4, 0x57, 0xe9, 0xb7, 0xa0, 0x83, 0x24, 0x2d, 0x10,
-	0xec, 0xe7, 0x94, 0x1a, 0xcc, 0xa7, 0x50, 0x16, 0x12, 0x9e, 0x3d, 0x79, 0x14, 0x63, 0xe2, 0xf6,
-	0x3d, 0x1f, 0xb3, 0xef, 0xea, 0x3f, 0x2a, 0x50, 0x5b, 0x27, 0x4b, 0x7a, 0x6a, 0xf7, 0xa9, 0x37,
-	0xba, 0x01, 0x0d, 0x07, 0xf7, 0x6c, 0xc7, 0xe8, 0x62, 0xcb, 0x73, 0x4c, 0xcc, 0x52, 0xf7, 0x82,
-	0x56, 0x67, 0xbd, 0x8f, 0x59, 0x27, 0x01, 0x23, 0x26, 0xdb, 0xf5, 0xf4, 0xe1, 0xa8, 0xbb, 0x4f,
-	0x4c, 0x43, 0x8e, 0x81, 0x89, 0x5e, 0x6a, 0x19, 0xae, 0x42, 0x2d, 0x00, 0xf3, 0x6c, 0x3a, 0x7f,
-	0x41, 0xab, 0x8a, 0xbe, 0x5d, 0x1b, 0x5d, 0x87, 0x06, 0xdd, 0xd3, 0xee, 0xc0, 0xee, 0x77, 0x49,
-	0x2e, 0xc8, 0x1d, 0x55, 0xcd, 0xe0, 0x64, 0x11, 0x5e, 0x45, 0xa1, 0x5c, 0xf3, 0x47, 0x98, 0xbb,
-	0x2a, 0x01, 0xb5, 0x63, 0xfe, 0x08, 0xab, 0xff, 0xa0, 0x40, 0x7d, 0x5d, 0xf7, 0xf4, 0x2d, 0xdb,
-	0xc0, 0xbb, 0x33, 0x3a, 0xf6, 0x0c, 0xf5, 0xd0, 0x77, 0xa0, 0x22, 0x56, 0xc0, 0x97, 0x14, 0x74,
-	0xa0, 0x0d, 0x68, 0xf8, 0xb1, 0x5c, 0x97, 0xe5, 0x2a, 0x85, 0xd4, 0x00, 0x2a, 0xe4, 0x39, 0x5d,
-	0xad, 0xee, 0x0f, 0xa3, 0x4d, 0x75, 0x03, 0x6a, 0xe1, 0xcf, 0x64, 0xd6, 0x9d, 0xb8, 0xa0, 0x88,
-	0x0e, 0x22, 0x8d, 0x5b, 0xe3, 0x21, 0xe1, 0x29, 0x37, 0x2c, 0x7e, 0x53, 0xfd, 0x6d, 0x05, 0xea,
-	0xdc, 0xdd, 0xef, 0x88, 0x93, 0x03, 0xba, 0x34, 0x56, 0xa1, 0xa0, 0xbf, 0xd1, 0xb7, 0xa3, 0xc5,
-	0xbe, 0xeb, 0x52, 0x23, 0x40, 0x91, 0xd0, 0x20, 0x33, 0xe2, 0xeb, 0xb3, 0x64, 0xc7, 0x5f, 0x12,
-	0x41, 0xe3, 0xac, 0xa1, 0x82, 0xd6, 0x82, 0x05, 0xdd, 0x30, 0x1c, 0xec, 0xba, 0x9c, 0x0e, 0xbf,
-	0x49, 0xbe, 0x1c, 0x61, 0xc7, 0xf5, 0x45, 0x3e, 0xaf, 0xf9, 0x4d, 0xf4, 0x1d, 0x28, 0x8b, 0xa8,
-	0x94, 0x95, 0x76, 0xae, 0xa4, 0xd3, 0xc9, 0x73, 0x39, 0x31, 0x42, 0xfd, 0xdb, 0x1c, 0x34, 0xf8,
-	0x86, 0xad, 0x72, 0x7f, 0x3c, 0x59, 0xf9, 0x56, 0xa1, 0xb6, 0x1f, 0xe8, 0xfe, 0xa4, 0x82, 0x54,
-	0xd8, 0x44, 0x44, 0xc6, 0x4c, 0x53, 0xc0, 0x68, 0x44, 0x50, 0x98, 0x2b, 0x22, 0x28, 0x9e, 0xd6,
-	0x82, 0x25, 0x63, 0xc4, 0x92, 0x24, 0x46, 0x54, 0x7f, 0x0d, 0xaa, 0x21, 0x04, 0xd4, 0x42, 0xb3,
-	0x72, 0x0f, 0xdf, 0x31, 0xbf, 0x89, 0x3e, 0x0a, 0xe2, 0x22, 0xb6, 0x55, 0x17, 0x24, 0xb4, 0xc4,
-	0x42, 0x22, 0xf5, 0xef, 0x15, 0x28, 0x71, 0xcc, 0x97, 0xa1, 0xca, 0x8d, 0x0e, 0x8d, 0x19, 0x19,
-	0x76, 0xe0, 0x5d, 0x24, 0x68, 0x7c, 0x75, 0x56, 0xe7, 0x02, 0x94, 0x63, 0xf6, 0x66, 0x81, 0xbb,
-	0x05, 0xff, 0x53, 0xc8, 0xc8, 0x90, 0x4f, 0xc4, 0xbe, 0xa0, 0x73, 0x50, 0x1c, 0xd8, 0x7d, 0x71,
-	0x32, 0xc4, 0x1a, 0xea, 0xd7, 0x0a, 0x2d, 0xe4, 0x6b, 0xb8, 0x67, 0x1f, 0x61, 0xe7, 0x64, 0xfe,
-	0x0a, 0xe8, 0xc3, 0x90, 0x98, 0x67, 0x4c, 0xbe, 0xc4, 0x00, 0xf4, 0x30, 0x60, 0x42, 0x5e, 0x56,
-	0x23, 0x09, 0xdb, 0x1d, 0x2e, 0xa4, 0x01, 0x33, 0xfe, 0x50, 0xa1, 0xb5, 0xdc, 0xe8, 0x52, 0x66,
-	0x8d, 0x76, 0x5e, 0x49, 0x22, 0xa3, 0xfe, 0x42, 0x81, 0x76, 0x50, 0x84, 0x71, 0x57, 0x4f, 0xe6,
-	0x3d, 0x29, 0x79, 0x35, 0xf9, 0xd5, 0xff, 0x17, 0xa5, 0x7c, 0xa2, 0xb4, 0x99, 0x32, 0x23, 0xbf,
-	0x90, 0x6f, 0xd1, 0x7a, 0x6e, 0x72, 0x41, 0xf3, 0x88, 0x4c, 0x1b, 0xca, 0xa2, 0x80, 0xc0, 0xca,
-	0xf9, 0xa2, 0x4d, 0x34, 0xec, 0xc2, 0x13, 0xec, 0x6d, 0x44, 0x8b, 0x30, 0x6f, 0x7a, 0x03, 0xc3,
-	0x47, 0x0c, 0x07, 0xfc, 0x88, 0xa1, 0x10, 0x3b, 0x62, 0xe0, 0xfd, 0xea, 0x90, 0x8a, 0x40, 0x62,
-	0x01, 0xaf, 0x6b, 0xc3, 0x7e, 0x47, 0x81, 0x16, 0x9f, 0x85, 0xce, 0x49, 0x52, 0xa2, 0x01, 0xf6,
-	0xb0, 0xf1, 0x4d, 0x97, 0x0a, 0xfe, 0x5b, 0x81, 0x66, 0xd8, 0xeb, 0x52, 0xc7, 0xf9, 0x31, 0x14,
-	0x69, 0xa5, 0x85, 0x53, 0x30, 0xd5, 0x34, 0x30, 0x68, 0x62, 0xb6, 0x69, 0xa8, 0xbd, 0x2b, 0x02,
-	0x04, 0xde, 0x0c, 0x5c, 0x7f, 0xfe, 0xf4, 0xae, 0x9f, 0x87, 0x42, 0xf6, 0x98, 0xe0, 0x65, 0x27,
-	0xc0, 0x41, 0x07, 0xfa, 0x04, 0x4a, 0xec, 0x32, 0x07, 0x3f, 0x76, 0xbb, 0x11, 0x45, 0xcd, 0x2f,
-	0x7a, 0x84, 0x2a, 0xe6, 0xb4, 0x43, 0xe3, 0x83, 0xd4, 0x5f, 0x86, 0xe5, 0x20, 0x1b, 0x65, 0xd3,
-	0xce, 0x2a, 0xb4, 0xea, 0xbf, 0x28, 0x70, 0x76, 0xe7, 0xc4, 0xea, 0xc5, 0xc5, 0x7f, 0x19, 0x4a,
-	0xa3, 0x81, 0x1e, 0xd4, 0x6a, 0x79, 0x8b, 0x86, 0x81, 0x6c, 0x6e, 0x6c, 0x10, 0x1f, 0xc2, 0xf6,
-	0xac, 0x2a, 0xfa, 0x76, 0xed, 0xa9, 0xae, 0xfd, 0x86, 0x48, 0x9f, 0xb1, 0xc1, 0xbc, 0x15, 0x2b,
-	0x43, 0xd5, 0x45, 0x2f, 0xf5, 0x56, 0x9f, 0x00, 0x50, 0x87, 0xde, 0x3d, 0x8d, 0x13, 0xa7, 0x23,
-	0x9e, 0x12, 0x93, 0xfd, 0xf3, 0x1c, 0xb4, 0x42, 0xbb, 0xf4, 0x4d, 0xc7, 0x37, 0x29, 0x59, 0x59,
-	0xfe, 0x15, 0x65, 0x65, 0x85, 0xf9, 0x63, 0x9a, 0xa2, 0x2c, 0xa6, 0xf9, 0xcd, 0x3c, 0x34, 0x82,
-	0x5d, 0xdb, 0x1e, 0xe8, 0x56, 0xaa, 0x24, 0xec, 0x88, 0x78, 0x3e, 0xba, 0x4f, 0xef, 0xcb, 0xf4,
-	0x24, 0x85, 0x11, 0x5a, 0x0c, 0x05, 0xba, 0x48, 0x99, 0xee, 0x78, 0xac, 0xf0, 0xc5, 0x73, 0x08,
-	0xa6, 0x90, 0xe6, 0x10, 0xa3, 0xdb, 0x80, 0xb8, 0x16, 0x75, 0x4d, 0xab, 0xeb, 0xe2, 0x9e, 0x6d,
-	0x19, 0x4c, 0xbf, 0x8a, 0x5a, 0x93, 0x7f, 0xe9, 0x58, 0x3b, 0xac, 0x1f, 0x7d, 0x0c, 0x05, 0xef,
-	0x64, 0xc4, 0xa2, 0x95, 0x86, 0xd4, 0xdf, 0x07, 0x74, 0xed, 0x9e, 0x8c, 0xb0, 0x46, 0xc1, 0xfd,
-	0xeb, 0x3b, 0x9e, 0xa3, 0x1f, 0xf1, 0xd0, 0xaf, 0xa0, 0x85, 0x7a, 0x88, 0xc5, 0xf0, 0xf7, 0x70,
-	0x81, 0x85, 0x48, 0xbc, 0xc9, 0x24, 0xdb, 0x57, 0xda, 0xae, 0xe7, 0x0d, 0x68, 0xe9, 0x8e, 0x4a,
-	0xb6, 0xdf, 0xbb, 0xeb, 0x0d, 0xc8, 0x22, 0x3d, 0xdb, 0xd3, 0x07, 0x4c, 0x3f, 0x2a, 0xdc, 0x3a,
-	0x90, 0x1e, 0x9a, 0x98, 0xfc, 0x73, 0x0e, 0x9a, 0x01, 0x61, 0x1a, 0x76, 0xc7, 0x83, 0x74, 0x7d,
-	0x9c, 0x5c, 0x3a, 0x99, 0xa6, 0x8a, 0xdf, 0x85, 0x2a, 0x97, 0x8a, 0x53, 0x48, 0x15, 0xb0, 0x21,
-	0x4f, 0x27, 0x88, 0x79, 0xf1, 0x15, 0x89, 0x79, 0x69, 0x86, 0xe2, 0x83, 0x9c, 0x37, 0xea, 0x4f,
-	0x14, 0x78, 0x2b, 0x61, 0x35, 0x27, 0x6e, 0xed, 0xe4, 0xd4, 0x8f, 0x5b, 0xd3, 0x38, 0x4a, 0x6e,
-	0xff, 0x1f, 0x42, 0xc9, 0xa1, 0xd8, 0xf9, 0x19, 0xd5, 0xb5, 0x89, 0xc2, 0xc7, 0x08, 0xd1, 0xf8,
-	0x10, 0xf5, 0x8f, 0x14, 0x38, 0x9f, 0x24, 0x75, 0x0e, 0xa7, 0xbe, 0x0a, 0x0b, 0x0c, 0xb5, 0xaf,
-	0xa3, 0x2b, 0x93, 0x75, 0x34, 0xd8, 0x1c, 0xcd, 0x1f, 0xa8, 0xee, 0xc0, 0xb2, 0xef, 0xfb, 0x83,
-	0xad, 0xdf, 0xc4, 0x9e, 0x3e, 0x21, 0xf1, 0xb9, 0x0c, 0x55, 0x16, 0x41, 0xb3, 0x84, 0x82, 0x95,
-	0x0c, 0x60, 0x4f, 0x54, 0xda, 0xd4, 0xff, 0x50, 0xe0, 0x1c, 0x75, 0x9e, 0xf1, 0xa3, 0x99, 0x2c,
-	0x07, 0x86, 0xaa, 0xa8, 0x48, 0x6c, 0xe9, 0x43, 0x7e, 0x77, 0xa4, 0xa2, 0x45, 0xfa, 0x50, 0x27,
-	0x59, 0x88, 0x93, 0x26, 0xc8, 0xc1, 0x09, 0x29, 0x49, 0xc6, 0xe9, 0x01, 0x69, 0xbc, 0x02, 0x17,
-	0x38, 0xed, 0xc2, 0x2c, 0x4e, 0xfb, 0x29, 0xbc, 0x15, 0x5b, 0xe9, 0x1c, 0x1c, 0x55, 0xff, 0x42,
-	0x21, 0xec, 0x88, 0xdc, 0xc1, 0x99, 0x3d, 0x70, 0xbd, 0x28, 0xce, 0x84, 0xba, 0xa6, 0x11, 0x37,
-	0x22, 0x06, 0xfa, 0x14, 0x2a, 0x16, 0x3e, 0xee, 0x86, 0x63, 0xa1, 0x0c, 0x51, 0x7d, 0xd9, 0xc2,
-	0xc7, 0xf4, 0x97, 0xba, 0x05, 0xe7, 0x13, 0xa4, 0xce, 0xb3, 0xf6, 0xbf, 0x53, 0xe0, 0xc2, 0xba,
-	0x63, 0x8f, 0x3e, 0x37, 0x1d, 0x6f, 0xac, 0x0f, 0xa2, 0x67, 0xcf, 0xaf, 0xa7, 0xb2, 0xf5, 0x59,
-	0x28, 0x2a, 0x66, 0xf2, 0x73, 0x5b, 0xa2, 0x41, 0x49, 0xa2, 0xf8, 0xa2, 0x43, 0x31, 0xf4, 0xbf,
-	0xe7, 0x65, 0xc4, 0x73, 0xb8, 0x29, 0x71, 0x49, 0x96, 0x04, 0x43, 0x5a, 0x08, 0xcf, 0xcf, 0x5a,
-	0x08, 0x4f, 0x31, 0xef, 0x85, 0x57, 0x64, 0xde, 0x4f, 0x5d, 0x99, 0xf9, 0x0c, 0xa2, 0x87, 0x14,
-	0xd4, 0x3b, 0xcf, 0x74, 0xba, 0xb1, 0x0a, 0x10, 0x14, 0xec, 0xf9, 0x15, 0xca, 0x2c, 0x68, 0x42,
-	0xa3, 0x08, 0xb7, 0x84, 0x2b, 0xe5, 0x9e, 0x3e, 0x54, 0x42, 0xfe, 0x3e, 0xb4, 0x65, 0x52, 0x3a,
-	0x8f, 0xe4, 0xff, 0x3c, 0x07, 0xd0, 0x11, 0xb7, 0x6e, 0x67, 0xf3, 0x05, 0xd7, 0x20, 0x14, 0x8d,
-	0x04, 0xfa, 0x1e, 0x96, 0x22, 0x83, 0xa8, 0x84, 0xc8, 0x49, 0x09, 0x4c, 0x22, 0x4f, 0x35, 0x28,
-	0x9e, 0x90, 0xd6, 0x30, 0xa1, 0x88, 0x9b, 0xdf, 0xb7, 0xa1, 0xe2, 0xd8, 0xc7, 0x5d, 0xa2, 0x66,
-	0x86, 0x7f, 0xad, 0xd8, 0xb1, 0x8f, 0x89, 0xf2, 0x19, 0xe8, 0x3c, 0x2c, 0x78, 0xba, 0x7b, 0x48,
-	0xf0, 0xb3, 0xba, 0x51, 0x89, 0x34, 0x3b, 0x06, 0x3a, 0x07, 0xc5, 0x7d, 0x73, 0x80, 0xd9, 0x6d,
-	0x85, 0x8a, 0xc6, 0x1a, 0xe8, 0x5b, 0xfe, 0xfd, 0xb7, 0x72, 0xe6, 0x2b, 0x2e, 0x14, 0x5e, 0xfd,
-	0x5a, 0x81, 0xc5, 0x60, 0xd7, 0xa8, 0x01, 0x22, 0x36, 0x8d, 0xda, 0xb3, 0x35, 0xdb, 0x60, 0xa6,
-	0xa2, 0x91, 0xe2, 0x11, 0xd8, 0x40, 0x66, 0xb5, 0x82, 0x21, 0x93, 0xd2, 0x64, 0xb2, 0x2e, 0xb2,
-	0x68, 0xd3, 0xf0, 0x2f, 0xc9, 0x97, 0x1c, 0xfb, 0xb8, 0x63, 0x88, 0xdd, 0x60, 0x77, 0x86, 0x59,
-	0x52, 0x48, 0x76, 0x63, 0x8d, 0x5e, 0x1b, 0xbe, 0x06, 0x75, 0xec, 0x38, 0xb6, 0xd3, 0x1d, 0x62,
-	0xd7, 0xd5, 0xfb, 0x98, 0xc7, 0xe7, 0x35, 0xda, 0xb9, 0xc9, 0xfa, 0xd4, 0x3f, 0x29, 0x40, 0x23,
-	0x58, 0x8a, 0x7f, 0x4c, 0x6e, 0x1a, 0xfe, 0x31, 0xb9, 0x49, 0x58, 0x07, 0x0e, 0x33, 0x85, 0x82,
-	0xb9, 0xab, 0xb9, 0x96, 0xa2, 0x55, 0x78, 0x6f, 0xc7, 0x20, 0x6e, 0x99, 0x28, 0x99, 0x65, 0x1b,
-	0x38, 0x60, 0x2e, 0xf8, 0x5d, 0x9c, 0xb7, 0x11, 0x19, 0x29, 0x64, 0x90, 0x91, 0x62, 0x06, 0x19,
-	0x29, 0x49, 0x64, 0x64, 0x19, 0x4a, 0x7b, 0xe3, 0xde, 0x21, 0xf6, 0x78, 0xc4, 0xc6, 0x5b, 0x51,
-	0xd9, 0x29, 0xc7, 0x64, 0x47, 0x88, 0x48, 0x25, 0x2c, 0x22, 0x6f, 0x43, 0x85, 0x9d, 0xd7, 0x76,
-	0x3d, 0x97, 0x1e, 0x3e, 0xe5, 0xb5, 0x32, 0xeb, 0xd8, 0x75, 0xd1, 0x03, 0x3f, 0x9c, 0xab, 0xca,
-	0x94, 0x9d, 0x5a, 0x9d, 0x98, 0x94, 0xf8, 0xc1, 0xdc, 0x4d, 0x58, 0x0c, 0x6d, 0x07, 0xf5, 0x11,
-	0x35, 0x4a, 0x6a, 0x28, 0xda, 0xa7, 0x6e, 0xe2, 0x06, 0x34, 0x82, 0x2d, 0xa1, 0x70, 0x75, 0x96,
-	0x64, 0x89, 0x5e, 0x0a, 0x26, 0x24, 0xb9, 0x71, 0x3a, 0x49, 0x46, 0x17, 0xa0, 0xcc, 0xb3, 0x23,
-	0xb7, 0xb5, 0x18, 0x29, 0x56, 0xa8, 0x3f, 0x04, 0x14, 0x50, 0x3f, 0x5f, 0xb4, 0x18, 0x13, 0x8f,
-	0x5c, 0x5c, 0x3c, 0xd4, 0x9f, 0x2a, 0xb0, 0x14, 0x9e, 0x6c, 0x56, 0xc7, 0xfb, 0x29, 0x54, 0xd9,
-	0xf1, 0x5f, 0x97, 0x28, 0x3e, 0x2f, 0x02, 0x5d, 0x9c, 0xc8, 0x17, 0x0d, 0x82, 0x57, 0x07, 0x44,
-	0xbc, 0x8e, 0x6d, 0xe7, 0xd0, 0xb4, 0xfa, 0x5d, 0x42, 0x99, 0xaf, 0x6e, 0x35, 0xde, 0xb9, 0x45,
-	0xfa, 0xd4, 0xaf, 0x14, 0xb8, 0xf4, 0x6c, 0x64, 0xe8, 0x1e, 0x0e, 0x45, 0x20, 0xf3, 0xde, 0xf6,
-	0xfb, 0xd8, 0xbf, 0x6e, 0x97, 0xcb, 0x76, 0x84, 0xc5, 0xa0, 0xd5, 0xbf, 0x12, 0xb4, 0x24, 0xae,
-	0xc8, 0xce, 0x4e, 0x4b, 0x1b, 0xca, 0x47, 0x1c, 0x9d, 0xff, 0x8a, 0xc2, 0x6f, 0x47, 0x8e, 0x49,
-	0xf3, 0xa7, 0x3f, 0x26, 0x55, 0x37, 0xe1, 0x82, 0x86, 0x5d, 0x6c, 0x19, 0x91, 0xd5, 0xcc, 0x5c,
-	0x6c, 0x1a, 0x41, 0x5b, 0x86, 0x6e, 0x1e, 0x61, 0x65, 0xb1, 0x6b, 0xd7, 0x21, 0x68, 0x3d, 0x6e,
-	0x8a, 0x49, 0xc8, 0x44, 0xe7, 0xf1, 0xd4, 0xbf, 0xcc, 0xc1, 0xf9, 0x47, 0x86, 0xc1, 0xad, 0x38,
-	0x8f, 0xc6, 0x5e, 0x57, 0xa0, 0x1c, 0x0f, 0x24, 0xf3, 0xc9, 0x40, 0xf2, 0x55, 0x59, 0x56, 0xee,
-	0x63, 0xac, 0xf1, 0xd0, 0xf7, 0x9d, 0x0e, 0xbb, 0x3f, 0xf4, 0x90, 0x9f, 0x9b, 0x91, 0x84, 0x9e,
-	0xfa, 0xcf, 0xe9, 0xf1, 0x55, 0xd9, 0x2f, 0x9a, 0xa9, 0x23, 0x68, 0x25, 0x37, 0x6b, 0x4e, 0x53,
-	0xe2, 0xef, 0xc8, 0xc8, 0x66, 0x05, 0xd6, 0x1a, 0x09, 0xa1, 0x68, 0xd7, 0xb6, 0xed, 0xaa, 0xff,
-	0x99, 0x83, 0xd6, 0x8e, 0x7e, 0x84, 0xff, 0xef, 0x30, 0xe8, 0x0b, 0x38, 0xe7, 0xea, 0x47, 0xb8,
-	0x1b, 0x4a, 0x8c, 0xbb, 0x0e, 0x7e, 0xc1, 0x43, 0xd0, 0xf7, 0x64, 0x96, 0x44, 0x7a, 0xcd, 0x46,
-	0x5b, 0x72, 0x23, 0xfd, 0x1a, 0x7e, 0x81, 0xde, 0x85, 0xc5, 0xf0, 0x3d, 0x2e, 0x42, 0x5a, 0x99,
-	0x6e, 0x79, 0x3d, 0x74, 0x4d, 0xab, 0x63, 0xa8, 0x2f, 0xe0, 0x9d, 0x67, 0x96, 0x8b, 0xbd, 0x4e,
-	0x70, 0xd5, 0x68, 0xce, 0x14, 0xf2, 0x32, 0x54, 0x83, 0x8d, 0x4f, 0xbc, 0x9c, 0x30, 0x5c, 0xd5,
-	0x86, 0xf6, 0xa6, 0xee, 0x1c, 0xfa, 0x65, 0xe6, 0x75, 0x76, 0x25, 0xe4, 0x35, 0x4e, 0xb8, 0x2f,
-	0x6e, 0x48, 0x69, 0x78, 0x1f, 0x3b, 0xd8, 0xea, 0xe1, 0xa7, 0x76, 0xef, 0x90, 0x84, 0x1b, 0x1e,
-	0x7b, 0xc6, 0xa6, 0x84, 0x82, 0xce, 0xf5, 0xd0, 0x2b, 0xb5, 0x5c, 0xe4, 0x95, 0xda, 0x94, 0x57,
-	0x8f, 0xea, 0xcf, 0x72, 0xb0, 0xfc, 0x68, 0xe0, 0x61, 0x27, 0xc8, 0xfc, 0x4f, 0x53, 0xc4, 0x08,
-	0xaa, 0x0a, 0xb9, 0x19, 0xaa, 0x0a, 0x89, 0xeb, 0xe3, 0xf9, 0xe4, 0xf5, 0x71, 0x59, 0x0d, 0xa4,
-	0x30, 0x63, 0x0d, 0xe4, 0x11, 0xc0, 0xc8, 0xb1, 0x47, 0xd8, 0xf1, 0x4c, 0xec, 0xa7, 0x6f, 0x19,
-	0xc2, 0x97, 0xd0, 0x20, 0xf5, 0x0b, 0x68, 0x3e, 0xe9, 0xad, 0xd9, 0xd6, 0xbe, 0xe9, 0x0c, 0xfd,
-	0x8d, 0x4a, 0x28, 0x9d, 0x92, 0x41, 0xe9, 0x72, 0x09, 0xa5, 0x53, 0x4d, 0x58, 0x0a, 0xe1, 0x9e,
-	0xd3, 0x70, 0xf5, 0x7b, 0xdd, 0x7d, 0xd3, 0x32, 0xe9, 0x95, 0xab, 0x1c, 0x0d, 0x3f, 0xa1, 0xdf,
-	0xdb, 0xe0, 0x3d, 0xb7, 0x3e, 0x15, 0x97, 0x55, 0x77, 0x4f, 0x46, 0x18, 0x2d, 0x40, 0x7e, 0x0b,
-	0x1f, 0x37, 0xcf, 0x20, 0x80, 0xd2, 0x96, 0xed, 0x0c, 0xf5, 0x41, 0x53, 0x41, 0x55, 0x58, 0xe0,
-	0x67, 0x73, 0xcd, 0x1c, 0xaa, 0x43, 0x65, 0xcd, 0x3f, 0xdf, 0x68, 0xe6, 0x6f, 0xfd, 0xa9, 0x02,
-	0x4b, 0x89, 0xd3, 0x23, 0xd4, 0x00, 0x78, 0x66, 0xf5, 0xf8, 0xb1, 0x5a, 0xf3, 0x0c, 0xaa, 0x41,
-	0xd9, 0x3f, 0x64, 0x63, 0xf8, 0x76, 0x6d, 0x0a, 0xdd, 0xcc, 0xa1, 0x26, 0xd4, 0xd8, 0xc0, 0x71,
-	0xaf, 0x87, 0x5d, 0xb7, 0x99, 0x17, 0x3d, 0x1b, 0xba, 0x39, 0x18, 0x3b, 0xb8, 0x59, 0x20, 0x73,
-	0xee, 0xda, 0x1a, 0x1e, 0x60, 0xdd, 0xc5, 0xcd, 0x22, 0x42, 0xd0, 0xe0, 0x0d, 0x7f, 0x50, 0x29,
-	0xd4, 0xe7, 0x0f, 0x5b, 0xb8, 0xf5, 0x3c, 0x7c, 0x06, 0x40, 0x97, 0x77, 0x1e, 0xce, 0x3e, 0xb3,
-	0x0c, 0xbc, 0x6f, 0x5a, 0xd8, 0x08, 0x3e, 0x35, 0xcf, 0xa0, 0xb3, 0xb0, 0xb8, 0x89, 0x9d, 0x3e,
-	0x0e, 0x75, 0xe6, 0xd0, 0x12, 0xd4, 0x37, 0xcd, 0x97, 0xa1, 0xae, 0xbc, 0x5a, 0x28, 0x2b, 0x4d,
-	0xe5, 0xfe, 0x7f, 0x5d, 0x85, 0x0a, 0x91, 0xad, 0x35, 0xdb, 0x76, 0x0c, 0x34, 0x00, 0x44, 0xdf,
-	0x85, 0x0c, 0x47, 0xb6, 0x25, 0x1e, 0x92, 0xa1, 0x3b, 0x51, 0xf6, 0xf0, 0x46, 0x12, 0x90, 0xcb,
-	0x4e, 0xfb, 0xba, 0x14, 0x3e, 0x06, 0xac, 0x9e, 0x41, 0x43, 0x3a, 0xdb, 0xae, 0x39, 0xc4, 0xbb,
-	0x66, 0xef, 0xd0, 0x0f, 0x90, 0xee, 0xa5, 0x84, 0x43, 0x49, 0x50, 0x7f, 0xbe, 0x6b, 0xd2, 0xf9,
-	0xd8, 0xc3, 0x1d, 0x5f, 0xe6, 0xd4, 0x33, 0xe8, 0x05, 0x9c, 0x7b, 0x82, 0x43, 0xb1, 0xa6, 0x3f,
-	0xe1, 0xfd, 0xf4, 0x09, 0x13, 0xc0, 0xa7, 0x9c, 0xf2, 0x29, 0x14, 0xa9, 0xb8, 0x21, 0x59, 0x38,
-	0x1a, 0x7e, 0x0f, 0xde, 0xbe, 0x92, 0x0e, 0x20, 0xb0, 0xfd, 0x10, 0x16, 0x63, 0x2f, 0x45, 0x91,
-	0xcc, 0x39, 0xc9, 0xdf, 0xfc, 0xb6, 0x6f, 0x65, 0x01, 0x15, 0x73, 0xf5, 0xa1, 0x11, 0x7d, 0x4f,
-	0x82, 0x56, 0x32, 0x3c, 0x4d, 0x63, 0x33, 0xbd, 0x97, 0xf9, 0x11, 0x1b, 0x15, 0x82, 0x66, 0xfc,
-	0xe5, 0x22, 0xba, 0x35, 0x11, 0x41, 0x54, 0xd8, 0xde, 0xcf, 0x04, 0x2b, 0xa6, 0x3b, 0xa1, 0x42,
-	0x90, 0x78, 0x31, 0x16, 0x97, 0x71, 0x1f, 0x4d, 0xda, 0x53, 0xb6, 0xf6, 0xdd, 0xcc, 0xf0, 0x62,
-	0xea, 0xdf, 0x62, 0x97, 0x6f, 0x64, 0xaf, 0xae, 0xd0, 0x87, 0x72, 0x74, 0x13, 0x9e, 0x8b, 0xb5,
-	0xef, 0x9f, 0x66, 0x88, 0x20, 0xe2, 0xc7, 0xf4, 0xd6, 0x8c, 0xe4, 0xdd, 0x52, 0x5c, 0xef, 0x7c,
-	0x7c, 0xe9, 0x4f, 0xb2, 0xda, 0x1f, 0x9e, 0x62, 0x84, 0x20, 0xc0, 0x8e, 0x3f, 0x0d, 0xf5, 0xd5,
-	0xf0, 0xee, 0x54, 0xa9, 0x99, 0x4d, 0x07, 0x7f, 0x00, 0x8b, 0xb1, 0x70, 0x0d, 0x65, 0x0f, 0xe9,
-	0xda, 0x93, 0x5c, 0x13, 0x53, 0xc9, 0xd8, 0x25, 0x24, 0x94, 0x22, 0xfd, 0x92, 0x8b, 0x4a, 0xed,
-	0x5b, 0x59, 0x40, 0xc5, 0x42, 0x5c, 0x6a, 0x2e, 0x63, 0x57, 0x4b, 0xd0, 0x6d, 0x39, 0x0e, 0xf9,
-	0x15, 0x9a, 0xf6, 0x07, 0x19, 0xa1, 0xc5, 0xa4, 0x47, 0x70, 0x56, 0x72, 0x03, 0x08, 0x7d, 0x30,
-	0x91, 0x59, 0xf1, 0xab, 0x4f, 0xed, 0x3b, 0x59, 0xc1, 0xc5, 0xbc, 0xbf, 0x0e, 0x68, 0xe7, 0xc0,
-	0x3e, 0xa6, 0x91, 0x43, 0x7f, 0xec, 0xe8, 0x2c, 0xd8, 0x49, 0xf3, 0x0d, 0x49, 0xd0, 0x14, 0x19,
-	0x9d, 0x38, 0x42, 0x4c, 0xde, 0x05, 0x78, 0x82, 0xbd, 0x4d, 0xec, 0x39, 0x44, 0x31, 0xde, 0x4d,
-	0x73, 0x7f, 0x1c, 0xc0, 0x9f, 0xea, 0xe6, 0x54, 0xb8, 0x90, 0x2b, 0x6a, 0x6e, 0xea, 0xd6, 0x58,
-	0x1f, 0x84, 0x9e, 0x30, 0xdc, 0x96, 0x0e, 0x8f, 0x83, 0xa5, 0x30, 0x32, 0x15, 0x5a, 0x4c, 0x79,
-	0x2c, 0x5c, 0x7b, 0xe8, 0x44, 0x71, 0xb2, 0x6b, 0x4f, 0xde, 0x66, 0x89, 0x9b, 0xbd, 0x09, 0xf0,
-	0x62, 0xe2, 0x2f, 0x15, 0x7a, 0x89, 0x2c, 0x06, 0xf0, 0xdc, 0xf4, 0x0e, 0xb6, 0x07, 0xba, 0xe5,
-	0x66, 0x21, 0x81, 0x02, 0x9e, 0x82, 0x04, 0x0e, 0x2f, 0x48, 0x30, 0xa0, 0x1e, 0x39, 0xe8, 0x43,
-	0xb2, 0x3b, 0xff, 0xb2, 0x43, 0xcf, 0xf6, 0xca, 0x74, 0x40, 0x31, 0xcb, 0x01, 0xd4, 0x7d, 0x55,
-	0x62, 0x9b, 0xfb, 0x5e, 0x1a, 0xa5, 0x01, 0x4c, 0x8a, 0x25, 0x90, 0x83, 0x86, 0x2d, 0x41, 0xf2,
-	0x1c, 0x03, 0x65, 0x3b, 0xff, 0x9a, 0x64, 0x09, 0xd2, 0x0f, 0x47, 0x98, 0xa9, 0x8b, 0x9d, 0x19,
-	0xca, 0xed, 0xa8, 0xf4, 0x08, 0x54, 0x6a, 0xea, 0x52, 0x8e, 0x20, 0xd5, 0x33, 0xe8, 0x39, 0x94,
-	0xf8, 0x1f, 0x9d, 0x5c, 0x9f, 0x5c, 0x7b, 0xe4, 0xd8, 0x6f, 0x4c, 0x81, 0x12, 0x88, 0x0f, 0xe1,
-	0x7c, 0x4a, 0xe5, 0x51, 0xea, 0x82, 0x27, 0x57, 0x29, 0xa7, 0x39, 0x07, 0x31, 0x59, 0xa2, 0xb4,
-	0x38, 0x61, 0xb2, 0xb4, 0x32, 0xe4, 0xb4, 0xc9, 0xba, 0xb0, 0x94, 0xa8, 0xda, 0xa0, 0xf7, 0x53,
-	0x1c, 0x9d, 0xac, 0xb6, 0x33, 0x6d, 0x82, 0x3e, 0xbc, 0x25, 0xad, 0x50, 0x48, 0x1d, 0xf7, 0xa4,
-	0x5a, 0xc6, 0xb4, 0x89, 0x7a, 0x70, 0x56, 0x52, 0x97, 0x90, 0xba, 0x9c, 0xf4, 0xfa, 0xc5, 0xb4,
-	0x49, 0xf6, 0xa1, 0xbd, 0xea, 0xd8, 0xba, 0xd1, 0xd3, 0x5d, 0x8f, 0xd6, 0x0a, 0x48, 0x16, 0xe5,
-	0x47, 0x4e, 0xf2, 0xb0, 0x5a, 0x5a, 0x51, 0x98, 0x36, 0xcf, 0x1e, 0x54, 0x29, 0x2b, 0xd9, 0x5f,
-	0x50, 0x20, 0xb9, 0x8f, 0x08, 0x41, 0xa4, 0x18, 0x1e, 0x19, 0xa0, 0x10, 0xea, 0x5d, 0xa8, 0xae,
-	0xd1, 0x23, 0x95, 0x8e, 0x65, 0xe0, 0x97, 0x71, 0x7f, 0x45, 0x5f, 0xdf, 0xde, 0x09, 0x01, 0x64,
-	0xde, 0xa1, 0x3a, 0x0d, 0x68, 0x0d, 0xfc, 0x92, 0xf1, 0x79, 0x45, 0x86, 0x37, 0x02, 0x92, 0x92,
-	0x00, 0x48, 0x21, 0x43, 0x9e, 0xfe, 0x5c, 0x38, 0xcc, 0x13, 0xd3, 0xdd, 0x4d, 0x41, 0x92, 0x80,
-	0xf4, 0x67, 0xbd, 0x97, 0x7d, 0x40, 0xd8, 0x33, 0xf8, 0x74, 0x75, 0xe8, 0x79, 0xce, 0xcd, 0x49,
-	0xa4, 0x87, 0x63, 0xb7, 0x95, 0xe9, 0x80, 0x62, 0x96, 0x6d, 0xa8, 0x10, 0xe9, 0x64, 0xec, 0xb9,
-	0x2e, 0x1b, 0x28, 0x3e, 0x67, 0x67, 0xce, 0x3a, 0x76, 0x7b, 0x8e, 0xb9, 0xc7, 0x99, 0x2e, 0x25,
-	0x27, 0x02, 0x32, 0x91, 0x39, 0x31, 0x48, 0x41, 0xf9, 0x6f, 0xd0, 0x68, 0x9d, 0xf6, 0xae, 0x8e,
-	0xcd, 0x81, 0xb1, 0xed, 0xd8, 0x7d, 0xfa, 0xf2, 0xe5, 0xde, 0xa4, 0xe5, 0x47, 0x40, 0x53, 0x23,
-	0xb1, 0x09, 0x23, 0xc4, 0xfc, 0xbf, 0x0a, 0x15, 0x51, 0x3e, 0x42, 0xb2, 0x1b, 0x5b, 0xf1, 0xc2,
-	0x55, 0xfb, 0xfa, 0x64, 0x20, 0x1f, 0xf3, 0xfd, 0xaf, 0x2b, 0x50, 0xf6, 0x5f, 0xf9, 0x7c, 0xc3,
-	0x75, 0x8f, 0x37, 0x50, 0x88, 0xf8, 0x01, 0x2c, 0xc6, 0x5e, 0xdc, 0x4b, 0x6d, 0x9c, 0xfc, 0x55,
-	0xfe, 0x34, 0x61, 0x7c, 0xce, 0xff, 0x24, 0x4e, 0xe4, 0x24, 0x37, 0xd3, 0x8a, 0x19, 0xf1, 0x74,
-	0x64, 0x0a, 0xe2, 0xff, 0xdd, 0x49, 0xc0, 0x16, 0x40, 0x28, 0xfc, 0x9f, 0x7c, 0x17, 0x96, 0x44,
-	0xb4, 0xd3, 0x76, 0x6b, 0x28, 0x8d, 0xf0, 0xdf, 0xcb, 0x72, 0xaf, 0x30, 0x3d, 0x46, 0x4b, 0x8f,
-	0xeb, 0x9f, 0x41, 0x2d, 0x7c, 0x4b, 0x1d, 0x49, 0xff, 0x92, 0x2c, 0x79, 0x8d, 0x7d, 0xda, 0x2a,
-	0x36, 0x4f, 0x19, 0xfa, 0x4d, 0x41, 0xe7, 0x02, 0x4a, 0x9e, 0x6f, 0x4a, 0x43, 0xe5, 0xd4, 0x53,
-	0x55, 0x69, 0xa8, 0x9c, 0x7e, 0x68, 0xca, 0x6a, 0x5a, 0xf1, 0x43, 0x3b, 0x69, 0x4d, 0x2b, 0xe5,
-	0x18, 0x54, 0x5a, 0xd3, 0x4a, 0x3b, 0x05, 0x54, 0xcf, 0xac, 0x7e, 0xf4, 0xc5, 0x87, 0x7d, 0xd3,
-	0x3b, 0x18, 0xef, 0x91, 0xd5, 0xdf, 0x65, 0x43, 0x3f, 0x30, 0x6d, 0xfe, 0xeb, 0xae, 0x2f, 0xee,
-	0x77, 0x29, 0xb6, 0xbb, 0x04, 0xdb, 0x68, 0x6f, 0xaf, 0x44, 0x5b, 0x1f, 0xfd, 0x4f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x26, 0x21, 0x68, 0xba, 0x15, 0x53, 0x00, 0x00,
+	// 4738 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3c, 0x5b, 0x6c, 0x1c, 0x59,
+	0x56, 0x53, 0xfd, 0x72, 0xf7, 0xe9, 0x76, 0xbb, 0x7d, 0x93, 0x71, 0x3a, 0x9d, 0x4c, 0x26, 0xa9,
+	0xbc, 0x3c, 0x79, 0x38, 0x19, 0x0f, 0x23, 0x86, 0xcd, 0xce, 0x2c, 0xb1, 0x1d, 0x67, 0x7a, 0x88,
+	0xb3, 0xde, 0xb2, 0x33, 0x41, 0xb3, 0x88, 0xa6, 0xdc, 0x75, 0xdd, 0xae, 0x75, 0x77, 0x55, 0xa7,
+	0xaa, 0xda, 0x8e, 0x07, 0x89, 0x1d, 0x40, 0x42, 0x5a, 0x84, 0x00, 0x21, 0x21, 0xc4, 0x1f, 0xe2,
+	0x0b, 0x06, 0x2d, 0x42, 0x5a, 0xf8, 0x81, 0x0f, 0x7e, 0x11, 0x7c, 0xac, 0x10, 0x12, 0x9f, 0x7c,
+	0x02, 0xff, 0xfc, 0xf2, 0x81, 0xee, 0xa3, 0x6e, 0xbd, 0x6e, 0x75, 0x97, 0xbb, 0x93, 0x89, 0xc4,
+	0xfe, 0xf5, 0x3d, 0x75, 0xee, 0xb9, 0xaf, 0xf3, 0x3e, 0xf7, 0x36, 0x34, 0x0c, 0xdd, 0xd3, 0x3b,
+	0x5d, 0xdb, 0x76, 0x8c, 0x95, 0xa1, 0x63, 0x7b, 0x36, 0x5a, 0x1c, 0x98, 0xfd, 0xa3, 0x91, 0xcb,
+	0x5a, 0x2b, 0xe4, 0x73, 0xab, 0xd6, 0xb5, 0x07, 0x03, 0xdb, 0x62, 0xa0, 0x56, 0xdd, 0xb4, 0x3c,
+	0xec, 0x58, 0x7a, 0x9f, 0xb7, 0x6b, 0xe1, 0x0e, 0xad, 0x9a, 0xdb, 0x3d, 0xc0, 0x03, 0x9d, 0xb7,
+	0x16, 0x4d, 0xcb, 0xc0, 0x2f, 0xc3, 0xf4, 0xd5, 0x39, 0x28, 0x3e, 0x1a, 0x0c, 0xbd, 0x13, 0xf5,
+	0x6f, 0x15, 0xa8, 0x6d, 0xf6, 0x47, 0xee, 0x81, 0x86, 0x5f, 0x8c, 0xb0, 0xeb, 0xa1, 0xfb, 0x50,
+	0xd8, 0xd3, 0x5d, 0xdc, 0x54, 0x2e, 0x2b, 0xcb, 0xd5, 0xd5, 0x8b, 0x2b, 0x91, 0x89, 0xf0, 0x29,
+	0x6c, 0xb9, 0xbd, 0x35, 0xdd, 0xc5, 0x1a, 0xc5, 0x44, 0x08, 0x0a, 0xc6, 0x5e, 0x7b, 0xa3, 0x99,
+	0xbb, 0xac, 0x2c, 0xe7, 0x35, 0xfa, 0x1b, 0x5d, 0x02, 0x70, 0x71, 0x6f, 0x80, 0x2d, 0xaf, 0xbd,
+	0xe1, 0x36, 0xf3, 0x97, 0xf3, 0xcb, 0x79, 0x2d, 0x04, 0x41, 0x2a, 0xd4, 0xba, 0x76, 0xbf, 0x8f,
+	0xbb, 0x9e, 0x69, 0x5b, 0xed, 0x8d, 0x66, 0x81, 0xf6, 0x8d, 0xc0, 0x50, 0x0b, 0xca, 0xa6, 0xdb,
+	0x1e, 0x0c, 0x6d, 0xc7, 0x6b, 0x16, 0x2f, 0x2b, 0xcb, 0x65, 0x4d, 0xb4, 0xd5, 0xff, 0x54, 0x60,
+	0x9e, 0x4f, 0xdb, 0x1d, 0xda, 0x96, 0x8b, 0xd1, 0x07, 0x50, 0x72, 0x3d, 0xdd, 0x1b, 0xb9, 0x7c,
+	0xe6, 0x17, 0xa4, 0x33, 0xdf, 0xa1, 0x28, 0x1a, 0x47, 0x95, 0x4e, 0x3d, 0x3e, 0xb5, 0xbc, 0x64,
+	0x6a, 0xd1, 0xe5, 0x15, 0x12, 0xcb, 0x5b, 0x86, 0x85, 0x7d, 0x32, 0xbb, 0x9d, 0x00, 0xa9, 0x48,
+	0x91, 0xe2, 0x60, 0x42, 0xc9, 0x33, 0x07, 0xf8, 0xbb, 0xfb, 0x3b, 0x58, 0xef, 0x37, 0x4b, 0x74,
+	0xac, 0x10, 0x44, 0xfd, 0x57, 0x05, 0x1a, 0x02, 0xdd, 0x3f, 0xa3, 0xb3, 0x50, 0xec, 0xda, 0x23,
+	0xcb, 0xa3, 0x4b, 0x9d, 0xd7, 0x58, 0x03, 0x5d, 0x81, 0x5a, 0xf7, 0x40, 0xb7, 0x2c, 0xdc, 0xef,
+	0x58, 0xfa, 0x00, 0xd3, 0x45, 0x55, 0xb4, 0x2a, 0x87, 0x3d, 0xd5, 0x07, 0x38, 0xd3, 0xda, 0x2e,
+	0x43, 0x75, 0xa8, 0x3b, 0x9e, 0x19, 0x39, 0x99, 0x30, 0x68, 0xdc, 0xc1, 0x90, 0x11, 0x4c, 0xfa,
+	0x6b, 0x57, 0x77, 0x0f, 0xdb, 0x1b, 0x7c, 0x45, 0x11, 0x98, 0xfa, 0x67, 0x0a, 0x2c, 0x3d, 0x74,
+	0x5d, 0xb3, 0x67, 0x25, 0x56, 0xb6, 0x04, 0x25, 0xcb, 0x36, 0x70, 0x7b, 0x83, 0x2e, 0x2d, 0xaf,
+	0xf1, 0x16, 0xba, 0x00, 0x95, 0x21, 0xc6, 0x4e, 0xc7, 0xb1, 0xfb, 0xfe, 0xc2, 0xca, 0x04, 0xa0,
+	0xd9, 0x7d, 0x8c, 0xbe, 0x07, 0x8b, 0x6e, 0x8c, 0x10, 0xe3, 0xb9, 0xea, 0xea, 0xd5, 0x95, 0x84,
+	0x20, 0xad, 0xc4, 0x07, 0xd5, 0x92, 0xbd, 0xd5, 0xaf, 0x72, 0x70, 0x46, 0xe0, 0xb1, 0xb9, 0x92,
+	0xdf, 0x64, 0xe7, 0x5d, 0xdc, 0x13, 0xd3, 0x63, 0x8d, 0x2c, 0x3b, 0x2f, 0x8e, 0x2c, 0x1f, 0x3e,
+	0xb2, 0x2c, 0x62, 0x10, 0x3b, 0x8f, 0x62, 0xf2, 0x3c, 0xde, 0x85, 0x2a, 0x7e, 0x39, 0x34, 0x1d,
+	0xdc, 0x21, 0x8c, 0x43, 0xb7, 0xbc, 0xa0, 0x01, 0x03, 0xed, 0x9a, 0x83, 0xb0, 0x6c, 0xcc, 0x65,
+	0x96, 0x0d, 0xf5, 0xcf, 0x15, 0x38, 0x97, 0x38, 0x25, 0x2e, 0x6c, 0x1a, 0x34, 0xe8, 0xca, 0x83,
+	0x9d, 0x21, 0x62, 0x47, 0x36, 0xfc, 0xc6, 0xb8, 0x0d, 0x0f, 0xd0, 0xb5, 0x44, 0xff, 0xd0, 0x24,
+	0x73, 0xd9, 0x27, 0x79, 0x08, 0xe7, 0x1e, 0x63, 0x8f, 0x0f, 0x40, 0xbe, 0x61, 0x77, 0x7a, 0x45,
+	0x16, 0x95, 0xea, 0x5c, 0x5c, 0xaa, 0xd5, 0xbf, 0xc9, 0x41, 0x23, 0x3c, 0x54, 0xdb, 0xda, 0xb7,
+	0xd1, 0x45, 0xa8, 0x08, 0x14, 0xce, 0x15, 0x01, 0x00, 0xfd, 0x3c, 0x14, 0xc9, 0x4c, 0x19, 0x4b,
+	0xd4, 0x57, 0xaf, 0xc8, 0xd7, 0x14, 0xa2, 0xa9, 0x31, 0x7c, 0xd4, 0x86, 0xba, 0xeb, 0xe9, 0x8e,
+	0xd7, 0x19, 0xda, 0x2e, 0x3d, 0x67, 0xca, 0x38, 0xd5, 0x55, 0x35, 0x4a, 0x41, 0x58, 0x81, 0x2d,
+	0xb7, 0xb7, 0xcd, 0x31, 0xb5, 0x79, 0xda, 0xd3, 0x6f, 0xa2, 0x47, 0x50, 0xc3, 0x96, 0x11, 0x10,
+	0x2a, 0x64, 0x26, 0x54, 0xc5, 0x96, 0x21, 0xc8, 0x04, 0xe7, 0x53, 0xcc, 0x7e, 0x3e, 0xbf, 0xa7,
+	0x40, 0x33, 0x79, 0x40, 0xb3, 0xa8, 0xec, 0x07, 0xac, 0x13, 0x66, 0x07, 0x34, 0x56, 0xc2, 0xc5,
+	0x21, 0x69, 0xbc, 0x8b, 0xfa, 0xc7, 0x0a, 0xbc, 0x1d, 0x4c, 0x87, 0x7e, 0x7a, 0x5d, 0xdc, 0x82,
+	0x6e, 0x41, 0xc3, 0xb4, 0xba, 0xfd, 0x91, 0x81, 0x9f, 0x59, 0x9f, 0x62, 0xbd, 0xef, 0x1d, 0x9c,
+	0xd0, 0x33, 0x2c, 0x6b, 0x09, 0xb8, 0xfa, 0x1f, 0x39, 0x58, 0x8a, 0xcf, 0x6b, 0x96, 0x4d, 0xfa,
+	0x39, 0x28, 0x9a, 0xd6, 0xbe, 0xed, 0xef, 0xd1, 0xa5, 0x31, 0x42, 0x49, 0xc6, 0x62, 0xc8, 0xc8,
+	0x06, 0xe4, 0xab, 0xb1, 0xee, 0x01, 0xee, 0x1e, 0x0e, 0x6d, 0x93, 0x2a, 0x2c, 0x42, 0xe2, 0x17,
+	0x25, 0x24, 0xe4, 0x33, 0x5e, 0x59, 0x67, 0x34, 0xd6, 0x05, 0x89, 0x47, 0x96, 0xe7, 0x9c, 0x68,
+	0x8b, 0xdd, 0x38, 0xbc, 0x75, 0x00, 0x4b, 0x72, 0x64, 0xd4, 0x80, 0xfc, 0x21, 0x3e, 0xa1, 0x4b,
+	0xae, 0x68, 0xe4, 0x27, 0xfa, 0x08, 0x8a, 0x47, 0x7a, 0x7f, 0x84, 0x9b, 0xb9, 0xcc, 0xec, 0xcb,
+	0x3a, 0x7c, 0x2b, 0xf7, 0x91, 0xa2, 0x0e, 0xe0, 0xc2, 0x63, 0xec, 0xb5, 0x2d, 0x17, 0x3b, 0xde,
+	0x9a, 0x69, 0xf5, 0xed, 0xde, 0xb6, 0xee, 0x1d, 0xcc, 0xa0, 0x2b, 0x22, 0x62, 0x9f, 0x8b, 0x89,
+	0xbd, 0xfa, 0x17, 0x0a, 0x5c, 0x94, 0x8f, 0xc7, 0x4f, 0xb5, 0x05, 0xe5, 0x7d, 0x13, 0xf7, 0x8d,
+	0xf6, 0x06, 0x53, 0x9c, 0x79, 0x4d, 0xb4, 0x89, 0xce, 0x18, 0x12, 0x64, 0x7e, 0x78, 0x57, 0x52,
+	0x56, 0xba, 0xe3, 0x39, 0xa6, 0xd5, 0x7b, 0x62, 0xba, 0x9e, 0xc6, 0xf0, 0x43, 0xac, 0x92, 0xcf,
+	0x2e, 0xa1, 0xbf, 0xab, 0xc0, 0xa5, 0xc7, 0xd8, 0x5b, 0x17, 0x26, 0x87, 0x7c, 0x37, 0x5d, 0xcf,
+	0xec, 0xba, 0xaf, 0xd6, 0x25, 0xcc, 0xe0, 0x7b, 0xa8, 0x7f, 0xa0, 0xc0, 0xbb, 0xa9, 0x93, 0xe1,
+	0x5b, 0xc7, 0x55, 0xaa, 0x6f, 0x70, 0xe4, 0x2a, 0xf5, 0x97, 0xf0, 0xc9, 0xe7, 0xe4, 0xf0, 0xb7,
+	0x75, 0xd3, 0x61, 0x2a, 0x75, 0x4a, 0x03, 0xf3, 0x63, 0x05, 0xde, 0x79, 0x8c, 0xbd, 0x6d, 0xdf,
+	0xdc, 0xbe, 0xc1, 0xdd, 0x21, 0x38, 0x21, 0xb3, 0xef, 0xfb, 0x9d, 0x11, 0x98, 0xfa, 0xfb, 0xec,
+	0x38, 0xa5, 0xf3, 0x7d, 0x23, 0x1b, 0x78, 0x09, 0x2e, 0x46, 0xf5, 0x04, 0x97, 0x78, 0xbe, 0x7d,
+	0xea, 0x8f, 0x8a, 0x50, 0xfb, 0x9c, 0xab, 0x06, 0xf2, 0x39, 0xb1, 0x13, 0x8a, 0xdc, 0x27, 0x0a,
+	0x39, 0x57, 0x32, 0x7f, 0xeb, 0x31, 0xcc, 0xbb, 0x18, 0x1f, 0x4e, 0x63, 0x3e, 0x6b, 0xa4, 0xa3,
+	0xdf, 0x42, 0x4f, 0x60, 0x71, 0x64, 0x51, 0xaf, 0x1d, 0x1b, 0x7c, 0x15, 0x6c, 0xe7, 0x27, 0xab,
+	0xd5, 0x64, 0x47, 0xf4, 0x29, 0x2c, 0xc4, 0x40, 0xcd, 0x62, 0x26, 0x5a, 0xf1, 0x6e, 0xa8, 0x0d,
+	0x0d, 0xc3, 0xb1, 0x87, 0x43, 0x6c, 0x74, 0x5c, 0x9f, 0x54, 0x29, 0x1b, 0x29, 0xde, 0x4f, 0x90,
+	0xba, 0x0f, 0x67, 0xe2, 0x33, 0x6d, 0x1b, 0xc4, 0x57, 0x24, 0xec, 0x25, 0xfb, 0x84, 0xee, 0xc0,
+	0x62, 0x12, 0xbf, 0x4c, 0xf1, 0x93, 0x1f, 0xd0, 0x5d, 0x40, 0xb1, 0xa9, 0x12, 0xf4, 0x0a, 0x43,
+	0x8f, 0x4e, 0x86, 0xa3, 0xd3, 0x80, 0x35, 0x8a, 0x0e, 0x0c, 0x9d, 0x7f, 0x09, 0xa1, 0xb7, 0xa1,
+	0xc1, 0x81, 0xc1, 0x46, 0x54, 0xb3, 0x6d, 0x44, 0x94, 0x98, 0xab, 0xfe, 0x48, 0x81, 0xa5, 0xe7,
+	0xba, 0xd7, 0x3d, 0xd8, 0x18, 0x70, 0x2e, 0x9d, 0x41, 0xca, 0x3f, 0x86, 0xca, 0x11, 0xe7, 0x48,
+	0x5f, 0x95, 0xbf, 0x2b, 0x99, 0x50, 0x98, 0xf7, 0xb5, 0xa0, 0x07, 0x09, 0x92, 0xce, 0x6e, 0x86,
+	0x82, 0xc5, 0x37, 0xa0, 0x6f, 0x26, 0x44, 0xb9, 0xea, 0x4b, 0x00, 0x3e, 0xb9, 0x2d, 0xb7, 0x37,
+	0xc5, 0xbc, 0x3e, 0x82, 0x39, 0x4e, 0x8d, 0x2b, 0x94, 0x49, 0x07, 0xe6, 0xa3, 0xab, 0x5f, 0x97,
+	0xa0, 0x1a, 0xfa, 0x80, 0xea, 0x90, 0x13, 0x9a, 0x22, 0x27, 0x59, 0x5d, 0x6e, 0x72, 0x5c, 0x95,
+	0x4f, 0xc6, 0x55, 0xd7, 0xa1, 0x6e, 0x52, 0x0b, 0xde, 0xe1, 0xa7, 0x42, 0x5d, 0xe7, 0x8a, 0x36,
+	0xcf, 0xa0, 0x9c, 0x45, 0xd0, 0x25, 0xa8, 0x5a, 0xa3, 0x41, 0xc7, 0xde, 0xef, 0x38, 0xf6, 0xb1,
+	0xcb, 0x03, 0xb4, 0x8a, 0x35, 0x1a, 0x7c, 0x77, 0x5f, 0xb3, 0x8f, 0xdd, 0x20, 0x06, 0x28, 0x9d,
+	0x32, 0x06, 0xb8, 0x04, 0xd5, 0x81, 0xfe, 0x92, 0x50, 0xed, 0x58, 0xa3, 0x01, 0x8d, 0xdd, 0xf2,
+	0x5a, 0x65, 0xa0, 0xbf, 0xd4, 0xec, 0xe3, 0xa7, 0xa3, 0x01, 0x5a, 0x86, 0x46, 0x5f, 0x77, 0xbd,
+	0x4e, 0x38, 0xf8, 0x2b, 0xd3, 0xe0, 0xaf, 0x4e, 0xe0, 0x8f, 0x82, 0x00, 0x30, 0x19, 0x4d, 0x54,
+	0x66, 0x88, 0x26, 0x8c, 0x41, 0x3f, 0x20, 0x04, 0xd9, 0xa3, 0x09, 0x63, 0xd0, 0x17, 0x64, 0x3e,
+	0x82, 0xb9, 0x3d, 0xea, 0x17, 0x8d, 0x13, 0xd6, 0x4d, 0xe2, 0x12, 0x31, 0xf7, 0x49, 0xf3, 0xd1,
+	0xd1, 0xb7, 0xa1, 0x42, 0xcd, 0x11, 0xed, 0x5b, 0xcb, 0xd4, 0x37, 0xe8, 0x40, 0x7a, 0x1b, 0xb8,
+	0xef, 0xe9, 0xb4, 0xf7, 0x7c, 0xb6, 0xde, 0xa2, 0x03, 0xd1, 0x94, 0x5d, 0x07, 0xeb, 0x1e, 0x36,
+	0xd6, 0x4e, 0xd6, 0xed, 0xc1, 0x50, 0xa7, 0xcc, 0xd4, 0xac, 0x53, 0xb7, 0x5e, 0xf6, 0x09, 0xdd,
+	0x80, 0x7a, 0x57, 0xb4, 0x36, 0x1d, 0x7b, 0xd0, 0x5c, 0xa0, 0x72, 0x14, 0x83, 0xa2, 0x77, 0x00,
+	0x7c, 0x1d, 0xa9, 0x7b, 0xcd, 0x06, 0x3d, 0xc5, 0x0a, 0x87, 0x3c, 0xa4, 0xb9, 0x1d, 0xd3, 0xed,
+	0xb0, 0x2c, 0x8a, 0x69, 0xf5, 0x9a, 0x8b, 0x74, 0xc4, 0xaa, 0x9f, 0x76, 0x31, 0xad, 0x1e, 0x3a,
+	0x07, 0x73, 0xa6, 0xdb, 0xd9, 0xd7, 0x0f, 0x71, 0x13, 0xd1, 0xaf, 0x25, 0xd3, 0xdd, 0xd4, 0x0f,
+	0xb1, 0xfa, 0x43, 0x38, 0x1b, 0x70, 0x57, 0xe8, 0x24, 0x93, 0x4c, 0xa1, 0x4c, 0xcb, 0x14, 0xe3,
+	0xbd, 0xe1, 0x9f, 0x16, 0x60, 0x69, 0x47, 0x3f, 0xc2, 0xaf, 0xdf, 0xf1, 0xce, 0xa4, 0xd6, 0x9e,
+	0xc0, 0x22, 0xf5, 0xb5, 0x57, 0x43, 0xf3, 0x69, 0x16, 0x32, 0xb1, 0x42, 0xb2, 0x23, 0xfa, 0x0e,
+	0x71, 0x45, 0x70, 0xf7, 0x70, 0xdb, 0x36, 0x03, 0x6b, 0xfe, 0x8e, 0x84, 0xce, 0xba, 0xc0, 0xd2,
+	0xc2, 0x3d, 0xd0, 0x36, 0x2c, 0x44, 0x8f, 0xc1, 0xb7, 0xe3, 0x37, 0xc7, 0x46, 0xb6, 0xc1, 0xee,
+	0x6b, 0xf5, 0xc8, 0x61, 0xb8, 0xa8, 0x09, 0x73, 0xdc, 0x08, 0x53, 0x9d, 0x51, 0xd6, 0xfc, 0x26,
+	0xda, 0x86, 0x33, 0x6c, 0x05, 0x3b, 0x5c, 0x20, 0xd8, 0xe2, 0xcb, 0x99, 0x16, 0x2f, 0xeb, 0x1a,
+	0x95, 0xa7, 0xca, 0x69, 0xe5, 0xa9, 0x09, 0x73, 0x9c, 0xc7, 0xa9, 0x1e, 0x29, 0x6b, 0x7e, 0x93,
+	0x1c, 0x73, 0xc0, 0xed, 0x55, 0xfa, 0x2d, 0x00, 0x90, 0xa0, 0x05, 0x82, 0xfd, 0x9c, 0x90, 0x83,
+	0xf9, 0x04, 0xca, 0x82, 0xc3, 0xb3, 0x07, 0x8f, 0xa2, 0x4f, 0x5c, 0xbf, 0xe7, 0x63, 0xfa, 0x5d,
+	0xfd, 0x17, 0x05, 0x6a, 0x1b, 0x64, 0x49, 0x4f, 0xec, 0x1e, 0xb5, 0x46, 0xd7, 0xa1, 0xee, 0xe0,
+	0xae, 0xed, 0x18, 0x1d, 0x6c, 0x79, 0x8e, 0x89, 0x59, 0xe8, 0x5e, 0xd0, 0xe6, 0x19, 0xf4, 0x11,
+	0x03, 0x12, 0x34, 0xa2, 0xb2, 0x5d, 0x4f, 0x1f, 0x0c, 0x3b, 0xfb, 0x44, 0x35, 0xe4, 0x18, 0x9a,
+	0x80, 0x52, 0xcd, 0x70, 0x05, 0x6a, 0x01, 0x9a, 0x67, 0xd3, 0xf1, 0x0b, 0x5a, 0x55, 0xc0, 0x76,
+	0x6d, 0x74, 0x0d, 0xea, 0x74, 0x4f, 0x3b, 0x7d, 0xbb, 0xd7, 0x21, 0xb1, 0x20, 0x37, 0x54, 0x35,
+	0x83, 0x4f, 0x8b, 0x9c, 0x55, 0x14, 0xcb, 0x35, 0xbf, 0xc4, 0xdc, 0x54, 0x09, 0xac, 0x1d, 0xf3,
+	0x4b, 0xac, 0xfe, 0xb3, 0x02, 0xf3, 0x1b, 0xba, 0xa7, 0x3f, 0xb5, 0x0d, 0xbc, 0x3b, 0xa5, 0x61,
+	0xcf, 0x90, 0x0f, 0xbd, 0x08, 0x15, 0xb1, 0x02, 0xbe, 0xa4, 0x00, 0x80, 0x36, 0xa1, 0xee, 0xfb,
+	0x72, 0x1d, 0x16, 0xab, 0x14, 0x52, 0x1d, 0xa8, 0x90, 0xe5, 0x74, 0xb5, 0x79, 0xbf, 0x1b, 0x6d,
+	0xaa, 0x9b, 0x50, 0x0b, 0x7f, 0x26, 0xa3, 0xee, 0xc4, 0x19, 0x45, 0x00, 0x08, 0x37, 0x3e, 0x1d,
+	0x0d, 0xc8, 0x99, 0x72, 0xc5, 0xe2, 0x37, 0xd5, 0xdf, 0x56, 0x60, 0x9e, 0x9b, 0xfb, 0x1d, 0x51,
+	0x39, 0xa0, 0x4b, 0x63, 0x19, 0x0a, 0xfa, 0x1b, 0x7d, 0x2b, 0x9a, 0xec, 0xbb, 0x26, 0x55, 0x02,
+	0x94, 0x08, 0x75, 0x32, 0x23, 0xb6, 0x3e, 0x4b, 0x74, 0xfc, 0x15, 0x61, 0x34, 0x7e, 0x34, 0x94,
+	0xd1, 0x9a, 0x30, 0xa7, 0x1b, 0x86, 0x83, 0x5d, 0x97, 0xcf, 0xc3, 0x6f, 0x92, 0x2f, 0x47, 0xd8,
+	0x71, 0x7d, 0x96, 0xcf, 0x6b, 0x7e, 0x13, 0x7d, 0x1b, 0xca, 0xc2, 0x2b, 0x65, 0xa9, 0x9d, 0xcb,
+	0xe9, 0xf3, 0xe4, 0xb1, 0x9c, 0xe8, 0xa1, 0xfe, 0x5d, 0x0e, 0xea, 0x7c, 0xc3, 0xd6, 0xb8, 0x3d,
+	0x1e, 0x2f, 0x7c, 0x6b, 0x50, 0xdb, 0x0f, 0x64, 0x7f, 0x5c, 0x42, 0x2a, 0xac, 0x22, 0x22, 0x7d,
+	0x26, 0x09, 0x60, 0xd4, 0x23, 0x28, 0xcc, 0xe4, 0x11, 0x14, 0x4f, 0xab, 0xc1, 0x92, 0x3e, 0x62,
+	0x49, 0xe2, 0x23, 0xaa, 0xbf, 0x02, 0xd5, 0x10, 0x01, 0xaa, 0xa1, 0x59, 0xba, 0x87, 0xef, 0x98,
+	0xdf, 0x44, 0x1f, 0x04, 0x7e, 0x11, 0xdb, 0xaa, 0xf3, 0x92, 0xb9, 0xc4, 0x5c, 0x22, 0xf5, 0x1f,
+	0x15, 0x28, 0x71, 0xca, 0xa4, 0x16, 0xc0, 0xf4, 0x0b, 0xf5, 0x19, 0x19, 0x75, 0xe0, 0x20, 0xe2,
+	0x34, 0xbe, 0x3a, 0xad, 0x73, 0x1e, 0xca, 0x31, 0x7d, 0x33, 0xc7, 0xcd, 0x82, 0xff, 0x29, 0xa4,
+	0x64, 0xe6, 0xfa, 0x4c, 0xbf, 0x90, 0x42, 0x48, 0xdf, 0xee, 0x89, 0xca, 0x10, 0x6b, 0xa8, 0xff,
+	0xa4, 0xd0, 0x44, 0xbe, 0x86, 0xbb, 0xf6, 0x11, 0x76, 0x4e, 0x66, 0xcf, 0x80, 0x3e, 0x08, 0xb1,
+	0x79, 0xc6, 0xe0, 0x4b, 0x74, 0x40, 0x0f, 0x82, 0x43, 0xc8, 0xcb, 0x72, 0x24, 0x61, 0xbd, 0xc3,
+	0x99, 0x34, 0x38, 0x8c, 0x3f, 0x54, 0x60, 0x29, 0xb1, 0x94, 0x69, 0xbd, 0x9d, 0x57, 0x12, 0xc8,
+	0xa8, 0x3f, 0x55, 0xa0, 0x15, 0x24, 0x61, 0xdc, 0xb5, 0x93, 0x59, 0x2b, 0x25, 0xaf, 0x26, 0xbe,
+	0xfa, 0x05, 0x91, 0xca, 0x27, 0x42, 0x9b, 0x29, 0x32, 0xe2, 0x1d, 0x54, 0x8b, 0xe6, 0x73, 0x93,
+	0x0b, 0x9a, 0x85, 0x65, 0x5a, 0x50, 0x16, 0x09, 0x04, 0x96, 0xce, 0x17, 0x6d, 0x22, 0x61, 0xe7,
+	0x1f, 0x63, 0x6f, 0x33, 0x9a, 0x84, 0x79, 0xd3, 0x1b, 0x18, 0x2e, 0x31, 0x1c, 0xf0, 0x12, 0x43,
+	0x21, 0x56, 0x62, 0xe0, 0x70, 0x75, 0x00, 0x2d, 0xd9, 0x02, 0x5e, 0xd7, 0x86, 0xfd, 0x8e, 0x02,
+	0x4d, 0x3e, 0x0a, 0x1d, 0x93, 0x84, 0x44, 0x7d, 0xec, 0x61, 0xe3, 0x9b, 0x4e, 0x15, 0xfc, 0xaf,
+	0x02, 0x8d, 0xb0, 0xd5, 0x25, 0x5f, 0xd1, 0x87, 0x50, 0xa4, 0x99, 0x16, 0x3e, 0x83, 0x89, 0xaa,
+	0x81, 0x61, 0x13, 0xb5, 0x4d, 0x5d, 0xed, 0x5d, 0xe1, 0x20, 0xf0, 0x66, 0x60, 0xfa, 0xf3, 0xa7,
+	0x37, 0xfd, 0xdc, 0x15, 0xb2, 0x47, 0x84, 0x2e, 0xab, 0x00, 0x07, 0x00, 0xf4, 0x31, 0x94, 0xd8,
+	0x65, 0x0e, 0x5e, 0x76, 0xbb, 0x1e, 0x25, 0xcd, 0xbe, 0xad, 0x84, 0x32, 0xe6, 0x14, 0xa0, 0xf1,
+	0x4e, 0xea, 0x67, 0xb0, 0x14, 0x44, 0xa3, 0x6c, 0xd8, 0x69, 0x99, 0x56, 0xfd, 0x77, 0x05, 0xce,
+	0xec, 0x9c, 0x58, 0xdd, 0x38, 0xfb, 0x2f, 0x41, 0x69, 0xd8, 0xd7, 0x83, 0x5c, 0x2d, 0x6f, 0x51,
+	0x37, 0x90, 0x8d, 0x8d, 0x0d, 0x62, 0x43, 0xd8, 0x9e, 0x55, 0x05, 0x6c, 0xd7, 0x9e, 0x68, 0xda,
+	0xaf, 0x8b, 0xf0, 0x19, 0x1b, 0xcc, 0x5a, 0xb1, 0x34, 0xd4, 0xbc, 0x80, 0x52, 0x6b, 0xf5, 0x31,
+	0x00, 0x35, 0xe8, 0x9d, 0xd3, 0x18, 0x71, 0xda, 0xe3, 0x09, 0x51, 0xd9, 0x3f, 0xc9, 0x41, 0x33,
+	0xb4, 0x4b, 0xdf, 0xb4, 0x7f, 0x93, 0x12, 0x95, 0xe5, 0x5f, 0x51, 0x54, 0x56, 0x98, 0xdd, 0xa7,
+	0x29, 0xca, 0x7c, 0x9a, 0xdf, 0xcc, 0x43, 0x3d, 0xd8, 0xb5, 0xed, 0xbe, 0x6e, 0xa5, 0x72, 0xc2,
+	0x8e, 0xf0, 0xe7, 0xa3, 0xfb, 0x74, 0x5b, 0x26, 0x27, 0x29, 0x07, 0xa1, 0xc5, 0x48, 0x90, 0x94,
+	0x09, 0x0b, 0x9c, 0x69, 0xe2, 0x8b, 0xc7, 0x10, 0x4c, 0x20, 0x49, 0xce, 0xeb, 0x0e, 0x20, 0x2e,
+	0x45, 0x1d, 0xd3, 0xea, 0xb8, 0xb8, 0x6b, 0x5b, 0x06, 0x93, 0xaf, 0xa2, 0xd6, 0xe0, 0x5f, 0xda,
+	0xd6, 0x0e, 0x83, 0xa3, 0x0f, 0xa1, 0xe0, 0x9d, 0x0c, 0x99, 0xb7, 0x52, 0x5f, 0xbd, 0x32, 0x76,
+	0x5e, 0xbb, 0x27, 0x43, 0xac, 0x51, 0x74, 0xff, 0xfa, 0x8e, 0xe7, 0xe8, 0x47, 0xdc, 0xf5, 0x2b,
+	0x68, 0x21, 0x08, 0xd1, 0x18, 0xfe, 0x1e, 0xce, 0x31, 0x17, 0x89, 0x37, 0x19, 0x67, 0xfb, 0x42,
+	0xdb, 0xf1, 0xbc, 0x3e, 0x4d, 0xdd, 0x51, 0xce, 0xf6, 0xa1, 0xbb, 0x5e, 0x9f, 0x2c, 0xd2, 0xb3,
+	0x3d, 0xbd, 0xcf, 0xe4, 0xa3, 0xc2, 0xb5, 0x03, 0x81, 0xd0, 0xc0, 0xe4, 0xdf, 0x72, 0xd0, 0x08,
+	0x26, 0xa6, 0x61, 0x77, 0xd4, 0x4f, 0x97, 0xc7, 0xf1, 0xa9, 0x93, 0x49, 0xa2, 0xf8, 0x1d, 0xa8,
+	0x72, 0xae, 0x38, 0x05, 0x57, 0x01, 0xeb, 0xf2, 0x64, 0x0c, 0x9b, 0x17, 0x5f, 0x11, 0x9b, 0x97,
+	0xa6, 0x48, 0x3e, 0xc8, 0xcf, 0x86, 0x94, 0x6f, 0xdf, 0x4e, 0x68, 0xcd, 0xb1, 0x5b, 0x3b, 0x3e,
+	0xf4, 0xe3, 0xda, 0x34, 0x4e, 0x92, 0xeb, 0xff, 0x07, 0x50, 0x72, 0x28, 0x75, 0x5e, 0xa3, 0xba,
+	0x3a, 0x96, 0xf9, 0xd8, 0x44, 0x34, 0xde, 0x45, 0xfd, 0x23, 0x05, 0xce, 0x25, 0xa7, 0x3a, 0x83,
+	0x51, 0x5f, 0x83, 0x39, 0x46, 0xda, 0x97, 0xd1, 0xe5, 0xf1, 0x32, 0x1a, 0x6c, 0x8e, 0xe6, 0x77,
+	0x54, 0x77, 0x60, 0xc9, 0xb7, 0xfd, 0xc1, 0xd6, 0x6f, 0x61, 0x4f, 0x1f, 0x13, 0xf8, 0xbc, 0x0b,
+	0x55, 0xe6, 0x41, 0xb3, 0x80, 0x82, 0xa5, 0x0c, 0x60, 0x4f, 0x64, 0xda, 0xd4, 0xff, 0x56, 0xe0,
+	0x2c, 0x35, 0x9e, 0xf1, 0xd2, 0x4c, 0x96, 0x82, 0xa1, 0x0a, 0xb5, 0x50, 0xf6, 0x81, 0x2d, 0xad,
+	0xa2, 0x45, 0x60, 0xa8, 0x9d, 0x4c, 0xc4, 0x49, 0x03, 0xe4, 0xa0, 0x42, 0x4a, 0x82, 0x71, 0x5a,
+	0x20, 0x8d, 0x67, 0xe0, 0x02, 0xa3, 0x5d, 0x98, 0xc6, 0x68, 0x3f, 0x81, 0xb7, 0x63, 0x2b, 0x9d,
+	0xe1, 0x44, 0xd5, 0xbf, 0x54, 0xc8, 0x71, 0x44, 0xee, 0xe0, 0x4c, 0xef, 0xb8, 0xbe, 0x23, 0x6a,
+	0x42, 0x1d, 0xd3, 0x88, 0x2b, 0x11, 0x03, 0x7d, 0x02, 0x15, 0x0b, 0x1f, 0x77, 0xc2, 0xbe, 0x50,
+	0x06, 0xaf, 0xbe, 0x6c, 0xe1, 0x63, 0xfa, 0x4b, 0x7d, 0x0a, 0xe7, 0x12, 0x53, 0x9d, 0x65, 0xed,
+	0x7f, 0xaf, 0xc0, 0xf9, 0x0d, 0xc7, 0x1e, 0x7e, 0x6e, 0x3a, 0xde, 0x48, 0xef, 0x47, 0x6b, 0xcf,
+	0xaf, 0x27, 0xb3, 0xf5, 0x69, 0xc8, 0x2b, 0x66, 0xfc, 0x73, 0x47, 0x22, 0x41, 0xc9, 0x49, 0xf1,
+	0x45, 0x87, 0x7c, 0xe8, 0xff, 0xca, 0xc3, 0xf9, 0x54, 0xbc, 0x09, 0x7e, 0x49, 0x96, 0x00, 0x43,
+	0x9a, 0x08, 0xcf, 0x4f, 0x9b, 0x08, 0x4f, 0x51, 0xef, 0x85, 0x57, 0xa4, 0xde, 0x4f, 0x9d, 0x99,
+	0xf9, 0x14, 0xa2, 0x45, 0x8a, 0x66, 0x29, 0x73, 0xee, 0x37, 0xda, 0x11, 0xad, 0x01, 0x04, 0x09,
+	0xfb, 0xe6, 0x5c, 0x66, 0x32, 0xa1, 0x5e, 0xe4, 0xb4, 0x84, 0x29, 0xe5, 0x96, 0x3e, 0x00, 0xa8,
+	0xdf, 0x83, 0x96, 0x8c, 0x4b, 0x67, 0xe1, 0xfc, 0x9f, 0xe4, 0x00, 0xda, 0xe2, 0xd6, 0xed, 0x74,
+	0xb6, 0xe0, 0x2a, 0x84, 0xbc, 0x91, 0x40, 0xde, 0xc3, 0x5c, 0x64, 0x10, 0x91, 0x10, 0x31, 0x29,
+	0xc1, 0x49, 0xc4, 0xa9, 0x06, 0xa5, 0x13, 0x92, 0x1a, 0xc6, 0x14, 0x71, 0xf5, 0x7b, 0x01, 0x2a,
+	0xa4, 0xd2, 0x49, 0xc4, 0xcc, 0xf0, 0xaf, 0x15, 0x3b, 0xf6, 0x31, 0x11, 0x3e, 0x83, 0x14, 0xb7,
+	0x3c, 0xdd, 0x3d, 0x24, 0xf4, 0x59, 0xde, 0xa8, 0x44, 0x9a, 0x6d, 0x83, 0xa4, 0x93, 0xf6, 0xcd,
+	0x3e, 0x66, 0xb7, 0x15, 0x2a, 0x1a, 0x6b, 0x90, 0x92, 0x2b, 0xbb, 0xff, 0x56, 0xce, 0x7c, 0xc5,
+	0x85, 0xe2, 0x93, 0x3c, 0xd4, 0x42, 0xb0, 0x6b, 0x54, 0x01, 0x11, 0x9d, 0x46, 0xf5, 0xd9, 0xba,
+	0x6d, 0x30, 0x55, 0x51, 0x4f, 0xb1, 0x08, 0xac, 0x23, 0xed, 0xa4, 0x05, 0x5d, 0xc6, 0x85, 0xc9,
+	0x64, 0x5d, 0x64, 0xd1, 0xa6, 0xe1, 0x5f, 0x92, 0x2f, 0x39, 0xf6, 0x71, 0xdb, 0x10, 0xbb, 0xc1,
+	0xee, 0x0c, 0xb3, 0xa0, 0x90, 0xec, 0xc6, 0x3a, 0x69, 0x93, 0xfd, 0xc4, 0x8e, 0x63, 0x3b, 0x9d,
+	0x01, 0x76, 0x5d, 0xbd, 0x87, 0xb9, 0x7f, 0x5e, 0xa3, 0xc0, 0x2d, 0x06, 0x53, 0xff, 0xa4, 0x00,
+	0xf5, 0x60, 0x29, 0x7e, 0x99, 0xdc, 0x34, 0xfc, 0x32, 0xb9, 0x49, 0x8e, 0x0e, 0x1c, 0xa6, 0x0a,
+	0xc5, 0xe1, 0xae, 0xe5, 0x9a, 0x8a, 0x56, 0xe1, 0xd0, 0xb6, 0x41, 0xcc, 0x32, 0x11, 0x32, 0xcb,
+	0x36, 0x70, 0x70, 0xb8, 0xe0, 0x83, 0xf8, 0xd9, 0x46, 0x78, 0xa4, 0x90, 0x81, 0x47, 0x8a, 0x19,
+	0x78, 0xa4, 0x24, 0xe1, 0x91, 0x25, 0x28, 0xed, 0x8d, 0xba, 0x87, 0xd8, 0xe3, 0x1e, 0x1b, 0x6f,
+	0x45, 0x79, 0xa7, 0x1c, 0xe3, 0x1d, 0xc1, 0x22, 0x95, 0x30, 0x8b, 0x5c, 0x80, 0x0a, 0xab, 0xd7,
+	0x76, 0x3c, 0x97, 0x16, 0x9f, 0xf2, 0x5a, 0x99, 0x01, 0x76, 0x5d, 0x72, 0xd9, 0x90, 0x99, 0xb0,
+	0xaa, 0x4c, 0xd8, 0xa9, 0xd6, 0x89, 0x71, 0x89, 0xef, 0xcc, 0xdd, 0x84, 0x85, 0xd0, 0x76, 0x50,
+	0x1b, 0x51, 0xa3, 0x53, 0x0d, 0x79, 0xfb, 0xd4, 0x4c, 0x5c, 0x87, 0x7a, 0xb0, 0x25, 0x14, 0x6f,
+	0x9e, 0x05, 0x59, 0x02, 0x4a, 0xd1, 0x04, 0x27, 0xd7, 0x4f, 0xc7, 0xc9, 0x24, 0x05, 0xcb, 0xa3,
+	0x23, 0xb7, 0xb9, 0x10, 0x49, 0x56, 0xa8, 0x3f, 0x00, 0x14, 0xcc, 0x7e, 0x36, 0x6f, 0x31, 0xc6,
+	0x1e, 0xb9, 0x38, 0x7b, 0xa8, 0x5f, 0x2b, 0xb0, 0x18, 0x1e, 0x6c, 0x5a, 0xc3, 0xfb, 0x09, 0x54,
+	0x59, 0xf9, 0xaf, 0x43, 0x04, 0x9f, 0x27, 0x81, 0xde, 0x19, 0x7b, 0x2e, 0x1a, 0x04, 0xaf, 0x0e,
+	0x08, 0x7b, 0x1d, 0xdb, 0xce, 0xa1, 0x69, 0xf5, 0x3a, 0x64, 0x66, 0xbe, 0xb8, 0xd5, 0x38, 0x90,
+	0x94, 0x54, 0xe8, 0xfd, 0x9f, 0x4b, 0xcf, 0x86, 0x86, 0xee, 0xe1, 0x90, 0x07, 0x32, 0xeb, 0x6d,
+	0xbf, 0x0f, 0xfd, 0xeb, 0x76, 0xb9, 0x6c, 0x25, 0x2c, 0x86, 0xad, 0xfe, 0xb5, 0x98, 0x4b, 0xe2,
+	0x8a, 0xec, 0xf4, 0x73, 0x69, 0x41, 0xf9, 0x88, 0x93, 0xf3, 0x5f, 0x51, 0xf8, 0xed, 0x48, 0x99,
+	0x34, 0x7f, 0xfa, 0x32, 0xa9, 0xba, 0x05, 0xe7, 0x35, 0xec, 0x62, 0xcb, 0x88, 0xac, 0x66, 0xea,
+	0x64, 0xd3, 0x10, 0x5a, 0x32, 0x72, 0xb3, 0x30, 0x2b, 0xf3, 0x5d, 0x3b, 0x0e, 0x76, 0x59, 0x1e,
+	0x31, 0xcf, 0x5d, 0x26, 0x3a, 0x8e, 0xa7, 0xfe, 0x55, 0x0e, 0xce, 0x3d, 0x34, 0x0c, 0xae, 0xc5,
+	0xd9, 0xa8, 0xaf, 0xcd, 0x51, 0x8e, 0x3b, 0x92, 0xf9, 0xa4, 0x23, 0xf9, 0xaa, 0x34, 0x2b, 0xb7,
+	0x31, 0xa4, 0x1c, 0xc4, 0x6d, 0xa7, 0xc3, 0xee, 0x0f, 0x3d, 0xe0, 0x75, 0x33, 0x12, 0xd0, 0x37,
+	0xe7, 0x32, 0xf9, 0x57, 0x65, 0x3f, 0x69, 0xa6, 0x0e, 0xa1, 0x99, 0xdc, 0xac, 0x19, 0x55, 0x89,
+	0xbf, 0x23, 0x43, 0x9b, 0x25, 0x58, 0x6b, 0x1a, 0x70, 0xd0, 0xb6, 0xed, 0xaa, 0x5f, 0x02, 0xd2,
+	0xb0, 0x6e, 0xf8, 0xe9, 0xa0, 0xd7, 0x74, 0x83, 0xe4, 0xac, 0x7f, 0xfb, 0x3a, 0xcf, 0xac, 0x05,
+	0x6d, 0xa8, 0x1e, 0x9c, 0x89, 0x8c, 0x3d, 0xe3, 0xa3, 0xb3, 0x50, 0x88, 0x4b, 0x7f, 0x93, 0x51,
+	0xd9, 0xed, 0xf6, 0x3c, 0x5d, 0x36, 0x6b, 0xa8, 0xff, 0x93, 0x83, 0x26, 0xb9, 0x38, 0xf3, 0xb3,
+	0xc3, 0x92, 0x5f, 0xc0, 0x59, 0x57, 0x3f, 0xc2, 0x9d, 0x50, 0x2a, 0xa0, 0xe3, 0xe0, 0x17, 0xdc,
+	0xe9, 0x7e, 0x4f, 0xa6, 0x3b, 0xa5, 0x17, 0x8b, 0xb4, 0x45, 0x37, 0x02, 0xd7, 0xf0, 0x0b, 0x74,
+	0x03, 0x16, 0xc2, 0x37, 0xd7, 0x3a, 0x26, 0x73, 0x15, 0x6a, 0xda, 0x7c, 0xe8, 0x62, 0x5a, 0xdb,
+	0x50, 0x5f, 0xc0, 0xc5, 0x67, 0x96, 0x8b, 0xbd, 0x76, 0x70, 0xb9, 0x6a, 0xc6, 0xa0, 0xf9, 0x5d,
+	0xa8, 0x06, 0x1b, 0x9f, 0x78, 0x2b, 0x62, 0xb8, 0xaa, 0x0d, 0xad, 0x2d, 0xdd, 0x39, 0xe4, 0x27,
+	0xec, 0x6e, 0xb0, 0x4b, 0x30, 0xaf, 0x71, 0xc0, 0x7d, 0x71, 0x27, 0x4c, 0xc3, 0xfb, 0xd8, 0xc1,
+	0x56, 0x17, 0x3f, 0xb1, 0xbb, 0x87, 0xc4, 0xc1, 0xf2, 0xd8, 0xc3, 0x3d, 0x25, 0xe4, 0x66, 0x6f,
+	0x84, 0xde, 0xe5, 0xe5, 0x22, 0xef, 0xf2, 0x26, 0xbc, 0xf3, 0x54, 0x7f, 0x9c, 0x83, 0xa5, 0x87,
+	0x7d, 0x0f, 0x3b, 0x41, 0xae, 0xe3, 0x34, 0x69, 0x9b, 0x20, 0x8f, 0x92, 0x9b, 0x22, 0x8f, 0x92,
+	0xb8, 0x30, 0x9f, 0x4f, 0x5e, 0x98, 0x97, 0x65, 0x7d, 0x0a, 0x53, 0x66, 0x7d, 0x1e, 0x02, 0x0c,
+	0x1d, 0x7b, 0x88, 0x1d, 0xcf, 0xc4, 0x7e, 0xc0, 0x9a, 0xc1, 0x61, 0x0b, 0x75, 0x52, 0xbf, 0x80,
+	0xc6, 0xe3, 0xee, 0xba, 0x6d, 0xed, 0x9b, 0xce, 0xc0, 0xdf, 0xa8, 0x84, 0xd0, 0x29, 0x19, 0x84,
+	0x2e, 0x97, 0x10, 0x3a, 0xd5, 0x84, 0xc5, 0x10, 0xed, 0x19, 0x55, 0x75, 0xaf, 0xdb, 0xd9, 0x37,
+	0x2d, 0x93, 0x5e, 0x32, 0xcb, 0x51, 0x87, 0x1b, 0x7a, 0xdd, 0x4d, 0x0e, 0xb9, 0xf5, 0x89, 0xb8,
+	0x9e, 0x4b, 0x72, 0xe5, 0x68, 0x0e, 0xf2, 0x4f, 0xf1, 0x71, 0xe3, 0x2d, 0x04, 0x50, 0x7a, 0x6a,
+	0x3b, 0x03, 0xbd, 0xdf, 0x50, 0x50, 0x15, 0xe6, 0x78, 0x35, 0xb2, 0x91, 0x43, 0xf3, 0x50, 0x59,
+	0xf7, 0x2b, 0x3a, 0x8d, 0xfc, 0xad, 0x3f, 0x55, 0x60, 0x31, 0x51, 0x2f, 0x43, 0x75, 0x80, 0x67,
+	0x56, 0x97, 0x17, 0x12, 0x1b, 0x6f, 0xa1, 0x1a, 0x94, 0xfd, 0xb2, 0x22, 0xa3, 0xb7, 0x6b, 0x53,
+	0xec, 0x46, 0x0e, 0x35, 0xa0, 0xc6, 0x3a, 0x8e, 0xba, 0x5d, 0xec, 0xba, 0x8d, 0xbc, 0x80, 0x6c,
+	0xea, 0x66, 0x7f, 0xe4, 0xe0, 0x46, 0x81, 0x8c, 0xb9, 0x6b, 0x6b, 0xb8, 0x8f, 0x75, 0x17, 0x37,
+	0x8a, 0x08, 0x41, 0x9d, 0x37, 0xfc, 0x4e, 0xa5, 0x10, 0xcc, 0xef, 0x36, 0x77, 0xeb, 0x79, 0xb8,
+	0xea, 0x41, 0x97, 0x77, 0x0e, 0xce, 0x3c, 0xb3, 0x0c, 0xbc, 0x6f, 0x5a, 0xd8, 0x08, 0x3e, 0x35,
+	0xde, 0x42, 0x67, 0x60, 0x61, 0x0b, 0x3b, 0x3d, 0x1c, 0x02, 0xe6, 0xd0, 0x22, 0xcc, 0x6f, 0x99,
+	0x2f, 0x43, 0xa0, 0xbc, 0x5a, 0x28, 0x2b, 0x0d, 0x65, 0xf5, 0x1f, 0xae, 0x42, 0x85, 0xf0, 0xd6,
+	0xba, 0x6d, 0x3b, 0x06, 0xea, 0x03, 0xa2, 0x2f, 0x61, 0x06, 0x43, 0xdb, 0x12, 0x4f, 0xe7, 0xd0,
+	0x4a, 0xf4, 0x78, 0x78, 0x23, 0x89, 0xc8, 0x79, 0xa7, 0x75, 0x4d, 0x8a, 0x1f, 0x43, 0x56, 0xdf,
+	0x42, 0x03, 0x3a, 0x1a, 0xa9, 0x9b, 0xec, 0x9a, 0xdd, 0x43, 0xdf, 0x25, 0xbc, 0x9f, 0xe2, 0x00,
+	0x26, 0x51, 0xfd, 0xf1, 0xae, 0x4a, 0xc7, 0x63, 0x4f, 0x95, 0x7c, 0x9e, 0x53, 0xdf, 0x42, 0x2f,
+	0xe0, 0xec, 0x63, 0x1c, 0xf2, 0xae, 0xfd, 0x01, 0x57, 0xd3, 0x07, 0x4c, 0x20, 0x9f, 0x72, 0xc8,
+	0x27, 0x50, 0xa4, 0xec, 0x86, 0x64, 0x0e, 0x78, 0xf8, 0x05, 0x7c, 0xeb, 0x72, 0x3a, 0x82, 0xa0,
+	0xf6, 0x03, 0x58, 0x88, 0xbd, 0x8d, 0x45, 0x32, 0xe3, 0x24, 0x7f, 0xe5, 0xdc, 0xba, 0x95, 0x05,
+	0x55, 0x8c, 0xd5, 0x83, 0x7a, 0xf4, 0x05, 0x0d, 0x5a, 0xce, 0xf0, 0x18, 0x8f, 0x8d, 0xf4, 0x5e,
+	0xe6, 0x67, 0x7b, 0x94, 0x09, 0x1a, 0xf1, 0xb7, 0x9a, 0xe8, 0xd6, 0x58, 0x02, 0x51, 0x66, 0xbb,
+	0x9d, 0x09, 0x57, 0x0c, 0x77, 0x02, 0x67, 0x65, 0x6f, 0xe4, 0xd0, 0x8a, 0x9c, 0x4c, 0xda, 0xe3,
+	0xbd, 0xd6, 0xbd, 0xcc, 0xf8, 0x62, 0xe8, 0xdf, 0x62, 0xd7, 0x8d, 0x64, 0xef, 0xcc, 0xd0, 0xfb,
+	0x72, 0x72, 0x63, 0x1e, 0xc8, 0xb5, 0x56, 0x4f, 0xd3, 0x45, 0x4c, 0xe2, 0x87, 0xb0, 0x24, 0x7f,
+	0xa9, 0x85, 0xee, 0xcb, 0xe9, 0xa5, 0x3f, 0x42, 0x6b, 0xbd, 0x7f, 0x8a, 0x1e, 0x62, 0x02, 0x76,
+	0xfc, 0x31, 0xac, 0x2f, 0x86, 0xf7, 0x26, 0x72, 0xcd, 0x74, 0x32, 0xf8, 0x7d, 0x58, 0x88, 0xb9,
+	0x6b, 0x28, 0xbb, 0x4b, 0xd7, 0x1a, 0x67, 0x9a, 0x98, 0x48, 0xc6, 0xae, 0x5d, 0xa1, 0x14, 0xee,
+	0x97, 0x5c, 0xcd, 0x6a, 0xdd, 0xca, 0x82, 0x2a, 0x16, 0xe2, 0x52, 0x75, 0x19, 0xbb, 0x4c, 0x83,
+	0xee, 0xc8, 0x69, 0xc8, 0x2f, 0x0d, 0xb5, 0xee, 0x66, 0xc4, 0x16, 0x83, 0x1e, 0xc1, 0x19, 0xc9,
+	0x9d, 0x27, 0x74, 0x77, 0xec, 0x61, 0xc5, 0x2f, 0x7b, 0xb5, 0x56, 0xb2, 0xa2, 0x8b, 0x71, 0x7f,
+	0x1d, 0xd0, 0xce, 0x01, 0x49, 0x3d, 0x5a, 0xfb, 0x66, 0x6f, 0xe4, 0xe8, 0xcc, 0xd9, 0x49, 0xb3,
+	0x0d, 0x49, 0xd4, 0x14, 0x1e, 0x1d, 0xdb, 0x43, 0x0c, 0xde, 0x01, 0x78, 0x8c, 0xbd, 0x2d, 0xec,
+	0x39, 0x44, 0x30, 0x6e, 0xa4, 0x99, 0x3f, 0x8e, 0xe0, 0x0f, 0x75, 0x73, 0x22, 0x5e, 0xc8, 0x14,
+	0x35, 0xb6, 0x74, 0x8b, 0x64, 0xdd, 0x83, 0x47, 0x1b, 0x77, 0xa4, 0xdd, 0xe3, 0x68, 0x29, 0x07,
+	0x99, 0x8a, 0x2d, 0x86, 0x3c, 0x16, 0xa6, 0x3d, 0x54, 0x43, 0x1d, 0x6f, 0xda, 0x93, 0xf7, 0x77,
+	0x5a, 0xf7, 0x32, 0xe3, 0x8b, 0x81, 0xbf, 0x52, 0xe0, 0x42, 0x12, 0xe1, 0xb9, 0xe9, 0x1d, 0x90,
+	0xdb, 0x1b, 0x6e, 0x96, 0x29, 0x50, 0xc4, 0x53, 0x4c, 0x81, 0xe3, 0x8b, 0x29, 0x18, 0x30, 0x1f,
+	0x29, 0x6d, 0x22, 0xd9, 0x2b, 0x07, 0x59, 0x99, 0xb7, 0xb5, 0x3c, 0x19, 0x51, 0x8c, 0x72, 0x00,
+	0xf3, 0xbe, 0x28, 0xb1, 0xcd, 0x7d, 0x2f, 0x6d, 0xa6, 0x01, 0x4e, 0x8a, 0x26, 0x90, 0xa3, 0x86,
+	0x35, 0x41, 0xb2, 0x72, 0x83, 0xb2, 0x55, 0xfc, 0xc6, 0x69, 0x82, 0xf4, 0x72, 0x10, 0x53, 0x75,
+	0xb1, 0x2a, 0xa9, 0x5c, 0x8f, 0x4a, 0x8b, 0xbe, 0xad, 0x5b, 0x59, 0x50, 0xc5, 0x58, 0xcf, 0xa1,
+	0xc4, 0xff, 0xda, 0xe5, 0xda, 0xf8, 0x6c, 0x2b, 0xa7, 0x7e, 0x7d, 0x02, 0x96, 0x20, 0x7c, 0x08,
+	0xe7, 0x52, 0x72, 0xad, 0x52, 0x13, 0x3c, 0x3e, 0x2f, 0x3b, 0xc9, 0x38, 0x88, 0xc1, 0x12, 0xc9,
+	0xd4, 0x31, 0x83, 0xa5, 0x25, 0x5e, 0x27, 0x0d, 0xd6, 0x81, 0xc5, 0x44, 0xd6, 0x06, 0xdd, 0x4e,
+	0x31, 0x74, 0xb2, 0xdc, 0xce, 0xa4, 0x01, 0x7a, 0xf0, 0xb6, 0x34, 0x43, 0x21, 0x35, 0xdc, 0xe3,
+	0x72, 0x19, 0x93, 0x06, 0xea, 0xc2, 0x19, 0x49, 0x5e, 0x42, 0x6a, 0x72, 0xd2, 0xf3, 0x17, 0x93,
+	0x06, 0xd9, 0x87, 0xd6, 0x9a, 0x63, 0xeb, 0x46, 0x57, 0x77, 0x3d, 0x9a, 0x2b, 0xc0, 0x46, 0xe0,
+	0x39, 0xc9, 0xdd, 0x6a, 0x69, 0x46, 0x61, 0xd2, 0x38, 0x7b, 0x50, 0xa5, 0x47, 0xc9, 0xfe, 0x74,
+	0x03, 0xc9, 0x6d, 0x44, 0x08, 0x23, 0x45, 0xf1, 0xc8, 0x10, 0x05, 0x53, 0xef, 0x42, 0x75, 0x9d,
+	0x16, 0x91, 0xda, 0xe4, 0x69, 0x71, 0xdc, 0x5e, 0xd1, 0xf7, 0xc6, 0x2b, 0x21, 0x84, 0xcc, 0x3b,
+	0x34, 0x4f, 0x1d, 0x5a, 0x03, 0xbf, 0x64, 0xe7, 0xbc, 0x2c, 0xa3, 0x1b, 0x41, 0x49, 0x09, 0x00,
+	0xa4, 0x98, 0x21, 0x4b, 0x7f, 0x36, 0xec, 0xe6, 0x89, 0xe1, 0xee, 0xa5, 0x10, 0x49, 0x60, 0xfa,
+	0xa3, 0xde, 0xcf, 0xde, 0x21, 0x6c, 0x19, 0xfc, 0x79, 0xb5, 0x69, 0x05, 0xeb, 0xe6, 0xb8, 0xa9,
+	0x87, 0x7d, 0xb7, 0xe5, 0xc9, 0x88, 0x62, 0x94, 0x6d, 0xa8, 0x10, 0xee, 0x64, 0xc7, 0x73, 0x4d,
+	0xd6, 0x51, 0x7c, 0xce, 0x7e, 0x38, 0x1b, 0xd8, 0xed, 0x3a, 0xe6, 0x1e, 0x3f, 0x74, 0xe9, 0x74,
+	0x22, 0x28, 0x63, 0x0f, 0x27, 0x86, 0x29, 0x66, 0xfe, 0x1b, 0xd4, 0x5b, 0xa7, 0xd0, 0xb5, 0x91,
+	0xd9, 0x37, 0xb6, 0x1d, 0xbb, 0x47, 0xdf, 0xfa, 0xdc, 0x1f, 0xb7, 0xfc, 0x08, 0x6a, 0xaa, 0x27,
+	0x36, 0xa6, 0x87, 0x18, 0xff, 0x57, 0xa1, 0xa1, 0x61, 0xa2, 0x43, 0x3e, 0xb3, 0xf7, 0xd8, 0x85,
+	0x2f, 0x17, 0xdd, 0x96, 0x11, 0x8a, 0x63, 0x65, 0xd6, 0x35, 0x35, 0xf2, 0x67, 0x26, 0xa4, 0x12,
+	0xf7, 0x99, 0xbd, 0x97, 0x72, 0xfc, 0x61, 0x8c, 0xb1, 0xc7, 0x1f, 0x45, 0x14, 0x8b, 0xf8, 0x65,
+	0xa8, 0x88, 0x1c, 0x18, 0x92, 0x5d, 0xb4, 0x8b, 0x67, 0xdf, 0x5a, 0xd7, 0xc6, 0x23, 0xf9, 0x94,
+	0x57, 0xbf, 0x06, 0x28, 0xfb, 0x8f, 0xb3, 0xbe, 0xe1, 0xe4, 0xcd, 0x1b, 0xc8, 0xa6, 0x7c, 0x1f,
+	0x16, 0x62, 0x7f, 0x94, 0x20, 0x55, 0xd4, 0xf2, 0x3f, 0x53, 0x98, 0xc4, 0x09, 0xcf, 0xf9, 0x7f,
+	0xfb, 0x89, 0xc0, 0xea, 0x66, 0x5a, 0x46, 0x26, 0x1e, 0x53, 0x4d, 0x20, 0xfc, 0xff, 0x3b, 0x92,
+	0x79, 0x0a, 0x10, 0x8a, 0x61, 0xc6, 0x5f, 0x61, 0x26, 0x6e, 0xf9, 0xa4, 0xdd, 0x1a, 0x48, 0xc3,
+	0x94, 0xf7, 0xb2, 0x5c, 0x07, 0x4d, 0x77, 0x34, 0xd3, 0x83, 0x93, 0x67, 0x50, 0x0b, 0x3f, 0x2e,
+	0x40, 0xd2, 0x7f, 0x92, 0x4b, 0xbe, 0x3e, 0x98, 0xb4, 0x8a, 0xad, 0x53, 0xfa, 0xaf, 0x13, 0xc8,
+	0xb9, 0x80, 0x92, 0x65, 0x69, 0xa9, 0xbf, 0x9f, 0x5a, 0x0c, 0x6f, 0xdd, 0xcd, 0x88, 0x1d, 0x4e,
+	0xcc, 0xc5, 0x6b, 0xad, 0xd2, 0xc4, 0x5c, 0x4a, 0xf5, 0xba, 0x75, 0x3b, 0x13, 0xae, 0x18, 0xee,
+	0xd7, 0xa0, 0x1a, 0x2a, 0x76, 0xa2, 0xeb, 0xd2, 0xe9, 0xc6, 0x0b, 0xb1, 0xad, 0x1b, 0x93, 0xd0,
+	0x7c, 0xfa, 0xf7, 0x95, 0xb5, 0x0f, 0xbe, 0x78, 0xbf, 0x67, 0x7a, 0x07, 0xa3, 0x3d, 0xb2, 0xbf,
+	0xf7, 0x58, 0xbf, 0xbb, 0xa6, 0xcd, 0x7f, 0xdd, 0xf3, 0x05, 0xea, 0x1e, 0x25, 0x75, 0x8f, 0x90,
+	0x1a, 0xee, 0xed, 0x95, 0x68, 0xeb, 0x83, 0xff, 0x1b, 0x00, 0x62, 0xef, 0x48, 0x9a, 0x2e, 0x55,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5319,6 +5437,10 @@ type DataCoordClient interface {
 	DescribeIndex(ctx context.Context, in *indexpb.DescribeIndexRequest, opts ...grpc.CallOption) (*indexpb.DescribeIndexResponse, error)
 	// Deprecated: use DescribeIndex instead
 	GetIndexBuildProgress(ctx context.Context, in *indexpb.GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*indexpb.GetIndexBuildProgressResponse, error)
+	// ReportJobResults is called by IndexNode to push the results of finished index jobs.
+	ReportJobResults(ctx context.Context, in *indexpb.ReportJobResultsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// ListNodeJobs is called by IndexNode at startup for the index jobs still assigned to it.
+	ListNodeJobs(ctx context.Context, in *indexpb.ListNodeJobsRequest, opts ...grpc.CallOption) (*indexpb.ListNodeJobsResponse, error)
 	GcConfirm(ctx context.Context, in *GcConfirmRequest, opts ...grpc.CallOption) (*GcConfirmResponse, error)
 }
 
@@ -5681,6 +5803,24 @@ func (c *dataCoordClient) GetIndexBuildProgress(ctx context.Context, in *indexpb
 	return out, nil
 }
 
+func (c *dataCoordClient) ReportJobResults(ctx context.Context, in *indexpb.ReportJobResultsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ReportJobResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ListNodeJobs(ctx context.Context, in *indexpb.ListNodeJobsRequest, opts ...grpc.CallOption) (*indexpb.ListNodeJobsResponse, error) {
+	out := new(indexpb.ListNodeJobsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ListNodeJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataCoordClient) GcConfirm(ctx context.Context, in *GcConfirmRequest, opts ...grpc.CallOption) (*GcConfirmResponse, error) {
 	out := new(GcConfirmResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GcConfirm", in, out, opts...)
@@ -5735,6 +5875,10 @@ type DataCoordServer interface {
 	DescribeIndex(context.Context, *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error)
 	// Deprecated: use DescribeIndex instead
 	GetIndexBuildProgress(context.Context, *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error)
+	// ReportJobResults is called by IndexNode to push the results of finished index jobs.
+	ReportJobResults(context.Context, *indexpb.ReportJobResultsRequest) (*commonpb.Status, error)
+	// ListNodeJobs is called by IndexNode at startup for the index jobs still assigned to it.
+	ListNodeJobs(context.Context, *indexpb.ListNodeJobsRequest) (*indexpb.ListNodeJobsResponse, error)
 	GcConfirm(context.Context, *GcConfirmRequest) (*GcConfirmResponse, error)
 }
 
@@ -5859,6 +6003,12 @@ func (*UnimplementedDataCoordServer) DescribeIndex(ctx context.Context, req *ind
 func (*UnimplementedDataCoordServer) GetIndexBuildProgress(ctx context.Context, req *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetIndexBuildProgress not implemented")
 }
+func (*UnimplementedDataCoordServer) ReportJobResults(ctx context.Context, req *indexpb.ReportJobResultsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportJobResults not implemented")
+}
+func (*UnimplementedDataCoordServer) ListNodeJobs(ctx context.Context, req *indexpb.ListNodeJobsRequest) (*indexpb.ListNodeJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNodeJobs not implemented")
+}
 func (*UnimplementedDataCoordServer) GcConfirm(ctx context.Context, req *GcConfirmRequest) (*GcConfirmResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GcConfirm not implemented")
 }
@@ -6569,6 +6719,42 @@ func _DataCoord_GetIndexBuildProgress_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_ReportJobResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(indexpb.ReportJobResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).ReportJobResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/ReportJobResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ReportJobResults(ctx, req.(*indexpb.ReportJobResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_ListNodeJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(indexpb.ListNodeJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).ListNodeJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/ListNodeJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ListNodeJobs(ctx, req.(*indexpb.ListNodeJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataCoord_GcConfirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GcConfirmRequest)
 	if err := dec(in); err != nil {
@@ -6747,6 +6933,14 @@ var _DataCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetIndexBuildProgress",
 			Handler:    _DataCoord_GetIndexBuildProgress_Handler,
 		},
+		{
+			MethodName: "ReportJobResults",
+			Handler:    _DataCoord_ReportJobResults_Handler,
+		},
+		{
+			MethodName: "ListNodeJobs",
+			Handler:    _DataCoord_ListNodeJobs_Handler,
+		},
 		{
 			MethodName: "GcConfirm",
 			Handler:    _DataCoord_GcConfirm_Handler,
@@ -6774,6 +6968,7 @@ type DataNodeClient interface {
 	Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ResendSegmentStats(ctx context.Context, in *ResendSegmentStatsRequest, opts ...grpc.CallOption) (*ResendSegmentStatsResponse, error)
 	AddImportSegment(ctx context.Context, in *AddImportSegmentRequest, opts ...grpc.CallOption) (*AddImportSegmentResponse, error)
+	ReadBinlogs(ctx context.Context, in *ReadBinlogsRequest, opts ...grpc.CallOption) (DataNode_ReadBinlogsClient, error)
 }
 
 type dataNodeClient struct {
@@ -6892,6 +7087,38 @@ func (c *dataNodeClient) AddImportSegment(ctx context.Context, in *AddImportSegm
 	return out, nil
 }
 
+func (c *dataNodeClient) ReadBinlogs(ctx context.Context, in *ReadBinlogsRequest, opts ...grpc.CallOption) (DataNode_ReadBinlogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DataNode_serviceDesc.Streams[0], "/milvus.proto.data.DataNode/ReadBinlogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dataNodeReadBinlogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DataNode_ReadBinlogsClient interface {
+	Recv() (*ReadBinlogsResponse, error)
+	grpc.ClientStream
+}
+
+type dataNodeReadBinlogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *dataNodeReadBinlogsClient) Recv() (*ReadBinlogsResponse, error) {
+	m := new(ReadBinlogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // DataNodeServer is the server API for DataNode service.
 type DataNodeServer interface {
 	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
@@ -6908,6 +7135,7 @@ type DataNodeServer interface {
 	Import(context.Context, *ImportTaskRequest) (*commonpb.Status, error)
 	ResendSegmentStats(context.Context, *ResendSegmentStatsRequest) (*ResendSegmentStatsResponse, error)
 	AddImportSegment(context.Context, *AddImportSegmentRequest) (*AddImportSegmentResponse, error)
+	ReadBinlogs(*ReadBinlogsRequest, DataNode_ReadBinlogsServer) error
 }
 
 // UnimplementedDataNodeServer can be embedded to have forward compatible implementations.
@@ -6950,6 +7178,9 @@ func (*UnimplementedDataNodeServer) ResendSegmentStats(ctx context.Context, req
 func (*UnimplementedDataNodeServer) AddImportSegment(ctx context.Context, req *AddImportSegmentRequest) (*AddImportSegmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddImportSegment not implemented")
 }
+func (*UnimplementedDataNodeServer) ReadBinlogs(req *ReadBinlogsRequest, srv DataNode_ReadBinlogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReadBinlogs not implemented")
+}
 
 func RegisterDataNodeServer(s *grpc.Server, srv DataNodeServer) {
 	s.RegisterService(&_DataNode_serviceDesc, srv)
@@ -7171,6 +7402,27 @@ func _DataNode_AddImportSegment_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataNode_ReadBinlogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadBinlogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DataNodeServer).ReadBinlogs(m, &dataNodeReadBinlogsServer{stream})
+}
+
+type DataNode_ReadBinlogsServer interface {
+	Send(*ReadBinlogsResponse) error
+	grpc.ServerStream
+}
+
+type dataNodeReadBinlogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *dataNodeReadBinlogsServer) Send(m *ReadBinlogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _DataNode_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.data.DataNode",
 	HandlerType: (*DataNodeServer)(nil),
@@ -7224,6 +7476,12 @@ var _DataNode_serviceDesc = grpc.ServiceDesc{
 			Handler:    _DataNode_AddImportSegment_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ReadBinlogs",
+			Handler:       _DataNode_ReadBinlogs_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "data_coord.proto",
 }
@@ -9,6 +9,7 @@ import (
 	proto "github.com/golang/protobuf/proto"
 	commonpb "github.com/milvus-io/milvus-proto/go-api/commonpb"
 	milvuspb "github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	schemapb "github.com/milvus-io/milvus-proto/go-api/schemapb"
 	internalpb "github.com/milvus-io/milvus/internal/proto/internalpb"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -27,6 +28,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// FailureDomain is where the failure of a task lies, retrying the task on another node only helps a NodeFailure.
+type FailureDomain int32
+
+const (
+	FailureDomain_UnknownFailure FailureDomain = 0
+	FailureDomain_NodeFailure    FailureDomain = 1
+	FailureDomain_DataFailure    FailureDomain = 2
+	FailureDomain_GlobalFailure  FailureDomain = 3
+)
+
+var FailureDomain_name = map[int32]string{
+	0: "UnknownFailure",
+	1: "NodeFailure",
+	2: "DataFailure",
+	3: "GlobalFailure",
+}
+
+var FailureDomain_value = map[string]int32{
+	"UnknownFailure": 0,
+	"NodeFailure":    1,
+	"DataFailure":    2,
+	"GlobalFailure":  3,
+}
+
+func (x FailureDomain) String() string {
+	return proto.EnumName(FailureDomain_name, int32(x))
+}
+
+func (FailureDomain) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{0}
+}
+
 type IndexInfo struct {
 	CollectionID int64                    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	FieldID      int64                    `protobuf:"varint,2,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
@@ -213,24 +246,31 @@ func (m *FieldIndex) GetCreateTime() uint64 {
 }
 
 type SegmentIndex struct {
-	CollectionID         int64               `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionID          int64               `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	SegmentID            int64               `protobuf:"varint,3,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	NumRows              int64               `protobuf:"varint,4,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	IndexID              int64               `protobuf:"varint,5,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	BuildID              int64               `protobuf:"varint,6,opt,name=buildID,proto3" json:"buildID,omitempty"`
-	NodeID               int64               `protobuf:"varint,7,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
-	IndexVersion         int64               `protobuf:"varint,8,opt,name=index_version,json=indexVersion,proto3" json:"index_version,omitempty"`
-	State                commonpb.IndexState `protobuf:"varint,9,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
-	FailReason           string              `protobuf:"bytes,10,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
-	IndexFileKeys        []string            `protobuf:"bytes,11,rep,name=index_file_keys,json=indexFileKeys,proto3" json:"index_file_keys,omitempty"`
-	Deleted              bool                `protobuf:"varint,12,opt,name=deleted,proto3" json:"deleted,omitempty"`
-	CreateTime           uint64              `protobuf:"varint,13,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	SerializeSize        uint64              `protobuf:"varint,14,opt,name=serialize_size,json=serializeSize,proto3" json:"serialize_size,omitempty"`
-	WriteHandoff         bool                `protobuf:"varint,15,opt,name=write_handoff,json=writeHandoff,proto3" json:"write_handoff,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	CollectionID   int64               `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID    int64               `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	SegmentID      int64               `protobuf:"varint,3,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	NumRows        int64               `protobuf:"varint,4,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	IndexID        int64               `protobuf:"varint,5,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	BuildID        int64               `protobuf:"varint,6,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	NodeID         int64               `protobuf:"varint,7,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	IndexVersion   int64               `protobuf:"varint,8,opt,name=index_version,json=indexVersion,proto3" json:"index_version,omitempty"`
+	State          commonpb.IndexState `protobuf:"varint,9,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
+	FailReason     string              `protobuf:"bytes,10,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
+	IndexFileKeys  []string            `protobuf:"bytes,11,rep,name=index_file_keys,json=indexFileKeys,proto3" json:"index_file_keys,omitempty"`
+	Deleted        bool                `protobuf:"varint,12,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	CreateTime     uint64              `protobuf:"varint,13,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	SerializeSize  uint64              `protobuf:"varint,14,opt,name=serialize_size,json=serializeSize,proto3" json:"serialize_size,omitempty"`
+	WriteHandoff   bool                `protobuf:"varint,15,opt,name=write_handoff,json=writeHandoff,proto3" json:"write_handoff,omitempty"`
+	IndexFileSizes []uint64            `protobuf:"varint,16,rep,packed,name=index_file_sizes,json=indexFileSizes,proto3" json:"index_file_sizes,omitempty"`
+	MemSize        uint64              `protobuf:"varint,17,opt,name=mem_size,json=memSize,proto3" json:"mem_size,omitempty"`
+	// warnings are the adjustments and data cleaning made by the build which finished successfully.
+	Warnings []string `protobuf:"bytes,18,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// brute_force is set when the build finished without an index because the segment is too small,
+	// the segment is searched by brute force then.
+	BruteForce           bool     `protobuf:"varint,19,opt,name=brute_force,json=bruteForce,proto3" json:"brute_force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SegmentIndex) Reset()         { *m = SegmentIndex{} }
@@ -363,6 +403,34 @@ func (m *SegmentIndex) GetWriteHandoff() bool {
 	return false
 }
 
+func (m *SegmentIndex) GetIndexFileSizes() []uint64 {
+	if m != nil {
+		return m.IndexFileSizes
+	}
+	return nil
+}
+
+func (m *SegmentIndex) GetMemSize() uint64 {
+	if m != nil {
+		return m.MemSize
+	}
+	return 0
+}
+
+func (m *SegmentIndex) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+func (m *SegmentIndex) GetBruteForce() bool {
+	if m != nil {
+		return m.BruteForce
+	}
+	return false
+}
+
 type RegisterNodeRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Address              *commonpb.Address `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -626,6 +694,7 @@ type SegmentIndexState struct {
 	SegmentID            int64               `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	State                commonpb.IndexState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
 	FailReason           string              `protobuf:"bytes,3,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
+	Warnings             []string            `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -677,6 +746,13 @@ func (m *SegmentIndexState) GetFailReason() string {
 	return ""
 }
 
+func (m *SegmentIndexState) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
 type GetSegmentIndexStateResponse struct {
 	Status               *commonpb.Status     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	States               []*SegmentIndexState `protobuf:"bytes,2,rep,name=states,proto3" json:"states,omitempty"`
@@ -885,6 +961,8 @@ type IndexFilePathInfo struct {
 	SerializedSize       uint64                   `protobuf:"varint,8,opt,name=serialized_size,json=serializedSize,proto3" json:"serialized_size,omitempty"`
 	IndexVersion         int64                    `protobuf:"varint,9,opt,name=index_version,json=indexVersion,proto3" json:"index_version,omitempty"`
 	NumRows              int64                    `protobuf:"varint,10,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	IndexFileSizes       []uint64                 `protobuf:"varint,11,rep,packed,name=index_file_sizes,json=indexFileSizes,proto3" json:"index_file_sizes,omitempty"`
+	MemSize              uint64                   `protobuf:"varint,12,opt,name=mem_size,json=memSize,proto3" json:"mem_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -985,6 +1063,20 @@ func (m *IndexFilePathInfo) GetNumRows() int64 {
 	return 0
 }
 
+func (m *IndexFilePathInfo) GetIndexFileSizes() []uint64 {
+	if m != nil {
+		return m.IndexFileSizes
+	}
+	return nil
+}
+
+func (m *IndexFilePathInfo) GetMemSize() uint64 {
+	if m != nil {
+		return m.MemSize
+	}
+	return 0
+}
+
 type SegmentInfo struct {
 	CollectionID         int64                `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	SegmentID            int64                `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
@@ -1355,18 +1447,20 @@ func (m *GetIndexBuildProgressResponse) GetTotalRows() int64 {
 }
 
 type StorageConfig struct {
-	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	AccessKeyID          string   `protobuf:"bytes,2,opt,name=access_keyID,json=accessKeyID,proto3" json:"access_keyID,omitempty"`
-	SecretAccessKey      string   `protobuf:"bytes,3,opt,name=secret_access_key,json=secretAccessKey,proto3" json:"secret_access_key,omitempty"`
-	UseSSL               bool     `protobuf:"varint,4,opt,name=useSSL,proto3" json:"useSSL,omitempty"`
-	BucketName           string   `protobuf:"bytes,5,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
-	RootPath             string   `protobuf:"bytes,6,opt,name=root_path,json=rootPath,proto3" json:"root_path,omitempty"`
-	UseIAM               bool     `protobuf:"varint,7,opt,name=useIAM,proto3" json:"useIAM,omitempty"`
-	IAMEndpoint          string   `protobuf:"bytes,8,opt,name=IAMEndpoint,proto3" json:"IAMEndpoint,omitempty"`
-	StorageType          string   `protobuf:"bytes,9,opt,name=storage_type,json=storageType,proto3" json:"storage_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Address         string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	AccessKeyID     string `protobuf:"bytes,2,opt,name=access_keyID,json=accessKeyID,proto3" json:"access_keyID,omitempty"`
+	SecretAccessKey string `protobuf:"bytes,3,opt,name=secret_access_key,json=secretAccessKey,proto3" json:"secret_access_key,omitempty"`
+	UseSSL          bool   `protobuf:"varint,4,opt,name=useSSL,proto3" json:"useSSL,omitempty"`
+	BucketName      string `protobuf:"bytes,5,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	RootPath        string `protobuf:"bytes,6,opt,name=root_path,json=rootPath,proto3" json:"root_path,omitempty"`
+	UseIAM          bool   `protobuf:"varint,7,opt,name=useIAM,proto3" json:"useIAM,omitempty"`
+	IAMEndpoint     string `protobuf:"bytes,8,opt,name=IAMEndpoint,proto3" json:"IAMEndpoint,omitempty"`
+	StorageType     string `protobuf:"bytes,9,opt,name=storage_type,json=storageType,proto3" json:"storage_type,omitempty"`
+	// zone_endpoints are the endpoints of the bucket in each availability zone, the node prefers the one of its zone.
+	ZoneEndpoints        []*ZoneEndpoint `protobuf:"bytes,10,rep,name=zone_endpoints,json=zoneEndpoints,proto3" json:"zone_endpoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *StorageConfig) Reset()         { *m = StorageConfig{} }
@@ -1457,28 +1551,131 @@ func (m *StorageConfig) GetStorageType() string {
 	return ""
 }
 
+func (m *StorageConfig) GetZoneEndpoints() []*ZoneEndpoint {
+	if m != nil {
+		return m.ZoneEndpoints
+	}
+	return nil
+}
+
+type ZoneEndpoint struct {
+	Zone                 string   `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ZoneEndpoint) Reset()         { *m = ZoneEndpoint{} }
+func (m *ZoneEndpoint) String() string { return proto.CompactTextString(m) }
+func (*ZoneEndpoint) ProtoMessage()    {}
+func (*ZoneEndpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{21}
+}
+
+func (m *ZoneEndpoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZoneEndpoint.Unmarshal(m, b)
+}
+func (m *ZoneEndpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZoneEndpoint.Marshal(b, m, deterministic)
+}
+func (m *ZoneEndpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZoneEndpoint.Merge(m, src)
+}
+func (m *ZoneEndpoint) XXX_Size() int {
+	return xxx_messageInfo_ZoneEndpoint.Size(m)
+}
+func (m *ZoneEndpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZoneEndpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZoneEndpoint proto.InternalMessageInfo
+
+func (m *ZoneEndpoint) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *ZoneEndpoint) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 type CreateJobRequest struct {
-	ClusterID            string                   `protobuf:"bytes,1,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
-	IndexFilePrefix      string                   `protobuf:"bytes,2,opt,name=index_file_prefix,json=indexFilePrefix,proto3" json:"index_file_prefix,omitempty"`
-	BuildID              int64                    `protobuf:"varint,3,opt,name=buildID,proto3" json:"buildID,omitempty"`
-	DataPaths            []string                 `protobuf:"bytes,4,rep,name=data_paths,json=dataPaths,proto3" json:"data_paths,omitempty"`
-	IndexVersion         int64                    `protobuf:"varint,5,opt,name=index_version,json=indexVersion,proto3" json:"index_version,omitempty"`
-	IndexID              int64                    `protobuf:"varint,6,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	IndexName            string                   `protobuf:"bytes,7,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	StorageConfig        *StorageConfig           `protobuf:"bytes,8,opt,name=storage_config,json=storageConfig,proto3" json:"storage_config,omitempty"`
-	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
-	TypeParams           []*commonpb.KeyValuePair `protobuf:"bytes,10,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
-	NumRows              int64                    `protobuf:"varint,11,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	ClusterID       string                   `protobuf:"bytes,1,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
+	IndexFilePrefix string                   `protobuf:"bytes,2,opt,name=index_file_prefix,json=indexFilePrefix,proto3" json:"index_file_prefix,omitempty"`
+	BuildID         int64                    `protobuf:"varint,3,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	DataPaths       []string                 `protobuf:"bytes,4,rep,name=data_paths,json=dataPaths,proto3" json:"data_paths,omitempty"`
+	IndexVersion    int64                    `protobuf:"varint,5,opt,name=index_version,json=indexVersion,proto3" json:"index_version,omitempty"`
+	IndexID         int64                    `protobuf:"varint,6,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	IndexName       string                   `protobuf:"bytes,7,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	StorageConfig   *StorageConfig           `protobuf:"bytes,8,opt,name=storage_config,json=storageConfig,proto3" json:"storage_config,omitempty"`
+	IndexParams     []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
+	TypeParams      []*commonpb.KeyValuePair `protobuf:"bytes,10,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
+	NumRows         int64                    `protobuf:"varint,11,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	// engine_version pins the build engine version, the default engine of IndexNode is used if it's empty.
+	EngineVersion string `protobuf:"bytes,12,opt,name=engine_version,json=engineVersion,proto3" json:"engine_version,omitempty"`
+	// priority of the job, the jobs with a positive priority are high priority and accepted
+	// in the maintenance windows of IndexNode.
+	Priority int32 `protobuf:"varint,13,opt,name=priority,proto3" json:"priority,omitempty"`
+	// data_timestamp pins the data version of the job, only the binlogs flushed at or before it are read,
+	// so the index is consistent with that flush even if new binlogs land during the build. 0 reads all the binlogs.
+	DataTimestamp uint64 `protobuf:"varint,16,opt,name=data_timestamp,json=dataTimestamp,proto3" json:"data_timestamp,omitempty"`
+	// rebuild_in_place replaces the index files of the build and the index version of the job: the new files are
+	// staged aside and swapped in by the index pointer once all are saved, so the build always has a complete index.
+	// The replaced files are deleted after indexNode.rebuild.deleteDelay.
+	RebuildInPlace bool `protobuf:"varint,17,opt,name=rebuild_in_place,json=rebuildInPlace,proto3" json:"rebuild_in_place,omitempty"`
+	// storage_roots are the storages other than storage_config holding some binlogs of the job, such as the legacy
+	// bucket of a migrated collection. The index files are always saved to storage_config.
+	StorageRoots []*StorageRoot `protobuf:"bytes,18,rep,name=storage_roots,json=storageRoots,proto3" json:"storage_roots,omitempty"`
+	// data_path_roots maps the binlog paths of data_paths to the names of
+	// the storage_roots they are read from, the paths not in it are read from storage_config.
+	DataPathRoots map[string]string `protobuf:"bytes,19,rep,name=data_path_roots,json=dataPathRoots,proto3" json:"data_path_roots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// pk_data_paths are the binlogs of the primary key field of the segment.
+	PkDataPaths []string `protobuf:"bytes,21,rep,name=pk_data_paths,json=pkDataPaths,proto3" json:"pk_data_paths,omitempty"`
+	// emit_pk_offsets saves the PK_OFFSETS index file mapping the primary keys of the indexed rows to their
+	// row offsets in the segment, so the index can be looked up by id without the binlogs. It needs pk_data_paths.
+	EmitPkOffsets bool `protobuf:"varint,23,opt,name=emit_pk_offsets,json=emitPkOffsets,proto3" json:"emit_pk_offsets,omitempty"`
+	// reservation_token is the token of the slot reserved for the job by ReserveSlots, the job is rejected if the
+	// reservation has expired. Empty means the job is not reserved.
+	ReservationToken string `protobuf:"bytes,24,opt,name=reservation_token,json=reservationToken,proto3" json:"reservation_token,omitempty"`
+	// job_class is the class of the job, the "background" jobs such as the routine re-index maintenance are built
+	// within the thread budget of indexNode.background at a lowered cpu and io priority. Empty means a foreground job.
+	JobClass string `protobuf:"bytes,25,opt,name=job_class,json=jobClass,proto3" json:"job_class,omitempty"`
+	// binlog_source is the address of the DataNode which wrote the binlogs of the segment, IndexNode streams the
+	// binlogs still kept by it instead of reading them from the object storage. Empty means no source.
+	BinlogSource string `protobuf:"bytes,26,opt,name=binlog_source,json=binlogSource,proto3" json:"binlog_source,omitempty"`
+	// replica_storage_config is the secondary storage the index files are copied to asynchronously once they're
+	// saved to storage_config, for the disaster recovery of the index files. Not set means no replication.
+	ReplicaStorageConfig *StorageConfig `protobuf:"bytes,27,opt,name=replica_storage_config,json=replicaStorageConfig,proto3" json:"replica_storage_config,omitempty"`
+	// compact_index_paths are the index files of the existing index of the job. The job rewrites them with the
+	// slice size and the codec of the node and swaps them in place like rebuild_in_place, instead of building
+	// the index from the binlogs. Empty means the index is built.
+	CompactIndexPaths []string `protobuf:"bytes,28,rep,name=compact_index_paths,json=compactIndexPaths,proto3" json:"compact_index_paths,omitempty"`
+	// presigned_get_urls maps the binlog paths of the job to their pre-signed GET urls, and presigned_upload grants
+	// the job the upload of the index files under the index directory of the job. If either is set, IndexNode
+	// accesses the objects of the job through them only and never uses the credentials of storage_config, whose
+	// root path still names the paths. The job fails on a binlog without an url or an index file out of the
+	// granted prefix. The keys of the saved index files are the prefix joined with the index file keys reported
+	// by QueryJobs, as for the other jobs. Such jobs can't list or delete objects, and knowhere uploads the files
+	// of a disk index itself, so they don't take storage_roots, rebuild_in_place, replica_storage_config,
+	// compact_index_paths or a DISKANN index.
+	PresignedGetUrls     map[string]string `protobuf:"bytes,29,rep,name=presigned_get_urls,json=presignedGetUrls,proto3" json:"presigned_get_urls,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PresignedUpload      *PresignedUpload  `protobuf:"bytes,31,opt,name=presigned_upload,json=presignedUpload,proto3" json:"presigned_upload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *CreateJobRequest) Reset()         { *m = CreateJobRequest{} }
 func (m *CreateJobRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateJobRequest) ProtoMessage()    {}
 func (*CreateJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9e019eb3fda53c2, []int{21}
+	return fileDescriptor_f9e019eb3fda53c2, []int{22}
 }
 
 func (m *CreateJobRequest) XXX_Unmarshal(b []byte) error {
@@ -1576,424 +1773,2133 @@ func (m *CreateJobRequest) GetNumRows() int64 {
 	return 0
 }
 
-type QueryJobsRequest struct {
-	ClusterID            string   `protobuf:"bytes,1,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
-	BuildIDs             []int64  `protobuf:"varint,2,rep,packed,name=buildIDs,proto3" json:"buildIDs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *CreateJobRequest) GetEngineVersion() string {
+	if m != nil {
+		return m.EngineVersion
+	}
+	return ""
 }
 
-func (m *QueryJobsRequest) Reset()         { *m = QueryJobsRequest{} }
-func (m *QueryJobsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryJobsRequest) ProtoMessage()    {}
-func (*QueryJobsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9e019eb3fda53c2, []int{22}
+func (m *CreateJobRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
 }
 
-func (m *QueryJobsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_QueryJobsRequest.Unmarshal(m, b)
-}
-func (m *QueryJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_QueryJobsRequest.Marshal(b, m, deterministic)
-}
-func (m *QueryJobsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryJobsRequest.Merge(m, src)
-}
-func (m *QueryJobsRequest) XXX_Size() int {
-	return xxx_messageInfo_QueryJobsRequest.Size(m)
-}
-func (m *QueryJobsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryJobsRequest.DiscardUnknown(m)
+func (m *CreateJobRequest) GetDataTimestamp() uint64 {
+	if m != nil {
+		return m.DataTimestamp
+	}
+	return 0
 }
 
-var xxx_messageInfo_QueryJobsRequest proto.InternalMessageInfo
-
-func (m *QueryJobsRequest) GetClusterID() string {
+func (m *CreateJobRequest) GetRebuildInPlace() bool {
 	if m != nil {
-		return m.ClusterID
+		return m.RebuildInPlace
 	}
-	return ""
+	return false
 }
 
-func (m *QueryJobsRequest) GetBuildIDs() []int64 {
+func (m *CreateJobRequest) GetStorageRoots() []*StorageRoot {
 	if m != nil {
-		return m.BuildIDs
+		return m.StorageRoots
 	}
 	return nil
 }
 
-type IndexTaskInfo struct {
-	BuildID              int64               `protobuf:"varint,1,opt,name=buildID,proto3" json:"buildID,omitempty"`
-	State                commonpb.IndexState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
-	IndexFileKeys        []string            `protobuf:"bytes,3,rep,name=index_file_keys,json=indexFileKeys,proto3" json:"index_file_keys,omitempty"`
-	SerializedSize       uint64              `protobuf:"varint,4,opt,name=serialized_size,json=serializedSize,proto3" json:"serialized_size,omitempty"`
-	FailReason           string              `protobuf:"bytes,5,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+func (m *CreateJobRequest) GetDataPathRoots() map[string]string {
+	if m != nil {
+		return m.DataPathRoots
+	}
+	return nil
 }
 
-func (m *IndexTaskInfo) Reset()         { *m = IndexTaskInfo{} }
-func (m *IndexTaskInfo) String() string { return proto.CompactTextString(m) }
-func (*IndexTaskInfo) ProtoMessage()    {}
-func (*IndexTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9e019eb3fda53c2, []int{23}
+func (m *CreateJobRequest) GetPkDataPaths() []string {
+	if m != nil {
+		return m.PkDataPaths
+	}
+	return nil
 }
 
-func (m *IndexTaskInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_IndexTaskInfo.Unmarshal(m, b)
-}
-func (m *IndexTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_IndexTaskInfo.Marshal(b, m, deterministic)
-}
-func (m *IndexTaskInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IndexTaskInfo.Merge(m, src)
-}
-func (m *IndexTaskInfo) XXX_Size() int {
-	return xxx_messageInfo_IndexTaskInfo.Size(m)
+func (m *CreateJobRequest) GetEmitPkOffsets() bool {
+	if m != nil {
+		return m.EmitPkOffsets
+	}
+	return false
 }
-func (m *IndexTaskInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_IndexTaskInfo.DiscardUnknown(m)
+
+func (m *CreateJobRequest) GetReservationToken() string {
+	if m != nil {
+		return m.ReservationToken
+	}
+	return ""
 }
 
-var xxx_messageInfo_IndexTaskInfo proto.InternalMessageInfo
+func (m *CreateJobRequest) GetJobClass() string {
+	if m != nil {
+		return m.JobClass
+	}
+	return ""
+}
 
-func (m *IndexTaskInfo) GetBuildID() int64 {
+func (m *CreateJobRequest) GetBinlogSource() string {
 	if m != nil {
-		return m.BuildID
+		return m.BinlogSource
 	}
-	return 0
+	return ""
 }
 
-func (m *IndexTaskInfo) GetState() commonpb.IndexState {
+func (m *CreateJobRequest) GetReplicaStorageConfig() *StorageConfig {
 	if m != nil {
-		return m.State
+		return m.ReplicaStorageConfig
 	}
-	return commonpb.IndexState_IndexStateNone
+	return nil
 }
 
-func (m *IndexTaskInfo) GetIndexFileKeys() []string {
+func (m *CreateJobRequest) GetCompactIndexPaths() []string {
 	if m != nil {
-		return m.IndexFileKeys
+		return m.CompactIndexPaths
 	}
 	return nil
 }
 
-func (m *IndexTaskInfo) GetSerializedSize() uint64 {
+func (m *CreateJobRequest) GetPresignedGetUrls() map[string]string {
 	if m != nil {
-		return m.SerializedSize
+		return m.PresignedGetUrls
 	}
-	return 0
+	return nil
 }
 
-func (m *IndexTaskInfo) GetFailReason() string {
+func (m *CreateJobRequest) GetPresignedUpload() *PresignedUpload {
 	if m != nil {
-		return m.FailReason
+		return m.PresignedUpload
 	}
-	return ""
+	return nil
 }
 
-type QueryJobsResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	ClusterID            string           `protobuf:"bytes,2,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
-	IndexInfos           []*IndexTaskInfo `protobuf:"bytes,3,rep,name=index_infos,json=indexInfos,proto3" json:"index_infos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+// PresignedUpload is a pre-signed POST policy of the object storage, it allows uploading any object whose key
+// starts with key_prefix until the policy expires.
+type PresignedUpload struct {
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// form_data are the signed fields of the policy, they're posted along with the key and the content of every object.
+	FormData             map[string]string `protobuf:"bytes,2,rep,name=form_data,json=formData,proto3" json:"form_data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	KeyPrefix            string            `protobuf:"bytes,3,opt,name=key_prefix,json=keyPrefix,proto3" json:"key_prefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *QueryJobsResponse) Reset()         { *m = QueryJobsResponse{} }
-func (m *QueryJobsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryJobsResponse) ProtoMessage()    {}
-func (*QueryJobsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9e019eb3fda53c2, []int{24}
+func (m *PresignedUpload) Reset()         { *m = PresignedUpload{} }
+func (m *PresignedUpload) String() string { return proto.CompactTextString(m) }
+func (*PresignedUpload) ProtoMessage()    {}
+func (*PresignedUpload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{23}
 }
 
-func (m *QueryJobsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_QueryJobsResponse.Unmarshal(m, b)
+func (m *PresignedUpload) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PresignedUpload.Unmarshal(m, b)
 }
-func (m *QueryJobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_QueryJobsResponse.Marshal(b, m, deterministic)
+func (m *PresignedUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PresignedUpload.Marshal(b, m, deterministic)
 }
-func (m *QueryJobsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryJobsResponse.Merge(m, src)
+func (m *PresignedUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PresignedUpload.Merge(m, src)
 }
-func (m *QueryJobsResponse) XXX_Size() int {
-	return xxx_messageInfo_QueryJobsResponse.Size(m)
+func (m *PresignedUpload) XXX_Size() int {
+	return xxx_messageInfo_PresignedUpload.Size(m)
 }
-func (m *QueryJobsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryJobsResponse.DiscardUnknown(m)
+func (m *PresignedUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_PresignedUpload.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryJobsResponse proto.InternalMessageInfo
+var xxx_messageInfo_PresignedUpload proto.InternalMessageInfo
 
-func (m *QueryJobsResponse) GetStatus() *commonpb.Status {
+func (m *PresignedUpload) GetUrl() string {
 	if m != nil {
-		return m.Status
+		return m.Url
 	}
-	return nil
+	return ""
 }
 
-func (m *QueryJobsResponse) GetClusterID() string {
+func (m *PresignedUpload) GetFormData() map[string]string {
 	if m != nil {
-		return m.ClusterID
+		return m.FormData
 	}
-	return ""
+	return nil
 }
 
-func (m *QueryJobsResponse) GetIndexInfos() []*IndexTaskInfo {
+func (m *PresignedUpload) GetKeyPrefix() string {
 	if m != nil {
-		return m.IndexInfos
+		return m.KeyPrefix
 	}
-	return nil
+	return ""
 }
 
-type DropJobsRequest struct {
-	ClusterID            string   `protobuf:"bytes,1,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
-	BuildIDs             []int64  `protobuf:"varint,2,rep,packed,name=buildIDs,proto3" json:"buildIDs,omitempty"`
+// StorageRoot is a named storage the binlogs of a job are read from.
+type StorageRoot struct {
+	Name          string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	StorageConfig *StorageConfig `protobuf:"bytes,2,opt,name=storage_config,json=storageConfig,proto3" json:"storage_config,omitempty"`
+	// tier is the storage tier of the root, the binlogs of a "cold" root may be archived, IndexNode restores them
+	// and waits for the restore before loading. Empty means the binlogs can be read at once.
+	Tier                 string   `protobuf:"bytes,3,opt,name=tier,proto3" json:"tier,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DropJobsRequest) Reset()         { *m = DropJobsRequest{} }
-func (m *DropJobsRequest) String() string { return proto.CompactTextString(m) }
-func (*DropJobsRequest) ProtoMessage()    {}
-func (*DropJobsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9e019eb3fda53c2, []int{25}
+func (m *StorageRoot) Reset()         { *m = StorageRoot{} }
+func (m *StorageRoot) String() string { return proto.CompactTextString(m) }
+func (*StorageRoot) ProtoMessage()    {}
+func (*StorageRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{24}
 }
 
-func (m *DropJobsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DropJobsRequest.Unmarshal(m, b)
+func (m *StorageRoot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageRoot.Unmarshal(m, b)
 }
-func (m *DropJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DropJobsRequest.Marshal(b, m, deterministic)
+func (m *StorageRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageRoot.Marshal(b, m, deterministic)
 }
-func (m *DropJobsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DropJobsRequest.Merge(m, src)
+func (m *StorageRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageRoot.Merge(m, src)
 }
-func (m *DropJobsRequest) XXX_Size() int {
-	return xxx_messageInfo_DropJobsRequest.Size(m)
+func (m *StorageRoot) XXX_Size() int {
+	return xxx_messageInfo_StorageRoot.Size(m)
 }
-func (m *DropJobsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DropJobsRequest.DiscardUnknown(m)
+func (m *StorageRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageRoot.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DropJobsRequest proto.InternalMessageInfo
+var xxx_messageInfo_StorageRoot proto.InternalMessageInfo
 
-func (m *DropJobsRequest) GetClusterID() string {
+func (m *StorageRoot) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *StorageRoot) GetStorageConfig() *StorageConfig {
+	if m != nil {
+		return m.StorageConfig
+	}
+	return nil
+}
+
+func (m *StorageRoot) GetTier() string {
+	if m != nil {
+		return m.Tier
+	}
+	return ""
+}
+
+type QueryJobsRequest struct {
+	ClusterID string  `protobuf:"bytes,1,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
+	BuildIDs  []int64 `protobuf:"varint,2,rep,packed,name=buildIDs,proto3" json:"buildIDs,omitempty"`
+	// the filters and the pagination only apply when buildIDs is empty, all the tasks of the cluster
+	// matching the filters are listed in the ascending order of buildID then.
+	States       []commonpb.IndexState `protobuf:"varint,3,rep,packed,name=states,proto3,enum=milvus.proto.common.IndexState" json:"states,omitempty"`
+	CollectionID int64                 `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// start_time and end_time bound the time the tasks were created, in unix milliseconds, 0 means unbounded.
+	StartTime int64 `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   int64 `protobuf:"varint,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Offset    int64 `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
+	// limit is the max number of tasks returned, 0 means no limit.
+	Limit                int64    `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryJobsRequest) Reset()         { *m = QueryJobsRequest{} }
+func (m *QueryJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryJobsRequest) ProtoMessage()    {}
+func (*QueryJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{25}
+}
+
+func (m *QueryJobsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryJobsRequest.Unmarshal(m, b)
+}
+func (m *QueryJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryJobsRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryJobsRequest.Merge(m, src)
+}
+func (m *QueryJobsRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryJobsRequest.Size(m)
+}
+func (m *QueryJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryJobsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryJobsRequest proto.InternalMessageInfo
+
+func (m *QueryJobsRequest) GetClusterID() string {
+	if m != nil {
+		return m.ClusterID
+	}
+	return ""
+}
+
+func (m *QueryJobsRequest) GetBuildIDs() []int64 {
+	if m != nil {
+		return m.BuildIDs
+	}
+	return nil
+}
+
+func (m *QueryJobsRequest) GetStates() []commonpb.IndexState {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+func (m *QueryJobsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *QueryJobsRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *QueryJobsRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *QueryJobsRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *QueryJobsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type IndexTaskInfo struct {
+	BuildID        int64               `protobuf:"varint,1,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	State          commonpb.IndexState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
+	IndexFileKeys  []string            `protobuf:"bytes,3,rep,name=index_file_keys,json=indexFileKeys,proto3" json:"index_file_keys,omitempty"`
+	SerializedSize uint64              `protobuf:"varint,4,opt,name=serialized_size,json=serializedSize,proto3" json:"serialized_size,omitempty"`
+	FailReason     string              `protobuf:"bytes,5,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
+	// index_file_sizes are the sizes of index_file_keys, serialized_size is their total before encoding.
+	IndexFileSizes []uint64 `protobuf:"varint,6,rep,packed,name=index_file_sizes,json=indexFileSizes,proto3" json:"index_file_sizes,omitempty"`
+	// mem_size is the estimated memory to load the index.
+	MemSize uint64 `protobuf:"varint,7,opt,name=mem_size,json=memSize,proto3" json:"mem_size,omitempty"`
+	// brute_force is set when the task finished without building an index because the segment is too small,
+	// the segment should be searched by brute force.
+	BruteForce bool `protobuf:"varint,8,opt,name=brute_force,json=bruteForce,proto3" json:"brute_force,omitempty"`
+	// fail_code classifies the failure, it's IllegalDimension or IllegalRowRecord when the binlogs of the job
+	// disagree with its dim or num_rows, the fail_reason describes the binlogs then.
+	FailCode commonpb.ErrorCode `protobuf:"varint,9,opt,name=fail_code,json=failCode,proto3,enum=milvus.proto.common.ErrorCode" json:"fail_code,omitempty"`
+	// warnings describe what the build degraded silently, such as the index params it adjusted
+	// or the rows it skipped, the task still succeeds.
+	Warnings []string `protobuf:"bytes,10,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// awaiting_restore is set while the task waits for its archived binlogs to be restored from the cold tier,
+	// the state is InProgress then.
+	AwaitingRestore bool `protobuf:"varint,11,opt,name=awaiting_restore,json=awaitingRestore,proto3" json:"awaiting_restore,omitempty"`
+	// queue_position is the 1-based position of the task in the build queue of the node while it's queued, the tasks
+	// are issued in the order of the positions if no task is enqueued meanwhile. It's 0 once the task leaves the queue.
+	QueuePosition int64 `protobuf:"varint,12,opt,name=queue_position,json=queuePosition,proto3" json:"queue_position,omitempty"`
+	// priority is the priority of the queued task.
+	Priority int32 `protobuf:"varint,13,opt,name=priority,proto3" json:"priority,omitempty"`
+	// eta_ms is the estimated milliseconds until the queued task finishes, by the build throughput baselines of
+	// the node. It's 0 once the task leaves the queue.
+	EtaMs int64 `protobuf:"varint,14,opt,name=eta_ms,json=etaMs,proto3" json:"eta_ms,omitempty"`
+	// failure_domain classifies the failure of the failed or retried task, so the task is only retried on another
+	// node when it's local to this node.
+	FailureDomain FailureDomain `protobuf:"varint,15,opt,name=failure_domain,json=failureDomain,proto3,enum=milvus.proto.index.FailureDomain" json:"failure_domain,omitempty"`
+	// evicted_state is the state of the completed task whose info was evicted by the retention policy of the node,
+	// the state is IndexStateNone then. It's IndexStateNone if the task is unknown to the node or still tracked.
+	EvictedState         commonpb.IndexState `protobuf:"varint,16,opt,name=evicted_state,json=evictedState,proto3,enum=milvus.proto.common.IndexState" json:"evicted_state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *IndexTaskInfo) Reset()         { *m = IndexTaskInfo{} }
+func (m *IndexTaskInfo) String() string { return proto.CompactTextString(m) }
+func (*IndexTaskInfo) ProtoMessage()    {}
+func (*IndexTaskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{26}
+}
+
+func (m *IndexTaskInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexTaskInfo.Unmarshal(m, b)
+}
+func (m *IndexTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexTaskInfo.Marshal(b, m, deterministic)
+}
+func (m *IndexTaskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexTaskInfo.Merge(m, src)
+}
+func (m *IndexTaskInfo) XXX_Size() int {
+	return xxx_messageInfo_IndexTaskInfo.Size(m)
+}
+func (m *IndexTaskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexTaskInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexTaskInfo proto.InternalMessageInfo
+
+func (m *IndexTaskInfo) GetBuildID() int64 {
+	if m != nil {
+		return m.BuildID
+	}
+	return 0
+}
+
+func (m *IndexTaskInfo) GetState() commonpb.IndexState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.IndexState_IndexStateNone
+}
+
+func (m *IndexTaskInfo) GetIndexFileKeys() []string {
+	if m != nil {
+		return m.IndexFileKeys
+	}
+	return nil
+}
+
+func (m *IndexTaskInfo) GetSerializedSize() uint64 {
+	if m != nil {
+		return m.SerializedSize
+	}
+	return 0
+}
+
+func (m *IndexTaskInfo) GetFailReason() string {
+	if m != nil {
+		return m.FailReason
+	}
+	return ""
+}
+
+func (m *IndexTaskInfo) GetIndexFileSizes() []uint64 {
+	if m != nil {
+		return m.IndexFileSizes
+	}
+	return nil
+}
+
+func (m *IndexTaskInfo) GetMemSize() uint64 {
+	if m != nil {
+		return m.MemSize
+	}
+	return 0
+}
+
+func (m *IndexTaskInfo) GetBruteForce() bool {
+	if m != nil {
+		return m.BruteForce
+	}
+	return false
+}
+
+func (m *IndexTaskInfo) GetFailCode() commonpb.ErrorCode {
+	if m != nil {
+		return m.FailCode
+	}
+	return commonpb.ErrorCode_Success
+}
+
+func (m *IndexTaskInfo) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+func (m *IndexTaskInfo) GetAwaitingRestore() bool {
+	if m != nil {
+		return m.AwaitingRestore
+	}
+	return false
+}
+
+func (m *IndexTaskInfo) GetQueuePosition() int64 {
+	if m != nil {
+		return m.QueuePosition
+	}
+	return 0
+}
+
+func (m *IndexTaskInfo) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *IndexTaskInfo) GetEtaMs() int64 {
+	if m != nil {
+		return m.EtaMs
+	}
+	return 0
+}
+
+func (m *IndexTaskInfo) GetFailureDomain() FailureDomain {
+	if m != nil {
+		return m.FailureDomain
+	}
+	return FailureDomain_UnknownFailure
+}
+
+func (m *IndexTaskInfo) GetEvictedState() commonpb.IndexState {
+	if m != nil {
+		return m.EvictedState
+	}
+	return commonpb.IndexState_IndexStateNone
+}
+
+type QueryJobsResponse struct {
+	Status     *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ClusterID  string           `protobuf:"bytes,2,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
+	IndexInfos []*IndexTaskInfo `protobuf:"bytes,3,rep,name=index_infos,json=indexInfos,proto3" json:"index_infos,omitempty"`
+	// total is the number of the tasks matching the filters before the pagination.
+	Total                int64    `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryJobsResponse) Reset()         { *m = QueryJobsResponse{} }
+func (m *QueryJobsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryJobsResponse) ProtoMessage()    {}
+func (*QueryJobsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{27}
+}
+
+func (m *QueryJobsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryJobsResponse.Unmarshal(m, b)
+}
+func (m *QueryJobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryJobsResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryJobsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryJobsResponse.Merge(m, src)
+}
+func (m *QueryJobsResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryJobsResponse.Size(m)
+}
+func (m *QueryJobsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryJobsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryJobsResponse proto.InternalMessageInfo
+
+func (m *QueryJobsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *QueryJobsResponse) GetClusterID() string {
+	if m != nil {
+		return m.ClusterID
+	}
+	return ""
+}
+
+func (m *QueryJobsResponse) GetIndexInfos() []*IndexTaskInfo {
+	if m != nil {
+		return m.IndexInfos
+	}
+	return nil
+}
+
+func (m *QueryJobsResponse) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type ReportJobResultsRequest struct {
+	ClusterID            string           `protobuf:"bytes,1,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
+	NodeID               int64            `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	IndexInfos           []*IndexTaskInfo `protobuf:"bytes,3,rep,name=index_infos,json=indexInfos,proto3" json:"index_infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReportJobResultsRequest) Reset()         { *m = ReportJobResultsRequest{} }
+func (m *ReportJobResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*ReportJobResultsRequest) ProtoMessage()    {}
+func (*ReportJobResultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{28}
+}
+
+func (m *ReportJobResultsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportJobResultsRequest.Unmarshal(m, b)
+}
+func (m *ReportJobResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportJobResultsRequest.Marshal(b, m, deterministic)
+}
+func (m *ReportJobResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportJobResultsRequest.Merge(m, src)
+}
+func (m *ReportJobResultsRequest) XXX_Size() int {
+	return xxx_messageInfo_ReportJobResultsRequest.Size(m)
+}
+func (m *ReportJobResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportJobResultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportJobResultsRequest proto.InternalMessageInfo
+
+func (m *ReportJobResultsRequest) GetClusterID() string {
+	if m != nil {
+		return m.ClusterID
+	}
+	return ""
+}
+
+func (m *ReportJobResultsRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *ReportJobResultsRequest) GetIndexInfos() []*IndexTaskInfo {
+	if m != nil {
+		return m.IndexInfos
+	}
+	return nil
+}
+
+type ListNodeJobsRequest struct {
+	ClusterID            string   `protobuf:"bytes,1,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
+	NodeID               int64    `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListNodeJobsRequest) Reset()         { *m = ListNodeJobsRequest{} }
+func (m *ListNodeJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListNodeJobsRequest) ProtoMessage()    {}
+func (*ListNodeJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{29}
+}
+
+func (m *ListNodeJobsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListNodeJobsRequest.Unmarshal(m, b)
+}
+func (m *ListNodeJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListNodeJobsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListNodeJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNodeJobsRequest.Merge(m, src)
+}
+func (m *ListNodeJobsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListNodeJobsRequest.Size(m)
+}
+func (m *ListNodeJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNodeJobsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListNodeJobsRequest proto.InternalMessageInfo
+
+func (m *ListNodeJobsRequest) GetClusterID() string {
+	if m != nil {
+		return m.ClusterID
+	}
+	return ""
+}
+
+func (m *ListNodeJobsRequest) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+type ListNodeJobsResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// buildIDs are the index jobs in progress on the node by the index meta.
+	BuildIDs             []int64  `protobuf:"varint,2,rep,packed,name=buildIDs,proto3" json:"buildIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListNodeJobsResponse) Reset()         { *m = ListNodeJobsResponse{} }
+func (m *ListNodeJobsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListNodeJobsResponse) ProtoMessage()    {}
+func (*ListNodeJobsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{30}
+}
+
+func (m *ListNodeJobsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListNodeJobsResponse.Unmarshal(m, b)
+}
+func (m *ListNodeJobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListNodeJobsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListNodeJobsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNodeJobsResponse.Merge(m, src)
+}
+func (m *ListNodeJobsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListNodeJobsResponse.Size(m)
+}
+func (m *ListNodeJobsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNodeJobsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListNodeJobsResponse proto.InternalMessageInfo
+
+func (m *ListNodeJobsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListNodeJobsResponse) GetBuildIDs() []int64 {
+	if m != nil {
+		return m.BuildIDs
+	}
+	return nil
+}
+
+type DropJobsRequest struct {
+	ClusterID            string   `protobuf:"bytes,1,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
+	BuildIDs             []int64  `protobuf:"varint,2,rep,packed,name=buildIDs,proto3" json:"buildIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropJobsRequest) Reset()         { *m = DropJobsRequest{} }
+func (m *DropJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*DropJobsRequest) ProtoMessage()    {}
+func (*DropJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{31}
+}
+
+func (m *DropJobsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropJobsRequest.Unmarshal(m, b)
+}
+func (m *DropJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropJobsRequest.Marshal(b, m, deterministic)
+}
+func (m *DropJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropJobsRequest.Merge(m, src)
+}
+func (m *DropJobsRequest) XXX_Size() int {
+	return xxx_messageInfo_DropJobsRequest.Size(m)
+}
+func (m *DropJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropJobsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropJobsRequest proto.InternalMessageInfo
+
+func (m *DropJobsRequest) GetClusterID() string {
+	if m != nil {
+		return m.ClusterID
+	}
+	return ""
+}
+
+func (m *DropJobsRequest) GetBuildIDs() []int64 {
+	if m != nil {
+		return m.BuildIDs
+	}
+	return nil
+}
+
+type JobInfo struct {
+	NumRows     int64                    `protobuf:"varint,1,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	Dim         int64                    `protobuf:"varint,2,opt,name=dim,proto3" json:"dim,omitempty"`
+	StartTime   int64                    `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime     int64                    `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	IndexParams []*commonpb.KeyValuePair `protobuf:"bytes,5,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
+	PodID       int64                    `protobuf:"varint,6,opt,name=podID,proto3" json:"podID,omitempty"`
+	// stage_mem_deltas are the C heap allocation deltas of the stages of the job, they're
+	// recorded only when indexNode.cgoMemTrace.enable is set.
+	StageMemDeltas       []*StageMemDelta `protobuf:"bytes,7,rep,name=stage_mem_deltas,json=stageMemDeltas,proto3" json:"stage_mem_deltas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *JobInfo) Reset()         { *m = JobInfo{} }
+func (m *JobInfo) String() string { return proto.CompactTextString(m) }
+func (*JobInfo) ProtoMessage()    {}
+func (*JobInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{32}
+}
+
+func (m *JobInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JobInfo.Unmarshal(m, b)
+}
+func (m *JobInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JobInfo.Marshal(b, m, deterministic)
+}
+func (m *JobInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobInfo.Merge(m, src)
+}
+func (m *JobInfo) XXX_Size() int {
+	return xxx_messageInfo_JobInfo.Size(m)
+}
+func (m *JobInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobInfo proto.InternalMessageInfo
+
+func (m *JobInfo) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *JobInfo) GetDim() int64 {
+	if m != nil {
+		return m.Dim
+	}
+	return 0
+}
+
+func (m *JobInfo) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *JobInfo) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *JobInfo) GetIndexParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.IndexParams
+	}
+	return nil
+}
+
+func (m *JobInfo) GetPodID() int64 {
+	if m != nil {
+		return m.PodID
+	}
+	return 0
+}
+
+func (m *JobInfo) GetStageMemDeltas() []*StageMemDelta {
+	if m != nil {
+		return m.StageMemDeltas
+	}
+	return nil
+}
+
+type StageMemDelta struct {
+	Stage string `protobuf:"bytes,1,opt,name=stage,proto3" json:"stage,omitempty"`
+	// allocated_delta is the C heap allocated at the end of the stage minus the one at its start in bytes.
+	AllocatedDelta       int64    `protobuf:"varint,2,opt,name=allocated_delta,json=allocatedDelta,proto3" json:"allocated_delta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StageMemDelta) Reset()         { *m = StageMemDelta{} }
+func (m *StageMemDelta) String() string { return proto.CompactTextString(m) }
+func (*StageMemDelta) ProtoMessage()    {}
+func (*StageMemDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{33}
+}
+
+func (m *StageMemDelta) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StageMemDelta.Unmarshal(m, b)
+}
+func (m *StageMemDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StageMemDelta.Marshal(b, m, deterministic)
+}
+func (m *StageMemDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StageMemDelta.Merge(m, src)
+}
+func (m *StageMemDelta) XXX_Size() int {
+	return xxx_messageInfo_StageMemDelta.Size(m)
+}
+func (m *StageMemDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_StageMemDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StageMemDelta proto.InternalMessageInfo
+
+func (m *StageMemDelta) GetStage() string {
+	if m != nil {
+		return m.Stage
+	}
+	return ""
+}
+
+func (m *StageMemDelta) GetAllocatedDelta() int64 {
+	if m != nil {
+		return m.AllocatedDelta
+	}
+	return 0
+}
+
+type GetJobStatsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetJobStatsRequest) Reset()         { *m = GetJobStatsRequest{} }
+func (m *GetJobStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetJobStatsRequest) ProtoMessage()    {}
+func (*GetJobStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{34}
+}
+
+func (m *GetJobStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetJobStatsRequest.Unmarshal(m, b)
+}
+func (m *GetJobStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetJobStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetJobStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJobStatsRequest.Merge(m, src)
+}
+func (m *GetJobStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetJobStatsRequest.Size(m)
+}
+func (m *GetJobStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJobStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetJobStatsRequest proto.InternalMessageInfo
+
+type GetJobStatsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	TotalJobNum          int64            `protobuf:"varint,2,opt,name=total_job_num,json=totalJobNum,proto3" json:"total_job_num,omitempty"`
+	InProgressJobNum     int64            `protobuf:"varint,3,opt,name=in_progress_job_num,json=inProgressJobNum,proto3" json:"in_progress_job_num,omitempty"`
+	EnqueueJobNum        int64            `protobuf:"varint,4,opt,name=enqueue_job_num,json=enqueueJobNum,proto3" json:"enqueue_job_num,omitempty"`
+	TaskSlots            int64            `protobuf:"varint,5,opt,name=task_slots,json=taskSlots,proto3" json:"task_slots,omitempty"`
+	JobInfos             []*JobInfo       `protobuf:"bytes,6,rep,name=job_infos,json=jobInfos,proto3" json:"job_infos,omitempty"`
+	EnableDisk           bool             `protobuf:"varint,7,opt,name=enable_disk,json=enableDisk,proto3" json:"enable_disk,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetJobStatsResponse) Reset()         { *m = GetJobStatsResponse{} }
+func (m *GetJobStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetJobStatsResponse) ProtoMessage()    {}
+func (*GetJobStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{35}
+}
+
+func (m *GetJobStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetJobStatsResponse.Unmarshal(m, b)
+}
+func (m *GetJobStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetJobStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetJobStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJobStatsResponse.Merge(m, src)
+}
+func (m *GetJobStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetJobStatsResponse.Size(m)
+}
+func (m *GetJobStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJobStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetJobStatsResponse proto.InternalMessageInfo
+
+func (m *GetJobStatsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetJobStatsResponse) GetTotalJobNum() int64 {
+	if m != nil {
+		return m.TotalJobNum
+	}
+	return 0
+}
+
+func (m *GetJobStatsResponse) GetInProgressJobNum() int64 {
+	if m != nil {
+		return m.InProgressJobNum
+	}
+	return 0
+}
+
+func (m *GetJobStatsResponse) GetEnqueueJobNum() int64 {
+	if m != nil {
+		return m.EnqueueJobNum
+	}
+	return 0
+}
+
+func (m *GetJobStatsResponse) GetTaskSlots() int64 {
+	if m != nil {
+		return m.TaskSlots
+	}
+	return 0
+}
+
+func (m *GetJobStatsResponse) GetJobInfos() []*JobInfo {
+	if m != nil {
+		return m.JobInfos
+	}
+	return nil
+}
+
+func (m *GetJobStatsResponse) GetEnableDisk() bool {
+	if m != nil {
+		return m.EnableDisk
+	}
+	return false
+}
+
+type NodeCapabilities struct {
+	IndexTypes           []string `protobuf:"bytes,1,rep,name=index_types,json=indexTypes,proto3" json:"index_types,omitempty"`
+	DiskIndex            bool     `protobuf:"varint,2,opt,name=disk_index,json=diskIndex,proto3" json:"disk_index,omitempty"`
+	Gpu                  bool     `protobuf:"varint,3,opt,name=gpu,proto3" json:"gpu,omitempty"`
+	MaxDim               int64    `protobuf:"varint,4,opt,name=max_dim,json=maxDim,proto3" json:"max_dim,omitempty"`
+	DataFormats          []string `protobuf:"bytes,5,rep,name=data_formats,json=dataFormats,proto3" json:"data_formats,omitempty"`
+	Version              string   `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+	EngineVersions       []string `protobuf:"bytes,7,rep,name=engine_versions,json=engineVersions,proto3" json:"engine_versions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeCapabilities) Reset()         { *m = NodeCapabilities{} }
+func (m *NodeCapabilities) String() string { return proto.CompactTextString(m) }
+func (*NodeCapabilities) ProtoMessage()    {}
+func (*NodeCapabilities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{36}
+}
+
+func (m *NodeCapabilities) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeCapabilities.Unmarshal(m, b)
+}
+func (m *NodeCapabilities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeCapabilities.Marshal(b, m, deterministic)
+}
+func (m *NodeCapabilities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeCapabilities.Merge(m, src)
+}
+func (m *NodeCapabilities) XXX_Size() int {
+	return xxx_messageInfo_NodeCapabilities.Size(m)
+}
+func (m *NodeCapabilities) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeCapabilities.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeCapabilities proto.InternalMessageInfo
+
+func (m *NodeCapabilities) GetIndexTypes() []string {
+	if m != nil {
+		return m.IndexTypes
+	}
+	return nil
+}
+
+func (m *NodeCapabilities) GetDiskIndex() bool {
+	if m != nil {
+		return m.DiskIndex
+	}
+	return false
+}
+
+func (m *NodeCapabilities) GetGpu() bool {
+	if m != nil {
+		return m.Gpu
+	}
+	return false
+}
+
+func (m *NodeCapabilities) GetMaxDim() int64 {
+	if m != nil {
+		return m.MaxDim
+	}
+	return 0
+}
+
+func (m *NodeCapabilities) GetDataFormats() []string {
+	if m != nil {
+		return m.DataFormats
+	}
+	return nil
+}
+
+func (m *NodeCapabilities) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *NodeCapabilities) GetEngineVersions() []string {
+	if m != nil {
+		return m.EngineVersions
+	}
+	return nil
+}
+
+type GetCapabilitiesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCapabilitiesRequest) Reset()         { *m = GetCapabilitiesRequest{} }
+func (m *GetCapabilitiesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCapabilitiesRequest) ProtoMessage()    {}
+func (*GetCapabilitiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{37}
+}
+
+func (m *GetCapabilitiesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCapabilitiesRequest.Unmarshal(m, b)
+}
+func (m *GetCapabilitiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCapabilitiesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCapabilitiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCapabilitiesRequest.Merge(m, src)
+}
+func (m *GetCapabilitiesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCapabilitiesRequest.Size(m)
+}
+func (m *GetCapabilitiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCapabilitiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCapabilitiesRequest proto.InternalMessageInfo
+
+type WatchJobLogRequest struct {
+	ClusterID            string   `protobuf:"bytes,1,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
+	BuildID              int64    `protobuf:"varint,2,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchJobLogRequest) Reset()         { *m = WatchJobLogRequest{} }
+func (m *WatchJobLogRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchJobLogRequest) ProtoMessage()    {}
+func (*WatchJobLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{38}
+}
+
+func (m *WatchJobLogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchJobLogRequest.Unmarshal(m, b)
+}
+func (m *WatchJobLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchJobLogRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchJobLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchJobLogRequest.Merge(m, src)
+}
+func (m *WatchJobLogRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchJobLogRequest.Size(m)
+}
+func (m *WatchJobLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchJobLogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchJobLogRequest proto.InternalMessageInfo
+
+func (m *WatchJobLogRequest) GetClusterID() string {
+	if m != nil {
+		return m.ClusterID
+	}
+	return ""
+}
+
+func (m *WatchJobLogRequest) GetBuildID() int64 {
+	if m != nil {
+		return m.BuildID
+	}
+	return 0
+}
+
+type JobLogEntry struct {
+	// time of the entry in unix milliseconds.
+	Time                 int64                    `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Level                string                   `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	Message              string                   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Fields               []*commonpb.KeyValuePair `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *JobLogEntry) Reset()         { *m = JobLogEntry{} }
+func (m *JobLogEntry) String() string { return proto.CompactTextString(m) }
+func (*JobLogEntry) ProtoMessage()    {}
+func (*JobLogEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{39}
+}
+
+func (m *JobLogEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JobLogEntry.Unmarshal(m, b)
+}
+func (m *JobLogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JobLogEntry.Marshal(b, m, deterministic)
+}
+func (m *JobLogEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobLogEntry.Merge(m, src)
+}
+func (m *JobLogEntry) XXX_Size() int {
+	return xxx_messageInfo_JobLogEntry.Size(m)
+}
+func (m *JobLogEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobLogEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobLogEntry proto.InternalMessageInfo
+
+func (m *JobLogEntry) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *JobLogEntry) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *JobLogEntry) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *JobLogEntry) GetFields() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+type SetLogLevelRequest struct {
+	// level is one of debug, info, warn and error.
+	Level string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	// duration in seconds, 0 means indexNode.logLevel.maxDuration.
+	Duration             int64    `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetLogLevelRequest) Reset()         { *m = SetLogLevelRequest{} }
+func (m *SetLogLevelRequest) String() string { return proto.CompactTextString(m) }
+func (*SetLogLevelRequest) ProtoMessage()    {}
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{40}
+}
+
+func (m *SetLogLevelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLogLevelRequest.Unmarshal(m, b)
+}
+func (m *SetLogLevelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLogLevelRequest.Marshal(b, m, deterministic)
+}
+func (m *SetLogLevelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLogLevelRequest.Merge(m, src)
+}
+func (m *SetLogLevelRequest) XXX_Size() int {
+	return xxx_messageInfo_SetLogLevelRequest.Size(m)
+}
+func (m *SetLogLevelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLogLevelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLogLevelRequest proto.InternalMessageInfo
+
+func (m *SetLogLevelRequest) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *SetLogLevelRequest) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+type ReserveSlotsRequest struct {
+	ClusterID            string                   `protobuf:"bytes,1,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
+	BuildID              int64                    `protobuf:"varint,2,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	NumRows              int64                    `protobuf:"varint,3,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,4,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
+	TypeParams           []*commonpb.KeyValuePair `protobuf:"bytes,5,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
+	Priority             int32                    `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ReserveSlotsRequest) Reset()         { *m = ReserveSlotsRequest{} }
+func (m *ReserveSlotsRequest) String() string { return proto.CompactTextString(m) }
+func (*ReserveSlotsRequest) ProtoMessage()    {}
+func (*ReserveSlotsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{41}
+}
+
+func (m *ReserveSlotsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReserveSlotsRequest.Unmarshal(m, b)
+}
+func (m *ReserveSlotsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReserveSlotsRequest.Marshal(b, m, deterministic)
+}
+func (m *ReserveSlotsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveSlotsRequest.Merge(m, src)
+}
+func (m *ReserveSlotsRequest) XXX_Size() int {
+	return xxx_messageInfo_ReserveSlotsRequest.Size(m)
+}
+func (m *ReserveSlotsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveSlotsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveSlotsRequest proto.InternalMessageInfo
+
+func (m *ReserveSlotsRequest) GetClusterID() string {
+	if m != nil {
+		return m.ClusterID
+	}
+	return ""
+}
+
+func (m *ReserveSlotsRequest) GetBuildID() int64 {
+	if m != nil {
+		return m.BuildID
+	}
+	return 0
+}
+
+func (m *ReserveSlotsRequest) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *ReserveSlotsRequest) GetIndexParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.IndexParams
+	}
+	return nil
+}
+
+func (m *ReserveSlotsRequest) GetTypeParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.TypeParams
+	}
+	return nil
+}
+
+func (m *ReserveSlotsRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+type ReserveSlotsResponse struct {
+	Status   *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Accepted bool             `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// token is passed by the CreateJob of the job to take the reservation, it's empty if the job is denied.
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	// reason tells why the job is denied.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// ttl_ms is how long the reservation is kept, in milliseconds.
+	TtlMs                int64    `protobuf:"varint,5,opt,name=ttl_ms,json=ttlMs,proto3" json:"ttl_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReserveSlotsResponse) Reset()         { *m = ReserveSlotsResponse{} }
+func (m *ReserveSlotsResponse) String() string { return proto.CompactTextString(m) }
+func (*ReserveSlotsResponse) ProtoMessage()    {}
+func (*ReserveSlotsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{42}
+}
+
+func (m *ReserveSlotsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReserveSlotsResponse.Unmarshal(m, b)
+}
+func (m *ReserveSlotsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReserveSlotsResponse.Marshal(b, m, deterministic)
+}
+func (m *ReserveSlotsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveSlotsResponse.Merge(m, src)
+}
+func (m *ReserveSlotsResponse) XXX_Size() int {
+	return xxx_messageInfo_ReserveSlotsResponse.Size(m)
+}
+func (m *ReserveSlotsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveSlotsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveSlotsResponse proto.InternalMessageInfo
+
+func (m *ReserveSlotsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ReserveSlotsResponse) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+func (m *ReserveSlotsResponse) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *ReserveSlotsResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ReserveSlotsResponse) GetTtlMs() int64 {
+	if m != nil {
+		return m.TtlMs
+	}
+	return 0
+}
+
+type HandoffJobsRequest struct {
+	ClusterID            string   `protobuf:"bytes,1,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HandoffJobsRequest) Reset()         { *m = HandoffJobsRequest{} }
+func (m *HandoffJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*HandoffJobsRequest) ProtoMessage()    {}
+func (*HandoffJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{43}
+}
+
+func (m *HandoffJobsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HandoffJobsRequest.Unmarshal(m, b)
+}
+func (m *HandoffJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HandoffJobsRequest.Marshal(b, m, deterministic)
+}
+func (m *HandoffJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandoffJobsRequest.Merge(m, src)
+}
+func (m *HandoffJobsRequest) XXX_Size() int {
+	return xxx_messageInfo_HandoffJobsRequest.Size(m)
+}
+func (m *HandoffJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandoffJobsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandoffJobsRequest proto.InternalMessageInfo
+
+func (m *HandoffJobsRequest) GetClusterID() string {
+	if m != nil {
+		return m.ClusterID
+	}
+	return ""
+}
+
+type HandoffJobsResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// jobs are the requests of the handed off jobs, without the reservation tokens taken on the node.
+	Jobs                 []*CreateJobRequest `protobuf:"bytes,2,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *HandoffJobsResponse) Reset()         { *m = HandoffJobsResponse{} }
+func (m *HandoffJobsResponse) String() string { return proto.CompactTextString(m) }
+func (*HandoffJobsResponse) ProtoMessage()    {}
+func (*HandoffJobsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{44}
+}
+
+func (m *HandoffJobsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HandoffJobsResponse.Unmarshal(m, b)
+}
+func (m *HandoffJobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HandoffJobsResponse.Marshal(b, m, deterministic)
+}
+func (m *HandoffJobsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandoffJobsResponse.Merge(m, src)
+}
+func (m *HandoffJobsResponse) XXX_Size() int {
+	return xxx_messageInfo_HandoffJobsResponse.Size(m)
+}
+func (m *HandoffJobsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandoffJobsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandoffJobsResponse proto.InternalMessageInfo
+
+func (m *HandoffJobsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *HandoffJobsResponse) GetJobs() []*CreateJobRequest {
+	if m != nil {
+		return m.Jobs
+	}
+	return nil
+}
+
+type SelfCheckRequest struct {
+	ClusterID string `protobuf:"bytes,1,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
+	// storage_config is the storage the index files are written to, the storage is not checked if it's not set.
+	StorageConfig *StorageConfig `protobuf:"bytes,2,opt,name=storage_config,json=storageConfig,proto3" json:"storage_config,omitempty"`
+	// rows is the number of the synthetic vectors, 0 means the default of the node.
+	Rows int64 `protobuf:"varint,3,opt,name=rows,proto3" json:"rows,omitempty"`
+	// engine_version is the build engine checked, empty means the default engine.
+	EngineVersion        string   `protobuf:"bytes,4,opt,name=engine_version,json=engineVersion,proto3" json:"engine_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SelfCheckRequest) Reset()         { *m = SelfCheckRequest{} }
+func (m *SelfCheckRequest) String() string { return proto.CompactTextString(m) }
+func (*SelfCheckRequest) ProtoMessage()    {}
+func (*SelfCheckRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{45}
+}
+
+func (m *SelfCheckRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelfCheckRequest.Unmarshal(m, b)
+}
+func (m *SelfCheckRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelfCheckRequest.Marshal(b, m, deterministic)
+}
+func (m *SelfCheckRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelfCheckRequest.Merge(m, src)
+}
+func (m *SelfCheckRequest) XXX_Size() int {
+	return xxx_messageInfo_SelfCheckRequest.Size(m)
+}
+func (m *SelfCheckRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelfCheckRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelfCheckRequest proto.InternalMessageInfo
+
+func (m *SelfCheckRequest) GetClusterID() string {
 	if m != nil {
 		return m.ClusterID
 	}
 	return ""
 }
 
-func (m *DropJobsRequest) GetBuildIDs() []int64 {
+func (m *SelfCheckRequest) GetStorageConfig() *StorageConfig {
+	if m != nil {
+		return m.StorageConfig
+	}
+	return nil
+}
+
+func (m *SelfCheckRequest) GetRows() int64 {
+	if m != nil {
+		return m.Rows
+	}
+	return 0
+}
+
+func (m *SelfCheckRequest) GetEngineVersion() string {
+	if m != nil {
+		return m.EngineVersion
+	}
+	return ""
+}
+
+type SelfCheckResult struct {
+	// dependency is the checked dependency: knowhere, local_disk or storage.
+	Dependency string `protobuf:"bytes,1,opt,name=dependency,proto3" json:"dependency,omitempty"`
+	Passed     bool   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	// reason tells why the check failed.
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ElapsedMs            int64    `protobuf:"varint,4,opt,name=elapsed_ms,json=elapsedMs,proto3" json:"elapsed_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SelfCheckResult) Reset()         { *m = SelfCheckResult{} }
+func (m *SelfCheckResult) String() string { return proto.CompactTextString(m) }
+func (*SelfCheckResult) ProtoMessage()    {}
+func (*SelfCheckResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{46}
+}
+
+func (m *SelfCheckResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelfCheckResult.Unmarshal(m, b)
+}
+func (m *SelfCheckResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelfCheckResult.Marshal(b, m, deterministic)
+}
+func (m *SelfCheckResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelfCheckResult.Merge(m, src)
+}
+func (m *SelfCheckResult) XXX_Size() int {
+	return xxx_messageInfo_SelfCheckResult.Size(m)
+}
+func (m *SelfCheckResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelfCheckResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelfCheckResult proto.InternalMessageInfo
+
+func (m *SelfCheckResult) GetDependency() string {
 	if m != nil {
-		return m.BuildIDs
+		return m.Dependency
+	}
+	return ""
+}
+
+func (m *SelfCheckResult) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+func (m *SelfCheckResult) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *SelfCheckResult) GetElapsedMs() int64 {
+	if m != nil {
+		return m.ElapsedMs
+	}
+	return 0
+}
+
+type SelfCheckResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// passed is set if all the checks passed.
+	Passed               bool               `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Results              []*SelfCheckResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SelfCheckResponse) Reset()         { *m = SelfCheckResponse{} }
+func (m *SelfCheckResponse) String() string { return proto.CompactTextString(m) }
+func (*SelfCheckResponse) ProtoMessage()    {}
+func (*SelfCheckResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{47}
+}
+
+func (m *SelfCheckResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelfCheckResponse.Unmarshal(m, b)
+}
+func (m *SelfCheckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelfCheckResponse.Marshal(b, m, deterministic)
+}
+func (m *SelfCheckResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelfCheckResponse.Merge(m, src)
+}
+func (m *SelfCheckResponse) XXX_Size() int {
+	return xxx_messageInfo_SelfCheckResponse.Size(m)
+}
+func (m *SelfCheckResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelfCheckResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelfCheckResponse proto.InternalMessageInfo
+
+func (m *SelfCheckResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
 	}
 	return nil
 }
 
-type JobInfo struct {
+func (m *SelfCheckResponse) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+func (m *SelfCheckResponse) GetResults() []*SelfCheckResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type SetSuspendedRequest struct {
+	Suspended bool `protobuf:"varint,1,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	// reason tells why the node is suspended, it's reported by GetComponentStates.
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetSuspendedRequest) Reset()         { *m = SetSuspendedRequest{} }
+func (m *SetSuspendedRequest) String() string { return proto.CompactTextString(m) }
+func (*SetSuspendedRequest) ProtoMessage()    {}
+func (*SetSuspendedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{48}
+}
+
+func (m *SetSuspendedRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetSuspendedRequest.Unmarshal(m, b)
+}
+func (m *SetSuspendedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetSuspendedRequest.Marshal(b, m, deterministic)
+}
+func (m *SetSuspendedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetSuspendedRequest.Merge(m, src)
+}
+func (m *SetSuspendedRequest) XXX_Size() int {
+	return xxx_messageInfo_SetSuspendedRequest.Size(m)
+}
+func (m *SetSuspendedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetSuspendedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetSuspendedRequest proto.InternalMessageInfo
+
+func (m *SetSuspendedRequest) GetSuspended() bool {
+	if m != nil {
+		return m.Suspended
+	}
+	return false
+}
+
+func (m *SetSuspendedRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type EstimateWaitTimeRequest struct {
+	// num_rows, index_params and type_params describe a job to estimate the build time of, by the build
+	// throughput baselines of IndexNode. They are optional.
 	NumRows              int64                    `protobuf:"varint,1,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	Dim                  int64                    `protobuf:"varint,2,opt,name=dim,proto3" json:"dim,omitempty"`
-	StartTime            int64                    `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime              int64                    `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,5,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
-	PodID                int64                    `protobuf:"varint,6,opt,name=podID,proto3" json:"podID,omitempty"`
+	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,2,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
+	TypeParams           []*commonpb.KeyValuePair `protobuf:"bytes,3,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *JobInfo) Reset()         { *m = JobInfo{} }
-func (m *JobInfo) String() string { return proto.CompactTextString(m) }
-func (*JobInfo) ProtoMessage()    {}
-func (*JobInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9e019eb3fda53c2, []int{26}
+func (m *EstimateWaitTimeRequest) Reset()         { *m = EstimateWaitTimeRequest{} }
+func (m *EstimateWaitTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateWaitTimeRequest) ProtoMessage()    {}
+func (*EstimateWaitTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{49}
 }
 
-func (m *JobInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_JobInfo.Unmarshal(m, b)
+func (m *EstimateWaitTimeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateWaitTimeRequest.Unmarshal(m, b)
 }
-func (m *JobInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_JobInfo.Marshal(b, m, deterministic)
+func (m *EstimateWaitTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateWaitTimeRequest.Marshal(b, m, deterministic)
 }
-func (m *JobInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobInfo.Merge(m, src)
+func (m *EstimateWaitTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateWaitTimeRequest.Merge(m, src)
 }
-func (m *JobInfo) XXX_Size() int {
-	return xxx_messageInfo_JobInfo.Size(m)
+func (m *EstimateWaitTimeRequest) XXX_Size() int {
+	return xxx_messageInfo_EstimateWaitTimeRequest.Size(m)
 }
-func (m *JobInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobInfo.DiscardUnknown(m)
+func (m *EstimateWaitTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateWaitTimeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobInfo proto.InternalMessageInfo
+var xxx_messageInfo_EstimateWaitTimeRequest proto.InternalMessageInfo
 
-func (m *JobInfo) GetNumRows() int64 {
+func (m *EstimateWaitTimeRequest) GetNumRows() int64 {
 	if m != nil {
 		return m.NumRows
 	}
 	return 0
 }
 
-func (m *JobInfo) GetDim() int64 {
+func (m *EstimateWaitTimeRequest) GetIndexParams() []*commonpb.KeyValuePair {
 	if m != nil {
-		return m.Dim
+		return m.IndexParams
+	}
+	return nil
+}
+
+func (m *EstimateWaitTimeRequest) GetTypeParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.TypeParams
+	}
+	return nil
+}
+
+type EstimateWaitTimeResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// wait_ms is the estimated time a job created now waits in the queue, in milliseconds.
+	WaitMs     int64 `protobuf:"varint,2,opt,name=wait_ms,json=waitMs,proto3" json:"wait_ms,omitempty"`
+	QueuedJobs int64 `protobuf:"varint,3,opt,name=queued_jobs,json=queuedJobs,proto3" json:"queued_jobs,omitempty"`
+	TaskSlots  int64 `protobuf:"varint,4,opt,name=task_slots,json=taskSlots,proto3" json:"task_slots,omitempty"`
+	// slo_violation_ratio is the fraction of the recent jobs waiting longer than indexNode.scheduler.waitTarget.
+	SloViolationRatio float64 `protobuf:"fixed64,5,opt,name=slo_violation_ratio,json=sloViolationRatio,proto3" json:"slo_violation_ratio,omitempty"`
+	// build_ms is the estimated build time of the job of the request in milliseconds, 0 if IndexNode has not built
+	// the index type and dim class of the job yet.
+	BuildMs              int64    `protobuf:"varint,6,opt,name=build_ms,json=buildMs,proto3" json:"build_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EstimateWaitTimeResponse) Reset()         { *m = EstimateWaitTimeResponse{} }
+func (m *EstimateWaitTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateWaitTimeResponse) ProtoMessage()    {}
+func (*EstimateWaitTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{50}
+}
+
+func (m *EstimateWaitTimeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateWaitTimeResponse.Unmarshal(m, b)
+}
+func (m *EstimateWaitTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateWaitTimeResponse.Marshal(b, m, deterministic)
+}
+func (m *EstimateWaitTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateWaitTimeResponse.Merge(m, src)
+}
+func (m *EstimateWaitTimeResponse) XXX_Size() int {
+	return xxx_messageInfo_EstimateWaitTimeResponse.Size(m)
+}
+func (m *EstimateWaitTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateWaitTimeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateWaitTimeResponse proto.InternalMessageInfo
+
+func (m *EstimateWaitTimeResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *EstimateWaitTimeResponse) GetWaitMs() int64 {
+	if m != nil {
+		return m.WaitMs
 	}
 	return 0
 }
 
-func (m *JobInfo) GetStartTime() int64 {
+func (m *EstimateWaitTimeResponse) GetQueuedJobs() int64 {
 	if m != nil {
-		return m.StartTime
+		return m.QueuedJobs
 	}
 	return 0
 }
 
-func (m *JobInfo) GetEndTime() int64 {
+func (m *EstimateWaitTimeResponse) GetTaskSlots() int64 {
 	if m != nil {
-		return m.EndTime
+		return m.TaskSlots
 	}
 	return 0
 }
 
-func (m *JobInfo) GetIndexParams() []*commonpb.KeyValuePair {
+func (m *EstimateWaitTimeResponse) GetSloViolationRatio() float64 {
 	if m != nil {
-		return m.IndexParams
+		return m.SloViolationRatio
 	}
-	return nil
+	return 0
 }
 
-func (m *JobInfo) GetPodID() int64 {
+func (m *EstimateWaitTimeResponse) GetBuildMs() int64 {
 	if m != nil {
-		return m.PodID
+		return m.BuildMs
 	}
 	return 0
 }
 
-type GetJobStatsRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type VerifyIndexRequest struct {
+	ClusterID string `protobuf:"bytes,1,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
+	// jobID identifies the verification job in QueryJobs and DropJobs, it shares the id space of the buildIDs.
+	JobID int64 `protobuf:"varint,2,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	// buildID and the ids below identify the verified index, they are checked against its manifest.
+	BuildID       int64    `protobuf:"varint,3,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	IndexVersion  int64    `protobuf:"varint,4,opt,name=index_version,json=indexVersion,proto3" json:"index_version,omitempty"`
+	IndexID       int64    `protobuf:"varint,5,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	CollectionID  int64    `protobuf:"varint,6,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID   int64    `protobuf:"varint,7,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	SegmentID     int64    `protobuf:"varint,8,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	FieldID       int64    `protobuf:"varint,9,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	IndexFileKeys []string `protobuf:"bytes,10,rep,name=index_file_keys,json=indexFileKeys,proto3" json:"index_file_keys,omitempty"`
+	// index_file_sizes are the sizes of index_file_keys recorded in the meta, they are checked if set.
+	IndexFileSizes []uint64       `protobuf:"varint,11,rep,packed,name=index_file_sizes,json=indexFileSizes,proto3" json:"index_file_sizes,omitempty"`
+	StorageConfig  *StorageConfig `protobuf:"bytes,12,opt,name=storage_config,json=storageConfig,proto3" json:"storage_config,omitempty"`
+	// load loads the index with the build engine, and runs the sanity queries of the engine if it has any.
+	Load                 bool                     `protobuf:"varint,13,opt,name=load,proto3" json:"load,omitempty"`
+	FieldType            schemapb.DataType        `protobuf:"varint,14,opt,name=field_type,json=fieldType,proto3,enum=milvus.proto.schema.DataType" json:"field_type,omitempty"`
+	TypeParams           []*commonpb.KeyValuePair `protobuf:"bytes,15,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
+	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,16,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
+	EngineVersion        string                   `protobuf:"bytes,17,opt,name=engine_version,json=engineVersion,proto3" json:"engine_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *GetJobStatsRequest) Reset()         { *m = GetJobStatsRequest{} }
-func (m *GetJobStatsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetJobStatsRequest) ProtoMessage()    {}
-func (*GetJobStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9e019eb3fda53c2, []int{27}
+func (m *VerifyIndexRequest) Reset()         { *m = VerifyIndexRequest{} }
+func (m *VerifyIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyIndexRequest) ProtoMessage()    {}
+func (*VerifyIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{51}
 }
 
-func (m *GetJobStatsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetJobStatsRequest.Unmarshal(m, b)
+func (m *VerifyIndexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyIndexRequest.Unmarshal(m, b)
 }
-func (m *GetJobStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetJobStatsRequest.Marshal(b, m, deterministic)
+func (m *VerifyIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyIndexRequest.Marshal(b, m, deterministic)
 }
-func (m *GetJobStatsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetJobStatsRequest.Merge(m, src)
+func (m *VerifyIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyIndexRequest.Merge(m, src)
 }
-func (m *GetJobStatsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetJobStatsRequest.Size(m)
+func (m *VerifyIndexRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyIndexRequest.Size(m)
 }
-func (m *GetJobStatsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetJobStatsRequest.DiscardUnknown(m)
+func (m *VerifyIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyIndexRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetJobStatsRequest proto.InternalMessageInfo
+var xxx_messageInfo_VerifyIndexRequest proto.InternalMessageInfo
 
-type GetJobStatsResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	TotalJobNum          int64            `protobuf:"varint,2,opt,name=total_job_num,json=totalJobNum,proto3" json:"total_job_num,omitempty"`
-	InProgressJobNum     int64            `protobuf:"varint,3,opt,name=in_progress_job_num,json=inProgressJobNum,proto3" json:"in_progress_job_num,omitempty"`
-	EnqueueJobNum        int64            `protobuf:"varint,4,opt,name=enqueue_job_num,json=enqueueJobNum,proto3" json:"enqueue_job_num,omitempty"`
-	TaskSlots            int64            `protobuf:"varint,5,opt,name=task_slots,json=taskSlots,proto3" json:"task_slots,omitempty"`
-	JobInfos             []*JobInfo       `protobuf:"bytes,6,rep,name=job_infos,json=jobInfos,proto3" json:"job_infos,omitempty"`
-	EnableDisk           bool             `protobuf:"varint,7,opt,name=enable_disk,json=enableDisk,proto3" json:"enable_disk,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+func (m *VerifyIndexRequest) GetClusterID() string {
+	if m != nil {
+		return m.ClusterID
+	}
+	return ""
 }
 
-func (m *GetJobStatsResponse) Reset()         { *m = GetJobStatsResponse{} }
-func (m *GetJobStatsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetJobStatsResponse) ProtoMessage()    {}
-func (*GetJobStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9e019eb3fda53c2, []int{28}
+func (m *VerifyIndexRequest) GetJobID() int64 {
+	if m != nil {
+		return m.JobID
+	}
+	return 0
 }
 
-func (m *GetJobStatsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetJobStatsResponse.Unmarshal(m, b)
+func (m *VerifyIndexRequest) GetBuildID() int64 {
+	if m != nil {
+		return m.BuildID
+	}
+	return 0
 }
-func (m *GetJobStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetJobStatsResponse.Marshal(b, m, deterministic)
+
+func (m *VerifyIndexRequest) GetIndexVersion() int64 {
+	if m != nil {
+		return m.IndexVersion
+	}
+	return 0
 }
-func (m *GetJobStatsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetJobStatsResponse.Merge(m, src)
+
+func (m *VerifyIndexRequest) GetIndexID() int64 {
+	if m != nil {
+		return m.IndexID
+	}
+	return 0
 }
-func (m *GetJobStatsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetJobStatsResponse.Size(m)
+
+func (m *VerifyIndexRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
 }
-func (m *GetJobStatsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetJobStatsResponse.DiscardUnknown(m)
+
+func (m *VerifyIndexRequest) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
 }
 
-var xxx_messageInfo_GetJobStatsResponse proto.InternalMessageInfo
+func (m *VerifyIndexRequest) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *VerifyIndexRequest) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
+func (m *VerifyIndexRequest) GetIndexFileKeys() []string {
+	if m != nil {
+		return m.IndexFileKeys
+	}
+	return nil
+}
+
+func (m *VerifyIndexRequest) GetIndexFileSizes() []uint64 {
+	if m != nil {
+		return m.IndexFileSizes
+	}
+	return nil
+}
 
-func (m *GetJobStatsResponse) GetStatus() *commonpb.Status {
+func (m *VerifyIndexRequest) GetStorageConfig() *StorageConfig {
 	if m != nil {
-		return m.Status
+		return m.StorageConfig
 	}
 	return nil
 }
 
-func (m *GetJobStatsResponse) GetTotalJobNum() int64 {
+func (m *VerifyIndexRequest) GetLoad() bool {
 	if m != nil {
-		return m.TotalJobNum
+		return m.Load
 	}
-	return 0
+	return false
 }
 
-func (m *GetJobStatsResponse) GetInProgressJobNum() int64 {
+func (m *VerifyIndexRequest) GetFieldType() schemapb.DataType {
 	if m != nil {
-		return m.InProgressJobNum
+		return m.FieldType
 	}
-	return 0
+	return schemapb.DataType_None
 }
 
-func (m *GetJobStatsResponse) GetEnqueueJobNum() int64 {
+func (m *VerifyIndexRequest) GetTypeParams() []*commonpb.KeyValuePair {
 	if m != nil {
-		return m.EnqueueJobNum
+		return m.TypeParams
 	}
-	return 0
+	return nil
 }
 
-func (m *GetJobStatsResponse) GetTaskSlots() int64 {
+func (m *VerifyIndexRequest) GetIndexParams() []*commonpb.KeyValuePair {
 	if m != nil {
-		return m.TaskSlots
+		return m.IndexParams
 	}
-	return 0
+	return nil
 }
 
-func (m *GetJobStatsResponse) GetJobInfos() []*JobInfo {
+func (m *VerifyIndexRequest) GetEngineVersion() string {
 	if m != nil {
-		return m.JobInfos
+		return m.EngineVersion
+	}
+	return ""
+}
+
+type GetCapabilitiesResponse struct {
+	Status               *commonpb.Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Capabilities         *NodeCapabilities `protobuf:"bytes,2,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetCapabilitiesResponse) Reset()         { *m = GetCapabilitiesResponse{} }
+func (m *GetCapabilitiesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCapabilitiesResponse) ProtoMessage()    {}
+func (*GetCapabilitiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{52}
+}
+
+func (m *GetCapabilitiesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCapabilitiesResponse.Unmarshal(m, b)
+}
+func (m *GetCapabilitiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCapabilitiesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCapabilitiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCapabilitiesResponse.Merge(m, src)
+}
+func (m *GetCapabilitiesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCapabilitiesResponse.Size(m)
+}
+func (m *GetCapabilitiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCapabilitiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCapabilitiesResponse proto.InternalMessageInfo
+
+func (m *GetCapabilitiesResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
 	}
 	return nil
 }
 
-func (m *GetJobStatsResponse) GetEnableDisk() bool {
+func (m *GetCapabilitiesResponse) GetCapabilities() *NodeCapabilities {
 	if m != nil {
-		return m.EnableDisk
+		return m.Capabilities
 	}
-	return false
+	return nil
 }
 
 func init() {
+	proto.RegisterEnum("milvus.proto.index.FailureDomain", FailureDomain_name, FailureDomain_value)
 	proto.RegisterType((*IndexInfo)(nil), "milvus.proto.index.IndexInfo")
 	proto.RegisterType((*FieldIndex)(nil), "milvus.proto.index.FieldIndex")
 	proto.RegisterType((*SegmentIndex)(nil), "milvus.proto.index.SegmentIndex")
@@ -2016,157 +3922,298 @@ func init() {
 	proto.RegisterType((*GetIndexBuildProgressRequest)(nil), "milvus.proto.index.GetIndexBuildProgressRequest")
 	proto.RegisterType((*GetIndexBuildProgressResponse)(nil), "milvus.proto.index.GetIndexBuildProgressResponse")
 	proto.RegisterType((*StorageConfig)(nil), "milvus.proto.index.StorageConfig")
+	proto.RegisterType((*ZoneEndpoint)(nil), "milvus.proto.index.ZoneEndpoint")
 	proto.RegisterType((*CreateJobRequest)(nil), "milvus.proto.index.CreateJobRequest")
+	proto.RegisterMapType((map[string]string)(nil), "milvus.proto.index.CreateJobRequest.DataPathRootsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "milvus.proto.index.CreateJobRequest.PresignedGetUrlsEntry")
+	proto.RegisterType((*PresignedUpload)(nil), "milvus.proto.index.PresignedUpload")
+	proto.RegisterMapType((map[string]string)(nil), "milvus.proto.index.PresignedUpload.FormDataEntry")
+	proto.RegisterType((*StorageRoot)(nil), "milvus.proto.index.StorageRoot")
 	proto.RegisterType((*QueryJobsRequest)(nil), "milvus.proto.index.QueryJobsRequest")
 	proto.RegisterType((*IndexTaskInfo)(nil), "milvus.proto.index.IndexTaskInfo")
 	proto.RegisterType((*QueryJobsResponse)(nil), "milvus.proto.index.QueryJobsResponse")
+	proto.RegisterType((*ReportJobResultsRequest)(nil), "milvus.proto.index.ReportJobResultsRequest")
+	proto.RegisterType((*ListNodeJobsRequest)(nil), "milvus.proto.index.ListNodeJobsRequest")
+	proto.RegisterType((*ListNodeJobsResponse)(nil), "milvus.proto.index.ListNodeJobsResponse")
 	proto.RegisterType((*DropJobsRequest)(nil), "milvus.proto.index.DropJobsRequest")
 	proto.RegisterType((*JobInfo)(nil), "milvus.proto.index.JobInfo")
+	proto.RegisterType((*StageMemDelta)(nil), "milvus.proto.index.StageMemDelta")
 	proto.RegisterType((*GetJobStatsRequest)(nil), "milvus.proto.index.GetJobStatsRequest")
 	proto.RegisterType((*GetJobStatsResponse)(nil), "milvus.proto.index.GetJobStatsResponse")
+	proto.RegisterType((*NodeCapabilities)(nil), "milvus.proto.index.NodeCapabilities")
+	proto.RegisterType((*GetCapabilitiesRequest)(nil), "milvus.proto.index.GetCapabilitiesRequest")
+	proto.RegisterType((*WatchJobLogRequest)(nil), "milvus.proto.index.WatchJobLogRequest")
+	proto.RegisterType((*JobLogEntry)(nil), "milvus.proto.index.JobLogEntry")
+	proto.RegisterType((*SetLogLevelRequest)(nil), "milvus.proto.index.SetLogLevelRequest")
+	proto.RegisterType((*ReserveSlotsRequest)(nil), "milvus.proto.index.ReserveSlotsRequest")
+	proto.RegisterType((*ReserveSlotsResponse)(nil), "milvus.proto.index.ReserveSlotsResponse")
+	proto.RegisterType((*HandoffJobsRequest)(nil), "milvus.proto.index.HandoffJobsRequest")
+	proto.RegisterType((*HandoffJobsResponse)(nil), "milvus.proto.index.HandoffJobsResponse")
+	proto.RegisterType((*SelfCheckRequest)(nil), "milvus.proto.index.SelfCheckRequest")
+	proto.RegisterType((*SelfCheckResult)(nil), "milvus.proto.index.SelfCheckResult")
+	proto.RegisterType((*SelfCheckResponse)(nil), "milvus.proto.index.SelfCheckResponse")
+	proto.RegisterType((*SetSuspendedRequest)(nil), "milvus.proto.index.SetSuspendedRequest")
+	proto.RegisterType((*EstimateWaitTimeRequest)(nil), "milvus.proto.index.EstimateWaitTimeRequest")
+	proto.RegisterType((*EstimateWaitTimeResponse)(nil), "milvus.proto.index.EstimateWaitTimeResponse")
+	proto.RegisterType((*VerifyIndexRequest)(nil), "milvus.proto.index.VerifyIndexRequest")
+	proto.RegisterType((*GetCapabilitiesResponse)(nil), "milvus.proto.index.GetCapabilitiesResponse")
 }
 
 func init() { proto.RegisterFile("index_coord.proto", fileDescriptor_f9e019eb3fda53c2) }
 
 var fileDescriptor_f9e019eb3fda53c2 = []byte{
-	// 2184 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0xcd, 0x8e, 0xdb, 0xc8,
-	0xf1, 0x37, 0x45, 0xcd, 0x8c, 0x58, 0x94, 0xe6, 0xa3, 0xed, 0xfd, 0xff, 0x65, 0xd9, 0x8e, 0xc7,
-	0xf4, 0xda, 0x56, 0x02, 0xec, 0xd8, 0x99, 0xcd, 0x06, 0x9b, 0x20, 0x09, 0x30, 0x9e, 0x59, 0xdb,
-	0xb2, 0xd7, 0xc6, 0x84, 0x32, 0x16, 0xc8, 0x22, 0x80, 0x42, 0x89, 0xad, 0x99, 0xde, 0xa1, 0xd8,
-	0x32, 0xbb, 0x69, 0x7b, 0x1c, 0x20, 0xc8, 0x65, 0x0f, 0x59, 0x2c, 0x10, 0x20, 0x08, 0x92, 0x17,
-	0xc8, 0x69, 0x73, 0xc8, 0x3d, 0x97, 0xbc, 0x40, 0x4e, 0x79, 0x84, 0xbc, 0x44, 0xae, 0x41, 0x7f,
-	0x90, 0x22, 0x29, 0x6a, 0xa4, 0xf9, 0xc8, 0x25, 0xb9, 0xa9, 0x8b, 0xd5, 0x5f, 0x55, 0xbf, 0xaa,
-	0xfa, 0x55, 0x0b, 0x36, 0x48, 0xe8, 0xe3, 0xb7, 0xbd, 0x01, 0xa5, 0x91, 0xbf, 0x35, 0x8e, 0x28,
-	0xa7, 0x08, 0x8d, 0x48, 0xf0, 0x3a, 0x66, 0x6a, 0xb4, 0x25, 0xbf, 0xb7, 0xea, 0x03, 0x3a, 0x1a,
-	0xd1, 0x50, 0xc9, 0x5a, 0xab, 0x24, 0xe4, 0x38, 0x0a, 0xbd, 0x40, 0x8f, 0xeb, 0xd9, 0x19, 0xce,
-	0x5f, 0xaa, 0x60, 0x75, 0xc4, 0xac, 0x4e, 0x38, 0xa4, 0xc8, 0x81, 0xfa, 0x80, 0x06, 0x01, 0x1e,
-	0x70, 0x42, 0xc3, 0xce, 0x5e, 0xd3, 0xd8, 0x34, 0xda, 0xa6, 0x9b, 0x93, 0xa1, 0x26, 0xac, 0x0c,
-	0x09, 0x0e, 0xfc, 0xce, 0x5e, 0xb3, 0x22, 0x3f, 0x27, 0x43, 0x74, 0x03, 0x40, 0x1d, 0x30, 0xf4,
-	0x46, 0xb8, 0x69, 0x6e, 0x1a, 0x6d, 0xcb, 0xb5, 0xa4, 0xe4, 0x85, 0x37, 0xc2, 0x62, 0xa2, 0x1c,
-	0x74, 0xf6, 0x9a, 0x55, 0x35, 0x51, 0x0f, 0xd1, 0x43, 0xb0, 0xf9, 0xf1, 0x18, 0xf7, 0xc6, 0x5e,
-	0xe4, 0x8d, 0x58, 0x73, 0x69, 0xd3, 0x6c, 0xdb, 0xdb, 0xb7, 0xb6, 0x72, 0x57, 0xd3, 0x77, 0x7a,
-	0x86, 0x8f, 0x3f, 0xf3, 0x82, 0x18, 0xef, 0x7b, 0x24, 0x72, 0x41, 0xcc, 0xda, 0x97, 0x93, 0xd0,
-	0x1e, 0xd4, 0xd5, 0xe6, 0x7a, 0x91, 0xe5, 0x45, 0x17, 0xb1, 0xe5, 0x34, 0xbd, 0xca, 0x2d, 0xbd,
-	0x0a, 0xf6, 0x7b, 0x11, 0x7d, 0xc3, 0x9a, 0x2b, 0xf2, 0xa0, 0xb6, 0x96, 0xb9, 0xf4, 0x0d, 0x13,
-	0xb7, 0xe4, 0x94, 0x7b, 0x81, 0x52, 0xa8, 0x49, 0x05, 0x4b, 0x4a, 0xe4, 0xe7, 0x8f, 0x60, 0x89,
-	0x71, 0x8f, 0xe3, 0xa6, 0xb5, 0x69, 0xb4, 0x57, 0xb7, 0x6f, 0x96, 0x1e, 0x40, 0x5a, 0xbc, 0x2b,
-	0xd4, 0x5c, 0xa5, 0x8d, 0x3e, 0x82, 0xff, 0x57, 0xc7, 0x97, 0xc3, 0xde, 0xd0, 0x23, 0x41, 0x2f,
-	0xc2, 0x1e, 0xa3, 0x61, 0x13, 0xa4, 0x21, 0xaf, 0x90, 0x74, 0xce, 0x23, 0x8f, 0x04, 0xae, 0xfc,
-	0x86, 0x1c, 0x68, 0x10, 0xd6, 0xf3, 0x62, 0x4e, 0x7b, 0xf2, 0x7b, 0xd3, 0xde, 0x34, 0xda, 0x35,
-	0xd7, 0x26, 0x6c, 0x27, 0xe6, 0x54, 0x6e, 0x83, 0x9e, 0xc3, 0x46, 0xcc, 0x70, 0xd4, 0xcb, 0x99,
-	0xa7, 0xbe, 0xa8, 0x79, 0xd6, 0xc4, 0xdc, 0xce, 0xc4, 0x44, 0xce, 0x97, 0x06, 0xc0, 0x23, 0xe9,
-	0x71, 0xb9, 0xfa, 0x8f, 0x12, 0xa7, 0x93, 0x70, 0x48, 0x25, 0x60, 0xec, 0xed, 0x1b, 0x5b, 0xd3,
-	0xa8, 0xdc, 0x4a, 0x51, 0xa6, 0x31, 0x21, 0x01, 0xd7, 0x84, 0x15, 0x1f, 0x07, 0x98, 0x63, 0x5f,
-	0x82, 0xa9, 0xe6, 0x26, 0x43, 0x74, 0x13, 0xec, 0x41, 0x84, 0x85, 0x2d, 0x38, 0xd1, 0x68, 0xaa,
-	0xba, 0xa0, 0x44, 0x2f, 0xc9, 0x08, 0x3b, 0x5f, 0x56, 0xa1, 0xde, 0xc5, 0x07, 0x23, 0x1c, 0x72,
-	0x75, 0x92, 0x45, 0xc0, 0xbb, 0x09, 0xf6, 0xd8, 0x8b, 0x38, 0xd1, 0x2a, 0x0a, 0xc0, 0x59, 0x11,
-	0xba, 0x0e, 0x16, 0xd3, 0xab, 0xee, 0xc9, 0x5d, 0x4d, 0x77, 0x22, 0x40, 0x57, 0xa1, 0x16, 0xc6,
-	0x23, 0xe5, 0x7a, 0x0d, 0xe2, 0x30, 0x1e, 0x49, 0xc7, 0x67, 0xe0, 0xbd, 0x94, 0x87, 0x77, 0x13,
-	0x56, 0xfa, 0x31, 0x91, 0x11, 0xb3, 0xac, 0xbe, 0xe8, 0x21, 0xfa, 0x3f, 0x58, 0x0e, 0xa9, 0x8f,
-	0x3b, 0x7b, 0x1a, 0x68, 0x7a, 0x84, 0x6e, 0x43, 0x43, 0x19, 0xf5, 0x35, 0x8e, 0x18, 0xa1, 0xa1,
-	0x86, 0x99, 0xc2, 0xe6, 0x67, 0x4a, 0x76, 0x56, 0xa4, 0xdd, 0x04, 0x7b, 0x1a, 0x5d, 0x30, 0x9c,
-	0x60, 0xea, 0x2e, 0xac, 0xa9, 0xcd, 0x87, 0x24, 0xc0, 0xbd, 0x23, 0x7c, 0xcc, 0x9a, 0xf6, 0xa6,
-	0xd9, 0xb6, 0x5c, 0x75, 0xa6, 0x47, 0x24, 0xc0, 0xcf, 0xf0, 0x31, 0xcb, 0xfa, 0xae, 0x7e, 0xa2,
-	0xef, 0x1a, 0x45, 0xdf, 0xa1, 0x3b, 0xb0, 0xca, 0x70, 0x44, 0xbc, 0x80, 0xbc, 0xc3, 0x3d, 0x46,
-	0xde, 0xe1, 0xe6, 0xaa, 0xd4, 0x69, 0xa4, 0xd2, 0x2e, 0x79, 0x87, 0x85, 0x19, 0xde, 0x44, 0x84,
-	0xe3, 0xde, 0xa1, 0x17, 0xfa, 0x74, 0x38, 0x6c, 0xae, 0xc9, 0x7d, 0xea, 0x52, 0xf8, 0x44, 0xc9,
-	0x9c, 0x3f, 0x1a, 0x70, 0xd9, 0xc5, 0x07, 0x84, 0x71, 0x1c, 0xbd, 0xa0, 0x3e, 0x76, 0xf1, 0xab,
-	0x18, 0x33, 0x8e, 0x1e, 0x40, 0xb5, 0xef, 0x31, 0xac, 0x21, 0x79, 0xbd, 0xd4, 0x3a, 0xcf, 0xd9,
-	0xc1, 0x43, 0x8f, 0x61, 0x57, 0x6a, 0xa2, 0xef, 0xc3, 0x8a, 0xe7, 0xfb, 0x11, 0x66, 0x4c, 0x02,
-	0x63, 0xd6, 0xa4, 0x1d, 0xa5, 0xe3, 0x26, 0xca, 0x19, 0x2f, 0x9a, 0x59, 0x2f, 0x3a, 0xbf, 0x35,
-	0xe0, 0x4a, 0xfe, 0x64, 0x6c, 0x4c, 0x43, 0x86, 0xd1, 0x87, 0xb0, 0x2c, 0x7c, 0x11, 0x33, 0x7d,
-	0xb8, 0x6b, 0xa5, 0xfb, 0x74, 0xa5, 0x8a, 0xab, 0x55, 0x45, 0x92, 0x24, 0x21, 0xe1, 0x49, 0x00,
-	0xab, 0x13, 0xde, 0x2a, 0x46, 0x9a, 0x4e, 0xf5, 0x9d, 0x90, 0x70, 0x15, 0xaf, 0x2e, 0x90, 0xf4,
-	0xb7, 0xf3, 0x33, 0xb8, 0xf2, 0x18, 0xf3, 0x0c, 0x26, 0xb4, 0xad, 0x16, 0x09, 0x9d, 0x7c, 0x76,
-	0xaf, 0x14, 0xb2, 0xbb, 0xf3, 0x27, 0x03, 0xde, 0x2b, 0xac, 0x7d, 0x9e, 0xdb, 0xa6, 0xe0, 0xae,
-	0x9c, 0x07, 0xdc, 0x66, 0x11, 0xdc, 0xce, 0xaf, 0x0d, 0xb8, 0xf6, 0x18, 0xf3, 0x6c, 0xe2, 0xb8,
-	0x60, 0x4b, 0xa0, 0x6f, 0x01, 0xa4, 0x09, 0x83, 0x35, 0xcd, 0x4d, 0xb3, 0x6d, 0xba, 0x19, 0x89,
-	0xf3, 0x1b, 0x03, 0x36, 0xa6, 0xf6, 0xcf, 0xe7, 0x1d, 0xa3, 0x98, 0x77, 0xfe, 0x53, 0xe6, 0xf8,
-	0x9d, 0x01, 0xd7, 0xcb, 0xcd, 0x71, 0x1e, 0xe7, 0xfd, 0x58, 0x4d, 0xc2, 0x02, 0xa5, 0xa2, 0xcc,
-	0xdc, 0x29, 0xab, 0x07, 0xd3, 0x7b, 0xea, 0x49, 0xce, 0xd7, 0x26, 0xa0, 0x5d, 0x99, 0x2c, 0xe4,
-	0xc7, 0xd3, 0xb8, 0xe6, 0xcc, 0xe4, 0xa4, 0x40, 0x41, 0xaa, 0x17, 0x41, 0x41, 0x96, 0xce, 0x44,
-	0x41, 0xae, 0x83, 0x25, 0xb2, 0x26, 0xe3, 0xde, 0x68, 0x2c, 0xeb, 0x45, 0xd5, 0x9d, 0x08, 0xa6,
-	0x0b, 0xfe, 0xca, 0x82, 0x05, 0xbf, 0x76, 0xe6, 0x82, 0xff, 0x16, 0x2e, 0x27, 0x81, 0x2d, 0xcb,
-	0xf7, 0x29, 0xdc, 0x91, 0x0f, 0x85, 0x4a, 0x31, 0x14, 0xe6, 0x38, 0xc5, 0xf9, 0x57, 0x05, 0x36,
-	0x3a, 0x49, 0xcd, 0xd9, 0xf7, 0xf8, 0xa1, 0xe4, 0x0c, 0x27, 0x47, 0xca, 0x6c, 0x04, 0x64, 0x0a,
-	0xb4, 0x39, 0xb3, 0x40, 0x57, 0xf3, 0x05, 0x3a, 0x7f, 0xc0, 0xa5, 0x22, 0x6a, 0x2e, 0x86, 0x74,
-	0xb6, 0x61, 0x3d, 0x53, 0x70, 0xc7, 0x1e, 0x3f, 0x14, 0xc4, 0x53, 0x54, 0xdc, 0x55, 0x92, 0xbd,
-	0x3d, 0x43, 0xf7, 0x60, 0x2d, 0xad, 0x90, 0xbe, 0x2a, 0x9c, 0x35, 0x89, 0x90, 0x49, 0x39, 0xf5,
-	0x93, 0xca, 0x99, 0x27, 0x10, 0x56, 0x09, 0x81, 0xc8, 0x92, 0x19, 0xc8, 0x91, 0x19, 0xe7, 0xaf,
-	0x06, 0xd8, 0x69, 0x80, 0x2e, 0xd8, 0x18, 0xe4, 0xfc, 0x52, 0x29, 0xfa, 0xe5, 0x16, 0xd4, 0x71,
-	0xe8, 0xf5, 0x03, 0xac, 0x71, 0x6b, 0x2a, 0xdc, 0x2a, 0x99, 0xc2, 0xed, 0x23, 0xb0, 0x27, 0x54,
-	0x32, 0x89, 0xc1, 0x3b, 0x33, 0xb9, 0x64, 0x16, 0x14, 0x2e, 0xa4, 0x9c, 0x92, 0x39, 0x5f, 0x55,
-	0x26, 0x65, 0x4e, 0x21, 0xf6, 0x3c, 0xc9, 0xec, 0xe7, 0x50, 0xd7, 0xb7, 0x50, 0x14, 0x57, 0xa5,
-	0xb4, 0x1f, 0x94, 0x1d, 0xab, 0x6c, 0xd3, 0xad, 0x8c, 0x19, 0x3f, 0x09, 0x79, 0x74, 0xec, 0xda,
-	0x6c, 0x22, 0x69, 0xf5, 0x60, 0xbd, 0xa8, 0x80, 0xd6, 0xc1, 0x3c, 0xc2, 0xc7, 0xda, 0xc6, 0xe2,
-	0xa7, 0x48, 0xff, 0xaf, 0x05, 0x76, 0x74, 0xd5, 0xbf, 0x79, 0x62, 0x3e, 0x1d, 0x52, 0x57, 0x69,
-	0xff, 0xb0, 0xf2, 0xb1, 0xe1, 0xfc, 0xde, 0x80, 0xf5, 0xbd, 0x88, 0x8e, 0x4f, 0x9d, 0x4a, 0x1d,
-	0xa8, 0x67, 0x78, 0x71, 0x12, 0xbd, 0x39, 0xd9, 0xbc, 0xa4, 0x7a, 0x15, 0x6a, 0x7e, 0x44, 0xc7,
-	0x3d, 0x2f, 0x08, 0x64, 0x60, 0x09, 0x8a, 0x18, 0xd1, 0xf1, 0x4e, 0x10, 0x08, 0x26, 0xb2, 0x87,
-	0xd9, 0x20, 0x22, 0xfd, 0xd3, 0x27, 0xf9, 0x39, 0x4c, 0xe4, 0x6b, 0x03, 0xde, 0x2b, 0xac, 0x7d,
-	0x1e, 0xff, 0xff, 0x24, 0x8f, 0x4a, 0xe5, 0xfe, 0x39, 0x1d, 0x4e, 0x16, 0x8d, 0x9e, 0xac, 0xb0,
-	0xf2, 0xdb, 0x43, 0x91, 0x55, 0xf6, 0x23, 0x7a, 0x20, 0xf9, 0xe3, 0xc5, 0xdd, 0xf8, 0x0f, 0x06,
-	0xdc, 0x98, 0xb1, 0xc7, 0x79, 0x6e, 0x5e, 0x6c, 0x86, 0x2b, 0xf3, 0x9a, 0x61, 0xb3, 0xd0, 0x0c,
-	0x3b, 0x7f, 0xae, 0x40, 0xa3, 0xcb, 0x69, 0xe4, 0x1d, 0xe0, 0x5d, 0x1a, 0x0e, 0xc9, 0x81, 0x48,
-	0xb5, 0x09, 0xc7, 0x36, 0xe4, 0x35, 0x52, 0x16, 0x7d, 0x0b, 0xea, 0xde, 0x60, 0x80, 0x19, 0x13,
-	0x2d, 0x87, 0xce, 0x20, 0x96, 0x6b, 0x2b, 0xd9, 0x33, 0x21, 0x42, 0xdf, 0x81, 0x0d, 0x86, 0x07,
-	0x11, 0xe6, 0xbd, 0x89, 0xa6, 0x46, 0xdd, 0x9a, 0xfa, 0xb0, 0x93, 0x68, 0x0b, 0x52, 0x1e, 0x33,
-	0xdc, 0xed, 0x7e, 0xaa, 0x91, 0xa7, 0x47, 0x82, 0x12, 0xf5, 0xe3, 0xc1, 0x11, 0xe6, 0xd9, 0x94,
-	0x0e, 0x4a, 0x24, 0x41, 0x7b, 0x0d, 0xac, 0x88, 0x52, 0x2e, 0xf3, 0xb0, 0xac, 0xbf, 0x96, 0x5b,
-	0x13, 0x02, 0x91, 0x6a, 0xf4, 0xaa, 0x9d, 0x9d, 0xe7, 0xba, 0xee, 0xea, 0x91, 0xe8, 0x2b, 0x3b,
-	0x3b, 0xcf, 0x3f, 0x09, 0xfd, 0x31, 0x25, 0x21, 0x97, 0x49, 0xd9, 0x72, 0xb3, 0x22, 0x71, 0x3d,
-	0xa6, 0x2c, 0xd1, 0x13, 0x94, 0x41, 0x26, 0x64, 0xcb, 0xb5, 0xb5, 0xec, 0xe5, 0xf1, 0x18, 0x3b,
-	0xff, 0x34, 0x61, 0x5d, 0xf1, 0x9e, 0xa7, 0xb4, 0x9f, 0xc0, 0xe3, 0x3a, 0x58, 0x83, 0x20, 0x16,
-	0x2d, 0x84, 0xc6, 0x86, 0xe5, 0x4e, 0x04, 0xc2, 0x22, 0xd9, 0xd2, 0x11, 0xe1, 0x21, 0x79, 0xab,
-	0x2d, 0xb7, 0x36, 0xa9, 0x1d, 0x52, 0x9c, 0xad, 0x72, 0xe6, 0x54, 0x95, 0xf3, 0x3d, 0xee, 0xe9,
-	0xd2, 0x53, 0x95, 0xa5, 0xc7, 0x12, 0x12, 0x55, 0x75, 0xa6, 0x8a, 0xc9, 0x52, 0x49, 0x31, 0xc9,
-	0x54, 0xd7, 0xe5, 0x7c, 0x75, 0xcd, 0x83, 0x77, 0xa5, 0x98, 0x24, 0x9e, 0xc0, 0x6a, 0x62, 0x98,
-	0x81, 0xc4, 0x88, 0xb4, 0x5e, 0x49, 0x6b, 0x23, 0x93, 0x5c, 0x16, 0x4c, 0x6e, 0x83, 0xe5, 0xb0,
-	0x55, 0xac, 0xc6, 0xd6, 0x99, 0xaa, 0x71, 0x81, 0x09, 0xc2, 0x59, 0x98, 0x60, 0xb6, 0xb2, 0xda,
-	0xf9, 0xca, 0xfa, 0x29, 0xac, 0xff, 0x34, 0xc6, 0xd1, 0xf1, 0x53, 0xda, 0x67, 0x8b, 0xf9, 0xb8,
-	0x05, 0x35, 0xed, 0xa8, 0x24, 0x09, 0xa7, 0x63, 0xe7, 0x1f, 0x06, 0x34, 0x64, 0xd8, 0xbf, 0xf4,
-	0xd8, 0x51, 0xf2, 0xa2, 0x92, 0x78, 0xd9, 0xc8, 0x7b, 0xf9, 0x8c, 0x3d, 0x44, 0xc9, 0x73, 0x80,
-	0x59, 0xf6, 0x1c, 0x50, 0xc2, 0x4d, 0xaa, 0xa5, 0xdc, 0xa4, 0xd0, 0x94, 0x2c, 0x4d, 0x35, 0x25,
-	0xdf, 0x18, 0xb0, 0x91, 0xb1, 0xd1, 0x79, 0x52, 0x58, 0xce, 0xb2, 0x95, 0xa2, 0x65, 0x1f, 0xe6,
-	0x53, 0xbb, 0x59, 0xe6, 0xea, 0x4c, 0x6a, 0x4f, 0x6c, 0x9c, 0x4b, 0xef, 0xcf, 0x60, 0x4d, 0x94,
-	0xd7, 0x8b, 0x71, 0xe7, 0xdf, 0x0d, 0x58, 0x79, 0x4a, 0xfb, 0xd2, 0x91, 0x59, 0x0c, 0x19, 0xf9,
-	0xa7, 0xa6, 0x75, 0x30, 0x7d, 0x32, 0xd2, 0xf9, 0x58, 0xfc, 0x14, 0x31, 0xc6, 0xb8, 0x17, 0xf1,
-	0xc9, 0x63, 0x99, 0x20, 0x5f, 0x42, 0x22, 0xdf, 0x5b, 0xae, 0x42, 0x0d, 0x87, 0xbe, 0xfa, 0xa8,
-	0x19, 0x2e, 0x0e, 0x7d, 0xf9, 0xe9, 0x62, 0x9a, 0x96, 0x2b, 0xb0, 0x34, 0xa6, 0x93, 0x07, 0x2e,
-	0x35, 0x70, 0xae, 0x00, 0x7a, 0x8c, 0xf9, 0x53, 0xda, 0x17, 0x5e, 0x49, 0xcc, 0xe3, 0xfc, 0xad,
-	0x22, 0x1b, 0x8a, 0x89, 0xf8, 0x3c, 0x0e, 0x76, 0xa0, 0xa1, 0x0a, 0xd0, 0x17, 0xb4, 0xdf, 0x0b,
-	0xe3, 0xc4, 0x28, 0xb6, 0x14, 0x3e, 0xa5, 0xfd, 0x17, 0xf1, 0x08, 0x7d, 0x00, 0x97, 0x49, 0xd8,
-	0x1b, 0xeb, 0x9a, 0x98, 0x6a, 0x2a, 0x2b, 0xad, 0x93, 0x30, 0xa9, 0x96, 0x5a, 0xfd, 0x2e, 0xac,
-	0xe1, 0xf0, 0x55, 0x8c, 0x63, 0x9c, 0xaa, 0x2a, 0x9b, 0x35, 0xb4, 0x58, 0xeb, 0x89, 0xda, 0xe7,
-	0xb1, 0xa3, 0x1e, 0x0b, 0x28, 0x67, 0x3a, 0x27, 0x5a, 0x42, 0xd2, 0x15, 0x02, 0xf4, 0x31, 0x58,
-	0x62, 0xba, 0x82, 0x96, 0x6a, 0x0c, 0xae, 0x95, 0x41, 0x4b, 0xfb, 0xdb, 0xad, 0x7d, 0xa1, 0x7e,
-	0x30, 0x11, 0x20, 0x9a, 0x2a, 0xfb, 0x84, 0x1d, 0xe9, 0x4a, 0x03, 0x4a, 0xb4, 0x47, 0xd8, 0xd1,
-	0xf6, 0x57, 0x00, 0x20, 0x11, 0xb9, 0x4b, 0x69, 0xe4, 0xa3, 0x40, 0x9a, 0x79, 0x97, 0x8e, 0xc6,
-	0x34, 0xc4, 0x21, 0x97, 0xd1, 0xcb, 0xd0, 0x56, 0x7e, 0x33, 0x3d, 0x98, 0x56, 0xd4, 0x6e, 0x69,
-	0xbd, 0x5f, 0xaa, 0x5f, 0x50, 0x76, 0x2e, 0xa1, 0x57, 0x92, 0x5c, 0x8b, 0x21, 0x61, 0x9c, 0x0c,
-	0xd8, 0xee, 0xa1, 0x17, 0x86, 0x38, 0x40, 0xdb, 0x33, 0x9e, 0xa2, 0xca, 0x94, 0x93, 0x3d, 0x6f,
-	0x97, 0xee, 0xd9, 0xe5, 0x11, 0x09, 0x0f, 0x12, 0x5c, 0x38, 0x97, 0xd0, 0x4b, 0xb0, 0x33, 0xef,
-	0x01, 0xe8, 0x6e, 0x99, 0x19, 0xa7, 0x1f, 0x0c, 0x5a, 0x27, 0x01, 0xc8, 0xb9, 0x84, 0x86, 0xd0,
-	0xc8, 0x3d, 0x58, 0xa1, 0xf6, 0x49, 0x9c, 0x3e, 0xfb, 0x4a, 0xd4, 0xfa, 0xf6, 0x02, 0x9a, 0xe9,
-	0xe9, 0x7f, 0xa9, 0x0c, 0x36, 0xf5, 0xe2, 0x73, 0x7f, 0xc6, 0x22, 0xb3, 0xde, 0xa6, 0x5a, 0x0f,
-	0x16, 0x9f, 0x90, 0x6e, 0xee, 0x4f, 0x2e, 0xa9, 0xc0, 0x75, 0x6f, 0x7e, 0xe3, 0xa2, 0x76, 0x6b,
-	0x2f, 0xda, 0xe1, 0x38, 0x97, 0xd0, 0x3e, 0x58, 0x69, 0x8f, 0x81, 0xde, 0x2f, 0x9b, 0x58, 0x6c,
-	0x41, 0x16, 0x70, 0x4e, 0x8e, 0xc3, 0x97, 0x3b, 0xa7, 0xac, 0x85, 0x28, 0x77, 0x4e, 0x69, 0x43,
-	0xe0, 0x5c, 0x42, 0xbf, 0x9a, 0xbc, 0x5a, 0xe6, 0x98, 0x33, 0x7a, 0x70, 0xd2, 0xf5, 0xcb, 0x88,
-	0x7c, 0xeb, 0xbb, 0xa7, 0x98, 0x91, 0x01, 0x07, 0xea, 0x1e, 0xd2, 0x37, 0x8a, 0xc1, 0xc4, 0x91,
-	0x27, 0x08, 0x7f, 0xc9, 0xe6, 0x3a, 0x96, 0xa6, 0x55, 0x67, 0x6e, 0x7e, 0xc2, 0x8c, 0x74, 0xf3,
-	0x1e, 0xc0, 0x63, 0xcc, 0x9f, 0x63, 0x1e, 0x91, 0x01, 0x2b, 0x86, 0xd5, 0x24, 0x61, 0x68, 0x85,
-	0x64, 0xab, 0x7b, 0x73, 0xf5, 0xd2, 0x0d, 0xfa, 0x60, 0xef, 0x1e, 0xe2, 0xc1, 0xd1, 0x13, 0xec,
-	0x05, 0xfc, 0x10, 0x95, 0xcf, 0xcc, 0x68, 0xcc, 0xc0, 0x5e, 0x99, 0x62, 0xb2, 0xc7, 0xf6, 0x37,
-	0xcb, 0xfa, 0x1f, 0xcc, 0x17, 0xd4, 0xc7, 0xff, 0xfd, 0xb9, 0x70, 0x1f, 0xac, 0xb4, 0x47, 0x28,
-	0x0f, 0xb5, 0x62, 0x0b, 0x31, 0x2f, 0xd4, 0x3e, 0x07, 0x2b, 0x65, 0x5b, 0xe5, 0x2b, 0x16, 0x09,
-	0x6b, 0xeb, 0xce, 0x1c, 0xad, 0xf4, 0xb4, 0x2f, 0xa0, 0x96, 0xb0, 0x23, 0x74, 0x7b, 0x56, 0x5e,
-	0xc8, 0xae, 0x3c, 0xe7, 0xac, 0xbf, 0x00, 0x3b, 0x43, 0x1d, 0xca, 0x2b, 0xc1, 0x34, 0xe5, 0x68,
-	0xdd, 0x9b, 0xab, 0xf7, 0xbf, 0x11, 0x90, 0x0f, 0xbf, 0xf7, 0xf9, 0xf6, 0x01, 0xe1, 0x87, 0x71,
-	0x5f, 0x58, 0xf6, 0xbe, 0xd2, 0xfc, 0x80, 0x50, 0xfd, 0xeb, 0x7e, 0x72, 0xca, 0xfb, 0x72, 0xa5,
-	0xfb, 0xd2, 0x4e, 0xe3, 0x7e, 0x7f, 0x59, 0x0e, 0x3f, 0xfc, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x34, 0xde, 0x9f, 0x9a, 0x80, 0x20, 0x00, 0x00,
+	// 4010 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0x5b, 0x73, 0x1b, 0x47,
+	0x76, 0x16, 0x2e, 0x24, 0x81, 0x03, 0x80, 0x04, 0x9b, 0x92, 0x05, 0x43, 0x92, 0x45, 0x8f, 0x57,
+	0x16, 0xed, 0xcd, 0x52, 0x5e, 0x6d, 0x36, 0xeb, 0xbd, 0xa5, 0x22, 0x91, 0x96, 0x4c, 0x49, 0x54,
+	0x98, 0xa1, 0x2c, 0x27, 0xae, 0x54, 0xcd, 0x0e, 0x30, 0x0d, 0x70, 0xcc, 0x99, 0x69, 0x78, 0xba,
+	0x21, 0x89, 0x4a, 0x55, 0x92, 0x87, 0xa4, 0x52, 0xb5, 0xe5, 0x4a, 0x2a, 0x97, 0xca, 0xe5, 0x29,
+	0x2f, 0x49, 0x9e, 0x52, 0x95, 0xa7, 0xbc, 0xa4, 0xb6, 0x9c, 0xfc, 0x83, 0xfc, 0x88, 0xfc, 0x84,
+	0xe4, 0x07, 0xa4, 0x4e, 0x77, 0xcf, 0xa0, 0x67, 0x30, 0x20, 0x20, 0x92, 0x79, 0xc9, 0xbe, 0xb0,
+	0xd0, 0x67, 0x4e, 0x5f, 0xcf, 0xed, 0x3b, 0xa7, 0x9b, 0xb0, 0xee, 0x47, 0x1e, 0x7d, 0xe5, 0xf4,
+	0x19, 0x8b, 0xbd, 0xed, 0x51, 0xcc, 0x04, 0x23, 0x24, 0xf4, 0x83, 0x17, 0x63, 0xae, 0x5a, 0xdb,
+	0xf2, 0x7b, 0xb7, 0xd9, 0x67, 0x61, 0xc8, 0x22, 0x45, 0xeb, 0xae, 0xfa, 0x91, 0xa0, 0x71, 0xe4,
+	0x06, 0xba, 0xdd, 0x34, 0x7b, 0x74, 0x9b, 0xbc, 0x7f, 0x44, 0x43, 0x57, 0xb5, 0xac, 0x7f, 0xa9,
+	0x42, 0x7d, 0x0f, 0xc7, 0xd8, 0x8b, 0x06, 0x8c, 0x58, 0xd0, 0xec, 0xb3, 0x20, 0xa0, 0x7d, 0xe1,
+	0xb3, 0x68, 0x6f, 0xb7, 0x53, 0xda, 0x2c, 0x6d, 0x55, 0xec, 0x0c, 0x8d, 0x74, 0x60, 0x65, 0xe0,
+	0xd3, 0xc0, 0xdb, 0xdb, 0xed, 0x94, 0xe5, 0xe7, 0xa4, 0x49, 0x6e, 0x00, 0xa8, 0xe5, 0x46, 0x6e,
+	0x48, 0x3b, 0x95, 0xcd, 0xd2, 0x56, 0xdd, 0xae, 0x4b, 0xca, 0x53, 0x37, 0xa4, 0xd8, 0x51, 0x36,
+	0xf6, 0x76, 0x3b, 0x55, 0xd5, 0x51, 0x37, 0xc9, 0x7d, 0x68, 0x88, 0x93, 0x11, 0x75, 0x46, 0x6e,
+	0xec, 0x86, 0xbc, 0xb3, 0xb4, 0x59, 0xd9, 0x6a, 0xdc, 0x7d, 0x77, 0x3b, 0xb3, 0x51, 0xbd, 0xc3,
+	0xc7, 0xf4, 0xe4, 0xb9, 0x1b, 0x8c, 0xe9, 0x81, 0xeb, 0xc7, 0x36, 0x60, 0xaf, 0x03, 0xd9, 0x89,
+	0xec, 0x42, 0x53, 0x4d, 0xae, 0x07, 0x59, 0x5e, 0x74, 0x90, 0x86, 0xec, 0xa6, 0x47, 0x79, 0x57,
+	0x8f, 0x42, 0x3d, 0x27, 0x66, 0x2f, 0x79, 0x67, 0x45, 0x2e, 0xb4, 0xa1, 0x69, 0x36, 0x7b, 0xc9,
+	0x71, 0x97, 0x82, 0x09, 0x37, 0x50, 0x0c, 0x35, 0xc9, 0x50, 0x97, 0x14, 0xf9, 0xf9, 0xfb, 0xb0,
+	0xc4, 0x85, 0x2b, 0x68, 0xa7, 0xbe, 0x59, 0xda, 0x5a, 0xbd, 0x7b, 0xb3, 0x70, 0x01, 0xf2, 0xc4,
+	0x0f, 0x91, 0xcd, 0x56, 0xdc, 0xe4, 0xfb, 0x70, 0x55, 0x2d, 0x5f, 0x36, 0x9d, 0x81, 0xeb, 0x07,
+	0x4e, 0x4c, 0x5d, 0xce, 0xa2, 0x0e, 0xc8, 0x83, 0xbc, 0xec, 0xa7, 0x7d, 0x1e, 0xb8, 0x7e, 0x60,
+	0xcb, 0x6f, 0xc4, 0x82, 0x96, 0xcf, 0x1d, 0x77, 0x2c, 0x98, 0x23, 0xbf, 0x77, 0x1a, 0x9b, 0xa5,
+	0xad, 0x9a, 0xdd, 0xf0, 0xf9, 0xbd, 0xb1, 0x60, 0x72, 0x1a, 0xb2, 0x0f, 0xeb, 0x63, 0x4e, 0x63,
+	0x27, 0x73, 0x3c, 0xcd, 0x45, 0x8f, 0x67, 0x0d, 0xfb, 0xee, 0x4d, 0x8e, 0xc8, 0xfa, 0xe3, 0x12,
+	0xc0, 0x03, 0x29, 0x71, 0x39, 0xfa, 0x4f, 0x12, 0xa1, 0xfb, 0xd1, 0x80, 0x49, 0x85, 0x69, 0xdc,
+	0xbd, 0xb1, 0x3d, 0xad, 0xa3, 0xdb, 0xa9, 0x96, 0x69, 0x9d, 0xc0, 0x9f, 0xa8, 0x13, 0x1e, 0x0d,
+	0xa8, 0xa0, 0x9e, 0x54, 0xa6, 0x9a, 0x9d, 0x34, 0xc9, 0x4d, 0x68, 0xf4, 0x63, 0x8a, 0x67, 0x21,
+	0x7c, 0xad, 0x4d, 0x55, 0x1b, 0x14, 0xe9, 0x99, 0x1f, 0x52, 0xeb, 0x4f, 0x96, 0xa0, 0x79, 0x48,
+	0x87, 0x21, 0x8d, 0x84, 0x5a, 0xc9, 0x22, 0xca, 0xbb, 0x09, 0x8d, 0x91, 0x1b, 0x0b, 0x5f, 0xb3,
+	0x28, 0x05, 0x36, 0x49, 0xe4, 0x3a, 0xd4, 0xb9, 0x1e, 0x75, 0x57, 0xce, 0x5a, 0xb1, 0x27, 0x04,
+	0xf2, 0x36, 0xd4, 0xa2, 0x71, 0xa8, 0x44, 0xaf, 0x95, 0x38, 0x1a, 0x87, 0x52, 0xf0, 0x86, 0x7a,
+	0x2f, 0x65, 0xd5, 0xbb, 0x03, 0x2b, 0xbd, 0xb1, 0x2f, 0x2d, 0x66, 0x59, 0x7d, 0xd1, 0x4d, 0xf2,
+	0x16, 0x2c, 0x47, 0xcc, 0xa3, 0x7b, 0xbb, 0x5a, 0xd1, 0x74, 0x8b, 0xbc, 0x07, 0x2d, 0x75, 0xa8,
+	0x2f, 0x68, 0xcc, 0x7d, 0x16, 0x69, 0x35, 0x53, 0xba, 0xf9, 0x5c, 0xd1, 0xce, 0xaa, 0x69, 0x37,
+	0xa1, 0x31, 0xad, 0x5d, 0x30, 0x98, 0xe8, 0xd4, 0xfb, 0xb0, 0xa6, 0x26, 0x1f, 0xf8, 0x01, 0x75,
+	0x8e, 0xe9, 0x09, 0xef, 0x34, 0x36, 0x2b, 0x5b, 0x75, 0x5b, 0xad, 0xe9, 0x81, 0x1f, 0xd0, 0xc7,
+	0xf4, 0x84, 0x9b, 0xb2, 0x6b, 0x9e, 0x2a, 0xbb, 0x56, 0x5e, 0x76, 0xe4, 0x16, 0xac, 0x72, 0x1a,
+	0xfb, 0x6e, 0xe0, 0xbf, 0xa6, 0x0e, 0xf7, 0x5f, 0xd3, 0xce, 0xaa, 0xe4, 0x69, 0xa5, 0xd4, 0x43,
+	0xff, 0x35, 0xc5, 0x63, 0x78, 0x19, 0xfb, 0x82, 0x3a, 0x47, 0x6e, 0xe4, 0xb1, 0xc1, 0xa0, 0xb3,
+	0x26, 0xe7, 0x69, 0x4a, 0xe2, 0xa7, 0x8a, 0x46, 0xb6, 0xa0, 0x6d, 0x2c, 0x17, 0x07, 0xe3, 0x9d,
+	0xf6, 0x66, 0x65, 0xab, 0x6a, 0xaf, 0xa6, 0xeb, 0xc5, 0xd1, 0x38, 0x0a, 0x2f, 0xa4, 0xa1, 0x9a,
+	0x6f, 0x5d, 0xce, 0xb7, 0x12, 0xd2, 0x50, 0xce, 0xd4, 0x85, 0xda, 0x4b, 0x37, 0x8e, 0xfc, 0x68,
+	0xc8, 0x3b, 0x44, 0x6e, 0x36, 0x6d, 0xe3, 0x6e, 0x7a, 0xf1, 0x18, 0x8d, 0x92, 0xc5, 0x7d, 0xda,
+	0xd9, 0x90, 0x6b, 0x00, 0x49, 0x7a, 0x80, 0x14, 0xeb, 0x6f, 0x4a, 0xb0, 0x61, 0xd3, 0xa1, 0xcf,
+	0x05, 0x8d, 0x9f, 0x32, 0x8f, 0xda, 0xf4, 0xab, 0x31, 0xe5, 0x82, 0x7c, 0x04, 0xd5, 0x9e, 0xcb,
+	0xa9, 0x36, 0x8a, 0xeb, 0x85, 0xf2, 0xd9, 0xe7, 0xc3, 0xfb, 0x2e, 0xa7, 0xb6, 0xe4, 0x24, 0xbf,
+	0x06, 0x2b, 0xae, 0xe7, 0xc5, 0x94, 0xf3, 0x4e, 0xf9, 0x94, 0x4e, 0xf7, 0x14, 0x8f, 0x9d, 0x30,
+	0x1b, 0x7a, 0x54, 0x31, 0xf5, 0xc8, 0xfa, 0xb3, 0x12, 0x5c, 0xce, 0xae, 0x8c, 0x8f, 0x58, 0xc4,
+	0x29, 0xf9, 0x1e, 0x2c, 0xa3, 0x36, 0x8c, 0xb9, 0x5e, 0xdc, 0xb5, 0xc2, 0x79, 0x0e, 0x25, 0x8b,
+	0xad, 0x59, 0xd1, 0x4d, 0xfb, 0x91, 0x2f, 0x12, 0x17, 0xa2, 0x56, 0xf8, 0x6e, 0xde, 0xd6, 0x75,
+	0xe8, 0xd9, 0x8b, 0x7c, 0xa1, 0x3c, 0x86, 0x0d, 0x7e, 0xfa, 0xdb, 0xfa, 0x1d, 0xb8, 0xfc, 0x90,
+	0x0a, 0x43, 0x2b, 0xf5, 0x59, 0x2d, 0x62, 0xbc, 0xd9, 0xf8, 0x52, 0xce, 0xc5, 0x17, 0xeb, 0x1f,
+	0x4a, 0x70, 0x25, 0x37, 0xf6, 0x79, 0x76, 0x9b, 0x9a, 0x57, 0xf9, 0x3c, 0xe6, 0x55, 0xc9, 0x9b,
+	0x97, 0xf5, 0x87, 0x25, 0xb8, 0xf6, 0x90, 0x0a, 0xd3, 0x75, 0x5d, 0xf0, 0x49, 0x90, 0x77, 0x00,
+	0x52, 0x97, 0xc5, 0x3b, 0x95, 0xcd, 0xca, 0x56, 0xc5, 0x36, 0x28, 0xd6, 0x3f, 0x96, 0x60, 0x7d,
+	0x6a, 0xfe, 0xac, 0xe7, 0x2b, 0xe5, 0x3d, 0xdf, 0xff, 0xd1, 0x71, 0x64, 0x2c, 0xaf, 0x9a, 0xb5,
+	0x3c, 0xeb, 0x2f, 0x4a, 0x70, 0xbd, 0xf8, 0xa8, 0xce, 0x23, 0xd8, 0x9f, 0xaa, 0x4e, 0x14, 0x35,
+	0x18, 0x83, 0xe0, 0xad, 0xa2, 0x68, 0x35, 0x3d, 0xa7, 0xee, 0x64, 0x7d, 0x5d, 0x01, 0xb2, 0x23,
+	0x5d, 0x99, 0xfc, 0xf8, 0x26, 0x62, 0x3b, 0x33, 0x74, 0xca, 0x01, 0xa4, 0xea, 0x45, 0x00, 0xa4,
+	0xa5, 0x33, 0x01, 0xa4, 0xeb, 0x50, 0x47, 0x9f, 0xce, 0x85, 0x1b, 0x8e, 0x64, 0x34, 0xab, 0xda,
+	0x13, 0xc2, 0x34, 0x1c, 0x59, 0x59, 0x10, 0x8e, 0xd4, 0xce, 0x0c, 0x47, 0x5e, 0xc1, 0x46, 0x62,
+	0xf4, 0x12, 0x5c, 0xbc, 0x81, 0x38, 0xb2, 0x66, 0x52, 0xce, 0x9b, 0xc9, 0x1c, 0xa1, 0x58, 0xbf,
+	0xa8, 0xc0, 0xfa, 0x5e, 0x12, 0x61, 0x0e, 0x5c, 0x71, 0x24, 0x11, 0xcd, 0xe9, 0x56, 0x34, 0x5b,
+	0x03, 0x0c, 0xf8, 0x50, 0x99, 0x09, 0x1f, 0xaa, 0x59, 0xf8, 0x90, 0x5d, 0xe0, 0x52, 0x5e, 0x6b,
+	0x2e, 0x06, 0x12, 0x67, 0xe3, 0xeb, 0xc8, 0x15, 0x47, 0x08, 0x8b, 0xd1, 0x50, 0x57, 0x7d, 0x73,
+	0xf7, 0x9c, 0xdc, 0x86, 0xb5, 0x34, 0x7e, 0x7b, 0x2a, 0xcc, 0xd6, 0xa4, 0x86, 0x4c, 0x82, 0xbd,
+	0x97, 0xc4, 0xf5, 0x2c, 0xbc, 0xa9, 0x17, 0xc0, 0x1b, 0x13, 0x6a, 0x41, 0x16, 0x6a, 0x15, 0x85,
+	0xfc, 0xc6, 0xdc, 0x90, 0xdf, 0xcc, 0x84, 0x7c, 0xeb, 0xdf, 0x4a, 0xd0, 0x48, 0xad, 0x7c, 0xc1,
+	0xdc, 0x27, 0x23, 0xdc, 0x72, 0x5e, 0xb8, 0xef, 0x42, 0x93, 0x46, 0x6e, 0x2f, 0xa0, 0x5a, 0xf9,
+	0x2b, 0x4a, 0xf9, 0x15, 0x4d, 0x29, 0xff, 0x03, 0x68, 0x4c, 0xd0, 0x72, 0x62, 0xc8, 0xb7, 0x66,
+	0xc2, 0x65, 0x53, 0xb3, 0x6c, 0x48, 0x61, 0x33, 0xb7, 0x7e, 0x5e, 0x9e, 0xc4, 0x51, 0xf9, 0xf1,
+	0x5c, 0x1e, 0xf1, 0x77, 0xa1, 0xa9, 0x77, 0xa1, 0x50, 0xbc, 0xf2, 0x8b, 0x3f, 0x2c, 0x5a, 0x56,
+	0xd1, 0xa4, 0xdb, 0xc6, 0x31, 0x7e, 0x12, 0x89, 0xf8, 0xc4, 0x6e, 0xf0, 0x09, 0xa5, 0xeb, 0x40,
+	0x3b, 0xcf, 0x40, 0xda, 0x50, 0x39, 0xa6, 0x27, 0xfa, 0x8c, 0xf1, 0x27, 0xc6, 0x97, 0x17, 0xa8,
+	0x80, 0x1a, 0x56, 0xdc, 0x3c, 0xd5, 0x29, 0x0f, 0x98, 0xad, 0xb8, 0x7f, 0x54, 0xfe, 0xb8, 0x64,
+	0xfd, 0x55, 0x09, 0xda, 0xbb, 0x31, 0x1b, 0xbd, 0xb1, 0x3f, 0xb6, 0xa0, 0x69, 0x40, 0xff, 0xc4,
+	0x05, 0x64, 0x68, 0xf3, 0x3c, 0xf3, 0xdb, 0x50, 0xf3, 0x62, 0x36, 0x72, 0xdc, 0x20, 0xe8, 0x54,
+	0x35, 0x0a, 0x8e, 0xd9, 0xe8, 0x5e, 0x10, 0x20, 0xd4, 0xd9, 0xa5, 0xbc, 0x1f, 0xfb, 0xbd, 0x37,
+	0x8f, 0x14, 0x73, 0xa0, 0xce, 0xd7, 0x25, 0xb8, 0x92, 0x1b, 0xfb, 0x3c, 0xf2, 0xff, 0xf5, 0xac,
+	0x56, 0x2a, 0xf1, 0xcf, 0x49, 0xe2, 0x4c, 0x6d, 0x74, 0x65, 0x98, 0x96, 0xdf, 0xee, 0xa3, 0x6b,
+	0x3a, 0x88, 0xd9, 0x50, 0x02, 0xd4, 0x8b, 0xdb, 0xf1, 0x5f, 0x97, 0xe0, 0xc6, 0x8c, 0x39, 0xce,
+	0xb3, 0xf3, 0x7c, 0xbe, 0x5f, 0x9e, 0x97, 0xef, 0x57, 0x72, 0xf9, 0xbe, 0xf5, 0x3f, 0x65, 0x68,
+	0x1d, 0x0a, 0x16, 0xbb, 0x43, 0xba, 0xc3, 0xa2, 0x81, 0x3f, 0x44, 0x7f, 0x9d, 0x80, 0xf8, 0x92,
+	0xdc, 0x46, 0xd2, 0xc4, 0xd9, 0xdc, 0x7e, 0x9f, 0x72, 0x8e, 0x59, 0x95, 0xf6, 0x20, 0x75, 0xbb,
+	0xa1, 0x68, 0x8f, 0x91, 0x44, 0x3e, 0x84, 0x75, 0x4e, 0xfb, 0x31, 0x15, 0xce, 0x84, 0x53, 0x6b,
+	0xdd, 0x9a, 0xfa, 0x70, 0x2f, 0xe1, 0x46, 0xd4, 0x3f, 0xe6, 0xf4, 0xf0, 0xf0, 0x89, 0xd6, 0x3c,
+	0xdd, 0x92, 0x09, 0xcb, 0xb8, 0x7f, 0x4c, 0x85, 0x19, 0x17, 0x40, 0x91, 0xa4, 0xd2, 0x5e, 0x83,
+	0x7a, 0xcc, 0x98, 0x90, 0xce, 0x5c, 0x06, 0xf1, 0xba, 0x5d, 0x43, 0x02, 0xba, 0x1a, 0x3d, 0xea,
+	0xde, 0xbd, 0x7d, 0x1d, 0xbc, 0x75, 0x0b, 0x53, 0xe7, 0xbd, 0x7b, 0xfb, 0x9f, 0x44, 0xde, 0x88,
+	0xf9, 0x91, 0x90, 0x9e, 0xbd, 0x6e, 0x9b, 0x24, 0xdc, 0x1e, 0x57, 0x27, 0xe1, 0x20, 0xee, 0x90,
+	0x5e, 0xbd, 0x6e, 0x37, 0x34, 0xed, 0xd9, 0xc9, 0x88, 0x92, 0x87, 0xb0, 0xfa, 0x9a, 0x45, 0xd4,
+	0xa1, 0xba, 0x0f, 0xba, 0x76, 0x54, 0xb6, 0xcd, 0x22, 0x65, 0xfb, 0x82, 0x45, 0x34, 0x19, 0xdc,
+	0x6e, 0xbd, 0x36, 0x5a, 0xdc, 0xfa, 0x09, 0x34, 0xcd, 0xcf, 0x84, 0x40, 0x15, 0x19, 0xf4, 0x89,
+	0xcb, 0xdf, 0xa6, 0x20, 0xca, 0x19, 0x41, 0x58, 0xbf, 0x68, 0x40, 0x5b, 0x61, 0xb8, 0x47, 0xac,
+	0x97, 0x68, 0xe9, 0x75, 0xa8, 0xf7, 0x83, 0x31, 0x17, 0x34, 0xd6, 0x2a, 0x5a, 0xb7, 0x27, 0x04,
+	0x14, 0x8c, 0x19, 0x06, 0x63, 0x3a, 0xf0, 0x5f, 0xe9, 0x61, 0xd7, 0x26, 0x71, 0x50, 0x92, 0xcd,
+	0x88, 0x5d, 0x99, 0x8a, 0xd8, 0x9e, 0x2b, 0x5c, 0x1d, 0x46, 0x15, 0xde, 0xad, 0x23, 0x45, 0x45,
+	0xd0, 0xa9, 0xc0, 0xb8, 0x54, 0x10, 0x18, 0x0d, 0xa4, 0xb0, 0x9c, 0x45, 0x0a, 0x59, 0x1b, 0x5a,
+	0xc9, 0xfb, 0xaa, 0x4f, 0x61, 0x35, 0x91, 0x4f, 0x5f, 0xaa, 0xaa, 0x14, 0x62, 0x41, 0x0a, 0x27,
+	0x7d, 0xad, 0xa9, 0xd3, 0x76, 0x8b, 0x9b, 0xcd, 0x29, 0x64, 0x51, 0x3f, 0x13, 0xb2, 0xc8, 0xa1,
+	0x5a, 0x38, 0x0b, 0xaa, 0x35, 0x51, 0x42, 0x23, 0x8b, 0x12, 0x6e, 0xc1, 0x2a, 0x8d, 0x86, 0x7e,
+	0x44, 0xd3, 0xd3, 0x6c, 0xca, 0x13, 0x69, 0x29, 0x6a, 0x72, 0x9c, 0x5d, 0xa8, 0x8d, 0x62, 0x9f,
+	0xc5, 0xbe, 0x38, 0x91, 0x95, 0x8a, 0x25, 0x3b, 0x6d, 0xe3, 0x10, 0x52, 0x5c, 0x13, 0xc8, 0xdb,
+	0x56, 0x75, 0x0a, 0xa4, 0x3e, 0x4b, 0x88, 0x88, 0x47, 0x62, 0x2a, 0x45, 0xec, 0xf8, 0x91, 0x33,
+	0x0a, 0xdc, 0xbe, 0x2a, 0x30, 0xd4, 0xec, 0x55, 0x4d, 0xdf, 0x8b, 0x0e, 0x90, 0x4a, 0x76, 0x21,
+	0x39, 0x49, 0x07, 0x0d, 0x4e, 0x15, 0x1b, 0x66, 0x45, 0x3b, 0xc5, 0x68, 0x33, 0x26, 0xec, 0x26,
+	0x9f, 0x34, 0x38, 0x71, 0x60, 0x2d, 0xd5, 0x22, 0x3d, 0xce, 0x86, 0x1c, 0xe7, 0x07, 0x45, 0xe3,
+	0xe4, 0x15, 0x7d, 0x7b, 0x57, 0xeb, 0x9b, 0x1c, 0x4c, 0x05, 0xec, 0x96, 0x67, 0xd2, 0x10, 0xc7,
+	0x8f, 0x8e, 0x1d, 0x43, 0x53, 0xaf, 0x48, 0x4d, 0x6d, 0x8c, 0x8e, 0x77, 0x53, 0x5d, 0x7d, 0x1f,
+	0xd6, 0x68, 0x88, 0xd5, 0x80, 0x63, 0x87, 0x0d, 0x06, 0x9c, 0x0a, 0xde, 0xb9, 0x2a, 0xf7, 0xdc,
+	0x42, 0xf2, 0xc1, 0xf1, 0x6f, 0x2a, 0x22, 0xf9, 0x36, 0xac, 0xc7, 0x94, 0xd3, 0xf8, 0x85, 0x8b,
+	0x9e, 0xde, 0x11, 0xec, 0x98, 0x46, 0x9d, 0x8e, 0x94, 0x44, 0xdb, 0xf8, 0xf0, 0x0c, 0xe9, 0xe8,
+	0x99, 0xbe, 0x64, 0x3d, 0xa7, 0x1f, 0xb8, 0x9c, 0x77, 0xde, 0x56, 0x9e, 0xe9, 0x4b, 0xd6, 0xdb,
+	0xc1, 0x36, 0x5a, 0x47, 0xcf, 0x8f, 0x02, 0x36, 0x74, 0x38, 0x1b, 0x63, 0x29, 0xa6, 0x2b, 0x19,
+	0x9a, 0x8a, 0x78, 0x28, 0x69, 0xe4, 0x73, 0x78, 0x2b, 0xa6, 0xa3, 0xc0, 0xef, 0xbb, 0x4e, 0x4e,
+	0xd9, 0xaf, 0x2d, 0xaa, 0xec, 0x97, 0xf5, 0x00, 0x19, 0x2a, 0xd9, 0x86, 0x8d, 0x3e, 0x0b, 0x47,
+	0x6e, 0x5f, 0xa4, 0xa9, 0x0b, 0x9e, 0xcc, 0x75, 0x79, 0x32, 0xeb, 0xfa, 0x93, 0xce, 0x4c, 0xf0,
+	0x7c, 0x8e, 0x80, 0x8c, 0x62, 0xca, 0xfd, 0x61, 0x44, 0x3d, 0x67, 0x48, 0x85, 0x33, 0x8e, 0x03,
+	0xde, 0xb9, 0x21, 0xe5, 0xf4, 0xa3, 0x85, 0xe4, 0x74, 0x90, 0x74, 0x7f, 0x48, 0xc5, 0x67, 0x71,
+	0xa0, 0x45, 0xd5, 0x1e, 0xe5, 0xc8, 0xe4, 0x29, 0x4c, 0x68, 0xce, 0x78, 0x14, 0x30, 0xd7, 0xeb,
+	0xdc, 0x94, 0x9b, 0x7d, 0xaf, 0x68, 0x9e, 0x74, 0xd8, 0xcf, 0x24, 0xab, 0xbd, 0x36, 0xca, 0x12,
+	0xba, 0xbf, 0x01, 0x64, 0x5a, 0x45, 0x4c, 0xc8, 0x56, 0x57, 0x90, 0xed, 0xb2, 0x09, 0xd9, 0xea,
+	0x06, 0x22, 0xeb, 0xee, 0xc0, 0x95, 0xc2, 0xc5, 0xbf, 0xc9, 0x20, 0x8f, 0xaa, 0xb5, 0x77, 0xda,
+	0x37, 0x6d, 0xe3, 0x10, 0x47, 0x63, 0x75, 0x88, 0xd6, 0x7f, 0x96, 0x60, 0x2d, 0xb7, 0x0b, 0x1c,
+	0x79, 0x1c, 0x07, 0xc9, 0xc8, 0xe3, 0x38, 0x20, 0x4f, 0xa1, 0x3e, 0x60, 0x71, 0x28, 0xd5, 0x58,
+	0x63, 0x9a, 0xef, 0x2e, 0x70, 0x1e, 0xdb, 0x0f, 0x58, 0x1c, 0xe2, 0xfe, 0xd5, 0x71, 0xd7, 0x06,
+	0xba, 0x89, 0xde, 0xf5, 0x98, 0x9e, 0x24, 0xae, 0x5f, 0x23, 0xc1, 0x63, 0x7a, 0xa2, 0x9c, 0x7e,
+	0xf7, 0xc7, 0xd0, 0xca, 0xf4, 0x7c, 0x93, 0xbd, 0x5a, 0x7f, 0x00, 0x0d, 0xc3, 0xdc, 0x31, 0x9a,
+	0x49, 0x17, 0xae, 0xa3, 0x59, 0x54, 0xec, 0xbd, 0xcb, 0x67, 0xf4, 0xde, 0x04, 0xaa, 0xc2, 0xa7,
+	0xb1, 0xde, 0x82, 0xfc, 0x6d, 0xfd, 0x79, 0x19, 0xda, 0xbf, 0x35, 0xa6, 0xf1, 0xc9, 0x23, 0xd6,
+	0xe3, 0x8b, 0x45, 0xc4, 0x2e, 0xd4, 0x74, 0x58, 0x4b, 0x90, 0x73, 0xda, 0x26, 0x3f, 0x48, 0x6b,
+	0x2c, 0x58, 0x7d, 0x5a, 0xa0, 0x5c, 0xa4, 0xd9, 0xa7, 0xa0, 0x62, 0xb5, 0x18, 0x2a, 0x72, 0xe1,
+	0xc6, 0x42, 0x55, 0x97, 0x97, 0x74, 0x1a, 0x86, 0x14, 0x59, 0x5c, 0x7e, 0x1b, 0x6a, 0x34, 0xf2,
+	0xd4, 0x47, 0x1d, 0x20, 0x69, 0xe4, 0xc9, 0x4f, 0x6f, 0xc1, 0xb2, 0xf2, 0x55, 0x49, 0xbd, 0x5d,
+	0xb5, 0x50, 0x30, 0x81, 0x1f, 0xfa, 0x42, 0xd7, 0xd9, 0x55, 0xc3, 0xfa, 0xa7, 0x25, 0x68, 0xc9,
+	0x25, 0x3e, 0x73, 0xf9, 0x71, 0x72, 0x5d, 0x91, 0x04, 0xf6, 0x52, 0x36, 0xb0, 0x9f, 0xb1, 0x3c,
+	0x56, 0x50, 0x6b, 0xaf, 0x14, 0xd5, 0xda, 0x0b, 0x52, 0xeb, 0x6a, 0x61, 0x6a, 0x9d, 0xab, 0xb7,
+	0x2d, 0x4d, 0xd5, 0xdb, 0x8a, 0x72, 0xe7, 0xe5, 0xb9, 0xb9, 0xf3, 0x4a, 0xb6, 0x5c, 0x9e, 0x2b,
+	0x89, 0xd7, 0xf2, 0x25, 0x71, 0xf2, 0x63, 0xa8, 0xcb, 0x65, 0xf4, 0x99, 0x97, 0xdc, 0x4f, 0xbc,
+	0x53, 0x78, 0x24, 0x9f, 0xc4, 0x31, 0x8b, 0x77, 0x98, 0x47, 0xed, 0x1a, 0x76, 0xc0, 0x5f, 0x99,
+	0x92, 0x20, 0xe4, 0x8a, 0xf1, 0x1f, 0x40, 0xdb, 0x7d, 0xe9, 0xfa, 0xc2, 0x8f, 0x86, 0x4e, 0x4c,
+	0x51, 0xaf, 0xa9, 0xbe, 0xf3, 0x5a, 0x4b, 0xe8, 0xb6, 0x22, 0x63, 0xf0, 0xfe, 0x6a, 0x4c, 0xc7,
+	0xd4, 0x19, 0x31, 0xee, 0x8b, 0x24, 0xfe, 0x57, 0xec, 0x96, 0xa4, 0x1e, 0x68, 0xe2, 0xa9, 0xf1,
+	0xff, 0x0a, 0x2c, 0x53, 0xe1, 0x3a, 0x21, 0x97, 0xf7, 0x13, 0x15, 0x7b, 0x89, 0x0a, 0x77, 0x9f,
+	0xa3, 0x29, 0xe2, 0x62, 0xc7, 0x31, 0x75, 0x3c, 0x16, 0xba, 0x7e, 0x24, 0x2f, 0x26, 0x56, 0x8b,
+	0x4d, 0xf1, 0x81, 0xe2, 0xdc, 0x95, 0x8c, 0x76, 0x6b, 0x60, 0x36, 0x11, 0x0f, 0xd0, 0x17, 0x7e,
+	0x5f, 0xa0, 0x50, 0xa5, 0xfa, 0xb4, 0x17, 0x53, 0x9f, 0xa6, 0xee, 0x25, 0x5b, 0xd6, 0x7f, 0x94,
+	0x60, 0xdd, 0x30, 0xde, 0xf3, 0x24, 0x44, 0x19, 0x93, 0x2f, 0xe7, 0x4d, 0xfe, 0x7e, 0x36, 0x51,
+	0xac, 0x14, 0x21, 0x36, 0x23, 0x51, 0x4c, 0xec, 0xc6, 0x4c, 0x16, 0xd1, 0xd6, 0x64, 0xf6, 0xa4,
+	0x4d, 0x5b, 0x35, 0xac, 0xbf, 0x2c, 0xc1, 0x55, 0x9b, 0x8e, 0x58, 0x2c, 0x64, 0x00, 0xe4, 0xe3,
+	0x40, 0x2c, 0xe8, 0x86, 0x26, 0x77, 0x1f, 0xe5, 0xcc, 0x1d, 0xda, 0x05, 0xac, 0xd5, 0x7a, 0x0c,
+	0x1b, 0x4f, 0x7c, 0x2e, 0xf0, 0xea, 0x64, 0x71, 0xbf, 0x38, 0x63, 0x41, 0xd6, 0x10, 0x2e, 0x67,
+	0x07, 0x3b, 0x8f, 0x9c, 0x4e, 0x71, 0xbe, 0xd6, 0x63, 0x58, 0xc3, 0x72, 0xc8, 0x85, 0x78, 0x72,
+	0xeb, 0xef, 0xca, 0xb0, 0xf2, 0x88, 0xf5, 0xa4, 0xfb, 0x33, 0xc1, 0x76, 0x29, 0x0b, 0xb6, 0xdb,
+	0x50, 0xf1, 0xfc, 0x50, 0xef, 0x18, 0x7f, 0xe6, 0xbc, 0x74, 0xe5, 0x34, 0x2f, 0x5d, 0xcd, 0x7a,
+	0xe9, 0x8b, 0xa9, 0x54, 0x5f, 0x86, 0xa5, 0x11, 0x9b, 0xdc, 0xb9, 0xaa, 0x06, 0x79, 0x0c, 0x6d,
+	0x2e, 0x30, 0x86, 0xa2, 0x6b, 0xf3, 0x68, 0x20, 0x5c, 0x55, 0xcd, 0x9c, 0x19, 0x47, 0xdd, 0x21,
+	0xdd, 0xa7, 0xe1, 0x2e, 0x72, 0xda, 0xab, 0xdc, 0x6c, 0x72, 0xeb, 0x29, 0xa6, 0xfe, 0x06, 0x05,
+	0xe7, 0x94, 0x2c, 0xfa, 0x88, 0x55, 0x03, 0x9d, 0xb7, 0x1b, 0x04, 0xac, 0xef, 0xa2, 0x99, 0xcb,
+	0x39, 0xf5, 0x39, 0xad, 0xa6, 0x64, 0xd9, 0xdd, 0xba, 0x0c, 0xe4, 0x21, 0x45, 0x03, 0x40, 0x61,
+	0x27, 0xb2, 0xb3, 0xfe, 0xbd, 0x0c, 0x1b, 0x19, 0xf2, 0x79, 0xf4, 0xc6, 0x82, 0x96, 0xaa, 0x66,
+	0x20, 0xcc, 0x8e, 0xc6, 0x89, 0xc4, 0x1a, 0x92, 0xf8, 0x88, 0xf5, 0x9e, 0x8e, 0x43, 0xf2, 0x1d,
+	0xd8, 0xc0, 0x34, 0x46, 0x17, 0x58, 0x52, 0x4e, 0x25, 0xc2, 0xb6, 0x1f, 0x25, 0xa5, 0x17, 0xcd,
+	0x8e, 0x89, 0x40, 0xa4, 0x3c, 0x6d, 0xc2, 0xaa, 0x04, 0xda, 0xd2, 0x64, 0xcd, 0x87, 0x85, 0x14,
+	0x97, 0x1f, 0x3b, 0x3c, 0xc0, 0x84, 0x45, 0x87, 0x6d, 0xa4, 0x1c, 0x22, 0x81, 0x7c, 0xac, 0xa0,
+	0xbf, 0xb2, 0x56, 0x55, 0xaa, 0xbe, 0x56, 0x24, 0x12, 0xad, 0x8c, 0x32, 0x2f, 0x50, 0x1e, 0xe5,
+	0x26, 0xe8, 0x1a, 0xab, 0xe3, 0xf9, 0xfc, 0x58, 0x97, 0x2d, 0x40, 0x91, 0x76, 0x7d, 0x7e, 0x6c,
+	0xfd, 0x57, 0x09, 0xda, 0x68, 0x76, 0x3b, 0xee, 0xc8, 0xed, 0xf9, 0x81, 0x2f, 0x7c, 0x2a, 0x7b,
+	0x29, 0x2d, 0xc3, 0x6c, 0x12, 0xcf, 0x10, 0x03, 0x8d, 0x32, 0x7e, 0x2c, 0x55, 0xc8, 0xc2, 0x0f,
+	0x8e, 0xa7, 0x8b, 0xb9, 0xea, 0x79, 0x42, 0x1d, 0x29, 0xaa, 0x94, 0xdb, 0x86, 0xca, 0x70, 0x34,
+	0xd6, 0x45, 0x5e, 0xfc, 0x49, 0xae, 0xc2, 0x4a, 0xe8, 0xbe, 0x72, 0x3c, 0x3f, 0x39, 0x80, 0xe5,
+	0xd0, 0x7d, 0xb5, 0xeb, 0x87, 0x58, 0x18, 0x91, 0xb9, 0x14, 0x42, 0x49, 0x57, 0x28, 0x85, 0xae,
+	0xdb, 0x0d, 0xa4, 0x3d, 0x50, 0x24, 0x44, 0x16, 0x49, 0x96, 0xaa, 0x0a, 0x32, 0x49, 0x13, 0xb5,
+	0x27, 0x9b, 0xc6, 0xa6, 0xe5, 0xf7, 0x4c, 0x1e, 0xcb, 0xad, 0x0e, 0xbc, 0xf5, 0x90, 0x0a, 0x73,
+	0x8f, 0x89, 0x06, 0x3d, 0x01, 0xf2, 0xb9, 0x2b, 0xfa, 0x47, 0x8f, 0x58, 0xef, 0x09, 0x1b, 0x2e,
+	0xe6, 0x13, 0x0c, 0xa8, 0x53, 0xce, 0x40, 0x1d, 0x2c, 0x3e, 0x36, 0xd4, 0x48, 0x0a, 0xe7, 0x4a,
+	0x38, 0xa9, 0xc1, 0x6a, 0xc5, 0x96, 0xbf, 0x25, 0xa0, 0xa2, 0x2f, 0x68, 0x90, 0x20, 0x5d, 0xd9,
+	0xc0, 0x31, 0x43, 0xca, 0x39, 0x1a, 0x88, 0xc2, 0x9e, 0x49, 0x93, 0xfc, 0x10, 0x96, 0xe5, 0x45,
+	0xc8, 0x1b, 0xdc, 0x6d, 0xe9, 0x0e, 0xd6, 0x03, 0x20, 0x87, 0x54, 0x3c, 0x61, 0xc3, 0x27, 0x38,
+	0x47, 0xb2, 0xb9, 0x74, 0x01, 0x25, 0x73, 0x01, 0x5d, 0xa8, 0x79, 0xe3, 0x58, 0xe6, 0x9b, 0x7a,
+	0x57, 0x69, 0xdb, 0xfa, 0xd3, 0x32, 0xde, 0xe2, 0x63, 0x3e, 0x4a, 0xa5, 0x42, 0x9e, 0xf3, 0x98,
+	0x32, 0xce, 0xb2, 0x92, 0x75, 0x96, 0x79, 0x07, 0x57, 0xbd, 0x88, 0xf2, 0xc9, 0x99, 0x5e, 0x4d,
+	0x99, 0xe0, 0x67, 0x39, 0x0b, 0x7e, 0xac, 0x7f, 0x96, 0x8f, 0x07, 0xcc, 0x03, 0x39, 0x67, 0xc0,
+	0xc2, 0x8a, 0xe6, 0x68, 0xf2, 0xd4, 0x27, 0x6d, 0x2b, 0x48, 0x80, 0x65, 0x01, 0xa5, 0x15, 0xaa,
+	0x81, 0x71, 0x54, 0xa3, 0xd8, 0xaa, 0x24, 0xeb, 0x16, 0x82, 0x32, 0x21, 0x02, 0x27, 0x4c, 0x7c,
+	0xc8, 0x92, 0x10, 0xc1, 0x3e, 0xb7, 0xee, 0x02, 0xd1, 0x4f, 0x42, 0x16, 0x0e, 0x7c, 0xd6, 0x1f,
+	0x95, 0x60, 0x23, 0xd3, 0xe9, 0x3c, 0x3b, 0xfc, 0x18, 0xaa, 0x5f, 0xb2, 0x5e, 0x52, 0x3e, 0xff,
+	0xd6, 0x22, 0x29, 0xbe, 0x2d, 0x7b, 0x58, 0xff, 0x5a, 0xc2, 0x2b, 0x92, 0x60, 0xb0, 0x73, 0x44,
+	0xfb, 0xc7, 0x8b, 0xe9, 0xdd, 0x85, 0x66, 0x83, 0x86, 0x8e, 0xca, 0xdf, 0x05, 0xa5, 0xb3, 0x6a,
+	0x41, 0xe9, 0x0c, 0x9f, 0x32, 0xac, 0x19, 0xeb, 0x46, 0xd0, 0x86, 0x97, 0xaa, 0x1e, 0x1d, 0xd1,
+	0xc8, 0xa3, 0x51, 0x3f, 0x49, 0x7e, 0x0d, 0x0a, 0x4a, 0x75, 0xe4, 0x72, 0x9e, 0x6a, 0x81, 0x6e,
+	0x19, 0xd2, 0xae, 0x64, 0xa4, 0x7d, 0x03, 0x80, 0x06, 0xee, 0x88, 0x53, 0xcf, 0x09, 0x93, 0x37,
+	0x57, 0x75, 0x4d, 0xd9, 0xe7, 0xd6, 0xdf, 0xcb, 0xa7, 0x0c, 0x93, 0x25, 0x9c, 0x43, 0x7e, 0xb3,
+	0x56, 0xf6, 0x53, 0x58, 0x89, 0xe5, 0xde, 0x12, 0x10, 0x59, 0x58, 0x55, 0xc9, 0x9d, 0x83, 0x9d,
+	0xf4, 0x41, 0x0c, 0x79, 0x48, 0xc5, 0xe1, 0x98, 0xcb, 0x23, 0xf0, 0x0c, 0xf1, 0xf2, 0x84, 0x26,
+	0x57, 0x59, 0xb3, 0x27, 0x04, 0xe3, 0x34, 0xca, 0xe6, 0x69, 0x58, 0xdf, 0x94, 0xe0, 0xea, 0x27,
+	0x5c, 0xf8, 0xa1, 0x2b, 0xe8, 0xe7, 0xae, 0x2f, 0xa1, 0x54, 0x32, 0xe2, 0x29, 0xe8, 0x2c, 0xef,
+	0x70, 0xca, 0x17, 0xe1, 0x70, 0x2a, 0x67, 0x70, 0x38, 0xd6, 0x7f, 0x97, 0xa0, 0x33, 0xbd, 0x81,
+	0xf3, 0x88, 0xed, 0x2a, 0xac, 0x60, 0xe2, 0xe7, 0x84, 0xc9, 0xed, 0xcd, 0x32, 0x36, 0xf7, 0x65,
+	0x80, 0x97, 0xf0, 0xc3, 0x73, 0xa4, 0x59, 0x2a, 0xfd, 0x06, 0x45, 0x42, 0x6b, 0xcf, 0x01, 0x92,
+	0x6a, 0x1e, 0x90, 0x6c, 0xc3, 0x06, 0x0f, 0x98, 0xf3, 0xc2, 0x67, 0x81, 0x2a, 0x5d, 0xca, 0x40,
+	0x21, 0x9d, 0x4e, 0xc9, 0x5e, 0xe7, 0x01, 0x7b, 0x9e, 0x7c, 0xb1, 0xf1, 0x2f, 0x9e, 0xbf, 0xaa,
+	0x01, 0xcb, 0xab, 0xf6, 0x49, 0x2c, 0xd8, 0xe7, 0xd6, 0x37, 0x4b, 0x40, 0x9e, 0xd3, 0xd8, 0x1f,
+	0x9c, 0x64, 0x6e, 0x02, 0x4f, 0x37, 0xf1, 0xcb, 0xb0, 0x84, 0x10, 0x27, 0x09, 0x2c, 0xaa, 0x71,
+	0xca, 0xdd, 0xc2, 0xd4, 0xe5, 0x41, 0xf5, 0xf4, 0xcb, 0x83, 0xdc, 0x2b, 0xc5, 0x7c, 0xe5, 0x65,
+	0x79, 0xfe, 0xf3, 0xc9, 0x95, 0x39, 0xcf, 0x27, 0x6b, 0xa7, 0x3c, 0x7f, 0xa8, 0x67, 0x9f, 0x3f,
+	0x14, 0x14, 0x42, 0xa0, 0xa8, 0x10, 0xb2, 0xf8, 0xd5, 0xff, 0xb4, 0x87, 0x6c, 0x9e, 0xdd, 0x43,
+	0xca, 0x9a, 0x6a, 0x4b, 0x5a, 0xa9, 0xfc, 0x8d, 0xcf, 0x5e, 0xe5, 0xd2, 0xd5, 0x4d, 0xd7, 0xaa,
+	0xcc, 0xda, 0x73, 0x37, 0xa6, 0xfa, 0x9d, 0x35, 0x16, 0x04, 0x11, 0x50, 0xda, 0x75, 0xd9, 0x01,
+	0x7f, 0xe6, 0x2d, 0x69, 0xed, 0x22, 0xde, 0xf3, 0xb4, 0xcf, 0x64, 0xd3, 0xd3, 0x9e, 0x7e, 0xbd,
+	0xc8, 0xd3, 0xff, 0x6d, 0x09, 0xae, 0x4e, 0x81, 0xcb, 0xf3, 0x58, 0xed, 0xa7, 0xd0, 0xec, 0x1b,
+	0x83, 0xe9, 0xe8, 0x55, 0x18, 0x34, 0xf3, 0xc8, 0xdd, 0xce, 0xf4, 0xfc, 0xf0, 0xb7, 0xa1, 0x95,
+	0x29, 0xb1, 0x10, 0x02, 0xab, 0x9f, 0x45, 0xc7, 0x11, 0x7b, 0x19, 0x69, 0x7a, 0xfb, 0x12, 0x59,
+	0x83, 0x06, 0x0e, 0x93, 0x10, 0x4a, 0x48, 0x40, 0xc1, 0x24, 0x84, 0x32, 0x59, 0x87, 0xd6, 0xc3,
+	0x80, 0xf5, 0xdc, 0x20, 0x21, 0x55, 0xee, 0xfe, 0x1c, 0x00, 0xa4, 0xbd, 0xee, 0x30, 0x16, 0x7b,
+	0x24, 0x90, 0xd9, 0xd9, 0x0e, 0x0b, 0x47, 0x2c, 0xa2, 0x91, 0x38, 0x54, 0x05, 0xcb, 0xed, 0xec,
+	0x92, 0x75, 0x63, 0x9a, 0x51, 0xdb, 0x7c, 0xf7, 0x5b, 0x85, 0xfc, 0x39, 0x66, 0xeb, 0x12, 0xf9,
+	0x4a, 0x3e, 0xf0, 0xc0, 0xa6, 0xcf, 0x85, 0xdf, 0xe7, 0x3b, 0x47, 0x6e, 0x14, 0xd1, 0x80, 0xdc,
+	0x9d, 0xf1, 0xde, 0xb2, 0x88, 0x39, 0x99, 0xf3, 0xbd, 0xc2, 0x39, 0x0f, 0x45, 0xac, 0xaa, 0x65,
+	0x52, 0x8c, 0xd6, 0x25, 0xf2, 0x0c, 0x1a, 0xc6, 0xc3, 0x36, 0xf2, 0xfe, 0x6c, 0x04, 0x63, 0x7a,
+	0xb1, 0xee, 0x69, 0xf2, 0xb6, 0x2e, 0x91, 0x01, 0xb4, 0x32, 0xaf, 0x32, 0xc9, 0xd6, 0x69, 0xef,
+	0x4a, 0xcc, 0xa7, 0x90, 0xdd, 0x0f, 0x16, 0xe0, 0x4c, 0x57, 0xff, 0x7b, 0xea, 0xc0, 0xa6, 0x9e,
+	0x35, 0xde, 0x99, 0x31, 0xc8, 0xac, 0x07, 0x98, 0xdd, 0x8f, 0x16, 0xef, 0x90, 0x4e, 0xee, 0x4d,
+	0x36, 0xa9, 0x72, 0xd2, 0xdb, 0xf3, 0x1f, 0xcf, 0xa8, 0xd9, 0xb6, 0x16, 0x7d, 0x65, 0x63, 0x5d,
+	0x22, 0x07, 0x50, 0x4f, 0xdf, 0xb9, 0x90, 0x42, 0x5b, 0xc9, 0x3f, 0x83, 0x59, 0x40, 0x38, 0x99,
+	0x77, 0x24, 0xc5, 0xc2, 0x29, 0x7a, 0xc6, 0xd2, 0xfd, 0x60, 0x01, 0xce, 0x74, 0xe5, 0xbf, 0x0f,
+	0x57, 0x0a, 0x5f, 0x6f, 0x90, 0x8f, 0x4e, 0xdb, 0x7e, 0xd1, 0x63, 0x92, 0xee, 0x77, 0xdf, 0xa0,
+	0x87, 0xa1, 0x1c, 0xe4, 0xf0, 0x88, 0xbd, 0x54, 0x0e, 0x5d, 0x67, 0x7c, 0x05, 0x93, 0x6b, 0x5b,
+	0x9a, 0x66, 0x9d, 0x39, 0xf9, 0x29, 0x3d, 0xd2, 0xc9, 0x1d, 0x80, 0x87, 0x54, 0xec, 0x53, 0x11,
+	0xfb, 0x7d, 0x9e, 0x37, 0xab, 0x89, 0xc3, 0xd0, 0x0c, 0xc9, 0x54, 0xb7, 0xe7, 0xf2, 0xa5, 0x13,
+	0xf4, 0xa0, 0x21, 0xa1, 0xe7, 0xa7, 0xd4, 0x0d, 0xc4, 0x11, 0x29, 0xee, 0x69, 0x70, 0xcc, 0xd0,
+	0xbd, 0x22, 0xc6, 0x64, 0x8e, 0xbb, 0x5f, 0xb7, 0xf4, 0x3f, 0x0a, 0xa1, 0x1f, 0xfd, 0xff, 0xef,
+	0x0b, 0x0f, 0xa0, 0x9e, 0x26, 0x6b, 0x64, 0xa1, 0x5c, 0x6e, 0x9e, 0xa9, 0x7d, 0x01, 0xf5, 0xb4,
+	0x46, 0x5f, 0x3c, 0x62, 0xfe, 0xfe, 0xad, 0x7b, 0x6b, 0x0e, 0x57, 0xba, 0xda, 0xa7, 0x50, 0x4b,
+	0x2a, 0xbe, 0xe4, 0xbd, 0x59, 0x7e, 0xc1, 0x1c, 0x79, 0xce, 0x5a, 0x7f, 0x06, 0x0d, 0xa3, 0xe2,
+	0x58, 0x1c, 0x09, 0xa6, 0x2b, 0x95, 0xdd, 0xdb, 0x73, 0xf9, 0xd2, 0x15, 0x07, 0xb0, 0x96, 0xc3,
+	0x13, 0xe4, 0xc3, 0x19, 0xbd, 0x0b, 0x2a, 0x5a, 0xdd, 0x6f, 0x2f, 0xc4, 0x9b, 0xce, 0xf6, 0x05,
+	0x34, 0x8c, 0x02, 0x58, 0xf1, 0x7e, 0xa6, 0x2b, 0x64, 0xdd, 0x9b, 0x33, 0xea, 0x8f, 0x49, 0xe9,
+	0xcb, 0xba, 0xf4, 0x51, 0x09, 0xa3, 0xa6, 0x51, 0x7f, 0x2a, 0x1e, 0x7b, 0xba, 0x40, 0x35, 0x4f,
+	0x02, 0x0c, 0xda, 0xf9, 0x34, 0x89, 0x14, 0x6e, 0x7a, 0x46, 0x36, 0xd8, 0xfd, 0x95, 0xc5, 0x98,
+	0xcd, 0xe0, 0x6f, 0x64, 0x28, 0xc5, 0xdb, 0x98, 0x4e, 0x61, 0xe6, 0x6d, 0xe3, 0x39, 0x34, 0xcd,
+	0xe4, 0xb7, 0x38, 0x2c, 0x16, 0xa4, 0xc7, 0xf3, 0xc6, 0xed, 0x43, 0xd3, 0x2c, 0x4d, 0x15, 0x8f,
+	0x5b, 0x50, 0xcd, 0xeb, 0x6e, 0xcd, 0x67, 0x4c, 0x8f, 0xe4, 0x67, 0xd0, 0x30, 0x8a, 0x43, 0xc5,
+	0x47, 0x32, 0x5d, 0x72, 0xea, 0xde, 0x9e, 0xcb, 0x67, 0xe8, 0x65, 0x3d, 0xad, 0x1b, 0x14, 0xfb,
+	0x84, 0x7c, 0x59, 0xa8, 0x7b, 0x6b, 0x0e, 0xd7, 0x2f, 0x47, 0xc8, 0xbb, 0xff, 0xab, 0x5f, 0xdc,
+	0x1d, 0xfa, 0xe2, 0x68, 0xdc, 0x43, 0xd5, 0xb8, 0xa3, 0x38, 0xbf, 0xe3, 0x33, 0xfd, 0xeb, 0x4e,
+	0xb2, 0xca, 0x3b, 0x72, 0xa4, 0x3b, 0xf2, 0x94, 0x46, 0xbd, 0xde, 0xb2, 0x6c, 0x7e, 0xef, 0x7f,
+	0x07, 0x00, 0x21, 0xd4, 0x52, 0x2c, 0x57, 0x3b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2661,6 +4708,34 @@ type IndexNodeClient interface {
 	QueryJobs(ctx context.Context, in *QueryJobsRequest, opts ...grpc.CallOption) (*QueryJobsResponse, error)
 	DropJobs(ctx context.Context, in *DropJobsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	GetJobStats(ctx context.Context, in *GetJobStatsRequest, opts ...grpc.CallOption) (*GetJobStatsResponse, error)
+	GetCapabilities(ctx context.Context, in *GetCapabilitiesRequest, opts ...grpc.CallOption) (*GetCapabilitiesResponse, error)
+	// WatchJobLog streams the log entries of a task, the entries logged so far first, then the new ones
+	// until the task is done.
+	WatchJobLog(ctx context.Context, in *WatchJobLogRequest, opts ...grpc.CallOption) (IndexNode_WatchJobLogClient, error)
+	// SetLogLevel changes the log level of the node for a bounded duration, then the previous level is restored.
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// EstimateWaitTime returns the estimated queue wait of a job created now and the wait SLO of the queue,
+	// for the external autoscalers.
+	EstimateWaitTime(ctx context.Context, in *EstimateWaitTimeRequest, opts ...grpc.CallOption) (*EstimateWaitTimeResponse, error)
+	// VerifyIndex checks the integrity of the index files of a finished build without building anything. The
+	// verification is a job of the jobID queried by QueryJobs, it finishes if the index is healthy and fails with
+	// the problems found otherwise.
+	VerifyIndex(ctx context.Context, in *VerifyIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// SetSuspended fences the node for investigation or lifts the fence. The suspended node stays registered and
+	// reports the suspension in the extra info of GetComponentStates, but rejects every new job.
+	SetSuspended(ctx context.Context, in *SetSuspendedRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// ReserveSlots is the preflight of CreateJob: the node weighs its load against the estimated cost of the job
+	// and reserves a build slot if it accepts the job. The CreateJob carrying the token takes the reservation
+	// until it expires.
+	ReserveSlots(ctx context.Context, in *ReserveSlotsRequest, opts ...grpc.CallOption) (*ReserveSlotsResponse, error)
+	// HandoffJobs takes back the queued jobs of the cluster not started yet from the node being removed, the jobs
+	// are dropped from the node and returned, so they are reassigned at once instead of after the node finishes
+	// them or its session expires. The running jobs stay on the node.
+	HandoffJobs(ctx context.Context, in *HandoffJobsRequest, opts ...grpc.CallOption) (*HandoffJobsResponse, error)
+	// SelfCheck runs a tiny end-to-end build of synthetic vectors, writes the index files to the storage, reads them
+	// back and deletes them, and reports whether every dependency of the node passes, for the verification of the
+	// nodes after deploying.
+	SelfCheck(ctx context.Context, in *SelfCheckRequest, opts ...grpc.CallOption) (*SelfCheckResponse, error)
 	ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
@@ -2728,6 +4803,110 @@ func (c *indexNodeClient) GetJobStats(ctx context.Context, in *GetJobStatsReques
 	return out, nil
 }
 
+func (c *indexNodeClient) GetCapabilities(ctx context.Context, in *GetCapabilitiesRequest, opts ...grpc.CallOption) (*GetCapabilitiesResponse, error) {
+	out := new(GetCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexNode/GetCapabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexNodeClient) WatchJobLog(ctx context.Context, in *WatchJobLogRequest, opts ...grpc.CallOption) (IndexNode_WatchJobLogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_IndexNode_serviceDesc.Streams[0], "/milvus.proto.index.IndexNode/WatchJobLog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &indexNodeWatchJobLogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type IndexNode_WatchJobLogClient interface {
+	Recv() (*JobLogEntry, error)
+	grpc.ClientStream
+}
+
+type indexNodeWatchJobLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *indexNodeWatchJobLogClient) Recv() (*JobLogEntry, error) {
+	m := new(JobLogEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *indexNodeClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexNode/SetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexNodeClient) EstimateWaitTime(ctx context.Context, in *EstimateWaitTimeRequest, opts ...grpc.CallOption) (*EstimateWaitTimeResponse, error) {
+	out := new(EstimateWaitTimeResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexNode/EstimateWaitTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexNodeClient) VerifyIndex(ctx context.Context, in *VerifyIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexNode/VerifyIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexNodeClient) SetSuspended(ctx context.Context, in *SetSuspendedRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexNode/SetSuspended", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexNodeClient) ReserveSlots(ctx context.Context, in *ReserveSlotsRequest, opts ...grpc.CallOption) (*ReserveSlotsResponse, error) {
+	out := new(ReserveSlotsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexNode/ReserveSlots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexNodeClient) HandoffJobs(ctx context.Context, in *HandoffJobsRequest, opts ...grpc.CallOption) (*HandoffJobsResponse, error) {
+	out := new(HandoffJobsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexNode/HandoffJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexNodeClient) SelfCheck(ctx context.Context, in *SelfCheckRequest, opts ...grpc.CallOption) (*SelfCheckResponse, error) {
+	out := new(SelfCheckResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexNode/SelfCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *indexNodeClient) ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error) {
 	out := new(internalpb.ShowConfigurationsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexNode/ShowConfigurations", in, out, opts...)
@@ -2754,6 +4933,34 @@ type IndexNodeServer interface {
 	QueryJobs(context.Context, *QueryJobsRequest) (*QueryJobsResponse, error)
 	DropJobs(context.Context, *DropJobsRequest) (*commonpb.Status, error)
 	GetJobStats(context.Context, *GetJobStatsRequest) (*GetJobStatsResponse, error)
+	GetCapabilities(context.Context, *GetCapabilitiesRequest) (*GetCapabilitiesResponse, error)
+	// WatchJobLog streams the log entries of a task, the entries logged so far first, then the new ones
+	// until the task is done.
+	WatchJobLog(*WatchJobLogRequest, IndexNode_WatchJobLogServer) error
+	// SetLogLevel changes the log level of the node for a bounded duration, then the previous level is restored.
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*commonpb.Status, error)
+	// EstimateWaitTime returns the estimated queue wait of a job created now and the wait SLO of the queue,
+	// for the external autoscalers.
+	EstimateWaitTime(context.Context, *EstimateWaitTimeRequest) (*EstimateWaitTimeResponse, error)
+	// VerifyIndex checks the integrity of the index files of a finished build without building anything. The
+	// verification is a job of the jobID queried by QueryJobs, it finishes if the index is healthy and fails with
+	// the problems found otherwise.
+	VerifyIndex(context.Context, *VerifyIndexRequest) (*commonpb.Status, error)
+	// SetSuspended fences the node for investigation or lifts the fence. The suspended node stays registered and
+	// reports the suspension in the extra info of GetComponentStates, but rejects every new job.
+	SetSuspended(context.Context, *SetSuspendedRequest) (*commonpb.Status, error)
+	// ReserveSlots is the preflight of CreateJob: the node weighs its load against the estimated cost of the job
+	// and reserves a build slot if it accepts the job. The CreateJob carrying the token takes the reservation
+	// until it expires.
+	ReserveSlots(context.Context, *ReserveSlotsRequest) (*ReserveSlotsResponse, error)
+	// HandoffJobs takes back the queued jobs of the cluster not started yet from the node being removed, the jobs
+	// are dropped from the node and returned, so they are reassigned at once instead of after the node finishes
+	// them or its session expires. The running jobs stay on the node.
+	HandoffJobs(context.Context, *HandoffJobsRequest) (*HandoffJobsResponse, error)
+	// SelfCheck runs a tiny end-to-end build of synthetic vectors, writes the index files to the storage, reads them
+	// back and deletes them, and reports whether every dependency of the node passes, for the verification of the
+	// nodes after deploying.
+	SelfCheck(context.Context, *SelfCheckRequest) (*SelfCheckResponse, error)
 	ShowConfigurations(context.Context, *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
@@ -2781,6 +4988,33 @@ func (*UnimplementedIndexNodeServer) DropJobs(ctx context.Context, req *DropJobs
 func (*UnimplementedIndexNodeServer) GetJobStats(ctx context.Context, req *GetJobStatsRequest) (*GetJobStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetJobStats not implemented")
 }
+func (*UnimplementedIndexNodeServer) GetCapabilities(ctx context.Context, req *GetCapabilitiesRequest) (*GetCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapabilities not implemented")
+}
+func (*UnimplementedIndexNodeServer) WatchJobLog(req *WatchJobLogRequest, srv IndexNode_WatchJobLogServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchJobLog not implemented")
+}
+func (*UnimplementedIndexNodeServer) SetLogLevel(ctx context.Context, req *SetLogLevelRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (*UnimplementedIndexNodeServer) EstimateWaitTime(ctx context.Context, req *EstimateWaitTimeRequest) (*EstimateWaitTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateWaitTime not implemented")
+}
+func (*UnimplementedIndexNodeServer) VerifyIndex(ctx context.Context, req *VerifyIndexRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyIndex not implemented")
+}
+func (*UnimplementedIndexNodeServer) SetSuspended(ctx context.Context, req *SetSuspendedRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSuspended not implemented")
+}
+func (*UnimplementedIndexNodeServer) ReserveSlots(ctx context.Context, req *ReserveSlotsRequest) (*ReserveSlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveSlots not implemented")
+}
+func (*UnimplementedIndexNodeServer) HandoffJobs(ctx context.Context, req *HandoffJobsRequest) (*HandoffJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandoffJobs not implemented")
+}
+func (*UnimplementedIndexNodeServer) SelfCheck(ctx context.Context, req *SelfCheckRequest) (*SelfCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelfCheck not implemented")
+}
 func (*UnimplementedIndexNodeServer) ShowConfigurations(ctx context.Context, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowConfigurations not implemented")
 }
@@ -2900,6 +5134,171 @@ func _IndexNode_GetJobStats_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IndexNode_GetCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexNodeServer).GetCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexNode/GetCapabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexNodeServer).GetCapabilities(ctx, req.(*GetCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexNode_WatchJobLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchJobLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IndexNodeServer).WatchJobLog(m, &indexNodeWatchJobLogServer{stream})
+}
+
+type IndexNode_WatchJobLogServer interface {
+	Send(*JobLogEntry) error
+	grpc.ServerStream
+}
+
+type indexNodeWatchJobLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *indexNodeWatchJobLogServer) Send(m *JobLogEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _IndexNode_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexNodeServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexNode/SetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexNodeServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexNode_EstimateWaitTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateWaitTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexNodeServer).EstimateWaitTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexNode/EstimateWaitTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexNodeServer).EstimateWaitTime(ctx, req.(*EstimateWaitTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexNode_VerifyIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexNodeServer).VerifyIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexNode/VerifyIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexNodeServer).VerifyIndex(ctx, req.(*VerifyIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexNode_SetSuspended_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSuspendedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexNodeServer).SetSuspended(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexNode/SetSuspended",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexNodeServer).SetSuspended(ctx, req.(*SetSuspendedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexNode_ReserveSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexNodeServer).ReserveSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexNode/ReserveSlots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexNodeServer).ReserveSlots(ctx, req.(*ReserveSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexNode_HandoffJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandoffJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexNodeServer).HandoffJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexNode/HandoffJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexNodeServer).HandoffJobs(ctx, req.(*HandoffJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexNode_SelfCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelfCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexNodeServer).SelfCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexNode/SelfCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexNodeServer).SelfCheck(ctx, req.(*SelfCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IndexNode_ShowConfigurations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(internalpb.ShowConfigurationsRequest)
 	if err := dec(in); err != nil {
@@ -2964,6 +5363,38 @@ var _IndexNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetJobStats",
 			Handler:    _IndexNode_GetJobStats_Handler,
 		},
+		{
+			MethodName: "GetCapabilities",
+			Handler:    _IndexNode_GetCapabilities_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _IndexNode_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "EstimateWaitTime",
+			Handler:    _IndexNode_EstimateWaitTime_Handler,
+		},
+		{
+			MethodName: "VerifyIndex",
+			Handler:    _IndexNode_VerifyIndex_Handler,
+		},
+		{
+			MethodName: "SetSuspended",
+			Handler:    _IndexNode_SetSuspended_Handler,
+		},
+		{
+			MethodName: "ReserveSlots",
+			Handler:    _IndexNode_ReserveSlots_Handler,
+		},
+		{
+			MethodName: "HandoffJobs",
+			Handler:    _IndexNode_HandoffJobs_Handler,
+		},
+		{
+			MethodName: "SelfCheck",
+			Handler:    _IndexNode_SelfCheck_Handler,
+		},
 		{
 			MethodName: "ShowConfigurations",
 			Handler:    _IndexNode_ShowConfigurations_Handler,
@@ -2973,6 +5404,12 @@ var _IndexNode_serviceDesc = grpc.ServiceDesc{
 			Handler:    _IndexNode_GetMetrics_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchJobLog",
+			Handler:       _IndexNode_WatchJobLog_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "index_coord.proto",
 }
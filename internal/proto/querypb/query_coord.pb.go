@@ -215,7 +215,7 @@ func (SyncType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_aab7cc9a69ed26e8, []int{5}
 }
 
-//--------------------QueryCoord grpc request and response proto------------------
+// --------------------QueryCoord grpc request and response proto------------------
 type ShowCollectionsRequest struct {
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	// Not useful for now
@@ -1221,7 +1221,7 @@ func (m *ShardLeadersList) GetNodeAddrs() []string {
 	return nil
 }
 
-//-----------------query node grpc request and response proto----------------
+// -----------------query node grpc request and response proto----------------
 type LoadMetaInfo struct {
 	LoadType             LoadType `protobuf:"varint,1,opt,name=load_type,json=loadType,proto3,enum=milvus.proto.query.LoadType" json:"load_type,omitempty"`
 	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
@@ -1615,18 +1615,20 @@ func (m *SegmentLoadInfo) GetStartPosition() *internalpb.MsgPosition {
 type FieldIndexInfo struct {
 	FieldID int64 `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
 	// deprecated
-	EnableIndex          bool                     `protobuf:"varint,2,opt,name=enable_index,json=enableIndex,proto3" json:"enable_index,omitempty"`
-	IndexName            string                   `protobuf:"bytes,3,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	IndexID              int64                    `protobuf:"varint,4,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	BuildID              int64                    `protobuf:"varint,5,opt,name=buildID,proto3" json:"buildID,omitempty"`
-	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
-	IndexFilePaths       []string                 `protobuf:"bytes,7,rep,name=index_file_paths,json=indexFilePaths,proto3" json:"index_file_paths,omitempty"`
-	IndexSize            int64                    `protobuf:"varint,8,opt,name=index_size,json=indexSize,proto3" json:"index_size,omitempty"`
-	IndexVersion         int64                    `protobuf:"varint,9,opt,name=index_version,json=indexVersion,proto3" json:"index_version,omitempty"`
-	NumRows              int64                    `protobuf:"varint,10,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	EnableIndex    bool                     `protobuf:"varint,2,opt,name=enable_index,json=enableIndex,proto3" json:"enable_index,omitempty"`
+	IndexName      string                   `protobuf:"bytes,3,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexID        int64                    `protobuf:"varint,4,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	BuildID        int64                    `protobuf:"varint,5,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	IndexParams    []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
+	IndexFilePaths []string                 `protobuf:"bytes,7,rep,name=index_file_paths,json=indexFilePaths,proto3" json:"index_file_paths,omitempty"`
+	IndexSize      int64                    `protobuf:"varint,8,opt,name=index_size,json=indexSize,proto3" json:"index_size,omitempty"`
+	IndexVersion   int64                    `protobuf:"varint,9,opt,name=index_version,json=indexVersion,proto3" json:"index_version,omitempty"`
+	NumRows        int64                    `protobuf:"varint,10,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	// mem_size is the memory to load the index estimated by IndexNode, 0 if it's unknown.
+	MemSize              int64    `protobuf:"varint,11,opt,name=mem_size,json=memSize,proto3" json:"mem_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *FieldIndexInfo) Reset()         { *m = FieldIndexInfo{} }
@@ -1724,6 +1726,13 @@ func (m *FieldIndexInfo) GetNumRows() int64 {
 	return 0
 }
 
+func (m *FieldIndexInfo) GetMemSize() int64 {
+	if m != nil {
+		return m.MemSize
+	}
+	return 0
+}
+
 type LoadSegmentsRequest struct {
 	Base                 *commonpb.MsgBase          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DstNodeID            int64                      `protobuf:"varint,2,opt,name=dst_nodeID,json=dstNodeID,proto3" json:"dst_nodeID,omitempty"`
@@ -2207,7 +2216,7 @@ func (m *ReplicaSegmentsInfo) GetVersions() []int64 {
 	return nil
 }
 
-//----------------request auto triggered by QueryCoord-----------------
+// ----------------request auto triggered by QueryCoord-----------------
 type HandoffSegmentsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	SegmentInfos         []*SegmentInfo    `protobuf:"bytes,2,rep,name=segmentInfos,proto3" json:"segmentInfos,omitempty"`
@@ -2904,7 +2913,7 @@ func (m *UnsubscribeChannelInfo) GetCollectionChannels() []*UnsubscribeChannels
 	return nil
 }
 
-//---- synchronize messages proto between QueryCoord and QueryNode -----
+// ---- synchronize messages proto between QueryCoord and QueryNode -----
 type SegmentChangeInfo struct {
 	OnlineNodeID         int64          `protobuf:"varint,1,opt,name=online_nodeID,json=onlineNodeID,proto3" json:"online_nodeID,omitempty"`
 	OnlineSegments       []*SegmentInfo `protobuf:"bytes,2,rep,name=online_segments,json=onlineSegments,proto3" json:"online_segments,omitempty"`
@@ -4087,267 +4096,267 @@ func init() {
 func init() { proto.RegisterFile("query_coord.proto", fileDescriptor_aab7cc9a69ed26e8) }
 
 var fileDescriptor_aab7cc9a69ed26e8 = []byte{
-	// 4155 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x49, 0x6c, 0x24, 0x59,
-	0x56, 0x15, 0xb9, 0xd8, 0x99, 0x2f, 0x17, 0xa7, 0xbf, 0x97, 0xca, 0xc9, 0xa9, 0xc5, 0x1d, 0xd5,
-	0xd5, 0x6d, 0xdc, 0xdd, 0x76, 0x8f, 0x6b, 0xa6, 0xa9, 0xd9, 0x34, 0x54, 0xd9, 0x53, 0x6e, 0xd3,
-	0x55, 0x6e, 0x13, 0xae, 0xaa, 0x41, 0xad, 0x66, 0x72, 0x22, 0x33, 0x7e, 0xa6, 0x43, 0x15, 0x19,
-	0x91, 0x15, 0x11, 0x69, 0xb7, 0x1b, 0x89, 0x13, 0x97, 0x41, 0x80, 0x04, 0x07, 0x4e, 0x88, 0x03,
-	0x02, 0x09, 0x24, 0x5a, 0xe2, 0x00, 0x37, 0x0e, 0x48, 0x48, 0x70, 0x02, 0x71, 0xe3, 0xc8, 0x15,
-	0x09, 0x24, 0x04, 0xd2, 0x68, 0x34, 0x37, 0xf4, 0xb7, 0xc8, 0xf8, 0x11, 0x3f, 0x9c, 0x61, 0xbb,
-	0xb7, 0x41, 0x73, 0x8b, 0x78, 0x7f, 0x79, 0xef, 0xbf, 0xfd, 0xfd, 0x05, 0x16, 0x5f, 0x4e, 0xb0,
-	0x7f, 0xd6, 0xed, 0x7b, 0x9e, 0x6f, 0x6d, 0x8e, 0x7d, 0x2f, 0xf4, 0x10, 0x1a, 0xd9, 0xce, 0xc9,
-	0x24, 0x60, 0x7f, 0x9b, 0xb4, 0xbd, 0x53, 0xef, 0x7b, 0xa3, 0x91, 0xe7, 0x32, 0x58, 0xa7, 0x1e,
-	0xef, 0xd1, 0x69, 0xda, 0x6e, 0x88, 0x7d, 0xd7, 0x74, 0x44, 0x6b, 0xd0, 0x3f, 0xc6, 0x23, 0x93,
-	0xff, 0xb5, 0x2c, 0x33, 0x34, 0xe3, 0xf3, 0xeb, 0xbf, 0xad, 0xc1, 0xea, 0xd1, 0xb1, 0x77, 0xba,
-	0xe3, 0x39, 0x0e, 0xee, 0x87, 0xb6, 0xe7, 0x06, 0x06, 0x7e, 0x39, 0xc1, 0x41, 0x88, 0xde, 0x86,
-	0x52, 0xcf, 0x0c, 0x70, 0x5b, 0x5b, 0xd3, 0xd6, 0x6b, 0xdb, 0x37, 0x36, 0x25, 0x4a, 0x38, 0x09,
-	0x4f, 0x82, 0xe1, 0x43, 0x33, 0xc0, 0x06, 0xed, 0x89, 0x10, 0x94, 0xac, 0xde, 0xfe, 0x6e, 0xbb,
-	0xb0, 0xa6, 0xad, 0x17, 0x0d, 0xfa, 0x8d, 0x5e, 0x85, 0x46, 0x3f, 0x9a, 0x7b, 0x7f, 0x37, 0x68,
-	0x17, 0xd7, 0x8a, 0xeb, 0x45, 0x43, 0x06, 0xea, 0xff, 0xae, 0xc1, 0xf5, 0x14, 0x19, 0xc1, 0xd8,
-	0x73, 0x03, 0x8c, 0xee, 0xc1, 0x5c, 0x10, 0x9a, 0xe1, 0x24, 0xe0, 0x94, 0x7c, 0x55, 0x49, 0xc9,
-	0x11, 0xed, 0x62, 0xf0, 0xae, 0x69, 0xb4, 0x05, 0x05, 0x5a, 0xf4, 0x35, 0x58, 0xb6, 0xdd, 0x27,
-	0x78, 0xe4, 0xf9, 0x67, 0xdd, 0x31, 0xf6, 0xfb, 0xd8, 0x0d, 0xcd, 0x21, 0x16, 0x34, 0x2e, 0x89,
-	0xb6, 0xc3, 0x69, 0x13, 0x7a, 0x07, 0xae, 0x33, 0x29, 0x05, 0xd8, 0x3f, 0xb1, 0xfb, 0xb8, 0x6b,
-	0x9e, 0x98, 0xb6, 0x63, 0xf6, 0x1c, 0xdc, 0x2e, 0xad, 0x15, 0xd7, 0x2b, 0xc6, 0x0a, 0x6d, 0x3e,
-	0x62, 0xad, 0x0f, 0x44, 0xa3, 0xfe, 0xe7, 0x1a, 0xac, 0x90, 0x15, 0x1e, 0x9a, 0x7e, 0x68, 0x7f,
-	0x06, 0x7c, 0xd6, 0xa1, 0x1e, 0x5f, 0x5b, 0xbb, 0x48, 0xdb, 0x24, 0x18, 0xe9, 0x33, 0x16, 0xe8,
-	0x09, 0x4f, 0x4a, 0x74, 0x99, 0x12, 0x4c, 0xff, 0x33, 0xae, 0x10, 0x71, 0x3a, 0xaf, 0x22, 0x88,
-	0x24, 0xce, 0x42, 0x1a, 0xe7, 0x25, 0xc4, 0xa0, 0xff, 0x73, 0x11, 0x56, 0x1e, 0x7b, 0xa6, 0x35,
-	0x55, 0x98, 0xcf, 0x9f, 0x9d, 0xdf, 0x85, 0x39, 0x66, 0x5d, 0xed, 0x12, 0xc5, 0x75, 0x57, 0xc6,
-	0xc5, 0x2d, 0x6f, 0x4a, 0xe1, 0x11, 0x05, 0x18, 0x7c, 0x10, 0xba, 0x0b, 0x4d, 0x1f, 0x8f, 0x1d,
-	0xbb, 0x6f, 0x76, 0xdd, 0xc9, 0xa8, 0x87, 0xfd, 0x76, 0x79, 0x4d, 0x5b, 0x2f, 0x1b, 0x0d, 0x0e,
-	0x3d, 0xa0, 0x40, 0xf4, 0x23, 0x68, 0x0c, 0x6c, 0xec, 0x58, 0x5d, 0xdb, 0xb5, 0xf0, 0x47, 0xfb,
-	0xbb, 0xed, 0xb9, 0xb5, 0xe2, 0x7a, 0x6d, 0xfb, 0xdb, 0x9b, 0x69, 0xcf, 0xb0, 0xa9, 0xe4, 0xc8,
-	0xe6, 0x23, 0x32, 0x7c, 0x9f, 0x8d, 0xfe, 0xbe, 0x1b, 0xfa, 0x67, 0x46, 0x7d, 0x10, 0x03, 0xa1,
-	0x36, 0xcc, 0xfb, 0x78, 0xe0, 0xe3, 0xe0, 0xb8, 0x3d, 0xbf, 0xa6, 0xad, 0x57, 0x0c, 0xf1, 0x8b,
-	0x5e, 0x87, 0x05, 0x1f, 0x07, 0xde, 0xc4, 0xef, 0xe3, 0xee, 0xd0, 0xf7, 0x26, 0xe3, 0xa0, 0x5d,
-	0x59, 0x2b, 0xae, 0x57, 0x8d, 0xa6, 0x00, 0xef, 0x51, 0x68, 0xe7, 0x7b, 0xb0, 0x98, 0xc2, 0x82,
-	0x5a, 0x50, 0x7c, 0x81, 0xcf, 0xa8, 0x20, 0x8a, 0x06, 0xf9, 0x44, 0xcb, 0x50, 0x3e, 0x31, 0x9d,
-	0x09, 0xe6, 0xac, 0x66, 0x3f, 0xdf, 0x2a, 0xdc, 0xd7, 0xf4, 0x3f, 0xd6, 0xa0, 0x6d, 0x60, 0x07,
-	0x9b, 0x01, 0xfe, 0x22, 0x45, 0xba, 0x0a, 0x73, 0xae, 0x67, 0xe1, 0xfd, 0x5d, 0x2a, 0xd2, 0xa2,
-	0xc1, 0xff, 0xf4, 0x9f, 0x69, 0xb0, 0xbc, 0x87, 0x43, 0xa2, 0xdb, 0x76, 0x10, 0xda, 0xfd, 0xc8,
-	0x78, 0xbf, 0x0b, 0x45, 0x1f, 0xbf, 0xe4, 0x94, 0xbd, 0x21, 0x53, 0x16, 0xb9, 0x62, 0xd5, 0x48,
-	0x83, 0x8c, 0x43, 0xaf, 0x40, 0xdd, 0x1a, 0x39, 0xdd, 0xfe, 0xb1, 0xe9, 0xba, 0xd8, 0x61, 0xd6,
-	0x51, 0x35, 0x6a, 0xd6, 0xc8, 0xd9, 0xe1, 0x20, 0x74, 0x0b, 0x20, 0xc0, 0xc3, 0x11, 0x76, 0xc3,
-	0xa9, 0xf7, 0x8c, 0x41, 0xd0, 0x06, 0x2c, 0x0e, 0x7c, 0x6f, 0xd4, 0x0d, 0x8e, 0x4d, 0xdf, 0xea,
-	0x3a, 0xd8, 0xb4, 0xb0, 0x4f, 0xa9, 0xaf, 0x18, 0x0b, 0xa4, 0xe1, 0x88, 0xc0, 0x1f, 0x53, 0x30,
-	0xba, 0x07, 0xe5, 0xa0, 0xef, 0x8d, 0x31, 0xd5, 0xb4, 0xe6, 0xf6, 0x4d, 0x95, 0x0e, 0xed, 0x9a,
-	0xa1, 0x79, 0x44, 0x3a, 0x19, 0xac, 0xaf, 0xfe, 0x3f, 0xdc, 0xd4, 0xbe, 0xe4, 0x9e, 0x2b, 0x66,
-	0x8e, 0xe5, 0x4f, 0xc7, 0x1c, 0xe7, 0x72, 0x99, 0xe3, 0xfc, 0xf9, 0xe6, 0x98, 0xe2, 0xda, 0x45,
-	0xcc, 0xb1, 0x32, 0xd3, 0x1c, 0xab, 0x9f, 0x8d, 0x39, 0xfe, 0xfd, 0xd4, 0x1c, 0xbf, 0xec, 0x62,
-	0x9f, 0x9a, 0x6c, 0x59, 0x32, 0xd9, 0xbf, 0xd4, 0xe0, 0x2b, 0x7b, 0x38, 0x8c, 0xc8, 0x27, 0x16,
-	0x88, 0xbf, 0xa4, 0x41, 0xf7, 0x13, 0x0d, 0x3a, 0x2a, 0x5a, 0xaf, 0x12, 0x78, 0x3f, 0x80, 0xd5,
-	0x08, 0x47, 0xd7, 0xc2, 0x41, 0xdf, 0xb7, 0xc7, 0x54, 0x8c, 0xd4, 0xc9, 0xd4, 0xb6, 0xef, 0xa8,
-	0x34, 0x36, 0x49, 0xc1, 0x4a, 0x34, 0xc5, 0x6e, 0x6c, 0x06, 0xfd, 0xf7, 0x34, 0x58, 0x21, 0x4e,
-	0x8d, 0x7b, 0x21, 0x77, 0xe0, 0x5d, 0x9e, 0xaf, 0xb2, 0x7f, 0x2b, 0xa4, 0xfc, 0x5b, 0x0e, 0x1e,
-	0xd3, 0x2c, 0x36, 0x49, 0xcf, 0x55, 0x78, 0xf7, 0x0d, 0x28, 0xdb, 0xee, 0xc0, 0x13, 0xac, 0xba,
-	0xad, 0x62, 0x55, 0x1c, 0x19, 0xeb, 0xad, 0xbb, 0x8c, 0x8a, 0xa9, 0xc3, 0xbd, 0x82, 0xba, 0x25,
-	0x97, 0x5d, 0x50, 0x2c, 0xfb, 0x77, 0x35, 0xb8, 0x9e, 0x42, 0x78, 0x95, 0x75, 0x7f, 0x07, 0xe6,
-	0x68, 0x18, 0x11, 0x0b, 0x7f, 0x55, 0xb9, 0xf0, 0x18, 0xba, 0xc7, 0x76, 0x10, 0x1a, 0x7c, 0x8c,
-	0xee, 0x41, 0x2b, 0xd9, 0x46, 0x02, 0x1c, 0x0f, 0x6e, 0x5d, 0xd7, 0x1c, 0x31, 0x06, 0x54, 0x8d,
-	0x1a, 0x87, 0x1d, 0x98, 0x23, 0x8c, 0xbe, 0x02, 0x15, 0x62, 0xb2, 0x5d, 0xdb, 0x12, 0xe2, 0x9f,
-	0xa7, 0x26, 0x6c, 0x05, 0xe8, 0x26, 0x00, 0x6d, 0x32, 0x2d, 0xcb, 0x67, 0xb1, 0xaf, 0x6a, 0x54,
-	0x09, 0xe4, 0x01, 0x01, 0xe8, 0x7f, 0xa0, 0x41, 0x9d, 0xf8, 0xd8, 0x27, 0x38, 0x34, 0x89, 0x1c,
-	0xd0, 0x37, 0xa1, 0xea, 0x78, 0xa6, 0xd5, 0x0d, 0xcf, 0xc6, 0x0c, 0x55, 0x33, 0xc9, 0xeb, 0xa9,
-	0x63, 0x7e, 0x7a, 0x36, 0xc6, 0x46, 0xc5, 0xe1, 0x5f, 0x79, 0xf8, 0x9d, 0x32, 0xe5, 0xa2, 0xc2,
-	0x94, 0xff, 0xb1, 0x0c, 0xab, 0x3f, 0x30, 0xc3, 0xfe, 0xf1, 0xee, 0x48, 0x84, 0xf0, 0xcb, 0x2b,
-	0xc1, 0xd4, 0xb7, 0x15, 0xe2, 0xbe, 0xed, 0x53, 0xf3, 0x9d, 0x91, 0x9e, 0x97, 0x55, 0x7a, 0x4e,
-	0x8a, 0xc5, 0xcd, 0xe7, 0x5c, 0x54, 0x31, 0x3d, 0x8f, 0x45, 0xda, 0xb9, 0xcb, 0x44, 0xda, 0x1d,
-	0x68, 0xe0, 0x8f, 0xfa, 0xce, 0x84, 0xc8, 0x9c, 0x62, 0x67, 0x21, 0xf4, 0x96, 0x02, 0x7b, 0xdc,
-	0xc8, 0xea, 0x7c, 0xd0, 0x3e, 0xa7, 0x81, 0x89, 0x7a, 0x84, 0x43, 0x93, 0xc6, 0xc9, 0xda, 0xf6,
-	0x5a, 0x96, 0xa8, 0x85, 0x7e, 0x30, 0x71, 0x93, 0x3f, 0x74, 0x03, 0xaa, 0x3c, 0xae, 0xef, 0xef,
-	0xb6, 0xab, 0x94, 0x7d, 0x53, 0x00, 0x32, 0xa1, 0xc1, 0x3d, 0x10, 0xa7, 0x10, 0x28, 0x85, 0xdf,
-	0x51, 0x21, 0x50, 0x0b, 0x3b, 0x4e, 0x79, 0xc0, 0xa3, 0x7c, 0x10, 0x03, 0x91, 0x02, 0xd5, 0x1b,
-	0x0c, 0x1c, 0xdb, 0xc5, 0x07, 0x4c, 0xc2, 0x35, 0x4a, 0x84, 0x0c, 0x24, 0xb9, 0xc0, 0x09, 0xf6,
-	0x03, 0xdb, 0x73, 0xdb, 0x75, 0xda, 0x2e, 0x7e, 0x3b, 0x5d, 0x58, 0x4c, 0xa1, 0x50, 0x84, 0xf8,
-	0xaf, 0xc7, 0x43, 0xfc, 0x6c, 0x1e, 0xc7, 0x52, 0x80, 0xbf, 0xd0, 0x60, 0xe5, 0x99, 0x1b, 0x4c,
-	0x7a, 0xd1, 0xda, 0xbe, 0x18, 0x3d, 0x4e, 0x7a, 0x90, 0x52, 0xca, 0x83, 0xe8, 0x3f, 0x2e, 0xc3,
-	0x02, 0x5f, 0x05, 0x11, 0x37, 0x75, 0x05, 0x37, 0xa0, 0x1a, 0x05, 0x11, 0xce, 0x90, 0x29, 0x00,
-	0xad, 0x41, 0x2d, 0x66, 0x08, 0x9c, 0xaa, 0x38, 0x28, 0x17, 0x69, 0x22, 0x25, 0x28, 0xc5, 0x52,
-	0x82, 0x9b, 0x00, 0x03, 0x67, 0x12, 0x1c, 0x77, 0x43, 0x7b, 0x84, 0x79, 0x4a, 0x52, 0xa5, 0x90,
-	0xa7, 0xf6, 0x08, 0xa3, 0x07, 0x50, 0xef, 0xd9, 0xae, 0xe3, 0x0d, 0xbb, 0x63, 0x33, 0x3c, 0x0e,
-	0x78, 0x31, 0xa7, 0x12, 0x0b, 0x4d, 0xe0, 0x1e, 0xd2, 0xbe, 0x46, 0x8d, 0x8d, 0x39, 0x24, 0x43,
-	0xd0, 0x2d, 0xa8, 0xb9, 0x93, 0x51, 0xd7, 0x1b, 0x74, 0x7d, 0xef, 0x34, 0xa0, 0x25, 0x5b, 0xd1,
-	0xa8, 0xba, 0x93, 0xd1, 0xfb, 0x03, 0xc3, 0x3b, 0x25, 0x4e, 0xbc, 0x4a, 0xdc, 0x79, 0xe0, 0x78,
-	0x43, 0x56, 0xae, 0xcd, 0x9e, 0x7f, 0x3a, 0x80, 0x8c, 0xb6, 0xb0, 0x13, 0x9a, 0x74, 0x74, 0x35,
-	0xdf, 0xe8, 0x68, 0x00, 0x7a, 0x0d, 0x9a, 0x7d, 0x6f, 0x34, 0x36, 0x29, 0x87, 0x1e, 0xf9, 0xde,
-	0x88, 0x5a, 0x4e, 0xd1, 0x48, 0x40, 0xd1, 0x0e, 0xd4, 0x68, 0xfe, 0xcc, 0xcd, 0xab, 0x46, 0xf1,
-	0xe8, 0x2a, 0xf3, 0x8a, 0xe5, 0xb1, 0x44, 0x41, 0xc1, 0x16, 0x9f, 0x01, 0xd1, 0x0c, 0x61, 0xa5,
-	0x81, 0xfd, 0x31, 0xe6, 0x16, 0x52, 0xe3, 0xb0, 0x23, 0xfb, 0x63, 0x4c, 0x92, 0x7a, 0xdb, 0x0d,
-	0xb0, 0x1f, 0x8a, 0x12, 0xab, 0xdd, 0xa0, 0xea, 0xd3, 0x60, 0x50, 0xae, 0xd8, 0x68, 0x1f, 0x9a,
-	0x41, 0x68, 0xfa, 0x61, 0x77, 0xec, 0x05, 0x54, 0x01, 0xda, 0x4d, 0xaa, 0xdb, 0x7a, 0x46, 0x41,
-	0xf7, 0x24, 0x18, 0x1e, 0xf2, 0x9e, 0x46, 0x83, 0x8e, 0x14, 0xbf, 0xfa, 0x7f, 0x17, 0xa0, 0x29,
-	0xd3, 0x4c, 0x8c, 0x98, 0x25, 0xf8, 0x42, 0x11, 0xc5, 0x2f, 0x59, 0x01, 0x76, 0xcd, 0x9e, 0x83,
-	0x59, 0x35, 0x41, 0xf5, 0xb0, 0x62, 0xd4, 0x18, 0x8c, 0x4e, 0x40, 0xf4, 0x89, 0x71, 0x8a, 0x2a,
-	0x7f, 0x91, 0x52, 0x5f, 0xa5, 0x10, 0x1a, 0x3c, 0xdb, 0x30, 0x2f, 0x0a, 0x11, 0xa6, 0x85, 0xe2,
-	0x97, 0xb4, 0xf4, 0x26, 0x36, 0xc5, 0xca, 0xb4, 0x50, 0xfc, 0xa2, 0x5d, 0xa8, 0xb3, 0x29, 0xc7,
-	0xa6, 0x6f, 0x8e, 0x84, 0x0e, 0xbe, 0xa2, 0xb4, 0xe3, 0xf7, 0xf0, 0xd9, 0x73, 0xe2, 0x12, 0x0e,
-	0x4d, 0xdb, 0x37, 0x98, 0xcc, 0x0e, 0xe9, 0x28, 0xb4, 0x0e, 0x2d, 0x36, 0xcb, 0xc0, 0x76, 0x30,
-	0xd7, 0xe6, 0x79, 0x56, 0x8d, 0x50, 0xf8, 0x23, 0xdb, 0xc1, 0x4c, 0x61, 0xa3, 0x25, 0x50, 0x29,
-	0x55, 0x98, 0xbe, 0x52, 0x08, 0x95, 0xd1, 0x1d, 0x68, 0xb0, 0x66, 0xe1, 0xe9, 0x98, 0x3b, 0x66,
-	0x34, 0x3e, 0x67, 0x30, 0x9a, 0x24, 0x4c, 0x46, 0x4c, 0xe3, 0x81, 0x2d, 0xc7, 0x9d, 0x8c, 0x88,
-	0xbe, 0xeb, 0x7f, 0x58, 0x82, 0x25, 0x62, 0xf6, 0xdc, 0x03, 0x5c, 0x21, 0xdc, 0xde, 0x04, 0xb0,
-	0x82, 0xb0, 0x2b, 0xb9, 0xaa, 0xaa, 0x15, 0x84, 0xdc, 0x19, 0x7f, 0x53, 0x44, 0xcb, 0x62, 0x76,
-	0x02, 0x9d, 0x70, 0x43, 0xe9, 0x88, 0x79, 0xa9, 0xad, 0xa2, 0x3b, 0xd0, 0xe0, 0x65, 0x9f, 0x54,
-	0xea, 0xd4, 0x19, 0xf0, 0x40, 0xed, 0x4c, 0xe7, 0x94, 0x5b, 0x56, 0xb1, 0xa8, 0x39, 0x7f, 0xb5,
-	0xa8, 0x59, 0x49, 0x46, 0xcd, 0xf7, 0x60, 0x81, 0x7a, 0x82, 0xc8, 0x8a, 0x84, 0x03, 0xc9, 0x63,
-	0x46, 0x4d, 0x3a, 0x54, 0xfc, 0x06, 0xf1, 0xc8, 0x07, 0x52, 0xe4, 0x23, 0xcc, 0x70, 0x31, 0xb6,
-	0xba, 0xa1, 0x6f, 0xba, 0xc1, 0x00, 0xfb, 0x34, 0x72, 0x56, 0x8c, 0x3a, 0x01, 0x3e, 0xe5, 0x30,
-	0xfd, 0x5f, 0x0a, 0xb0, 0xca, 0x0b, 0xd8, 0xab, 0xeb, 0x45, 0x56, 0xf8, 0x12, 0xfe, 0xbf, 0x78,
-	0x4e, 0x49, 0x58, 0xca, 0x91, 0x9a, 0x95, 0x15, 0xa9, 0x99, 0x5c, 0x16, 0xcd, 0xa5, 0xca, 0xa2,
-	0x68, 0x2b, 0x67, 0x3e, 0xff, 0x56, 0x0e, 0x29, 0xf8, 0x69, 0xae, 0x4e, 0x65, 0x57, 0x35, 0xd8,
-	0x4f, 0x3e, 0x86, 0xfe, 0xa7, 0x06, 0x8d, 0x23, 0x6c, 0xfa, 0xfd, 0x63, 0xc1, 0xc7, 0x77, 0xe2,
-	0x5b, 0x5f, 0xaf, 0x66, 0x88, 0x58, 0x1a, 0xf2, 0xf3, 0xb3, 0xe7, 0xf5, 0x5f, 0x1a, 0xd4, 0x7f,
-	0x8d, 0x34, 0x89, 0xc5, 0xde, 0x8f, 0x2f, 0xf6, 0xb5, 0x8c, 0xc5, 0x1a, 0x38, 0xf4, 0x6d, 0x7c,
-	0x82, 0x7f, 0xee, 0x96, 0xfb, 0x4f, 0x1a, 0x74, 0x8e, 0xce, 0xdc, 0xbe, 0xc1, 0x6c, 0xf9, 0xea,
-	0x16, 0x73, 0x07, 0x1a, 0x27, 0x52, 0xd6, 0x56, 0xa0, 0x0a, 0x57, 0x3f, 0x89, 0x17, 0x7e, 0x06,
-	0xb4, 0xc4, 0x8e, 0x1b, 0x5f, 0xac, 0x70, 0xad, 0xaf, 0xab, 0xa8, 0x4e, 0x10, 0x47, 0x5d, 0xd3,
-	0x82, 0x2f, 0x03, 0xf5, 0xdf, 0xd7, 0x60, 0x49, 0xd1, 0x11, 0x5d, 0x87, 0x79, 0x5e, 0x64, 0xf2,
-	0x18, 0xcc, 0x6c, 0xd8, 0x22, 0xe2, 0x99, 0x6e, 0x93, 0xd8, 0x56, 0x3a, 0x15, 0xb4, 0xd0, 0x6d,
-	0xa8, 0x45, 0xd5, 0x80, 0x95, 0x92, 0x8f, 0x15, 0xa0, 0x0e, 0x54, 0xb8, 0x73, 0x12, 0x65, 0x56,
-	0xf4, 0xaf, 0xff, 0x9d, 0x06, 0xab, 0xef, 0x9a, 0xae, 0xe5, 0x0d, 0x06, 0x57, 0x67, 0xeb, 0x0e,
-	0x48, 0x45, 0x44, 0xde, 0xed, 0x09, 0xb9, 0xf2, 0x78, 0x03, 0x16, 0x7d, 0xe6, 0x19, 0x2d, 0x99,
-	0xef, 0x45, 0xa3, 0x25, 0x1a, 0x22, 0x7e, 0xfe, 0x55, 0x01, 0x10, 0x09, 0x06, 0x0f, 0x4d, 0xc7,
-	0x74, 0xfb, 0xf8, 0xf2, 0xa4, 0xdf, 0x85, 0xa6, 0x14, 0xc2, 0xa2, 0x13, 0xb9, 0x78, 0x0c, 0x0b,
-	0xd0, 0x7b, 0xd0, 0xec, 0x31, 0x54, 0x5d, 0x1f, 0x9b, 0x81, 0xe7, 0x52, 0xe7, 0xda, 0x54, 0xef,
-	0x44, 0x3c, 0xf5, 0xed, 0xe1, 0x10, 0xfb, 0x3b, 0x9e, 0x6b, 0xf1, 0x5c, 0xac, 0x27, 0xc8, 0x24,
-	0x43, 0x89, 0xe0, 0xa6, 0xf1, 0x5c, 0x88, 0x06, 0xa2, 0x80, 0x4e, 0x59, 0x11, 0x60, 0xd3, 0x99,
-	0x32, 0x62, 0xea, 0x8d, 0x5b, 0xac, 0xe1, 0x28, 0x7b, 0x23, 0x4a, 0x11, 0x5f, 0xf5, 0xbf, 0xd1,
-	0x00, 0x45, 0xf5, 0x12, 0xad, 0x0c, 0xa9, 0xf6, 0x25, 0x87, 0x6a, 0x8a, 0xa0, 0x70, 0x03, 0xaa,
-	0x96, 0x18, 0xc9, 0xcd, 0x65, 0x0a, 0xa0, 0x3e, 0x9a, 0x12, 0xdd, 0x25, 0xc1, 0x18, 0x5b, 0xa2,
-	0x1e, 0x61, 0xc0, 0xc7, 0x14, 0x26, 0x87, 0xe7, 0x52, 0x32, 0x3c, 0xc7, 0xf7, 0x59, 0xca, 0xd2,
-	0x3e, 0x8b, 0xfe, 0x49, 0x01, 0x5a, 0xd4, 0xdd, 0xed, 0x4c, 0x8b, 0xfd, 0x5c, 0x44, 0xdf, 0x81,
-	0x06, 0x3f, 0xb3, 0x96, 0x08, 0xaf, 0xbf, 0x8c, 0x4d, 0x86, 0xde, 0x86, 0x65, 0xd6, 0xc9, 0xc7,
-	0xc1, 0xc4, 0x99, 0xa6, 0xe2, 0x2c, 0x99, 0x45, 0x2f, 0x99, 0x9f, 0x25, 0x4d, 0x62, 0xc4, 0x33,
-	0x58, 0x1d, 0x3a, 0x5e, 0xcf, 0x74, 0xba, 0xb2, 0x78, 0x98, 0x0c, 0x73, 0x68, 0xfc, 0x32, 0x1b,
-	0x7e, 0x14, 0x97, 0x61, 0x80, 0xf6, 0x48, 0x59, 0x8f, 0x5f, 0x4c, 0xb3, 0xfc, 0x72, 0xee, 0x2c,
-	0xbf, 0x4e, 0x06, 0x46, 0x49, 0xfe, 0x9f, 0x68, 0xb0, 0x90, 0xd8, 0x2a, 0x4d, 0x96, 0x94, 0x5a,
-	0xba, 0xa4, 0xbc, 0x0f, 0x65, 0x52, 0x67, 0x31, 0x67, 0xd8, 0x54, 0x97, 0x3b, 0xf2, 0xac, 0x06,
-	0x1b, 0x80, 0xb6, 0x60, 0x49, 0x71, 0x40, 0xca, 0x75, 0x00, 0xa5, 0xcf, 0x47, 0xf5, 0x9f, 0x94,
-	0xa0, 0x16, 0xe3, 0xc7, 0x8c, 0x6a, 0x38, 0xcf, 0xde, 0x57, 0x62, 0x79, 0xc5, 0xf4, 0xf2, 0x32,
-	0xce, 0xce, 0x88, 0xde, 0x8d, 0xf0, 0x88, 0x25, 0xff, 0xbc, 0x12, 0x19, 0xe1, 0x11, 0x4d, 0xfd,
-	0xe3, 0x59, 0xfd, 0x9c, 0x94, 0xd5, 0x27, 0xea, 0x9e, 0xf9, 0x73, 0xea, 0x9e, 0x8a, 0x5c, 0xf7,
-	0x48, 0x76, 0x54, 0x4d, 0xda, 0x51, 0xde, 0x02, 0xf5, 0x6d, 0x58, 0xea, 0xfb, 0xd8, 0x0c, 0xb1,
-	0xf5, 0xf0, 0x6c, 0x27, 0x6a, 0xe2, 0x99, 0x91, 0xaa, 0x09, 0x3d, 0x9a, 0xee, 0x19, 0x31, 0x29,
-	0xd7, 0xa9, 0x94, 0xd5, 0x65, 0x15, 0x97, 0x0d, 0x13, 0xb2, 0x70, 0xcf, 0xf4, 0x2f, 0x59, 0x1a,
-	0x37, 0x2e, 0x55, 0x1a, 0xdf, 0x86, 0x9a, 0x08, 0xad, 0xc4, 0xdc, 0x9b, 0xcc, 0xf3, 0x09, 0x5f,
-	0x60, 0x05, 0x92, 0x33, 0x58, 0x90, 0x37, 0x5d, 0x93, 0x45, 0x69, 0x2b, 0x5d, 0x94, 0x5e, 0x87,
-	0x79, 0x3b, 0xe8, 0x0e, 0xcc, 0x17, 0xb8, 0xbd, 0x48, 0x5b, 0xe7, 0xec, 0xe0, 0x91, 0xf9, 0x02,
-	0xeb, 0xff, 0x5a, 0x84, 0xe6, 0xb4, 0x8a, 0xc9, 0xed, 0x46, 0xf2, 0x5c, 0x12, 0x38, 0x80, 0xd6,
-	0x34, 0x50, 0x53, 0x0e, 0x9f, 0x5b, 0x88, 0x25, 0x4f, 0x32, 0x16, 0xc6, 0x09, 0x7b, 0x95, 0xf6,
-	0x8a, 0x4b, 0x17, 0xda, 0x2b, 0xbe, 0xe2, 0x49, 0xe3, 0x3d, 0x58, 0x89, 0x02, 0xb0, 0xb4, 0x6c,
-	0x96, 0xe5, 0x2f, 0x8b, 0xc6, 0xc3, 0xf8, 0xf2, 0x33, 0x5c, 0xc0, 0x7c, 0x96, 0x0b, 0x48, 0xaa,
-	0x40, 0x25, 0xa5, 0x02, 0xe9, 0x03, 0xcf, 0xaa, 0xe2, 0xc0, 0x53, 0x7f, 0x06, 0x4b, 0x74, 0x1b,
-	0x30, 0xe8, 0xfb, 0x76, 0x0f, 0x47, 0x39, 0x6b, 0x1e, 0xb1, 0x76, 0xa0, 0x92, 0x48, 0x7b, 0xa3,
-	0x7f, 0xfd, 0x77, 0x34, 0x58, 0x4d, 0xcf, 0x4b, 0x35, 0x66, 0xea, 0x48, 0x34, 0xc9, 0x91, 0xfc,
-	0x3a, 0x2c, 0x4d, 0xa7, 0x97, 0x13, 0xea, 0x8c, 0x94, 0x51, 0x41, 0xb8, 0x81, 0xa6, 0x73, 0x08,
-	0x98, 0xfe, 0x13, 0x2d, 0xda, 0x4d, 0x25, 0xb0, 0x21, 0xdd, 0x63, 0x26, 0xc1, 0xcd, 0x73, 0x1d,
-	0xdb, 0x8d, 0xaa, 0x6e, 0xbe, 0x46, 0x06, 0xe4, 0x55, 0xf7, 0xbb, 0xb0, 0xc0, 0x3b, 0x45, 0x31,
-	0x2a, 0x67, 0x56, 0xd6, 0x64, 0xe3, 0xa2, 0xe8, 0x74, 0x17, 0x9a, 0x7c, 0xf3, 0x57, 0xe0, 0x2b,
-	0xaa, 0xb6, 0x84, 0x7f, 0x15, 0x5a, 0xa2, 0xdb, 0x45, 0xa3, 0xe2, 0x02, 0x1f, 0x18, 0x65, 0x77,
-	0x3f, 0xd6, 0xa0, 0x2d, 0xc7, 0xc8, 0xd8, 0xf2, 0x2f, 0x9e, 0xe3, 0x7d, 0x5b, 0x3e, 0x36, 0xbb,
-	0x7b, 0x0e, 0x3d, 0x53, 0x3c, 0xe2, 0xf0, 0xec, 0x80, 0x1e, 0x81, 0x92, 0xd2, 0x64, 0xd7, 0x0e,
-	0x42, 0xdf, 0xee, 0x4d, 0xae, 0x74, 0x05, 0x44, 0xff, 0xdb, 0x02, 0x7c, 0x55, 0x39, 0xe1, 0x55,
-	0x0e, 0xc8, 0xb2, 0x76, 0x02, 0x1e, 0x42, 0x25, 0x51, 0xc2, 0xbc, 0x76, 0xce, 0xe2, 0xf9, 0xa6,
-	0x16, 0xdb, 0x5c, 0x11, 0xe3, 0xc8, 0x1c, 0x91, 0x4e, 0x97, 0xb2, 0xe7, 0xe0, 0x4a, 0x2b, 0xcd,
-	0x21, 0xc6, 0xa1, 0x07, 0x50, 0x67, 0xe5, 0x61, 0xf7, 0xc4, 0xc6, 0xa7, 0xe2, 0x5c, 0xe7, 0x96,
-	0xd2, 0xaf, 0xd1, 0x7e, 0xcf, 0x6d, 0x7c, 0x6a, 0xd4, 0x9c, 0xe8, 0x3b, 0xd0, 0xff, 0xb7, 0x08,
-	0x30, 0x6d, 0x23, 0xb5, 0xe9, 0xd4, 0x60, 0xb8, 0x05, 0xc4, 0x20, 0x24, 0x10, 0xcb, 0xb9, 0x9f,
-	0xf8, 0x45, 0xc6, 0x74, 0x7b, 0xd6, 0xb2, 0x83, 0x90, 0xf3, 0x65, 0xeb, 0x7c, 0x5a, 0x04, 0x8b,
-	0x88, 0xc8, 0xd8, 0xb1, 0x89, 0xa8, 0xbd, 0x08, 0x04, 0xbd, 0x05, 0x68, 0xe8, 0x7b, 0xa7, 0xb6,
-	0x3b, 0x8c, 0x67, 0xec, 0x2c, 0xb1, 0x5f, 0xe4, 0x2d, 0xb1, 0x94, 0xfd, 0x87, 0xd0, 0x4a, 0x74,
-	0x17, 0x2c, 0xb9, 0x37, 0x83, 0x8c, 0x3d, 0x69, 0x2e, 0x7e, 0x82, 0xb3, 0x20, 0x63, 0x08, 0x3a,
-	0x5d, 0x68, 0x25, 0xe9, 0x55, 0x9c, 0xc1, 0x7c, 0x43, 0x3e, 0x83, 0x39, 0xcf, 0x4c, 0xc9, 0x34,
-	0xb1, 0x43, 0x98, 0xce, 0x00, 0x96, 0x55, 0x94, 0x28, 0x90, 0xdc, 0x97, 0x91, 0xe4, 0xc9, 0x69,
-	0x63, 0x87, 0x3d, 0xdf, 0x8b, 0xd2, 0x45, 0xca, 0xe6, 0x2c, 0x0f, 0x1c, 0xdb, 0x94, 0x2b, 0x48,
-	0x9b, 0x72, 0xfa, 0x1f, 0x69, 0x80, 0xd2, 0xda, 0x8d, 0x9a, 0x50, 0x88, 0x26, 0x29, 0xec, 0xef,
-	0x26, 0xb4, 0xa9, 0x90, 0xd2, 0xa6, 0x1b, 0x50, 0x8d, 0x22, 0x22, 0x77, 0x7f, 0x53, 0x40, 0x5c,
-	0xd7, 0x4a, 0xb2, 0xae, 0xc5, 0x08, 0x2b, 0xcb, 0x84, 0x1d, 0x03, 0x4a, 0x5b, 0x4c, 0x7c, 0x26,
-	0x4d, 0x9e, 0x69, 0x16, 0x85, 0x31, 0x4c, 0x45, 0x19, 0xd3, 0x7f, 0x14, 0x00, 0x4d, 0x63, 0x7e,
-	0x74, 0x10, 0x95, 0x27, 0x50, 0x6e, 0xc1, 0x52, 0x3a, 0x23, 0x10, 0x69, 0x10, 0x4a, 0xe5, 0x03,
-	0xaa, 0xd8, 0x5d, 0x54, 0x5d, 0x56, 0x7a, 0x27, 0xf2, 0x71, 0x2c, 0xc1, 0xb9, 0x95, 0x95, 0xe0,
-	0x24, 0xdc, 0xdc, 0x6f, 0x24, 0x2f, 0x39, 0x31, 0xa3, 0xb9, 0xaf, 0xf4, 0x47, 0xa9, 0x25, 0xcf,
-	0xba, 0xe1, 0x74, 0xf5, 0xeb, 0x49, 0xff, 0x56, 0x80, 0xc5, 0x88, 0x1b, 0x17, 0xe2, 0xf4, 0xec,
-	0x83, 0xbf, 0xcf, 0x98, 0xb5, 0x1f, 0xaa, 0x59, 0xfb, 0xcb, 0xe7, 0xe6, 0xb0, 0x9f, 0x1f, 0x67,
-	0x3f, 0x86, 0x79, 0xbe, 0x7d, 0x96, 0xb2, 0xdd, 0x3c, 0x55, 0xe2, 0x32, 0x94, 0x89, 0xab, 0x10,
-	0xfb, 0x49, 0xec, 0x87, 0xb1, 0x34, 0x7e, 0x6f, 0x8d, 0x9b, 0x6f, 0x43, 0xba, 0xb6, 0xa6, 0xff,
-	0xb5, 0x06, 0x70, 0x74, 0xe6, 0xf6, 0x1f, 0x30, 0x4b, 0x7b, 0x1b, 0x4a, 0xb3, 0xee, 0x71, 0x90,
-	0xde, 0x34, 0x37, 0xa7, 0x3d, 0x73, 0x08, 0x57, 0xaa, 0x83, 0x8b, 0xc9, 0x3a, 0x38, 0xab, 0x82,
-	0xcd, 0xf6, 0x2e, 0xff, 0xa0, 0xc1, 0x75, 0x42, 0xc4, 0xa7, 0x92, 0xb2, 0xe4, 0xe2, 0x70, 0xcc,
-	0x73, 0x15, 0x65, 0xcf, 0x75, 0x1f, 0xe6, 0x59, 0x29, 0x2a, 0xd2, 0x87, 0x5b, 0x59, 0x2c, 0x63,
-	0x0c, 0x36, 0x44, 0x77, 0xfd, 0x19, 0x34, 0x8c, 0xb8, 0x24, 0x10, 0x82, 0x52, 0xec, 0xb6, 0x0e,
-	0xfd, 0xa6, 0xc9, 0xbc, 0x39, 0x36, 0xfb, 0x76, 0x78, 0x46, 0x09, 0x2b, 0x1b, 0xd1, 0xbf, 0x5a,
-	0xec, 0xfa, 0x4f, 0x35, 0x58, 0x15, 0xe7, 0x07, 0x5c, 0xa9, 0x2e, 0xcf, 0x9b, 0x6d, 0x58, 0xe1,
-	0x1a, 0x94, 0x50, 0x25, 0x96, 0x75, 0x2c, 0x31, 0x98, 0xbc, 0x8c, 0x6d, 0x58, 0x09, 0x4d, 0x7f,
-	0x88, 0xc3, 0xe4, 0x18, 0xc6, 0xb9, 0x25, 0xd6, 0x28, 0x8f, 0xc9, 0x73, 0x7e, 0x73, 0x9b, 0x9d,
-	0xc0, 0x73, 0x87, 0xc0, 0x75, 0x02, 0xdc, 0xc9, 0x88, 0xaf, 0x52, 0x3f, 0x85, 0x1b, 0xec, 0xbe,
-	0x5c, 0x4f, 0xa6, 0xe8, 0x4a, 0xdb, 0xa7, 0xca, 0x75, 0x27, 0x4c, 0xe8, 0x4f, 0x35, 0xb8, 0x99,
-	0x81, 0xf9, 0x2a, 0x69, 0xef, 0x63, 0x25, 0xf6, 0x8c, 0x0c, 0x5f, 0xc2, 0x4b, 0xf3, 0xd3, 0x04,
-	0x91, 0x3f, 0x2b, 0xc1, 0x62, 0xaa, 0xd3, 0x85, 0x75, 0xee, 0x4d, 0x40, 0x44, 0x08, 0xd1, 0xf3,
-	0x0b, 0x5a, 0x34, 0x71, 0x5f, 0xdd, 0x72, 0x27, 0xa3, 0xe8, 0xe9, 0x05, 0xa9, 0x9b, 0x90, 0xcd,
-	0x7a, 0xb3, 0xcd, 0xd3, 0x48, 0x72, 0xa5, 0xec, 0xbb, 0xbb, 0x29, 0x02, 0x37, 0x0f, 0x26, 0x23,
-	0xb6, 0xcf, 0xca, 0xa5, 0xcc, 0xfc, 0x2f, 0x41, 0x25, 0x81, 0xd1, 0x00, 0x16, 0xe9, 0xfd, 0x8c,
-	0x49, 0x38, 0xf4, 0x48, 0xe6, 0x49, 0xe9, 0x62, 0x5e, 0xfe, 0x5b, 0xb9, 0x31, 0xbd, 0xcf, 0x47,
-	0x13, 0xe2, 0x79, 0xf2, 0xe9, 0xca, 0x50, 0x81, 0xc7, 0x76, 0xfb, 0xde, 0x28, 0xc2, 0x33, 0x77,
-	0x41, 0x3c, 0xfb, 0x7c, 0xb4, 0x8c, 0x27, 0x0e, 0xed, 0xec, 0xc0, 0x8a, 0x72, 0xe9, 0xb3, 0xe2,
-	0x4a, 0x39, 0x9e, 0xc8, 0x3e, 0x84, 0x65, 0xd5, 0xaa, 0x2e, 0x31, 0x47, 0x8a, 0xe2, 0x8b, 0xcc,
-	0xb1, 0xf1, 0x2b, 0x50, 0x8d, 0x4e, 0xbf, 0x50, 0x0d, 0xe6, 0x9f, 0xb9, 0xef, 0xb9, 0xde, 0xa9,
-	0xdb, 0xba, 0x86, 0xe6, 0xa1, 0xf8, 0xc0, 0x71, 0x5a, 0x1a, 0x6a, 0x40, 0xf5, 0x28, 0xf4, 0xb1,
-	0x49, 0x90, 0xb4, 0x0a, 0xa8, 0x09, 0xf0, 0xae, 0x1d, 0x84, 0x9e, 0x6f, 0xf7, 0x4d, 0xa7, 0x55,
-	0xdc, 0xf8, 0x18, 0x9a, 0xf2, 0xde, 0x12, 0xaa, 0x43, 0xe5, 0xc0, 0x0b, 0xbf, 0xff, 0x91, 0x1d,
-	0x84, 0xad, 0x6b, 0xa4, 0xff, 0x81, 0x17, 0x1e, 0xfa, 0x38, 0xc0, 0x6e, 0xd8, 0xd2, 0x10, 0xc0,
-	0xdc, 0xfb, 0xee, 0xae, 0x1d, 0xbc, 0x68, 0x15, 0xd0, 0x12, 0xdf, 0x36, 0x36, 0x9d, 0x7d, 0xbe,
-	0x61, 0xd3, 0x2a, 0x92, 0xe1, 0xd1, 0x5f, 0x09, 0xb5, 0xa0, 0x1e, 0x75, 0xd9, 0x3b, 0x7c, 0xd6,
-	0x2a, 0xa3, 0x2a, 0x94, 0xd9, 0xe7, 0xdc, 0x86, 0x05, 0xad, 0xe4, 0x99, 0x07, 0x99, 0x93, 0x2d,
-	0x22, 0x02, 0xb5, 0xae, 0x91, 0x95, 0xf1, 0x43, 0xa7, 0x96, 0x86, 0x16, 0xa0, 0x16, 0x3b, 0xc2,
-	0x69, 0x15, 0x08, 0x60, 0xcf, 0x1f, 0xf7, 0xb9, 0x37, 0x62, 0x24, 0x10, 0x76, 0xee, 0x12, 0x4e,
-	0x94, 0x36, 0x1e, 0x42, 0x45, 0x6c, 0x7a, 0x91, 0xae, 0x9c, 0x45, 0xe4, 0xb7, 0x75, 0x0d, 0x2d,
-	0x42, 0x43, 0xba, 0xd6, 0xde, 0xd2, 0x10, 0x82, 0xa6, 0xfc, 0xf0, 0xa4, 0x55, 0xd8, 0xd8, 0x06,
-	0x98, 0x26, 0x3f, 0x84, 0x9c, 0x7d, 0xf7, 0xc4, 0x74, 0x6c, 0x8b, 0xd1, 0x46, 0x9a, 0x08, 0x77,
-	0x29, 0x77, 0x98, 0x66, 0xb5, 0x0a, 0x1b, 0xb7, 0xa1, 0x22, 0x02, 0x3a, 0x81, 0x1b, 0x78, 0xe4,
-	0x9d, 0x60, 0x26, 0x99, 0x23, 0x1c, 0xb6, 0xb4, 0xed, 0x9f, 0x22, 0x00, 0x76, 0x4c, 0xe1, 0x79,
-	0xbe, 0x85, 0x1c, 0x40, 0x7b, 0x38, 0xdc, 0xf1, 0x46, 0x63, 0xcf, 0x15, 0xdb, 0xa7, 0x01, 0xda,
-	0x94, 0x75, 0x9f, 0xff, 0xa4, 0x3b, 0xf2, 0xd5, 0x77, 0x5e, 0x55, 0xf6, 0x4f, 0x74, 0xd6, 0xaf,
-	0xa1, 0x11, 0xc5, 0xf6, 0xd4, 0x1e, 0xe1, 0xa7, 0x76, 0xff, 0x45, 0x74, 0xb6, 0x91, 0xfd, 0xe4,
-	0x23, 0xd1, 0x55, 0xe0, 0xbb, 0xa3, 0xc4, 0x77, 0x14, 0xfa, 0xb6, 0x3b, 0x14, 0x5e, 0x5a, 0xbf,
-	0x86, 0x5e, 0x26, 0x1e, 0x9c, 0x08, 0x84, 0xdb, 0x79, 0xde, 0x98, 0x5c, 0x0e, 0xa5, 0x03, 0x0b,
-	0x89, 0x37, 0x78, 0x68, 0x43, 0x7d, 0x01, 0x58, 0xf5, 0x5e, 0xb0, 0xf3, 0x46, 0xae, 0xbe, 0x11,
-	0x36, 0x1b, 0x9a, 0xf2, 0x3b, 0x33, 0xf4, 0x4b, 0x59, 0x13, 0xa4, 0x9e, 0x20, 0x74, 0x36, 0xf2,
-	0x74, 0x8d, 0x50, 0x7d, 0xc0, 0x14, 0x74, 0x16, 0x2a, 0xe5, 0x73, 0x8d, 0xce, 0x79, 0x01, 0x52,
-	0xbf, 0x86, 0x7e, 0x44, 0x62, 0x59, 0xe2, 0xa1, 0x04, 0x7a, 0x53, 0xed, 0x7f, 0xd5, 0xef, 0x29,
-	0x66, 0x61, 0xf8, 0x20, 0x69, 0x5e, 0xd9, 0xd4, 0xa7, 0x9e, 0x4e, 0xe5, 0xa7, 0x3e, 0x36, 0xfd,
-	0x79, 0xd4, 0x5f, 0x18, 0xc3, 0x84, 0x9a, 0x4d, 0xf2, 0xb0, 0xec, 0x2d, 0x15, 0x8a, 0xcc, 0xd7,
-	0x1a, 0x9d, 0xcd, 0xbc, 0xdd, 0xe3, 0xda, 0x25, 0x3f, 0x08, 0x50, 0x33, 0x4d, 0xf9, 0x88, 0x41,
-	0xad, 0x5d, 0xea, 0xf7, 0x05, 0xfa, 0x35, 0xf4, 0x54, 0x72, 0xaf, 0xe8, 0xb5, 0x2c, 0xe1, 0xc8,
-	0x47, 0xe8, 0xb3, 0xf8, 0xf6, 0x9b, 0x80, 0x98, 0xed, 0xb8, 0x03, 0x7b, 0x38, 0xf1, 0x4d, 0xa6,
-	0x58, 0x59, 0xee, 0x26, 0xdd, 0x55, 0xa0, 0xf9, 0xda, 0x05, 0x46, 0x44, 0x4b, 0xea, 0x02, 0xec,
-	0xe1, 0xf0, 0x09, 0x0e, 0x7d, 0xbb, 0x1f, 0x24, 0x57, 0x34, 0xf5, 0xa8, 0xbc, 0x83, 0x40, 0xf5,
-	0xfa, 0xcc, 0x7e, 0x11, 0x82, 0x1e, 0xd4, 0xf6, 0x48, 0xe6, 0x4d, 0xb3, 0x89, 0x00, 0x65, 0x8e,
-	0x14, 0x3d, 0x04, 0x8a, 0xf5, 0xd9, 0x1d, 0xe3, 0xee, 0x2c, 0xf1, 0x38, 0x02, 0x65, 0x0a, 0x36,
-	0xfd, 0x64, 0x43, 0xed, 0xce, 0x32, 0x5e, 0x5b, 0xb0, 0x15, 0xed, 0x1c, 0xe3, 0xfe, 0x8b, 0x77,
-	0xb1, 0xe9, 0x84, 0xc7, 0x19, 0x2b, 0x8a, 0xf5, 0x38, 0x7f, 0x45, 0x52, 0xc7, 0x08, 0x07, 0x86,
-	0xa5, 0x1d, 0x7a, 0xf2, 0x28, 0x97, 0x2c, 0x5b, 0xea, 0x29, 0xd2, 0x3d, 0x73, 0xaa, 0x9e, 0x09,
-	0x8b, 0xbb, 0xbe, 0x37, 0x96, 0x91, 0xbc, 0xa5, 0x44, 0x92, 0xea, 0x97, 0x13, 0xc5, 0x0f, 0xa0,
-	0x2e, 0x2a, 0x43, 0x9a, 0xcb, 0xaa, 0xb9, 0x10, 0xef, 0x92, 0x73, 0xe2, 0x0f, 0x61, 0x21, 0x51,
-	0x72, 0xaa, 0x85, 0xae, 0xae, 0x4b, 0x67, 0xcd, 0x7e, 0x0a, 0x88, 0xbe, 0x78, 0x91, 0x5e, 0xdb,
-	0x65, 0x64, 0x1c, 0xe9, 0x8e, 0x02, 0xc9, 0x56, 0xee, 0xfe, 0x91, 0xe4, 0x7f, 0x0b, 0x56, 0x94,
-	0x65, 0x5d, 0xd2, 0x21, 0xf0, 0xfb, 0x5d, 0xe7, 0xd4, 0x9e, 0x49, 0x87, 0x70, 0xee, 0x08, 0x81,
-	0x7f, 0xfb, 0x93, 0x26, 0x54, 0x69, 0xe6, 0x45, 0xa5, 0xf5, 0x8b, 0xc4, 0xeb, 0xd3, 0x4d, 0xbc,
-	0x3e, 0x84, 0x85, 0xc4, 0x2b, 0x12, 0xb5, 0xd2, 0xaa, 0x9f, 0x9a, 0xe4, 0xc8, 0x1f, 0xe4, 0x77,
-	0x1c, 0xea, 0x50, 0xa8, 0x7c, 0xeb, 0x31, 0x6b, 0xee, 0xe7, 0xec, 0x01, 0x56, 0x74, 0x86, 0xf9,
-	0x7a, 0xe6, 0x2e, 0xa8, 0x7c, 0xf7, 0xed, 0x8b, 0xcf, 0x4b, 0x3e, 0xfb, 0xbc, 0xed, 0x43, 0x58,
-	0x48, 0xdc, 0x40, 0x56, 0x4b, 0x55, 0x7d, 0x4d, 0x79, 0xd6, 0xec, 0x9f, 0x63, 0x82, 0x63, 0xc1,
-	0x92, 0xe2, 0x72, 0x28, 0xda, 0xcc, 0xda, 0x5e, 0x54, 0xdf, 0x22, 0x9d, 0xbd, 0xa0, 0x86, 0x64,
-	0x4a, 0xc9, 0x98, 0x30, 0x25, 0x32, 0xf9, 0x94, 0xbe, 0xf3, 0x66, 0xbe, 0x77, 0xf7, 0xd1, 0x82,
-	0x8e, 0x60, 0x8e, 0xdd, 0x4b, 0x46, 0xaf, 0xa8, 0xcf, 0xe2, 0x62, 0x77, 0x96, 0x3b, 0xb3, 0x6e,
-	0x36, 0x07, 0x13, 0x27, 0x0c, 0xe8, 0xa4, 0x65, 0xea, 0x21, 0x91, 0xf2, 0x42, 0x7d, 0xfc, 0x32,
-	0x71, 0x67, 0xf6, 0xfd, 0x61, 0x31, 0xe9, 0xff, 0xef, 0x2c, 0xf0, 0x23, 0x58, 0x52, 0x9c, 0xd0,
-	0xa3, 0xac, 0x6c, 0x3f, 0xe3, 0x6e, 0x40, 0x67, 0x2b, 0x77, 0xff, 0x08, 0xf3, 0x0f, 0xa1, 0x95,
-	0xdc, 0xb6, 0x47, 0x6f, 0x64, 0xe9, 0xb3, 0x0a, 0xe7, 0xf9, 0xca, 0xfc, 0xf0, 0xeb, 0x1f, 0x6c,
-	0x0f, 0xed, 0xf0, 0x78, 0xd2, 0x23, 0x2d, 0x5b, 0xac, 0xeb, 0x5b, 0xb6, 0xc7, 0xbf, 0xb6, 0x04,
-	0xff, 0xb7, 0xe8, 0xe8, 0x2d, 0x8a, 0x6a, 0xdc, 0xeb, 0xcd, 0xd1, 0xdf, 0x7b, 0xff, 0x17, 0x00,
-	0x00, 0xff, 0xff, 0xc2, 0xde, 0x60, 0xae, 0x08, 0x48, 0x00, 0x00,
+	// 4147 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x4b, 0x6c, 0x24, 0x49,
+	0x56, 0x9d, 0xf5, 0xb1, 0xab, 0x5e, 0x7d, 0x5c, 0x0e, 0x7f, 0xba, 0xa6, 0xb6, 0x3f, 0x9e, 0xec,
+	0xe9, 0x19, 0xe3, 0x9e, 0xb1, 0x7b, 0xdc, 0xbb, 0x43, 0xef, 0x4f, 0x4b, 0xb7, 0xbd, 0xed, 0x31,
+	0xd3, 0xed, 0x31, 0xe9, 0xee, 0x5e, 0x34, 0x1a, 0xb6, 0x36, 0x5d, 0x19, 0x55, 0x4e, 0x75, 0x56,
+	0x66, 0x75, 0x66, 0x96, 0x3d, 0x1e, 0x24, 0x4e, 0x5c, 0x76, 0x05, 0x48, 0x70, 0xe0, 0x84, 0x38,
+	0x20, 0x90, 0x40, 0x62, 0x24, 0x0e, 0x70, 0xe3, 0x80, 0x84, 0x04, 0x27, 0x10, 0x37, 0x8e, 0x5c,
+	0x91, 0xe0, 0x02, 0xd2, 0x6a, 0xb5, 0x37, 0x14, 0xbf, 0xac, 0x8c, 0xcc, 0x48, 0x57, 0xda, 0x9e,
+	0x9e, 0x99, 0x45, 0x7b, 0xcb, 0x7c, 0xf1, 0x79, 0x2f, 0x5e, 0xbc, 0x7f, 0x44, 0xc0, 0xfc, 0xcb,
+	0x31, 0xf6, 0x4f, 0xbb, 0x3d, 0xcf, 0xf3, 0xad, 0xf5, 0x91, 0xef, 0x85, 0x1e, 0x42, 0x43, 0xdb,
+	0x39, 0x1e, 0x07, 0xec, 0x6f, 0x9d, 0xb6, 0x77, 0xea, 0x3d, 0x6f, 0x38, 0xf4, 0x5c, 0x06, 0xeb,
+	0xd4, 0xe3, 0x3d, 0x3a, 0x4d, 0xdb, 0x0d, 0xb1, 0xef, 0x9a, 0x8e, 0x68, 0x0d, 0x7a, 0x47, 0x78,
+	0x68, 0xf2, 0xbf, 0x96, 0x65, 0x86, 0x66, 0x7c, 0x7e, 0xfd, 0x77, 0x35, 0x58, 0x3e, 0x38, 0xf2,
+	0x4e, 0xb6, 0x3c, 0xc7, 0xc1, 0xbd, 0xd0, 0xf6, 0xdc, 0xc0, 0xc0, 0x2f, 0xc7, 0x38, 0x08, 0xd1,
+	0x5d, 0x28, 0x1d, 0x9a, 0x01, 0x6e, 0x6b, 0x2b, 0xda, 0x6a, 0x6d, 0xf3, 0xda, 0xba, 0x44, 0x09,
+	0x27, 0xe1, 0x49, 0x30, 0x78, 0x68, 0x06, 0xd8, 0xa0, 0x3d, 0x11, 0x82, 0x92, 0x75, 0xb8, 0xbb,
+	0xdd, 0x2e, 0xac, 0x68, 0xab, 0x45, 0x83, 0x7e, 0xa3, 0x37, 0xa0, 0xd1, 0x8b, 0xe6, 0xde, 0xdd,
+	0x0e, 0xda, 0xc5, 0x95, 0xe2, 0x6a, 0xd1, 0x90, 0x81, 0xfa, 0x7f, 0x68, 0x70, 0x35, 0x45, 0x46,
+	0x30, 0xf2, 0xdc, 0x00, 0xa3, 0x7b, 0x30, 0x13, 0x84, 0x66, 0x38, 0x0e, 0x38, 0x25, 0x5f, 0x53,
+	0x52, 0x72, 0x40, 0xbb, 0x18, 0xbc, 0x6b, 0x1a, 0x6d, 0x41, 0x81, 0x16, 0xbd, 0x0b, 0x8b, 0xb6,
+	0xfb, 0x04, 0x0f, 0x3d, 0xff, 0xb4, 0x3b, 0xc2, 0x7e, 0x0f, 0xbb, 0xa1, 0x39, 0xc0, 0x82, 0xc6,
+	0x05, 0xd1, 0xb6, 0x3f, 0x69, 0x42, 0xef, 0xc1, 0x55, 0xb6, 0x4b, 0x01, 0xf6, 0x8f, 0xed, 0x1e,
+	0xee, 0x9a, 0xc7, 0xa6, 0xed, 0x98, 0x87, 0x0e, 0x6e, 0x97, 0x56, 0x8a, 0xab, 0x15, 0x63, 0x89,
+	0x36, 0x1f, 0xb0, 0xd6, 0x07, 0xa2, 0x51, 0xff, 0x0b, 0x0d, 0x96, 0xc8, 0x0a, 0xf7, 0x4d, 0x3f,
+	0xb4, 0x5f, 0x01, 0x9f, 0x75, 0xa8, 0xc7, 0xd7, 0xd6, 0x2e, 0xd2, 0x36, 0x09, 0x46, 0xfa, 0x8c,
+	0x04, 0x7a, 0xc2, 0x93, 0x12, 0x5d, 0xa6, 0x04, 0xd3, 0xff, 0x9c, 0x0b, 0x44, 0x9c, 0xce, 0xcb,
+	0x6c, 0x44, 0x12, 0x67, 0x21, 0x8d, 0xf3, 0x02, 0xdb, 0xa0, 0xff, 0x4b, 0x11, 0x96, 0x1e, 0x7b,
+	0xa6, 0x35, 0x11, 0x98, 0x2f, 0x9e, 0x9d, 0xdf, 0x85, 0x19, 0xa6, 0x5d, 0xed, 0x12, 0xc5, 0x75,
+	0x5b, 0xc6, 0xc5, 0xda, 0xd6, 0x27, 0x14, 0x1e, 0x50, 0x80, 0xc1, 0x07, 0xa1, 0xdb, 0xd0, 0xf4,
+	0xf1, 0xc8, 0xb1, 0x7b, 0x66, 0xd7, 0x1d, 0x0f, 0x0f, 0xb1, 0xdf, 0x2e, 0xaf, 0x68, 0xab, 0x65,
+	0xa3, 0xc1, 0xa1, 0x7b, 0x14, 0x88, 0x7e, 0x04, 0x8d, 0xbe, 0x8d, 0x1d, 0xab, 0x6b, 0xbb, 0x16,
+	0xfe, 0x64, 0x77, 0xbb, 0x3d, 0xb3, 0x52, 0x5c, 0xad, 0x6d, 0x7e, 0x7b, 0x3d, 0x6d, 0x19, 0xd6,
+	0x95, 0x1c, 0x59, 0x7f, 0x44, 0x86, 0xef, 0xb2, 0xd1, 0xdf, 0x77, 0x43, 0xff, 0xd4, 0xa8, 0xf7,
+	0x63, 0x20, 0xd4, 0x86, 0x59, 0x1f, 0xf7, 0x7d, 0x1c, 0x1c, 0xb5, 0x67, 0x57, 0xb4, 0xd5, 0x8a,
+	0x21, 0x7e, 0xd1, 0x5b, 0x30, 0xe7, 0xe3, 0xc0, 0x1b, 0xfb, 0x3d, 0xdc, 0x1d, 0xf8, 0xde, 0x78,
+	0x14, 0xb4, 0x2b, 0x2b, 0xc5, 0xd5, 0xaa, 0xd1, 0x14, 0xe0, 0x1d, 0x0a, 0xed, 0x7c, 0x0f, 0xe6,
+	0x53, 0x58, 0x50, 0x0b, 0x8a, 0x2f, 0xf0, 0x29, 0xdd, 0x88, 0xa2, 0x41, 0x3e, 0xd1, 0x22, 0x94,
+	0x8f, 0x4d, 0x67, 0x8c, 0x39, 0xab, 0xd9, 0xcf, 0xb7, 0x0a, 0xf7, 0x35, 0xfd, 0x4f, 0x34, 0x68,
+	0x1b, 0xd8, 0xc1, 0x66, 0x80, 0xbf, 0xcc, 0x2d, 0x5d, 0x86, 0x19, 0xd7, 0xb3, 0xf0, 0xee, 0x36,
+	0xdd, 0xd2, 0xa2, 0xc1, 0xff, 0xf4, 0x9f, 0x6b, 0xb0, 0xb8, 0x83, 0x43, 0x22, 0xdb, 0x76, 0x10,
+	0xda, 0xbd, 0x48, 0x79, 0xbf, 0x0b, 0x45, 0x1f, 0xbf, 0xe4, 0x94, 0xdd, 0x91, 0x29, 0x8b, 0x4c,
+	0xb1, 0x6a, 0xa4, 0x41, 0xc6, 0xa1, 0xd7, 0xa1, 0x6e, 0x0d, 0x9d, 0x6e, 0xef, 0xc8, 0x74, 0x5d,
+	0xec, 0x30, 0xed, 0xa8, 0x1a, 0x35, 0x6b, 0xe8, 0x6c, 0x71, 0x10, 0xba, 0x01, 0x10, 0xe0, 0xc1,
+	0x10, 0xbb, 0xe1, 0xc4, 0x7a, 0xc6, 0x20, 0x68, 0x0d, 0xe6, 0xfb, 0xbe, 0x37, 0xec, 0x06, 0x47,
+	0xa6, 0x6f, 0x75, 0x1d, 0x6c, 0x5a, 0xd8, 0xa7, 0xd4, 0x57, 0x8c, 0x39, 0xd2, 0x70, 0x40, 0xe0,
+	0x8f, 0x29, 0x18, 0xdd, 0x83, 0x72, 0xd0, 0xf3, 0x46, 0x98, 0x4a, 0x5a, 0x73, 0xf3, 0xba, 0x4a,
+	0x86, 0xb6, 0xcd, 0xd0, 0x3c, 0x20, 0x9d, 0x0c, 0xd6, 0x57, 0xff, 0x1f, 0xae, 0x6a, 0x5f, 0x71,
+	0xcb, 0x15, 0x53, 0xc7, 0xf2, 0xe7, 0xa3, 0x8e, 0x33, 0xb9, 0xd4, 0x71, 0xf6, 0x6c, 0x75, 0x4c,
+	0x71, 0xed, 0x3c, 0xea, 0x58, 0x99, 0xaa, 0x8e, 0xd5, 0x57, 0xa3, 0x8e, 0xff, 0x30, 0x51, 0xc7,
+	0xaf, 0xfa, 0xb6, 0x4f, 0x54, 0xb6, 0x2c, 0xa9, 0xec, 0x5f, 0x69, 0xf0, 0xda, 0x0e, 0x0e, 0x23,
+	0xf2, 0x89, 0x06, 0xe2, 0xaf, 0xa8, 0xd3, 0xfd, 0x4c, 0x83, 0x8e, 0x8a, 0xd6, 0xcb, 0x38, 0xde,
+	0x8f, 0x60, 0x39, 0xc2, 0xd1, 0xb5, 0x70, 0xd0, 0xf3, 0xed, 0x11, 0xf9, 0x66, 0x46, 0xa6, 0xb6,
+	0x79, 0x4b, 0x25, 0xb1, 0x49, 0x0a, 0x96, 0xa2, 0x29, 0xb6, 0x63, 0x33, 0xe8, 0xbf, 0xaf, 0xc1,
+	0x12, 0x31, 0x6a, 0xdc, 0x0a, 0xb9, 0x7d, 0xef, 0xe2, 0x7c, 0x95, 0xed, 0x5b, 0x21, 0x65, 0xdf,
+	0x72, 0xf0, 0x98, 0x46, 0xb1, 0x49, 0x7a, 0x2e, 0xc3, 0xbb, 0x6f, 0x40, 0xd9, 0x76, 0xfb, 0x9e,
+	0x60, 0xd5, 0x4d, 0x15, 0xab, 0xe2, 0xc8, 0x58, 0x6f, 0xdd, 0x65, 0x54, 0x4c, 0x0c, 0xee, 0x25,
+	0xc4, 0x2d, 0xb9, 0xec, 0x82, 0x62, 0xd9, 0xbf, 0xa7, 0xc1, 0xd5, 0x14, 0xc2, 0xcb, 0xac, 0xfb,
+	0x3b, 0x30, 0x43, 0xdd, 0x88, 0x58, 0xf8, 0x1b, 0xca, 0x85, 0xc7, 0xd0, 0x3d, 0xb6, 0x83, 0xd0,
+	0xe0, 0x63, 0x74, 0x0f, 0x5a, 0xc9, 0x36, 0xe2, 0xe0, 0xb8, 0x73, 0xeb, 0xba, 0xe6, 0x90, 0x31,
+	0xa0, 0x6a, 0xd4, 0x38, 0x6c, 0xcf, 0x1c, 0x62, 0xf4, 0x1a, 0x54, 0x88, 0xca, 0x76, 0x6d, 0x4b,
+	0x6c, 0xff, 0x2c, 0x55, 0x61, 0x2b, 0x40, 0xd7, 0x01, 0x68, 0x93, 0x69, 0x59, 0x3e, 0xf3, 0x7d,
+	0x55, 0xa3, 0x4a, 0x20, 0x0f, 0x08, 0x40, 0xff, 0x43, 0x0d, 0xea, 0xc4, 0xc6, 0x3e, 0xc1, 0xa1,
+	0x49, 0xf6, 0x01, 0x7d, 0x13, 0xaa, 0x8e, 0x67, 0x5a, 0xdd, 0xf0, 0x74, 0xc4, 0x50, 0x35, 0x37,
+	0xaf, 0xa9, 0x96, 0x40, 0x06, 0x3d, 0x3d, 0x1d, 0x61, 0xa3, 0xe2, 0xf0, 0xaf, 0x3c, 0xfc, 0x4e,
+	0xa9, 0x72, 0x51, 0xa1, 0xca, 0xff, 0x54, 0x86, 0xe5, 0x1f, 0x98, 0x61, 0xef, 0x68, 0x7b, 0x28,
+	0x5c, 0xf8, 0xc5, 0x85, 0x60, 0x62, 0xdb, 0x0a, 0x71, 0xdb, 0xf6, 0xb9, 0xd9, 0xce, 0x48, 0xce,
+	0xcb, 0x2a, 0x39, 0x27, 0xc9, 0xe2, 0xfa, 0x73, 0xbe, 0x55, 0x31, 0x39, 0x8f, 0x79, 0xda, 0x99,
+	0x8b, 0x78, 0xda, 0x2d, 0x68, 0xe0, 0x4f, 0x7a, 0xce, 0x98, 0xec, 0x39, 0xc5, 0xce, 0x5c, 0xe8,
+	0x0d, 0x05, 0xf6, 0xb8, 0x92, 0xd5, 0xf9, 0xa0, 0x5d, 0x4e, 0x03, 0xdb, 0xea, 0x21, 0x0e, 0x4d,
+	0xea, 0x27, 0x6b, 0x9b, 0x2b, 0x59, 0x5b, 0x2d, 0xe4, 0x83, 0x6d, 0x37, 0xf9, 0x43, 0xd7, 0xa0,
+	0xca, 0xfd, 0xfa, 0xee, 0x76, 0xbb, 0x4a, 0xd9, 0x37, 0x01, 0x20, 0x13, 0x1a, 0xdc, 0x02, 0x71,
+	0x0a, 0x81, 0x52, 0xf8, 0x1d, 0x15, 0x02, 0xf5, 0x66, 0xc7, 0x29, 0x0f, 0xb8, 0x97, 0x0f, 0x62,
+	0x20, 0x92, 0xa0, 0x7a, 0xfd, 0xbe, 0x63, 0xbb, 0x78, 0x8f, 0xed, 0x70, 0x8d, 0x12, 0x21, 0x03,
+	0x49, 0x2c, 0x70, 0x8c, 0xfd, 0xc0, 0xf6, 0xdc, 0x76, 0x9d, 0xb6, 0x8b, 0xdf, 0x4e, 0x17, 0xe6,
+	0x53, 0x28, 0x14, 0x2e, 0xfe, 0xeb, 0x71, 0x17, 0x3f, 0x9d, 0xc7, 0xb1, 0x10, 0xe0, 0x2f, 0x35,
+	0x58, 0x7a, 0xe6, 0x06, 0xe3, 0xc3, 0x68, 0x6d, 0x5f, 0x8e, 0x1c, 0x27, 0x2d, 0x48, 0x29, 0x65,
+	0x41, 0xf4, 0x1f, 0x97, 0x61, 0x8e, 0xaf, 0x82, 0x6c, 0x37, 0x35, 0x05, 0xd7, 0xa0, 0x1a, 0x39,
+	0x11, 0xce, 0x90, 0x09, 0x00, 0xad, 0x40, 0x2d, 0xa6, 0x08, 0x9c, 0xaa, 0x38, 0x28, 0x17, 0x69,
+	0x22, 0x24, 0x28, 0xc5, 0x42, 0x82, 0xeb, 0x00, 0x7d, 0x67, 0x1c, 0x1c, 0x75, 0x43, 0x7b, 0x88,
+	0x79, 0x48, 0x52, 0xa5, 0x90, 0xa7, 0xf6, 0x10, 0xa3, 0x07, 0x50, 0x3f, 0xb4, 0x5d, 0xc7, 0x1b,
+	0x74, 0x47, 0x66, 0x78, 0x14, 0xf0, 0x64, 0x4e, 0xb5, 0x2d, 0x34, 0x80, 0x7b, 0x48, 0xfb, 0x1a,
+	0x35, 0x36, 0x66, 0x9f, 0x0c, 0x41, 0x37, 0xa0, 0xe6, 0x8e, 0x87, 0x5d, 0xaf, 0xdf, 0xf5, 0xbd,
+	0x93, 0x80, 0xa6, 0x6c, 0x45, 0xa3, 0xea, 0x8e, 0x87, 0x1f, 0xf6, 0x0d, 0xef, 0x84, 0x18, 0xf1,
+	0x2a, 0x31, 0xe7, 0x81, 0xe3, 0x0d, 0x58, 0xba, 0x36, 0x7d, 0xfe, 0xc9, 0x00, 0x32, 0xda, 0xc2,
+	0x4e, 0x68, 0xd2, 0xd1, 0xd5, 0x7c, 0xa3, 0xa3, 0x01, 0xe8, 0x4d, 0x68, 0xf6, 0xbc, 0xe1, 0xc8,
+	0xa4, 0x1c, 0x7a, 0xe4, 0x7b, 0x43, 0xaa, 0x39, 0x45, 0x23, 0x01, 0x45, 0x5b, 0x50, 0xa3, 0xf1,
+	0x33, 0x57, 0xaf, 0x1a, 0xc5, 0xa3, 0xab, 0xd4, 0x2b, 0x16, 0xc7, 0x12, 0x01, 0x05, 0x5b, 0x7c,
+	0x06, 0x44, 0x32, 0x84, 0x96, 0x06, 0xf6, 0xa7, 0x98, 0x6b, 0x48, 0x8d, 0xc3, 0x0e, 0xec, 0x4f,
+	0x31, 0x09, 0xea, 0x6d, 0x37, 0xc0, 0x7e, 0x28, 0x52, 0xac, 0x76, 0x83, 0x8a, 0x4f, 0x83, 0x41,
+	0xb9, 0x60, 0xa3, 0x5d, 0x68, 0x06, 0xa1, 0xe9, 0x87, 0xdd, 0x91, 0x17, 0x50, 0x01, 0x68, 0x37,
+	0x57, 0xb4, 0x34, 0x45, 0x51, 0x42, 0xf7, 0x24, 0x18, 0xec, 0xf3, 0x9e, 0x46, 0x83, 0x8e, 0x14,
+	0xbf, 0xfa, 0x4f, 0x8a, 0xd0, 0x94, 0x69, 0x26, 0x4a, 0xcc, 0x02, 0x7c, 0x21, 0x88, 0xe2, 0x97,
+	0xac, 0x00, 0xbb, 0xa4, 0x3c, 0xc4, 0xb2, 0x09, 0x2a, 0x87, 0x15, 0xa3, 0xc6, 0x60, 0x74, 0x02,
+	0x22, 0x4f, 0x8c, 0x53, 0x54, 0xf8, 0x8b, 0x94, 0xfa, 0x2a, 0x85, 0x50, 0xe7, 0xd9, 0x86, 0x59,
+	0x91, 0x88, 0x30, 0x29, 0x14, 0xbf, 0xa4, 0xe5, 0x70, 0x6c, 0x53, 0xac, 0x4c, 0x0a, 0xc5, 0x2f,
+	0xda, 0x86, 0x3a, 0x9b, 0x72, 0x64, 0xfa, 0xe6, 0x50, 0xc8, 0xe0, 0xeb, 0x4a, 0x3d, 0xfe, 0x00,
+	0x9f, 0x3e, 0x27, 0x26, 0x61, 0xdf, 0xb4, 0x7d, 0x83, 0xed, 0xd9, 0x3e, 0x1d, 0x85, 0x56, 0xa1,
+	0xc5, 0x66, 0xe9, 0xdb, 0x0e, 0xe6, 0xd2, 0x3c, 0xcb, 0xb2, 0x11, 0x0a, 0x7f, 0x64, 0x3b, 0x98,
+	0x09, 0x6c, 0xb4, 0x04, 0xba, 0x4b, 0x15, 0x26, 0xaf, 0x14, 0x42, 0xf7, 0xe8, 0x16, 0x34, 0x58,
+	0xb3, 0xb0, 0x74, 0xcc, 0x1c, 0x33, 0x1a, 0x9f, 0x33, 0x18, 0x0d, 0x12, 0xc6, 0x43, 0x26, 0xf1,
+	0xc0, 0x96, 0xe3, 0x8e, 0x87, 0x54, 0xde, 0x5f, 0x83, 0xca, 0x10, 0x0f, 0xd9, 0xe4, 0xcc, 0x88,
+	0xce, 0x0e, 0xf1, 0x90, 0x4c, 0xad, 0xff, 0x51, 0x09, 0x16, 0x88, 0x45, 0xe0, 0xc6, 0xe1, 0x12,
+	0x9e, 0xf8, 0x3a, 0x80, 0x15, 0x84, 0x5d, 0xc9, 0x8a, 0x55, 0xad, 0x20, 0xe4, 0x76, 0xfa, 0x9b,
+	0xc2, 0x91, 0x16, 0xb3, 0x63, 0xeb, 0x84, 0x85, 0x4a, 0x3b, 0xd3, 0x0b, 0x55, 0x91, 0x6e, 0x41,
+	0x83, 0x67, 0x84, 0x52, 0x16, 0x54, 0x67, 0xc0, 0x3d, 0xb5, 0x9d, 0x9d, 0x51, 0x56, 0xb3, 0x62,
+	0x0e, 0x75, 0xf6, 0x72, 0x0e, 0xb5, 0x92, 0x74, 0xa8, 0x1f, 0xc0, 0x1c, 0x35, 0x12, 0x91, 0x82,
+	0x09, 0xdb, 0x92, 0x47, 0xc3, 0x9a, 0x74, 0xa8, 0xf8, 0x0d, 0xe2, 0x4e, 0x11, 0x24, 0xa7, 0x48,
+	0x98, 0xe1, 0x62, 0x6c, 0x75, 0x43, 0xdf, 0x74, 0x83, 0x3e, 0xf6, 0xa9, 0x3c, 0x54, 0x8c, 0x3a,
+	0x01, 0x3e, 0xe5, 0x30, 0xfd, 0x5f, 0x0b, 0xb0, 0xcc, 0x73, 0xdb, 0xcb, 0xcb, 0x45, 0x96, 0x67,
+	0x13, 0xae, 0xa1, 0x78, 0x46, 0xb6, 0x58, 0xca, 0x11, 0xb5, 0x95, 0x15, 0x51, 0x9b, 0x9c, 0x31,
+	0xcd, 0xa4, 0x32, 0xa6, 0xa8, 0xca, 0x33, 0x9b, 0xbf, 0xca, 0x43, 0x6a, 0x01, 0x34, 0x8c, 0xa7,
+	0x7b, 0x57, 0x35, 0xd8, 0x4f, 0x3e, 0x86, 0xfe, 0x97, 0x06, 0x8d, 0x03, 0x6c, 0xfa, 0xbd, 0x23,
+	0xc1, 0xc7, 0xf7, 0xe2, 0x55, 0xb1, 0x37, 0x32, 0xb6, 0x58, 0x1a, 0xf2, 0x8b, 0x53, 0x0e, 0xfb,
+	0x6f, 0x0d, 0xea, 0xbf, 0x41, 0x9a, 0xc4, 0x62, 0xef, 0xc7, 0x17, 0xfb, 0x66, 0xc6, 0x62, 0x0d,
+	0x1c, 0xfa, 0x36, 0x3e, 0xc6, 0xbf, 0x70, 0xcb, 0xfd, 0x67, 0x0d, 0x3a, 0x07, 0xa7, 0x6e, 0xcf,
+	0x60, 0xba, 0x7c, 0x79, 0x8d, 0xb9, 0x05, 0x8d, 0x63, 0x29, 0xa0, 0x2b, 0x50, 0x81, 0xab, 0x1f,
+	0xc7, 0x73, 0x42, 0x03, 0x5a, 0xa2, 0x18, 0xc7, 0x17, 0x2b, 0x4c, 0xeb, 0x5b, 0x2a, 0xaa, 0x13,
+	0xc4, 0x51, 0xd3, 0x34, 0xe7, 0xcb, 0x40, 0xfd, 0x0f, 0x34, 0x58, 0x50, 0x74, 0x44, 0x57, 0x61,
+	0x96, 0xe7, 0x9f, 0x6d, 0x2d, 0xa6, 0xc3, 0x16, 0xd9, 0x9e, 0x49, 0x05, 0xc5, 0xb6, 0xd2, 0x51,
+	0xa2, 0x85, 0x6e, 0x42, 0x2d, 0x4a, 0x14, 0xac, 0xd4, 0xfe, 0x58, 0x01, 0xea, 0x40, 0x85, 0x1b,
+	0x27, 0x91, 0x81, 0x45, 0xff, 0xfa, 0xdf, 0x6b, 0xb0, 0xfc, 0xbe, 0xe9, 0x5a, 0x5e, 0xbf, 0x7f,
+	0x79, 0xb6, 0x6e, 0x81, 0x94, 0x5f, 0xe4, 0xad, 0x5c, 0x48, 0x83, 0xd0, 0x1d, 0x98, 0xf7, 0x99,
+	0x65, 0xb4, 0x64, 0xbe, 0x17, 0x8d, 0x96, 0x68, 0x88, 0xf8, 0xf9, 0xd7, 0x05, 0x40, 0xc4, 0x19,
+	0x3c, 0x34, 0x1d, 0xd3, 0xed, 0xe1, 0x8b, 0x93, 0x7e, 0x1b, 0x9a, 0x92, 0x0b, 0x8b, 0x0e, 0xeb,
+	0xe2, 0x3e, 0x2c, 0x40, 0x1f, 0x40, 0xf3, 0x90, 0xa1, 0xea, 0xfa, 0xd8, 0x0c, 0x3c, 0x97, 0x1a,
+	0xd7, 0xa6, 0xba, 0x48, 0xf1, 0xd4, 0xb7, 0x07, 0x03, 0xec, 0x6f, 0x79, 0xae, 0xc5, 0xc3, 0xb4,
+	0x43, 0x41, 0x26, 0x19, 0x4a, 0x36, 0x6e, 0xe2, 0xcf, 0xc5, 0xd6, 0x40, 0xe4, 0xd0, 0x29, 0x2b,
+	0x02, 0x6c, 0x3a, 0x13, 0x46, 0x4c, 0xac, 0x71, 0x8b, 0x35, 0x1c, 0x64, 0xd7, 0xa8, 0x14, 0xfe,
+	0x55, 0xff, 0x5b, 0x0d, 0x50, 0x94, 0x4a, 0xd1, 0xa4, 0x91, 0x4a, 0x5f, 0x72, 0xa8, 0x96, 0x1e,
+	0x4a, 0x7c, 0xab, 0x25, 0x46, 0x72, 0x75, 0x99, 0x00, 0xa8, 0x8d, 0xa6, 0x44, 0x77, 0x89, 0x33,
+	0xc6, 0x96, 0x48, 0x55, 0x18, 0xf0, 0x31, 0x85, 0xc9, 0xee, 0xb9, 0x94, 0x74, 0xcf, 0xf1, 0x12,
+	0x4c, 0x59, 0x2a, 0xc1, 0xe8, 0x9f, 0x15, 0xa0, 0x45, 0xcd, 0xdd, 0xd6, 0xa4, 0x0e, 0x90, 0x8b,
+	0xe8, 0x5b, 0xd0, 0xe0, 0xc7, 0xd9, 0x12, 0xe1, 0xf5, 0x97, 0xb1, 0xc9, 0xd0, 0x5d, 0x58, 0x64,
+	0x9d, 0x7c, 0x1c, 0x8c, 0x9d, 0x49, 0x94, 0xce, 0xe2, 0x5c, 0xf4, 0x92, 0xd9, 0x59, 0xd2, 0x24,
+	0x46, 0x3c, 0x83, 0xe5, 0x81, 0xe3, 0x1d, 0x9a, 0x4e, 0x57, 0xde, 0x1e, 0xb6, 0x87, 0x39, 0x24,
+	0x7e, 0x91, 0x0d, 0x3f, 0x88, 0xef, 0x61, 0x80, 0x76, 0x48, 0xc6, 0x8f, 0x5f, 0x4c, 0x12, 0x80,
+	0x72, 0xee, 0x04, 0xa0, 0x4e, 0x06, 0x8a, 0x3f, 0xfd, 0x4f, 0x35, 0x98, 0x4b, 0x54, 0x51, 0x93,
+	0xd9, 0xa6, 0x96, 0xce, 0x36, 0xef, 0x43, 0x39, 0x20, 0x7d, 0x29, 0x93, 0x9a, 0xea, 0x4c, 0x48,
+	0x9e, 0xd5, 0x60, 0x03, 0xd0, 0x06, 0x2c, 0x28, 0xce, 0x4e, 0xb9, 0x0c, 0xa0, 0xf4, 0xd1, 0xa9,
+	0xfe, 0xd3, 0x12, 0xd4, 0x62, 0xfc, 0x98, 0x92, 0x28, 0xe7, 0x29, 0x8b, 0x25, 0x96, 0x57, 0x4c,
+	0x2f, 0x2f, 0xe3, 0x58, 0x4d, 0x0a, 0xdd, 0xcb, 0x52, 0xe8, 0x2e, 0x05, 0xfc, 0x33, 0x72, 0xc0,
+	0x2f, 0xa7, 0x44, 0xb3, 0x67, 0xa4, 0x44, 0x15, 0x39, 0x25, 0x92, 0xf4, 0xa8, 0x9a, 0xd4, 0xa3,
+	0xbc, 0xb9, 0xeb, 0x5d, 0x58, 0xe8, 0xf9, 0xd8, 0x0c, 0xb1, 0xf5, 0xf0, 0x74, 0x2b, 0x6a, 0xe2,
+	0x91, 0x91, 0xaa, 0x09, 0x3d, 0x9a, 0x94, 0x93, 0xd8, 0x2e, 0xd7, 0xe9, 0x2e, 0xab, 0x33, 0x2e,
+	0xbe, 0x37, 0x6c, 0x93, 0xeb, 0x41, 0xec, 0x2f, 0x99, 0x35, 0x37, 0x2e, 0x94, 0x35, 0xdf, 0x84,
+	0x9a, 0x70, 0xad, 0x44, 0xdd, 0x9b, 0xcc, 0xf2, 0x71, 0x10, 0x71, 0x59, 0x71, 0x63, 0x30, 0x27,
+	0xd7, 0x63, 0x93, 0xf9, 0x6a, 0x2b, 0x9d, 0xaf, 0x5e, 0x85, 0x59, 0x3b, 0xe8, 0xf6, 0xcd, 0x17,
+	0xb8, 0x3d, 0x4f, 0x5b, 0x67, 0xec, 0xe0, 0x91, 0xf9, 0x02, 0xeb, 0xff, 0x56, 0x84, 0xe6, 0x24,
+	0x8b, 0xc9, 0x6d, 0x46, 0xf2, 0xdc, 0x1f, 0xd8, 0x83, 0xd6, 0xc4, 0x51, 0x53, 0x0e, 0x9f, 0x99,
+	0x88, 0x25, 0x0f, 0x39, 0xe6, 0x46, 0x32, 0x40, 0x2e, 0x23, 0x97, 0xce, 0x55, 0x46, 0xbe, 0xe4,
+	0x21, 0xe4, 0x3d, 0x58, 0x8a, 0x1c, 0xb0, 0xb4, 0x6c, 0x16, 0xe5, 0x2f, 0x8a, 0xc6, 0xfd, 0xf8,
+	0xf2, 0x33, 0x4c, 0xc0, 0x6c, 0x96, 0x09, 0x48, 0x8a, 0x40, 0x25, 0x25, 0x02, 0xe9, 0xb3, 0xd0,
+	0xaa, 0xe2, 0x2c, 0x54, 0x7f, 0x06, 0x0b, 0xb4, 0x42, 0x48, 0x4e, 0x86, 0x0e, 0x71, 0x14, 0xb3,
+	0xe6, 0xd9, 0xd6, 0x0e, 0x54, 0x12, 0x61, 0x6f, 0xf4, 0xaf, 0xff, 0x44, 0x83, 0xe5, 0xf4, 0xbc,
+	0x54, 0x62, 0x26, 0x86, 0x44, 0x93, 0x0c, 0xc9, 0x6f, 0xc2, 0xc2, 0x64, 0x7a, 0x39, 0xa0, 0xce,
+	0x08, 0x19, 0x15, 0x84, 0x1b, 0x68, 0x32, 0x87, 0x80, 0xe9, 0x3f, 0xd5, 0xa2, 0x42, 0x2b, 0x81,
+	0x0d, 0x68, 0xf9, 0x99, 0x38, 0x37, 0xcf, 0x75, 0x6c, 0x17, 0x77, 0x25, 0x72, 0xea, 0x0c, 0xc8,
+	0xb3, 0xee, 0xf7, 0x61, 0x8e, 0x77, 0x8a, 0x7c, 0x54, 0xce, 0xa8, 0xac, 0xc9, 0xc6, 0x45, 0xde,
+	0xe9, 0x36, 0x34, 0x79, 0x5d, 0x58, 0xe0, 0x2b, 0xaa, 0xaa, 0xc5, 0xbf, 0x0e, 0x2d, 0xd1, 0xed,
+	0xbc, 0x5e, 0x71, 0x8e, 0x0f, 0x8c, 0xa2, 0xbb, 0x1f, 0x6b, 0xd0, 0x96, 0x7d, 0x64, 0x6c, 0xf9,
+	0xe7, 0x8f, 0xf1, 0xbe, 0x2d, 0x9f, 0xa8, 0xdd, 0x3e, 0x83, 0x9e, 0x09, 0x1e, 0x71, 0xae, 0xb6,
+	0x47, 0x4f, 0x47, 0x49, 0x6a, 0xb2, 0x6d, 0x07, 0xa1, 0x6f, 0x1f, 0x8e, 0x2f, 0x75, 0x3b, 0x44,
+	0xff, 0xbb, 0x02, 0x7c, 0x4d, 0x39, 0xe1, 0x65, 0xce, 0xce, 0xb2, 0x2a, 0x01, 0x0f, 0xa1, 0x92,
+	0x48, 0x61, 0xde, 0x3c, 0x63, 0xf1, 0xbc, 0xde, 0xc5, 0x8a, 0x2b, 0x62, 0x1c, 0x99, 0x23, 0x92,
+	0xe9, 0x52, 0xf6, 0x1c, 0x5c, 0x68, 0xa5, 0x39, 0xc4, 0x38, 0x52, 0x79, 0x66, 0xe9, 0x61, 0xf7,
+	0xd8, 0xc6, 0x27, 0xe2, 0xc8, 0xe7, 0x86, 0xd2, 0xae, 0xd1, 0x7e, 0xcf, 0x6d, 0x7c, 0x62, 0xd4,
+	0x9c, 0xe8, 0x3b, 0xd0, 0xff, 0xb7, 0x08, 0x30, 0x69, 0x23, 0xb9, 0xe9, 0x44, 0x61, 0xb8, 0x06,
+	0xc4, 0x20, 0xc4, 0x11, 0xcb, 0xb1, 0x9f, 0xf8, 0x45, 0xc6, 0xa4, 0x72, 0x6b, 0xd9, 0x41, 0xc8,
+	0xf9, 0xb2, 0x71, 0x36, 0x2d, 0x82, 0x45, 0x64, 0xcb, 0xd8, 0x89, 0x4a, 0x2d, 0x98, 0x40, 0xd0,
+	0x3b, 0x80, 0x06, 0xbe, 0x77, 0x62, 0xbb, 0x83, 0x78, 0xc4, 0xce, 0x02, 0xfb, 0x79, 0xde, 0x12,
+	0x0b, 0xd9, 0x7f, 0x08, 0xad, 0x44, 0x77, 0xc1, 0x92, 0x7b, 0x53, 0xc8, 0xd8, 0x91, 0xe6, 0xe2,
+	0x87, 0x3b, 0x73, 0x32, 0x86, 0xa0, 0xd3, 0x85, 0x56, 0x92, 0x5e, 0xc5, 0xf1, 0xcc, 0x37, 0xe4,
+	0xe3, 0x99, 0xb3, 0xd4, 0x94, 0x4c, 0x13, 0x3b, 0x9f, 0xe9, 0xf4, 0x61, 0x51, 0x45, 0x89, 0x02,
+	0xc9, 0x7d, 0x19, 0x49, 0x9e, 0x98, 0x76, 0x82, 0x47, 0xff, 0x1e, 0xd4, 0x62, 0x14, 0x64, 0x5a,
+	0xe0, 0x58, 0x51, 0xae, 0x20, 0x15, 0xe5, 0xf4, 0x3f, 0xd6, 0x00, 0xa5, 0xa5, 0x1b, 0x35, 0xa1,
+	0x10, 0x4d, 0x52, 0xd8, 0xdd, 0x4e, 0x48, 0x53, 0x21, 0x25, 0x4d, 0xd7, 0xa0, 0x1a, 0x79, 0x44,
+	0x6e, 0xfe, 0x26, 0x80, 0xb8, 0xac, 0x95, 0x64, 0x59, 0x8b, 0x11, 0x56, 0x96, 0x09, 0x3b, 0x02,
+	0x94, 0xd6, 0x98, 0xf8, 0x4c, 0x9a, 0x3c, 0xd3, 0x34, 0x0a, 0x63, 0x98, 0x8a, 0x32, 0xa6, 0xff,
+	0x2c, 0x00, 0x9a, 0xf8, 0xfc, 0xe8, 0x8c, 0x2a, 0x8f, 0xa3, 0xdc, 0x80, 0x85, 0x74, 0x44, 0x20,
+	0xc2, 0x20, 0x94, 0x8a, 0x07, 0x54, 0xbe, 0xbb, 0xa8, 0xba, 0xc7, 0xf4, 0x5e, 0x64, 0xe3, 0x58,
+	0x80, 0x73, 0x23, 0x2b, 0xc0, 0x49, 0x98, 0xb9, 0xdf, 0x4a, 0xde, 0x7f, 0x62, 0x4a, 0x73, 0x5f,
+	0x69, 0x8f, 0x52, 0x4b, 0x9e, 0x76, 0xf9, 0xe9, 0xf2, 0x37, 0x97, 0xfe, 0xbd, 0x00, 0xf3, 0x11,
+	0x37, 0xce, 0xc5, 0xe9, 0xe9, 0x67, 0x82, 0xaf, 0x98, 0xb5, 0x1f, 0xab, 0x59, 0xfb, 0xab, 0x67,
+	0xc6, 0xb0, 0x5f, 0x1c, 0x67, 0x3f, 0x85, 0x59, 0x5e, 0x3e, 0x4b, 0xe9, 0x6e, 0x9e, 0x2c, 0x71,
+	0x11, 0xca, 0xc4, 0x54, 0x88, 0x7a, 0x12, 0xfb, 0x61, 0x2c, 0x8d, 0x5f, 0x69, 0xe3, 0xea, 0xdb,
+	0x90, 0x6e, 0xb4, 0xe9, 0x7f, 0xa3, 0x01, 0x90, 0x2a, 0xe4, 0x03, 0xa6, 0x69, 0x77, 0xa1, 0x34,
+	0xed, 0x8a, 0x07, 0xe9, 0x4d, 0x63, 0x73, 0xda, 0x33, 0xc7, 0xe6, 0x4a, 0x79, 0x70, 0x31, 0x99,
+	0x07, 0x67, 0x65, 0xb0, 0xd9, 0xd6, 0xe5, 0x1f, 0xc9, 0x95, 0xf6, 0x53, 0xb7, 0xf7, 0xb9, 0x84,
+	0x2c, 0xb9, 0x38, 0x1c, 0xb3, 0x5c, 0x45, 0xd9, 0x72, 0xdd, 0x87, 0x59, 0x96, 0x8a, 0x8a, 0xf0,
+	0xe1, 0x46, 0x16, 0xcb, 0x18, 0x83, 0x0d, 0xd1, 0x5d, 0x7f, 0x06, 0x0d, 0x23, 0xbe, 0x13, 0xe4,
+	0x60, 0x23, 0x76, 0x91, 0x87, 0x7e, 0xd3, 0x60, 0xde, 0x1c, 0x99, 0x3d, 0x3b, 0x3c, 0xa5, 0x84,
+	0x95, 0x8d, 0xe8, 0x5f, 0xbd, 0xed, 0xfa, 0xcf, 0x34, 0x58, 0x16, 0xe7, 0x07, 0x5c, 0xa8, 0x2e,
+	0xce, 0x9b, 0x4d, 0x58, 0xe2, 0x12, 0x94, 0x10, 0x25, 0x16, 0x75, 0x2c, 0x30, 0x98, 0xbc, 0x8c,
+	0x4d, 0x58, 0x0a, 0x4d, 0x7f, 0x80, 0xc3, 0xe4, 0x18, 0xc6, 0xb9, 0x05, 0xd6, 0x28, 0x8f, 0xc9,
+	0x73, 0x7e, 0x73, 0x93, 0x1d, 0xce, 0x73, 0x83, 0xc0, 0x65, 0x02, 0x48, 0xe5, 0x82, 0x41, 0xf4,
+	0x13, 0xb8, 0xc6, 0xae, 0xd2, 0x1d, 0xca, 0x14, 0x5d, 0xaa, 0x7c, 0xaa, 0x5c, 0x77, 0x42, 0x85,
+	0xfe, 0x4c, 0x83, 0xeb, 0x19, 0x98, 0x2f, 0x13, 0xf6, 0x3e, 0x56, 0x62, 0xcf, 0x88, 0xf0, 0x25,
+	0xbc, 0x34, 0x3e, 0x4d, 0x10, 0xf9, 0xf3, 0x12, 0xcc, 0xa7, 0x3a, 0x9d, 0x5b, 0xe6, 0xde, 0x06,
+	0x44, 0x36, 0x21, 0x7a, 0x99, 0x41, 0x93, 0x26, 0x6e, 0xab, 0x5b, 0xee, 0x78, 0x18, 0xbd, 0xca,
+	0x20, 0x79, 0x13, 0xb2, 0x59, 0x6f, 0x56, 0x3c, 0x8d, 0x76, 0xae, 0x94, 0x7d, 0xad, 0x37, 0x45,
+	0xe0, 0xfa, 0xde, 0x78, 0xc8, 0xea, 0xac, 0x7c, 0x97, 0x99, 0xfd, 0x6d, 0xb9, 0x09, 0x30, 0xea,
+	0xc3, 0x3c, 0x41, 0xe5, 0x8d, 0xc3, 0x81, 0x47, 0x22, 0x4f, 0x4a, 0x17, 0xb3, 0xf2, 0xdf, 0xca,
+	0x8d, 0xe9, 0x43, 0x3e, 0x9a, 0x10, 0xcf, 0x83, 0x4f, 0x57, 0x86, 0x0a, 0x3c, 0xb6, 0xdb, 0xf3,
+	0x86, 0x11, 0x9e, 0x99, 0x73, 0xe2, 0xd9, 0xe5, 0xa3, 0x65, 0x3c, 0x71, 0x68, 0x67, 0x0b, 0x96,
+	0x94, 0x4b, 0x9f, 0xe6, 0x57, 0xca, 0xf1, 0x40, 0xf6, 0x21, 0x2c, 0xaa, 0x56, 0x75, 0x81, 0x39,
+	0x52, 0x14, 0x9f, 0x67, 0x8e, 0xb5, 0x5f, 0x83, 0x6a, 0x74, 0xfa, 0x85, 0x6a, 0x30, 0xfb, 0xcc,
+	0xfd, 0xc0, 0xf5, 0x4e, 0xdc, 0xd6, 0x15, 0x34, 0x0b, 0xc5, 0x07, 0x8e, 0xd3, 0xd2, 0x50, 0x03,
+	0xaa, 0x07, 0xa1, 0x8f, 0x4d, 0x82, 0xa4, 0x55, 0x40, 0x4d, 0x80, 0xf7, 0xed, 0x20, 0xf4, 0x7c,
+	0xbb, 0x67, 0x3a, 0xad, 0xe2, 0xda, 0xa7, 0xd0, 0x94, 0x6b, 0x4b, 0xa8, 0x0e, 0x95, 0x3d, 0x2f,
+	0xfc, 0xfe, 0x27, 0x76, 0x10, 0xb6, 0xae, 0x90, 0xfe, 0x7b, 0x5e, 0xb8, 0xef, 0xe3, 0x00, 0xbb,
+	0x61, 0x4b, 0x43, 0x00, 0x33, 0x1f, 0xba, 0xdb, 0x76, 0xf0, 0xa2, 0x55, 0x40, 0x0b, 0xbc, 0x6c,
+	0x6c, 0x3a, 0xbb, 0xbc, 0x60, 0xd3, 0x2a, 0x92, 0xe1, 0xd1, 0x5f, 0x09, 0xb5, 0xa0, 0x1e, 0x75,
+	0xd9, 0xd9, 0x7f, 0xd6, 0x2a, 0xa3, 0x2a, 0x94, 0xd9, 0xe7, 0xcc, 0x9a, 0x05, 0xad, 0xe4, 0x99,
+	0x07, 0x99, 0x93, 0x2d, 0x22, 0x02, 0xb5, 0xae, 0x90, 0x95, 0xf1, 0x43, 0xa7, 0x96, 0x86, 0xe6,
+	0xa0, 0x16, 0x3b, 0xc2, 0x69, 0x15, 0x08, 0x60, 0xc7, 0x1f, 0xf5, 0xb8, 0x35, 0x62, 0x24, 0x10,
+	0x76, 0x6e, 0x13, 0x4e, 0x94, 0xd6, 0x1e, 0x42, 0x45, 0x14, 0xbd, 0x48, 0x57, 0xce, 0x22, 0xf2,
+	0xdb, 0xba, 0x82, 0xe6, 0xa1, 0x21, 0xdd, 0x78, 0x6f, 0x69, 0x08, 0x41, 0x53, 0x7e, 0x93, 0xd2,
+	0x2a, 0xac, 0x6d, 0x02, 0x4c, 0x82, 0x1f, 0x42, 0xce, 0xae, 0x7b, 0x6c, 0x3a, 0xb6, 0xc5, 0x68,
+	0x23, 0x4d, 0x84, 0xbb, 0x94, 0x3b, 0x4c, 0xb2, 0x5a, 0x85, 0xb5, 0x9b, 0x50, 0x11, 0x0e, 0x9d,
+	0xc0, 0x0d, 0x3c, 0xf4, 0x8e, 0x31, 0xdb, 0x99, 0x03, 0x1c, 0xb6, 0xb4, 0xcd, 0x9f, 0x21, 0x00,
+	0x76, 0x4c, 0xe1, 0x79, 0xbe, 0x85, 0x1c, 0x40, 0x3b, 0x38, 0x24, 0x25, 0x58, 0xcf, 0x15, 0xe5,
+	0xd3, 0x00, 0xad, 0xcb, 0xb2, 0xcf, 0x7f, 0xd2, 0x1d, 0xf9, 0xea, 0x3b, 0x6f, 0x28, 0xfb, 0x27,
+	0x3a, 0xeb, 0x57, 0xd0, 0x90, 0x62, 0x23, 0xf7, 0xbb, 0x9e, 0xda, 0xbd, 0x17, 0xd1, 0xd9, 0x46,
+	0xf6, 0x6b, 0x90, 0x44, 0x57, 0x81, 0xef, 0x96, 0x12, 0xdf, 0x41, 0xe8, 0xdb, 0xee, 0x40, 0x58,
+	0x69, 0xfd, 0x0a, 0x7a, 0x99, 0x78, 0x8b, 0x22, 0x10, 0x6e, 0xe6, 0x79, 0x7e, 0x72, 0x31, 0x94,
+	0x0e, 0xcc, 0x25, 0x9e, 0xe7, 0xa1, 0x35, 0xf5, 0xdd, 0x60, 0xd5, 0x53, 0xc2, 0xce, 0x9d, 0x5c,
+	0x7d, 0x23, 0x6c, 0x36, 0x34, 0xe5, 0x27, 0x68, 0xe8, 0x57, 0xb2, 0x26, 0x48, 0xbd, 0x4e, 0xe8,
+	0xac, 0xe5, 0xe9, 0x1a, 0xa1, 0xfa, 0x88, 0x09, 0xe8, 0x34, 0x54, 0xca, 0x97, 0x1c, 0x9d, 0xb3,
+	0x1c, 0xa4, 0x7e, 0x05, 0xfd, 0x88, 0xf8, 0xb2, 0xc4, 0x1b, 0x0a, 0xf4, 0xb6, 0xda, 0xfe, 0xaa,
+	0x9f, 0x5a, 0x4c, 0xc3, 0xf0, 0x51, 0x52, 0xbd, 0xb2, 0xa9, 0x4f, 0xbd, 0xaa, 0xca, 0x4f, 0x7d,
+	0x6c, 0xfa, 0xb3, 0xa8, 0x3f, 0x37, 0x86, 0x31, 0x55, 0x9b, 0xe4, 0x61, 0xd9, 0x3b, 0x2a, 0x14,
+	0x99, 0x0f, 0x39, 0x3a, 0xeb, 0x79, 0xbb, 0xc7, 0xa5, 0x4b, 0x7e, 0x2b, 0xa0, 0x66, 0x9a, 0xf2,
+	0x7d, 0x43, 0x67, 0x2d, 0x4f, 0xd7, 0x08, 0xd5, 0x53, 0xc9, 0xbc, 0xa2, 0x37, 0xb3, 0x36, 0x47,
+	0x3e, 0x42, 0x9f, 0xc6, 0xb7, 0xdf, 0x06, 0xc4, 0x74, 0xc7, 0xed, 0xdb, 0x83, 0xb1, 0x6f, 0x32,
+	0xc1, 0xca, 0x32, 0x37, 0xe9, 0xae, 0x02, 0xcd, 0xbb, 0xe7, 0x18, 0x11, 0x2d, 0xa9, 0x0b, 0xb0,
+	0x83, 0xc3, 0x27, 0x38, 0xf4, 0xed, 0x5e, 0x90, 0x5c, 0xd1, 0xc4, 0xa2, 0xf2, 0x0e, 0x02, 0xd5,
+	0x5b, 0x53, 0xfb, 0x45, 0x08, 0x0e, 0xa1, 0xb6, 0x83, 0x43, 0x1e, 0x4d, 0x04, 0x28, 0x73, 0xa4,
+	0xe8, 0x21, 0x50, 0xac, 0x4e, 0xef, 0x18, 0x37, 0x67, 0x89, 0x77, 0x13, 0x28, 0x73, 0x63, 0xd3,
+	0xaf, 0x39, 0x3a, 0x77, 0x72, 0xf5, 0x8d, 0xaf, 0x68, 0xeb, 0x08, 0xf7, 0x5e, 0xbc, 0x8f, 0x4d,
+	0x27, 0x3c, 0xca, 0x58, 0x51, 0xac, 0xc7, 0xd9, 0x2b, 0x92, 0x3a, 0x46, 0x38, 0x30, 0x2c, 0x6c,
+	0xd1, 0x93, 0x47, 0x39, 0x65, 0xd9, 0x50, 0x4f, 0x91, 0xee, 0x99, 0x53, 0xf4, 0x4c, 0x98, 0xdf,
+	0xf6, 0xbd, 0x91, 0x8c, 0xe4, 0x1d, 0x25, 0x92, 0x54, 0xbf, 0x9c, 0x28, 0x7e, 0x00, 0x75, 0x91,
+	0x19, 0xd2, 0x58, 0x56, 0xcd, 0x85, 0x78, 0x97, 0x9c, 0x13, 0x7f, 0x0c, 0x73, 0x89, 0x94, 0x53,
+	0xbd, 0xe9, 0xea, 0xbc, 0x74, 0xda, 0xec, 0x27, 0x80, 0xe8, 0x63, 0x98, 0xf8, 0x8a, 0xb3, 0x22,
+	0x8e, 0x74, 0x47, 0x81, 0x64, 0x23, 0x77, 0xff, 0x68, 0xe7, 0x7f, 0x07, 0x96, 0x94, 0x69, 0x1d,
+	0xba, 0xab, 0x5a, 0xdc, 0x59, 0xb9, 0x67, 0xe7, 0xdd, 0x73, 0x8c, 0x10, 0xf8, 0x37, 0x3f, 0x6b,
+	0x42, 0x95, 0x46, 0x5e, 0x74, 0xb7, 0x7e, 0x19, 0x78, 0x7d, 0xbe, 0x81, 0xd7, 0xc7, 0x30, 0x97,
+	0x78, 0x60, 0xa2, 0x16, 0x5a, 0xf5, 0x2b, 0x94, 0x1c, 0xf1, 0x83, 0xfc, 0xc4, 0x43, 0xed, 0x0a,
+	0x95, 0xcf, 0x40, 0xa6, 0xcd, 0xfd, 0x9c, 0xbd, 0xcd, 0x8a, 0xce, 0x30, 0xdf, 0xca, 0xac, 0x82,
+	0xca, 0x77, 0xdf, 0xbe, 0xfc, 0xb8, 0xe4, 0xd5, 0xc7, 0x6d, 0x1f, 0xc3, 0x5c, 0xe2, 0x06, 0xb2,
+	0x7a, 0x57, 0xd5, 0xd7, 0x94, 0xa7, 0xcd, 0xfe, 0x05, 0x06, 0x38, 0x16, 0x2c, 0x28, 0x2e, 0x87,
+	0xa2, 0xf5, 0xac, 0xf2, 0xa2, 0xfa, 0x16, 0xe9, 0xf4, 0x05, 0x35, 0x24, 0x55, 0x42, 0xab, 0xaa,
+	0xf9, 0x55, 0xaf, 0xec, 0x3b, 0x6f, 0xe7, 0x7b, 0x92, 0x1f, 0x2d, 0xe8, 0x00, 0x66, 0xd8, 0xbd,
+	0x64, 0xf4, 0xba, 0x72, 0x0d, 0xf1, 0x3b, 0xcb, 0x9d, 0x69, 0x37, 0x9b, 0x83, 0xb1, 0x13, 0x06,
+	0x74, 0xd2, 0x32, 0xb5, 0x90, 0x48, 0x79, 0xa1, 0x3e, 0x7e, 0x99, 0xb8, 0x33, 0xfd, 0xfe, 0xb0,
+	0x98, 0xf4, 0xff, 0x77, 0x14, 0xf8, 0x09, 0x2c, 0x28, 0x4e, 0xe8, 0x51, 0x56, 0xb4, 0x9f, 0x71,
+	0x37, 0xa0, 0xb3, 0x91, 0xbb, 0x7f, 0x84, 0xf9, 0x87, 0xd0, 0x4a, 0x96, 0xed, 0xd1, 0x9d, 0x2c,
+	0x79, 0x56, 0xe1, 0x3c, 0x5b, 0x98, 0x1f, 0x7e, 0xfd, 0xa3, 0xcd, 0x81, 0x1d, 0x1e, 0x8d, 0x0f,
+	0x49, 0xcb, 0x06, 0xeb, 0xfa, 0x8e, 0xed, 0xf1, 0xaf, 0x0d, 0xc1, 0xff, 0x0d, 0x3a, 0x7a, 0x83,
+	0xa2, 0x1a, 0x1d, 0x1e, 0xce, 0xd0, 0xdf, 0x7b, 0xff, 0x37, 0x00, 0x38, 0x1c, 0x32, 0x73, 0x23,
+	0x48, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -46,7 +46,6 @@ import (
 	grpcquerynode "github.com/milvus-io/milvus/internal/distributed/querynode"
 	grpcrootcoord "github.com/milvus-io/milvus/internal/distributed/rootcoord"
 	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
-	"github.com/milvus-io/milvus/internal/indexnode"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
@@ -238,7 +237,7 @@ func runIndexNode(ctx context.Context, localMsg bool, alias string) *grpcindexno
 	wg.Add(1)
 	go func() {
 		if !localMsg {
-			logutil.SetupLogger(&indexnode.Params.Log)
+			logutil.SetupLogger(&paramtable.Get().Log)
 			defer log.Sync()
 		}
 
@@ -193,6 +193,7 @@ func (broker *CoordinatorBroker) GetIndexInfo(ctx context.Context, collectionID
 			IndexParams:    info.GetIndexParams(),
 			IndexFilePaths: info.GetIndexFilePaths(),
 			IndexSize:      int64(info.GetSerializedSize()),
+			MemSize:        int64(info.GetMemSize()),
 			IndexVersion:   info.GetIndexVersion(),
 			NumRows:        info.GetNumRows(),
 		})
@@ -109,7 +109,12 @@ func calculateSegmentSize(segmentLoadInfo *querypb.SegmentLoadInfo) int64 {
 	for _, fieldBinlog := range segmentLoadInfo.BinlogPaths {
 		fieldID := fieldBinlog.FieldID
 		if index, ok := fieldIndex[fieldID]; ok {
-			segmentSize += index.IndexSize
+			// prefer the memory size reported by IndexNode, the indexes built by the older IndexNodes don't have it.
+			if index.GetMemSize() > 0 {
+				segmentSize += index.GetMemSize()
+			} else {
+				segmentSize += index.IndexSize
+			}
 		} else {
 			segmentSize += funcutil.GetFieldSizeFromFieldBinlog(fieldBinlog)
 		}
@@ -863,6 +863,9 @@ func GetStorageSizeByIndexInfo(indexInfo *querypb.FieldIndexInfo) (uint64, uint6
 		return 0, 0, fmt.Errorf("index type not exist in index params")
 	}
 	if indexType == indexparamcheck.IndexDISKANN {
+		if indexInfo.GetMemSize() > 0 {
+			return uint64(indexInfo.GetMemSize()), uint64(indexInfo.IndexSize), nil
+		}
 		neededMemSize := indexInfo.IndexSize / UsedDiskMemoryRatio
 		return uint64(neededMemSize), uint64(indexInfo.IndexSize), nil
 	}
@@ -0,0 +1,154 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrInjectedFault is returned by the chunk manager accesses failed by fault injection.
+var ErrInjectedFault = errors.New("injected fault")
+
+// FaultInjector decides the faults injected into the accesses of FaultInjectionChunkManager.
+type FaultInjector interface {
+	// Latency returns the delay added before an access.
+	Latency() time.Duration
+	// Fail reports whether an access fails.
+	Fail() bool
+	// PartialWrite reports whether a write persists only the first half of the content and fails.
+	PartialWrite() bool
+}
+
+// FaultInjectionChunkManager wraps a ChunkManager and injects latency, errors and partial writes
+// into its accesses, it's only meant for resilience tests.
+type FaultInjectionChunkManager struct {
+	ChunkManager
+	injector FaultInjector
+}
+
+var _ ChunkManager = (*FaultInjectionChunkManager)(nil)
+
+// NewFaultInjectionChunkManager returns a ChunkManager injecting the faults decided by injector into cm.
+func NewFaultInjectionChunkManager(cm ChunkManager, injector FaultInjector) *FaultInjectionChunkManager {
+	return &FaultInjectionChunkManager{
+		ChunkManager: cm,
+		injector:     injector,
+	}
+}
+
+func (fcm *FaultInjectionChunkManager) inject(ctx context.Context) error {
+	if latency := fcm.injector.Latency(); latency > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(latency):
+		}
+	}
+	if fcm.injector.Fail() {
+		return ErrInjectedFault
+	}
+	return nil
+}
+
+// Write writes the content, or only the first half of it when a partial write is injected.
+func (fcm *FaultInjectionChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	if err := fcm.inject(ctx); err != nil {
+		return err
+	}
+	if fcm.injector.PartialWrite() {
+		if err := fcm.ChunkManager.Write(ctx, filePath, content[:len(content)/2]); err != nil {
+			return err
+		}
+		return ErrInjectedFault
+	}
+	return fcm.ChunkManager.Write(ctx, filePath, content)
+}
+
+// MultiWrite writes the contents one by one, so a partial write leaves some of them unwritten.
+func (fcm *FaultInjectionChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
+	for filePath, content := range contents {
+		if err := fcm.Write(ctx, filePath, content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (fcm *FaultInjectionChunkManager) Exist(ctx context.Context, filePath string) (bool, error) {
+	if err := fcm.inject(ctx); err != nil {
+		return false, err
+	}
+	return fcm.ChunkManager.Exist(ctx, filePath)
+}
+
+func (fcm *FaultInjectionChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	if err := fcm.inject(ctx); err != nil {
+		return nil, err
+	}
+	return fcm.ChunkManager.Read(ctx, filePath)
+}
+
+func (fcm *FaultInjectionChunkManager) MultiRead(ctx context.Context, filePaths []string) ([][]byte, error) {
+	if err := fcm.inject(ctx); err != nil {
+		return nil, err
+	}
+	return fcm.ChunkManager.MultiRead(ctx, filePaths)
+}
+
+func (fcm *FaultInjectionChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	if err := fcm.inject(ctx); err != nil {
+		return nil, err
+	}
+	return fcm.ChunkManager.ReadAt(ctx, filePath, off, length)
+}
+
+func (fcm *FaultInjectionChunkManager) ListWithPrefix(ctx context.Context, prefix string, recursive bool) ([]string, []time.Time, error) {
+	if err := fcm.inject(ctx); err != nil {
+		return nil, nil, err
+	}
+	return fcm.ChunkManager.ListWithPrefix(ctx, prefix, recursive)
+}
+
+func (fcm *FaultInjectionChunkManager) ReadWithPrefix(ctx context.Context, prefix string) ([]string, [][]byte, error) {
+	if err := fcm.inject(ctx); err != nil {
+		return nil, nil, err
+	}
+	return fcm.ChunkManager.ReadWithPrefix(ctx, prefix)
+}
+
+func (fcm *FaultInjectionChunkManager) Remove(ctx context.Context, filePath string) error {
+	if err := fcm.inject(ctx); err != nil {
+		return err
+	}
+	return fcm.ChunkManager.Remove(ctx, filePath)
+}
+
+func (fcm *FaultInjectionChunkManager) MultiRemove(ctx context.Context, filePaths []string) error {
+	if err := fcm.inject(ctx); err != nil {
+		return err
+	}
+	return fcm.ChunkManager.MultiRemove(ctx, filePaths)
+}
+
+func (fcm *FaultInjectionChunkManager) RemoveWithPrefix(ctx context.Context, prefix string) error {
+	if err := fcm.inject(ctx); err != nil {
+		return err
+	}
+	return fcm.ChunkManager.RemoveWithPrefix(ctx, prefix)
+}
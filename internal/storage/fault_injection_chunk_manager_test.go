@@ -0,0 +1,78 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFaultInjector struct {
+	latency      time.Duration
+	fail         bool
+	partialWrite bool
+}
+
+func (m *mockFaultInjector) Latency() time.Duration { return m.latency }
+func (m *mockFaultInjector) Fail() bool             { return m.fail }
+func (m *mockFaultInjector) PartialWrite() bool     { return m.partialWrite }
+
+func TestFaultInjectionChunkManager(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	injector := &mockFaultInjector{}
+	cm := NewFaultInjectionChunkManager(NewLocalChunkManager(RootPath(root)), injector)
+	filePath := path.Join(root, "file")
+
+	t.Run("no fault", func(t *testing.T) {
+		assert.NoError(t, cm.Write(ctx, filePath, []byte("content")))
+		value, err := cm.Read(ctx, filePath)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("content"), value)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		injector.fail = true
+		defer func() { injector.fail = false }()
+		_, err := cm.Read(ctx, filePath)
+		assert.ErrorIs(t, err, ErrInjectedFault)
+		assert.ErrorIs(t, cm.Write(ctx, filePath, []byte("other")), ErrInjectedFault)
+		assert.ErrorIs(t, cm.Remove(ctx, filePath), ErrInjectedFault)
+	})
+
+	t.Run("partial write", func(t *testing.T) {
+		injector.partialWrite = true
+		defer func() { injector.partialWrite = false }()
+		assert.ErrorIs(t, cm.MultiWrite(ctx, map[string][]byte{filePath: []byte("12345678")}), ErrInjectedFault)
+		value, err := cm.Read(ctx, filePath)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("1234"), value)
+	})
+
+	t.Run("latency", func(t *testing.T) {
+		injector.latency = time.Hour
+		defer func() { injector.latency = 0 }()
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err := cm.Exist(ctx, filePath)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -0,0 +1,117 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"time"
+)
+
+// HedgePolicy decides when HedgedChunkManager hedges a read.
+type HedgePolicy interface {
+	// Delay returns how long a read runs before it is hedged, a non positive delay means no hedging.
+	Delay() time.Duration
+	// Acquire takes a hedged read from the budget, it reports false when the budget is exhausted.
+	Acquire() bool
+	// Observe records the latency of a succeeded read.
+	Observe(latency time.Duration)
+}
+
+// HedgedChunkManager wraps a ChunkManager and hedges the slow reads: when a read runs longer than the delay
+// of the policy, a second identical read is issued and the first successful response is taken.
+// It trades some extra requests for a shorter tail latency on the object stores with latency spikes.
+type HedgedChunkManager struct {
+	ChunkManager
+	policy HedgePolicy
+}
+
+var _ ChunkManager = (*HedgedChunkManager)(nil)
+
+// NewHedgedChunkManager returns a ChunkManager hedging the reads of cm by policy.
+func NewHedgedChunkManager(cm ChunkManager, policy HedgePolicy) *HedgedChunkManager {
+	return &HedgedChunkManager{
+		ChunkManager: cm,
+		policy:       policy,
+	}
+}
+
+type hedgedResult struct {
+	value   []byte
+	err     error
+	latency time.Duration
+}
+
+// hedge runs read, and runs it once more if it doesn't return within the delay. The losing read is canceled.
+func (hcm *HedgedChunkManager) hedge(ctx context.Context, read func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	delay := hcm.policy.Delay()
+	if delay <= 0 {
+		start := time.Now()
+		value, err := read(ctx)
+		if err == nil {
+			hcm.policy.Observe(time.Since(start))
+		}
+		return value, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make(chan hedgedResult, 2)
+	issue := func() {
+		go func() {
+			start := time.Now()
+			value, err := read(ctx)
+			results <- hedgedResult{value: value, err: err, latency: time.Since(start)}
+		}()
+	}
+	issue()
+	pending := 1
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	for {
+		select {
+		case <-timer.C:
+			if hcm.policy.Acquire() {
+				issue()
+				pending++
+			}
+		case result := <-results:
+			pending--
+			if result.err == nil {
+				hcm.policy.Observe(result.latency)
+				return result.value, nil
+			}
+			// a failed read is not hedged, the hedged read only races a read still running.
+			if pending == 0 {
+				return nil, result.err
+			}
+		}
+	}
+}
+
+// Read reads the file, it's hedged if it's slow.
+func (hcm *HedgedChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	return hcm.hedge(ctx, func(ctx context.Context) ([]byte, error) {
+		return hcm.ChunkManager.Read(ctx, filePath)
+	})
+}
+
+// ReadAt reads the range of the file, it's hedged if it's slow.
+func (hcm *HedgedChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	return hcm.hedge(ctx, func(ctx context.Context) ([]byte, error) {
+		return hcm.ChunkManager.ReadAt(ctx, filePath, off, length)
+	})
+}
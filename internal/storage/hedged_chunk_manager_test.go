@@ -0,0 +1,131 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockHedgePolicy struct {
+	delay    time.Duration
+	budget   int
+	acquired int
+	observed []time.Duration
+	mu       sync.Mutex
+}
+
+func (m *mockHedgePolicy) Delay() time.Duration { return m.delay }
+
+func (m *mockHedgePolicy) Acquire() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.acquired >= m.budget {
+		return false
+	}
+	m.acquired++
+	return true
+}
+
+func (m *mockHedgePolicy) Observe(latency time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.observed = append(m.observed, latency)
+}
+
+// slowChunkManager serves the reads with the latencies and errors in call order.
+type slowChunkManager struct {
+	ChunkManager
+	mu        sync.Mutex
+	calls     int
+	latencies []time.Duration
+	errs      []error
+}
+
+func (s *slowChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	s.mu.Lock()
+	call := s.calls
+	s.calls++
+	s.mu.Unlock()
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(s.latencies[call]):
+	}
+	if call < len(s.errs) && s.errs[call] != nil {
+		return nil, s.errs[call]
+	}
+	return []byte{byte(call)}, nil
+}
+
+func TestHedgedChunkManager(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("no hedging", func(t *testing.T) {
+		policy := &mockHedgePolicy{budget: 1}
+		cm := NewHedgedChunkManager(&slowChunkManager{latencies: []time.Duration{20 * time.Millisecond}}, policy)
+		value, err := cm.Read(ctx, "file")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{0}, value)
+		assert.Equal(t, 0, policy.acquired)
+		assert.Equal(t, 1, len(policy.observed))
+	})
+
+	t.Run("hedged read wins", func(t *testing.T) {
+		policy := &mockHedgePolicy{delay: 10 * time.Millisecond, budget: 1}
+		slow := &slowChunkManager{latencies: []time.Duration{time.Minute, 0}}
+		cm := NewHedgedChunkManager(slow, policy)
+		value, err := cm.Read(ctx, "file")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{1}, value)
+		assert.Equal(t, 1, policy.acquired)
+	})
+
+	t.Run("budget exhausted", func(t *testing.T) {
+		policy := &mockHedgePolicy{delay: time.Millisecond}
+		slow := &slowChunkManager{latencies: []time.Duration{20 * time.Millisecond, 0}}
+		cm := NewHedgedChunkManager(slow, policy)
+		value, err := cm.Read(ctx, "file")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{0}, value)
+		assert.Equal(t, 1, slow.calls)
+	})
+
+	t.Run("first read fails", func(t *testing.T) {
+		policy := &mockHedgePolicy{delay: 10 * time.Millisecond, budget: 1}
+		slow := &slowChunkManager{latencies: []time.Duration{0}, errs: []error{errors.New("mock")}}
+		cm := NewHedgedChunkManager(slow, policy)
+		_, err := cm.Read(ctx, "file")
+		assert.Error(t, err)
+		assert.Equal(t, 0, policy.acquired)
+	})
+
+	t.Run("slow read fails after hedging", func(t *testing.T) {
+		policy := &mockHedgePolicy{delay: 10 * time.Millisecond, budget: 1}
+		slow := &slowChunkManager{latencies: []time.Duration{20 * time.Millisecond, 30 * time.Millisecond},
+			errs: []error{errors.New("mock")}}
+		cm := NewHedgedChunkManager(slow, policy)
+		value, err := cm.Read(ctx, "file")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{1}, value)
+	})
+}
@@ -89,6 +89,22 @@ func (codec *IndexFileBinlogCodec) serializeImpl(
 	}, nil
 }
 
+// SerializeFile serializes one index file as blob, the index params are serialized by SerializeIndexParams.
+func (codec *IndexFileBinlogCodec) SerializeFile(
+	indexBuildID UniqueID,
+	version int64,
+	collectionID UniqueID,
+	partitionID UniqueID,
+	segmentID UniqueID,
+	fieldID UniqueID,
+	indexName string,
+	indexID UniqueID,
+	data *Blob,
+) (*Blob, error) {
+	ts := Timestamp(time.Now().UnixNano())
+	return codec.serializeImpl(indexBuildID, version, collectionID, partitionID, segmentID, fieldID, indexName, indexID, data.Key, data.Value, ts)
+}
+
 // SerializeIndexParams serilizes index params as blob.
 func (codec *IndexFileBinlogCodec) SerializeIndexParams(
 	indexBuildID UniqueID,
@@ -61,6 +61,7 @@ type MinioChunkManager struct {
 }
 
 var _ ChunkManager = (*MinioChunkManager)(nil)
+var _ ObjectRestorer = (*MinioChunkManager)(nil)
 
 // NewMinioChunkManager create a new local manager object.
 // Deprecated: Do not call this directly! Use factory.NewPersistentStorageChunkManager instead.
@@ -184,6 +185,77 @@ func (mcm *MinioChunkManager) Size(ctx context.Context, filePath string) (int64,
 	return objectInfo.Size, nil
 }
 
+// archivedStorageClasses are the storage classes of the objects which must be restored before read.
+var archivedStorageClasses = map[string]struct{}{
+	"GLACIER":      {},
+	"DEEP_ARCHIVE": {},
+}
+
+// Restore requests to restore the archived object, the restore in progress is not an error.
+func (mcm *MinioChunkManager) Restore(ctx context.Context, filePath string, days int, tier string) error {
+	req := minio.RestoreRequest{}
+	req.SetDays(days)
+	req.SetGlacierJobParameters(minio.GlacierJobParameters{Tier: minio.TierType(tier)})
+	err := mcm.Client.RestoreObject(ctx, mcm.bucketName, filePath, "", req)
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "RestoreAlreadyInProgress" {
+			return nil
+		}
+		log.Warn("failed to restore object", zap.String("path", filePath), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// Restored returns true if the object is not archived or the restored copy of it is ready.
+func (mcm *MinioChunkManager) Restored(ctx context.Context, filePath string) (bool, error) {
+	objectInfo, err := mcm.Client.StatObject(ctx, mcm.bucketName, filePath, minio.StatObjectOptions{})
+	if err != nil {
+		errResponse := minio.ToErrorResponse(err)
+		if errResponse.Code == "NoSuchKey" {
+			return false, WrapErrNoSuchKey(filePath)
+		}
+		log.Warn("failed to stat object", zap.String("path", filePath), zap.Error(err))
+		return false, err
+	}
+	if objectInfo.Restore != nil {
+		return !objectInfo.Restore.OngoingRestore, nil
+	}
+	_, archived := archivedStorageClasses[objectInfo.StorageClass]
+	return !archived, nil
+}
+
+// PresignGet returns the url to GET the object of filePath without the credentials until expiry.
+func (mcm *MinioChunkManager) PresignGet(ctx context.Context, filePath string, expiry time.Duration) (string, error) {
+	u, err := mcm.Client.PresignedGetObject(ctx, mcm.bucketName, filePath, expiry, nil)
+	if err != nil {
+		log.Warn("failed to presign object", zap.String("path", filePath), zap.Error(err))
+		return "", err
+	}
+	return u.String(), nil
+}
+
+// PresignUpload returns the url and the form data of a POST policy uploading any object whose key starts
+// with prefix without the credentials until expiry.
+func (mcm *MinioChunkManager) PresignUpload(ctx context.Context, prefix string, expiry time.Duration) (string, map[string]string, error) {
+	policy := minio.NewPostPolicy()
+	if err := policy.SetBucket(mcm.bucketName); err != nil {
+		return "", nil, err
+	}
+	if err := policy.SetKeyStartsWith(prefix); err != nil {
+		return "", nil, err
+	}
+	if err := policy.SetExpires(time.Now().Add(expiry)); err != nil {
+		return "", nil, err
+	}
+	u, formData, err := mcm.Client.PresignedPostPolicy(ctx, policy)
+	if err != nil {
+		log.Warn("failed to presign upload", zap.String("prefix", prefix), zap.Error(err))
+		return "", nil, err
+	}
+	return u.String(), formData, nil
+}
+
 // Write writes the data to minio storage.
 func (mcm *MinioChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
 	_, err := mcm.Client.PutObject(ctx, mcm.bucketName, filePath, bytes.NewReader(content), int64(len(content)), minio.PutObjectOptions{})
@@ -530,6 +530,27 @@ func TestMinIOCM(t *testing.T) {
 		_, err = testCM.ReadAt(ctx, key, 100, 1)
 		assert.Error(t, err)
 		assert.True(t, errors.Is(err, ErrNoSuchKey))
+
+		_, err = testCM.Restored(ctx, key)
+		assert.True(t, errors.Is(err, ErrNoSuchKey))
+	})
+
+	t.Run("test Restored", func(t *testing.T) {
+		testPrefix := path.Join(testMinIOKVRoot, "restored")
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		testCM, err := newMinIOChunkManager(ctx, testBucket, testPrefix)
+		require.NoError(t, err)
+		defer testCM.RemoveWithPrefix(ctx, testPrefix)
+
+		key := path.Join(testPrefix, "a")
+		require.NoError(t, testCM.Write(ctx, key, []byte("1")))
+
+		// the objects of the standard storage class are never archived.
+		restored, err := testCM.Restored(ctx, key)
+		assert.NoError(t, err)
+		assert.True(t, restored)
 	})
 }
 
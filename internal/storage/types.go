@@ -60,3 +60,13 @@ type ChunkManager interface {
 	// RemoveWithPrefix remove files with same @prefix.
 	RemoveWithPrefix(ctx context.Context, prefix string) error
 }
+
+// ObjectRestorer is implemented by the chunk managers of the storages archiving the objects to a cold tier,
+// an archived object must be restored before it's read.
+type ObjectRestorer interface {
+	// Restore requests to restore the archived @filePath for @days with the retrieval @tier, it returns
+	// nil if the restore is in progress already.
+	Restore(ctx context.Context, filePath string, days int, tier string) error
+	// Restored returns true if @filePath can be read, that is it's not archived or it has been restored.
+	Restored(ctx context.Context, filePath string) (bool, error)
+}
@@ -136,6 +136,18 @@ type DataNodeComponent interface {
 	// Return nil in status:
 	//     The dataCoord is not nil.
 	SetDataCoord(dataCoord DataCoord) error
+
+	// ReadBinlogs streams the insert binlogs of a segment kept by DataNode since they were written.
+	// It's a server streaming rpc, the client of DataNode returns the stream to receive the binlogs instead.
+	ReadBinlogs(req *datapb.ReadBinlogsRequest, stream datapb.DataNode_ReadBinlogsServer) error
+}
+
+// BinlogReader reads the insert binlogs kept by DataNode, it's implemented by the grpc client of DataNode.
+type BinlogReader interface {
+	// ReadBinlogs returns the stream of the binlogs kept by DataNode, the binlogs not kept are skipped.
+	ReadBinlogs(ctx context.Context, req *datapb.ReadBinlogsRequest) (datapb.DataNode_ReadBinlogsClient, error)
+	// Stop closes the connection to DataNode.
+	Stop() error
 }
 
 // DataCoord is the interface `datacoord` package implements
@@ -359,6 +371,14 @@ type DataCoord interface {
 	// Deprecated: use DescribeIndex instead
 	GetIndexBuildProgress(ctx context.Context, req *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error)
 
+	// ReportJobResults receives the results of finished index jobs pushed by IndexNode, so that index meta
+	// is updated without waiting for the next QueryJobs polling.
+	ReportJobResults(ctx context.Context, req *indexpb.ReportJobResultsRequest) (*commonpb.Status, error)
+
+	// ListNodeJobs returns the index jobs in progress on the IndexNode, which the IndexNode reconciles at startup
+	// instead of leaving them to time out.
+	ListNodeJobs(ctx context.Context, req *indexpb.ListNodeJobsRequest) (*indexpb.ListNodeJobsResponse, error)
+
 	// DropIndex deletes indexes based on IndexID. One IndexID corresponds to the index of an entire column. A column is
 	// divided into many segments, and each segment corresponds to an IndexBuildID. IndexCoord uses IndexBuildID to record
 	// index tasks. Therefore, when DropIndex is called, delete all tasks corresponding to IndexBuildID corresponding to IndexID.
@@ -406,6 +426,22 @@ type IndexNode interface {
 	DropJobs(context.Context, *indexpb.DropJobsRequest) (*commonpb.Status, error)
 	// GetJobStats returns metrics of indexnode, including available job queue info, available task slots and finished job infos.
 	GetJobStats(context.Context, *indexpb.GetJobStatsRequest) (*indexpb.GetJobStatsResponse, error)
+	// GetCapabilities returns the capabilities of indexnode, such as the supported index types and disk index support.
+	GetCapabilities(context.Context, *indexpb.GetCapabilitiesRequest) (*indexpb.GetCapabilitiesResponse, error)
+	// SetLogLevel changes the log level of indexnode for a bounded duration, then the previous level is restored.
+	SetLogLevel(context.Context, *indexpb.SetLogLevelRequest) (*commonpb.Status, error)
+	// EstimateWaitTime returns the estimated queue wait of a job created now and the wait SLO of the queue.
+	EstimateWaitTime(context.Context, *indexpb.EstimateWaitTimeRequest) (*indexpb.EstimateWaitTimeResponse, error)
+	// VerifyIndex schedules a job checking the integrity of the index files of a finished build.
+	VerifyIndex(context.Context, *indexpb.VerifyIndexRequest) (*commonpb.Status, error)
+	// SetSuspended suspends indexnode or lifts the suspension, the suspended indexnode rejects the new jobs.
+	SetSuspended(context.Context, *indexpb.SetSuspendedRequest) (*commonpb.Status, error)
+	// ReserveSlots reserves a build slot for the job which is going to be assigned to indexnode.
+	ReserveSlots(context.Context, *indexpb.ReserveSlotsRequest) (*indexpb.ReserveSlotsResponse, error)
+	// HandoffJobs drops the queued jobs of indexnode not started yet and returns them to be reassigned.
+	HandoffJobs(context.Context, *indexpb.HandoffJobsRequest) (*indexpb.HandoffJobsResponse, error)
+	// SelfCheck runs a tiny end-to-end build with synthetic data and reports whether every dependency passes.
+	SelfCheck(context.Context, *indexpb.SelfCheckRequest) (*indexpb.SelfCheckResponse, error)
 
 	ShowConfigurations(ctx context.Context, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error)
 	// GetMetrics gets the metrics about IndexNode.
@@ -421,9 +457,30 @@ type IndexNodeComponent interface {
 	// SetEtcdClient set etcd client for IndexNodeComponent
 	SetEtcdClient(etcdClient *clientv3.Client)
 
+	// SetDataCoord set DataCoord for IndexNode
+	// `dataCoord` is a client of data coordinator, it is used to report the results of finished jobs.
+	//
+	// Return a generic error in status:
+	//     If the dataCoord is nil or the dataCoord has been set before.
+	// Return nil in status:
+	//     The dataCoord is not nil.
+	SetDataCoord(dataCoord DataCoord) error
+
+	// SetBinlogReaderCreator sets the creator of the BinlogReader of the DataNode at the given address,
+	// it's used to stream the binlogs of the jobs from the DataNode which wrote them.
+	SetBinlogReaderCreator(creator func(ctx context.Context, addr string) (BinlogReader, error))
+
 	// UpdateStateCode updates state code for IndexNodeComponent
 	//  `stateCode` is current statement of this QueryCoord, indicating whether it's healthy.
 	UpdateStateCode(stateCode commonpb.StateCode)
+
+	// WatchJobLog streams the log entries of a task until the task is done.
+	// It's a server streaming rpc, the client of IndexNode returns the stream to receive the entries instead.
+	WatchJobLog(req *indexpb.WatchJobLogRequest, stream indexpb.IndexNode_WatchJobLogServer) error
+
+	// DropPanickedJob drops the pending job a panicking rpc handler may have left half set up, so the job fails
+	// with the rpc and can be assigned again. It's called by the panic recovery of the grpc server.
+	DropPanickedJob(clusterID string, jobID int64)
 }
 
 // RootCoord is the interface `rootcoord` package implements
@@ -20,6 +20,8 @@ import "context"
 
 type ctxTenantKey struct{}
 
+type ctxClusterKey struct{}
+
 // WithTenantID creates a new context that has tenantID injected.
 func WithTenantID(ctx context.Context, tenantID string) context.Context {
 	if ctx == nil {
@@ -37,3 +39,20 @@ func TenantID(ctx context.Context) string {
 
 	return ""
 }
+
+// WithClusterID creates a new context that has the cluster id of the request injected.
+func WithClusterID(ctx context.Context, clusterID string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, ctxClusterKey{}, clusterID)
+}
+
+// ClusterID tries to retrieve the cluster id from the given context.
+// If it doesn't exist, an empty string is returned.
+func ClusterID(ctx context.Context) string {
+	if clusterID, ok := ctx.Value(ctxClusterKey{}).(string); ok {
+		return clusterID
+	}
+	return ""
+}
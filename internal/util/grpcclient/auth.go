@@ -8,9 +8,14 @@ import (
 
 type Token struct {
 	Value string
+	// Authorization is sent in the authorization header if it's set, for the servers validating a shared token.
+	Authorization string
 }
 
 func (t *Token) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	if t.Authorization != "" {
+		return map[string]string{util.HeaderSourceID: t.Value, util.HeaderAuthorize: t.Authorization}, nil
+	}
 	return map[string]string{util.HeaderSourceID: t.Value}, nil
 }
 
@@ -77,6 +77,9 @@ type ClientBase[T any] struct {
 	MaxBackoff        float32
 	BackoffMultiplier float32
 	NodeID            int64
+
+	// AuthToken is the token the server validates, it's sent with every request if set.
+	AuthToken string
 }
 
 // SetRole sets role of client
@@ -201,7 +204,7 @@ func (c *ClientBase[T]) connect(ctx context.Context) error {
 				},
 				MinConnectTimeout: c.DialTimeout,
 			}),
-			grpc.WithPerRPCCredentials(&Token{Value: crypto.Base64Encode(util.MemberCredID)}),
+			grpc.WithPerRPCCredentials(&Token{Value: crypto.Base64Encode(util.MemberCredID), Authorization: c.AuthToken}),
 		)
 	} else {
 		conn, err = grpc.DialContext(
@@ -232,7 +235,7 @@ func (c *ClientBase[T]) connect(ctx context.Context) error {
 				},
 				MinConnectTimeout: c.DialTimeout,
 			}),
-			grpc.WithPerRPCCredentials(&Token{Value: crypto.Base64Encode(util.MemberCredID)}),
+			grpc.WithPerRPCCredentials(&Token{Value: crypto.Base64Encode(util.MemberCredID), Authorization: c.AuthToken}),
 		)
 	}
 
@@ -14,6 +14,11 @@ type Dataset struct {
 	Data  map[string]interface{}
 }
 
+// RawData returns the raw data of the dataset, e.g. the []float32 of a float vector dataset.
+func (ds *Dataset) RawData() interface{} {
+	return ds.Data[keyRawArr]
+}
+
 func GenFloatVecDataset(vectors []float32) *Dataset {
 	return &Dataset{
 		DType: schemapb.DataType_FloatVector,
@@ -268,6 +268,37 @@ func (index *CgoIndex) Serialize() ([]*Blob, error) {
 	return ret, nil
 }
 
+// SerializeEach serializes the index and passes its files to fn one by one, each file is copied out of
+// the binary set right before fn is called, so only one file is held in the Go heap at a time.
+func (index *CgoIndex) SerializeEach(fn func(blob *Blob) error) error {
+	var cBinarySet C.CBinarySet
+
+	status := C.SerializeIndexToBinarySet(index.indexPtr, &cBinarySet)
+	defer func() {
+		if cBinarySet != nil {
+			C.DeleteBinarySet(cBinarySet)
+		}
+	}()
+	if err := HandleCStatus(&status, "failed to serialize index to binary set"); err != nil {
+		return err
+	}
+
+	keys, err := GetBinarySetKeys(cBinarySet)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		value, err := GetBinarySetValue(cBinarySet, key)
+		if err != nil {
+			return err
+		}
+		if err := fn(&Blob{Key: key, Value: value, Size: int64(len(value))}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (index *CgoIndex) GetIndexFileInfo() ([]*IndexFileInfo, error) {
 	var cBinarySet C.CBinarySet
 
@@ -0,0 +1,333 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexmanifest
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+)
+
+// Key is the file key of the signed manifest among the index files.
+const Key = "index_manifest"
+
+const (
+	AlgorithmHMACSHA256 = "hmac-sha256"
+	AlgorithmEd25519    = "ed25519"
+)
+
+var (
+	// ErrInvalidSignature is returned when the manifest is not signed by the key of the verifier.
+	ErrInvalidSignature = errors.New("invalid index manifest signature")
+	// ErrFileMismatch is returned when an index file is not the one recorded in the manifest.
+	ErrFileMismatch = errors.New("index file mismatches the manifest")
+)
+
+// File is an index file recorded in the manifest.
+type File struct {
+	Key    string `json:"key"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// AutoIndex is the index chosen by the node for an AUTOINDEX build.
+type AutoIndex struct {
+	Policy      string            `json:"policy"`
+	IndexParams map[string]string `json:"index_params"`
+	Reason      string            `json:"reason,omitempty"`
+}
+
+// Manifest identifies the index build and lists its index files with their digests.
+type Manifest struct {
+	BuildID      int64  `json:"build_id"`
+	IndexID      int64  `json:"index_id"`
+	IndexVersion int64  `json:"index_version"`
+	CollectionID int64  `json:"collection_id"`
+	PartitionID  int64  `json:"partition_id"`
+	SegmentID    int64  `json:"segment_id"`
+	FieldID      int64  `json:"field_id"`
+	Files        []File `json:"files"`
+	// AutoIndex is set if the index of an AUTOINDEX build is chosen by the node.
+	AutoIndex *AutoIndex `json:"auto_index,omitempty"`
+}
+
+// signedManifest is the content of the manifest file, the signature covers the raw manifest bytes.
+type signedManifest struct {
+	Manifest  json.RawMessage `json:"manifest"`
+	Algorithm string          `json:"algorithm"`
+	Signature string          `json:"signature"`
+}
+
+// Signer signs the manifests.
+type Signer interface {
+	Algorithm() string
+	Sign(payload []byte) ([]byte, error)
+}
+
+// Verifier verifies the signatures of the manifests.
+type Verifier interface {
+	Algorithm() string
+	Verify(payload, signature []byte) error
+}
+
+type hmacKey []byte
+
+// NewHMACKey returns the HMAC-SHA256 signer and verifier of the shared key.
+func NewHMACKey(key []byte) interface {
+	Signer
+	Verifier
+} {
+	return hmacKey(key)
+}
+
+func (k hmacKey) Algorithm() string {
+	return AlgorithmHMACSHA256
+}
+
+func (k hmacKey) Sign(payload []byte) ([]byte, error) {
+	mac := hmac.New(sha256.New, k)
+	mac.Write(payload)
+	return mac.Sum(nil), nil
+}
+
+func (k hmacKey) Verify(payload, signature []byte) error {
+	expected, _ := k.Sign(payload)
+	if !hmac.Equal(expected, signature) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+type ed25519Signer ed25519.PrivateKey
+
+// NewEd25519Signer returns the signer of the ed25519 private key, either the 32 bytes seed or the 64 bytes key.
+func NewEd25519Signer(key []byte) (Signer, error) {
+	switch len(key) {
+	case ed25519.SeedSize:
+		return ed25519Signer(ed25519.NewKeyFromSeed(key)), nil
+	case ed25519.PrivateKeySize:
+		return ed25519Signer(key), nil
+	default:
+		return nil, fmt.Errorf("invalid ed25519 private key size %d", len(key))
+	}
+}
+
+func (s ed25519Signer) Algorithm() string {
+	return AlgorithmEd25519
+}
+
+func (s ed25519Signer) Sign(payload []byte) ([]byte, error) {
+	return ed25519.Sign(ed25519.PrivateKey(s), payload), nil
+}
+
+type ed25519Verifier ed25519.PublicKey
+
+// NewEd25519Verifier returns the verifier of the ed25519 public key.
+func NewEd25519Verifier(key []byte) (Verifier, error) {
+	if len(key) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid ed25519 public key size %d", len(key))
+	}
+	return ed25519Verifier(key), nil
+}
+
+func (v ed25519Verifier) Algorithm() string {
+	return AlgorithmEd25519
+}
+
+func (v ed25519Verifier) Verify(payload, signature []byte) error {
+	if !ed25519.Verify(ed25519.PublicKey(v), payload, signature) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// NewSigner returns the signer of the algorithm with the base64 encoded key.
+func NewSigner(algorithm string, key string) (Signer, error) {
+	decoded, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64 signing key: %w", err)
+	}
+	switch algorithm {
+	case AlgorithmHMACSHA256:
+		if len(decoded) == 0 {
+			return nil, errors.New("empty hmac signing key")
+		}
+		return NewHMACKey(decoded), nil
+	case AlgorithmEd25519:
+		return NewEd25519Signer(decoded)
+	default:
+		return nil, fmt.Errorf("unknown signing algorithm %s", algorithm)
+	}
+}
+
+// NewVerifier returns the verifier of the algorithm with the base64 encoded key,
+// the shared key for HMAC and the public key for ed25519.
+func NewVerifier(algorithm string, key string) (Verifier, error) {
+	decoded, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64 verifying key: %w", err)
+	}
+	switch algorithm {
+	case AlgorithmHMACSHA256:
+		if len(decoded) == 0 {
+			return nil, errors.New("empty hmac verifying key")
+		}
+		return NewHMACKey(decoded), nil
+	case AlgorithmEd25519:
+		return NewEd25519Verifier(decoded)
+	default:
+		return nil, fmt.Errorf("unknown signing algorithm %s", algorithm)
+	}
+}
+
+// Hasher computes the digest of an index file.
+type Hasher struct {
+	hash hash.Hash
+	size int64
+}
+
+func NewHasher() *Hasher {
+	return &Hasher{hash: sha256.New()}
+}
+
+func (h *Hasher) Write(p []byte) (int, error) {
+	h.size += int64(len(p))
+	return h.hash.Write(p)
+}
+
+// File returns the manifest entry of the index file of the key hashed so far.
+func (h *Hasher) File(key string) File {
+	return File{Key: key, Size: h.size, SHA256: hex.EncodeToString(h.hash.Sum(nil))}
+}
+
+// NewFile returns the manifest entry of the index file.
+func NewFile(key string, value []byte) File {
+	h := NewHasher()
+	h.Write(value)
+	return h.File(key)
+}
+
+// NewFileFromReader returns the manifest entry of the index file read from the reader.
+func NewFileFromReader(key string, reader io.Reader) (File, error) {
+	h := NewHasher()
+	if _, err := io.Copy(h, reader); err != nil {
+		return File{}, err
+	}
+	return h.File(key), nil
+}
+
+// Sign returns the content of the manifest file signed by the signer.
+func Sign(manifest *Manifest, signer Signer) ([]byte, error) {
+	payload, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := signer.Sign(payload)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&signedManifest{
+		Manifest:  payload,
+		Algorithm: signer.Algorithm(),
+		Signature: base64.StdEncoding.EncodeToString(signature),
+	})
+}
+
+// Verify verifies the signature of the manifest file and returns the manifest.
+func Verify(data []byte, verifier Verifier) (*Manifest, error) {
+	signed := &signedManifest{}
+	if err := json.Unmarshal(data, signed); err != nil {
+		return nil, fmt.Errorf("invalid index manifest: %w", err)
+	}
+	if signed.Algorithm != verifier.Algorithm() {
+		return nil, fmt.Errorf("%w: signed by %s, verified by %s", ErrInvalidSignature, signed.Algorithm, verifier.Algorithm())
+	}
+	signature, err := base64.StdEncoding.DecodeString(signed.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	if err := verifier.Verify(signed.Manifest, signature); err != nil {
+		return nil, err
+	}
+	manifest := &Manifest{}
+	if err := json.Unmarshal(signed.Manifest, manifest); err != nil {
+		return nil, fmt.Errorf("invalid index manifest: %w", err)
+	}
+	return manifest, nil
+}
+
+// Parse returns the manifest of the manifest file without verifying its signature,
+// for the readers checking the index files against it only to detect corruption.
+func Parse(data []byte) (*Manifest, error) {
+	signed := &signedManifest{}
+	if err := json.Unmarshal(data, signed); err != nil {
+		return nil, fmt.Errorf("invalid index manifest: %w", err)
+	}
+	manifest := &Manifest{}
+	if err := json.Unmarshal(signed.Manifest, manifest); err != nil {
+		return nil, fmt.Errorf("invalid index manifest: %w", err)
+	}
+	return manifest, nil
+}
+
+// VerifierOf returns the verifier of the signatures made by the signer,
+// it returns false if the signer is not one of this package.
+func VerifierOf(signer Signer) (Verifier, bool) {
+	switch s := signer.(type) {
+	case hmacKey:
+		return s, true
+	case ed25519Signer:
+		return ed25519Verifier(ed25519.PrivateKey(s).Public().(ed25519.PublicKey)), true
+	default:
+		return nil, false
+	}
+}
+
+// VerifyFile checks the index file against the manifest.
+func (m *Manifest) VerifyFile(key string, value []byte) error {
+	return m.verify(NewFile(key, value))
+}
+
+// VerifyReader checks the index file read from the reader against the manifest.
+func (m *Manifest) VerifyReader(key string, reader io.Reader) error {
+	file, err := NewFileFromReader(key, reader)
+	if err != nil {
+		return err
+	}
+	return m.verify(file)
+}
+
+func (m *Manifest) verify(file File) error {
+	for _, expected := range m.Files {
+		if expected.Key != file.Key {
+			continue
+		}
+		if expected != file {
+			return fmt.Errorf("%w: %s has %d bytes with sha256 %s, the manifest records %d bytes with sha256 %s",
+				ErrFileMismatch, file.Key, file.Size, file.SHA256, expected.Size, expected.SHA256)
+		}
+		return nil
+	}
+	return fmt.Errorf("%w: %s is not in the manifest", ErrFileMismatch, file.Key)
+}
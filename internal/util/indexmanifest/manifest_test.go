@@ -0,0 +1,145 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexmanifest
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestManifest() *Manifest {
+	return &Manifest{
+		BuildID:   1,
+		IndexID:   2,
+		SegmentID: 3,
+		Files:     []File{NewFile("HNSW", []byte("index data")), NewFile("SLICE_META", []byte("meta"))},
+	}
+}
+
+func TestHMAC(t *testing.T) {
+	key := NewHMACKey([]byte("secret"))
+	manifest := newTestManifest()
+	data, err := Sign(manifest, key)
+	require.NoError(t, err)
+
+	verified, err := Verify(data, key)
+	assert.NoError(t, err)
+	assert.Equal(t, manifest, verified)
+	assert.NoError(t, verified.VerifyFile("HNSW", []byte("index data")))
+	assert.NoError(t, verified.VerifyReader("SLICE_META", bytes.NewReader([]byte("meta"))))
+	assert.True(t, errors.Is(verified.VerifyFile("HNSW", []byte("index dat4")), ErrFileMismatch))
+	assert.True(t, errors.Is(verified.VerifyFile("HNSW", []byte("index data!")), ErrFileMismatch))
+	assert.True(t, errors.Is(verified.VerifyFile("IVF", []byte("index data")), ErrFileMismatch))
+
+	_, err = Verify(data, NewHMACKey([]byte("other")))
+	assert.True(t, errors.Is(err, ErrInvalidSignature))
+	tampered := bytes.Replace(data, []byte(`"build_id":1`), []byte(`"build_id":4`), 1)
+	require.NotEqual(t, data, tampered)
+	_, err = Verify(tampered, key)
+	assert.True(t, errors.Is(err, ErrInvalidSignature))
+	_, err = Verify([]byte("{"), key)
+	assert.Error(t, err)
+}
+
+func TestEd25519(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	signer, err := NewSigner(AlgorithmEd25519, base64.StdEncoding.EncodeToString(privateKey.Seed()))
+	require.NoError(t, err)
+	verifier, err := NewVerifier(AlgorithmEd25519, base64.StdEncoding.EncodeToString(publicKey))
+	require.NoError(t, err)
+
+	manifest := newTestManifest()
+	data, err := Sign(manifest, signer)
+	require.NoError(t, err)
+	verified, err := Verify(data, verifier)
+	assert.NoError(t, err)
+	assert.Equal(t, manifest, verified)
+
+	otherPublicKey, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	otherVerifier, err := NewEd25519Verifier(otherPublicKey)
+	require.NoError(t, err)
+	_, err = Verify(data, otherVerifier)
+	assert.True(t, errors.Is(err, ErrInvalidSignature))
+	// the algorithm must match
+	_, err = Verify(data, NewHMACKey(publicKey))
+	assert.True(t, errors.Is(err, ErrInvalidSignature))
+}
+
+func TestNewSigner(t *testing.T) {
+	key := base64.StdEncoding.EncodeToString([]byte("secret"))
+	signer, err := NewSigner(AlgorithmHMACSHA256, key)
+	assert.NoError(t, err)
+	assert.Equal(t, AlgorithmHMACSHA256, signer.Algorithm())
+
+	_, err = NewSigner(AlgorithmHMACSHA256, "")
+	assert.Error(t, err)
+	_, err = NewSigner(AlgorithmHMACSHA256, "not base64!")
+	assert.Error(t, err)
+	_, err = NewSigner(AlgorithmEd25519, key)
+	assert.Error(t, err)
+	_, err = NewSigner("rsa", key)
+	assert.Error(t, err)
+	_, err = NewVerifier(AlgorithmEd25519, key)
+	assert.Error(t, err)
+	_, err = NewVerifier("rsa", key)
+	assert.Error(t, err)
+}
+
+func TestVerifierOf(t *testing.T) {
+	_, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	ed25519Signer, err := NewEd25519Signer(privateKey)
+	require.NoError(t, err)
+	for _, signer := range []Signer{NewHMACKey([]byte("secret")), ed25519Signer} {
+		data, err := Sign(newTestManifest(), signer)
+		require.NoError(t, err)
+		verifier, ok := VerifierOf(signer)
+		require.True(t, ok)
+		verified, err := Verify(data, verifier)
+		assert.NoError(t, err)
+		assert.Equal(t, newTestManifest(), verified)
+	}
+}
+
+func TestParse(t *testing.T) {
+	data, err := Sign(newTestManifest(), NewHMACKey([]byte("secret")))
+	require.NoError(t, err)
+	parsed, err := Parse(data)
+	assert.NoError(t, err)
+	assert.Equal(t, newTestManifest(), parsed)
+
+	manifest := newTestManifest()
+	manifest.AutoIndex = &AutoIndex{Policy: "default", IndexParams: map[string]string{"index_type": "IVF_SQ8"}}
+	data, err = Sign(manifest, NewHMACKey([]byte("secret")))
+	require.NoError(t, err)
+	parsed, err = Parse(data)
+	assert.NoError(t, err)
+	assert.Equal(t, manifest, parsed)
+
+	_, err = Parse([]byte("{"))
+	assert.Error(t, err)
+	_, err = Parse([]byte(`{"manifest":"x"}`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,123 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexpointer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// Key is the file key of the index pointer in the directory of the index files of a build.
+const Key = "index_pointer"
+
+// stagingDir is the directory under the index directory the rebuilt index files are staged in.
+const stagingDir = "rebuild"
+
+// Storage is the part of the chunk manager the pointer is read and written with.
+type Storage interface {
+	Exist(ctx context.Context, filePath string) (bool, error)
+	Read(ctx context.Context, filePath string) ([]byte, error)
+	Write(ctx context.Context, filePath string, content []byte) error
+}
+
+// Pointer makes the index files of an in-place rebuild visible in place of the files of the index directory.
+// The pointer is a single object, so replacing it swaps all the index files at once.
+type Pointer struct {
+	// Version is the version of the rebuild, a pointer is only replaced by the pointer of a larger version.
+	Version int64 `json:"version"`
+	// Keys are the keys of the index files staged by the rebuild.
+	Keys []string `json:"keys"`
+}
+
+// StagingDir returns the directory the index files of the rebuild version are staged in.
+func StagingDir(indexDir string, version int64) string {
+	return path.Join(indexDir, stagingDir, strconv.FormatInt(version, 10))
+}
+
+// IsStaged reports whether the file of the index directory is staged by a rebuild.
+func IsStaged(indexDir string, filePath string) bool {
+	return strings.HasPrefix(filePath, path.Join(indexDir, stagingDir)+"/")
+}
+
+// Path returns the path of the staged index file of the key.
+func (p *Pointer) Path(indexDir string, key string) string {
+	return path.Join(StagingDir(indexDir, p.Version), key)
+}
+
+// Paths returns the paths of all the staged index files.
+func (p *Pointer) Paths(indexDir string) []string {
+	paths := make([]string, 0, len(p.Keys))
+	for _, key := range p.Keys {
+		paths = append(paths, p.Path(indexDir, key))
+	}
+	return paths
+}
+
+// Read returns the pointer of the index directory, or nil if the index has not been rebuilt in place.
+func Read(ctx context.Context, storage Storage, indexDir string) (*Pointer, error) {
+	pointerPath := path.Join(indexDir, Key)
+	exist, err := storage.Exist(ctx, pointerPath)
+	if err != nil || !exist {
+		return nil, err
+	}
+	data, err := storage.Read(ctx, pointerPath)
+	if err != nil {
+		return nil, err
+	}
+	pointer := &Pointer{}
+	if err := json.Unmarshal(data, pointer); err != nil {
+		return nil, fmt.Errorf("invalid index pointer %s: %w", pointerPath, err)
+	}
+	return pointer, nil
+}
+
+// Write replaces the pointer of the index directory.
+func Write(ctx context.Context, storage Storage, indexDir string, pointer *Pointer) error {
+	data, err := json.Marshal(pointer)
+	if err != nil {
+		return err
+	}
+	return storage.Write(ctx, path.Join(indexDir, Key), data)
+}
+
+// ResolvePaths returns the paths of the index files of the keys, the files staged by the last in-place rebuild
+// of the index directory are resolved through its pointer.
+func ResolvePaths(ctx context.Context, storage Storage, indexDir string, keys []string) ([]string, error) {
+	pointer, err := Read(ctx, storage, indexDir)
+	if err != nil {
+		return nil, err
+	}
+	staged := make(map[string]struct{})
+	if pointer != nil {
+		for _, key := range pointer.Keys {
+			staged[key] = struct{}{}
+		}
+	}
+	paths := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if _, ok := staged[key]; ok {
+			paths = append(paths, pointer.Path(indexDir, key))
+		} else {
+			paths = append(paths, path.Join(indexDir, key))
+		}
+	}
+	return paths, nil
+}
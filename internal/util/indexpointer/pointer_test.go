@@ -0,0 +1,73 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexpointer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mapStorage map[string][]byte
+
+func (s mapStorage) Exist(ctx context.Context, filePath string) (bool, error) {
+	_, ok := s[filePath]
+	return ok, nil
+}
+
+func (s mapStorage) Read(ctx context.Context, filePath string) ([]byte, error) {
+	return s[filePath], nil
+}
+
+func (s mapStorage) Write(ctx context.Context, filePath string, content []byte) error {
+	s[filePath] = content
+	return nil
+}
+
+func TestPointer(t *testing.T) {
+	ctx := context.Background()
+	storage := mapStorage{}
+	indexDir := "files/index_files/1/1/2/3"
+
+	pointer, err := Read(ctx, storage, indexDir)
+	assert.NoError(t, err)
+	assert.Nil(t, pointer)
+	paths, err := ResolvePaths(ctx, storage, indexDir, []string{"HNSW", "SLICE_META"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{indexDir + "/HNSW", indexDir + "/SLICE_META"}, paths)
+
+	require.NoError(t, Write(ctx, storage, indexDir, &Pointer{Version: 100, Keys: []string{"HNSW"}}))
+	pointer, err = Read(ctx, storage, indexDir)
+	assert.NoError(t, err)
+	assert.Equal(t, &Pointer{Version: 100, Keys: []string{"HNSW"}}, pointer)
+	assert.Equal(t, []string{indexDir + "/rebuild/100/HNSW"}, pointer.Paths(indexDir))
+	paths, err = ResolvePaths(ctx, storage, indexDir, []string{"HNSW", "SLICE_META"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{indexDir + "/rebuild/100/HNSW", indexDir + "/SLICE_META"}, paths)
+
+	assert.True(t, IsStaged(indexDir, indexDir+"/rebuild/100/HNSW"))
+	assert.False(t, IsStaged(indexDir, indexDir+"/HNSW"))
+	assert.False(t, IsStaged(indexDir, indexDir+"/rebuilds"))
+
+	storage[indexDir+"/"+Key] = []byte("{")
+	_, err = Read(ctx, storage, indexDir)
+	assert.Error(t, err)
+	_, err = ResolvePaths(ctx, storage, indexDir, []string{"HNSW"})
+	assert.Error(t, err)
+}
@@ -6,9 +6,14 @@ import (
 	"github.com/milvus-io/milvus/internal/common"
 )
 
-func BuildSegmentIndexFilePath(rootPath string, buildID, indexVersion, partID, segID int64, fileKey string) string {
+// BuildSegmentIndexDir returns the directory of the index files of the build.
+func BuildSegmentIndexDir(rootPath string, buildID, indexVersion, partID, segID int64) string {
 	k := JoinIDPath(buildID, indexVersion, partID, segID)
-	return path.Join(rootPath, common.SegmentIndexPath, k, fileKey)
+	return path.Join(rootPath, common.SegmentIndexPath, k)
+}
+
+func BuildSegmentIndexFilePath(rootPath string, buildID, indexVersion, partID, segID int64, fileKey string) string {
+	return path.Join(BuildSegmentIndexDir(rootPath, buildID, indexVersion, partID, segID), fileKey)
 }
 
 func BuildSegmentIndexFilePaths(rootPath string, buildID, indexVersion, partID, segID int64, fileKeys []string) []string {
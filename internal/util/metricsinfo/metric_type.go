@@ -27,6 +27,9 @@ const (
 
 	// SystemInfoMetrics means users request for system information metrics.
 	SystemInfoMetrics = "system_info"
+
+	// SchedulerSnapshotMetrics means users request for the snapshot of the scheduler internals.
+	SchedulerSnapshotMetrics = "scheduler_snapshot"
 )
 
 // ParseMetricType returns the metric type of req
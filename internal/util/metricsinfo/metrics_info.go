@@ -134,10 +134,60 @@ type IndexNodeConfiguration struct {
 	SimdType string `json:"simd_type"`
 }
 
+// IndexNodeWarmup records the baseline performance measured by the warm-up benchmark of IndexNode at startup,
+// a degraded node builds or writes much slower than its peers.
+type IndexNodeWarmup struct {
+	BuildRows             int    `json:"build_rows"`
+	BuildDim              int    `json:"build_dim"`
+	BuildMilliseconds     int64  `json:"build_milliseconds"`
+	DiskBytes             int64  `json:"disk_bytes"`
+	DiskWriteMilliseconds int64  `json:"disk_write_milliseconds"`
+	DiskReadMilliseconds  int64  `json:"disk_read_milliseconds"`
+	Error                 string `json:"error,omitempty"`
+	FinishedTime          string `json:"finished_time"`
+}
+
+// IndexNodeScalingHint is the number of IndexNode replicas a node asks for to drain its backlog within the wait target,
+// an external scaler sums the hints of the nodes for the desired replicas of the IndexNode deployment.
+type IndexNodeScalingHint struct {
+	DesiredReplicas           int     `json:"desired_replicas"`
+	QueuedJobs                int     `json:"queued_jobs"`
+	RunningJobs               int     `json:"running_jobs"`
+	TaskSlots                 int     `json:"task_slots"`
+	MeanBuildMilliseconds     int64   `json:"mean_build_milliseconds"`
+	EstimatedWaitMilliseconds int64   `json:"estimated_wait_milliseconds"`
+	CPUHeadroom               float64 `json:"cpu_headroom"`
+	MemoryHeadroom            float64 `json:"memory_headroom"`
+}
+
+// IndexNodeBuildBaseline is the build throughput of an index type and dim class over the recent builds of IndexNode.
+type IndexNodeBuildBaseline struct {
+	IndexType     string  `json:"index_type"`
+	DimClass      string  `json:"dim_class"`
+	Builds        int     `json:"builds"`
+	RowsPerSecond float64 `json:"rows_per_second"`
+	GBPerSecond   float64 `json:"gb_per_second"`
+}
+
+// IndexNodeCachedSegment is a field of a segment whose decoded data IndexNode keeps in memory, a rebuild of the
+// index of the field assigned to the node skips loading and decoding its binlogs.
+type IndexNodeCachedSegment struct {
+	ClusterID    string `json:"cluster_id"`
+	CollectionID int64  `json:"collection_id"`
+	SegmentID    int64  `json:"segment_id"`
+	FieldID      int64  `json:"field_id"`
+	NumRows      int64  `json:"num_rows"`
+}
+
 // IndexNodeInfos implements ComponentInfos
 type IndexNodeInfos struct {
 	BaseComponentInfos
-	SystemConfigurations IndexNodeConfiguration `json:"system_configurations"`
+	SystemConfigurations IndexNodeConfiguration   `json:"system_configurations"`
+	Warmup               *IndexNodeWarmup         `json:"warmup,omitempty"`
+	ScalingHint          *IndexNodeScalingHint    `json:"scaling_hint,omitempty"`
+	BuildBaselines       []IndexNodeBuildBaseline `json:"build_baselines,omitempty"`
+	// CachedSegments are the hints of the data locality of the node for the job assignment.
+	CachedSegments []IndexNodeCachedSegment `json:"cached_segments,omitempty"`
 }
 
 // IndexCoordConfiguration records the configuration of IndexCoord.
@@ -174,6 +174,11 @@ func TestIndexNodeInfos_Codec(t *testing.T) {
 
 			SimdType: "auto",
 		},
+		ScalingHint: &IndexNodeScalingHint{
+			DesiredReplicas: 2,
+			QueuedJobs:      10,
+			TaskSlots:       2,
+		},
 	}
 	s, err := MarshalComponentInfos(infos1)
 	assert.Equal(t, nil, err)
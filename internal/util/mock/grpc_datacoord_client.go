@@ -203,3 +203,13 @@ func (m *GrpcDataCoordClient) DescribeIndex(ctx context.Context, req *indexpb.De
 func (m *GrpcDataCoordClient) GetIndexBuildProgress(ctx context.Context, req *indexpb.GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*indexpb.GetIndexBuildProgressResponse, error) {
 	return &indexpb.GetIndexBuildProgressResponse{}, m.Err
 }
+
+// ReportJobResults receives the results of finished index jobs pushed by IndexNode.
+func (m *GrpcDataCoordClient) ReportJobResults(ctx context.Context, req *indexpb.ReportJobResultsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+// ListNodeJobs returns the index jobs in progress on the IndexNode.
+func (m *GrpcDataCoordClient) ListNodeJobs(ctx context.Context, req *indexpb.ListNodeJobsRequest, opts ...grpc.CallOption) (*indexpb.ListNodeJobsResponse, error) {
+	return &indexpb.ListNodeJobsResponse{}, m.Err
+}
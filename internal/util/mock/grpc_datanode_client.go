@@ -77,6 +77,10 @@ func (m *GrpcDataNodeClient) AddImportSegment(ctx context.Context, in *datapb.Ad
 	return &datapb.AddImportSegmentResponse{}, m.Err
 }
 
+func (m *GrpcDataNodeClient) ReadBinlogs(ctx context.Context, in *datapb.ReadBinlogsRequest, opts ...grpc.CallOption) (datapb.DataNode_ReadBinlogsClient, error) {
+	return nil, m.Err
+}
+
 func (m *GrpcDataNodeClient) SyncSegments(ctx context.Context, in *datapb.SyncSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
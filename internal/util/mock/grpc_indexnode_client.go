@@ -61,6 +61,42 @@ func (m *GrpcIndexNodeClient) GetJobStats(ctx context.Context, in *indexpb.GetJo
 	return &indexpb.GetJobStatsResponse{}, m.Err
 }
 
+func (m *GrpcIndexNodeClient) GetCapabilities(ctx context.Context, in *indexpb.GetCapabilitiesRequest, opts ...grpc.CallOption) (*indexpb.GetCapabilitiesResponse, error) {
+	return &indexpb.GetCapabilitiesResponse{}, m.Err
+}
+
+func (m *GrpcIndexNodeClient) SetLogLevel(ctx context.Context, in *indexpb.SetLogLevelRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcIndexNodeClient) EstimateWaitTime(ctx context.Context, in *indexpb.EstimateWaitTimeRequest, opts ...grpc.CallOption) (*indexpb.EstimateWaitTimeResponse, error) {
+	return &indexpb.EstimateWaitTimeResponse{}, m.Err
+}
+
+func (m *GrpcIndexNodeClient) VerifyIndex(ctx context.Context, in *indexpb.VerifyIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcIndexNodeClient) SetSuspended(ctx context.Context, in *indexpb.SetSuspendedRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcIndexNodeClient) ReserveSlots(ctx context.Context, in *indexpb.ReserveSlotsRequest, opts ...grpc.CallOption) (*indexpb.ReserveSlotsResponse, error) {
+	return &indexpb.ReserveSlotsResponse{}, m.Err
+}
+
+func (m *GrpcIndexNodeClient) HandoffJobs(ctx context.Context, in *indexpb.HandoffJobsRequest, opts ...grpc.CallOption) (*indexpb.HandoffJobsResponse, error) {
+	return &indexpb.HandoffJobsResponse{}, m.Err
+}
+
+func (m *GrpcIndexNodeClient) SelfCheck(ctx context.Context, in *indexpb.SelfCheckRequest, opts ...grpc.CallOption) (*indexpb.SelfCheckResponse, error) {
+	return &indexpb.SelfCheckResponse{}, m.Err
+}
+
+func (m *GrpcIndexNodeClient) WatchJobLog(ctx context.Context, in *indexpb.WatchJobLogRequest, opts ...grpc.CallOption) (indexpb.IndexNode_WatchJobLogClient, error) {
+	return nil, m.Err
+}
+
 func (m *GrpcIndexNodeClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
 	return &milvuspb.GetMetricsResponse{}, m.Err
 }
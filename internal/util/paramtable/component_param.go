@@ -99,6 +99,30 @@ func (p *ComponentParam) Init() {
 
 func (p *ComponentParam) init() {
 	p.ServiceParam.init()
+	p.HookCfg.init()
+	p.initItems()
+}
+
+// Namespace returns a ComponentParam reading the configs of p. The configs saved into the returned
+// ComponentParam are only visible to itself, so a component embedded in a process with others can
+// tune its params without racing with them.
+func (p *ComponentParam) Namespace() *ComponentParam {
+	ns := &ComponentParam{}
+	ns.once.Do(func() {
+		ns.BaseTable = BaseTable{
+			mgr:       p.mgr.Namespace(),
+			configDir: p.configDir,
+			YamlFile:  p.YamlFile,
+			Log:       p.Log,
+		}
+		ns.HookCfg = p.HookCfg
+		ns.initItems()
+	})
+	return ns
+}
+
+func (p *ComponentParam) initItems() {
+	p.ServiceParam.initItems()
 
 	p.CommonCfg.init(&p.BaseTable)
 	p.QuotaConfig.init(&p.BaseTable)
@@ -113,7 +137,6 @@ func (p *ComponentParam) init() {
 	p.DataNodeCfg.init(&p.BaseTable)
 	p.IndexNodeCfg.init(&p.BaseTable)
 	p.HTTPCfg.init(&p.BaseTable)
-	p.HookCfg.init()
 
 	p.RootCoordGrpcServerCfg.Init(typeutil.RootCoordRole, &p.BaseTable)
 	p.ProxyGrpcServerCfg.Init(typeutil.ProxyRole, &p.BaseTable)
@@ -209,6 +232,8 @@ type commonConfig struct {
 
 	SessionTTL        ParamItem `refreshable:"false"`
 	SessionRetryTimes ParamItem `refreshable:"false"`
+
+	IndexFileCodecs ParamItem `refreshable:"true"`
 }
 
 func (p *commonConfig) init(base *BaseTable) {
@@ -546,6 +571,12 @@ func (p *commonConfig) init(base *BaseTable) {
 	}
 	p.SessionRetryTimes.Init(base.mgr)
 
+	p.IndexFileCodecs = ParamItem{
+		Key:          "common.indexFileCodecs",
+		Version:      "2.3.0",
+		DefaultValue: "",
+	}
+	p.IndexFileCodecs.Init(base.mgr)
 }
 
 type traceConfig struct {
@@ -1406,6 +1437,9 @@ type dataCoordConfig struct {
 	IndexTaskSchedulerInterval ParamItem `refreshable:"false"`
 
 	MinSegmentNumRowsToEnableIndex ParamItem `refreshable:"true"`
+
+	PresignedURLEnable ParamItem `refreshable:"true"`
+	PresignedURLExpiry ParamItem `refreshable:"true"`
 }
 
 func (p *dataCoordConfig) init(base *BaseTable) {
@@ -1646,6 +1680,20 @@ func (p *dataCoordConfig) init(base *BaseTable) {
 		DefaultValue: "1000",
 	}
 	p.IndexTaskSchedulerInterval.Init(base.mgr)
+
+	p.PresignedURLEnable = ParamItem{
+		Key:          "dataCoord.presignedURL.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.PresignedURLEnable.Init(base.mgr)
+
+	p.PresignedURLExpiry = ParamItem{
+		Key:          "dataCoord.presignedURL.expiry",
+		Version:      "2.3.0",
+		DefaultValue: "3600",
+	}
+	p.PresignedURLExpiry.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -1662,6 +1710,10 @@ type dataNodeConfig struct {
 
 	// io concurrency to fetch stats logs
 	IOConcurrency ParamItem `refreshable:"false"`
+
+	BinlogCacheEnable   ParamItem `refreshable:"false"`
+	BinlogCacheCapacity ParamItem `refreshable:"false"`
+	BinlogCacheTTL      ParamItem `refreshable:"false"`
 }
 
 func (p *dataNodeConfig) init(base *BaseTable) {
@@ -1716,6 +1768,26 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 	}
 	p.IOConcurrency.Init(base.mgr)
 
+	p.BinlogCacheEnable = ParamItem{
+		Key:          "dataNode.binlogCache.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.BinlogCacheEnable.Init(base.mgr)
+
+	p.BinlogCacheCapacity = ParamItem{
+		Key:          "dataNode.binlogCache.capacity",
+		Version:      "2.3.0",
+		DefaultValue: "256",
+	}
+	p.BinlogCacheCapacity.Init(base.mgr)
+
+	p.BinlogCacheTTL = ParamItem{
+		Key:          "dataNode.binlogCache.ttl",
+		Version:      "2.3.0",
+		DefaultValue: "600",
+	}
+	p.BinlogCacheTTL.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -1728,6 +1800,187 @@ type indexNodeConfig struct {
 	MaxDiskUsagePercentage ParamItem `refreshable:"true"`
 
 	GracefulStopTimeout ParamItem `refreshable:"false"`
+
+	// upload
+	UploadParallel       ParamItem `refreshable:"true"`
+	UploadResumeAttempts ParamItem `refreshable:"true"`
+	UploadCodec          ParamItem `refreshable:"true"`
+
+	// build auto tuning
+	BuildTuneEnable         ParamItem `refreshable:"false"`
+	BuildTuneTargetCPUUsage ParamItem `refreshable:"true"`
+	BuildTuneMaxParallel    ParamItem `refreshable:"true"`
+	BuildTuneInterval       ParamItem `refreshable:"false"`
+	BuildTuneHeadroom       ParamItem `refreshable:"true"`
+
+	BuildResultCacheEnable   ParamItem `refreshable:"false"`
+	BuildResultCacheCapacity ParamItem `refreshable:"false"`
+	BuildResultCacheTTL      ParamItem `refreshable:"false"`
+
+	ResultCallbackEnable     ParamItem `refreshable:"true"`
+	ResultCallbackRetryTimes ParamItem `refreshable:"true"`
+	ReconcileEnable          ParamItem `refreshable:"false"`
+
+	AuditEnable     ParamItem `refreshable:"true"`
+	AuditPathPrefix ParamItem `refreshable:"true"`
+
+	MaxTaskLifetime ParamItem `refreshable:"true"`
+
+	FaultInjectionEnable                  ParamItem `refreshable:"true"`
+	FaultInjectionSeed                    ParamItem `refreshable:"false"`
+	FaultInjectionStorageLatency          ParamItem `refreshable:"true"`
+	FaultInjectionStorageErrorRate        ParamItem `refreshable:"true"`
+	FaultInjectionStoragePartialWriteRate ParamItem `refreshable:"true"`
+	FaultInjectionSchedulerMaxDelay       ParamItem `refreshable:"true"`
+
+	StorageTenantRequestRate ParamItem `refreshable:"true"`
+
+	BruteForceRowThreshold ParamItem `refreshable:"true"`
+
+	SchedulerTenantWeights     ParamItem `refreshable:"true"`
+	SchedulerIndexTypeParallel ParamItem `refreshable:"true"`
+
+	MaintenanceWindows            ParamItem `refreshable:"true"`
+	MaintenanceAcceptHighPriority ParamItem `refreshable:"true"`
+
+	JournalEnable  ParamItem `refreshable:"false"`
+	JournalPath    ParamItem `refreshable:"false"`
+	JournalMaxSize ParamItem `refreshable:"false"`
+
+	FlatFastPathEnable ParamItem `refreshable:"true"`
+
+	HandoffEnable ParamItem `refreshable:"true"`
+
+	StagingDirs          ParamItem `refreshable:"false"`
+	StagingProbeInterval ParamItem `refreshable:"false"`
+
+	NonFiniteVectorPolicy ParamItem `refreshable:"true"`
+	RowCountCheckMode     ParamItem `refreshable:"true"`
+
+	BinlogSourceEnable  ParamItem `refreshable:"true"`
+	BinlogSourceTimeout ParamItem `refreshable:"true"`
+
+	ColdRestoreDays         ParamItem `refreshable:"true"`
+	ColdRestoreTier         ParamItem `refreshable:"true"`
+	ColdRestorePollInterval ParamItem `refreshable:"true"`
+	ColdRestoreTimeout      ParamItem `refreshable:"true"`
+
+	ScratchEncryptionEnable ParamItem `refreshable:"true"`
+
+	ConfigGuardEnable        ParamItem `refreshable:"false"`
+	ConfigGuardInterval      ParamItem `refreshable:"false"`
+	ConfigGuardObserveWindow ParamItem `refreshable:"false"`
+	ConfigGuardFailureRatio  ParamItem `refreshable:"false"`
+	ConfigGuardMemoryRatio   ParamItem `refreshable:"false"`
+
+	MetricsTaskLabels     ParamItem `refreshable:"true"`
+	MetricsMaxLabelValues ParamItem `refreshable:"true"`
+
+	ReplicationReconcileInterval ParamItem `refreshable:"false"`
+	ReplicationMaxAttempts       ParamItem `refreshable:"true"`
+
+	FlightPort      ParamItem `refreshable:"false"`
+	FlightBatchRows ParamItem `refreshable:"true"`
+
+	SandboxEnable          ParamItem `refreshable:"true"`
+	SandboxMaxAddressSpace ParamItem `refreshable:"true"`
+	SandboxMaxOpenFiles    ParamItem `refreshable:"true"`
+	SandboxMaxCPUTime      ParamItem `refreshable:"true"`
+	SandboxWorkers         ParamItem `refreshable:"false"`
+
+	AutoIndexPolicy ParamItem `refreshable:"true"`
+
+	DatasetCacheCapacity ParamItem `refreshable:"false"`
+	DatasetCacheTTL      ParamItem `refreshable:"false"`
+
+	TaskRetentionTTL      ParamItem `refreshable:"true"`
+	TaskRetentionMaxCount ParamItem `refreshable:"true"`
+
+	CollectionDropWatchEnable ParamItem `refreshable:"false"`
+
+	IOMetaConcurrency    ParamItem `refreshable:"false"`
+	IOStorageConcurrency ParamItem `refreshable:"false"`
+
+	GrpcInterceptors ParamItem `refreshable:"false"`
+	GrpcAuthToken    ParamItem `refreshable:"false"`
+	GrpcRateLimits   ParamItem `refreshable:"false"`
+
+	HookSoPath ParamItem `refreshable:"false"`
+
+	ManifestSigningAlgorithm ParamItem `refreshable:"false"`
+	ManifestSigningKey       ParamItem `refreshable:"false"`
+
+	CPUAffinityEnable ParamItem `refreshable:"true"`
+	CPUAffinityCPUSet ParamItem `refreshable:"true"`
+
+	BuildWatchdogStallTimeout ParamItem `refreshable:"true"`
+	BuildWatchdogAbort        ParamItem `refreshable:"true"`
+
+	StorageHedgeEnable      ParamItem `refreshable:"true"`
+	StorageHedgeBudgetRatio ParamItem `refreshable:"true"`
+	StorageHedgeMinDelay    ParamItem `refreshable:"true"`
+
+	ArenaStages    ParamItem `refreshable:"true"`
+	ArenaChunkSize ParamItem `refreshable:"true"`
+
+	UploadChunkedSerialize ParamItem `refreshable:"true"`
+
+	UploadPriorityEnable        ParamItem `refreshable:"true"`
+	UploadPriorityMaxConcurrent ParamItem `refreshable:"true"`
+
+	WarmupEnable    ParamItem `refreshable:"false"`
+	WarmupBuildRows ParamItem `refreshable:"false"`
+
+	JobLogEnable     ParamItem `refreshable:"true"`
+	JobLogMaxEntries ParamItem `refreshable:"true"`
+
+	DecodeWorkers   ParamItem `refreshable:"false"`
+	DecodeQueueSize ParamItem `refreshable:"false"`
+
+	ParamProfiles ParamItem `refreshable:"true"`
+
+	LimitMaxDim     ParamItem `refreshable:"true"`
+	LimitMaxRows    ParamItem `refreshable:"true"`
+	LimitIndexTypes ParamItem `refreshable:"true"`
+
+	LogLevelMaxDuration ParamItem `refreshable:"true"`
+
+	Zone ParamItem `refreshable:"false"`
+
+	DiagnosticsEnable     ParamItem `refreshable:"true"`
+	DiagnosticsPathPrefix ParamItem `refreshable:"true"`
+
+	SchedulerWaitTarget     ParamItem `refreshable:"true"`
+	SchedulerEnqueueTimeout ParamItem `refreshable:"true"`
+
+	DecisionLogEnable  ParamItem `refreshable:"false"`
+	DecisionLogPath    ParamItem `refreshable:"false"`
+	DecisionLogMaxSize ParamItem `refreshable:"false"`
+
+	AutoscaleMinHeadroom ParamItem `refreshable:"true"`
+
+	MemoryPressureEnable    ParamItem `refreshable:"false"`
+	MemoryPressureThreshold ParamItem `refreshable:"true"`
+	MemoryPressureSustain   ParamItem `refreshable:"true"`
+	MemoryPressureInterval  ParamItem `refreshable:"false"`
+
+	RebuildDeleteDelay ParamItem `refreshable:"true"`
+
+	KnowhereLogLevel        ParamItem `refreshable:"false"`
+	KnowhereLogModuleLevels ParamItem `refreshable:"false"`
+	KnowhereLogFile         ParamItem `refreshable:"false"`
+	KnowhereLogMaxSize      ParamItem `refreshable:"false"`
+	KnowhereLogMaxAge       ParamItem `refreshable:"false"`
+	CGOMemTraceEnable       ParamItem `refreshable:"false"`
+	SuspendEnable           ParamItem `refreshable:"true"`
+	ReservationTTL          ParamItem `refreshable:"true"`
+	BackgroundBuildThreads  ParamItem `refreshable:"true"`
+	BackgroundNice          ParamItem `refreshable:"true"`
+
+	IOPriorityEnable     ParamItem `refreshable:"true"`
+	IOPriorityCgroup     ParamItem `refreshable:"false"`
+	IOPriorityForeground ParamItem `refreshable:"true"`
+	IOPriorityBackground ParamItem `refreshable:"true"`
 }
 
 func (p *indexNodeConfig) init(base *BaseTable) {
@@ -1780,6 +2033,888 @@ func (p *indexNodeConfig) init(base *BaseTable) {
 		FallbackKeys: []string{"common.gracefulStopTimeout"},
 	}
 	p.GracefulStopTimeout.Init(base.mgr)
+
+	p.UploadParallel = ParamItem{
+		Key:          "indexNode.upload.parallel",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+	}
+	p.UploadParallel.Init(base.mgr)
+
+	p.UploadResumeAttempts = ParamItem{
+		Key:          "indexNode.upload.resumeAttempts",
+		Version:      "2.3.0",
+		DefaultValue: "3",
+	}
+	p.UploadResumeAttempts.Init(base.mgr)
+
+	p.UploadCodec = ParamItem{
+		Key:          "indexNode.upload.codec",
+		Version:      "2.3.0",
+		DefaultValue: "",
+	}
+	p.UploadCodec.Init(base.mgr)
+
+	p.BuildTuneEnable = ParamItem{
+		Key:          "indexNode.scheduler.autoTune.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.BuildTuneEnable.Init(base.mgr)
+
+	p.BuildTuneTargetCPUUsage = ParamItem{
+		Key:          "indexNode.scheduler.autoTune.targetCPUUsage",
+		Version:      "2.3.0",
+		DefaultValue: "80",
+	}
+	p.BuildTuneTargetCPUUsage.Init(base.mgr)
+
+	p.BuildTuneMaxParallel = ParamItem{
+		Key:          "indexNode.scheduler.autoTune.maxBuildParallel",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+	}
+	p.BuildTuneMaxParallel.Init(base.mgr)
+
+	p.BuildTuneInterval = ParamItem{
+		Key:          "indexNode.scheduler.autoTune.interval",
+		Version:      "2.3.0",
+		DefaultValue: "10",
+	}
+	p.BuildTuneInterval.Init(base.mgr)
+
+	p.BuildTuneHeadroom = ParamItem{
+		Key:          "indexNode.scheduler.autoTune.standaloneHeadroom",
+		Version:      "2.3.0",
+		DefaultValue: "20",
+	}
+	p.BuildTuneHeadroom.Init(base.mgr)
+
+	p.BuildResultCacheEnable = ParamItem{
+		Key:          "indexNode.buildResultCache.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.BuildResultCacheEnable.Init(base.mgr)
+
+	p.BuildResultCacheCapacity = ParamItem{
+		Key:          "indexNode.buildResultCache.capacity",
+		Version:      "2.3.0",
+		DefaultValue: "1024",
+	}
+	p.BuildResultCacheCapacity.Init(base.mgr)
+
+	p.BuildResultCacheTTL = ParamItem{
+		Key:          "indexNode.buildResultCache.ttl",
+		Version:      "2.3.0",
+		DefaultValue: "86400",
+	}
+	p.BuildResultCacheTTL.Init(base.mgr)
+
+	p.ResultCallbackEnable = ParamItem{
+		Key:          "indexNode.resultCallback.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.ResultCallbackEnable.Init(base.mgr)
+
+	p.ResultCallbackRetryTimes = ParamItem{
+		Key:          "indexNode.resultCallback.retryTimes",
+		Version:      "2.3.0",
+		DefaultValue: "5",
+	}
+	p.ResultCallbackRetryTimes.Init(base.mgr)
+
+	p.ReconcileEnable = ParamItem{
+		Key:          "indexNode.reconcile.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.ReconcileEnable.Init(base.mgr)
+
+	p.AuditEnable = ParamItem{
+		Key:          "indexNode.audit.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.AuditEnable.Init(base.mgr)
+
+	p.AuditPathPrefix = ParamItem{
+		Key:          "indexNode.audit.pathPrefix",
+		Version:      "2.3.0",
+		DefaultValue: "index_audit",
+	}
+	p.AuditPathPrefix.Init(base.mgr)
+
+	p.MaxTaskLifetime = ParamItem{
+		Key:          "indexNode.scheduler.maxTaskLifetime",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+	}
+	p.MaxTaskLifetime.Init(base.mgr)
+
+	p.FaultInjectionEnable = ParamItem{
+		Key:          "indexNode.faultInjection.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.FaultInjectionEnable.Init(base.mgr)
+
+	p.FaultInjectionSeed = ParamItem{
+		Key:          "indexNode.faultInjection.seed",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+	}
+	p.FaultInjectionSeed.Init(base.mgr)
+
+	p.FaultInjectionStorageLatency = ParamItem{
+		Key:          "indexNode.faultInjection.storage.latency",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+	}
+	p.FaultInjectionStorageLatency.Init(base.mgr)
+
+	p.FaultInjectionStorageErrorRate = ParamItem{
+		Key:          "indexNode.faultInjection.storage.errorRate",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+	}
+	p.FaultInjectionStorageErrorRate.Init(base.mgr)
+
+	p.FaultInjectionStoragePartialWriteRate = ParamItem{
+		Key:          "indexNode.faultInjection.storage.partialWriteRate",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+	}
+	p.FaultInjectionStoragePartialWriteRate.Init(base.mgr)
+
+	p.FaultInjectionSchedulerMaxDelay = ParamItem{
+		Key:          "indexNode.faultInjection.scheduler.maxDelay",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+	}
+	p.FaultInjectionSchedulerMaxDelay.Init(base.mgr)
+
+	p.StorageTenantRequestRate = ParamItem{
+		Key:          "indexNode.storage.tenantRequestRate",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+	}
+	p.StorageTenantRequestRate.Init(base.mgr)
+
+	p.BruteForceRowThreshold = ParamItem{
+		Key:          "indexNode.bruteForceRowThreshold",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+	}
+	p.BruteForceRowThreshold.Init(base.mgr)
+
+	p.SchedulerTenantWeights = ParamItem{
+		Key:          "indexNode.scheduler.tenantWeights",
+		Version:      "2.3.0",
+		DefaultValue: "{}",
+	}
+	p.SchedulerTenantWeights.Init(base.mgr)
+
+	p.SchedulerIndexTypeParallel = ParamItem{
+		Key:          "indexNode.scheduler.indexTypeParallel",
+		Version:      "2.3.0",
+		DefaultValue: "{}",
+	}
+	p.SchedulerIndexTypeParallel.Init(base.mgr)
+
+	p.MaintenanceWindows = ParamItem{
+		Key:          "indexNode.maintenance.windows",
+		Version:      "2.3.0",
+		DefaultValue: "",
+	}
+	p.MaintenanceWindows.Init(base.mgr)
+
+	p.MaintenanceAcceptHighPriority = ParamItem{
+		Key:          "indexNode.maintenance.acceptHighPriority",
+		Version:      "2.3.0",
+		DefaultValue: "true",
+	}
+	p.MaintenanceAcceptHighPriority.Init(base.mgr)
+
+	p.JournalEnable = ParamItem{
+		Key:          "indexNode.journal.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.JournalEnable.Init(base.mgr)
+
+	p.JournalPath = ParamItem{
+		Key:          "indexNode.journal.path",
+		Version:      "2.3.0",
+		DefaultValue: "",
+	}
+	p.JournalPath.Init(base.mgr)
+
+	p.JournalMaxSize = ParamItem{
+		Key:          "indexNode.journal.maxSize",
+		Version:      "2.3.0",
+		DefaultValue: "16",
+	}
+	p.JournalMaxSize.Init(base.mgr)
+
+	p.FlatFastPathEnable = ParamItem{
+		Key:          "indexNode.flatFastPath.enable",
+		Version:      "2.3.0",
+		DefaultValue: "true",
+	}
+	p.FlatFastPathEnable.Init(base.mgr)
+
+	p.HandoffEnable = ParamItem{
+		Key:          "indexNode.handoff.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.HandoffEnable.Init(base.mgr)
+
+	p.StagingDirs = ParamItem{
+		Key:          "indexNode.staging.dirs",
+		Version:      "2.3.0",
+		DefaultValue: "",
+	}
+	p.StagingDirs.Init(base.mgr)
+
+	p.StagingProbeInterval = ParamItem{
+		Key:          "indexNode.staging.probeInterval",
+		Version:      "2.3.0",
+		DefaultValue: "30",
+	}
+	p.StagingProbeInterval.Init(base.mgr)
+
+	p.NonFiniteVectorPolicy = ParamItem{
+		Key:          "indexNode.nonFiniteVector.policy",
+		Version:      "2.3.0",
+		DefaultValue: "fail",
+	}
+	p.NonFiniteVectorPolicy.Init(base.mgr)
+
+	p.RowCountCheckMode = ParamItem{
+		Key:          "indexNode.rowCountCheck.mode",
+		Version:      "2.3.0",
+		DefaultValue: "strict",
+	}
+	p.RowCountCheckMode.Init(base.mgr)
+
+	p.BinlogSourceEnable = ParamItem{
+		Key:          "indexNode.binlogSource.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.BinlogSourceEnable.Init(base.mgr)
+
+	p.BinlogSourceTimeout = ParamItem{
+		Key:          "indexNode.binlogSource.timeout",
+		Version:      "2.3.0",
+		DefaultValue: "10",
+	}
+	p.BinlogSourceTimeout.Init(base.mgr)
+
+	p.ColdRestoreDays = ParamItem{
+		Key:          "indexNode.coldStorage.restoreDays",
+		Version:      "2.3.0",
+		DefaultValue: "1",
+	}
+	p.ColdRestoreDays.Init(base.mgr)
+
+	p.ColdRestoreTier = ParamItem{
+		Key:          "indexNode.coldStorage.restoreTier",
+		Version:      "2.3.0",
+		DefaultValue: "Standard",
+	}
+	p.ColdRestoreTier.Init(base.mgr)
+
+	p.ColdRestorePollInterval = ParamItem{
+		Key:          "indexNode.coldStorage.pollInterval",
+		Version:      "2.3.0",
+		DefaultValue: "60",
+	}
+	p.ColdRestorePollInterval.Init(base.mgr)
+
+	p.ColdRestoreTimeout = ParamItem{
+		Key:          "indexNode.coldStorage.restoreTimeout",
+		Version:      "2.3.0",
+		DefaultValue: "43200",
+	}
+	p.ColdRestoreTimeout.Init(base.mgr)
+
+	p.ScratchEncryptionEnable = ParamItem{
+		Key:          "indexNode.scratchEncryption.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.ScratchEncryptionEnable.Init(base.mgr)
+
+	p.ConfigGuardEnable = ParamItem{
+		Key:          "indexNode.configGuard.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.ConfigGuardEnable.Init(base.mgr)
+
+	p.ConfigGuardInterval = ParamItem{
+		Key:          "indexNode.configGuard.interval",
+		Version:      "2.3.0",
+		DefaultValue: "10",
+	}
+	p.ConfigGuardInterval.Init(base.mgr)
+
+	p.ConfigGuardObserveWindow = ParamItem{
+		Key:          "indexNode.configGuard.observeWindow",
+		Version:      "2.3.0",
+		DefaultValue: "600",
+	}
+	p.ConfigGuardObserveWindow.Init(base.mgr)
+
+	p.ConfigGuardFailureRatio = ParamItem{
+		Key:          "indexNode.configGuard.failureRatio",
+		Version:      "2.3.0",
+		DefaultValue: "0.5",
+	}
+	p.ConfigGuardFailureRatio.Init(base.mgr)
+
+	p.ConfigGuardMemoryRatio = ParamItem{
+		Key:          "indexNode.configGuard.memoryRatio",
+		Version:      "2.3.0",
+		DefaultValue: "0.5",
+	}
+	p.ConfigGuardMemoryRatio.Init(base.mgr)
+
+	p.MetricsTaskLabels = ParamItem{
+		Key:          "indexNode.metrics.taskLabels",
+		Version:      "2.3.0",
+		DefaultValue: "",
+	}
+	p.MetricsTaskLabels.Init(base.mgr)
+
+	p.MetricsMaxLabelValues = ParamItem{
+		Key:          "indexNode.metrics.maxLabelValues",
+		Version:      "2.3.0",
+		DefaultValue: "100",
+	}
+	p.MetricsMaxLabelValues.Init(base.mgr)
+
+	p.ReplicationReconcileInterval = ParamItem{
+		Key:          "indexNode.replication.reconcileInterval",
+		Version:      "2.3.0",
+		DefaultValue: "60",
+	}
+	p.ReplicationReconcileInterval.Init(base.mgr)
+
+	p.ReplicationMaxAttempts = ParamItem{
+		Key:          "indexNode.replication.maxAttempts",
+		Version:      "2.3.0",
+		DefaultValue: "10",
+	}
+	p.ReplicationMaxAttempts.Init(base.mgr)
+
+	p.FlightPort = ParamItem{
+		Key:          "indexNode.flight.port",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+	}
+	p.FlightPort.Init(base.mgr)
+
+	p.FlightBatchRows = ParamItem{
+		Key:          "indexNode.flight.batchRows",
+		Version:      "2.3.0",
+		DefaultValue: "1024",
+	}
+	p.FlightBatchRows.Init(base.mgr)
+
+	p.SandboxEnable = ParamItem{
+		Key:          "indexNode.sandbox.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.SandboxEnable.Init(base.mgr)
+
+	p.SandboxMaxAddressSpace = ParamItem{
+		Key:          "indexNode.sandbox.maxAddressSpace",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+	}
+	p.SandboxMaxAddressSpace.Init(base.mgr)
+
+	p.SandboxMaxOpenFiles = ParamItem{
+		Key:          "indexNode.sandbox.maxOpenFiles",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+	}
+	p.SandboxMaxOpenFiles.Init(base.mgr)
+
+	p.SandboxMaxCPUTime = ParamItem{
+		Key:          "indexNode.sandbox.maxCPUTime",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+	}
+	p.SandboxMaxCPUTime.Init(base.mgr)
+
+	p.SandboxWorkers = ParamItem{
+		Key:          "indexNode.sandbox.workers",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+	}
+	p.SandboxWorkers.Init(base.mgr)
+
+	p.AutoIndexPolicy = ParamItem{
+		Key:          "indexNode.autoIndex.policy",
+		Version:      "2.3.0",
+		DefaultValue: "default",
+	}
+	p.AutoIndexPolicy.Init(base.mgr)
+
+	p.DatasetCacheCapacity = ParamItem{
+		Key:          "indexNode.datasetCache.capacity",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+	}
+	p.DatasetCacheCapacity.Init(base.mgr)
+
+	p.DatasetCacheTTL = ParamItem{
+		Key:          "indexNode.datasetCache.ttl",
+		Version:      "2.3.0",
+		DefaultValue: "600",
+	}
+	p.DatasetCacheTTL.Init(base.mgr)
+
+	p.TaskRetentionTTL = ParamItem{
+		Key:          "indexNode.taskRetention.ttl",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+	}
+	p.TaskRetentionTTL.Init(base.mgr)
+
+	p.TaskRetentionMaxCount = ParamItem{
+		Key:          "indexNode.taskRetention.maxCount",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+	}
+	p.TaskRetentionMaxCount.Init(base.mgr)
+
+	p.CollectionDropWatchEnable = ParamItem{
+		Key:          "indexNode.collectionDropWatch.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.CollectionDropWatchEnable.Init(base.mgr)
+
+	p.IOMetaConcurrency = ParamItem{
+		Key:          "indexNode.io.metaConcurrency",
+		Version:      "2.3.0",
+		DefaultValue: "16",
+	}
+	p.IOMetaConcurrency.Init(base.mgr)
+
+	p.IOStorageConcurrency = ParamItem{
+		Key:          "indexNode.io.storageConcurrency",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+	}
+	p.IOStorageConcurrency.Init(base.mgr)
+
+	p.GrpcInterceptors = ParamItem{
+		Key:          "indexNode.grpc.interceptors",
+		Version:      "2.3.0",
+		DefaultValue: "recovery",
+	}
+	p.GrpcInterceptors.Init(base.mgr)
+
+	p.GrpcAuthToken = ParamItem{
+		Key:          "indexNode.grpc.authToken",
+		Version:      "2.3.0",
+		DefaultValue: "",
+	}
+	p.GrpcAuthToken.Init(base.mgr)
+
+	p.GrpcRateLimits = ParamItem{
+		Key:          "indexNode.grpc.rateLimits",
+		Version:      "2.3.0",
+		DefaultValue: "{}",
+	}
+	p.GrpcRateLimits.Init(base.mgr)
+
+	p.HookSoPath = ParamItem{
+		Key:          "indexNode.hook.soPath",
+		Version:      "2.3.0",
+		DefaultValue: "",
+	}
+	p.HookSoPath.Init(base.mgr)
+
+	p.ManifestSigningAlgorithm = ParamItem{
+		Key:          "indexNode.manifestSigning.algorithm",
+		Version:      "2.3.0",
+		DefaultValue: "",
+	}
+	p.ManifestSigningAlgorithm.Init(base.mgr)
+
+	p.ManifestSigningKey = ParamItem{
+		Key:          "indexNode.manifestSigning.key",
+		Version:      "2.3.0",
+		DefaultValue: "",
+	}
+	p.ManifestSigningKey.Init(base.mgr)
+
+	p.CPUAffinityEnable = ParamItem{
+		Key:          "indexNode.cpuAffinity.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.CPUAffinityEnable.Init(base.mgr)
+
+	p.CPUAffinityCPUSet = ParamItem{
+		Key:          "indexNode.cpuAffinity.cpuSet",
+		Version:      "2.3.0",
+		DefaultValue: "",
+	}
+	p.CPUAffinityCPUSet.Init(base.mgr)
+
+	p.BuildWatchdogStallTimeout = ParamItem{
+		Key:          "indexNode.buildWatchdog.stallTimeout",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+	}
+	p.BuildWatchdogStallTimeout.Init(base.mgr)
+
+	p.BuildWatchdogAbort = ParamItem{
+		Key:          "indexNode.buildWatchdog.abort",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.BuildWatchdogAbort.Init(base.mgr)
+
+	p.StorageHedgeEnable = ParamItem{
+		Key:          "indexNode.storage.hedge.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.StorageHedgeEnable.Init(base.mgr)
+
+	p.StorageHedgeBudgetRatio = ParamItem{
+		Key:          "indexNode.storage.hedge.budgetRatio",
+		Version:      "2.3.0",
+		DefaultValue: "0.05",
+	}
+	p.StorageHedgeBudgetRatio.Init(base.mgr)
+
+	p.StorageHedgeMinDelay = ParamItem{
+		Key:          "indexNode.storage.hedge.minDelay",
+		Version:      "2.3.0",
+		DefaultValue: "50",
+	}
+	p.StorageHedgeMinDelay.Init(base.mgr)
+
+	p.ArenaStages = ParamItem{
+		Key:          "indexNode.arena.stages",
+		Version:      "2.3.0",
+		DefaultValue: "",
+	}
+	p.ArenaStages.Init(base.mgr)
+
+	p.ArenaChunkSize = ParamItem{
+		Key:          "indexNode.arena.chunkSize",
+		Version:      "2.3.0",
+		DefaultValue: "64",
+	}
+	p.ArenaChunkSize.Init(base.mgr)
+
+	p.UploadChunkedSerialize = ParamItem{
+		Key:          "indexNode.upload.chunkedSerialize",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.UploadChunkedSerialize.Init(base.mgr)
+
+	p.UploadPriorityEnable = ParamItem{
+		Key:          "indexNode.upload.priority.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.UploadPriorityEnable.Init(base.mgr)
+
+	p.UploadPriorityMaxConcurrent = ParamItem{
+		Key:          "indexNode.upload.priority.maxConcurrent",
+		Version:      "2.3.0",
+		DefaultValue: "4",
+	}
+	p.UploadPriorityMaxConcurrent.Init(base.mgr)
+
+	p.WarmupEnable = ParamItem{
+		Key:          "indexNode.warmup.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.WarmupEnable.Init(base.mgr)
+
+	p.WarmupBuildRows = ParamItem{
+		Key:          "indexNode.warmup.buildRows",
+		Version:      "2.3.0",
+		DefaultValue: "10000",
+	}
+	p.WarmupBuildRows.Init(base.mgr)
+
+	p.JobLogEnable = ParamItem{
+		Key:          "indexNode.jobLog.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.JobLogEnable.Init(base.mgr)
+
+	p.JobLogMaxEntries = ParamItem{
+		Key:          "indexNode.jobLog.maxEntries",
+		Version:      "2.3.0",
+		DefaultValue: "1000",
+	}
+	p.JobLogMaxEntries.Init(base.mgr)
+
+	p.DecodeWorkers = ParamItem{
+		Key:          "indexNode.decode.workers",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+	}
+	p.DecodeWorkers.Init(base.mgr)
+
+	p.DecodeQueueSize = ParamItem{
+		Key:          "indexNode.decode.queueSize",
+		Version:      "2.3.0",
+		DefaultValue: "16",
+	}
+	p.DecodeQueueSize.Init(base.mgr)
+
+	p.ParamProfiles = ParamItem{
+		Key:          "indexNode.paramProfiles",
+		Version:      "2.3.0",
+		DefaultValue: "{}",
+	}
+	p.ParamProfiles.Init(base.mgr)
+
+	p.LimitMaxDim = ParamItem{
+		Key:          "indexNode.limits.maxDim",
+		Version:      "2.3.0",
+		DefaultValue: "32768",
+	}
+	p.LimitMaxDim.Init(base.mgr)
+
+	p.LimitMaxRows = ParamItem{
+		Key:          "indexNode.limits.maxRows",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+	}
+	p.LimitMaxRows.Init(base.mgr)
+
+	p.LimitIndexTypes = ParamItem{
+		Key:          "indexNode.limits.indexTypes",
+		Version:      "2.3.0",
+		DefaultValue: "{}",
+	}
+	p.LimitIndexTypes.Init(base.mgr)
+
+	p.LogLevelMaxDuration = ParamItem{
+		Key:          "indexNode.logLevel.maxDuration",
+		Version:      "2.3.0",
+		DefaultValue: "3600",
+	}
+	p.LogLevelMaxDuration.Init(base.mgr)
+
+	p.Zone = ParamItem{
+		Key:          "indexNode.zone",
+		Version:      "2.3.0",
+		DefaultValue: "",
+	}
+	p.Zone.Init(base.mgr)
+
+	p.DiagnosticsEnable = ParamItem{
+		Key:          "indexNode.diagnostics.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.DiagnosticsEnable.Init(base.mgr)
+
+	p.DiagnosticsPathPrefix = ParamItem{
+		Key:          "indexNode.diagnostics.pathPrefix",
+		Version:      "2.3.0",
+		DefaultValue: "index_diagnostics",
+	}
+	p.DiagnosticsPathPrefix.Init(base.mgr)
+
+	p.SchedulerWaitTarget = ParamItem{
+		Key:          "indexNode.scheduler.waitTarget",
+		Version:      "2.3.0",
+		DefaultValue: "60",
+	}
+	p.SchedulerWaitTarget.Init(base.mgr)
+
+	p.SchedulerEnqueueTimeout = ParamItem{
+		Key:          "indexNode.scheduler.enqueueTimeout",
+		Version:      "2.3.0",
+		DefaultValue: "0",
+	}
+	p.SchedulerEnqueueTimeout.Init(base.mgr)
+
+	p.DecisionLogEnable = ParamItem{
+		Key:          "indexNode.scheduler.decisionLog.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.DecisionLogEnable.Init(base.mgr)
+
+	p.DecisionLogPath = ParamItem{
+		Key:          "indexNode.scheduler.decisionLog.path",
+		Version:      "2.3.0",
+		DefaultValue: "",
+	}
+	p.DecisionLogPath.Init(base.mgr)
+
+	p.DecisionLogMaxSize = ParamItem{
+		Key:          "indexNode.scheduler.decisionLog.maxSize",
+		Version:      "2.3.0",
+		DefaultValue: "64",
+	}
+	p.DecisionLogMaxSize.Init(base.mgr)
+
+	p.AutoscaleMinHeadroom = ParamItem{
+		Key:          "indexNode.autoscale.minHeadroom",
+		Version:      "2.3.0",
+		DefaultValue: "10",
+	}
+	p.AutoscaleMinHeadroom.Init(base.mgr)
+
+	p.MemoryPressureEnable = ParamItem{
+		Key:          "indexNode.memoryPressure.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.MemoryPressureEnable.Init(base.mgr)
+
+	p.MemoryPressureThreshold = ParamItem{
+		Key:          "indexNode.memoryPressure.threshold",
+		Version:      "2.3.0",
+		DefaultValue: "40",
+	}
+	p.MemoryPressureThreshold.Init(base.mgr)
+
+	p.MemoryPressureSustain = ParamItem{
+		Key:          "indexNode.memoryPressure.sustain",
+		Version:      "2.3.0",
+		DefaultValue: "30",
+	}
+	p.MemoryPressureSustain.Init(base.mgr)
+
+	p.MemoryPressureInterval = ParamItem{
+		Key:          "indexNode.memoryPressure.interval",
+		Version:      "2.3.0",
+		DefaultValue: "5",
+	}
+	p.MemoryPressureInterval.Init(base.mgr)
+
+	p.RebuildDeleteDelay = ParamItem{
+		Key:          "indexNode.rebuild.deleteDelay",
+		Version:      "2.3.0",
+		DefaultValue: "600",
+	}
+	p.RebuildDeleteDelay.Init(base.mgr)
+
+	p.KnowhereLogLevel = ParamItem{
+		Key:          "indexNode.knowhereLog.level",
+		Version:      "2.3.0",
+		DefaultValue: "info",
+	}
+	p.KnowhereLogLevel.Init(base.mgr)
+
+	p.KnowhereLogModuleLevels = ParamItem{
+		Key:          "indexNode.knowhereLog.moduleLevels",
+		Version:      "2.3.0",
+		DefaultValue: "{}",
+	}
+	p.KnowhereLogModuleLevels.Init(base.mgr)
+
+	p.KnowhereLogFile = ParamItem{
+		Key:          "indexNode.knowhereLog.file",
+		Version:      "2.3.0",
+		DefaultValue: "",
+	}
+	p.KnowhereLogFile.Init(base.mgr)
+
+	p.KnowhereLogMaxSize = ParamItem{
+		Key:          "indexNode.knowhereLog.maxSize",
+		Version:      "2.3.0",
+		DefaultValue: "200",
+	}
+	p.KnowhereLogMaxSize.Init(base.mgr)
+
+	p.KnowhereLogMaxAge = ParamItem{
+		Key:          "indexNode.knowhereLog.maxAge",
+		Version:      "2.3.0",
+		DefaultValue: "7",
+	}
+	p.KnowhereLogMaxAge.Init(base.mgr)
+
+	p.CGOMemTraceEnable = ParamItem{
+		Key:          "indexNode.cgoMemTrace.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.CGOMemTraceEnable.Init(base.mgr)
+
+	p.SuspendEnable = ParamItem{
+		Key:          "indexNode.suspend.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.SuspendEnable.Init(base.mgr)
+
+	p.ReservationTTL = ParamItem{
+		Key:          "indexNode.reservation.ttl",
+		Version:      "2.3.0",
+		DefaultValue: "30",
+	}
+	p.ReservationTTL.Init(base.mgr)
+
+	p.BackgroundBuildThreads = ParamItem{
+		Key:          "indexNode.background.buildThreads",
+		Version:      "2.3.0",
+		DefaultValue: "1",
+	}
+	p.BackgroundBuildThreads.Init(base.mgr)
+
+	p.BackgroundNice = ParamItem{
+		Key:          "indexNode.background.nice",
+		Version:      "2.3.0",
+		DefaultValue: "19",
+	}
+	p.BackgroundNice.Init(base.mgr)
+
+	p.IOPriorityEnable = ParamItem{
+		Key:          "indexNode.ioPriority.enable",
+		Version:      "2.3.0",
+		DefaultValue: "false",
+	}
+	p.IOPriorityEnable.Init(base.mgr)
+
+	p.IOPriorityCgroup = ParamItem{
+		Key:          "indexNode.ioPriority.cgroup",
+		Version:      "2.3.0",
+		DefaultValue: "",
+	}
+	p.IOPriorityCgroup.Init(base.mgr)
+
+	p.IOPriorityForeground = ParamItem{
+		Key:          "indexNode.ioPriority.foreground",
+		Version:      "2.3.0",
+		DefaultValue: "100",
+	}
+	p.IOPriorityForeground.Init(base.mgr)
+
+	p.IOPriorityBackground = ParamItem{
+		Key:          "indexNode.ioPriority.background",
+		Version:      "2.3.0",
+		DefaultValue: "10",
+	}
+	p.IOPriorityBackground.Init(base.mgr)
 }
 
 type integrationTestConfig struct {
@@ -125,6 +125,7 @@ func TestComponentParam(t *testing.T) {
 		t.Logf("default session TTL time = %d", Params.SessionTTL.GetAsInt64())
 		assert.Equal(t, Params.SessionRetryTimes.GetAsInt64(), int64(DefaultSessionRetryTimes))
 		t.Logf("default session retry times = %d", Params.SessionRetryTimes.GetAsInt64())
+		assert.Equal(t, "", Params.IndexFileCodecs.GetValue())
 
 		params.Save("common.security.superUsers", "super1,super2,super3")
 		assert.Equal(t, []string{"super1", "super2", "super3"}, Params.SuperUsers.GetAsStrings())
@@ -333,6 +334,8 @@ func TestComponentParam(t *testing.T) {
 		assert.True(t, Params.EnableGarbageCollection.GetAsBool())
 		assert.Equal(t, Params.EnableActiveStandby.GetAsBool(), false)
 		t.Logf("dataCoord EnableActiveStandby = %t", Params.EnableActiveStandby.GetAsBool())
+		assert.False(t, Params.PresignedURLEnable.GetAsBool())
+		assert.Equal(t, time.Hour, Params.PresignedURLExpiry.GetAsDuration(time.Second))
 	})
 
 	t.Run("test dataNodeConfig", func(t *testing.T) {
@@ -355,12 +358,163 @@ func TestComponentParam(t *testing.T) {
 		period := Params.SyncPeriod
 		t.Logf("SyncPeriod: %v", period)
 		assert.Equal(t, 10*time.Minute, Params.SyncPeriod.GetAsDuration(time.Second))
+
+		assert.False(t, Params.BinlogCacheEnable.GetAsBool())
+		assert.Equal(t, 256, Params.BinlogCacheCapacity.GetAsInt())
+		assert.Equal(t, 10*time.Minute, Params.BinlogCacheTTL.GetAsDuration(time.Second))
 	})
 
 	t.Run("test indexNodeConfig", func(t *testing.T) {
 		Params := params.IndexNodeCfg
 		params.Save(Params.GracefulStopTimeout.Key, "50")
 		assert.Equal(t, Params.GracefulStopTimeout.GetAsInt64(), int64(50))
+
+		assert.Equal(t, 0, Params.UploadParallel.GetAsInt())
+		assert.Equal(t, 3, Params.UploadResumeAttempts.GetAsInt())
+		assert.Equal(t, "", Params.UploadCodec.GetValue())
+
+		assert.False(t, Params.BuildResultCacheEnable.GetAsBool())
+		assert.Equal(t, 1024, Params.BuildResultCacheCapacity.GetAsInt())
+		assert.Equal(t, 24*time.Hour, Params.BuildResultCacheTTL.GetAsDuration(time.Second))
+
+		assert.False(t, Params.ResultCallbackEnable.GetAsBool())
+		assert.Equal(t, 5, Params.ResultCallbackRetryTimes.GetAsInt())
+		assert.False(t, Params.ReconcileEnable.GetAsBool())
+
+		assert.False(t, Params.AuditEnable.GetAsBool())
+		assert.Equal(t, "index_audit", Params.AuditPathPrefix.GetValue())
+
+		assert.Equal(t, 0, Params.MaxTaskLifetime.GetAsInt())
+		assert.False(t, Params.FaultInjectionEnable.GetAsBool())
+		assert.Equal(t, int64(0), Params.FaultInjectionSeed.GetAsInt64())
+		assert.Equal(t, 0, Params.FaultInjectionStorageLatency.GetAsInt())
+		assert.Equal(t, 0.0, Params.FaultInjectionStorageErrorRate.GetAsFloat())
+		assert.Equal(t, 0.0, Params.FaultInjectionStoragePartialWriteRate.GetAsFloat())
+		assert.Equal(t, 0, Params.FaultInjectionSchedulerMaxDelay.GetAsInt())
+		assert.Equal(t, float64(0), Params.StorageTenantRequestRate.GetAsFloat())
+		assert.Equal(t, int64(0), Params.BruteForceRowThreshold.GetAsInt64())
+		assert.Empty(t, Params.SchedulerTenantWeights.GetAsJSONMap())
+		assert.Empty(t, Params.SchedulerIndexTypeParallel.GetAsJSONMap())
+		assert.Equal(t, "", Params.MaintenanceWindows.GetValue())
+		assert.True(t, Params.MaintenanceAcceptHighPriority.GetAsBool())
+		assert.False(t, Params.JournalEnable.GetAsBool())
+		assert.Equal(t, "", Params.JournalPath.GetValue())
+		assert.Equal(t, int64(16), Params.JournalMaxSize.GetAsInt64())
+		assert.True(t, Params.FlatFastPathEnable.GetAsBool())
+		assert.False(t, Params.HandoffEnable.GetAsBool())
+		assert.Equal(t, "", Params.StagingDirs.GetValue())
+		assert.Equal(t, 30*time.Second, Params.StagingProbeInterval.GetAsDuration(time.Second))
+		assert.Equal(t, "fail", Params.NonFiniteVectorPolicy.GetValue())
+		assert.Equal(t, "strict", Params.RowCountCheckMode.GetValue())
+		assert.False(t, Params.BinlogSourceEnable.GetAsBool())
+		assert.Equal(t, 10*time.Second, Params.BinlogSourceTimeout.GetAsDuration(time.Second))
+		assert.Equal(t, 1, Params.ColdRestoreDays.GetAsInt())
+		assert.Equal(t, "Standard", Params.ColdRestoreTier.GetValue())
+		assert.Equal(t, time.Minute, Params.ColdRestorePollInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 12*time.Hour, Params.ColdRestoreTimeout.GetAsDuration(time.Second))
+		assert.False(t, Params.ScratchEncryptionEnable.GetAsBool())
+		assert.False(t, Params.ConfigGuardEnable.GetAsBool())
+		assert.Equal(t, 10*time.Second, Params.ConfigGuardInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 10*time.Minute, Params.ConfigGuardObserveWindow.GetAsDuration(time.Second))
+		assert.Equal(t, 0.5, Params.ConfigGuardFailureRatio.GetAsFloat())
+		assert.Equal(t, 0.5, Params.ConfigGuardMemoryRatio.GetAsFloat())
+		assert.Equal(t, "", Params.MetricsTaskLabels.GetValue())
+		assert.Equal(t, 100, Params.MetricsMaxLabelValues.GetAsInt())
+		assert.Equal(t, time.Minute, Params.ReplicationReconcileInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 10, Params.ReplicationMaxAttempts.GetAsInt())
+		assert.Equal(t, 0, Params.FlightPort.GetAsInt())
+		assert.Equal(t, 1024, Params.FlightBatchRows.GetAsInt())
+		assert.False(t, Params.SandboxEnable.GetAsBool())
+		assert.Equal(t, int64(0), Params.SandboxMaxAddressSpace.GetAsInt64())
+		assert.Equal(t, int64(0), Params.SandboxMaxOpenFiles.GetAsInt64())
+		assert.Equal(t, int64(0), Params.SandboxMaxCPUTime.GetAsInt64())
+		assert.Equal(t, 0, Params.SandboxWorkers.GetAsInt())
+		assert.Equal(t, "default", Params.AutoIndexPolicy.GetValue())
+		assert.Equal(t, int64(0), Params.DatasetCacheCapacity.GetAsInt64())
+		assert.Equal(t, 600*time.Second, Params.DatasetCacheTTL.GetAsDuration(time.Second))
+		assert.Equal(t, time.Duration(0), Params.TaskRetentionTTL.GetAsDuration(time.Second))
+		assert.Equal(t, 0, Params.TaskRetentionMaxCount.GetAsInt())
+		assert.False(t, Params.CollectionDropWatchEnable.GetAsBool())
+		assert.Equal(t, 16, Params.IOMetaConcurrency.GetAsInt())
+		assert.Equal(t, 0, Params.IOStorageConcurrency.GetAsInt())
+		assert.Equal(t, []string{"recovery"}, Params.GrpcInterceptors.GetAsStrings())
+		assert.Equal(t, "", Params.GrpcAuthToken.GetValue())
+		assert.Empty(t, Params.GrpcRateLimits.GetAsJSONMap())
+		assert.Equal(t, "", Params.HookSoPath.GetValue())
+		assert.Equal(t, "", Params.ManifestSigningAlgorithm.GetValue())
+		assert.Equal(t, "", Params.ManifestSigningKey.GetValue())
+		assert.False(t, Params.CPUAffinityEnable.GetAsBool())
+		assert.Equal(t, "", Params.CPUAffinityCPUSet.GetValue())
+		assert.Equal(t, 0, Params.BuildWatchdogStallTimeout.GetAsInt())
+		assert.False(t, Params.BuildWatchdogAbort.GetAsBool())
+		assert.False(t, Params.StorageHedgeEnable.GetAsBool())
+		assert.Equal(t, 0.05, Params.StorageHedgeBudgetRatio.GetAsFloat())
+		assert.Equal(t, 50, Params.StorageHedgeMinDelay.GetAsInt())
+		assert.Equal(t, "", Params.ArenaStages.GetValue())
+		assert.Equal(t, 64, Params.ArenaChunkSize.GetAsInt())
+		assert.False(t, Params.UploadChunkedSerialize.GetAsBool())
+		assert.False(t, Params.UploadPriorityEnable.GetAsBool())
+		assert.Equal(t, 4, Params.UploadPriorityMaxConcurrent.GetAsInt())
+		assert.False(t, Params.WarmupEnable.GetAsBool())
+		assert.Equal(t, 10000, Params.WarmupBuildRows.GetAsInt())
+		assert.False(t, Params.JobLogEnable.GetAsBool())
+		assert.Equal(t, 1000, Params.JobLogMaxEntries.GetAsInt())
+		assert.Equal(t, 0, Params.DecodeWorkers.GetAsInt())
+		assert.Equal(t, 16, Params.DecodeQueueSize.GetAsInt())
+		assert.Equal(t, "{}", Params.ParamProfiles.GetValue())
+		assert.Equal(t, int64(32768), Params.LimitMaxDim.GetAsInt64())
+		assert.Equal(t, int64(0), Params.LimitMaxRows.GetAsInt64())
+		assert.Equal(t, "{}", Params.LimitIndexTypes.GetValue())
+		assert.Equal(t, time.Hour, Params.LogLevelMaxDuration.GetAsDuration(time.Second))
+		assert.Equal(t, "", Params.Zone.GetValue())
+		assert.False(t, Params.DiagnosticsEnable.GetAsBool())
+		assert.Equal(t, "index_diagnostics", Params.DiagnosticsPathPrefix.GetValue())
+		assert.Equal(t, time.Minute, Params.SchedulerWaitTarget.GetAsDuration(time.Second))
+		assert.Equal(t, time.Duration(0), Params.SchedulerEnqueueTimeout.GetAsDuration(time.Millisecond))
+		assert.False(t, Params.DecisionLogEnable.GetAsBool())
+		assert.Equal(t, "", Params.DecisionLogPath.GetValue())
+		assert.Equal(t, int64(64), Params.DecisionLogMaxSize.GetAsInt64())
+		assert.Equal(t, 10.0, Params.AutoscaleMinHeadroom.GetAsFloat())
+		assert.False(t, Params.MemoryPressureEnable.GetAsBool())
+		assert.Equal(t, 40.0, Params.MemoryPressureThreshold.GetAsFloat())
+		assert.Equal(t, 30*time.Second, Params.MemoryPressureSustain.GetAsDuration(time.Second))
+		assert.Equal(t, 5*time.Second, Params.MemoryPressureInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 10*time.Minute, Params.RebuildDeleteDelay.GetAsDuration(time.Second))
+		assert.Equal(t, "info", Params.KnowhereLogLevel.GetValue())
+		assert.Empty(t, Params.KnowhereLogModuleLevels.GetAsJSONMap())
+		assert.Equal(t, "", Params.KnowhereLogFile.GetValue())
+		assert.Equal(t, int64(200), Params.KnowhereLogMaxSize.GetAsInt64())
+		assert.Equal(t, 7, Params.KnowhereLogMaxAge.GetAsInt())
+
+		assert.False(t, Params.CGOMemTraceEnable.GetAsBool())
+
+		assert.False(t, Params.SuspendEnable.GetAsBool())
+
+		assert.Equal(t, 30*time.Second, Params.ReservationTTL.GetAsDuration(time.Second))
+		assert.Equal(t, 1, Params.BackgroundBuildThreads.GetAsInt())
+		assert.Equal(t, 19, Params.BackgroundNice.GetAsInt())
+		assert.False(t, Params.IOPriorityEnable.GetAsBool())
+		assert.Equal(t, "", Params.IOPriorityCgroup.GetValue())
+		assert.Equal(t, 100, Params.IOPriorityForeground.GetAsInt())
+		assert.Equal(t, 10, Params.IOPriorityBackground.GetAsInt())
 	})
 
 }
+
+func TestComponentParamNamespace(t *testing.T) {
+	Init()
+	params := Get()
+	ns := params.Namespace()
+
+	assert.Equal(t, params.IndexNodeCfg.BuildParallel.GetValue(), ns.IndexNodeCfg.BuildParallel.GetValue())
+	assert.Equal(t, params.EtcdCfg.MetaRootPath.GetValue(), ns.EtcdCfg.MetaRootPath.GetValue())
+
+	ns.Save(ns.IndexNodeCfg.BuildParallel.Key, "7")
+	defer ns.Reset(ns.IndexNodeCfg.BuildParallel.Key)
+	assert.Equal(t, 7, ns.IndexNodeCfg.BuildParallel.GetAsInt())
+	assert.NotEqual(t, 7, params.IndexNodeCfg.BuildParallel.GetAsInt())
+
+	params.Save(params.IndexNodeCfg.ReconcileEnable.Key, "true")
+	defer params.Reset(params.IndexNodeCfg.ReconcileEnable.Key)
+	assert.True(t, ns.IndexNodeCfg.ReconcileEnable.GetAsBool())
+}
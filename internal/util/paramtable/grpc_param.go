@@ -385,8 +385,9 @@ func (p *GrpcClientConfig) Init(domain string, base *BaseTable) {
 
 	compressionEnabled := fmt.Sprintf("%t", DefaultCompressionEnabled)
 	p.CompressionEnabled = ParamItem{
-		Key:     "grpc.client.compressionEnabled",
-		Version: "2.0.0",
+		Key:          p.Domain + ".grpc.clientCompressionEnabled",
+		Version:      "2.0.0",
+		FallbackKeys: []string{"grpc.client.compressionEnabled"},
 		Formatter: func(v string) string {
 			if v == "" {
 				return compressionEnabled
@@ -396,7 +397,7 @@ func (p *GrpcClientConfig) Init(domain string, base *BaseTable) {
 				log.Warn("Failed to convert int when parsing grpc.client.compressionEnabled, set to default",
 					zap.String("role", p.Domain),
 					zap.String("grpc.client.compressionEnabled", v))
-				return backoffMultiplier
+				return compressionEnabled
 			}
 			return v
 		},
@@ -147,6 +147,10 @@ func TestGrpcClientParams(t *testing.T) {
 	assert.Equal(t, clientConfig.CompressionEnabled.GetAsBool(), DefaultCompressionEnabled)
 	base.Save("grpc.client.CompressionEnabled", "true")
 	assert.Equal(t, clientConfig.CompressionEnabled.GetAsBool(), true)
+	base.Save(role+".grpc.clientCompressionEnabled", "false")
+	assert.Equal(t, clientConfig.CompressionEnabled.GetAsBool(), false)
+	base.Remove(role + ".grpc.clientCompressionEnabled")
+	assert.Equal(t, clientConfig.CompressionEnabled.GetAsBool(), true)
 
 	base.Save("common.security.tlsMode", "1")
 	base.Save("tls.serverPemPath", "/pem")
@@ -55,7 +55,10 @@ type ServiceParam struct {
 
 func (p *ServiceParam) init() {
 	p.BaseTable.init(10)
+	p.initItems()
+}
 
+func (p *ServiceParam) initItems() {
 	p.LocalStorageCfg.Init(&p.BaseTable)
 	p.MetaStoreCfg.Init(&p.BaseTable)
 	p.EtcdCfg.Init(&p.BaseTable)
@@ -568,6 +571,7 @@ type MinioConfig struct {
 	UseIAM          ParamItem `refreshable:"false"`
 	CloudProvider   ParamItem `refreshable:"false"`
 	IAMEndpoint     ParamItem `refreshable:"false"`
+	ZoneEndpoints   ParamItem `refreshable:"false"`
 }
 
 func (p *MinioConfig) Init(base *BaseTable) {
@@ -650,4 +654,11 @@ func (p *MinioConfig) Init(base *BaseTable) {
 		Version:      "2.0.0",
 	}
 	p.IAMEndpoint.Init(base.mgr)
+
+	p.ZoneEndpoints = ParamItem{
+		Key:          "minio.zoneEndpoints",
+		DefaultValue: "{}",
+		Version:      "2.3.0",
+	}
+	p.ZoneEndpoints.Init(base.mgr)
 }
@@ -165,6 +165,7 @@ func TestServiceParam(t *testing.T) {
 		assert.Equal(t, Params.CloudProvider.GetValue(), "aws")
 
 		assert.Equal(t, Params.IAMEndpoint.GetValue(), "")
+		assert.Empty(t, Params.ZoneEndpoints.GetAsJSONMap())
 
 		t.Logf("Minio BucketName = %s", Params.BucketName.GetValue())
 
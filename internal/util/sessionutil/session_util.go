@@ -93,6 +93,8 @@ type Session struct {
 	Stopping    bool   `json:"Stopping,omitempty"`
 	TriggerKill bool
 	Version     semver.Version `json:"Version,omitempty"`
+	// Metadata is the extra information published by the server, such as the capabilities of IndexNode.
+	Metadata map[string]string `json:"Metadata,omitempty"`
 
 	liveCh  <-chan bool
 	etcdCli *clientv3.Client
@@ -130,6 +132,14 @@ func WithResueNodeID(b bool) SessionOption {
 	return func(session *Session) { session.reuseNodeID = b }
 }
 
+// CapabilitiesMetadataKey is the metadata key of the capabilities published by the server.
+const CapabilitiesMetadataKey = "Capabilities"
+
+// WithMetadata sets the extra information published in the session.
+func WithMetadata(metadata map[string]string) SessionOption {
+	return func(session *Session) { session.Metadata = metadata }
+}
+
 func (s *Session) apply(opts ...SessionOption) {
 	for _, opt := range opts {
 		opt(s)
@@ -145,7 +155,8 @@ func (s *Session) UnmarshalJSON(data []byte) error {
 		Exclusive   bool   `json:"Exclusive,omitempty"`
 		Stopping    bool   `json:"Stopping,omitempty"`
 		TriggerKill bool
-		Version     string `json:"Version"`
+		Version     string            `json:"Version"`
+		Metadata    map[string]string `json:"Metadata,omitempty"`
 	}
 	err := json.Unmarshal(data, &raw)
 	if err != nil {
@@ -165,6 +176,7 @@ func (s *Session) UnmarshalJSON(data []byte) error {
 	s.Exclusive = raw.Exclusive
 	s.Stopping = raw.Stopping
 	s.TriggerKill = raw.TriggerKill
+	s.Metadata = raw.Metadata
 	return nil
 }
 
@@ -179,7 +191,8 @@ func (s *Session) MarshalJSON() ([]byte, error) {
 		Exclusive   bool   `json:"Exclusive,omitempty"`
 		Stopping    bool   `json:"Stopping,omitempty"`
 		TriggerKill bool
-		Version     string `json:"Version"`
+		Version     string            `json:"Version"`
+		Metadata    map[string]string `json:"Metadata,omitempty"`
 	}{
 		ServerID:    s.ServerID,
 		ServerName:  s.ServerName,
@@ -188,6 +201,7 @@ func (s *Session) MarshalJSON() ([]byte, error) {
 		Stopping:    s.Stopping,
 		TriggerKill: s.TriggerKill,
 		Version:     verStr,
+		Metadata:    s.Metadata,
 	})
 
 }
@@ -415,6 +415,7 @@ func TestSesssionMarshal(t *testing.T) {
 		ServerName: "test",
 		Address:    "localhost",
 		Version:    common.Version,
+		Metadata:   map[string]string{CapabilitiesMetadataKey: "{}"},
 	}
 
 	bs, err := json.Marshal(s)
@@ -427,6 +428,7 @@ func TestSesssionMarshal(t *testing.T) {
 	assert.Equal(t, s.ServerName, s2.ServerName)
 	assert.Equal(t, s.Address, s2.Address)
 	assert.Equal(t, s.Version.String(), s2.Version.String())
+	assert.Equal(t, s.Metadata, s2.Metadata)
 }
 
 func TestSessionUnmarshal(t *testing.T) {
@@ -701,6 +703,12 @@ func TestSessionEventType_String(t *testing.T) {
 	}
 }
 
+func TestSession_WithMetadata(t *testing.T) {
+	s := &Session{}
+	s.apply(WithMetadata(map[string]string{"key": "value"}))
+	assert.Equal(t, "value", s.Metadata["key"])
+}
+
 func TestSession_apply(t *testing.T) {
 	session := &Session{}
 	opts := []SessionOption{WithCustomConfigEnable(), WithTTL(100), WithRetryTimes(200)}
@@ -599,7 +599,7 @@ func (cluster *MiniCluster) CreateDefaultQueryNode() (types.QueryNodeComponent,
 
 func (cluster *MiniCluster) CreateDefaultIndexNode() (types.IndexNodeComponent, error) {
 	log.Debug("mini cluster CreateDefaultIndexNode")
-	indexNode := indexnode.NewIndexNode(cluster.ctx, cluster.factory)
+	indexNode := indexnode.NewIndexNode(cluster.ctx, cluster.factory, paramtable.Get())
 	indexNode.SetEtcdClient(cluster.etcdCli)
 	port := funcutil.GetAvailablePort()
 	indexNode.SetAddress(funcutil.GetLocalIP() + ":" + fmt.Sprint(port))
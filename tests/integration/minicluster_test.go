@@ -23,6 +23,7 @@ import (
 	"github.com/milvus-io/milvus/internal/datanode"
 	"github.com/milvus-io/milvus/internal/indexnode"
 	"github.com/milvus-io/milvus/internal/querynode"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -113,7 +114,7 @@ func TestAddRemoveIndexNode(t *testing.T) {
 	defer c.Stop()
 	assert.NoError(t, err)
 
-	indexNode := indexnode.NewIndexNode(ctx, c.factory)
+	indexNode := indexnode.NewIndexNode(ctx, c.factory, paramtable.Get())
 	indexNode.SetEtcdClient(c.etcdCli)
 	//indexNode := c.CreateDefaultIndexNode()
 